@@ -8,17 +8,23 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
-	"strings"
 	"syscall"
 	"time"
 
 	"github.com/DeRuina/KUHA-REST-API/docs" // This is required to generate swagger docs
-	"github.com/DeRuina/KUHA-REST-API/internal/env"
+	"github.com/DeRuina/KUHA-REST-API/internal/athletelinks"
+	"github.com/DeRuina/KUHA-REST-API/internal/config"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
+	"github.com/DeRuina/KUHA-REST-API/internal/exports"
+	"github.com/DeRuina/KUHA-REST-API/internal/idempotency"
+	"github.com/DeRuina/KUHA-REST-API/internal/ingest"
 	"github.com/DeRuina/KUHA-REST-API/internal/logger"
 	"github.com/DeRuina/KUHA-REST-API/internal/ratelimiter"
 	"github.com/DeRuina/KUHA-REST-API/internal/store"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/auth"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/internal/webhooks"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
@@ -26,89 +32,49 @@ import (
 
 	archapi "github.com/DeRuina/KUHA-REST-API/cmd/api/archinisis"
 	authapi "github.com/DeRuina/KUHA-REST-API/cmd/api/auth"
+	eventsapi "github.com/DeRuina/KUHA-REST-API/cmd/api/events"
+	exportsapi "github.com/DeRuina/KUHA-REST-API/cmd/api/exports"
 	fisapi "github.com/DeRuina/KUHA-REST-API/cmd/api/fis"
+	ingestapi "github.com/DeRuina/KUHA-REST-API/cmd/api/ingest"
 	kamkapi "github.com/DeRuina/KUHA-REST-API/cmd/api/kamk"
 	klabapi "github.com/DeRuina/KUHA-REST-API/cmd/api/klab"
 	tietoevryapi "github.com/DeRuina/KUHA-REST-API/cmd/api/tietoevry"
 	utvapi "github.com/DeRuina/KUHA-REST-API/cmd/api/utv"
+	webhooksapi "github.com/DeRuina/KUHA-REST-API/cmd/api/webhooks"
 )
 
 type api struct {
-	config           config
+	config           config.Config
 	store            store.Storage
 	cacheStorage     *cache.Storage
 	redisRateLimiter *ratelimiter.RedisSlidingLimiter
 	localRateLimiter *ratelimiter.FixedWindowRateLimiter
-}
-
-type config struct {
-	addr        string
-	db          dbConfig
-	env         string
-	apiURL      string
-	auth        authConfig
-	redisCfg    redisConfig
-	rateLimiter ratelimiter.Config
-}
-
-type redisConfig struct {
-	addr    string
-	pw      string
-	db      int
-	enabled bool
-}
-
-type authConfig struct {
-	basic basicConfig
-	jwt   jwtConfig
-}
-
-type basicConfig struct {
-	user string
-	pass string
-}
-
-type jwtConfig struct {
-	secret   []byte
-	issuer   string
-	audience string
-}
-
-type dbConfig struct {
-	fisAddr        string
-	utvAddr        string
-	authAddr       string
-	tietoevryAddr  string
-	kamkAddr       string
-	klabAddr       string
-	archinisisAddr string
-	maxOpenConns   int
-	maxIdleConns   int
-	maxIdleTime    string
+	inflight         *inflightTracker
+	exports          *exports.Pool
+	ingest           *ingest.Pool
+	outbox           *events.Outbox
+	events           *events.Hub
+	webhooks         *webhooks.Dispatcher
+	idempotency      idempotency.Store
 }
 
 func (app *api) mount() http.Handler {
 	r := chi.NewRouter()
 
-	// Middlewares
-	r.Use(middleware.Timeout(3 * time.Minute))
+	// Middlewares (request deadlines are applied per route group)
 	r.Use(middleware.Recoverer)
 	r.Use(middleware.RealIP)
 	r.Use(ExtractClientIDMiddleware())
 	r.Use(app.RateLimiterMiddleware)
 	r.Use(middleware.RequestID)
 	r.Use(logger.LoggerMiddleware)
-
-	origins := strings.Split(env.GetString("CORS_ALLOWED_ORIGIN", ""), ",")
-	for i := range origins {
-		origins[i] = strings.TrimSpace(origins[i])
-	}
+	r.Use(app.InflightWritesMiddleware)
 
 	r.Use(cors.Handler(cors.Options{
-		AllowedOrigins:   origins,
+		AllowedOrigins:   app.config.Server.CORSAllowedOrigins,
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
-		ExposedHeaders:   []string{"Link"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "Range", "If-Range", "Idempotency-Key", "Last-Event-ID"},
+		ExposedHeaders:   []string{"Link", "Location", "Content-Range", "Content-Disposition", "Accept-Ranges", "ETag", "Idempotent-Replayed"},
 		AllowCredentials: false,
 		MaxAge:           300,
 	}))
@@ -117,6 +83,7 @@ func (app *api) mount() http.Handler {
 		// Auth routes
 		if app.store.Auth != nil {
 			r.Route("/auth", func(r chi.Router) {
+				r.Use(app.RouteLimitsMiddleware("auth"))
 				authHandler := authapi.NewAuthHandler(app.store.Auth)
 				r.Post("/token", authHandler.IssueTokens)
 				r.Post("/refresh", authHandler.RefreshToken)
@@ -130,25 +97,129 @@ func (app *api) mount() http.Handler {
 			})
 		}
 
-		// Healthcheck
-		r.Get("/health", app.healthCheckHandler)
+		r.Group(func(r chi.Router) {
+			r.Use(app.RouteLimitsMiddleware(""))
+
+			// Healthcheck
+			r.Get("/health", app.healthCheckHandler)
+			r.Get("/ready", app.readinessHandler)
 
-		// Metrics
-		r.With(app.BasicAuthMiddleware()).Get("/metrics", expvar.Handler().ServeHTTP)
+			// Metrics
+			r.With(app.BasicAuthMiddleware()).Get("/metrics", expvar.Handler().ServeHTTP)
 
-		// Swagger docs
-		docsURL := fmt.Sprintf("%s/swagger/doc.json", app.config.addr)
-		r.Get("/docs", func(w http.ResponseWriter, r *http.Request) {
-			http.Redirect(w, r, "/v1/docs/", http.StatusMovedPermanently)
+			// Swagger docs
+			docsURL := fmt.Sprintf("%s/swagger/doc.json", app.config.Server.Addr)
+			r.Get("/docs", func(w http.ResponseWriter, r *http.Request) {
+				http.Redirect(w, r, "/v1/docs/", http.StatusMovedPermanently)
+			})
+			r.Get("/docs/*", httpSwagger.Handler(httpSwagger.URL(docsURL)))
 		})
-		r.Get("/docs/*", httpSwagger.Handler(httpSwagger.URL(docsURL)))
 
 		r.Group(func(r chi.Router) {
-			r.Use(JWTMiddleware())
+			var calendarFeeds auth.CalendarFeeds
+			if app.store.Auth != nil {
+				calendarFeeds = app.store.Auth.CalendarFeeds()
+			}
+			r.Use(JWTMiddleware(calendarFeeds))
+
+			// Export job routes
+			if app.exports != nil {
+				r.Route("/exports", func(r chi.Router) {
+					r.Use(app.RouteLimitsMiddleware("exports"))
+					r.Use(app.IdempotencyMiddleware)
+
+					// Register handlers
+					exportsHandler := exportsapi.NewExportsHandler(app.store.Auth.ExportJobs(), app.exports)
+
+					r.Post("/", exportsHandler.CreateExport)
+					r.Get("/", exportsHandler.ListExports)
+					r.Get("/{id}", exportsHandler.GetExport)
+					r.Post("/{id}/cancel", exportsHandler.CancelExport)
+					r.Get("/{id}/download", exportsHandler.DownloadExport)
+				})
+			} else {
+				logger.Logger.Warn("export routes disabled: auth database not connected or exports disabled")
+				r.Route("/exports", func(r chi.Router) {
+					r.Handle("/*", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						utils.ServiceUnavailableDBResponse(w, r, "Auth")
+					}))
+				})
+			}
+
+			// Ingest job routes
+			if app.ingest != nil {
+				r.Route("/ingest-jobs", func(r chi.Router) {
+					r.Use(app.RouteLimitsMiddleware("ingest"))
+
+					// Register handlers
+					ingestJobsHandler := ingestapi.NewIngestJobsHandler(app.store.Auth.IngestJobs(), app.ingest)
+
+					r.Get("/", ingestJobsHandler.ListIngestJobs)
+					r.Get("/{id}", ingestJobsHandler.GetIngestJob)
+					r.Post("/{id}/retry", ingestJobsHandler.RetryIngestJob)
+				})
+			} else {
+				logger.Logger.Warn("ingest job routes disabled: auth database not connected or ingest jobs disabled")
+				r.Route("/ingest-jobs", func(r chi.Router) {
+					r.Handle("/*", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						utils.ServiceUnavailableDBResponse(w, r, "Auth")
+					}))
+				})
+			}
+
+			// Event stream routes
+			if app.events != nil {
+				r.Route("/events", func(r chi.Router) {
+					r.Use(app.RouteLimitsMiddleware("events"))
+
+					// Register handlers
+					eventsHandler := eventsapi.NewEventsHandler(app.events, app.store.UTV, app.config.Events.Heartbeat)
+
+					r.Get("/stream", eventsHandler.StreamEvents)
+				})
+			} else {
+				logger.Logger.Warn("event stream routes disabled: auth database not connected or events disabled")
+				r.Route("/events", func(r chi.Router) {
+					r.Handle("/*", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						utils.ServiceUnavailableDBResponse(w, r, "Auth")
+					}))
+				})
+			}
+
+			// Webhook subscription routes
+			if app.webhooks != nil {
+				r.Route("/webhooks", func(r chi.Router) {
+					r.Use(app.RouteLimitsMiddleware("webhooks"))
+					r.Use(app.IdempotencyMiddleware)
+
+					// Register handlers
+					webhooksHandler := webhooksapi.NewWebhooksHandler(app.store.Auth.Webhooks(), app.webhooks, app.config.Webhooks.AllowHTTP, app.config.Webhooks.AllowPrivate)
+
+					r.Post("/", webhooksHandler.CreateWebhook)
+					r.Get("/", webhooksHandler.ListWebhooks)
+					r.Get("/{id}", webhooksHandler.GetWebhook)
+					r.Put("/{id}", webhooksHandler.UpdateWebhook)
+					r.Delete("/{id}", webhooksHandler.DeleteWebhook)
+					r.Post("/{id}/rotate-secret", webhooksHandler.RotateWebhookSecret)
+					r.Get("/{id}/deliveries", webhooksHandler.ListWebhookDeliveries)
+					r.Post("/{id}/deliveries/{delivery_id}/redeliver", webhooksHandler.RedeliverWebhookDelivery)
+					r.Post("/{id}/redeliver-dead", webhooksHandler.RedeliverDeadWebhookDeliveries)
+				})
+			} else {
+				logger.Logger.Warn("webhook routes disabled: auth database not connected or webhooks disabled")
+				r.Route("/webhooks", func(r chi.Router) {
+					r.Handle("/*", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						utils.ServiceUnavailableDBResponse(w, r, "Auth")
+					}))
+				})
+			}
 
 			// Tietoevry routes
 			if app.store.Tietoevry != nil {
 				r.Route("/tietoevry", func(r chi.Router) {
+					r.Use(app.RouteLimitsMiddleware("tietoevry"))
+					r.Use(app.IdempotencyMiddleware)
+
 					// Register handlers
 					userHandler := tietoevryapi.NewTietoevryUserHandler(app.store.Tietoevry.Users(), app.cacheStorage)
 					exerciseHandler := tietoevryapi.NewTietoevryExerciseHandler(app.store.Tietoevry.Exercises(), app.cacheStorage)
@@ -159,33 +230,33 @@ func (app *api) mount() http.Handler {
 					activityZoneHandler := tietoevryapi.NewTietoevryActivityZoneHandler(app.store.Tietoevry.ActivityZones(), app.cacheStorage)
 
 					// user routes
-					r.Post("/users", userHandler.UpsertUser)
+					r.Post("/users", app.async("tietoevry_users", userHandler.UpsertUser))
 					r.Delete("/users", userHandler.DeleteUser)
 					r.Get("/users", userHandler.GetUser)
 					r.Get("/deleted-users", userHandler.GetDeletedUsers)
 
 					// exercise routes
-					r.With(GzipDecompressionMiddleware()).Post("/exercises", exerciseHandler.InsertExercisesBulk)
+					r.With(GzipDecompressionMiddleware()).Post("/exercises", app.async("tietoevry_exercises", exerciseHandler.InsertExercisesBulk))
 					r.Get("/exercises", exerciseHandler.GetExercises)
 
 					// symptom routes
-					r.With(GzipDecompressionMiddleware()).Post("/symptoms", symptomHandler.InsertSymptomsBulk)
+					r.With(GzipDecompressionMiddleware()).Post("/symptoms", app.async("tietoevry_symptoms", symptomHandler.InsertSymptomsBulk))
 					r.Get("/symptoms", symptomHandler.GetSymptoms)
 
 					// measurement routes
-					r.With(GzipDecompressionMiddleware()).Post("/measurements", measurementHandler.InsertMeasurementsBulk)
+					r.With(GzipDecompressionMiddleware()).Post("/measurements", app.async("tietoevry_measurements", measurementHandler.InsertMeasurementsBulk))
 					r.Get("/measurements", measurementHandler.GetMeasurements)
 
 					// test result routes
-					r.With(GzipDecompressionMiddleware()).Post("/test-results", testResultHandler.InsertTestResultsBulk)
+					r.With(GzipDecompressionMiddleware()).Post("/test-results", app.async("tietoevry_test_results", testResultHandler.InsertTestResultsBulk))
 					r.Get("/test-results", testResultHandler.GetTestResults)
 
 					// questionnaire routes
-					r.With(GzipDecompressionMiddleware()).Post("/questionnaires", questionnaireHandler.InsertQuestionnaireAnswersBulk)
+					r.With(GzipDecompressionMiddleware()).Post("/questionnaires", app.async("tietoevry_questionnaires", questionnaireHandler.InsertQuestionnaireAnswersBulk))
 					r.Get("/questionnaires", questionnaireHandler.GetQuestionnaires)
 
 					// activity zone routes
-					r.With(GzipDecompressionMiddleware()).Post("/activity-zones", activityZoneHandler.InsertActivityZonesBulk)
+					r.With(GzipDecompressionMiddleware()).Post("/activity-zones", app.async("tietoevry_activity_zones", activityZoneHandler.InsertActivityZonesBulk))
 					r.Get("/activity-zones", activityZoneHandler.GetActivityZones)
 				})
 			} else {
@@ -200,6 +271,9 @@ func (app *api) mount() http.Handler {
 			// KAMK routes
 			if app.store.KAMK != nil {
 				r.Route("/kamk", func(r chi.Router) {
+					r.Use(app.RouteLimitsMiddleware("kamk"))
+					r.Use(app.IdempotencyMiddleware)
+
 					// Register handlers
 					injuriesHandler := kamkapi.NewInjuriesHandler(app.store.KAMK.Injuries(), app.cacheStorage)
 					queriesHandler := kamkapi.NewQueriesHandler(app.store.KAMK.Queries(), app.cacheStorage)
@@ -230,6 +304,9 @@ func (app *api) mount() http.Handler {
 			// Archinisis routes
 			if app.store.ARCHINISIS != nil {
 				r.Route("/archinisis", func(r chi.Router) {
+					r.Use(app.RouteLimitsMiddleware("archinisis"))
+					r.Use(app.IdempotencyMiddleware)
+
 					// Register handlers
 					dataHandler := archapi.NewDataHandler(app.store.ARCHINISIS.Data(), app.cacheStorage)
 					userHandler := archapi.NewUserDataHandler(app.store.ARCHINISIS.Users(), app.cacheStorage)
@@ -256,6 +333,9 @@ func (app *api) mount() http.Handler {
 			// KLAB routes
 			if app.store.KLAB != nil {
 				r.Route("/klab", func(r chi.Router) {
+					r.Use(app.RouteLimitsMiddleware("klab"))
+					r.Use(app.IdempotencyMiddleware)
+
 					// Register handlers
 					userDataHandler := klabapi.NewUserDataHandler(app.store.KLAB.Users(), app.cacheStorage)
 					klabDataHandler := klabapi.NewKlabDataHandler(app.store.KLAB.Data(), app.cacheStorage)
@@ -265,7 +345,7 @@ func (app *api) mount() http.Handler {
 					r.Delete("/user", userDataHandler.DeleteUser)
 
 					// data routes
-					r.Post("/data", klabDataHandler.InsertKlabDataBulk)
+					r.Post("/data", app.async("klab_data", klabDataHandler.InsertKlabDataBulk))
 					r.Get("/data", klabDataHandler.GetKlabData)
 				})
 			} else {
@@ -280,6 +360,9 @@ func (app *api) mount() http.Handler {
 			// FIS routes
 			if app.store.FIS != nil {
 				r.Route("/fis", func(r chi.Router) {
+					r.Use(app.RouteLimitsMiddleware("fis"))
+					r.Use(app.IdempotencyMiddleware)
+
 					// Register handlers
 					competitorHandler := fisapi.NewCompetitorHandler(app.store.FIS.Competitors(), app.cacheStorage)
 					raceCCHandler := fisapi.NewRaceCCHandler(app.store.FIS.RaceCC(), app.cacheStorage)
@@ -291,6 +374,10 @@ func (app *api) mount() http.Handler {
 					athleteHandler := fisapi.NewAthleteHandler(app.store.FIS.Athlete(), app.cacheStorage)
 					kamkRacesHandler := fisapi.NewRaceSearchHandler(app.store.FIS.RaceCC(), app.store.FIS.RaceJP(), app.store.FIS.RaceNK(), app.cacheStorage)
 					kamkResultsHandler := fisapi.NewResultKAMKHandler(app.store.FIS.ResultCC(), app.store.FIS.ResultJP(), app.store.FIS.ResultNK(), app.cacheStorage)
+					changesHandler := fisapi.NewChangesHandler(app.store.FIS.Changes())
+					sectorHandler := fisapi.NewSectorHandler(app.store.FIS, app.cacheStorage)
+					calendarHandler := fisapi.NewCalendarHandler(app.store.FIS.Calendar())
+					athleteLinksHandler := fisapi.NewAthleteLinksHandler(app.store.FIS.AthleteLinks(), athletelinks.NewMatcher(app.store))
 
 					// kamk endpoints
 					r.Get("/races/search", kamkRacesHandler.SearchRaces)
@@ -303,6 +390,7 @@ func (app *api) mount() http.Handler {
 					r.Get("/competitor/search", competitorHandler.SearchCompetitors)
 					r.Get("/competitor/count-by-nation", competitorHandler.GetCompetitorCountsByNation)
 					r.Get("/competitor/sectorcode", competitorHandler.GetSectorcodeByFiscode)
+					r.Get("/competitor/{fiscode}/stats", sectorHandler.GetCompetitorStats)
 
 					// athlete routes
 					r.Get("/fiscode", athleteHandler.GetAthletesBySporttiID)
@@ -372,6 +460,61 @@ func (app *api) mount() http.Handler {
 					r.Put("/resultnk", resultNKHandler.UpdateResultNK)
 					r.Delete("/resultnk", resultNKHandler.DeleteResultNK)
 
+					// incremental sync
+					r.Get("/changes", changesHandler.GetChanges)
+
+					// competition calendar
+					r.Get("/calendar", calendarHandler.GetCalendar)
+
+					// calendar feed tokens, kept in the auth database
+					if calendarFeeds != nil {
+						calendarFeedsHandler := fisapi.NewCalendarFeedsHandler(calendarFeeds)
+
+						r.Post("/calendar/feeds", calendarFeedsHandler.CreateCalendarFeed)
+						r.Get("/calendar/feeds", calendarFeedsHandler.ListCalendarFeeds)
+						r.Delete("/calendar/feeds/{id}", calendarFeedsHandler.DeleteCalendarFeed)
+					} else {
+						r.Route("/calendar/feeds", func(r chi.Router) {
+							r.Handle("/*", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+								utils.ServiceUnavailableDBResponse(w, r, "Auth")
+							}))
+						})
+					}
+
+					// sportti_id linking: matching job and review of the candidates
+					r.Post("/athlete-links/match", athleteLinksHandler.RunAthleteLinkMatching)
+					r.Get("/athlete-links", athleteLinksHandler.ListAthleteLinkCandidates)
+					r.Get("/athlete-links/{id}", athleteLinksHandler.GetAthleteLinkCandidate)
+					r.Post("/athlete-links/{id}/approve", athleteLinksHandler.ApproveAthleteLinkCandidate)
+					r.Post("/athlete-links/{id}/reject", athleteLinksHandler.RejectAthleteLinkCandidate)
+
+					// sector-agnostic routes; the per-sector routes above remain as aliases
+					r.Get("/athletes/{fiscode}/results", sectorHandler.GetAthleteResultsAllSectors)
+					r.Route("/{sector}", func(r chi.Router) {
+						r.Get("/seasons", sectorHandler.GetSectorSeasons)
+						r.Get("/disciplines", sectorHandler.GetSectorDisciplines)
+						r.Get("/categories", sectorHandler.GetSectorCategories)
+						r.Get("/races", sectorHandler.GetSectorRaces)
+						r.Get("/races/{raceid}", sectorHandler.GetSectorRace)
+						r.Get("/races/{raceid}/results", sectorHandler.GetSectorRaceResults)
+						r.Get("/races/{raceid}/results/enriched", sectorHandler.GetSectorRaceEnrichedResults)
+						r.Get("/results", sectorHandler.GetSectorAthleteResults)
+						r.Get("/head-to-head", sectorHandler.GetHeadToHead)
+						r.Get("/points", sectorHandler.GetPointsList)
+						r.Get("/points/history", sectorHandler.GetPointsHistory)
+					})
+
+					// sync (bulk upsert) routes
+					r.Route("/sync", func(r chi.Router) {
+						r.Use(GzipDecompressionMiddleware())
+						r.Post("/competitor", app.async("fis_sync_competitor", competitorHandler.SyncCompetitors))
+						r.Post("/racecc", app.async("fis_sync_racecc", raceCCHandler.SyncRacesCC))
+						r.Post("/racejp", app.async("fis_sync_racejp", raceJPhandler.SyncRacesJP))
+						r.Post("/racenk", app.async("fis_sync_racenk", raceNKhandler.SyncRacesNK))
+						r.Post("/resultcc", app.async("fis_sync_resultcc", resultCCHandler.SyncResultsCC))
+						r.Post("/resultjp", app.async("fis_sync_resultjp", resultJPHandler.SyncResultsJP))
+						r.Post("/resultnk", app.async("fis_sync_resultnk", resultNKHandler.SyncResultsNK))
+					})
 				})
 			} else {
 				logger.Logger.Warn("fis routes disabled: database not reachable")
@@ -384,6 +527,9 @@ func (app *api) mount() http.Handler {
 			// UTV routes
 			if app.store.UTV != nil {
 				r.Route("/utv", func(r chi.Router) {
+					r.Use(app.RouteLimitsMiddleware("utv"))
+					r.Use(app.IdempotencyMiddleware)
+
 					// Register handlers
 					generalHandler := utvapi.NewGeneralDataHandler(
 						app.store.UTV.Oura(),
@@ -453,7 +599,7 @@ func (app *api) mount() http.Handler {
 						r.Get("/dates", ouraHandler.GetDates)
 						r.Get("/types", ouraHandler.GetTypes)
 						r.Get("/data", ouraHandler.GetData)
-						r.Post("/data", ouraHandler.InsertData)
+						r.Post("/data", app.async("utv_oura_data", ouraHandler.InsertData))
 						r.Delete("/data", ouraHandler.DeleteAllData)
 						r.Get("/status", ouraTokenHandler.GetStatus)
 						r.Post("/token", ouraTokenHandler.UpsertToken)
@@ -465,7 +611,7 @@ func (app *api) mount() http.Handler {
 						r.Get("/dates", polarHandler.GetDates)
 						r.Get("/types", polarHandler.GetTypes)
 						r.Get("/data", polarHandler.GetData)
-						r.Post("/data", polarHandler.InsertData)
+						r.Post("/data", app.async("utv_polar_data", polarHandler.InsertData))
 						r.Delete("/data", polarHandler.DeleteAllData)
 						r.Get("/status", polarTokenHandler.GetStatus)
 						r.Post("/token", polarTokenHandler.UpsertToken)
@@ -477,7 +623,7 @@ func (app *api) mount() http.Handler {
 						r.Get("/dates", suuntoHandler.GetDates)
 						r.Get("/types", suuntoHandler.GetTypes)
 						r.Get("/data", suuntoHandler.GetData)
-						r.Post("/data", suuntoHandler.InsertData)
+						r.Post("/data", app.async("utv_suunto_data", suuntoHandler.InsertData))
 						r.Delete("/data", suuntoHandler.DeleteAllData)
 						r.Get("/status", suuntoTokenHandler.GetStatus)
 						r.Post("/token", suuntoTokenHandler.UpsertToken)
@@ -489,7 +635,7 @@ func (app *api) mount() http.Handler {
 						r.Get("/dates", garminHandler.GetDates)
 						r.Get("/types", garminHandler.GetTypes)
 						r.Get("/data", garminHandler.GetData)
-						r.Post("/data", garminHandler.InsertData)
+						r.Post("/data", app.async("utv_garmin_data", garminHandler.InsertData))
 						r.Delete("/data", garminHandler.DeleteAllData)
 						r.Get("/status", garminTokenHandler.GetStatus)
 						r.Post("/token", garminTokenHandler.UpsertToken)
@@ -520,15 +666,20 @@ func (app *api) mount() http.Handler {
 func (app *api) run(mux http.Handler) error {
 	// Docs
 	docs.SwaggerInfo.Version = version
-	docs.SwaggerInfo.Host = app.config.apiURL
+	docs.SwaggerInfo.Host = app.config.Server.ExternalURL
 	docs.SwaggerInfo.BasePath = "/v1"
 
 	srv := &http.Server{
-		Addr:         app.config.addr,
+		Addr:         app.config.Server.Addr,
 		Handler:      mux,
-		ReadTimeout:  3 * time.Minute,
-		WriteTimeout: 3 * time.Minute,
-		IdleTimeout:  time.Minute,
+		ReadTimeout:  app.config.HTTP.ReadTimeout,
+		WriteTimeout: app.config.HTTP.WriteTimeout,
+		IdleTimeout:  app.config.HTTP.IdleTimeout,
+	}
+
+	// open event streams would otherwise hold up the drain
+	if app.events != nil {
+		srv.RegisterOnShutdown(app.events.Stop)
 	}
 
 	shutdown := make(chan error)
@@ -539,15 +690,11 @@ func (app *api) run(mux http.Handler) error {
 		signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 		s := <-quit
 
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancel()
-
 		logger.Logger.Infow("signal caught", "signal", s.String())
-
-		shutdown <- srv.Shutdown(ctx)
+		shutdown <- app.shutdown(srv)
 	}()
 
-	logger.Logger.Infow("server has started", "addr", app.config.addr, "env", app.config.env)
+	logger.Logger.Infow("server has started", "addr", app.config.Server.Addr, "env", app.config.Server.Env)
 
 	err := srv.ListenAndServe()
 	if !errors.Is(err, http.ErrServerClosed) {
@@ -559,7 +706,61 @@ func (app *api) run(mux http.Handler) error {
 		return err
 	}
 
-	logger.Logger.Infow("server has stopped", "addr", app.config.addr, "env", app.config.env)
+	logger.Logger.Infow("server has stopped", "addr", app.config.Server.Addr, "env", app.config.Server.Env)
+
+	return nil
+}
+
+// shutdown flips readiness to failing, gives load balancers time to notice,
+// then drains in-flight requests. Requests still running when the drain
+// timeout expires are aborted and logged.
+func (app *api) shutdown(srv *http.Server) error {
+	app.inflight.draining.Store(true)
+
+	logger.Logger.Infow("draining",
+		"readiness_delay", app.config.Server.ReadinessDelay.String(),
+		"shutdown_timeout", app.config.Server.ShutdownTimeout.String(),
+		"inflight_writes", app.inflight.Count(),
+	)
+	time.Sleep(app.config.Server.ReadinessDelay)
+
+	ctx, cancel := context.WithTimeout(context.Background(), app.config.Server.ShutdownTimeout)
+	defer cancel()
+
+	completedBefore := app.inflight.completed.Load()
+	err := srv.Shutdown(ctx)
+	drained := app.inflight.completed.Load() - completedBefore
+
+	// running exports and ingest jobs are put back in the queue for another instance
+	if app.exports != nil {
+		app.exports.Stop()
+	}
+	if app.ingest != nil {
+		app.ingest.Stop()
+	}
+	// after the writes have drained, so their events are queued first
+	if app.webhooks != nil {
+		app.webhooks.Stop()
+	}
+	if app.outbox != nil {
+		app.outbox.Stop()
+	}
+
+	if err == nil {
+		logger.Logger.Infow("drain complete", "drained_writes", drained)
+		return nil
+	}
 
+	aborted := app.inflight.Snapshot()
+	logger.Logger.Warnw("drain timeout exceeded, aborting in-flight requests",
+		"error", err,
+		"drained_writes", drained,
+		"aborted_writes", len(aborted),
+		"aborted", aborted,
+	)
+
+	if cerr := srv.Close(); cerr != nil {
+		return cerr
+	}
 	return nil
 }
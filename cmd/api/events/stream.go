@@ -0,0 +1,296 @@
+package eventsapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
+	"github.com/DeRuina/KUHA-REST-API/internal/logger"
+	"github.com/DeRuina/KUHA-REST-API/internal/store"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+// maxAthleteIDs caps the athlete_ids filter of a stream
+const maxAthleteIDs = 1000
+
+// retryMillis is the reconnection delay suggested to clients
+const retryMillis = 2000
+
+var (
+	errNoDomains      = errors.New("no readable domains to follow")
+	errTooManyIDs     = fmt.Errorf("at most %d athlete_ids allowed", maxAthleteIDs)
+	errInvalidEventID = errors.New("invalid Last-Event-ID")
+	errGroupEmpty     = errors.New("group not found or has no members")
+)
+
+// Handler struct
+type EventsHandler struct {
+	hub       *events.Hub
+	utv       store.UTV
+	heartbeat time.Duration
+}
+
+// NewEventsHandler returns the event stream handler. utv may be nil, in
+// which case streams cannot be filtered by group.
+func NewEventsHandler(hub *events.Hub, utv store.UTV, heartbeat time.Duration) *EventsHandler {
+	return &EventsHandler{hub: hub, utv: utv, heartbeat: heartbeat}
+}
+
+// StreamEvents godoc
+//
+//	@Summary		Stream change events
+//	@Description	Streams the change events of the domains the client can read as Server-Sent Events, starting from now. Each event has its ID in the `id` field and the event as JSON in `data`. A client reconnecting with `Last-Event-ID` (or `last_event_id`) first receives the events it missed; if some are no longer available, a `reset` event tells it to reload its data. Lines starting with `:` are keep-alives. A stream ends at the read deadline of the route and the client reconnects.
+//	@Tags			Events
+//	@Produce		text/event-stream
+//	@Param			domains			query		string	false	"Comma-separated domains (default: all readable)"
+//	@Param			athlete_ids		query		string	false	"Comma-separated athlete IDs (user UUIDs, sportti IDs or FIS codes, depending on the domain)"
+//	@Param			group_id		query		string	false	"UTV group ID (UUID); follows the members of the group"
+//	@Param			last_event_id	query		int		false	"Resume after this event ID"
+//	@Param			Last-Event-ID	header		int		false	"Resume after this event ID"
+//	@Success		200				{object}	swagger.ChangeEvent	"Stream of events"
+//	@Failure		400				{object}	swagger.ValidationErrorResponse
+//	@Failure		401				{object}	swagger.UnauthorizedResponse
+//	@Failure		403				{object}	swagger.ForbiddenResponse
+//	@Failure		404				{object}	swagger.NotFoundResponse
+//	@Failure		500				{object}	swagger.InternalServerErrorResponse
+//	@Failure		503				{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/events/stream [get]
+func (h *EventsHandler) StreamEvents(w http.ResponseWriter, r *http.Request) {
+	if err := utils.ValidateParams(r, []string{"domains", "athlete_ids", "group_id", "last_event_id"}); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	filter, ok := h.readFilter(w, r)
+	if !ok {
+		return
+	}
+
+	lastID, err := lastEventID(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	// subscribe before replaying, so nothing published meanwhile is missed
+	sub, ok := h.hub.Subscribe(filter)
+	if !ok {
+		utils.ServiceUnavailableDBResponse(w, r, "Auth")
+		return
+	}
+	defer h.hub.Unsubscribe(sub)
+
+	var replay []events.Published
+	var gap bool
+	if lastID > 0 {
+		replay, err = h.hub.Replay(r.Context(), lastID, filter)
+		if errors.Is(err, events.ErrGap) {
+			gap = true
+		} else if err != nil {
+			utils.InternalServerError(w, r, err)
+			return
+		}
+	}
+
+	// end the stream just before the route deadline, so the client sees a
+	// clean end and reconnects instead of getting a timeout
+	rc := http.NewResponseController(w)
+	end := make(<-chan time.Time)
+	if deadline, ok := r.Context().Deadline(); ok {
+		_ = rc.SetWriteDeadline(deadline)
+		timer := time.NewTimer(time.Until(deadline) - time.Second)
+		defer timer.Stop()
+		end = timer.C
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	// keep reverse proxies from buffering the stream
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	sse := &sseWriter{w: w, rc: rc}
+	sse.write(fmt.Sprintf("retry: %d\n\n", retryMillis))
+	if gap {
+		sse.reset(lastID)
+	}
+	for _, e := range replay {
+		sse.event(e)
+		lastID = e.ID
+	}
+	if sse.flush() != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(h.heartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-sub.Done():
+			return
+		case <-end:
+			return
+		case <-heartbeat.C:
+			sse.write(": keep-alive\n\n")
+		case e := <-sub.Events():
+			// already replayed
+			if e.ID <= lastID {
+				continue
+			}
+			sse.event(e)
+			lastID = e.ID
+		}
+		if sse.flush() != nil {
+			return
+		}
+	}
+}
+
+// readFilter reads the filter of a stream, answering 400, 403 or 404 if it
+// is invalid or asks for data the client cannot read
+func (h *EventsHandler) readFilter(w http.ResponseWriter, r *http.Request) (events.Filter, bool) {
+	var f events.Filter
+
+	requested := listParam(r, "domains")
+	for _, d := range requested {
+		if !slices.Contains(events.Domains, d) {
+			utils.BadRequestResponse(w, r, fmt.Errorf("%w: domain %q", utils.ErrInvalidChoice, d))
+			return f, false
+		}
+		if !authz.Allowed(r.Context(), http.MethodGet, "/v1/"+d) {
+			utils.ForbiddenResponse(w, r, fmt.Errorf("access denied to domain %s", d))
+			return f, false
+		}
+	}
+	f.Domains = requested
+	if len(requested) == 0 {
+		for _, d := range events.Domains {
+			if authz.Allowed(r.Context(), http.MethodGet, "/v1/"+d) {
+				f.Domains = append(f.Domains, d)
+			}
+		}
+	}
+	if len(f.Domains) == 0 {
+		utils.ForbiddenResponse(w, r, errNoDomains)
+		return f, false
+	}
+
+	ids := listParam(r, "athlete_ids")
+	if len(ids) > maxAthleteIDs {
+		utils.BadRequestResponse(w, r, errTooManyIDs)
+		return f, false
+	}
+	if len(ids) > 0 {
+		f.AthleteIDs = make(map[string]bool, len(ids))
+		for _, id := range ids {
+			f.AthleteIDs[id] = true
+		}
+	}
+
+	if g := r.URL.Query().Get("group_id"); g != "" {
+		groupID, err := utils.ParseUUID(g)
+		if err != nil {
+			utils.BadRequestResponse(w, r, err)
+			return f, false
+		}
+		if !authz.Allowed(r.Context(), http.MethodGet, "/v1/utv") {
+			utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
+			return f, false
+		}
+		if h.utv == nil {
+			utils.ServiceUnavailableDBResponse(w, r, "UTV")
+			return f, false
+		}
+		members, err := h.utv.UserData().GetGroupMembers(r.Context(), groupID)
+		if err != nil {
+			utils.InternalServerError(w, r, err)
+			return f, false
+		}
+		if len(members) == 0 {
+			utils.NotFoundResponse(w, r, errGroupEmpty)
+			return f, false
+		}
+		if f.AthleteIDs == nil {
+			f.AthleteIDs = make(map[string]bool, len(members))
+		}
+		for _, m := range members {
+			f.AthleteIDs[m.String()] = true
+		}
+	}
+
+	return f, true
+}
+
+// lastEventID returns the ID of the last event the client received, 0 if
+// it starts afresh
+func lastEventID(r *http.Request) (int64, error) {
+	val := r.Header.Get("Last-Event-ID")
+	if val == "" {
+		val = r.URL.Query().Get("last_event_id")
+	}
+	if val == "" {
+		return 0, nil
+	}
+	id, err := strconv.ParseInt(val, 10, 64)
+	if err != nil || id < 0 {
+		return 0, errInvalidEventID
+	}
+	return id, nil
+}
+
+func listParam(r *http.Request, key string) []string {
+	var out []string
+	for _, v := range r.URL.Query()[key] {
+		for _, part := range strings.Split(v, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				out = append(out, part)
+			}
+		}
+	}
+	return out
+}
+
+// sseWriter writes Server-Sent Events, keeping the first error
+type sseWriter struct {
+	w   http.ResponseWriter
+	rc  *http.ResponseController
+	err error
+}
+
+func (s *sseWriter) event(e events.Published) {
+	data, err := json.Marshal(e)
+	if err != nil {
+		logger.Logger.Warnw("encoding event failed", "event_id", e.ID, "error", err)
+		return
+	}
+	s.write(fmt.Sprintf("id: %d\ndata: %s\n\n", e.ID, data))
+}
+
+// reset tells the client that events after lastID were missed
+func (s *sseWriter) reset(lastID int64) {
+	s.write(fmt.Sprintf("event: reset\ndata: {\"last_event_id\":%d}\n\n", lastID))
+}
+
+func (s *sseWriter) write(text string) {
+	if s.err == nil {
+		_, s.err = s.w.Write([]byte(text))
+	}
+}
+
+func (s *sseWriter) flush() error {
+	if s.err == nil {
+		s.err = s.rc.Flush()
+	}
+	return s.err
+}
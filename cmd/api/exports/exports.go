@@ -0,0 +1,321 @@
+package exportsapi
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authn"
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	authsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/auth"
+	"github.com/DeRuina/KUHA-REST-API/internal/exports"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/auth"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+var (
+	errUnknownKind    = errors.New("unknown export kind")
+	errExportFinished = errors.New("export has already finished")
+	errExportNotReady = errors.New("export is not ready for download")
+	errExportExpired  = errors.New("export file is no longer available")
+)
+
+// Handler struct
+type ExportsHandler struct {
+	store auth.ExportJobs
+	pool  *exports.Pool
+}
+
+func NewExportsHandler(store auth.ExportJobs, pool *exports.Pool) *ExportsHandler {
+	return &ExportsHandler{store: store, pool: pool}
+}
+
+// Input struct for validation
+type ExportJobInput struct {
+	Kind   string          `json:"kind" validate:"required"`
+	Format string          `json:"format" validate:"required,oneof=csv parquet ndjson"`
+	Params json.RawMessage `json:"params" validate:"required"`
+}
+
+// ExportJobResponse is the status of an export job
+type ExportJobResponse struct {
+	ID          uuid.UUID       `json:"id"`
+	Kind        string          `json:"kind"`
+	Format      string          `json:"format"`
+	Params      json.RawMessage `json:"params"`
+	Status      string          `json:"status"`
+	Error       *string         `json:"error,omitempty"`
+	RowsWritten int64           `json:"rows_written"`
+	SizeBytes   int64           `json:"size_bytes"`
+	CreatedAt   time.Time       `json:"created_at"`
+	StartedAt   *time.Time      `json:"started_at,omitempty"`
+	FinishedAt  *time.Time      `json:"finished_at,omitempty"`
+	ExpiresAt   time.Time       `json:"expires_at"`
+	DownloadURL string          `json:"download_url,omitempty"`
+}
+
+func exportJobResponse(job authsqlc.ExportJob) ExportJobResponse {
+	resp := ExportJobResponse{
+		ID:          job.ID,
+		Kind:        job.Kind,
+		Format:      job.Format,
+		Params:      job.Params,
+		Status:      job.Status,
+		Error:       utils.StringPtrOrNil(job.Error),
+		RowsWritten: job.RowsWritten,
+		SizeBytes:   job.SizeBytes,
+		CreatedAt:   job.CreatedAt,
+		StartedAt:   utils.TimePtrOrNil(job.StartedAt),
+		FinishedAt:  utils.TimePtrOrNil(job.FinishedAt),
+		ExpiresAt:   job.ExpiresAt,
+	}
+	if job.Status == auth.ExportSucceeded {
+		resp.DownloadURL = fmt.Sprintf("/v1/exports/%s/download", job.ID)
+	}
+	return resp
+}
+
+// CreateExport godoc
+//
+//	@Summary		Submit an export job
+//	@Description	Queues a bulk export that runs in the background. Kinds: `fis_results` (params: sector cc|jp|nk, season_from, season_to; needs FIS read access) and `utv_data` (params: source oura|polar|garmin|suunto, group_id and/or user_ids, optional from/to days; needs UTV read access). Poll the returned Location until the status is `succeeded`, then download the file.
+//	@Tags			Exports
+//	@Accept			json
+//	@Produce		json
+//	@Param			export	body		swagger.ExportJobInput	true	"Export job"
+//	@Success		202		{object}	swagger.ExportJobEnvelope
+//	@Header			202		{string}	Location	"URL of the job"
+//	@Failure		400		{object}	swagger.ValidationErrorResponse
+//	@Failure		401		{object}	swagger.UnauthorizedResponse
+//	@Failure		403		{object}	swagger.ForbiddenResponse
+//	@Failure		500		{object}	swagger.InternalServerErrorResponse
+//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/exports [post]
+func (h *ExportsHandler) CreateExport(w http.ResponseWriter, r *http.Request) {
+	var input ExportJobInput
+	if err := utils.ReadJSON(w, r, &input); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	if err := utils.GetValidator().Struct(input); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	exp, ok := h.pool.Registry()[input.Kind]
+	if !ok {
+		utils.BadRequestResponse(w, r, fmt.Errorf("%w %q (available: %v)", errUnknownKind, input.Kind, h.pool.Registry().Kinds()))
+		return
+	}
+	if !authz.Allowed(r.Context(), http.MethodGet, exp.Path()) {
+		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
+		return
+	}
+	if _, err := exp.Prepare(input.Params); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	job, err := h.store.CreateJob(r.Context(), authsqlc.CreateExportJobParams{
+		ClientName: authn.GetClientName(r.Context()),
+		Kind:       input.Kind,
+		Format:     input.Format,
+		Params:     input.Params,
+		ChunkSize:  h.pool.ChunkSize(),
+		ExpiresAt:  h.pool.ExpiresAt(),
+	})
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+	h.pool.Notify()
+
+	w.Header().Set("Location", fmt.Sprintf("/v1/exports/%s", job.ID))
+	utils.WriteJSON(w, http.StatusAccepted, map[string]any{"export": exportJobResponse(job)})
+}
+
+// ListExports godoc
+//
+//	@Summary		List export jobs
+//	@Description	Lists the export jobs of the calling client, newest first
+//	@Tags			Exports
+//	@Produce		json
+//	@Param			limit	query		int		false	"Page size (default 100, max 1000)"
+//	@Param			cursor	query		string	false	"Cursor from the previous page"
+//	@Success		200		{object}	swagger.ExportJobListResponse
+//	@Failure		400		{object}	swagger.ValidationErrorResponse
+//	@Failure		401		{object}	swagger.UnauthorizedResponse
+//	@Failure		500		{object}	swagger.InternalServerErrorResponse
+//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/exports [get]
+func (h *ExportsHandler) ListExports(w http.ResponseWriter, r *http.Request) {
+	if err := utils.ValidateParams(r, []string{"limit", "cursor"}); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	page, err := utils.ParsePage(r, utils.DefaultPageLimits, utils.CursorTime|utils.CursorID)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	jobs, err := h.store.ListJobs(r.Context(), authn.GetClientName(r.Context()), page)
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+
+	jobs, pageInfo := utils.NextPage(jobs, page, func(j authsqlc.ExportJob) utils.Cursor {
+		return utils.TimeCursor(j.CreatedAt, j.ID)
+	})
+	utils.SetNextLink(w, r, pageInfo.NextCursor)
+
+	resp := make([]ExportJobResponse, len(jobs))
+	for i, job := range jobs {
+		resp[i] = exportJobResponse(job)
+	}
+	utils.WriteJSON(w, http.StatusOK, map[string]any{"exports": resp, "pagination": pageInfo})
+}
+
+// GetExport godoc
+//
+//	@Summary		Get an export job
+//	@Description	Returns the status and progress of an export job of the calling client
+//	@Tags			Exports
+//	@Produce		json
+//	@Param			id	path		string	true	"Export job ID (UUID)"
+//	@Success		200	{object}	swagger.ExportJobEnvelope
+//	@Failure		400	{object}	swagger.ValidationErrorResponse
+//	@Failure		401	{object}	swagger.UnauthorizedResponse
+//	@Failure		404	{object}	swagger.NotFoundResponse
+//	@Failure		500	{object}	swagger.InternalServerErrorResponse
+//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/exports/{id} [get]
+func (h *ExportsHandler) GetExport(w http.ResponseWriter, r *http.Request) {
+	job, ok := h.loadJob(w, r)
+	if !ok {
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, map[string]any{"export": exportJobResponse(job)})
+}
+
+// CancelExport godoc
+//
+//	@Summary		Cancel an export job
+//	@Description	Cancels a queued or running export job. A running export stops within a few seconds and its partial file is discarded.
+//	@Tags			Exports
+//	@Produce		json
+//	@Param			id	path		string	true	"Export job ID (UUID)"
+//	@Success		200	{object}	swagger.ExportJobEnvelope
+//	@Failure		400	{object}	swagger.ValidationErrorResponse
+//	@Failure		401	{object}	swagger.UnauthorizedResponse
+//	@Failure		404	{object}	swagger.NotFoundResponse
+//	@Failure		409	{object}	swagger.ConflictResponse
+//	@Failure		500	{object}	swagger.InternalServerErrorResponse
+//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/exports/{id}/cancel [post]
+func (h *ExportsHandler) CancelExport(w http.ResponseWriter, r *http.Request) {
+	job, ok := h.loadJob(w, r)
+	if !ok {
+		return
+	}
+
+	canceled, err := h.store.CancelJob(r.Context(), job.ID, job.ClientName, h.pool.ExpiresAt())
+	if errors.Is(err, sql.ErrNoRows) {
+		utils.ConflictResponse(w, r, errExportFinished)
+		return
+	}
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+
+	utils.WriteJSON(w, http.StatusOK, map[string]any{"export": exportJobResponse(canceled)})
+}
+
+// DownloadExport godoc
+//
+//	@Summary		Download an export file
+//	@Description	Downloads the file of a succeeded export job. Supports `Range` and `If-Range` requests, so interrupted downloads can be resumed.
+//	@Tags			Exports
+//	@Produce		text/csv,application/vnd.apache.parquet,application/x-ndjson
+//	@Param			id		path		string	true	"Export job ID (UUID)"
+//	@Param			Range	header		string	false	"Byte range, e.g. bytes=1048576-"
+//	@Success		200		{file}		file	"Export file"
+//	@Success		206		{file}		file	"Requested range of the export file"
+//	@Failure		400		{object}	swagger.ValidationErrorResponse
+//	@Failure		401		{object}	swagger.UnauthorizedResponse
+//	@Failure		403		{object}	swagger.ForbiddenResponse
+//	@Failure		404		{object}	swagger.NotFoundResponse
+//	@Failure		409		{object}	swagger.ConflictResponse
+//	@Failure		416		"Range not satisfiable"
+//	@Failure		500		{object}	swagger.InternalServerErrorResponse
+//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/exports/{id}/download [get]
+func (h *ExportsHandler) DownloadExport(w http.ResponseWriter, r *http.Request) {
+	job, ok := h.loadJob(w, r)
+	if !ok {
+		return
+	}
+
+	// the client may have lost access since submitting the job
+	if exp, ok := h.pool.Registry()[job.Kind]; !ok || !authz.Allowed(r.Context(), http.MethodGet, exp.Path()) {
+		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
+		return
+	}
+
+	if job.Status != auth.ExportSucceeded {
+		utils.ConflictResponse(w, r, fmt.Errorf("%w: status is %s", errExportNotReady, job.Status))
+		return
+	}
+	if time.Now().After(job.ExpiresAt) {
+		utils.ConflictResponse(w, r, errExportExpired)
+		return
+	}
+
+	format := utils.Format(job.Format)
+	name := fmt.Sprintf("%s_%s.%s", job.Kind, job.ID, format)
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name))
+	// the file never changes, so the job ID identifies its content
+	w.Header().Set("ETag", fmt.Sprintf("%q", job.ID.String()))
+
+	modified := job.CreatedAt
+	if job.FinishedAt.Valid {
+		modified = job.FinishedAt.Time
+	}
+	content := exports.NewChunkReader(r.Context(), h.store, job.ID, job.SizeBytes, job.ChunkSize)
+	http.ServeContent(w, r, name, modified, content)
+}
+
+// loadJob reads the job in the id path parameter, answering 400 or 404 if
+// it is invalid or does not belong to the client
+func (h *ExportsHandler) loadJob(w http.ResponseWriter, r *http.Request) (authsqlc.ExportJob, bool) {
+	id, err := utils.ParseUUID(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return authsqlc.ExportJob{}, false
+	}
+
+	job, err := h.store.GetJob(r.Context(), id, authn.GetClientName(r.Context()))
+	if errors.Is(err, sql.ErrNoRows) {
+		utils.NotFoundResponse(w, r, err)
+		return job, false
+	}
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return job, false
+	}
+	return job, true
+}
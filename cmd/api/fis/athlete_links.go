@@ -0,0 +1,337 @@
+package fisapi
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/athletelinks"
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authn"
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+// linkStatusAll lists the candidates of every status
+const linkStatusAll = "all"
+
+var (
+	errLinkCandidateNotFound = errors.New("link candidate not found")
+	errLinkCandidateReviewed = errors.New("link candidate has already been reviewed")
+	errInvalidLinkStatus     = errors.New("invalid status: must be pending, approved, rejected or all")
+	errInvalidMinConfidence  = errors.New("invalid min_confidence: must be a number between 0 and 1")
+)
+
+// linkSourcePaths are the routes of the systems the competitors are matched
+// with. A candidate ties a FIS competitor to a person of these systems, so
+// the candidates are only open to clients that can read all of them.
+var linkSourcePaths = []string{"/v1/klab", "/v1/archinisis", "/v1/tietoevry"}
+
+// authorizeLinks checks the route itself and read access to the sources
+func authorizeLinks(r *http.Request) bool {
+	if !authz.Authorize(r) {
+		return false
+	}
+	for _, path := range linkSourcePaths {
+		if !authz.Allowed(r.Context(), http.MethodGet, path) {
+			return false
+		}
+	}
+	return true
+}
+
+// Handler struct
+type AthleteLinksHandler struct {
+	store   fis.AthleteLinks
+	matcher *athletelinks.Matcher
+}
+
+func NewAthleteLinksHandler(store fis.AthleteLinks, matcher *athletelinks.Matcher) *AthleteLinksHandler {
+	return &AthleteLinksHandler{store: store, matcher: matcher}
+}
+
+// FISAthleteLinkCandidate is a proposed fiscode <-> sportti_id link
+type FISAthleteLinkCandidate struct {
+	ID         int32           `json:"id"`
+	Fiscode    int32           `json:"fiscode"`
+	Sporttiid  int32           `json:"sporttiid"`
+	Confidence string          `json:"confidence"`
+	Sources    []string        `json:"sources"`
+	Evidence   json.RawMessage `json:"evidence"`
+	Status     string          `json:"status"`
+	CreatedAt  time.Time       `json:"created_at"`
+	UpdatedAt  time.Time       `json:"updated_at"`
+	ReviewedBy *string         `json:"reviewed_by"`
+	ReviewedAt *time.Time      `json:"reviewed_at"`
+}
+
+func linkCandidateFromSqlc(c fissqlc.FisAthleteLinkCandidate) FISAthleteLinkCandidate {
+	return FISAthleteLinkCandidate{
+		ID:         c.ID,
+		Fiscode:    c.Fiscode,
+		Sporttiid:  c.Sporttiid,
+		Confidence: c.Confidence,
+		Sources:    c.Sources,
+		Evidence:   c.Evidence,
+		Status:     c.Status,
+		CreatedAt:  c.CreatedAt,
+		UpdatedAt:  c.UpdatedAt,
+		ReviewedBy: utils.StringPtrOrNil(c.ReviewedBy),
+		ReviewedAt: utils.TimePtrOrNil(c.ReviewedAt),
+	}
+}
+
+// RunAthleteLinkMatching godoc
+//
+//	@Summary		Match FIS competitors to sportti_ids
+//	@Description	Matches the Finnish FIS competitors whose fiscode is not in athlete yet with the athletes known by sportti_id to K-Lab and Archinisis whose sportti_id is not in athlete either, by name and birthdate. Tietoevry has no names, so its birthdates and genders only corroborate the other sources; databases that are not connected are skipped. Candidates with a confidence of at least 0.6 are saved as pending for review, and pending candidates the run no longer finds are dropped; reviewed candidates are left as they are. Nothing is linked until a candidate is approved. The athlete-links routes also need read access to K-Lab, Archinisis and Tietoevry.
+//	@Tags			FIS - Athlete Linking
+//	@Produce		json
+//	@Success		200	{object}	swagger.FISAthleteLinkMatchResponse
+//	@Failure		401	{object}	swagger.UnauthorizedResponse
+//	@Failure		403	{object}	swagger.ForbiddenResponse
+//	@Failure		500	{object}	swagger.InternalServerErrorResponse
+//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/fis/athlete-links/match [post]
+func (h *AthleteLinksHandler) RunAthleteLinkMatching(w http.ResponseWriter, r *http.Request) {
+	if !authorizeLinks(r) {
+		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
+		return
+	}
+
+	summary, err := h.matcher.Run(r.Context())
+	if err != nil {
+		utils.HandleDatabaseError(w, r, err)
+		return
+	}
+
+	utils.WriteJSON(w, http.StatusOK, map[string]any{"summary": summary})
+}
+
+// ListAthleteLinkCandidates godoc
+//
+//	@Summary		List athlete link candidates
+//	@Description	Lists the proposed fiscode <-> sportti_id links, best first. evidence holds how the name, birthdate and gender matched, without the identities themselves. The athlete-links routes also need read access to K-Lab, Archinisis and Tietoevry.
+//	@Tags			FIS - Athlete Linking
+//	@Produce		json
+//	@Param			status			query		string	false	"Status (default: pending)"	Enums(pending, approved, rejected, all)
+//	@Param			min_confidence	query		number	false	"Minimum confidence, 0 to 1"
+//	@Param			fiscode			query		int32	false	"FIS code"
+//	@Param			sporttiid		query		int32	false	"Sportti ID"
+//	@Param			limit			query		int		false	"Page size (default: 100, max: 1000)"
+//	@Param			cursor			query		string	false	"Opaque cursor from pagination.next_cursor of the previous page"
+//	@Success		200				{object}	swagger.FISAthleteLinkCandidateListResponse
+//	@Failure		400				{object}	swagger.ValidationErrorResponse
+//	@Failure		401				{object}	swagger.UnauthorizedResponse
+//	@Failure		403				{object}	swagger.ForbiddenResponse
+//	@Failure		500				{object}	swagger.InternalServerErrorResponse
+//	@Failure		503				{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/fis/athlete-links [get]
+func (h *AthleteLinksHandler) ListAthleteLinkCandidates(w http.ResponseWriter, r *http.Request) {
+	if !authorizeLinks(r) {
+		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
+		return
+	}
+	if err := utils.ValidateParams(r, []string{"status", "min_confidence", "fiscode", "sporttiid", "limit", "cursor"}); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	q := r.URL.Query()
+	in := fis.LinkCandidateQuery{Status: fis.LinkPending}
+	switch status := strings.ToLower(strings.TrimSpace(q.Get("status"))); status {
+	case "":
+	case linkStatusAll:
+		in.Status = ""
+	case fis.LinkPending, fis.LinkApproved, fis.LinkRejected:
+		in.Status = status
+	default:
+		utils.BadRequestResponse(w, r, errInvalidLinkStatus)
+		return
+	}
+	if v := strings.TrimSpace(q.Get("min_confidence")); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil || f < 0 || f > 1 {
+			utils.BadRequestResponse(w, r, errInvalidMinConfidence)
+			return
+		}
+		in.MinConfidence = f
+	}
+	var err error
+	if in.Fiscode, err = optionalInt32Param(r, "fiscode"); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	if in.Sporttiid, err = optionalInt32Param(r, "sporttiid"); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	page, err := utils.ParsePage(r, utils.DefaultPageLimits, utils.CursorN|utils.CursorKey)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	if page.After != nil {
+		if _, err := strconv.ParseFloat(*page.After.Key, 64); err != nil {
+			utils.BadRequestResponse(w, r, utils.ErrInvalidCursor)
+			return
+		}
+	}
+
+	rows, err := h.store.ListCandidates(r.Context(), in, page)
+	if err != nil {
+		utils.HandleDatabaseError(w, r, err)
+		return
+	}
+	rows, pageInfo := utils.NextPage(rows, page, func(row fissqlc.FisAthleteLinkCandidate) utils.Cursor {
+		c := utils.IntCursor(int64(row.ID))
+		c.Key = &row.Confidence
+		return c
+	})
+
+	candidates := make([]FISAthleteLinkCandidate, 0, len(rows))
+	for _, row := range rows {
+		candidates = append(candidates, linkCandidateFromSqlc(row))
+	}
+
+	utils.SetNextLink(w, r, pageInfo.NextCursor)
+	utils.WriteJSON(w, http.StatusOK, map[string]any{
+		"candidates": candidates,
+		"pagination": pageInfo,
+	})
+}
+
+// GetAthleteLinkCandidate godoc
+//
+//	@Summary		Get an athlete link candidate
+//	@Tags			FIS - Athlete Linking
+//	@Produce		json
+//	@Param			id	path		int32	true	"Candidate ID"
+//	@Success		200	{object}	swagger.FISAthleteLinkCandidateResponse
+//	@Failure		400	{object}	swagger.ValidationErrorResponse
+//	@Failure		401	{object}	swagger.UnauthorizedResponse
+//	@Failure		403	{object}	swagger.ForbiddenResponse
+//	@Failure		404	{object}	swagger.NotFoundResponse
+//	@Failure		500	{object}	swagger.InternalServerErrorResponse
+//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/fis/athlete-links/{id} [get]
+func (h *AthleteLinksHandler) GetAthleteLinkCandidate(w http.ResponseWriter, r *http.Request) {
+	if !authorizeLinks(r) {
+		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
+		return
+	}
+	id, err := utils.ParsePositiveInt32(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.BadRequestResponse(w, r, fmt.Errorf("invalid id: %w", err))
+		return
+	}
+
+	c, err := h.store.GetCandidate(r.Context(), id)
+	if errors.Is(err, sql.ErrNoRows) {
+		utils.NotFoundResponse(w, r, errLinkCandidateNotFound)
+		return
+	}
+	if err != nil {
+		utils.HandleDatabaseError(w, r, err)
+		return
+	}
+
+	utils.WriteJSON(w, http.StatusOK, map[string]any{"candidate": linkCandidateFromSqlc(c)})
+}
+
+// ApproveAthleteLinkCandidate godoc
+//
+//	@Summary		Approve an athlete link candidate
+//	@Description	Links the fiscode to the sportti_id by inserting it into athlete, named after the FIS competitor, and rejects the other pending candidates of the fiscode and of the sportti_id. Fails with 409 if the candidate has already been reviewed or the fiscode has been linked since.
+//	@Tags			FIS - Athlete Linking
+//	@Produce		json
+//	@Param			id	path		int32	true	"Candidate ID"
+//	@Success		200	{object}	swagger.FISAthleteLinkCandidateResponse
+//	@Failure		400	{object}	swagger.ValidationErrorResponse
+//	@Failure		401	{object}	swagger.UnauthorizedResponse
+//	@Failure		403	{object}	swagger.ForbiddenResponse
+//	@Failure		404	{object}	swagger.NotFoundResponse
+//	@Failure		409	{object}	swagger.ConflictResponse
+//	@Failure		500	{object}	swagger.InternalServerErrorResponse
+//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/fis/athlete-links/{id}/approve [post]
+func (h *AthleteLinksHandler) ApproveAthleteLinkCandidate(w http.ResponseWriter, r *http.Request) {
+	h.review(w, r, true)
+}
+
+// RejectAthleteLinkCandidate godoc
+//
+//	@Summary		Reject an athlete link candidate
+//	@Description	Rejects a pending candidate. Later matching runs leave it rejected. Fails with 409 if the candidate has already been reviewed.
+//	@Tags			FIS - Athlete Linking
+//	@Produce		json
+//	@Param			id	path		int32	true	"Candidate ID"
+//	@Success		200	{object}	swagger.FISAthleteLinkCandidateResponse
+//	@Failure		400	{object}	swagger.ValidationErrorResponse
+//	@Failure		401	{object}	swagger.UnauthorizedResponse
+//	@Failure		403	{object}	swagger.ForbiddenResponse
+//	@Failure		404	{object}	swagger.NotFoundResponse
+//	@Failure		409	{object}	swagger.ConflictResponse
+//	@Failure		500	{object}	swagger.InternalServerErrorResponse
+//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/fis/athlete-links/{id}/reject [post]
+func (h *AthleteLinksHandler) RejectAthleteLinkCandidate(w http.ResponseWriter, r *http.Request) {
+	h.review(w, r, false)
+}
+
+func (h *AthleteLinksHandler) review(w http.ResponseWriter, r *http.Request, approve bool) {
+	if !authorizeLinks(r) {
+		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
+		return
+	}
+	id, err := utils.ParsePositiveInt32(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.BadRequestResponse(w, r, fmt.Errorf("invalid id: %w", err))
+		return
+	}
+
+	c, err := h.store.ReviewCandidate(r.Context(), id, approve, authn.GetClientName(r.Context()))
+	if errors.Is(err, sql.ErrNoRows) {
+		// tell a missing candidate from one that is no longer pending
+		if _, err := h.store.GetCandidate(r.Context(), id); err == nil {
+			utils.ConflictResponse(w, r, errLinkCandidateReviewed)
+		} else if errors.Is(err, sql.ErrNoRows) {
+			utils.NotFoundResponse(w, r, errLinkCandidateNotFound)
+		} else {
+			utils.HandleDatabaseError(w, r, err)
+		}
+		return
+	}
+	if err != nil {
+		utils.HandleDatabaseError(w, r, err)
+		return
+	}
+
+	utils.WriteJSON(w, http.StatusOK, map[string]any{"candidate": linkCandidateFromSqlc(c)})
+}
+
+// optionalInt32Param reads an optional positive integer query parameter
+func optionalInt32Param(r *http.Request, key string) (*int32, error) {
+	val := strings.TrimSpace(r.URL.Query().Get(key))
+	if val == "" {
+		return nil, nil
+	}
+	n, err := utils.ParsePositiveInt32(val)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return &n, nil
+}
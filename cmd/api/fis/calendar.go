@@ -0,0 +1,347 @@
+package fisapi
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+const (
+	// defaultCalendarDays is the window of the calendar when to is not given
+	defaultCalendarDays = 90
+	// maxCalendarDays caps the window of the calendar
+	maxCalendarDays = 400
+
+	// calStatusCancelled is the calstatuscode of a cancelled race
+	calStatusCancelled = "C"
+
+	icalDate     = "20060102"
+	icalDateTime = "20060102T150405Z"
+)
+
+var errCalendarWindow = fmt.Errorf("the calendar window can be at most %d days", maxCalendarDays)
+
+// Handler struct
+type CalendarHandler struct {
+	store fis.Calendar
+}
+
+func NewCalendarHandler(store fis.Calendar) *CalendarHandler {
+	return &CalendarHandler{store: store}
+}
+
+// FISCalendarRace is a race of a calendar event
+type FISCalendarRace struct {
+	Sector         string  `json:"sector"`
+	Raceid         int32   `json:"raceid"`
+	Racedate       *string `json:"racedate"`
+	Disciplinecode *string `json:"disciplinecode"`
+	Catcode        *string `json:"catcode"`
+	Gender         *string `json:"gender"`
+	Description    *string `json:"description"`
+	Calstatuscode  *string `json:"calstatuscode"`
+}
+
+// FISCalendarEvent groups the races of an event. StartDate and EndDate are
+// the first and last race dates of the event, including its races outside
+// the window; Cancelled is set when every race in the window is cancelled.
+type FISCalendarEvent struct {
+	Eventid    *int32            `json:"eventid"`
+	Place      *string           `json:"place"`
+	Nationcode *string           `json:"nationcode"`
+	StartDate  string            `json:"start_date"`
+	EndDate    string            `json:"end_date"`
+	Sectors    []string          `json:"sectors"`
+	Catcodes   []string          `json:"catcodes"`
+	Cancelled  bool              `json:"cancelled"`
+	Races      []FISCalendarRace `json:"races"`
+
+	start, end   time.Time
+	lastModified time.Time
+}
+
+// calendarEvents groups the races by event, in the order of their first
+// race. Races without an eventid are events of their own.
+func calendarEvents(rows []fissqlc.GetCalendarRacesRow) []*FISCalendarEvent {
+	var events []*FISCalendarEvent
+	byID := map[int32]*FISCalendarEvent{}
+	for _, row := range rows {
+		ev, ok := byID[row.Eventid.Int32]
+		if !ok || !row.Eventid.Valid {
+			ev = &FISCalendarEvent{
+				Eventid:    utils.Int32PtrOrNil(row.Eventid),
+				Place:      utils.StringPtrOrNil(row.Place),
+				Nationcode: utils.StringPtrOrNil(row.Nationcode),
+				Sectors:    []string{},
+				Catcodes:   []string{},
+				Cancelled:  true,
+			}
+			events = append(events, ev)
+			if row.Eventid.Valid {
+				byID[row.Eventid.Int32] = ev
+			}
+		}
+
+		if row.EventStart.Valid && (ev.start.IsZero() || row.EventStart.Time.Before(ev.start)) {
+			ev.start = row.EventStart.Time
+		}
+		if row.EventEnd.Valid && row.EventEnd.Time.After(ev.end) {
+			ev.end = row.EventEnd.Time
+		}
+		if row.Lastupdate.Valid && row.Lastupdate.Time.After(ev.lastModified) {
+			ev.lastModified = row.Lastupdate.Time
+		}
+		if !slices.Contains(ev.Sectors, row.Sector) {
+			ev.Sectors = append(ev.Sectors, row.Sector)
+		}
+		if row.Catcode.Valid && row.Catcode.String != "" && !slices.Contains(ev.Catcodes, row.Catcode.String) {
+			ev.Catcodes = append(ev.Catcodes, row.Catcode.String)
+		}
+		if strings.TrimSpace(row.Calstatuscode.String) != calStatusCancelled {
+			ev.Cancelled = false
+		}
+
+		ev.Races = append(ev.Races, FISCalendarRace{
+			Sector:         row.Sector,
+			Raceid:         row.Raceid,
+			Racedate:       utils.FormatDatePtr(row.Racedate),
+			Disciplinecode: utils.StringPtrOrNil(row.Disciplinecode),
+			Catcode:        utils.StringPtrOrNil(row.Catcode),
+			Gender:         utils.StringPtrOrNil(row.Gender),
+			Description:    utils.StringPtrOrNil(row.Description),
+			Calstatuscode:  utils.StringPtrOrNil(row.Calstatuscode),
+		})
+	}
+
+	for _, ev := range events {
+		ev.StartDate = ev.start.Format(time.DateOnly)
+		ev.EndDate = ev.end.Format(time.DateOnly)
+	}
+	return events
+}
+
+// wantsICalendar tells whether the calendar is requested as iCalendar,
+// with format=ics or an Accept header of text/calendar
+func wantsICalendar(r *http.Request) bool {
+	if f := r.URL.Query().Get("format"); f != "" {
+		return strings.EqualFold(f, "ics")
+	}
+	return strings.Contains(r.Header.Get("Accept"), "text/calendar")
+}
+
+// icalEscape escapes a TEXT value (RFC 5545 3.3.11)
+func icalEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
+
+// icalLine writes a content line folded at 75 octets (RFC 5545 3.1),
+// without splitting UTF-8 sequences
+func icalLine(b *strings.Builder, name, value string) {
+	line := name + ":" + value
+	limit := 75
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		limit = 74
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
+
+func strOr(s *string, def string) string {
+	if s == nil || *s == "" {
+		return def
+	}
+	return *s
+}
+
+// eventUID is the stable UID of an event in the feed
+func eventUID(ev *FISCalendarEvent) string {
+	if ev.Eventid != nil {
+		return fmt.Sprintf("fis-event-%d@kuha", *ev.Eventid)
+	}
+	return fmt.Sprintf("fis-race-%s-%d@kuha", ev.Races[0].Sector, ev.Races[0].Raceid)
+}
+
+// iCalendar renders the events as all-day VEVENTs
+func iCalendar(events []*FISCalendarEvent, now time.Time) string {
+	var b strings.Builder
+	icalLine(&b, "BEGIN", "VCALENDAR")
+	icalLine(&b, "VERSION", "2.0")
+	icalLine(&b, "PRODID", "-//KUHA//FIS calendar//EN")
+	icalLine(&b, "CALSCALE", "GREGORIAN")
+	icalLine(&b, "METHOD", "PUBLISH")
+	icalLine(&b, "X-WR-CALNAME", "FIS calendar")
+
+	for _, ev := range events {
+		if ev.start.IsZero() {
+			continue
+		}
+		place := strOr(ev.Place, "FIS event")
+		summary := place
+		if ev.Nationcode != nil {
+			summary += " (" + *ev.Nationcode + ")"
+		}
+		if len(ev.Catcodes) > 0 {
+			summary += " " + strings.Join(ev.Catcodes, "/")
+		}
+		summary += " " + strings.ToUpper(strings.Join(ev.Sectors, "/"))
+
+		var desc []string
+		for _, race := range ev.Races {
+			parts := []string{strOr(race.Racedate, "-"), strings.ToUpper(race.Sector)}
+			for _, p := range []*string{race.Catcode, race.Gender, race.Disciplinecode, race.Description} {
+				if p != nil && *p != "" {
+					parts = append(parts, *p)
+				}
+			}
+			if strings.TrimSpace(strOr(race.Calstatuscode, "")) == calStatusCancelled {
+				parts = append(parts, "(cancelled)")
+			}
+			desc = append(desc, strings.Join(parts, " "))
+		}
+
+		icalLine(&b, "BEGIN", "VEVENT")
+		icalLine(&b, "UID", eventUID(ev))
+		icalLine(&b, "DTSTAMP", now.UTC().Format(icalDateTime))
+		icalLine(&b, "DTSTART;VALUE=DATE", ev.start.Format(icalDate))
+		icalLine(&b, "DTEND;VALUE=DATE", ev.end.AddDate(0, 0, 1).Format(icalDate))
+		icalLine(&b, "SUMMARY", icalEscape(summary))
+		location := place
+		if ev.Nationcode != nil {
+			location += ", " + *ev.Nationcode
+		}
+		icalLine(&b, "LOCATION", icalEscape(location))
+		icalLine(&b, "DESCRIPTION", icalEscape(strings.Join(desc, "\n")))
+		if ev.Cancelled {
+			icalLine(&b, "STATUS", "CANCELLED")
+		} else {
+			icalLine(&b, "STATUS", "CONFIRMED")
+		}
+		if !ev.lastModified.IsZero() {
+			icalLine(&b, "LAST-MODIFIED", ev.lastModified.UTC().Format(icalDateTime))
+		}
+		icalLine(&b, "TRANSP", "TRANSPARENT")
+		icalLine(&b, "END", "VEVENT")
+	}
+
+	icalLine(&b, "END", "VCALENDAR")
+	return b.String()
+}
+
+// upperList reads a list parameter and upper-cases its values
+func upperList(r *http.Request, key string) []string {
+	vals := parseListParam(r, key)
+	for i, v := range vals {
+		vals[i] = strings.ToUpper(v)
+	}
+	return vals
+}
+
+// GetCalendar godoc
+//
+//	@Summary		Get the FIS competition calendar
+//	@Description	Returns the races of every sector dated from..to grouped into events by eventid, in the order of their first race. Races without an eventid are events of their own. A race is cancelled when its calstatuscode is C, an event when all its races are.
+//	@Description	With format=ics or an Accept header of text/calendar the calendar is returned as iCalendar, one all-day event per FIS event. Calendar applications that cannot send a bearer token subscribe with format=ics and the token of a calendar feed.
+//	@Tags			FIS - Calendar
+//	@Accept			json
+//	@Produce		json
+//	@Produce		text/calendar
+//	@Param			from		query		string		false	"First race date (YYYY-MM-DD, default: today)"
+//	@Param			to			query		string		false	"Last race date (YYYY-MM-DD, default: 90 days after from, at most 400 days after it)"
+//	@Param			sector		query		[]string	false	"Sector code: cc, jp, nk (repeat or comma-separated)"
+//	@Param			nationcode	query		[]string	false	"Nation code of the venue (repeat or comma-separated)"
+//	@Param			catcode		query		[]string	false	"Category code (repeat or comma-separated)"
+//	@Param			gender		query		[]string	false	"Gender (repeat or comma-separated)"
+//	@Param			format		query		string		false	"Response format"	Enums(json, ics)
+//	@Param			token		query		string		false	"Calendar feed token, instead of the bearer token (format=ics only)"
+//	@Success		200			{object}	swagger.FISCalendarResponse
+//	@Failure		400			{object}	swagger.ValidationErrorResponse
+//	@Failure		401			{object}	swagger.UnauthorizedResponse
+//	@Failure		403			{object}	swagger.ForbiddenResponse
+//	@Failure		500			{object}	swagger.InternalServerErrorResponse
+//	@Failure		503			{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/fis/calendar [get]
+func (h *CalendarHandler) GetCalendar(w http.ResponseWriter, r *http.Request) {
+	if !authz.Authorize(r) {
+		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
+		return
+	}
+	if err := utils.ValidateParams(r, []string{"from", "to", "sector", "nationcode", "catcode", "gender", "format", "token"}); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	if f := r.URL.Query().Get("format"); f != "" && !strings.EqualFold(f, "json") && !strings.EqualFold(f, "ics") {
+		utils.BadRequestResponse(w, r, utils.ErrInvalidChoice)
+		return
+	}
+
+	var q fis.CalendarQuery
+	var err error
+	if q.From, err = dateParam(r, "from", today()); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	if q.To, err = dateParam(r, "to", q.From.AddDate(0, 0, defaultCalendarDays)); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	if q.From.After(q.To) {
+		utils.BadRequestResponse(w, r, utils.ErrInvalidDateRange)
+		return
+	}
+	if q.From.AddDate(0, 0, maxCalendarDays).Before(q.To) {
+		utils.BadRequestResponse(w, r, errCalendarWindow)
+		return
+	}
+
+	for _, code := range parseListParam(r, "sector") {
+		code = strings.ToLower(code)
+		if !slices.Contains(sectorCodes, code) {
+			utils.BadRequestResponse(w, r, utils.ErrInvalidSectorCode)
+			return
+		}
+		q.Sectors = append(q.Sectors, code)
+	}
+	q.Nations = upperList(r, "nationcode")
+	q.Catcodes = upperList(r, "catcode")
+	q.Genders = upperList(r, "gender")
+
+	rows, err := h.store.GetCalendarRaces(r.Context(), q)
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+	events := calendarEvents(rows)
+
+	if wantsICalendar(r) {
+		w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+		w.Header().Set("Content-Disposition", `inline; filename="fis-calendar.ics"`)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(iCalendar(events, time.Now())))
+		return
+	}
+
+	if events == nil {
+		events = []*FISCalendarEvent{}
+	}
+	utils.WriteJSON(w, http.StatusOK, map[string]any{
+		"from":   q.From.Format(time.DateOnly),
+		"to":     q.To.Format(time.DateOnly),
+		"count":  len(events),
+		"events": events,
+	})
+}
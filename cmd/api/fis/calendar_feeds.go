@@ -0,0 +1,157 @@
+package fisapi
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authn"
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	authsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/auth"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/auth"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+// CalendarPath is the only route a calendar feed token can read, and only
+// with format=ics
+const CalendarPath = "/v1/fis/calendar"
+
+// Handler struct
+type CalendarFeedsHandler struct {
+	store auth.CalendarFeeds
+}
+
+func NewCalendarFeedsHandler(store auth.CalendarFeeds) *CalendarFeedsHandler {
+	return &CalendarFeedsHandler{store: store}
+}
+
+// FISCalendarFeed is a calendar feed of a client. The token and the URL
+// that carries it are only returned when the feed is created.
+type FISCalendarFeed struct {
+	ID         uuid.UUID  `json:"id"`
+	Token      string     `json:"token,omitempty"`
+	URL        string     `json:"url,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+}
+
+func calendarFeedResponse(feed authsqlc.CalendarFeed) FISCalendarFeed {
+	return FISCalendarFeed{
+		ID:         feed.ID,
+		CreatedAt:  feed.CreatedAt,
+		LastUsedAt: utils.TimePtrOrNil(feed.LastUsedAt),
+	}
+}
+
+// canReadCalendar tells whether the client may read the calendar, which
+// is what a feed of its own gives access to
+func canReadCalendar(r *http.Request) bool {
+	return authz.Allowed(r.Context(), http.MethodGet, CalendarPath)
+}
+
+// CreateCalendarFeed godoc
+//
+//	@Summary		Create a calendar feed
+//	@Description	Creates a read-only feed token for calendar applications that cannot send a bearer token. The token only reads GET /fis/calendar with format=ics, passed as the token query parameter, with the roles the client has when the feed is read. It is only returned in this response; a feed is revoked by deleting it.
+//	@Tags			FIS - Calendar
+//	@Produce		json
+//	@Success		201	{object}	swagger.FISCalendarFeedEnvelope
+//	@Header			201	{string}	Location	"URL of the feed"
+//	@Failure		401	{object}	swagger.UnauthorizedResponse
+//	@Failure		403	{object}	swagger.ForbiddenResponse
+//	@Failure		500	{object}	swagger.InternalServerErrorResponse
+//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/fis/calendar/feeds [post]
+func (h *CalendarFeedsHandler) CreateCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	if !canReadCalendar(r) {
+		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
+		return
+	}
+
+	feed, token, err := h.store.CreateFeed(r.Context(), authn.GetClientName(r.Context()))
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+
+	resp := calendarFeedResponse(feed)
+	resp.Token = token
+	resp.URL = CalendarPath + "?" + url.Values{"format": {"ics"}, "token": {token}}.Encode()
+	w.Header().Set("Location", fmt.Sprintf("/v1/fis/calendar/feeds/%s", feed.ID))
+	utils.WriteJSON(w, http.StatusCreated, map[string]any{"feed": resp})
+}
+
+// ListCalendarFeeds godoc
+//
+//	@Summary		List calendar feeds
+//	@Description	Lists the calendar feeds of the calling client, newest first, without their tokens
+//	@Tags			FIS - Calendar
+//	@Produce		json
+//	@Success		200	{object}	swagger.FISCalendarFeedListResponse
+//	@Failure		401	{object}	swagger.UnauthorizedResponse
+//	@Failure		403	{object}	swagger.ForbiddenResponse
+//	@Failure		500	{object}	swagger.InternalServerErrorResponse
+//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/fis/calendar/feeds [get]
+func (h *CalendarFeedsHandler) ListCalendarFeeds(w http.ResponseWriter, r *http.Request) {
+	if !canReadCalendar(r) {
+		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
+		return
+	}
+	feeds, err := h.store.ListFeeds(r.Context(), authn.GetClientName(r.Context()))
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+
+	resp := make([]FISCalendarFeed, len(feeds))
+	for i, feed := range feeds {
+		resp[i] = calendarFeedResponse(feed)
+	}
+	utils.WriteJSON(w, http.StatusOK, map[string]any{"feeds": resp})
+}
+
+// DeleteCalendarFeed godoc
+//
+//	@Summary		Revoke a calendar feed
+//	@Description	Deletes a calendar feed of the calling client; its token stops working at once
+//	@Tags			FIS - Calendar
+//	@Param			id	path	string	true	"Feed ID (UUID)"
+//	@Success		200	"Feed revoked"
+//	@Failure		400	{object}	swagger.ValidationErrorResponse
+//	@Failure		401	{object}	swagger.UnauthorizedResponse
+//	@Failure		403	{object}	swagger.ForbiddenResponse
+//	@Failure		404	{object}	swagger.NotFoundResponse
+//	@Failure		500	{object}	swagger.InternalServerErrorResponse
+//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/fis/calendar/feeds/{id} [delete]
+func (h *CalendarFeedsHandler) DeleteCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	if !canReadCalendar(r) {
+		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
+		return
+	}
+
+	id, err := utils.ParseUUID(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	n, err := h.store.DeleteFeed(r.Context(), id, authn.GetClientName(r.Context()))
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+	if n == 0 {
+		utils.NotFoundResponse(w, r, fmt.Errorf("calendar feed not found"))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
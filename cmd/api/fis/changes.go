@@ -0,0 +1,153 @@
+package fisapi
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+var errMissingSince = fmt.Errorf("since is required")
+
+// Handler struct
+type ChangesHandler struct {
+	store fis.Changes
+}
+
+func NewChangesHandler(store fis.Changes) *ChangesHandler {
+	return &ChangesHandler{store: store}
+}
+
+// FISChangeResponse is a row written or deleted since the watermark. data
+// holds the row as returned by the other FIS routes and is left out for
+// deletions.
+type FISChangeResponse struct {
+	Op        string `json:"op"`
+	ID        int32  `json:"id"`
+	ChangedAt string `json:"changed_at"`
+	Data      any    `json:"data,omitempty"`
+}
+
+// GetChanges godoc
+//
+//	@Summary		List FIS changes since a watermark
+//	@Description	Lists the rows of a FIS table written (`op` upsert) or deleted (`op` delete) at or after `since`, ordered by the time the database recorded the change and by key. Page through the changes with the cursor; on the last page, `watermark` is the `since` to use next time. The watermark is held back to the start of the oldest transaction in progress, whose changes are dated when it started, so rows may be listed again. Rows are listed again if they change again.
+//	@Tags			FIS - Changes
+//	@Produce		json
+//	@Param			entity	query		string	true	"Table"	Enums(competitor, racecc, racejp, racenk, resultcc, resultjp, resultnk)
+//	@Param			since	query		string	true	"Watermark (RFC3339)"
+//	@Param			limit	query		int		false	"Page size (default: 100, max: 1000)"
+//	@Param			cursor	query		string	false	"Opaque cursor from pagination.next_cursor of the previous page"
+//	@Success		200		{object}	swagger.FISChangesResponse
+//	@Failure		400		{object}	swagger.ValidationErrorResponse
+//	@Failure		401		{object}	swagger.UnauthorizedResponse
+//	@Failure		403		{object}	swagger.ForbiddenResponse
+//	@Failure		500		{object}	swagger.InternalServerErrorResponse
+//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/fis/changes [get]
+func (h *ChangesHandler) GetChanges(w http.ResponseWriter, r *http.Request) {
+	if !authz.Authorize(r) {
+		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
+		return
+	}
+
+	if err := utils.ValidateParams(r, []string{"entity", "since", "limit", "cursor"}); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	entity := r.URL.Query().Get("entity")
+	if !slices.Contains(fis.ChangeEntities, entity) {
+		utils.BadRequestResponse(w, r, fmt.Errorf("%w: entity must be one of %s", utils.ErrInvalidChoice, strings.Join(fis.ChangeEntities, ", ")))
+		return
+	}
+
+	sinceStr := r.URL.Query().Get("since")
+	if sinceStr == "" {
+		utils.BadRequestResponse(w, r, errMissingSince)
+		return
+	}
+	since, err := utils.ParseTimestamp(sinceStr)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	page, err := utils.ParsePage(r, utils.DefaultPageLimits, utils.CursorTime|utils.CursorN|utils.CursorKey)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	// read before the changes, so that every transaction committing later
+	// started after it
+	horizon, err := h.store.Horizon(r.Context())
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+
+	changes, err := h.store.ListChanges(r.Context(), entity, since, page)
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+	changes, pageInfo := utils.NextPage(changes, page, func(c fis.Change) utils.Cursor {
+		n := int64(c.ID)
+		return utils.Cursor{Time: &c.ChangedAt, N: &n, Key: &c.Op}
+	})
+
+	out := make([]FISChangeResponse, len(changes))
+	for i, c := range changes {
+		out[i] = FISChangeResponse{
+			Op:        c.Op,
+			ID:        c.ID,
+			ChangedAt: c.ChangedAt.Format(time.RFC3339Nano),
+			Data:      changeData(c.Row),
+		}
+	}
+
+	body := map[string]any{"entity": entity, "changes": out, "pagination": pageInfo}
+	if pageInfo.NextCursor == "" {
+		watermark := since
+		if len(changes) > 0 {
+			watermark = changes[len(changes)-1].ChangedAt
+		}
+		// changes still being written may be dated before the last one listed
+		if horizon.Before(watermark) {
+			watermark = horizon
+		}
+		body["watermark"] = watermark.Format(time.RFC3339Nano)
+	}
+
+	utils.SetNextLink(w, r, pageInfo.NextCursor)
+	utils.WriteJSON(w, http.StatusOK, body)
+}
+
+// changeData converts the row of a change like the other FIS routes do
+func changeData(row any) any {
+	switch row := row.(type) {
+	case fissqlc.ACompetitor:
+		return FISCompetitorFullFromSqlc(row)
+	case fissqlc.ARacecc:
+		return FISRaceCCFullFromSqlc(row)
+	case fissqlc.ARacejp:
+		return FISRaceJPFullFromSqlc(row)
+	case fissqlc.ARacenk:
+		return FISRaceNKFullFromSqlc(row)
+	case fissqlc.AResultcc:
+		return FISResultCCFullFromSqlc(row)
+	case fissqlc.AResultjp:
+		return FISResultJPFullFromSqlc(row)
+	case fissqlc.AResultnk:
+		return FISResultNKFullFromSqlc(row)
+	}
+	return nil
+}
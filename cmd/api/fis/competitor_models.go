@@ -313,6 +313,13 @@ type FISCompetitorResponse struct {
 	Classcode          *string `json:"classcode"`
 }
 
+// FISCompetitorSearchResult is a competitor matched by a search. Relevance
+// is the similarity of the best matching field to q, 0 without q.
+type FISCompetitorSearchResult struct {
+	FISCompetitorResponse
+	Relevance string `json:"relevance"`
+}
+
 func FISCompetitorFullFromSqlc(row fissqlc.ACompetitor) FISCompetitorResponse {
 	var (
 		birthStr  *string
@@ -6,10 +6,13 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/fis"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
@@ -344,6 +347,8 @@ func (h *CompetitorHandler) DeleteCompetitor(w http.ResponseWriter, r *http.Requ
 //	@Summary		Search competitors
 //	@Description	Gets competitors filtered by optional Nationcode, Sectorcode, Gender and age range (in years).
 //	@Description	Age filters (agemin/agemax) are converted internally to a birthdate range based on today's date.
+//	@Description	q matches the names and ski clubs of the competitors ignoring accents and tolerating typos ("Maki" finds "Mäki"); the matches are ordered by relevance, best first. fiscode matches the FIS codes starting with the given digits.
+//	@Description	Results are paginated: a page holds at most 100 competitors unless limit is given (max 1000); follow pagination.next_cursor for the rest.
 //	@Tags			FIS - KAMK
 //	@Accept			json
 //	@Produce		json
@@ -352,6 +357,10 @@ func (h *CompetitorHandler) DeleteCompetitor(w http.ResponseWriter, r *http.Requ
 //	@Param			gender		query		string	false	"Gender filter (M/W)"
 //	@Param			agemin		query		int		false	"Minimum age in years (inclusive). For example, agemin=18 means competitors who are at least 18."
 //	@Param			agemax		query		int		false	"Maximum age in years (inclusive). For example, agemax=30 means competitors who are at most 30."
+//	@Param			q			query		string	false	"Name or ski club, at least 2 characters"
+//	@Param			fiscode		query		string	false	"FIS code prefix (digits)"
+//	@Param			limit		query		int		false	"Page size (default: 100, max: 1000)"
+//	@Param			cursor		query		string	false	"Opaque cursor from pagination.next_cursor of the previous page"
 //	@Success		200			{object}	swagger.FISCompetitorSearchResponse
 //	@Failure		400			{object}	swagger.ValidationErrorResponse
 //	@Failure		401			{object}	swagger.UnauthorizedResponse
@@ -372,6 +381,10 @@ func (h *CompetitorHandler) SearchCompetitors(w http.ResponseWriter, r *http.Req
 		"gender",
 		"agemin",
 		"agemax",
+		"q",
+		"fiscode",
+		"limit",
+		"cursor",
 	}); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -436,6 +449,29 @@ func (h *CompetitorHandler) SearchCompetitors(w http.ResponseWriter, r *http.Req
 		}
 	}
 
+	query := strings.TrimSpace(q.Get("q"))
+	if query != "" && utf8.RuneCountInString(query) < 2 {
+		utils.BadRequestResponse(w, r, fmt.Errorf("q must be at least 2 characters"))
+		return
+	}
+	fiscodePrefix := strings.TrimSpace(q.Get("fiscode"))
+	if !isDigits(fiscodePrefix) {
+		utils.BadRequestResponse(w, r, fmt.Errorf("invalid fiscode: %s", fiscodePrefix))
+		return
+	}
+
+	page, err := utils.ParsePage(r, utils.DefaultPageLimits, utils.CursorN|utils.CursorKey)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	if page.After != nil {
+		if _, err := strconv.ParseFloat(*page.After.Key, 64); err != nil {
+			utils.BadRequestResponse(w, r, utils.ErrInvalidCursor)
+			return
+		}
+	}
+
 	rows, err := h.store.SearchCompetitors(
 		r.Context(),
 		nationPtr,
@@ -443,24 +479,47 @@ func (h *CompetitorHandler) SearchCompetitors(w http.ResponseWriter, r *http.Req
 		genderPtr,
 		birthMinPtr,
 		birthMaxPtr,
+		query,
+		fiscodePrefix,
+		page,
 	)
 	if err != nil {
 		utils.InternalServerError(w, r, err)
 		return
 	}
+	rows, pageInfo := utils.NextPage(rows, page, func(row fissqlc.SearchCompetitorsRow) utils.Cursor {
+		c := utils.IntCursor(int64(row.ACompetitor.Competitorid))
+		c.Key = &row.Relevance
+		return c
+	})
 
-	competitors := make([]any, 0, len(rows))
-	for _, c := range rows {
-		competitors = append(competitors, FISCompetitorFullFromSqlc(c))
+	competitors := make([]FISCompetitorSearchResult, 0, len(rows))
+	for _, row := range rows {
+		competitors = append(competitors, FISCompetitorSearchResult{
+			FISCompetitorResponse: FISCompetitorFullFromSqlc(row.ACompetitor),
+			Relevance:             row.Relevance,
+		})
 	}
 
 	body := map[string]any{
 		"competitors": competitors,
+		"pagination":  pageInfo,
 	}
 
+	utils.SetNextLink(w, r, pageInfo.NextCursor)
 	utils.WriteJSON(w, http.StatusOK, body)
 }
 
+// isDigits reports whether s holds only ASCII digits; the empty string does
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
 // used only in this handler's response
 type competitorNationCountItem struct {
 	Nationcode  string `json:"nationcode" example:"FIN"`
@@ -0,0 +1,402 @@
+package fisapi
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+// FISEnrichedResult is a race result with the competitor data and the
+// FIS time and points strings parsed. Time ranked sectors fill the time
+// fields, Ski Jumping the points ones; BehindMs and BehindPoints are the
+// gaps to the winner.
+type FISEnrichedResult struct {
+	Recid          int32   `json:"recid"`
+	Fiscode        *int32  `json:"fiscode"`
+	Competitorname *string `json:"competitorname"`
+	Nationcode     *string `json:"nationcode"`
+	Birthdate      *string `json:"birthdate"`
+	Skiclub        *string `json:"skiclub"`
+	Status         *string `json:"status"`
+	Position       *int32  `json:"position"`
+	NationRank     *int32  `json:"nation_rank"`
+	Bib            *int32  `json:"bib"`
+	Racepoints     *string `json:"racepoints"`
+	Cuppoints      *string `json:"cuppoints"`
+
+	Time         *string  `json:"time,omitempty"`
+	TimeMs       *int64   `json:"time_ms,omitempty"`
+	BehindMs     *int64   `json:"behind_ms,omitempty"`
+	Points       *float64 `json:"points,omitempty"`
+	BehindPoints *float64 `json:"behind_points,omitempty"`
+
+	// Cross-Country
+	RunTimes    []FISRunTime `json:"run_times,omitempty"`
+	BonusTimeMs *int64       `json:"bonus_time_ms,omitempty"`
+
+	// Ski Jumping and the jumping part of Nordic combined
+	Rounds []FISJumpRound `json:"rounds,omitempty"`
+
+	// Nordic combined
+	JumpPoints   *float64 `json:"jump_points,omitempty"`
+	JumpPosition *int32   `json:"jump_position,omitempty"`
+	CCTime       *string  `json:"cc_time,omitempty"`
+	CCTimeMs     *int64   `json:"cc_time_ms,omitempty"`
+	CCPosition   *int32   `json:"cc_position,omitempty"`
+}
+
+// FISRunTime is a timer of a Cross-Country result (timer1..timer3)
+type FISRunTime struct {
+	Run    int    `json:"run"`
+	Time   string `json:"time"`
+	TimeMs int64  `json:"time_ms"`
+}
+
+// FISJumpRound is a round of a jumping result. JudgeMarks holds the marks
+// of the five judges; JudgePoints is the sum of the three middle ones when
+// all five are given, the reported judge points otherwise.
+type FISJumpRound struct {
+	Round          int        `json:"round"`
+	Status         *string    `json:"status"`
+	Position       *int32     `json:"position"`
+	Speed          *float64   `json:"speed"`
+	Distance       *float64   `json:"distance"`
+	DistancePoints *float64   `json:"distance_points"`
+	JudgeMarks     []*float64 `json:"judge_marks"`
+	JudgePoints    *float64   `json:"judge_points"`
+	Gate           *int32     `json:"gate"`
+	GatePoints     *float64   `json:"gate_points"`
+	Wind           *float64   `json:"wind"`
+	WindPoints     *float64   `json:"wind_points"`
+	Total          *float64   `json:"total"`
+}
+
+// jumpRoundCols are the columns of one round of a JP or NK result
+type jumpRoundCols struct {
+	status, position, speed, dist, distPts sql.NullString
+	judges                                 [5]sql.NullString
+	judgePts, gate, gatePts, wind, windPts sql.NullString
+	total                                  sql.NullString
+}
+
+func floatPtr(s sql.NullString) *float64 {
+	if !s.Valid {
+		return nil
+	}
+	f, err := strconv.ParseFloat(strings.TrimSpace(s.String), 64)
+	if err != nil {
+		return nil
+	}
+	return &f
+}
+
+// intPtr parses integers stored as text or numeric, such as "12" or
+// "12.00000"
+func intPtr(s sql.NullString) *int32 {
+	f := floatPtr(s)
+	if f == nil || *f != math.Trunc(*f) {
+		return nil
+	}
+	n := int32(*f)
+	return &n
+}
+
+func timeMsPtr(s sql.NullString) *int64 {
+	if !s.Valid {
+		return nil
+	}
+	ms, ok := parseRaceTime(s.String)
+	if !ok {
+		return nil
+	}
+	return &ms
+}
+
+// judgePoints sums the marks without the highest and the lowest one
+func judgePoints(marks []*float64) (float64, bool) {
+	vals := make([]float64, 0, len(marks))
+	for _, m := range marks {
+		if m == nil {
+			return 0, false
+		}
+		vals = append(vals, *m)
+	}
+	slices.Sort(vals)
+	var sum float64
+	for _, v := range vals[1 : len(vals)-1] {
+		sum += v
+	}
+	return math.Round(sum*10) / 10, true
+}
+
+// jumpRound converts the columns of a round; false when the round was not
+// jumped
+func jumpRound(n int, c jumpRoundCols) (FISJumpRound, bool) {
+	round := FISJumpRound{
+		Round:          n,
+		Status:         utils.StringPtrOrNil(c.status),
+		Position:       intPtr(c.position),
+		Speed:          floatPtr(c.speed),
+		Distance:       floatPtr(c.dist),
+		DistancePoints: floatPtr(c.distPts),
+		JudgeMarks:     make([]*float64, len(c.judges)),
+		JudgePoints:    floatPtr(c.judgePts),
+		Gate:           intPtr(c.gate),
+		GatePoints:     floatPtr(c.gatePts),
+		Wind:           floatPtr(c.wind),
+		WindPoints:     floatPtr(c.windPts),
+		Total:          floatPtr(c.total),
+	}
+	for i, j := range c.judges {
+		round.JudgeMarks[i] = floatPtr(j)
+	}
+	if p, ok := judgePoints(round.JudgeMarks); ok {
+		round.JudgePoints = &p
+	}
+	jumped := round.Distance != nil || round.Total != nil || round.JudgePoints != nil
+	return round, jumped
+}
+
+func jumpRounds(cols ...jumpRoundCols) []FISJumpRound {
+	var rounds []FISJumpRound
+	for i, c := range cols {
+		if round, ok := jumpRound(i+1, c); ok {
+			rounds = append(rounds, round)
+		}
+	}
+	return rounds
+}
+
+// competitorNation prefers the nation of the result to the current one of
+// the competitor
+func competitorNation(result, competitor sql.NullString) *string {
+	if result.Valid && strings.TrimSpace(result.String) != "" {
+		return &result.String
+	}
+	return utils.StringPtrOrNil(competitor)
+}
+
+func enrichedResultFromCC(row fissqlc.GetEnrichedRaceResultsCCRow) FISEnrichedResult {
+	res := row.AResultcc
+	out := FISEnrichedResult{
+		Recid:          res.Recid,
+		Fiscode:        utils.Int32PtrOrNil(res.Fiscode),
+		Competitorname: utils.StringPtrOrNil(res.Competitorname),
+		Nationcode:     competitorNation(res.Nationcode, row.CompetitorNationcode),
+		Birthdate:      utils.FormatDatePtr(row.Birthdate),
+		Skiclub:        utils.StringPtrOrNil(row.Skiclub),
+		Status:         utils.StringPtrOrNil(res.Status),
+		Position:       intPtr(res.Position),
+		Bib:            intPtr(res.Bib),
+		Racepoints:     utils.StringPtrOrNil(res.Racepoints),
+		Cuppoints:      utils.StringPtrOrNil(res.Cuppoints),
+		Time:           utils.StringPtrOrNil(res.Timetot),
+		TimeMs:         timeMsPtr(res.Timetot),
+		BonusTimeMs:    timeMsPtr(res.Bonustime),
+	}
+	for i, t := range []sql.NullString{res.Timer1, res.Timer2, res.Timer3} {
+		if ms := timeMsPtr(t); ms != nil {
+			out.RunTimes = append(out.RunTimes, FISRunTime{Run: i + 1, Time: t.String, TimeMs: *ms})
+		}
+	}
+	return out
+}
+
+func enrichedResultFromJP(row fissqlc.GetEnrichedRaceResultsJPRow) FISEnrichedResult {
+	res := row.AResultjp
+	return FISEnrichedResult{
+		Recid:          res.Recid,
+		Fiscode:        utils.Int32PtrOrNil(res.Fiscode),
+		Competitorname: utils.StringPtrOrNil(res.Competitorname),
+		Nationcode:     competitorNation(res.Nationcode, row.CompetitorNationcode),
+		Birthdate:      utils.FormatDatePtr(row.Birthdate),
+		Skiclub:        utils.StringPtrOrNil(row.Skiclub),
+		Status:         utils.StringPtrOrNil(res.Status),
+		Position:       utils.Int32PtrOrNil(res.Position),
+		Bib:            utils.Int32PtrOrNil(res.Bib),
+		Racepoints:     utils.StringPtrOrNil(res.Racepoints),
+		Cuppoints:      utils.StringPtrOrNil(res.Cuppoints),
+		Points:         floatPtr(res.Tot),
+		Rounds: jumpRounds(
+			jumpRoundCols{
+				res.Statusr1, res.Posr1, res.Speedr1, res.Distr1, res.Disptsr1,
+				[5]sql.NullString{res.J1r1, res.J2r1, res.J3r1, res.J4r1, res.J5r1},
+				res.Judptsr1, res.Gater1, res.Gateptsr1, res.Windr1, res.Windptsr1, res.Totrun1,
+			},
+			jumpRoundCols{
+				res.Statusr2, res.Posr2, res.Speedr2, res.Distr2, res.Disptsr2,
+				[5]sql.NullString{res.J1r2, res.J2r2, res.J3r2, res.J4r2, res.J5r2},
+				res.Judptsr2, res.Gater2, res.Gateptsr2, res.Windr2, res.Windptsr2, res.Totrun2,
+			},
+			jumpRoundCols{
+				res.Statusr3, res.Posr3, res.Speedr3, res.Distr3, res.Disptsr3,
+				[5]sql.NullString{res.J1r3, res.J2r3, res.J3r3, res.J4r3, res.J5r3},
+				res.Judptsr3, res.Gater3, res.Gateptsr3, res.Windr3, res.Windptsr3, res.Totrun3,
+			},
+			jumpRoundCols{
+				res.Statusr4, res.Posr4, res.Speedr4, res.Distr4, res.Disptsr4,
+				[5]sql.NullString{res.J1r4, res.J2r4, res.J3r4, res.J4r4, res.J5r4},
+				res.Judptsr4, res.Gater4, res.Gateptsr4, res.Windr4, res.Windptsr4, res.Totrun4,
+			},
+		),
+	}
+}
+
+func enrichedResultFromNK(row fissqlc.GetEnrichedRaceResultsNKRow) FISEnrichedResult {
+	res := row.AResultnk
+	return FISEnrichedResult{
+		Recid:          res.Recid,
+		Fiscode:        utils.Int32PtrOrNil(res.Fiscode),
+		Competitorname: utils.StringPtrOrNil(res.Competitorname),
+		Nationcode:     competitorNation(res.Nationcode, row.CompetitorNationcode),
+		Birthdate:      utils.FormatDatePtr(row.Birthdate),
+		Skiclub:        utils.StringPtrOrNil(row.Skiclub),
+		Status:         utils.StringPtrOrNil(res.Status),
+		Position:       utils.Int32PtrOrNil(res.Position),
+		Bib:            utils.Int32PtrOrNil(res.Bib),
+		Racepoints:     utils.StringPtrOrNil(res.Racepoints),
+		Cuppoints:      utils.StringPtrOrNil(res.Cuppoints),
+		Time:           utils.StringPtrOrNil(res.Timetot),
+		TimeMs:         timeMsPtr(res.Timetot),
+		Rounds: jumpRounds(
+			jumpRoundCols{
+				res.Statusr1, res.Posr1, res.Speedr1, res.Distr1, res.Disptsr1,
+				[5]sql.NullString{res.J1r1, res.J2r1, res.J3r1, res.J4r1, res.J5r1},
+				res.Judptsr1, res.Gater1, res.Gateptsr1, res.Windr1, res.Windptsr1, res.Totrun1,
+			},
+			jumpRoundCols{
+				res.Statusr2, res.Posr2, res.Speedr2, res.Distr2, res.Disptsr2,
+				[5]sql.NullString{res.J1r2, res.J2r2, res.J3r2, res.J4r2, res.J5r2},
+				res.Judptsr2, res.Gater2, res.Gateptsr2, res.Windr2, res.Windptsr2, res.Totrun2,
+			},
+		),
+		JumpPoints:   floatPtr(res.Pointsjump),
+		JumpPosition: intPtr(res.Posjump),
+		CCTime:       utils.StringPtrOrNil(res.Timecc),
+		CCTimeMs:     timeMsPtr(res.Timecc),
+		CCPosition:   intPtr(res.Poscc),
+	}
+}
+
+// rankEnrichedResults fills the gaps to the winner, the best ranked result
+// with a score, and the ranks among the ranked results of the same nation
+func rankEnrichedResults(results []FISEnrichedResult, kind string) {
+	var winner *FISEnrichedResult
+	for i := range results {
+		res := &results[i]
+		if res.Position == nil || (res.TimeMs == nil && res.Points == nil) {
+			continue
+		}
+		if winner == nil || *res.Position < *winner.Position {
+			winner = res
+		}
+	}
+
+	for i := range results {
+		res := &results[i]
+		if res.Position == nil {
+			continue
+		}
+		if winner != nil {
+			switch {
+			case kind == scoreKindTime && res.TimeMs != nil && winner.TimeMs != nil:
+				behind := *res.TimeMs - *winner.TimeMs
+				res.BehindMs = &behind
+			case kind == scoreKindPoints && res.Points != nil && winner.Points != nil:
+				behind := math.Round((*winner.Points-*res.Points)*10) / 10
+				res.BehindPoints = &behind
+			}
+		}
+
+		rank := int32(1)
+		for _, other := range results {
+			if other.Position != nil && *other.Position < *res.Position && sameNation(other.Nationcode, res.Nationcode) {
+				rank++
+			}
+		}
+		res.NationRank = &rank
+	}
+}
+
+func sameNation(a, b *string) bool {
+	return a != nil && b != nil && *a == *b
+}
+
+// GetSectorRaceEnrichedResults godoc
+//
+//	@Summary		Get the enriched results of a race of a sector
+//	@Description	Returns the race with its results, the birthdate, ski club and nation of the competitors, and the FIS strings parsed: times in milliseconds with the gap to the winner in Cross-Country and Nordic combined, points with the gap to the winner in Ski Jumping, and the rank among the ranked results of the same nation. Jumping results have a breakdown per round with the five judge marks and the judge points computed from them (the highest and lowest mark dropped).
+//	@Tags			FIS - Sectors
+//	@Accept			json
+//	@Produce		json
+//	@Param			sector	path		string	true	"Sector code"	Enums(cc, jp, nk)
+//	@Param			raceid	path		int32	true	"Race ID"
+//	@Success		200		{object}	swagger.FISEnrichedResultsResponse
+//	@Failure		400		{object}	swagger.ValidationErrorResponse
+//	@Failure		401		{object}	swagger.UnauthorizedResponse
+//	@Failure		403		{object}	swagger.ForbiddenResponse
+//	@Failure		404		{object}	swagger.NotFoundResponse
+//	@Failure		500		{object}	swagger.InternalServerErrorResponse
+//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/fis/{sector}/races/{raceid}/results/enriched [get]
+func (h *SectorHandler) GetSectorRaceEnrichedResults(w http.ResponseWriter, r *http.Request) {
+	if !authz.Authorize(r) {
+		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
+		return
+	}
+	s, ok := h.sector(w, r)
+	if !ok {
+		return
+	}
+
+	raceID, err := utils.ParsePositiveInt32(chi.URLParam(r, "raceid"))
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	cacheKey := fmt.Sprintf("%s:sector:enriched:race=%d", s.cache().results, raceID)
+	if h.cache != nil {
+		if raw, err := h.cache.Get(r.Context(), cacheKey); err == nil && raw != "" {
+			utils.WriteJSON(w, http.StatusOK, json.RawMessage(raw))
+			return
+		}
+	}
+
+	races, err := s.racesByIDs(r.Context(), []int32{raceID})
+	if err != nil {
+		utils.HandleDatabaseError(w, r, err)
+		return
+	}
+	if len(races) == 0 {
+		utils.NotFoundResponse(w, r, fmt.Errorf("race %d not found", raceID))
+		return
+	}
+
+	results, err := s.enrichedResults(r.Context(), raceID)
+	if err != nil {
+		utils.HandleDatabaseError(w, r, err)
+		return
+	}
+	rankEnrichedResults(results, s.scoreKind())
+
+	body := map[string]any{
+		"race":       races[0],
+		"score_kind": s.scoreKind(),
+		"results":    results,
+	}
+	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, body, FISCacheTTL)
+	utils.WriteJSON(w, http.StatusOK, body)
+}
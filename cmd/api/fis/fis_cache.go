@@ -74,28 +74,28 @@ func invalidateSector(ctx context.Context, c *cache.Storage, sector string) {
 	invalidateNationsSector(ctx, c, sector)
 }
 
-func invalidateRaceCC(ctx context.Context, c *cache.Storage, raceID int32) {
+func invalidateRaceCC(ctx context.Context, c *cache.Storage) {
 	if c == nil {
 		return
 	}
 	_ = c.DeleteByPrefixes(ctx, fisRaceCCLastRowPrefix, fisRaceCCListPrefix)
 }
 
-func invalidateRaceJP(ctx context.Context, c *cache.Storage, raceID int32) {
+func invalidateRaceJP(ctx context.Context, c *cache.Storage) {
 	if c == nil {
 		return
 	}
 	_ = c.DeleteByPrefixes(ctx, fisRaceJPLastRowPrefix, fisRaceJPListPrefix)
 }
 
-func invalidateRaceNK(ctx context.Context, c *cache.Storage, raceID int32) {
+func invalidateRaceNK(ctx context.Context, c *cache.Storage) {
 	if c == nil {
 		return
 	}
 	_ = c.DeleteByPrefixes(ctx, fisRaceNKLastRowPrefix, fisRaceNKListPrefix)
 }
 
-func invalidateResultCC(ctx context.Context, c *cache.Storage, recid int32) {
+func invalidateResultCC(ctx context.Context, c *cache.Storage) {
 	if c == nil {
 		return
 	}
@@ -107,7 +107,7 @@ func invalidateResultCC(ctx context.Context, c *cache.Storage, recid int32) {
 	)
 }
 
-func invalidateResultJP(ctx context.Context, c *cache.Storage, recid int32) {
+func invalidateResultJP(ctx context.Context, c *cache.Storage) {
 	if c == nil {
 		return
 	}
@@ -119,7 +119,7 @@ func invalidateResultJP(ctx context.Context, c *cache.Storage, recid int32) {
 	)
 }
 
-func invalidateResultNK(ctx context.Context, c *cache.Storage, recid int32) {
+func invalidateResultNK(ctx context.Context, c *cache.Storage) {
 	if c == nil {
 		return
 	}
@@ -130,3 +130,19 @@ func invalidateResultNK(ctx context.Context, c *cache.Storage, recid int32) {
 		fisResultNKAthletePrefix,
 	)
 }
+
+// invalidateCompetitors drops the cached competitors as a whole, with the
+// athlete and nation lists of the sectors, after a sync of many of them
+func invalidateCompetitors(ctx context.Context, c *cache.Storage, sectors []string) {
+	if c == nil {
+		return
+	}
+	prefixes := []string{fisLastRowPrefix, fisCompetitorPrefix}
+	for _, sector := range sectors {
+		prefixes = append(prefixes,
+			fmt.Sprintf("%s:%s", fisAthletesPrefix, sector),
+			fmt.Sprintf("%s:%s", fisNationsPrefix, sector),
+		)
+	}
+	_ = c.DeleteByPrefixes(ctx, prefixes...)
+}
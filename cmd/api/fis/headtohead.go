@@ -0,0 +1,298 @@
+package fisapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+// maxHeadToHead caps the number of competitors compared at once
+const maxHeadToHead = 10
+
+const (
+	scoreKindTime   = "time"
+	scoreKindPoints = "points"
+)
+
+var errHeadToHeadFiscodes = fmt.Errorf("between 2 and %d distinct fiscode values required", maxHeadToHead)
+
+// FISHeadToHeadResult is the result of one of the compared competitors in a
+// race. Behind is the gap to the best placed of the compared competitors, in
+// seconds or points depending on the sector; RacepointsBehind the gap in
+// race points.
+type FISHeadToHeadResult struct {
+	Fiscode          *int32   `json:"fiscode"`
+	Competitorname   *string  `json:"competitorname"`
+	Nationcode       *string  `json:"nationcode"`
+	Status           *string  `json:"status"`
+	Position         *int32   `json:"position"`
+	Score            *string  `json:"score"`
+	Racepoints       *string  `json:"racepoints"`
+	Behind           *float64 `json:"behind"`
+	RacepointsBehind *float64 `json:"racepoints_behind"`
+}
+
+// FISHeadToHeadRace is a race at least two of the compared competitors
+// competed in
+type FISHeadToHeadRace struct {
+	Raceid         int32                 `json:"raceid"`
+	Racedate       *string               `json:"racedate"`
+	Seasoncode     *int32                `json:"seasoncode"`
+	Disciplinecode *string               `json:"disciplinecode"`
+	Catcode        *string               `json:"catcode"`
+	Place          *string               `json:"place"`
+	Nationcode     *string               `json:"nationcode"`
+	Results        []FISHeadToHeadResult `json:"results"`
+}
+
+// FISHeadToHeadRecord is the record of a competitor against an opponent in
+// the races both competed in. A race is undecided when neither is ranked.
+type FISHeadToHeadRecord struct {
+	Fiscode   int32 `json:"fiscode"`
+	Opponent  int32 `json:"opponent"`
+	Races     int   `json:"races"`
+	Wins      int   `json:"wins"`
+	Losses    int   `json:"losses"`
+	Undecided int   `json:"undecided"`
+}
+
+// parseRaceTime parses a race time such as "1:02:03.4", "26:30.2" or
+// "45.1" to milliseconds
+func parseRaceTime(s string) (int64, bool) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, false
+	}
+	parts := strings.Split(s, ":")
+	if len(parts) > 3 {
+		return 0, false
+	}
+	secs, err := strconv.ParseFloat(parts[len(parts)-1], 64)
+	if err != nil || secs < 0 {
+		return 0, false
+	}
+	ms := int64(math.Round(secs * 1000))
+	unit := int64(60 * 1000)
+	for i := len(parts) - 2; i >= 0; i-- {
+		n, err := strconv.ParseInt(parts[i], 10, 64)
+		if err != nil || n < 0 {
+			return 0, false
+		}
+		ms += n * unit
+		unit *= 60
+	}
+	return ms, true
+}
+
+func parsePoints(s *string) (float64, bool) {
+	if s == nil {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(strings.TrimSpace(*s), 64)
+	return f, err == nil
+}
+
+// scoreGap returns how far score is behind the leader's: seconds slower or
+// points fewer
+func scoreGap(kind string, score, leader *string) (float64, bool) {
+	if score == nil || leader == nil {
+		return 0, false
+	}
+	if kind == scoreKindPoints {
+		own, ok1 := parsePoints(score)
+		best, ok2 := parsePoints(leader)
+		return math.Round((best-own)*10) / 10, ok1 && ok2
+	}
+	own, ok1 := parseRaceTime(*score)
+	best, ok2 := parseRaceTime(*leader)
+	return float64(own-best) / 1000, ok1 && ok2
+}
+
+// headToHeadRaces groups the rows by race. The rows of a race are ordered by
+// position, so the first ranked one is the leader of the comparison.
+func headToHeadRaces(rows []fissqlc.GetHeadToHeadCCRow, kind string) []FISHeadToHeadRace {
+	races := []FISHeadToHeadRace{}
+	var leader *FISHeadToHeadResult
+	for _, row := range rows {
+		if len(races) == 0 || races[len(races)-1].Raceid != row.Raceid {
+			races = append(races, FISHeadToHeadRace{
+				Raceid:         row.Raceid,
+				Racedate:       utils.FormatDatePtr(row.Racedate),
+				Seasoncode:     utils.Int32PtrOrNil(row.Seasoncode),
+				Disciplinecode: utils.StringPtrOrNil(row.Disciplinecode),
+				Catcode:        utils.StringPtrOrNil(row.Catcode),
+				Place:          utils.StringPtrOrNil(row.Place),
+				Nationcode:     utils.StringPtrOrNil(row.Nationcode),
+			})
+			leader = nil
+		}
+
+		res := FISHeadToHeadResult{
+			Fiscode:        utils.Int32PtrOrNil(row.Fiscode),
+			Competitorname: utils.StringPtrOrNil(row.Competitorname),
+			Nationcode:     utils.StringPtrOrNil(row.Competitornation),
+			Status:         utils.StringPtrOrNil(row.Status),
+			Position:       utils.Int32PtrOrNil(row.Position),
+			Score:          utils.StringPtrOrNil(row.Score),
+			Racepoints:     utils.StringPtrOrNil(row.Racepoints),
+		}
+		if leader == nil && res.Position != nil {
+			leader = &res
+		}
+		if leader != nil && res.Position != nil {
+			if gap, ok := scoreGap(kind, res.Score, leader.Score); ok {
+				res.Behind = &gap
+			}
+			own, ok1 := parsePoints(res.Racepoints)
+			best, ok2 := parsePoints(leader.Racepoints)
+			if ok1 && ok2 {
+				gap := math.Round((own-best)*100) / 100
+				res.RacepointsBehind = &gap
+			}
+		}
+
+		race := &races[len(races)-1]
+		race.Results = append(race.Results, res)
+	}
+	return races
+}
+
+// headToHeadRecords returns the record of every pair of competitors, in the
+// order the fiscodes were given
+func headToHeadRecords(fiscodes []int32, races []FISHeadToHeadRace) []FISHeadToHeadRecord {
+	records := []FISHeadToHeadRecord{}
+	for i, a := range fiscodes {
+		for _, b := range fiscodes[i+1:] {
+			rec := FISHeadToHeadRecord{Fiscode: a, Opponent: b}
+			for _, race := range races {
+				ra, okA := findHeadToHeadResult(race.Results, a)
+				rb, okB := findHeadToHeadResult(race.Results, b)
+				if !okA || !okB {
+					continue
+				}
+				rec.Races++
+				switch {
+				case ra.Position == nil && rb.Position == nil:
+					rec.Undecided++
+				case rb.Position == nil || (ra.Position != nil && *ra.Position < *rb.Position):
+					rec.Wins++
+				case ra.Position == nil || *rb.Position < *ra.Position:
+					rec.Losses++
+				default:
+					rec.Undecided++
+				}
+			}
+			records = append(records, rec)
+		}
+	}
+	return records
+}
+
+func findHeadToHeadResult(results []FISHeadToHeadResult, fiscode int32) (FISHeadToHeadResult, bool) {
+	for _, res := range results {
+		if res.Fiscode != nil && *res.Fiscode == fiscode {
+			return res, true
+		}
+	}
+	return FISHeadToHeadResult{}, false
+}
+
+// parseHeadToHeadFiscodes reads the fiscode values (repeated or
+// comma-separated)
+func parseHeadToHeadFiscodes(r *http.Request) ([]int32, error) {
+	var fiscodes []int32
+	for _, v := range parseListParam(r, "fiscode") {
+		n, err := utils.ParsePositiveInt32(strings.TrimSpace(v))
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(fiscodes, n) {
+			fiscodes = append(fiscodes, n)
+		}
+	}
+	if len(fiscodes) < 2 || len(fiscodes) > maxHeadToHead {
+		return nil, errHeadToHeadFiscodes
+	}
+	return fiscodes, nil
+}
+
+// GetHeadToHead godoc
+//
+//	@Summary		Compare FIS competitors head to head
+//	@Description	Returns every race of the sector at least two of the competitors competed in, newest first, with their positions and their gaps to the best placed of them: behind is in seconds in Cross-Country and Nordic combined and in points in Ski Jumping, racepoints_behind in race points. records holds the head-to-head record of every pair in the races both competed in; a ranked result beats an unranked one.
+//	@Tags			FIS - Athlete
+//	@Accept			json
+//	@Produce		json
+//	@Param			sector			path		string		true	"Sector code"	Enums(cc, jp, nk)
+//	@Param			fiscode			query		[]int32		true	"FIS codes, 2 to 10 (repeat or comma-separated)"
+//	@Param			seasoncode		query		[]int32		false	"Season code (repeat or comma-separated)"
+//	@Param			disciplinecode	query		[]string	false	"Discipline code (repeat or comma-separated)"
+//	@Param			catcode			query		[]string	false	"Category code (repeat or comma-separated)"
+//	@Success		200				{object}	swagger.FISHeadToHeadResponse
+//	@Failure		400				{object}	swagger.ValidationErrorResponse
+//	@Failure		401				{object}	swagger.UnauthorizedResponse
+//	@Failure		403				{object}	swagger.ForbiddenResponse
+//	@Failure		500				{object}	swagger.InternalServerErrorResponse
+//	@Failure		503				{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/fis/{sector}/head-to-head [get]
+func (h *SectorHandler) GetHeadToHead(w http.ResponseWriter, r *http.Request) {
+	if !authz.Authorize(r) {
+		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
+		return
+	}
+	if err := utils.ValidateParams(r, []string{"fiscode", "seasoncode", "disciplinecode", "catcode"}); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	s, ok := h.sector(w, r)
+	if !ok {
+		return
+	}
+
+	fiscodes, err := parseHeadToHeadFiscodes(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	seasons, err := parseSeasonCodes(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	discs := parseListParam(r, "disciplinecode")
+	cats := parseListParam(r, "catcode")
+
+	cacheKey := fmt.Sprintf("%s:h2h:fis=%v:sc=%v:dc=%v:cc=%v", s.cache().athlete, fiscodes, seasons, discs, cats)
+	if h.cache != nil {
+		if raw, err := h.cache.Get(r.Context(), cacheKey); err == nil && raw != "" {
+			utils.WriteJSON(w, http.StatusOK, json.RawMessage(raw))
+			return
+		}
+	}
+
+	rows, err := s.headToHead(r.Context(), fiscodes, seasons, discs, cats)
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+
+	races := headToHeadRaces(rows, s.scoreKind())
+	body := map[string]any{
+		"fiscodes":   fiscodes,
+		"score_kind": s.scoreKind(),
+		"records":    headToHeadRecords(fiscodes, races),
+		"races":      races,
+	}
+	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, body, FISCacheTTL)
+	utils.WriteJSON(w, http.StatusOK, body)
+}
@@ -0,0 +1,325 @@
+package fisapi
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+// Defaults of the FIS points lists: the average of the best 5 race points
+// of the last 12 months
+const (
+	defaultPointsPeriod = 12
+	defaultPointsBest   = 5
+	defaultPointsTop    = 100
+	maxPointsTop        = 1000
+	// maxPointsLists caps the lists computed for a history
+	maxPointsLists = 120
+)
+
+var (
+	errTooManyPointsLists = fmt.Errorf("at most %d lists per history: narrow from/to or raise step_months", maxPointsLists)
+	errNoPointsList       = errors.New("FIS points lists are only computed for cc and nk")
+)
+
+// FISPointsStanding is the standing of a competitor in a FIS points list of
+// a discipline (the list discipline of the races) and gender. Points is the
+// average of the best race points plus race penalty of the period; lower is
+// better.
+type FISPointsStanding struct {
+	ListDate       string  `json:"list_date"`
+	Disciplinecode *string `json:"disciplinecode"`
+	Gender         *string `json:"gender"`
+	Fiscode        *int32  `json:"fiscode"`
+	Competitorname *string `json:"competitorname"`
+	Nationcode     *string `json:"nationcode"`
+	Results        int64   `json:"results"`
+	Points         string  `json:"points"`
+	Rank           int64   `json:"rank"`
+	NationRank     int64   `json:"nation_rank"`
+}
+
+// pointsStandingFromSqlc converts a points list row. The rows of the
+// sectors have the same columns, so the NK rows are converted to the CC
+// ones.
+func pointsStandingFromSqlc(row fissqlc.GetFISPointsListCCRow) FISPointsStanding {
+	return FISPointsStanding{
+		ListDate:       row.ListDate.Format(time.DateOnly),
+		Disciplinecode: utils.StringPtrOrNil(row.Disciplinecode),
+		Gender:         utils.StringPtrOrNil(row.Gender),
+		Fiscode:        utils.Int32PtrOrNil(row.Fiscode),
+		Competitorname: utils.StringPtrOrNil(row.Competitorname),
+		Nationcode:     utils.StringPtrOrNil(row.Nationcode),
+		Results:        row.Results,
+		Points:         row.Points,
+		Rank:           row.Rank,
+		NationRank:     row.NationRank,
+	}
+}
+
+// intParam reads an optional integer query parameter within [min, max]
+func intParam(r *http.Request, key string, def, min, max int32) (int32, error) {
+	val := r.URL.Query().Get(key)
+	if val == "" {
+		return def, nil
+	}
+	n, err := strconv.ParseInt(val, 10, 32)
+	if err != nil || int32(n) < min || int32(n) > max {
+		return 0, fmt.Errorf("invalid %s: must be an integer between %d and %d", key, min, max)
+	}
+	return int32(n), nil
+}
+
+// dateParam reads an optional YYYY-MM-DD query parameter
+func dateParam(r *http.Request, key string, def time.Time) (time.Time, error) {
+	val := r.URL.Query().Get(key)
+	if val == "" {
+		return def, nil
+	}
+	d, err := utils.ParseDate(val)
+	if err != nil {
+		return d, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return d, nil
+}
+
+// readPointsRule reads the parameters of the points rule shared by the list
+// and the history
+func readPointsRule(r *http.Request, q *fis.PointsListQuery) error {
+	var err error
+	if q.PeriodMonths, err = intParam(r, "period_months", defaultPointsPeriod, 1, 60); err != nil {
+		return err
+	}
+	if q.Best, err = intParam(r, "best", defaultPointsBest, 1, 50); err != nil {
+		return err
+	}
+	if q.MinResults, err = intParam(r, "min_results", 1, 1, q.Best); err != nil {
+		return err
+	}
+	q.Disciplines = parseListParam(r, "disciplinecode")
+	q.Gender = strings.ToUpper(strings.TrimSpace(r.URL.Query().Get("gender")))
+	return nil
+}
+
+// pointsSector resolves the sector of the request and answers 400 when it
+// has no points list: only the sectors ranked by time (lower points better)
+// have one
+func (h *SectorHandler) pointsSector(w http.ResponseWriter, r *http.Request) (sector, bool) {
+	s, ok := h.sector(w, r)
+	if !ok {
+		return nil, false
+	}
+	if s.scoreKind() != scoreKindTime {
+		utils.BadRequestResponse(w, r, errNoPointsList)
+		return nil, false
+	}
+	return s, true
+}
+
+func today() time.Time {
+	return time.Now().UTC().Truncate(24 * time.Hour)
+}
+
+// GetPointsList godoc
+//
+//	@Summary		Get a FIS points list of a sector
+//	@Description	Computes the FIS points standings of the sector at date: per list discipline and gender, the average of the best race points plus race penalty of each competitor in the races valid for FIS points of the period before the date, counting only races with a FIS list and a known penalty, ranked overall (rank) and within the nation (nation_rank). Lower points are better. Only cc and nk have points lists; jp answers 400. With nationcode the list is the national ranking and top caps the national rank; with fiscode it is the standing of one competitor.
+//	@Tags			FIS - Points
+//	@Accept			json
+//	@Produce		json
+//	@Param			sector			path		string		true	"Sector code"	Enums(cc, nk)
+//	@Param			date			query		string		false	"List date (YYYY-MM-DD, default: today)"
+//	@Param			period_months	query		int			false	"Months of results counted (default: 12, max: 60)"
+//	@Param			best			query		int			false	"Number of best results averaged (default: 5, max: 50)"
+//	@Param			min_results		query		int			false	"Results needed to be listed (default: 1, max: best)"
+//	@Param			disciplinecode	query		[]string	false	"List discipline code (repeat or comma-separated)"
+//	@Param			gender			query		string		false	"Gender"
+//	@Param			nationcode		query		string		false	"Nation code; ranks within the nation"
+//	@Param			fiscode			query		int32		false	"FIS Code; returns the standing of one competitor"
+//	@Param			top				query		int			false	"Ranks listed per discipline and gender (default: 100, max: 1000)"
+//	@Success		200				{object}	swagger.FISPointsListResponse
+//	@Failure		400				{object}	swagger.ValidationErrorResponse
+//	@Failure		401				{object}	swagger.UnauthorizedResponse
+//	@Failure		403				{object}	swagger.ForbiddenResponse
+//	@Failure		500				{object}	swagger.InternalServerErrorResponse
+//	@Failure		503				{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/fis/{sector}/points [get]
+func (h *SectorHandler) GetPointsList(w http.ResponseWriter, r *http.Request) {
+	if !authz.Authorize(r) {
+		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
+		return
+	}
+	if err := utils.ValidateParams(r, []string{"date", "period_months", "best", "min_results", "disciplinecode", "gender", "nationcode", "fiscode", "top"}); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	s, ok := h.pointsSector(w, r)
+	if !ok {
+		return
+	}
+
+	var q fis.PointsListQuery
+	date, err := dateParam(r, "date", today())
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	q.From, q.To, q.StepMonths = date, date, 1
+	if err := readPointsRule(r, &q); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	q.Nationcode = strings.ToUpper(strings.TrimSpace(r.URL.Query().Get("nationcode")))
+	if val := r.URL.Query().Get("fiscode"); val != "" {
+		if q.Fiscode, err = utils.ParsePositiveInt32(val); err != nil {
+			utils.BadRequestResponse(w, r, err)
+			return
+		}
+	}
+	if q.Top, err = intParam(r, "top", defaultPointsTop, 1, maxPointsTop); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	if q.Fiscode != 0 {
+		q.Top = 0
+	}
+
+	cacheKey := fmt.Sprintf("%s:points:%+v", s.cache().athlete, q)
+	if h.cache != nil {
+		if raw, err := h.cache.Get(r.Context(), cacheKey); err == nil && raw != "" {
+			utils.WriteJSON(w, http.StatusOK, json.RawMessage(raw))
+			return
+		}
+	}
+
+	standings, err := s.pointsList(r.Context(), q)
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+
+	body := map[string]any{
+		"list_date":     date.Format(time.DateOnly),
+		"period_months": q.PeriodMonths,
+		"best":          q.Best,
+		"standings":     standings,
+	}
+	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, body, FISCacheTTL)
+	utils.WriteJSON(w, http.StatusOK, body)
+}
+
+// GetPointsHistory godoc
+//
+//	@Summary		Get the FIS points history of a competitor
+//	@Description	Computes the FIS points lists of the sector every step_months from from to to, as /fis/{sector}/points does, and returns the standings of the competitor in them.
+//	@Tags			FIS - Points
+//	@Accept			json
+//	@Produce		json
+//	@Param			sector			path		string		true	"Sector code"	Enums(cc, nk)
+//	@Param			fiscode			query		int32		true	"FIS Code"
+//	@Param			from			query		string		false	"First list date (YYYY-MM-DD, default: two years before to)"
+//	@Param			to				query		string		false	"Last list date (YYYY-MM-DD, default: today)"
+//	@Param			step_months		query		int			false	"Months between lists (default: 1, max: 24)"
+//	@Param			period_months	query		int			false	"Months of results counted (default: 12, max: 60)"
+//	@Param			best			query		int			false	"Number of best results averaged (default: 5, max: 50)"
+//	@Param			min_results		query		int			false	"Results needed to be listed (default: 1, max: best)"
+//	@Param			disciplinecode	query		[]string	false	"List discipline code (repeat or comma-separated)"
+//	@Param			gender			query		string		false	"Gender"
+//	@Success		200				{object}	swagger.FISPointsHistoryResponse
+//	@Failure		400				{object}	swagger.ValidationErrorResponse
+//	@Failure		401				{object}	swagger.UnauthorizedResponse
+//	@Failure		403				{object}	swagger.ForbiddenResponse
+//	@Failure		404				{object}	swagger.NotFoundResponse
+//	@Failure		500				{object}	swagger.InternalServerErrorResponse
+//	@Failure		503				{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/fis/{sector}/points/history [get]
+func (h *SectorHandler) GetPointsHistory(w http.ResponseWriter, r *http.Request) {
+	if !authz.Authorize(r) {
+		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
+		return
+	}
+	if err := utils.ValidateParams(r, []string{"fiscode", "from", "to", "step_months", "period_months", "best", "min_results", "disciplinecode", "gender"}); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	s, ok := h.pointsSector(w, r)
+	if !ok {
+		return
+	}
+
+	var q fis.PointsListQuery
+	var err error
+	if q.Fiscode, err = utils.ParsePositiveInt32(r.URL.Query().Get("fiscode")); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	if q.To, err = dateParam(r, "to", today()); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	if q.From, err = dateParam(r, "from", q.To.AddDate(-2, 0, 0)); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	if q.From.After(q.To) {
+		utils.BadRequestResponse(w, r, utils.ErrInvalidDateRange)
+		return
+	}
+	if q.StepMonths, err = intParam(r, "step_months", 1, 1, 24); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	if q.From.AddDate(0, int(q.StepMonths)*maxPointsLists, 0).Before(q.To) {
+		utils.BadRequestResponse(w, r, errTooManyPointsLists)
+		return
+	}
+	if err := readPointsRule(r, &q); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	if _, err := s.competitorID(r.Context(), q.Fiscode); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			utils.NotFoundResponse(w, r, fmt.Errorf("competitor with FIS code %d not found", q.Fiscode))
+			return
+		}
+		utils.InternalServerError(w, r, err)
+		return
+	}
+
+	cacheKey := fmt.Sprintf("%s:points:history:%+v", s.cache().athlete, q)
+	if h.cache != nil {
+		if raw, err := h.cache.Get(r.Context(), cacheKey); err == nil && raw != "" {
+			utils.WriteJSON(w, http.StatusOK, json.RawMessage(raw))
+			return
+		}
+	}
+
+	history, err := s.pointsList(r.Context(), q)
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+
+	body := map[string]any{
+		"fiscode":       q.Fiscode,
+		"period_months": q.PeriodMonths,
+		"best":          q.Best,
+		"history":       history,
+	}
+	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, body, FISCacheTTL)
+	utils.WriteJSON(w, http.StatusOK, body)
+}
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/fis"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
@@ -146,10 +147,13 @@ func (h *RaceCCHandler) GetCategoryCodesCC(w http.ResponseWriter, r *http.Reques
 //	@Summary	Get list of Cross-Country races
 //	@Tags		FIS - Race Data
 //	@Accept		json
-//	@Produce	json
+//	@Produce	json,text/csv,application/vnd.apache.parquet
 //	@Param		seasoncode		query		[]int32		false	"Season code (repeat or comma-separated)"
 //	@Param		disciplinecode	query		[]string	false	"Discipline code (repeat or comma-separated)"
 //	@Param		catcode			query		[]string	false	"Category code (repeat or comma-separated)"
+//	@Param		limit			query		int			false	"Page size (default: 100, max: 1000)"
+//	@Param		cursor			query		string		false	"Opaque cursor from pagination.next_cursor of the previous page"
+//	@Param		format			query		string		false	"Response format: json (default), csv or parquet; also negotiated with Accept"
 //	@Success	200				{object}	swagger.FISRacesCCResponse
 //	@Failure	400				{object}	swagger.ValidationErrorResponse
 //	@Failure	401				{object}	swagger.UnauthorizedResponse
@@ -191,27 +195,50 @@ func (h *RaceCCHandler) GetRacesCC(w http.ResponseWriter, r *http.Request) {
 		seasons = append(seasons, int32(n))
 	}
 
-	cacheKey := fmt.Sprintf("%s:sc=%v:dc=%v:cc=%v", fisRaceCCListPrefix, seasons, discs, cats)
-	if h.cache != nil {
+	page, err := utils.ParsePage(r, utils.DefaultPageLimits, utils.CursorN)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	format, err := utils.NegotiateFormat(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	cacheKey := fmt.Sprintf("%s:sc=%v:dc=%v:cc=%v:%s", fisRaceCCListPrefix, seasons, discs, cats, page.CacheKey())
+	if h.cache != nil && format == utils.FormatJSON {
 		if raw, err := h.cache.Get(r.Context(), cacheKey); err == nil && raw != "" {
+			utils.SetNextLink(w, r, utils.CachedNextCursor(raw))
 			utils.WriteJSON(w, http.StatusOK, json.RawMessage(raw))
 			return
 		}
 	}
 
-	rows, err := h.store.GetRacesCC(r.Context(), seasons, discs, cats)
+	rows, err := h.store.GetRacesCC(r.Context(), seasons, discs, cats, page)
 	if err != nil {
 		utils.InternalServerError(w, r, err)
 		return
 	}
+	rows, pageInfo := utils.NextPage(rows, page, func(row fissqlc.ARacecc) utils.Cursor {
+		return utils.IntCursor(int64(row.Raceid))
+	})
 
 	out := make([]FISRaceCCFullResponse, 0, len(rows))
 	for _, row := range rows {
 		out = append(out, FISRaceCCFullFromSqlc(row))
 	}
 
-	body := map[string]any{"races": out}
+	if format != utils.FormatJSON {
+		utils.SetNextLink(w, r, pageInfo.NextCursor)
+		utils.WriteTable(w, format, "fis_races_cc", out)
+		return
+	}
+
+	body := map[string]any{"races": out, "pagination": pageInfo}
 	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, body, FISCacheTTL)
+	utils.SetNextLink(w, r, pageInfo.NextCursor)
 	utils.WriteJSON(w, http.StatusOK, body)
 }
 
@@ -303,7 +330,7 @@ func (h *RaceCCHandler) InsertRaceCC(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	invalidateRaceCC(r.Context(), h.cache, clean.Raceid)
+	invalidateRaceCC(r.Context(), h.cache)
 	w.WriteHeader(http.StatusCreated)
 }
 
@@ -355,7 +382,7 @@ func (h *RaceCCHandler) UpdateRaceCC(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	invalidateRaceCC(r.Context(), h.cache, clean.Raceid)
+	invalidateRaceCC(r.Context(), h.cache)
 	w.WriteHeader(http.StatusOK)
 }
 
@@ -402,6 +429,6 @@ func (h *RaceCCHandler) DeleteRaceCC(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	invalidateRaceCC(r.Context(), h.cache, id)
+	invalidateRaceCC(r.Context(), h.cache)
 	w.WriteHeader(http.StatusOK)
 }
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/fis"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
@@ -146,10 +147,13 @@ func (h *RaceJPHandler) GetCategoryCodesJP(w http.ResponseWriter, r *http.Reques
 //	@Summary	Get list of Ski Jumping races
 //	@Tags		FIS - Race Data
 //	@Accept		json
-//	@Produce	json
+//	@Produce	json,text/csv,application/vnd.apache.parquet
 //	@Param		seasoncode		query		[]int32		false	"Season code (repeat or comma-separated)"
 //	@Param		disciplinecode	query		[]string	false	"Discipline code (repeat or comma-separated)"
 //	@Param		catcode			query		[]string	false	"Category code (repeat or comma-separated)"
+//	@Param		limit			query		int			false	"Page size (default: 100, max: 1000)"
+//	@Param		cursor			query		string		false	"Opaque cursor from pagination.next_cursor of the previous page"
+//	@Param		format			query		string		false	"Response format: json (default), csv or parquet; also negotiated with Accept"
 //	@Success	200				{object}	swagger.FISRacesJPResponse
 //	@Failure	400				{object}	swagger.ValidationErrorResponse
 //	@Failure	401				{object}	swagger.UnauthorizedResponse
@@ -189,27 +193,50 @@ func (h *RaceJPHandler) GetRacesJP(w http.ResponseWriter, r *http.Request) {
 		seasons = append(seasons, int32(n))
 	}
 
-	cacheKey := fmt.Sprintf("%s:sc=%v:dc=%v:cc=%v", fisRaceJPListPrefix, seasons, discs, cats)
-	if h.cache != nil {
+	page, err := utils.ParsePage(r, utils.DefaultPageLimits, utils.CursorN)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	format, err := utils.NegotiateFormat(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	cacheKey := fmt.Sprintf("%s:sc=%v:dc=%v:cc=%v:%s", fisRaceJPListPrefix, seasons, discs, cats, page.CacheKey())
+	if h.cache != nil && format == utils.FormatJSON {
 		if raw, err := h.cache.Get(r.Context(), cacheKey); err == nil && raw != "" {
+			utils.SetNextLink(w, r, utils.CachedNextCursor(raw))
 			utils.WriteJSON(w, http.StatusOK, json.RawMessage(raw))
 			return
 		}
 	}
 
-	rows, err := h.store.GetRacesJP(r.Context(), seasons, discs, cats)
+	rows, err := h.store.GetRacesJP(r.Context(), seasons, discs, cats, page)
 	if err != nil {
 		utils.InternalServerError(w, r, err)
 		return
 	}
+	rows, pageInfo := utils.NextPage(rows, page, func(row fissqlc.ARacejp) utils.Cursor {
+		return utils.IntCursor(int64(row.Raceid))
+	})
 
 	out := make([]FISRaceJPFullResponse, 0, len(rows))
 	for _, row := range rows {
 		out = append(out, FISRaceJPFullFromSqlc(row))
 	}
 
-	body := map[string]any{"races": out}
+	if format != utils.FormatJSON {
+		utils.SetNextLink(w, r, pageInfo.NextCursor)
+		utils.WriteTable(w, format, "fis_races_jp", out)
+		return
+	}
+
+	body := map[string]any{"races": out, "pagination": pageInfo}
 	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, body, FISCacheTTL)
+	utils.SetNextLink(w, r, pageInfo.NextCursor)
 	utils.WriteJSON(w, http.StatusOK, body)
 }
 
@@ -301,7 +328,7 @@ func (h *RaceJPHandler) InsertRaceJP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	invalidateRaceJP(r.Context(), h.cache, clean.Raceid)
+	invalidateRaceJP(r.Context(), h.cache)
 	w.WriteHeader(http.StatusCreated)
 }
 
@@ -353,7 +380,7 @@ func (h *RaceJPHandler) UpdateRaceJP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	invalidateRaceJP(r.Context(), h.cache, clean.Raceid)
+	invalidateRaceJP(r.Context(), h.cache)
 	w.WriteHeader(http.StatusOK)
 }
 
@@ -400,6 +427,6 @@ func (h *RaceJPHandler) DeleteRaceJP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	invalidateRaceJP(r.Context(), h.cache, id)
+	invalidateRaceJP(r.Context(), h.cache)
 	w.WriteHeader(http.StatusOK)
 }
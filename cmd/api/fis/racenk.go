@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/fis"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
@@ -146,10 +147,13 @@ func (h *RaceNKHandler) GetCategoryCodesNK(w http.ResponseWriter, r *http.Reques
 //	@Summary	Get list of Nordic Combined races
 //	@Tags		FIS - Race Data
 //	@Accept		json
-//	@Produce	json
+//	@Produce	json,text/csv,application/vnd.apache.parquet
 //	@Param		seasoncode		query		[]int32		false	"Season code (repeat or comma-separated)"
 //	@Param		disciplinecode	query		[]string	false	"Discipline code (repeat or comma-separated)"
 //	@Param		catcode			query		[]string	false	"Category code (repeat or comma-separated)"
+//	@Param		limit			query		int			false	"Page size (default: 100, max: 1000)"
+//	@Param		cursor			query		string		false	"Opaque cursor from pagination.next_cursor of the previous page"
+//	@Param		format			query		string		false	"Response format: json (default), csv or parquet; also negotiated with Accept"
 //	@Success	200				{object}	swagger.FISRacesNKResponse
 //	@Failure	400				{object}	swagger.ValidationErrorResponse
 //	@Failure	401				{object}	swagger.UnauthorizedResponse
@@ -190,27 +194,50 @@ func (h *RaceNKHandler) GetRacesNK(w http.ResponseWriter, r *http.Request) {
 		seasons = append(seasons, int32(n))
 	}
 
-	cacheKey := fmt.Sprintf("%s:sc=%v:dc=%v:cc=%v", fisRaceNKListPrefix, seasons, discs, cats)
-	if h.cache != nil {
+	page, err := utils.ParsePage(r, utils.DefaultPageLimits, utils.CursorN)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	format, err := utils.NegotiateFormat(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	cacheKey := fmt.Sprintf("%s:sc=%v:dc=%v:cc=%v:%s", fisRaceNKListPrefix, seasons, discs, cats, page.CacheKey())
+	if h.cache != nil && format == utils.FormatJSON {
 		if raw, err := h.cache.Get(r.Context(), cacheKey); err == nil && raw != "" {
+			utils.SetNextLink(w, r, utils.CachedNextCursor(raw))
 			utils.WriteJSON(w, http.StatusOK, json.RawMessage(raw))
 			return
 		}
 	}
 
-	rows, err := h.store.GetRacesNK(r.Context(), seasons, discs, cats)
+	rows, err := h.store.GetRacesNK(r.Context(), seasons, discs, cats, page)
 	if err != nil {
 		utils.InternalServerError(w, r, err)
 		return
 	}
+	rows, pageInfo := utils.NextPage(rows, page, func(row fissqlc.ARacenk) utils.Cursor {
+		return utils.IntCursor(int64(row.Raceid))
+	})
 
 	out := make([]FISRaceNKFullResponse, 0, len(rows))
 	for _, row := range rows {
 		out = append(out, FISRaceNKFullFromSqlc(row))
 	}
 
-	body := map[string]any{"races": out}
+	if format != utils.FormatJSON {
+		utils.SetNextLink(w, r, pageInfo.NextCursor)
+		utils.WriteTable(w, format, "fis_races_nk", out)
+		return
+	}
+
+	body := map[string]any{"races": out, "pagination": pageInfo}
 	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, body, FISCacheTTL)
+	utils.SetNextLink(w, r, pageInfo.NextCursor)
 	utils.WriteJSON(w, http.StatusOK, body)
 }
 
@@ -302,7 +329,7 @@ func (h *RaceNKHandler) InsertRaceNK(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	invalidateRaceNK(r.Context(), h.cache, clean.Raceid)
+	invalidateRaceNK(r.Context(), h.cache)
 	w.WriteHeader(http.StatusCreated)
 }
 
@@ -354,7 +381,7 @@ func (h *RaceNKHandler) UpdateRaceNK(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	invalidateRaceNK(r.Context(), h.cache, clean.Raceid)
+	invalidateRaceNK(r.Context(), h.cache)
 	w.WriteHeader(http.StatusOK)
 }
 
@@ -401,6 +428,6 @@ func (h *RaceNKHandler) DeleteRaceNK(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	invalidateRaceNK(r.Context(), h.cache, id)
+	invalidateRaceNK(r.Context(), h.cache)
 	w.WriteHeader(http.StatusOK)
 }
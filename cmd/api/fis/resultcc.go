@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/fis"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
@@ -122,7 +123,7 @@ func (h *ResultCCHandler) InsertResultCC(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	invalidateResultCC(r.Context(), h.cache, clean.Recid)
+	invalidateResultCC(r.Context(), h.cache)
 	w.WriteHeader(http.StatusCreated)
 }
 
@@ -174,7 +175,7 @@ func (h *ResultCCHandler) UpdateResultCC(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	invalidateResultCC(r.Context(), h.cache, clean.Recid)
+	invalidateResultCC(r.Context(), h.cache)
 	w.WriteHeader(http.StatusOK)
 }
 
@@ -221,7 +222,7 @@ func (h *ResultCCHandler) DeleteResultCC(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	invalidateResultCC(r.Context(), h.cache, id)
+	invalidateResultCC(r.Context(), h.cache)
 	w.WriteHeader(http.StatusOK)
 }
 
@@ -230,8 +231,9 @@ func (h *ResultCCHandler) DeleteResultCC(w http.ResponseWriter, r *http.Request)
 //	@Summary	Get results for a Cross-Country race
 //	@Tags		FIS - Race Results
 //	@Accept		json
-//	@Produce	json
+//	@Produce	json,text/csv,application/vnd.apache.parquet
 //	@Param		raceid	query		int32	true	"Race ID"
+//	@Param		format	query		string	false	"Response format: json (default), csv or parquet; also negotiated with Accept"
 //	@Success	200		{object}	swagger.FISRaceResultsCCResponse
 //	@Failure	400		{object}	swagger.ValidationErrorResponse
 //	@Failure	401		{object}	swagger.UnauthorizedResponse
@@ -245,7 +247,7 @@ func (h *ResultCCHandler) GetRaceResultsCC(w http.ResponseWriter, r *http.Reques
 		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
 		return
 	}
-	if err := utils.ValidateParams(r, []string{"raceid"}); err != nil {
+	if err := utils.ValidateParams(r, []string{"raceid", "format"}); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
@@ -257,8 +259,14 @@ func (h *ResultCCHandler) GetRaceResultsCC(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	format, err := utils.NegotiateFormat(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
 	cacheKey := fmt.Sprintf("%s:race=%d", fisResultCCRacePrefix, raceID)
-	if h.cache != nil {
+	if h.cache != nil && format == utils.FormatJSON {
 		if raw, err := h.cache.Get(r.Context(), cacheKey); err == nil && raw != "" {
 			utils.WriteJSON(w, http.StatusOK, json.RawMessage(raw))
 			return
@@ -281,6 +289,11 @@ func (h *ResultCCHandler) GetRaceResultsCC(w http.ResponseWriter, r *http.Reques
 		out = append(out, FISResultCCFullFromSqlc(row))
 	}
 
+	if format != utils.FormatJSON {
+		utils.WriteTable(w, format, fmt.Sprintf("fis_results_cc_race_%d", raceID), out)
+		return
+	}
+
 	body := map[string]any{"results": out}
 	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, body, FISCacheTTL)
 	utils.WriteJSON(w, http.StatusOK, body)
@@ -291,11 +304,14 @@ func (h *ResultCCHandler) GetRaceResultsCC(w http.ResponseWriter, r *http.Reques
 //	@Summary	Get Cross-Country results for an athlete
 //	@Tags		FIS - Athlete
 //	@Accept		json
-//	@Produce	json
+//	@Produce	json,application/x-ndjson,text/csv,application/vnd.apache.parquet
 //	@Param		fiscode			query		int32		true	"FIS Code"
 //	@Param		seasoncode		query		[]int32		false	"Season code (repeat or comma-separated)"
 //	@Param		disciplinecode	query		[]string	false	"Discipline code (repeat or comma-separated)"
 //	@Param		catcode			query		[]string	false	"Category code (repeat or comma-separated)"
+//	@Param		limit			query		int			false	"Page size (default: 100, max: 1000)"
+//	@Param		cursor			query		string		false	"Opaque cursor from pagination.next_cursor of the previous page"
+//	@Param		format			query		string		false	"Response format: json (default), csv or parquet; also negotiated with Accept"
 //	@Success	200				{object}	swagger.FISAthleteResultsCCResponse
 //	@Failure	400				{object}	swagger.ValidationErrorResponse
 //	@Failure	401				{object}	swagger.UnauthorizedResponse
@@ -310,7 +326,7 @@ func (h *ResultCCHandler) GetAthleteResultsCC(w http.ResponseWriter, r *http.Req
 		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
 		return
 	}
-	if err := utils.ValidateParams(r, []string{"fiscode", "seasoncode", "disciplinecode", "catcode"}); err != nil {
+	if err := utils.ValidateParams(r, []string{"fiscode", "seasoncode", "disciplinecode", "catcode", "limit", "cursor", "format"}); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
@@ -352,26 +368,58 @@ func (h *ResultCCHandler) GetAthleteResultsCC(w http.ResponseWriter, r *http.Req
 		seasons = append(seasons, int32(n))
 	}
 
-	cacheKey := fmt.Sprintf("%s:fis=%d:sc=%v:dc=%v:cc=%v", fisResultCCAthletePrefix, fiscode, seasons, discs, cats)
-	if h.cache != nil {
+	page, err := utils.ParsePage(r, utils.DefaultPageLimits, utils.CursorTime|utils.CursorN)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	format, err := utils.NegotiateFormat(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	cacheKey := fmt.Sprintf("%s:fis=%d:sc=%v:dc=%v:cc=%v:%s", fisResultCCAthletePrefix, fiscode, seasons, discs, cats, page.CacheKey())
+	if h.cache != nil && format == utils.FormatJSON && !utils.WantsNDJSON(r) {
 		if raw, err := h.cache.Get(r.Context(), cacheKey); err == nil && raw != "" {
+			utils.SetNextLink(w, r, utils.CachedNextCursor(raw))
 			utils.WriteJSON(w, http.StatusOK, json.RawMessage(raw))
 			return
 		}
 	}
 
-	rows, err := h.store.GetAthleteResultsCC(r.Context(), competitorID, seasons, discs, cats)
+	rows, err := h.store.GetAthleteResultsCC(r.Context(), competitorID, seasons, discs, cats, page)
 	if err != nil {
 		utils.InternalServerError(w, r, err)
 		return
 	}
+	rows, pageInfo := utils.NextPage(rows, page, func(row fissqlc.GetAthleteResultsCCRow) utils.Cursor {
+		return fis.ResultCursor(row.Racedate, row.Recid)
+	})
+
+	if format != utils.FormatJSON {
+		out := make([]FISAthleteResultCCRow, 0, len(rows))
+		for _, row := range rows {
+			out = append(out, FISAthleteResultCCFromSqlc(row))
+		}
+		utils.SetNextLink(w, r, pageInfo.NextCursor)
+		utils.WriteTable(w, format, fmt.Sprintf("fis_results_cc_athlete_%d", fiscode), out)
+		return
+	}
 
-	out := make([]FISAthleteResultCCRow, 0, len(rows))
+	sw := utils.NewStreamWriter(w, r)
+	utils.SetNextLink(w, r, pageInfo.NextCursor)
+	sw.Array("results")
 	for _, row := range rows {
-		out = append(out, FISAthleteResultCCFromSqlc(row))
+		if err := sw.Row(FISAthleteResultCCFromSqlc(row)); err != nil {
+			return
+		}
+	}
+	sw.Field("pagination", pageInfo)
+	if err := sw.Close(); err != nil {
+		return
 	}
 
-	body := map[string]any{"results": out}
-	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, body, FISCacheTTL)
-	utils.WriteJSON(w, http.StatusOK, body)
+	cache.SetCacheStream(r.Context(), h.cache, cacheKey, sw, FISCacheTTL)
 }
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/fis"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
@@ -113,7 +114,7 @@ func (h *ResultNKHandler) InsertResultNK(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	invalidateResultNK(r.Context(), h.cache, clean.Recid)
+	invalidateResultNK(r.Context(), h.cache)
 	w.WriteHeader(http.StatusCreated)
 }
 
@@ -165,7 +166,7 @@ func (h *ResultNKHandler) UpdateResultNK(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	invalidateResultNK(r.Context(), h.cache, clean.Recid)
+	invalidateResultNK(r.Context(), h.cache)
 	w.WriteHeader(http.StatusOK)
 }
 
@@ -212,7 +213,7 @@ func (h *ResultNKHandler) DeleteResultNK(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	invalidateResultNK(r.Context(), h.cache, id)
+	invalidateResultNK(r.Context(), h.cache)
 	w.WriteHeader(http.StatusOK)
 }
 
@@ -221,8 +222,9 @@ func (h *ResultNKHandler) DeleteResultNK(w http.ResponseWriter, r *http.Request)
 //	@Summary	Get results for a Nordic Combined race
 //	@Tags		FIS - Race Results
 //	@Accept		json
-//	@Produce	json
+//	@Produce	json,text/csv,application/vnd.apache.parquet
 //	@Param		raceid	query		int32	true	"Race ID"
+//	@Param		format	query		string	false	"Response format: json (default), csv or parquet; also negotiated with Accept"
 //	@Success	200		{object}	swagger.FISRaceResultsNKResponse
 //	@Failure	400		{object}	swagger.ValidationErrorResponse
 //	@Failure	401		{object}	swagger.UnauthorizedResponse
@@ -236,7 +238,7 @@ func (h *ResultNKHandler) GetRaceResultsNK(w http.ResponseWriter, r *http.Reques
 		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
 		return
 	}
-	if err := utils.ValidateParams(r, []string{"raceid"}); err != nil {
+	if err := utils.ValidateParams(r, []string{"raceid", "format"}); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
@@ -248,8 +250,14 @@ func (h *ResultNKHandler) GetRaceResultsNK(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	format, err := utils.NegotiateFormat(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
 	cacheKey := fmt.Sprintf("%s:race=%d", fisResultNKRacePrefix, raceID)
-	if h.cache != nil {
+	if h.cache != nil && format == utils.FormatJSON {
 		if raw, err := h.cache.Get(r.Context(), cacheKey); err == nil && raw != "" {
 			utils.WriteJSON(w, http.StatusOK, json.RawMessage(raw))
 			return
@@ -272,6 +280,11 @@ func (h *ResultNKHandler) GetRaceResultsNK(w http.ResponseWriter, r *http.Reques
 		out = append(out, FISResultNKFullFromSqlc(row))
 	}
 
+	if format != utils.FormatJSON {
+		utils.WriteTable(w, format, fmt.Sprintf("fis_results_nk_race_%d", raceID), out)
+		return
+	}
+
 	body := map[string]any{"results": out}
 	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, body, FISCacheTTL)
 	utils.WriteJSON(w, http.StatusOK, body)
@@ -282,11 +295,14 @@ func (h *ResultNKHandler) GetRaceResultsNK(w http.ResponseWriter, r *http.Reques
 //	@Summary	Get Nordic Combined results for an athlete
 //	@Tags		FIS - Athlete
 //	@Accept		json
-//	@Produce	json
+//	@Produce	json,application/x-ndjson,text/csv,application/vnd.apache.parquet
 //	@Param		fiscode			query		int32		true	"FIS Code"
 //	@Param		seasoncode		query		[]int32		false	"Season code (repeat or comma-separated)"
 //	@Param		disciplinecode	query		[]string	false	"Discipline code (repeat or comma-separated)"
 //	@Param		catcode			query		[]string	false	"Category code (repeat or comma-separated)"
+//	@Param		limit			query		int			false	"Page size (default: 100, max: 1000)"
+//	@Param		cursor			query		string		false	"Opaque cursor from pagination.next_cursor of the previous page"
+//	@Param		format			query		string		false	"Response format: json (default), csv or parquet; also negotiated with Accept"
 //	@Success	200				{object}	swagger.FISAthleteResultsNKResponse
 //	@Failure	400				{object}	swagger.ValidationErrorResponse
 //	@Failure	401				{object}	swagger.UnauthorizedResponse
@@ -301,7 +317,7 @@ func (h *ResultNKHandler) GetAthleteResultsNK(w http.ResponseWriter, r *http.Req
 		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
 		return
 	}
-	if err := utils.ValidateParams(r, []string{"fiscode", "seasoncode", "disciplinecode", "catcode"}); err != nil {
+	if err := utils.ValidateParams(r, []string{"fiscode", "seasoncode", "disciplinecode", "catcode", "limit", "cursor", "format"}); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
@@ -343,26 +359,58 @@ func (h *ResultNKHandler) GetAthleteResultsNK(w http.ResponseWriter, r *http.Req
 		seasons = append(seasons, int32(n))
 	}
 
-	cacheKey := fmt.Sprintf("%s:fis=%d:sc=%v:dc=%v:cc=%v", fisResultNKAthletePrefix, fiscode, seasons, discs, cats)
-	if h.cache != nil {
+	page, err := utils.ParsePage(r, utils.DefaultPageLimits, utils.CursorTime|utils.CursorN)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	format, err := utils.NegotiateFormat(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	cacheKey := fmt.Sprintf("%s:fis=%d:sc=%v:dc=%v:cc=%v:%s", fisResultNKAthletePrefix, fiscode, seasons, discs, cats, page.CacheKey())
+	if h.cache != nil && format == utils.FormatJSON && !utils.WantsNDJSON(r) {
 		if raw, err := h.cache.Get(r.Context(), cacheKey); err == nil && raw != "" {
+			utils.SetNextLink(w, r, utils.CachedNextCursor(raw))
 			utils.WriteJSON(w, http.StatusOK, json.RawMessage(raw))
 			return
 		}
 	}
 
-	rows, err := h.store.GetAthleteResultsNK(r.Context(), competitorID, seasons, discs, cats)
+	rows, err := h.store.GetAthleteResultsNK(r.Context(), competitorID, seasons, discs, cats, page)
 	if err != nil {
 		utils.InternalServerError(w, r, err)
 		return
 	}
+	rows, pageInfo := utils.NextPage(rows, page, func(row fissqlc.GetAthleteResultsNKRow) utils.Cursor {
+		return fis.ResultCursor(row.Racedate, row.Recid)
+	})
+
+	if format != utils.FormatJSON {
+		out := make([]FISAthleteResultNKRow, 0, len(rows))
+		for _, row := range rows {
+			out = append(out, FISAthleteResultNKFromSqlc(row))
+		}
+		utils.SetNextLink(w, r, pageInfo.NextCursor)
+		utils.WriteTable(w, format, fmt.Sprintf("fis_results_nk_athlete_%d", fiscode), out)
+		return
+	}
 
-	out := make([]FISAthleteResultNKRow, 0, len(rows))
+	sw := utils.NewStreamWriter(w, r)
+	utils.SetNextLink(w, r, pageInfo.NextCursor)
+	sw.Array("results")
 	for _, row := range rows {
-		out = append(out, FISAthleteResultNKFromSqlc(row))
+		if err := sw.Row(FISAthleteResultNKFromSqlc(row)); err != nil {
+			return
+		}
+	}
+	sw.Field("pagination", pageInfo)
+	if err := sw.Close(); err != nil {
+		return
 	}
 
-	body := map[string]any{"results": out}
-	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, body, FISCacheTTL)
-	utils.WriteJSON(w, http.StatusOK, body)
+	cache.SetCacheStream(r.Context(), h.cache, cacheKey, sw, FISCacheTTL)
 }
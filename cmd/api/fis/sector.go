@@ -0,0 +1,365 @@
+package fisapi
+
+import (
+	"context"
+	"math"
+
+	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/store"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+// sectorCodes lists the sectors of the unified routes, in the order their
+// results are merged on the same race date
+var sectorCodes = []string{"cc", "jp", "nk"}
+
+// sector reads the races and results of one sector through its stores and
+// converts them to the common models
+type sector interface {
+	seasons(ctx context.Context) ([]int32, error)
+	disciplines(ctx context.Context) ([]string, error)
+	categories(ctx context.Context) ([]string, error)
+	races(ctx context.Context, seasons []int32, discs, cats []string, page utils.Page) ([]FISRace, error)
+	racesByIDs(ctx context.Context, raceIDs []int32) ([]FISRace, error)
+	raceResults(ctx context.Context, raceID int32) ([]FISResult, error)
+	enrichedResults(ctx context.Context, raceID int32) ([]FISEnrichedResult, error)
+	competitorID(ctx context.Context, fiscode int32) (int32, error)
+	athleteResults(ctx context.Context, competitorID int32, seasons []int32, discs, cats []string, page utils.Page) ([]FISAthleteResult, error)
+	// the stats and head-to-head rows of every sector have the columns of
+	// the CC ones
+	seasonStats(ctx context.Context, competitorID int32) ([]fissqlc.GetCompetitorSeasonStatsCCRow, error)
+	bestResults(ctx context.Context, competitorID int32) ([]FISBestResult, error)
+	headToHead(ctx context.Context, fiscodes, seasons []int32, discs, cats []string) ([]fissqlc.GetHeadToHeadCCRow, error)
+	pointsList(ctx context.Context, q fis.PointsListQuery) ([]FISPointsStanding, error)
+	// scoreKind tells how the results of the sector are ranked: "time" or
+	// "points"
+	scoreKind() string
+	// cache returns the cache prefixes of the sector, so the unified routes
+	// are invalidated together with the routes of the sector
+	cache() sectorCache
+}
+
+type sectorCache struct {
+	codes   string
+	races   string
+	results string
+	athlete string
+}
+
+// newSectors returns the sectors by code
+func newSectors(s store.FIS) map[string]sector {
+	return map[string]sector{
+		"cc": ccSector{raceStore: s.RaceCC(), resultStore: s.ResultCC(), competitors: s.Competitors()},
+		"jp": jpSector{raceStore: s.RaceJP(), resultStore: s.ResultJP(), competitors: s.Competitors()},
+		"nk": nkSector{raceStore: s.RaceNK(), resultStore: s.ResultNK(), competitors: s.Competitors()},
+	}
+}
+
+func convertRows[T, U any](rows []T, err error, f func(T) U) ([]U, error) {
+	if err != nil {
+		return nil, err
+	}
+	out := make([]U, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, f(row))
+	}
+	return out, nil
+}
+
+// afterInSector returns the page of one sector that continues the merged
+// athlete results after the cursor. On the race date of the cursor, the
+// results of the sectors before the cursor sector have all been returned and
+// those of the sectors after it not yet.
+func afterInSector(page utils.Page, code string) utils.Page {
+	if page.After == nil {
+		return page
+	}
+	n := *page.After.N
+	switch {
+	case code < *page.After.Key:
+		n = math.MaxInt32
+	case code > *page.After.Key:
+		n = -1
+	}
+	return utils.Page{
+		Limit: page.Limit,
+		After: &utils.Cursor{Time: page.After.Time, N: &n},
+	}
+}
+
+// mergedResultCursor is the position of a result in the results of all
+// sectors (race date, sector, RecID)
+func mergedResultCursor(row FISAthleteResult) utils.Cursor {
+	c := row.cursor
+	sector := row.Sector
+	c.Key = &sector
+	return c
+}
+
+// compareResults orders the results of all sectors as mergedResultCursor
+func compareResults(a, b FISAthleteResult) int {
+	if c := a.cursor.Time.Compare(*b.cursor.Time); c != 0 {
+		return c
+	}
+	if a.Sector != b.Sector {
+		if a.Sector < b.Sector {
+			return -1
+		}
+		return 1
+	}
+	return int(*a.cursor.N - *b.cursor.N)
+}
+
+type ccSector struct {
+	raceStore   fis.Racecc
+	resultStore fis.Resultcc
+	competitors fis.Competitors
+}
+
+func (s ccSector) seasons(ctx context.Context) ([]int32, error) {
+	return s.raceStore.GetCrossCountrySeasons(ctx)
+}
+
+func (s ccSector) disciplines(ctx context.Context) ([]string, error) {
+	return s.raceStore.GetCrossCountryDisciplines(ctx)
+}
+
+func (s ccSector) categories(ctx context.Context) ([]string, error) {
+	return s.raceStore.GetCrossCountryCategories(ctx)
+}
+
+func (s ccSector) races(ctx context.Context, seasons []int32, discs, cats []string, page utils.Page) ([]FISRace, error) {
+	rows, err := s.raceStore.GetRacesCC(ctx, seasons, discs, cats, page)
+	return convertRows(rows, err, raceFromCC)
+}
+
+func (s ccSector) racesByIDs(ctx context.Context, raceIDs []int32) ([]FISRace, error) {
+	rows, err := s.raceStore.GetRacesByIDsCC(ctx, raceIDs)
+	return convertRows(rows, err, raceFromCC)
+}
+
+func (s ccSector) raceResults(ctx context.Context, raceID int32) ([]FISResult, error) {
+	rows, err := s.resultStore.GetRaceResultsCCByRaceID(ctx, raceID)
+	return convertRows(rows, err, resultFromCC)
+}
+
+func (s ccSector) enrichedResults(ctx context.Context, raceID int32) ([]FISEnrichedResult, error) {
+	rows, err := s.resultStore.GetEnrichedRaceResultsCC(ctx, raceID)
+	return convertRows(rows, err, enrichedResultFromCC)
+}
+
+func (s ccSector) competitorID(ctx context.Context, fiscode int32) (int32, error) {
+	return s.competitors.GetCompetitorIDByFiscodeCC(ctx, fiscode)
+}
+
+func (s ccSector) athleteResults(ctx context.Context, competitorID int32, seasons []int32, discs, cats []string, page utils.Page) ([]FISAthleteResult, error) {
+	rows, err := s.resultStore.GetAthleteResultsCC(ctx, competitorID, seasons, discs, cats, page)
+	return convertRows(rows, err, athleteResultFromCC)
+}
+
+func (s ccSector) seasonStats(ctx context.Context, competitorID int32) ([]fissqlc.GetCompetitorSeasonStatsCCRow, error) {
+	return s.resultStore.GetCompetitorSeasonStatsCC(ctx, competitorID)
+}
+
+func (s ccSector) bestResults(ctx context.Context, competitorID int32) ([]FISBestResult, error) {
+	rows, err := s.resultStore.GetCompetitorBestResultsCC(ctx, competitorID)
+	return convertRows(rows, err, bestResultFromSqlc)
+}
+
+func (s ccSector) headToHead(ctx context.Context, fiscodes, seasons []int32, discs, cats []string) ([]fissqlc.GetHeadToHeadCCRow, error) {
+	return s.resultStore.GetHeadToHeadCC(ctx, fiscodes, seasons, discs, cats)
+}
+
+func (s ccSector) pointsList(ctx context.Context, q fis.PointsListQuery) ([]FISPointsStanding, error) {
+	rows, err := s.resultStore.GetFISPointsListCC(ctx, q)
+	return convertRows(rows, err, pointsStandingFromSqlc)
+}
+
+func (s ccSector) scoreKind() string {
+	return scoreKindTime
+}
+
+func (s ccSector) cache() sectorCache {
+	return sectorCache{
+		codes:   fisRaceCCCodesPrefix,
+		races:   fisRaceCCListPrefix,
+		results: fisResultCCRacePrefix,
+		athlete: fisResultCCAthletePrefix,
+	}
+}
+
+type jpSector struct {
+	raceStore   fis.Racejp
+	resultStore fis.Resultjp
+	competitors fis.Competitors
+}
+
+func (s jpSector) seasons(ctx context.Context) ([]int32, error) {
+	return s.raceStore.GetSkiJumpingSeasons(ctx)
+}
+
+func (s jpSector) disciplines(ctx context.Context) ([]string, error) {
+	return s.raceStore.GetSkiJumpingDisciplines(ctx)
+}
+
+func (s jpSector) categories(ctx context.Context) ([]string, error) {
+	return s.raceStore.GetSkiJumpingCategories(ctx)
+}
+
+func (s jpSector) races(ctx context.Context, seasons []int32, discs, cats []string, page utils.Page) ([]FISRace, error) {
+	rows, err := s.raceStore.GetRacesJP(ctx, seasons, discs, cats, page)
+	return convertRows(rows, err, raceFromJP)
+}
+
+func (s jpSector) racesByIDs(ctx context.Context, raceIDs []int32) ([]FISRace, error) {
+	rows, err := s.raceStore.GetRacesByIDsJP(ctx, raceIDs)
+	return convertRows(rows, err, raceFromJP)
+}
+
+func (s jpSector) raceResults(ctx context.Context, raceID int32) ([]FISResult, error) {
+	rows, err := s.resultStore.GetRaceResultsJPByRaceID(ctx, raceID)
+	return convertRows(rows, err, resultFromJP)
+}
+
+func (s jpSector) enrichedResults(ctx context.Context, raceID int32) ([]FISEnrichedResult, error) {
+	rows, err := s.resultStore.GetEnrichedRaceResultsJP(ctx, raceID)
+	return convertRows(rows, err, enrichedResultFromJP)
+}
+
+func (s jpSector) competitorID(ctx context.Context, fiscode int32) (int32, error) {
+	return s.competitors.GetCompetitorIDByFiscodeJP(ctx, fiscode)
+}
+
+func (s jpSector) athleteResults(ctx context.Context, competitorID int32, seasons []int32, discs, cats []string, page utils.Page) ([]FISAthleteResult, error) {
+	rows, err := s.resultStore.GetAthleteResultsJP(ctx, competitorID, seasons, discs, cats, page)
+	return convertRows(rows, err, athleteResultFromJP)
+}
+
+func (s jpSector) seasonStats(ctx context.Context, competitorID int32) ([]fissqlc.GetCompetitorSeasonStatsCCRow, error) {
+	rows, err := s.resultStore.GetCompetitorSeasonStatsJP(ctx, competitorID)
+	return convertRows(rows, err, func(row fissqlc.GetCompetitorSeasonStatsJPRow) fissqlc.GetCompetitorSeasonStatsCCRow {
+		return fissqlc.GetCompetitorSeasonStatsCCRow(row)
+	})
+}
+
+func (s jpSector) bestResults(ctx context.Context, competitorID int32) ([]FISBestResult, error) {
+	rows, err := s.resultStore.GetCompetitorBestResultsJP(ctx, competitorID)
+	return convertRows(rows, err, func(row fissqlc.GetCompetitorBestResultsJPRow) FISBestResult {
+		return bestResultFromSqlc(fissqlc.GetCompetitorBestResultsCCRow(row))
+	})
+}
+
+func (s jpSector) headToHead(ctx context.Context, fiscodes, seasons []int32, discs, cats []string) ([]fissqlc.GetHeadToHeadCCRow, error) {
+	rows, err := s.resultStore.GetHeadToHeadJP(ctx, fiscodes, seasons, discs, cats)
+	return convertRows(rows, err, func(row fissqlc.GetHeadToHeadJPRow) fissqlc.GetHeadToHeadCCRow {
+		return fissqlc.GetHeadToHeadCCRow(row)
+	})
+}
+
+// pointsList has no JP list: ski jumping ranks by scored points, not by
+// race points
+func (s jpSector) pointsList(ctx context.Context, q fis.PointsListQuery) ([]FISPointsStanding, error) {
+	return nil, errNoPointsList
+}
+
+func (s jpSector) scoreKind() string {
+	return scoreKindPoints
+}
+
+func (s jpSector) cache() sectorCache {
+	return sectorCache{
+		codes:   fisRaceJPCodesPrefix,
+		races:   fisRaceJPListPrefix,
+		results: fisResultJPRacePrefix,
+		athlete: fisResultJPAthletePrefix,
+	}
+}
+
+type nkSector struct {
+	raceStore   fis.Racenk
+	resultStore fis.Resultnk
+	competitors fis.Competitors
+}
+
+func (s nkSector) seasons(ctx context.Context) ([]int32, error) {
+	return s.raceStore.GetNordicCombinedSeasons(ctx)
+}
+
+func (s nkSector) disciplines(ctx context.Context) ([]string, error) {
+	return s.raceStore.GetNordicCombinedDisciplines(ctx)
+}
+
+func (s nkSector) categories(ctx context.Context) ([]string, error) {
+	return s.raceStore.GetNordicCombinedCategories(ctx)
+}
+
+func (s nkSector) races(ctx context.Context, seasons []int32, discs, cats []string, page utils.Page) ([]FISRace, error) {
+	rows, err := s.raceStore.GetRacesNK(ctx, seasons, discs, cats, page)
+	return convertRows(rows, err, raceFromNK)
+}
+
+func (s nkSector) racesByIDs(ctx context.Context, raceIDs []int32) ([]FISRace, error) {
+	rows, err := s.raceStore.GetRacesByIDsNK(ctx, raceIDs)
+	return convertRows(rows, err, raceFromNK)
+}
+
+func (s nkSector) raceResults(ctx context.Context, raceID int32) ([]FISResult, error) {
+	rows, err := s.resultStore.GetRaceResultsNKByRaceID(ctx, raceID)
+	return convertRows(rows, err, resultFromNK)
+}
+
+func (s nkSector) enrichedResults(ctx context.Context, raceID int32) ([]FISEnrichedResult, error) {
+	rows, err := s.resultStore.GetEnrichedRaceResultsNK(ctx, raceID)
+	return convertRows(rows, err, enrichedResultFromNK)
+}
+
+func (s nkSector) competitorID(ctx context.Context, fiscode int32) (int32, error) {
+	return s.competitors.GetCompetitorIDByFiscodeNK(ctx, fiscode)
+}
+
+func (s nkSector) athleteResults(ctx context.Context, competitorID int32, seasons []int32, discs, cats []string, page utils.Page) ([]FISAthleteResult, error) {
+	rows, err := s.resultStore.GetAthleteResultsNK(ctx, competitorID, seasons, discs, cats, page)
+	return convertRows(rows, err, athleteResultFromNK)
+}
+
+func (s nkSector) seasonStats(ctx context.Context, competitorID int32) ([]fissqlc.GetCompetitorSeasonStatsCCRow, error) {
+	rows, err := s.resultStore.GetCompetitorSeasonStatsNK(ctx, competitorID)
+	return convertRows(rows, err, func(row fissqlc.GetCompetitorSeasonStatsNKRow) fissqlc.GetCompetitorSeasonStatsCCRow {
+		return fissqlc.GetCompetitorSeasonStatsCCRow(row)
+	})
+}
+
+func (s nkSector) bestResults(ctx context.Context, competitorID int32) ([]FISBestResult, error) {
+	rows, err := s.resultStore.GetCompetitorBestResultsNK(ctx, competitorID)
+	return convertRows(rows, err, func(row fissqlc.GetCompetitorBestResultsNKRow) FISBestResult {
+		return bestResultFromSqlc(fissqlc.GetCompetitorBestResultsCCRow(row))
+	})
+}
+
+func (s nkSector) headToHead(ctx context.Context, fiscodes, seasons []int32, discs, cats []string) ([]fissqlc.GetHeadToHeadCCRow, error) {
+	rows, err := s.resultStore.GetHeadToHeadNK(ctx, fiscodes, seasons, discs, cats)
+	return convertRows(rows, err, func(row fissqlc.GetHeadToHeadNKRow) fissqlc.GetHeadToHeadCCRow {
+		return fissqlc.GetHeadToHeadCCRow(row)
+	})
+}
+
+func (s nkSector) pointsList(ctx context.Context, q fis.PointsListQuery) ([]FISPointsStanding, error) {
+	rows, err := s.resultStore.GetFISPointsListNK(ctx, q)
+	return convertRows(rows, err, func(row fissqlc.GetFISPointsListNKRow) FISPointsStanding {
+		return pointsStandingFromSqlc(fissqlc.GetFISPointsListCCRow(row))
+	})
+}
+
+func (s nkSector) scoreKind() string {
+	return scoreKindTime
+}
+
+func (s nkSector) cache() sectorCache {
+	return sectorCache{
+		codes:   fisRaceNKCodesPrefix,
+		races:   fisRaceNKListPrefix,
+		results: fisResultNKRacePrefix,
+		athlete: fisResultNKAthletePrefix,
+	}
+}
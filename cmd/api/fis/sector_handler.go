@@ -0,0 +1,554 @@
+package fisapi
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	"github.com/DeRuina/KUHA-REST-API/internal/store"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+// SectorHandler serves the /fis/{sector} routes, which return the races and
+// results of every sector in the same shape
+type SectorHandler struct {
+	sectors map[string]sector
+	cache   *cache.Storage
+}
+
+func NewSectorHandler(store store.FIS, cache *cache.Storage) *SectorHandler {
+	return &SectorHandler{sectors: newSectors(store), cache: cache}
+}
+
+// sector returns the sector of the path, answering 400 if it is unknown
+func (h *SectorHandler) sector(w http.ResponseWriter, r *http.Request) (sector, bool) {
+	s, ok := h.sectors[strings.ToLower(chi.URLParam(r, "sector"))]
+	if !ok {
+		utils.BadRequestResponse(w, r, utils.ErrInvalidSectorCode)
+	}
+	return s, ok
+}
+
+// parseSectors reads the sectors filter (repeated or comma-separated),
+// answering 400 if a sector is unknown. No filter means every sector.
+func (h *SectorHandler) parseSectors(w http.ResponseWriter, r *http.Request) ([]string, bool) {
+	requested := parseListParam(r, "sectors")
+	if len(requested) == 0 {
+		return sectorCodes, true
+	}
+	var codes []string
+	for _, c := range requested {
+		c = strings.ToLower(strings.TrimSpace(c))
+		if _, ok := h.sectors[c]; !ok {
+			utils.BadRequestResponse(w, r, utils.ErrInvalidSectorCode)
+			return nil, false
+		}
+		if !slices.Contains(codes, c) {
+			codes = append(codes, c)
+		}
+	}
+	return codes, true
+}
+
+// parseSeasonCodes reads the seasoncode filter (repeated or comma-separated)
+func parseSeasonCodes(r *http.Request) ([]int32, error) {
+	var seasons []int32
+	for _, s := range parseListParam(r, "seasoncode") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid seasoncode: %s", s)
+		}
+		seasons = append(seasons, int32(n))
+	}
+	return seasons, nil
+}
+
+// GetSectorSeasons godoc
+//
+//	@Summary	Get the season codes of a sector
+//	@Tags		FIS - Sectors
+//	@Accept		json
+//	@Produce	json
+//	@Param		sector	path		string	true	"Sector code"	Enums(cc, jp, nk)
+//	@Success	200		{object}	swagger.FISSeasonsCCResponse
+//	@Failure	400		{object}	swagger.ValidationErrorResponse
+//	@Failure	401		{object}	swagger.UnauthorizedResponse
+//	@Failure	403		{object}	swagger.ForbiddenResponse
+//	@Failure	500		{object}	swagger.InternalServerErrorResponse
+//	@Failure	503		{object}	swagger.ServiceUnavailableResponse
+//	@Security	BearerAuth
+//	@Router		/fis/{sector}/seasons [get]
+func (h *SectorHandler) GetSectorSeasons(w http.ResponseWriter, r *http.Request) {
+	h.getCodes(w, r, "seasons", func(s sector, r *http.Request) (any, error) {
+		return s.seasons(r.Context())
+	})
+}
+
+// GetSectorDisciplines godoc
+//
+//	@Summary	Get the discipline codes of a sector
+//	@Tags		FIS - Sectors
+//	@Accept		json
+//	@Produce	json
+//	@Param		sector	path		string	true	"Sector code"	Enums(cc, jp, nk)
+//	@Success	200		{object}	swagger.FISDisciplinesCCResponse
+//	@Failure	400		{object}	swagger.ValidationErrorResponse
+//	@Failure	401		{object}	swagger.UnauthorizedResponse
+//	@Failure	403		{object}	swagger.ForbiddenResponse
+//	@Failure	500		{object}	swagger.InternalServerErrorResponse
+//	@Failure	503		{object}	swagger.ServiceUnavailableResponse
+//	@Security	BearerAuth
+//	@Router		/fis/{sector}/disciplines [get]
+func (h *SectorHandler) GetSectorDisciplines(w http.ResponseWriter, r *http.Request) {
+	h.getCodes(w, r, "disciplines", func(s sector, r *http.Request) (any, error) {
+		return s.disciplines(r.Context())
+	})
+}
+
+// GetSectorCategories godoc
+//
+//	@Summary	Get the category codes of a sector
+//	@Tags		FIS - Sectors
+//	@Accept		json
+//	@Produce	json
+//	@Param		sector	path		string	true	"Sector code"	Enums(cc, jp, nk)
+//	@Success	200		{object}	swagger.FISCategoriesCCResponse
+//	@Failure	400		{object}	swagger.ValidationErrorResponse
+//	@Failure	401		{object}	swagger.UnauthorizedResponse
+//	@Failure	403		{object}	swagger.ForbiddenResponse
+//	@Failure	500		{object}	swagger.InternalServerErrorResponse
+//	@Failure	503		{object}	swagger.ServiceUnavailableResponse
+//	@Security	BearerAuth
+//	@Router		/fis/{sector}/categories [get]
+func (h *SectorHandler) GetSectorCategories(w http.ResponseWriter, r *http.Request) {
+	h.getCodes(w, r, "categories", func(s sector, r *http.Request) (any, error) {
+		return s.categories(r.Context())
+	})
+}
+
+// getCodes answers with a code list of the sector. The body is the same as
+// on the /seasoncodeXX, /disciplinecodeXX and /catcodeXX routes, so the
+// cache entries are shared.
+func (h *SectorHandler) getCodes(w http.ResponseWriter, r *http.Request, name string, list func(sector, *http.Request) (any, error)) {
+	if !authz.Authorize(r) {
+		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
+		return
+	}
+	s, ok := h.sector(w, r)
+	if !ok {
+		return
+	}
+
+	cacheKey := fmt.Sprintf("%s:%s", s.cache().codes, name)
+	if h.cache != nil {
+		if raw, err := h.cache.Get(r.Context(), cacheKey); err == nil && raw != "" {
+			utils.WriteJSON(w, http.StatusOK, json.RawMessage(raw))
+			return
+		}
+	}
+
+	rows, err := list(s, r)
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+
+	body := map[string]any{name: rows}
+	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, body, FISCacheTTL)
+	utils.WriteJSON(w, http.StatusOK, body)
+}
+
+// GetSectorRaces godoc
+//
+//	@Summary		Get list of races of a sector
+//	@Description	Returns the races of the sector in a common shape; details holds the race with the fields of the sector, as on /racecc, /racejp or /racenk.
+//	@Tags			FIS - Sectors
+//	@Accept			json
+//	@Produce		json
+//	@Param			sector			path		string		true	"Sector code"	Enums(cc, jp, nk)
+//	@Param			seasoncode		query		[]int32		false	"Season code (repeat or comma-separated)"
+//	@Param			disciplinecode	query		[]string	false	"Discipline code (repeat or comma-separated)"
+//	@Param			catcode			query		[]string	false	"Category code (repeat or comma-separated)"
+//	@Param			limit			query		int			false	"Page size (default: 100, max: 1000)"
+//	@Param			cursor			query		string		false	"Opaque cursor from pagination.next_cursor of the previous page"
+//	@Success		200				{object}	swagger.FISRacesResponse
+//	@Failure		400				{object}	swagger.ValidationErrorResponse
+//	@Failure		401				{object}	swagger.UnauthorizedResponse
+//	@Failure		403				{object}	swagger.ForbiddenResponse
+//	@Failure		500				{object}	swagger.InternalServerErrorResponse
+//	@Failure		503				{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/fis/{sector}/races [get]
+func (h *SectorHandler) GetSectorRaces(w http.ResponseWriter, r *http.Request) {
+	if !authz.Authorize(r) {
+		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
+		return
+	}
+	if err := utils.ValidateParams(r, []string{"seasoncode", "disciplinecode", "catcode", "limit", "cursor"}); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	s, ok := h.sector(w, r)
+	if !ok {
+		return
+	}
+
+	seasons, err := parseSeasonCodes(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	discs := parseListParam(r, "disciplinecode")
+	cats := parseListParam(r, "catcode")
+
+	page, err := utils.ParsePage(r, utils.DefaultPageLimits, utils.CursorN)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	cacheKey := fmt.Sprintf("%s:sector:sc=%v:dc=%v:cc=%v:%s", s.cache().races, seasons, discs, cats, page.CacheKey())
+	if h.cache != nil {
+		if raw, err := h.cache.Get(r.Context(), cacheKey); err == nil && raw != "" {
+			utils.SetNextLink(w, r, utils.CachedNextCursor(raw))
+			utils.WriteJSON(w, http.StatusOK, json.RawMessage(raw))
+			return
+		}
+	}
+
+	rows, err := s.races(r.Context(), seasons, discs, cats, page)
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+	rows, pageInfo := utils.NextPage(rows, page, func(row FISRace) utils.Cursor {
+		return utils.IntCursor(int64(row.Raceid))
+	})
+
+	body := map[string]any{"races": rows, "pagination": pageInfo}
+	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, body, FISCacheTTL)
+	utils.SetNextLink(w, r, pageInfo.NextCursor)
+	utils.WriteJSON(w, http.StatusOK, body)
+}
+
+// GetSectorRace godoc
+//
+//	@Summary	Get a race of a sector
+//	@Tags		FIS - Sectors
+//	@Accept		json
+//	@Produce	json
+//	@Param		sector	path		string	true	"Sector code"	Enums(cc, jp, nk)
+//	@Param		raceid	path		int32	true	"Race ID"
+//	@Success	200		{object}	swagger.FISRaceEnvelope
+//	@Failure	400		{object}	swagger.ValidationErrorResponse
+//	@Failure	401		{object}	swagger.UnauthorizedResponse
+//	@Failure	403		{object}	swagger.ForbiddenResponse
+//	@Failure	404		{object}	swagger.NotFoundResponse
+//	@Failure	500		{object}	swagger.InternalServerErrorResponse
+//	@Failure	503		{object}	swagger.ServiceUnavailableResponse
+//	@Security	BearerAuth
+//	@Router		/fis/{sector}/races/{raceid} [get]
+func (h *SectorHandler) GetSectorRace(w http.ResponseWriter, r *http.Request) {
+	if !authz.Authorize(r) {
+		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
+		return
+	}
+	s, ok := h.sector(w, r)
+	if !ok {
+		return
+	}
+
+	raceID, err := utils.ParsePositiveInt32(chi.URLParam(r, "raceid"))
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	cacheKey := fmt.Sprintf("%s:sector:race=%d", s.cache().races, raceID)
+	if h.cache != nil {
+		if raw, err := h.cache.Get(r.Context(), cacheKey); err == nil && raw != "" {
+			utils.WriteJSON(w, http.StatusOK, json.RawMessage(raw))
+			return
+		}
+	}
+
+	rows, err := s.racesByIDs(r.Context(), []int32{raceID})
+	if err != nil {
+		utils.HandleDatabaseError(w, r, err)
+		return
+	}
+	if len(rows) == 0 {
+		utils.NotFoundResponse(w, r, fmt.Errorf("race %d not found", raceID))
+		return
+	}
+
+	body := map[string]any{"race": rows[0]}
+	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, body, FISCacheTTL)
+	utils.WriteJSON(w, http.StatusOK, body)
+}
+
+// GetSectorRaceResults godoc
+//
+//	@Summary		Get the results of a race of a sector
+//	@Description	Returns the results of the race in a common shape; position and bib are strings in every sector, and details holds the result with the fields of the sector, as on /resultcc, /resultjp or /resultnk.
+//	@Tags			FIS - Sectors
+//	@Accept			json
+//	@Produce		json
+//	@Param			sector	path		string	true	"Sector code"	Enums(cc, jp, nk)
+//	@Param			raceid	path		int32	true	"Race ID"
+//	@Success		200		{object}	swagger.FISResultsResponse
+//	@Failure		400		{object}	swagger.ValidationErrorResponse
+//	@Failure		401		{object}	swagger.UnauthorizedResponse
+//	@Failure		403		{object}	swagger.ForbiddenResponse
+//	@Failure		404		{object}	swagger.NotFoundResponse
+//	@Failure		500		{object}	swagger.InternalServerErrorResponse
+//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/fis/{sector}/races/{raceid}/results [get]
+func (h *SectorHandler) GetSectorRaceResults(w http.ResponseWriter, r *http.Request) {
+	if !authz.Authorize(r) {
+		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
+		return
+	}
+	s, ok := h.sector(w, r)
+	if !ok {
+		return
+	}
+
+	raceID, err := utils.ParsePositiveInt32(chi.URLParam(r, "raceid"))
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	cacheKey := fmt.Sprintf("%s:sector:race=%d", s.cache().results, raceID)
+	if h.cache != nil {
+		if raw, err := h.cache.Get(r.Context(), cacheKey); err == nil && raw != "" {
+			utils.WriteJSON(w, http.StatusOK, json.RawMessage(raw))
+			return
+		}
+	}
+
+	rows, err := s.raceResults(r.Context(), raceID)
+	if err != nil {
+		utils.HandleDatabaseError(w, r, err)
+		return
+	}
+	if len(rows) == 0 {
+		utils.NotFoundResponse(w, r, fmt.Errorf("no results found for raceid %d", raceID))
+		return
+	}
+
+	body := map[string]any{"results": rows}
+	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, body, FISCacheTTL)
+	utils.WriteJSON(w, http.StatusOK, body)
+}
+
+// GetSectorAthleteResults godoc
+//
+//	@Summary	Get the results of an athlete in a sector
+//	@Tags		FIS - Sectors
+//	@Accept		json
+//	@Produce	json
+//	@Param		sector			path		string		true	"Sector code"	Enums(cc, jp, nk)
+//	@Param		fiscode			query		int32		true	"FIS Code"
+//	@Param		seasoncode		query		[]int32		false	"Season code (repeat or comma-separated)"
+//	@Param		disciplinecode	query		[]string	false	"Discipline code (repeat or comma-separated)"
+//	@Param		catcode			query		[]string	false	"Category code (repeat or comma-separated)"
+//	@Param		limit			query		int			false	"Page size (default: 100, max: 1000)"
+//	@Param		cursor			query		string		false	"Opaque cursor from pagination.next_cursor of the previous page"
+//	@Success	200				{object}	swagger.FISAthleteResultsResponse
+//	@Failure	400				{object}	swagger.ValidationErrorResponse
+//	@Failure	401				{object}	swagger.UnauthorizedResponse
+//	@Failure	403				{object}	swagger.ForbiddenResponse
+//	@Failure	404				{object}	swagger.NotFoundResponse
+//	@Failure	500				{object}	swagger.InternalServerErrorResponse
+//	@Failure	503				{object}	swagger.ServiceUnavailableResponse
+//	@Security	BearerAuth
+//	@Router		/fis/{sector}/results [get]
+func (h *SectorHandler) GetSectorAthleteResults(w http.ResponseWriter, r *http.Request) {
+	if !authz.Authorize(r) {
+		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
+		return
+	}
+	if err := utils.ValidateParams(r, []string{"fiscode", "seasoncode", "disciplinecode", "catcode", "limit", "cursor"}); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	s, ok := h.sector(w, r)
+	if !ok {
+		return
+	}
+
+	fiscode, err := utils.ParsePositiveInt32(r.URL.Query().Get("fiscode"))
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	seasons, err := parseSeasonCodes(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	discs := parseListParam(r, "disciplinecode")
+	cats := parseListParam(r, "catcode")
+
+	page, err := utils.ParsePage(r, utils.DefaultPageLimits, utils.CursorTime|utils.CursorN)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	competitorID, err := s.competitorID(r.Context(), fiscode)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			utils.NotFoundResponse(w, r, fmt.Errorf("competitor with FIS code %d not found", fiscode))
+			return
+		}
+		utils.InternalServerError(w, r, err)
+		return
+	}
+
+	cacheKey := fmt.Sprintf("%s:sector:fis=%d:sc=%v:dc=%v:cc=%v:%s", s.cache().athlete, fiscode, seasons, discs, cats, page.CacheKey())
+	if h.cache != nil {
+		if raw, err := h.cache.Get(r.Context(), cacheKey); err == nil && raw != "" {
+			utils.SetNextLink(w, r, utils.CachedNextCursor(raw))
+			utils.WriteJSON(w, http.StatusOK, json.RawMessage(raw))
+			return
+		}
+	}
+
+	rows, err := s.athleteResults(r.Context(), competitorID, seasons, discs, cats, page)
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+	rows, pageInfo := utils.NextPage(rows, page, func(row FISAthleteResult) utils.Cursor {
+		return row.cursor
+	})
+
+	body := map[string]any{"results": rows, "pagination": pageInfo}
+	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, body, FISCacheTTL)
+	utils.SetNextLink(w, r, pageInfo.NextCursor)
+	utils.WriteJSON(w, http.StatusOK, body)
+}
+
+// GetAthleteResultsAllSectors godoc
+//
+//	@Summary		Get the results of an athlete in every sector
+//	@Description	Returns the results of the FIS code in the sectors it competes in, e.g. a Nordic combined athlete who also has Cross-Country or Ski Jumping results, ordered by race date. competitors maps each sector the athlete was found in to the competitor ID there.
+//	@Tags			FIS - Sectors
+//	@Accept			json
+//	@Produce		json
+//	@Param			fiscode			path		int32		true	"FIS Code"
+//	@Param			sectors			query		[]string	false	"Sector codes (repeat or comma-separated; default: all)"
+//	@Param			seasoncode		query		[]int32		false	"Season code (repeat or comma-separated)"
+//	@Param			disciplinecode	query		[]string	false	"Discipline code (repeat or comma-separated)"
+//	@Param			catcode			query		[]string	false	"Category code (repeat or comma-separated)"
+//	@Param			limit			query		int			false	"Page size (default: 100, max: 1000)"
+//	@Param			cursor			query		string		false	"Opaque cursor from pagination.next_cursor of the previous page"
+//	@Success		200				{object}	swagger.FISCrossSectorResultsResponse
+//	@Failure		400				{object}	swagger.ValidationErrorResponse
+//	@Failure		401				{object}	swagger.UnauthorizedResponse
+//	@Failure		403				{object}	swagger.ForbiddenResponse
+//	@Failure		404				{object}	swagger.NotFoundResponse
+//	@Failure		500				{object}	swagger.InternalServerErrorResponse
+//	@Failure		503				{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/fis/athletes/{fiscode}/results [get]
+func (h *SectorHandler) GetAthleteResultsAllSectors(w http.ResponseWriter, r *http.Request) {
+	if !authz.Authorize(r) {
+		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
+		return
+	}
+	if err := utils.ValidateParams(r, []string{"sectors", "seasoncode", "disciplinecode", "catcode", "limit", "cursor"}); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	fiscode, err := utils.ParsePositiveInt32(chi.URLParam(r, "fiscode"))
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	codes, ok := h.parseSectors(w, r)
+	if !ok {
+		return
+	}
+
+	seasons, err := parseSeasonCodes(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	discs := parseListParam(r, "disciplinecode")
+	cats := parseListParam(r, "catcode")
+
+	page, err := utils.ParsePage(r, utils.DefaultPageLimits, utils.CursorTime|utils.CursorN|utils.CursorKey)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	if page.After != nil && !slices.Contains(sectorCodes, *page.After.Key) {
+		utils.BadRequestResponse(w, r, utils.ErrInvalidCursor)
+		return
+	}
+
+	competitors := map[string]int32{}
+	var rows []FISAthleteResult
+	for _, code := range codes {
+		s := h.sectors[code]
+		competitorID, err := s.competitorID(r.Context(), fiscode)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			utils.InternalServerError(w, r, err)
+			return
+		}
+		competitors[code] = competitorID
+
+		sectorRows, err := s.athleteResults(r.Context(), competitorID, seasons, discs, cats, afterInSector(page, code))
+		if err != nil {
+			utils.InternalServerError(w, r, err)
+			return
+		}
+		rows = append(rows, sectorRows...)
+	}
+	if len(competitors) == 0 {
+		utils.NotFoundResponse(w, r, fmt.Errorf("competitor with FIS code %d not found", fiscode))
+		return
+	}
+
+	// every sector returned up to a page and one row, so the first rows of
+	// the merge are the page and the row telling whether more follow
+	slices.SortFunc(rows, compareResults)
+	if len(rows) > int(page.FetchLimit()) {
+		rows = rows[:page.FetchLimit()]
+	}
+	rows, pageInfo := utils.NextPage(rows, page, mergedResultCursor)
+	if rows == nil {
+		rows = []FISAthleteResult{}
+	}
+
+	utils.SetNextLink(w, r, pageInfo.NextCursor)
+	utils.WriteJSON(w, http.StatusOK, map[string]any{
+		"fiscode":     fiscode,
+		"competitors": competitors,
+		"results":     rows,
+		"pagination":  pageInfo,
+	})
+}
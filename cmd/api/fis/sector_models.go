@@ -0,0 +1,238 @@
+package fisapi
+
+import (
+	"strconv"
+
+	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+// FISRace is a race of any sector. Details holds the race as returned by
+// the /racecc, /racejp and /racenk routes, with the fields of the sector.
+type FISRace struct {
+	Sector         string  `json:"sector"`
+	Raceid         int32   `json:"raceid"`
+	Eventid        *int32  `json:"eventid"`
+	Seasoncode     *int32  `json:"seasoncode"`
+	Disciplinecode *string `json:"disciplinecode"`
+	Catcode        *string `json:"catcode"`
+	Gender         *string `json:"gender"`
+	Racedate       *string `json:"racedate"`
+	Description    *string `json:"description"`
+	Place          *string `json:"place"`
+	Nationcode     *string `json:"nationcode"`
+	Lastupdate     *string `json:"lastupdate"`
+	Details        any     `json:"details"`
+}
+
+// FISResult is a result of any sector. Position and bib are strings, as in
+// Cross-Country. Details holds the result as returned by the /resultcc,
+// /resultjp and /resultnk routes, with the fields of the sector.
+type FISResult struct {
+	Sector         string  `json:"sector"`
+	Recid          int32   `json:"recid"`
+	Raceid         *int32  `json:"raceid"`
+	Competitorid   *int32  `json:"competitorid"`
+	Fiscode        *int32  `json:"fiscode"`
+	Competitorname *string `json:"competitorname"`
+	Nationcode     *string `json:"nationcode"`
+	Status         *string `json:"status"`
+	Position       *string `json:"position"`
+	Bib            *string `json:"bib"`
+	Racepoints     *string `json:"racepoints"`
+	Cuppoints      *string `json:"cuppoints"`
+	Lastupdate     *string `json:"lastupdate"`
+	Details        any     `json:"details"`
+}
+
+// FISAthleteResult is a result of an athlete with its race, in any sector.
+// Details holds the row as returned by the /resultathlete* routes.
+type FISAthleteResult struct {
+	Sector         string  `json:"sector"`
+	Recid          int32   `json:"recid"`
+	Raceid         *int32  `json:"raceid"`
+	Position       *string `json:"position"`
+	Racedate       *string `json:"racedate"`
+	Seasoncode     *int32  `json:"seasoncode"`
+	Disciplinecode *string `json:"disciplinecode"`
+	Catcode        *string `json:"catcode"`
+	Place          *string `json:"place"`
+	Details        any     `json:"details"`
+
+	// position of the row in the athlete results, see fis.ResultCursor
+	cursor utils.Cursor
+}
+
+func raceFromCC(row fissqlc.ARacecc) FISRace {
+	d := FISRaceCCFullFromSqlc(row)
+	return FISRace{
+		Sector:         "cc",
+		Raceid:         d.Raceid,
+		Eventid:        d.Eventid,
+		Seasoncode:     d.Seasoncode,
+		Disciplinecode: d.Disciplinecode,
+		Catcode:        d.Catcode,
+		Gender:         d.Gender,
+		Racedate:       d.Racedate,
+		Description:    d.Description,
+		Place:          d.Place,
+		Nationcode:     d.Nationcode,
+		Lastupdate:     d.Lastupdate,
+		Details:        d,
+	}
+}
+
+func raceFromJP(row fissqlc.ARacejp) FISRace {
+	d := FISRaceJPFullFromSqlc(row)
+	return FISRace{
+		Sector:         "jp",
+		Raceid:         d.Raceid,
+		Eventid:        d.Eventid,
+		Seasoncode:     d.Seasoncode,
+		Disciplinecode: d.Disciplinecode,
+		Catcode:        d.Catcode,
+		Gender:         d.Gender,
+		Racedate:       d.Racedate,
+		Description:    d.Description,
+		Place:          d.Place,
+		Nationcode:     d.Nationcode,
+		Lastupdate:     d.Lastupdate,
+		Details:        d,
+	}
+}
+
+func raceFromNK(row fissqlc.ARacenk) FISRace {
+	d := FISRaceNKFullFromSqlc(row)
+	return FISRace{
+		Sector:         "nk",
+		Raceid:         d.Raceid,
+		Eventid:        d.Eventid,
+		Seasoncode:     d.Seasoncode,
+		Disciplinecode: d.Disciplinecode,
+		Catcode:        d.Catcode,
+		Gender:         d.Gender,
+		Racedate:       d.Racedate,
+		Description:    d.Description,
+		Place:          d.Place,
+		Nationcode:     d.Nationcode,
+		Lastupdate:     d.Lastupdate,
+		Details:        d,
+	}
+}
+
+func resultFromCC(row fissqlc.AResultcc) FISResult {
+	d := FISResultCCFullFromSqlc(row)
+	return FISResult{
+		Sector:         "cc",
+		Recid:          d.Recid,
+		Raceid:         d.Raceid,
+		Competitorid:   d.Competitorid,
+		Fiscode:        d.Fiscode,
+		Competitorname: d.Competitorname,
+		Nationcode:     d.Nationcode,
+		Status:         d.Status,
+		Position:       d.Position,
+		Bib:            d.Bib,
+		Racepoints:     d.Racepoints,
+		Cuppoints:      d.Cuppoints,
+		Lastupdate:     d.Lastupdate,
+		Details:        d,
+	}
+}
+
+func resultFromJP(row fissqlc.AResultjp) FISResult {
+	d := FISResultJPFullFromSqlc(row)
+	return FISResult{
+		Sector:         "jp",
+		Recid:          d.Recid,
+		Raceid:         d.Raceid,
+		Competitorid:   d.Competitorid,
+		Fiscode:        d.Fiscode,
+		Competitorname: d.Competitorname,
+		Nationcode:     d.Nationcode,
+		Status:         d.Status,
+		Position:       intString(d.Position),
+		Bib:            intString(d.Bib),
+		Racepoints:     d.Racepoints,
+		Cuppoints:      d.Cuppoints,
+		Lastupdate:     d.Lastupdate,
+		Details:        d,
+	}
+}
+
+func resultFromNK(row fissqlc.AResultnk) FISResult {
+	d := FISResultNKFullFromSqlc(row)
+	return FISResult{
+		Sector:         "nk",
+		Recid:          d.Recid,
+		Raceid:         d.Raceid,
+		Competitorid:   d.Competitorid,
+		Fiscode:        d.Fiscode,
+		Competitorname: d.Competitorname,
+		Nationcode:     d.Nationcode,
+		Status:         d.Status,
+		Position:       intString(d.Position),
+		Bib:            intString(d.Bib),
+		Racepoints:     d.Racepoints,
+		Cuppoints:      d.Cuppoints,
+		Lastupdate:     d.Lastupdate,
+		Details:        d,
+	}
+}
+
+func athleteResultFromCC(row fissqlc.GetAthleteResultsCCRow) FISAthleteResult {
+	return FISAthleteResult{
+		Sector:         "cc",
+		Recid:          row.Recid,
+		Raceid:         utils.Int32PtrOrNil(row.Raceid),
+		Position:       utils.StringPtrOrNil(row.Position),
+		Racedate:       utils.FormatDatePtr(row.Racedate),
+		Seasoncode:     utils.Int32PtrOrNil(row.Seasoncode),
+		Disciplinecode: utils.StringPtrOrNil(row.Disciplinecode),
+		Catcode:        utils.StringPtrOrNil(row.Catcode),
+		Place:          utils.StringPtrOrNil(row.Place),
+		Details:        FISAthleteResultCCFromSqlc(row),
+		cursor:         fis.ResultCursor(row.Racedate, row.Recid),
+	}
+}
+
+func athleteResultFromJP(row fissqlc.GetAthleteResultsJPRow) FISAthleteResult {
+	return FISAthleteResult{
+		Sector:         "jp",
+		Recid:          row.Recid,
+		Raceid:         utils.Int32PtrOrNil(row.Raceid),
+		Position:       intString(utils.Int32PtrOrNil(row.Position)),
+		Racedate:       utils.FormatDatePtr(row.Racedate),
+		Seasoncode:     utils.Int32PtrOrNil(row.Seasoncode),
+		Disciplinecode: utils.StringPtrOrNil(row.Disciplinecode),
+		Catcode:        utils.StringPtrOrNil(row.Catcode),
+		Place:          utils.StringPtrOrNil(row.Place),
+		Details:        FISAthleteResultJPFromSqlc(row),
+		cursor:         fis.ResultCursor(row.Racedate, row.Recid),
+	}
+}
+
+func athleteResultFromNK(row fissqlc.GetAthleteResultsNKRow) FISAthleteResult {
+	return FISAthleteResult{
+		Sector:         "nk",
+		Recid:          row.Recid,
+		Raceid:         utils.Int32PtrOrNil(row.Raceid),
+		Position:       intString(utils.Int32PtrOrNil(row.Position)),
+		Racedate:       utils.FormatDatePtr(row.Racedate),
+		Seasoncode:     utils.Int32PtrOrNil(row.Seasoncode),
+		Disciplinecode: utils.StringPtrOrNil(row.Disciplinecode),
+		Catcode:        utils.StringPtrOrNil(row.Catcode),
+		Place:          utils.StringPtrOrNil(row.Place),
+		Details:        FISAthleteResultNKFromSqlc(row),
+		cursor:         fis.ResultCursor(row.Racedate, row.Recid),
+	}
+}
+
+func intString(n *int32) *string {
+	if n == nil {
+		return nil
+	}
+	s := strconv.Itoa(int(*n))
+	return &s
+}
@@ -0,0 +1,188 @@
+package fisapi
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+// FISSeasonStats aggregates the results of a competitor in one season, or
+// over the career. RacepointsChange is the change of the average race
+// points from the previous season; lower points are better.
+type FISSeasonStats struct {
+	Seasoncode       *int32  `json:"seasoncode,omitempty"`
+	Starts           int64   `json:"starts"`
+	Wins             int64   `json:"wins"`
+	Podiums          int64   `json:"podiums"`
+	Top10            int64   `json:"top10"`
+	Dnf              int64   `json:"dnf"`
+	Dsq              int64   `json:"dsq"`
+	BestPosition     *int32  `json:"best_position"`
+	AvgRacepoints    *string `json:"avg_racepoints"`
+	Cuppoints        *string `json:"cuppoints"`
+	RacepointsChange *string `json:"racepoints_change,omitempty"`
+}
+
+// FISBestResult is the best result of a competitor in a discipline and
+// category, with the race it was achieved in
+type FISBestResult struct {
+	Disciplinecode *string `json:"disciplinecode"`
+	Catcode        *string `json:"catcode"`
+	Starts         int64   `json:"starts"`
+	BestPosition   *int32  `json:"best_position"`
+	BestRacepoints *string `json:"best_racepoints"`
+	Raceid         *int32  `json:"raceid"`
+	Racedate       *string `json:"racedate"`
+	Seasoncode     *int32  `json:"seasoncode"`
+	Place          *string `json:"place"`
+}
+
+// FISSectorStats holds the statistics of a competitor in one sector
+type FISSectorStats struct {
+	Sector       string           `json:"sector"`
+	Competitorid int32            `json:"competitorid"`
+	Career       FISSeasonStats   `json:"career"`
+	Seasons      []FISSeasonStats `json:"seasons"`
+	Best         []FISBestResult  `json:"best"`
+}
+
+// seasonStatsFromSqlc converts a stats row. The stats rows of the sectors
+// have the same columns, so the JP and NK rows are converted to the CC ones.
+func seasonStatsFromSqlc(row fissqlc.GetCompetitorSeasonStatsCCRow) FISSeasonStats {
+	return FISSeasonStats{
+		Seasoncode:       utils.Int32PtrOrNil(row.Seasoncode),
+		Starts:           row.Starts,
+		Wins:             row.Wins,
+		Podiums:          row.Podiums,
+		Top10:            row.Top10,
+		Dnf:              row.Dnf,
+		Dsq:              row.Dsq,
+		BestPosition:     utils.Int32PtrOrNil(row.BestPosition),
+		AvgRacepoints:    utils.StringPtrOrNil(row.AvgRacepoints),
+		Cuppoints:        utils.StringPtrOrNil(row.Cuppoints),
+		RacepointsChange: utils.StringPtrOrNil(row.RacepointsChange),
+	}
+}
+
+func bestResultFromSqlc(row fissqlc.GetCompetitorBestResultsCCRow) FISBestResult {
+	return FISBestResult{
+		Disciplinecode: utils.StringPtrOrNil(row.Disciplinecode),
+		Catcode:        utils.StringPtrOrNil(row.Catcode),
+		Starts:         row.Starts,
+		BestPosition:   utils.Int32PtrOrNil(row.BestPosition),
+		BestRacepoints: utils.StringPtrOrNil(row.BestRacepoints),
+		Raceid:         utils.Int32PtrOrNil(row.Raceid),
+		Racedate:       utils.FormatDatePtr(row.Racedate),
+		Seasoncode:     utils.Int32PtrOrNil(row.Seasoncode),
+		Place:          utils.StringPtrOrNil(row.Place),
+	}
+}
+
+// sectorStats reads the statistics of a competitor in sector s
+func sectorStats(ctx context.Context, code string, s sector, competitorID int32) (FISSectorStats, error) {
+	out := FISSectorStats{Sector: code, Competitorid: competitorID, Seasons: []FISSeasonStats{}}
+
+	rows, err := s.seasonStats(ctx, competitorID)
+	if err != nil {
+		return out, err
+	}
+	for _, row := range rows {
+		if row.Career {
+			out.Career = seasonStatsFromSqlc(row)
+			continue
+		}
+		out.Seasons = append(out.Seasons, seasonStatsFromSqlc(row))
+	}
+
+	out.Best, err = s.bestResults(ctx, competitorID)
+	if out.Best == nil {
+		out.Best = []FISBestResult{}
+	}
+	return out, err
+}
+
+// GetCompetitorStats godoc
+//
+//	@Summary		Get the career statistics of a competitor
+//	@Description	Aggregates the results of a FIS code per sector: starts, wins, podiums, top-10s, DNF and DSQ counts, best position, average race points and cup points per season and over the career, with the change of the average race points from the previous season, and the best result per discipline and category. Starts exclude DNS results.
+//	@Tags			FIS - Athlete
+//	@Accept			json
+//	@Produce		json
+//	@Param			fiscode	path		int32		true	"FIS Code"
+//	@Param			sectors	query		[]string	false	"Sector codes (repeat or comma-separated; default: all)"
+//	@Success		200		{object}	swagger.FISCompetitorStatsResponse
+//	@Failure		400		{object}	swagger.ValidationErrorResponse
+//	@Failure		401		{object}	swagger.UnauthorizedResponse
+//	@Failure		403		{object}	swagger.ForbiddenResponse
+//	@Failure		404		{object}	swagger.NotFoundResponse
+//	@Failure		500		{object}	swagger.InternalServerErrorResponse
+//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/fis/competitor/{fiscode}/stats [get]
+func (h *SectorHandler) GetCompetitorStats(w http.ResponseWriter, r *http.Request) {
+	if !authz.Authorize(r) {
+		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
+		return
+	}
+	if err := utils.ValidateParams(r, []string{"sectors"}); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	fiscode, err := utils.ParsePositiveInt32(chi.URLParam(r, "fiscode"))
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+	codes, ok := h.parseSectors(w, r)
+	if !ok {
+		return
+	}
+
+	sectors := []any{}
+	for _, code := range codes {
+		s := h.sectors[code]
+		competitorID, err := s.competitorID(r.Context(), fiscode)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			utils.InternalServerError(w, r, err)
+			return
+		}
+
+		cacheKey := fmt.Sprintf("%s:stats:competitor=%d", s.cache().athlete, competitorID)
+		if h.cache != nil {
+			if raw, err := h.cache.Get(r.Context(), cacheKey); err == nil && raw != "" {
+				sectors = append(sectors, json.RawMessage(raw))
+				continue
+			}
+		}
+
+		stats, err := sectorStats(r.Context(), code, s, competitorID)
+		if err != nil {
+			utils.InternalServerError(w, r, err)
+			return
+		}
+		cache.SetCacheJSON(r.Context(), h.cache, cacheKey, stats, FISCacheTTL)
+		sectors = append(sectors, stats)
+	}
+	if len(sectors) == 0 {
+		utils.NotFoundResponse(w, r, fmt.Errorf("competitor with FIS code %d not found", fiscode))
+		return
+	}
+
+	utils.WriteJSON(w, http.StatusOK, map[string]any{
+		"fiscode": fiscode,
+		"sectors": sectors,
+	})
+}
@@ -0,0 +1,320 @@
+package fisapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+type SyncRacesCCInput struct {
+	Races []InsertRaceCCInput `json:"races" validate:"required,min=1,dive"`
+}
+
+type SyncRacesJPInput struct {
+	Races []InsertRaceJPInput `json:"races" validate:"required,min=1,dive"`
+}
+
+type SyncRacesNKInput struct {
+	Races []InsertRaceNKInput `json:"races" validate:"required,min=1,dive"`
+}
+
+type SyncResultsCCInput struct {
+	Results []InsertResultCCInput `json:"results" validate:"required,min=1,dive"`
+}
+
+type SyncResultsJPInput struct {
+	Results []InsertResultJPInput `json:"results" validate:"required,min=1,dive"`
+}
+
+type SyncResultsNKInput struct {
+	Results []InsertResultNKInput `json:"results" validate:"required,min=1,dive"`
+}
+
+type SyncCompetitorsInput struct {
+	Competitors []InsertCompetitorInput `json:"competitors" validate:"required,min=1,dive"`
+}
+
+// SyncRacesCC godoc
+//
+//	@Summary		Sync Cross-Country races (bulk upsert)
+//	@Description	Inserts or updates many Cross-Country races in one transaction. A row replaces the stored one only if its lastupdate is newer, or equally new with a newer version; older rows are skipped. The response counts the rows inserted, updated and skipped.
+//	@Tags			FIS - Race Management – Cross-Country
+//	@Accept			json
+//	@Produce		json
+//	@Param			races	body		swagger.FISSyncRacesCCInput	true	"Rows to upsert"
+//	@Param			async	query		bool						false	"Queue the request as an ingest job, see /ingest-jobs"
+//	@Success		200		{object}	swagger.FISSyncResponse
+//	@Success		202		{object}	swagger.IngestJobEnvelope	"Queued as an ingest job (async mode)"
+//	@Failure		400		{object}	swagger.ValidationErrorResponse
+//	@Failure		401		{object}	swagger.UnauthorizedResponse
+//	@Failure		403		{object}	swagger.ForbiddenResponse
+//	@Failure		409		{object}	swagger.ConflictResponse
+//	@Failure		500		{object}	swagger.InternalServerErrorResponse
+//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/fis/sync/racecc [post]
+func (h *RaceCCHandler) SyncRacesCC(w http.ResponseWriter, r *http.Request) {
+	var in SyncRacesCCInput
+	if !readSync(w, r, &in) {
+		return
+	}
+
+	counts, ok := writeSync(w, r, in.Races, mapInsertRaceCCInput, h.store.SyncRacesCC)
+	if !ok {
+		return
+	}
+
+	invalidateRaceCC(r.Context(), h.cache)
+	utils.WriteJSON(w, http.StatusOK, counts)
+}
+
+// SyncRacesJP godoc
+//
+//	@Summary		Sync Ski Jumping races (bulk upsert)
+//	@Description	Inserts or updates many Ski Jumping races in one transaction. A row replaces the stored one only if its lastupdate is newer, or equally new with a newer version; older rows are skipped. The response counts the rows inserted, updated and skipped.
+//	@Tags			FIS - Race Management – Ski Jumping
+//	@Accept			json
+//	@Produce		json
+//	@Param			races	body		swagger.FISSyncRacesJPInput	true	"Rows to upsert"
+//	@Param			async	query		bool						false	"Queue the request as an ingest job, see /ingest-jobs"
+//	@Success		200		{object}	swagger.FISSyncResponse
+//	@Success		202		{object}	swagger.IngestJobEnvelope	"Queued as an ingest job (async mode)"
+//	@Failure		400		{object}	swagger.ValidationErrorResponse
+//	@Failure		401		{object}	swagger.UnauthorizedResponse
+//	@Failure		403		{object}	swagger.ForbiddenResponse
+//	@Failure		409		{object}	swagger.ConflictResponse
+//	@Failure		500		{object}	swagger.InternalServerErrorResponse
+//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/fis/sync/racejp [post]
+func (h *RaceJPHandler) SyncRacesJP(w http.ResponseWriter, r *http.Request) {
+	var in SyncRacesJPInput
+	if !readSync(w, r, &in) {
+		return
+	}
+
+	counts, ok := writeSync(w, r, in.Races, mapInsertRaceJPInput, h.store.SyncRacesJP)
+	if !ok {
+		return
+	}
+
+	invalidateRaceJP(r.Context(), h.cache)
+	utils.WriteJSON(w, http.StatusOK, counts)
+}
+
+// SyncRacesNK godoc
+//
+//	@Summary		Sync Nordic Combined races (bulk upsert)
+//	@Description	Inserts or updates many Nordic Combined races in one transaction. A row replaces the stored one only if its lastupdate is newer, or equally new with a newer version; older rows are skipped. The response counts the rows inserted, updated and skipped.
+//	@Tags			FIS - Race Management – Nordic Combined
+//	@Accept			json
+//	@Produce		json
+//	@Param			races	body		swagger.FISSyncRacesNKInput	true	"Rows to upsert"
+//	@Param			async	query		bool						false	"Queue the request as an ingest job, see /ingest-jobs"
+//	@Success		200		{object}	swagger.FISSyncResponse
+//	@Success		202		{object}	swagger.IngestJobEnvelope	"Queued as an ingest job (async mode)"
+//	@Failure		400		{object}	swagger.ValidationErrorResponse
+//	@Failure		401		{object}	swagger.UnauthorizedResponse
+//	@Failure		403		{object}	swagger.ForbiddenResponse
+//	@Failure		409		{object}	swagger.ConflictResponse
+//	@Failure		500		{object}	swagger.InternalServerErrorResponse
+//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/fis/sync/racenk [post]
+func (h *RaceNKHandler) SyncRacesNK(w http.ResponseWriter, r *http.Request) {
+	var in SyncRacesNKInput
+	if !readSync(w, r, &in) {
+		return
+	}
+
+	counts, ok := writeSync(w, r, in.Races, mapInsertRaceNKInput, h.store.SyncRacesNK)
+	if !ok {
+		return
+	}
+
+	invalidateRaceNK(r.Context(), h.cache)
+	utils.WriteJSON(w, http.StatusOK, counts)
+}
+
+// SyncResultsCC godoc
+//
+//	@Summary		Sync Cross-Country results (bulk upsert)
+//	@Description	Inserts or updates many Cross-Country results in one transaction. A row replaces the stored one only if its lastupdate is newer, or equally new with a newer version; older rows are skipped. The response counts the rows inserted, updated and skipped.
+//	@Tags			FIS - Result Management – Cross-Country
+//	@Accept			json
+//	@Produce		json
+//	@Param			results	body		swagger.FISSyncResultsCCInput	true	"Rows to upsert"
+//	@Param			async	query		bool							false	"Queue the request as an ingest job, see /ingest-jobs"
+//	@Success		200		{object}	swagger.FISSyncResponse
+//	@Success		202		{object}	swagger.IngestJobEnvelope	"Queued as an ingest job (async mode)"
+//	@Failure		400		{object}	swagger.ValidationErrorResponse
+//	@Failure		401		{object}	swagger.UnauthorizedResponse
+//	@Failure		403		{object}	swagger.ForbiddenResponse
+//	@Failure		409		{object}	swagger.ConflictResponse
+//	@Failure		500		{object}	swagger.InternalServerErrorResponse
+//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/fis/sync/resultcc [post]
+func (h *ResultCCHandler) SyncResultsCC(w http.ResponseWriter, r *http.Request) {
+	var in SyncResultsCCInput
+	if !readSync(w, r, &in) {
+		return
+	}
+
+	counts, ok := writeSync(w, r, in.Results, mapInsertResultCCInput, h.store.SyncResultsCC)
+	if !ok {
+		return
+	}
+
+	invalidateResultCC(r.Context(), h.cache)
+	utils.WriteJSON(w, http.StatusOK, counts)
+}
+
+// SyncResultsJP godoc
+//
+//	@Summary		Sync Ski Jumping results (bulk upsert)
+//	@Description	Inserts or updates many Ski Jumping results in one transaction. A row replaces the stored one only if its lastupdate is newer, or equally new with a newer version; older rows are skipped. The response counts the rows inserted, updated and skipped.
+//	@Tags			FIS - Result Management – Ski Jumping
+//	@Accept			json
+//	@Produce		json
+//	@Param			results	body		swagger.FISSyncResultsJPInput	true	"Rows to upsert"
+//	@Param			async	query		bool							false	"Queue the request as an ingest job, see /ingest-jobs"
+//	@Success		200		{object}	swagger.FISSyncResponse
+//	@Success		202		{object}	swagger.IngestJobEnvelope	"Queued as an ingest job (async mode)"
+//	@Failure		400		{object}	swagger.ValidationErrorResponse
+//	@Failure		401		{object}	swagger.UnauthorizedResponse
+//	@Failure		403		{object}	swagger.ForbiddenResponse
+//	@Failure		409		{object}	swagger.ConflictResponse
+//	@Failure		500		{object}	swagger.InternalServerErrorResponse
+//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/fis/sync/resultjp [post]
+func (h *ResultJPHandler) SyncResultsJP(w http.ResponseWriter, r *http.Request) {
+	var in SyncResultsJPInput
+	if !readSync(w, r, &in) {
+		return
+	}
+
+	counts, ok := writeSync(w, r, in.Results, mapInsertResultJPInput, h.store.SyncResultsJP)
+	if !ok {
+		return
+	}
+
+	invalidateResultJP(r.Context(), h.cache)
+	utils.WriteJSON(w, http.StatusOK, counts)
+}
+
+// SyncResultsNK godoc
+//
+//	@Summary		Sync Nordic Combined results (bulk upsert)
+//	@Description	Inserts or updates many Nordic Combined results in one transaction. A row replaces the stored one only if its lastupdate is newer, or equally new with a newer version; older rows are skipped. The response counts the rows inserted, updated and skipped.
+//	@Tags			FIS - Result Management – Nordic Combined
+//	@Accept			json
+//	@Produce		json
+//	@Param			results	body		swagger.FISSyncResultsNKInput	true	"Rows to upsert"
+//	@Param			async	query		bool							false	"Queue the request as an ingest job, see /ingest-jobs"
+//	@Success		200		{object}	swagger.FISSyncResponse
+//	@Success		202		{object}	swagger.IngestJobEnvelope	"Queued as an ingest job (async mode)"
+//	@Failure		400		{object}	swagger.ValidationErrorResponse
+//	@Failure		401		{object}	swagger.UnauthorizedResponse
+//	@Failure		403		{object}	swagger.ForbiddenResponse
+//	@Failure		409		{object}	swagger.ConflictResponse
+//	@Failure		500		{object}	swagger.InternalServerErrorResponse
+//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/fis/sync/resultnk [post]
+func (h *ResultNKHandler) SyncResultsNK(w http.ResponseWriter, r *http.Request) {
+	var in SyncResultsNKInput
+	if !readSync(w, r, &in) {
+		return
+	}
+
+	counts, ok := writeSync(w, r, in.Results, mapInsertResultNKInput, h.store.SyncResultsNK)
+	if !ok {
+		return
+	}
+
+	invalidateResultNK(r.Context(), h.cache)
+	utils.WriteJSON(w, http.StatusOK, counts)
+}
+
+// SyncCompetitors godoc
+//
+//	@Summary		Sync competitors (bulk upsert)
+//	@Description	Inserts or updates many competitors in one transaction. A row replaces the stored one only if its lastupdate is newer, or equally new with a newer version; older rows are skipped. The response counts the rows inserted, updated and skipped.
+//	@Tags			FIS - Competitor Management
+//	@Accept			json
+//	@Produce		json
+//	@Param			competitors	body		swagger.FISSyncCompetitorsInput	true	"Rows to upsert"
+//	@Param			async		query		bool							false	"Queue the request as an ingest job, see /ingest-jobs"
+//	@Success		200			{object}	swagger.FISSyncResponse
+//	@Success		202			{object}	swagger.IngestJobEnvelope	"Queued as an ingest job (async mode)"
+//	@Failure		400			{object}	swagger.ValidationErrorResponse
+//	@Failure		401			{object}	swagger.UnauthorizedResponse
+//	@Failure		403			{object}	swagger.ForbiddenResponse
+//	@Failure		409			{object}	swagger.ConflictResponse
+//	@Failure		500			{object}	swagger.InternalServerErrorResponse
+//	@Failure		503			{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/fis/sync/competitor [post]
+func (h *CompetitorHandler) SyncCompetitors(w http.ResponseWriter, r *http.Request) {
+	var in SyncCompetitorsInput
+	if !readSync(w, r, &in) {
+		return
+	}
+
+	counts, ok := writeSync(w, r, in.Competitors, mapInsertInput, h.store.SyncCompetitors)
+	if !ok {
+		return
+	}
+
+	var sectors []string
+	for _, c := range in.Competitors {
+		if c.Sectorcode != nil && *c.Sectorcode != "" && !slices.Contains(sectors, *c.Sectorcode) {
+			sectors = append(sectors, *c.Sectorcode)
+		}
+	}
+	invalidateCompetitors(r.Context(), h.cache, sectors)
+	utils.WriteJSON(w, http.StatusOK, counts)
+}
+
+// readSync reads and validates the body of a sync request
+func readSync(w http.ResponseWriter, r *http.Request, in any) bool {
+	if !authz.Authorize(r) {
+		utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
+		return false
+	}
+	if err := utils.ReadJSON(w, r, in); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return false
+	}
+	if err := utils.GetValidator().Struct(in); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return false
+	}
+	return true
+}
+
+// writeSync maps the rows of a sync request and upserts them with write
+func writeSync[In, Clean any](w http.ResponseWriter, r *http.Request, rows []In, mapInput func(In) (Clean, error), write func(context.Context, []Clean) (utils.UpsertCounts, error)) (utils.UpsertCounts, bool) {
+	clean := make([]Clean, len(rows))
+	for i, row := range rows {
+		c, err := mapInput(row)
+		if err != nil {
+			utils.BadRequestResponse(w, r, fmt.Errorf("row %d: %w", i, err))
+			return utils.UpsertCounts{}, false
+		}
+		clean[i] = c
+	}
+
+	counts, err := write(r.Context(), clean)
+	if err != nil {
+		utils.HandleDatabaseError(w, r, err)
+		return utils.UpsertCounts{}, false
+	}
+	return counts, true
+}
@@ -25,7 +25,7 @@ func (app *api) healthCheckHandler(w http.ResponseWriter, r *http.Request) {
 	defer cancel()
 
 	data := map[string]any{
-		"env":     app.config.env,
+		"env":     app.config.Server.Env,
 		"version": version,
 	}
 
@@ -33,7 +33,7 @@ func (app *api) healthCheckHandler(w http.ResponseWriter, r *http.Request) {
 	statusCode := http.StatusOK
 
 	// Redis check
-	if app.config.redisCfg.enabled {
+	if app.config.Redis.Enabled {
 		if app.cacheStorage == nil || app.cacheStorage.Ping(ctx) != nil {
 			data["redis"] = "down"
 		} else {
@@ -67,6 +67,9 @@ func (app *api) healthCheckHandler(w http.ResponseWriter, r *http.Request) {
 
 	// Add uptime and goroutine info
 	data["uptime_seconds"] = int64(time.Since(startTime).Seconds())
+	if app.inflight.draining.Load() {
+		status = "draining"
+	}
 	data["api"] = status
 
 	statusCode = http.StatusOK
@@ -75,3 +78,27 @@ func (app *api) healthCheckHandler(w http.ResponseWriter, r *http.Request) {
 		utils.InternalServerError(w, r, err)
 	}
 }
+
+// readinessHandler godoc
+//
+//	@Summary		Readiness check
+//	@Description	Returns 503 once the server has started draining for shutdown so load balancers stop routing new requests to it
+//	@Tags			Health
+//	@Produce		json
+//	@Success		200	{object}	swagger.ReadinessResponse	"Ready to receive traffic"
+//	@Failure		503	{object}	swagger.ReadinessResponse	"Draining for shutdown"
+//	@Router			/ready [get]
+func (app *api) readinessHandler(w http.ResponseWriter, r *http.Request) {
+	if app.inflight.draining.Load() {
+		utils.WriteJSON(w, http.StatusServiceUnavailable, map[string]any{
+			"status":          "draining",
+			"inflight_writes": app.inflight.Count(),
+		})
+		return
+	}
+
+	utils.WriteJSON(w, http.StatusOK, map[string]any{
+		"status":          "ready",
+		"inflight_writes": app.inflight.Count(),
+	})
+}
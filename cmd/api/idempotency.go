@@ -0,0 +1,205 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authn"
+	"github.com/DeRuina/KUHA-REST-API/internal/idempotency"
+	"github.com/DeRuina/KUHA-REST-API/internal/logger"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+const (
+	idempotencyKeyHeader      = "Idempotency-Key"
+	idempotentReplayedHeader  = "Idempotent-Replayed"
+	maxIdempotencyKeyLength   = 255
+	maxIdempotentResponseSize = 1 << 20
+
+	// maxIdempotentRequestSize caps the body buffered for the fingerprint,
+	// below the body limits of the bulk routes
+	maxIdempotentRequestSize = 10 << 20
+
+	// idempotencyRetryAfter is the Retry-After, in seconds, sent while the
+	// original request is still running
+	idempotencyRetryAfter = 5
+)
+
+// IdempotencyMiddleware makes POST and PUT requests sent with an
+// Idempotency-Key header safe to retry. The first request with a key is
+// executed and its response stored; a retry with the same key and body
+// gets the stored response back with Idempotent-Replayed: true. Reusing a
+// key for a different request is rejected with 422, and a retry arriving
+// while the original request is still running gets 409.
+//
+// Server errors are not stored, so a request that failed with a 5xx can be
+// retried with the same key. Responses larger than 1 MiB are not stored
+// either. Keyed requests are buffered, so their bodies are capped at 10 MiB
+// (413); larger uploads are sent without a key or as async jobs.
+func (app *api) IdempotencyMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(idempotencyKeyHeader)
+		if app.idempotency == nil || key == "" || (r.Method != http.MethodPost && r.Method != http.MethodPut) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if !validIdempotencyKey(key) {
+			utils.BadRequestResponse(w, r, fmt.Errorf("%s must be 1 to %d printable ASCII characters", idempotencyKeyHeader, maxIdempotencyKeyLength))
+			return
+		}
+
+		// The body is needed for the fingerprint before the handler runs;
+		// it is read within the limits the handler would apply, capped so
+		// that a keyed request cannot hold a bulk upload in memory
+		limits := utils.GetBodyLimits(r.Context())
+		maxBytes := limits.MaxBytes
+		if strings.EqualFold(r.Header.Get("Content-Encoding"), "gzip") {
+			maxBytes = limits.MaxGzipBytes
+		}
+		maxBytes = min(maxBytes, maxIdempotentRequestSize)
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBytes))
+		if err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				utils.RequestEntityTooLargeResponse(w, r, fmt.Errorf("request bodies sent with %s are limited to %d bytes", idempotencyKeyHeader, maxBytes))
+				return
+			}
+			utils.BadRequestResponse(w, r, err)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		scoped := idempotency.Key(authn.GetClientName(r.Context()), r.Method, r.URL.Path, key)
+		fingerprint := idempotency.Fingerprint(r, body)
+
+		// store calls must not fail because the client went away
+		ctx := context.WithoutCancel(r.Context())
+		cfg := app.config.Idempotency
+
+		rec, reserved, err := app.idempotency.Reserve(ctx, scoped, fingerprint, cfg.LockTimeout)
+		if err != nil {
+			utils.InternalServerError(w, r, err)
+			return
+		}
+		if !reserved {
+			switch {
+			case rec.Fingerprint != fingerprint:
+				utils.UnprocessableEntityResponse(w, r, fmt.Errorf("%s was already used for a different request", idempotencyKeyHeader))
+			case !rec.Completed():
+				w.Header().Set("Retry-After", strconv.Itoa(idempotencyRetryAfter))
+				utils.ConflictResponse(w, r, fmt.Errorf("a request with this %s is still being processed", idempotencyKeyHeader))
+			default:
+				replayResponse(w, rec)
+			}
+			return
+		}
+
+		rw := &idempotencyRecorder{ResponseWriter: w}
+		stored := false
+		defer func() {
+			if stored {
+				return
+			}
+			if err := app.idempotency.Release(ctx, scoped, rec.Token); err != nil {
+				logger.Logger.Warnw("failed to release idempotency key", "error", err)
+			}
+		}()
+
+		next.ServeHTTP(rw, r)
+
+		// Nothing was written when the deadline cut the handler short; the
+		// timeout response is written after this middleware returns
+		if rw.status == 0 && errors.Is(r.Context().Err(), context.DeadlineExceeded) {
+			return
+		}
+		if rw.status == 0 {
+			rw.status = http.StatusOK
+		}
+		if rw.status >= http.StatusInternalServerError || rw.overflow {
+			return
+		}
+
+		// a key whose lock expired may have been taken over by a retry;
+		// the store then refuses the response and the retry's own one is
+		// kept
+		err = app.idempotency.Complete(ctx, scoped, rec.Token, idempotency.Record{
+			Fingerprint: fingerprint,
+			Status:      rw.status,
+			Header:      idempotency.ResponseHeader(w.Header()),
+			Body:        rw.body.Bytes(),
+		}, cfg.TTL)
+		if errors.Is(err, idempotency.ErrNotHeld) {
+			logger.Logger.Warnw("idempotent response not stored: key taken over after its lock expired")
+			stored = true
+			return
+		}
+		if err != nil {
+			logger.Logger.Warnw("failed to store idempotent response", "error", err)
+			return
+		}
+		stored = true
+	})
+}
+
+func validIdempotencyKey(key string) bool {
+	if len(key) > maxIdempotencyKeyLength {
+		return false
+	}
+	for i := 0; i < len(key); i++ {
+		if key[i] < 0x20 || key[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+func replayResponse(w http.ResponseWriter, rec idempotency.Record) {
+	for k, v := range rec.Header {
+		w.Header()[k] = v
+	}
+	w.Header().Set(idempotentReplayedHeader, "true")
+	w.WriteHeader(rec.Status)
+	w.Write(rec.Body)
+}
+
+// idempotencyRecorder copies the status and body of a response while it
+// is written to the client
+type idempotencyRecorder struct {
+	http.ResponseWriter
+	status   int
+	body     bytes.Buffer
+	overflow bool
+}
+
+func (rw *idempotencyRecorder) WriteHeader(status int) {
+	if rw.status == 0 {
+		rw.status = status
+	}
+	rw.ResponseWriter.WriteHeader(status)
+}
+
+func (rw *idempotencyRecorder) Write(p []byte) (int, error) {
+	if rw.status == 0 {
+		rw.status = http.StatusOK
+	}
+	if !rw.overflow {
+		if rw.body.Len()+len(p) > maxIdempotentResponseSize {
+			rw.overflow = true
+			rw.body = bytes.Buffer{}
+		} else {
+			rw.body.Write(p)
+		}
+	}
+	return rw.ResponseWriter.Write(p)
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer
+func (rw *idempotencyRecorder) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
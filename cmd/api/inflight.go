@@ -0,0 +1,91 @@
+package main
+
+import (
+	"net/http"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// inflightTracker keeps track of write requests that are still being
+// processed so that shutdown can wait for them and report the ones it had
+// to abort
+type inflightTracker struct {
+	mu       sync.Mutex
+	nextID   uint64
+	requests map[uint64]inflightRequest
+
+	draining  atomic.Bool
+	completed atomic.Int64
+}
+
+type inflightRequest struct {
+	Method    string    `json:"method"`
+	Path      string    `json:"path"`
+	RequestID string    `json:"request_id"`
+	StartedAt time.Time `json:"started_at"`
+}
+
+func newInflightTracker() *inflightTracker {
+	return &inflightTracker{requests: make(map[uint64]inflightRequest)}
+}
+
+func (t *inflightTracker) add(req inflightRequest) uint64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.nextID++
+	t.requests[t.nextID] = req
+	return t.nextID
+}
+
+func (t *inflightTracker) done(id uint64) {
+	t.mu.Lock()
+	delete(t.requests, id)
+	t.mu.Unlock()
+	t.completed.Add(1)
+}
+
+// Count returns the number of write requests currently in flight
+func (t *inflightTracker) Count() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.requests)
+}
+
+// Snapshot returns the in-flight write requests, oldest first
+func (t *inflightTracker) Snapshot() []inflightRequest {
+	t.mu.Lock()
+	out := make([]inflightRequest, 0, len(t.requests))
+	for _, req := range t.requests {
+		out = append(out, req)
+	}
+	t.mu.Unlock()
+
+	sort.Slice(out, func(i, j int) bool { return out[i].StartedAt.Before(out[j].StartedAt) })
+	return out
+}
+
+// InflightWritesMiddleware registers every POST/PUT/PATCH/DELETE request
+// with the tracker for the duration of the handler
+func (app *api) InflightWritesMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		id := app.inflight.add(inflightRequest{
+			Method:    r.Method,
+			Path:      r.URL.Path,
+			RequestID: middleware.GetReqID(r.Context()),
+			StartedAt: time.Now(),
+		})
+		defer app.inflight.done(id)
+
+		next.ServeHTTP(w, r)
+	})
+}
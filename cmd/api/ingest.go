@@ -0,0 +1,27 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+
+	ingestapi "github.com/DeRuina/KUHA-REST-API/cmd/api/ingest"
+	"github.com/DeRuina/KUHA-REST-API/internal/ingest"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+var errAsyncUnavailable = errors.New("asynchronous ingestion is not available: auth database not connected or ingest jobs disabled")
+
+// async lets the write handler h of a route be run as an ingest job of
+// kind with ?async=true, see ingestapi.IngestJobsHandler.Async
+func (app *api) async(kind string, h http.HandlerFunc) http.HandlerFunc {
+	if app.ingest == nil {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if ingest.Requested(r) {
+				utils.BadRequestResponse(w, r, errAsyncUnavailable)
+				return
+			}
+			h(w, r)
+		}
+	}
+	return ingestapi.NewIngestJobsHandler(app.store.Auth.IngestJobs(), app.ingest).Async(kind, h)
+}
@@ -0,0 +1,256 @@
+package ingestapi
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authn"
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	authsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/auth"
+	"github.com/DeRuina/KUHA-REST-API/internal/ingest"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/auth"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+var errJobNotFailed = errors.New("only failed ingest jobs can be retried")
+
+// Handler struct
+type IngestJobsHandler struct {
+	store auth.IngestJobs
+	pool  *ingest.Pool
+}
+
+func NewIngestJobsHandler(store auth.IngestJobs, pool *ingest.Pool) *IngestJobsHandler {
+	return &IngestJobsHandler{store: store, pool: pool}
+}
+
+// IngestJobResponse is the status of an ingest job
+type IngestJobResponse struct {
+	ID            uuid.UUID       `json:"id"`
+	Kind          string          `json:"kind"`
+	Path          string          `json:"path"`
+	Status        string          `json:"status"`
+	Error         *string         `json:"error,omitempty"`
+	RecordsTotal  int32           `json:"records_total"`
+	RecordsDone   int32           `json:"records_done"`
+	RecordsFailed int32           `json:"records_failed"`
+	RecordErrors  json.RawMessage `json:"record_errors"`
+	Attempts      int32           `json:"attempts"`
+	NextAttemptAt *time.Time      `json:"next_attempt_at,omitempty"`
+	CreatedAt     time.Time       `json:"created_at"`
+	StartedAt     *time.Time      `json:"started_at,omitempty"`
+	FinishedAt    *time.Time      `json:"finished_at,omitempty"`
+	ExpiresAt     time.Time       `json:"expires_at"`
+}
+
+func ingestJobResponse(job authsqlc.IngestJob) IngestJobResponse {
+	resp := IngestJobResponse{
+		ID:            job.ID,
+		Kind:          job.Kind,
+		Path:          job.Path,
+		Status:        job.Status,
+		Error:         utils.StringPtrOrNil(job.Error),
+		RecordsTotal:  job.RecordsTotal,
+		RecordsDone:   job.RecordsDone,
+		RecordsFailed: job.RecordsFailed,
+		RecordErrors:  job.RecordErrors,
+		Attempts:      job.Attempts,
+		CreatedAt:     job.CreatedAt,
+		StartedAt:     utils.TimePtrOrNil(job.StartedAt),
+		FinishedAt:    utils.TimePtrOrNil(job.FinishedAt),
+		ExpiresAt:     job.ExpiresAt,
+	}
+	// only a job waiting for a retry has a meaningful next attempt
+	if job.Status == auth.IngestQueued && job.Attempts > 0 {
+		resp.NextAttemptAt = &job.NextAttemptAt
+	}
+	return resp
+}
+
+// Async registers next as the handler of ingest jobs of kind and returns
+// it wrapped: requests with ?async=true are authorized, queued as a job and
+// answered with 202 Accepted, the others are passed to next. The body of a
+// queued request is validated when the job runs.
+func (h *IngestJobsHandler) Async(kind string, next http.HandlerFunc) http.HandlerFunc {
+	h.pool.Register(kind, next)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !ingest.Requested(r) {
+			next(w, r)
+			return
+		}
+
+		if !authz.Authorize(r) {
+			utils.ForbiddenResponse(w, r, fmt.Errorf("access denied"))
+			return
+		}
+
+		if r.Header.Get("X-Was-Gzipped") != "true" {
+			// gzip bodies are already capped by GzipDecompressionMiddleware
+			r.Body = http.MaxBytesReader(w, r.Body, utils.GetBodyLimits(r.Context()).MaxBytes)
+		}
+		payload, err := io.ReadAll(r.Body)
+		if err != nil {
+			utils.BadRequestResponse(w, r, err)
+			return
+		}
+		records, err := ingest.Count(payload)
+		if err != nil {
+			utils.BadRequestResponse(w, r, err)
+			return
+		}
+
+		query := r.URL.Query()
+		query.Del("async")
+
+		job, err := h.store.CreateJob(r.Context(), authsqlc.CreateIngestJobParams{
+			ClientName:   authn.GetClientName(r.Context()),
+			Roles:        authn.GetClientRoles(r.Context()),
+			Kind:         kind,
+			Path:         r.URL.Path,
+			Query:        query.Encode(),
+			RecordsTotal: int32(records),
+			ExpiresAt:    h.pool.ExpiresAt(),
+		}, payload)
+		if err != nil {
+			utils.InternalServerError(w, r, err)
+			return
+		}
+		h.pool.Notify()
+
+		w.Header().Set("Location", fmt.Sprintf("/v1/ingest-jobs/%s", job.ID))
+		utils.WriteJSON(w, http.StatusAccepted, map[string]any{"ingest_job": ingestJobResponse(job)})
+	}
+}
+
+// ListIngestJobs godoc
+//
+//	@Summary		List ingest jobs
+//	@Description	Lists the ingest jobs of the calling client, newest first
+//	@Tags			Ingest jobs
+//	@Produce		json
+//	@Param			limit	query		int		false	"Page size (default 100, max 1000)"
+//	@Param			cursor	query		string	false	"Cursor from the previous page"
+//	@Success		200		{object}	swagger.IngestJobListResponse
+//	@Failure		400		{object}	swagger.ValidationErrorResponse
+//	@Failure		401		{object}	swagger.UnauthorizedResponse
+//	@Failure		500		{object}	swagger.InternalServerErrorResponse
+//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/ingest-jobs [get]
+func (h *IngestJobsHandler) ListIngestJobs(w http.ResponseWriter, r *http.Request) {
+	if err := utils.ValidateParams(r, []string{"limit", "cursor"}); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	page, err := utils.ParsePage(r, utils.DefaultPageLimits, utils.CursorTime|utils.CursorID)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	jobs, err := h.store.ListJobs(r.Context(), authn.GetClientName(r.Context()), page)
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+
+	jobs, pageInfo := utils.NextPage(jobs, page, func(j authsqlc.IngestJob) utils.Cursor {
+		return utils.TimeCursor(j.CreatedAt, j.ID)
+	})
+	utils.SetNextLink(w, r, pageInfo.NextCursor)
+
+	resp := make([]IngestJobResponse, len(jobs))
+	for i, job := range jobs {
+		resp[i] = ingestJobResponse(job)
+	}
+	utils.WriteJSON(w, http.StatusOK, map[string]any{"ingest_jobs": resp, "pagination": pageInfo})
+}
+
+// GetIngestJob godoc
+//
+//	@Summary		Get an ingest job
+//	@Description	Returns the status, progress and per-record errors of an ingest job of the calling client
+//	@Tags			Ingest jobs
+//	@Produce		json
+//	@Param			id	path		string	true	"Ingest job ID (UUID)"
+//	@Success		200	{object}	swagger.IngestJobEnvelope
+//	@Failure		400	{object}	swagger.ValidationErrorResponse
+//	@Failure		401	{object}	swagger.UnauthorizedResponse
+//	@Failure		404	{object}	swagger.NotFoundResponse
+//	@Failure		500	{object}	swagger.InternalServerErrorResponse
+//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/ingest-jobs/{id} [get]
+func (h *IngestJobsHandler) GetIngestJob(w http.ResponseWriter, r *http.Request) {
+	job, ok := h.loadJob(w, r)
+	if !ok {
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, map[string]any{"ingest_job": ingestJobResponse(job)})
+}
+
+// RetryIngestJob godoc
+//
+//	@Summary		Retry an ingest job
+//	@Description	Queues a failed ingest job again, e.g. after creating the users its records refer to. A job processed in batches resumes after the last batch written.
+//	@Tags			Ingest jobs
+//	@Produce		json
+//	@Param			id	path		string	true	"Ingest job ID (UUID)"
+//	@Success		202	{object}	swagger.IngestJobEnvelope
+//	@Failure		400	{object}	swagger.ValidationErrorResponse
+//	@Failure		401	{object}	swagger.UnauthorizedResponse
+//	@Failure		404	{object}	swagger.NotFoundResponse
+//	@Failure		409	{object}	swagger.ConflictResponse
+//	@Failure		500	{object}	swagger.InternalServerErrorResponse
+//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/ingest-jobs/{id}/retry [post]
+func (h *IngestJobsHandler) RetryIngestJob(w http.ResponseWriter, r *http.Request) {
+	job, ok := h.loadJob(w, r)
+	if !ok {
+		return
+	}
+
+	queued, err := h.store.ResubmitJob(r.Context(), job.ID, job.ClientName, h.pool.ExpiresAt())
+	if errors.Is(err, sql.ErrNoRows) {
+		utils.ConflictResponse(w, r, fmt.Errorf("%w: status is %s", errJobNotFailed, job.Status))
+		return
+	}
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+	h.pool.Notify()
+
+	utils.WriteJSON(w, http.StatusAccepted, map[string]any{"ingest_job": ingestJobResponse(queued)})
+}
+
+// loadJob reads the job in the id path parameter, answering 400 or 404 if
+// it is invalid or does not belong to the client
+func (h *IngestJobsHandler) loadJob(w http.ResponseWriter, r *http.Request) (authsqlc.IngestJob, bool) {
+	id, err := utils.ParseUUID(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return authsqlc.IngestJob{}, false
+	}
+
+	job, err := h.store.GetJob(r.Context(), id, authn.GetClientName(r.Context()))
+	if errors.Is(err, sql.ErrNoRows) {
+		utils.NotFoundResponse(w, r, err)
+		return job, false
+	}
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return job, false
+	}
+	return job, true
+}
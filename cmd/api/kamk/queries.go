@@ -102,8 +102,9 @@ func (h *QueriesHandler) AddQuestionnaire(w http.ResponseWriter, r *http.Request
 //	@Description	Returns questionnaires for a competitor ordered by timestamp DESC
 //	@Tags			KAMK - Queries
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,text/csv,application/vnd.apache.parquet
 //	@Param			user_id	query		integer	true	"sportti_id"
+//	@Param			format	query		string	false	"Response format: json (default), csv or parquet; also negotiated with Accept"
 //	@Success		200		{object}	swagger.KamkQuestionnairesListResponse
 //	@Success		204		"No Content: no rows"
 //	@Failure		400		{object}	swagger.ValidationErrorResponse
@@ -119,7 +120,7 @@ func (h *QueriesHandler) GetQuestionnaires(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	if err := utils.ValidateParams(r, []string{"user_id"}); err != nil {
+	if err := utils.ValidateParams(r, []string{"user_id", "format"}); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
@@ -139,8 +140,14 @@ func (h *QueriesHandler) GetQuestionnaires(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	format, err := utils.NegotiateFormat(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
 	cacheKey := fmt.Sprintf("kamk:queries:list:%d", uid)
-	if h.cache != nil {
+	if h.cache != nil && format == utils.FormatJSON {
 		if cached, err := h.cache.Get(r.Context(), cacheKey); err == nil && cached != "" {
 			utils.WriteJSON(w, http.StatusOK, json.RawMessage(cached))
 			return
@@ -152,12 +159,17 @@ func (h *QueriesHandler) GetQuestionnaires(w http.ResponseWriter, r *http.Reques
 		utils.InternalServerError(w, r, err)
 		return
 	}
-	if len(items) == 0 {
+	if len(items) == 0 && format == utils.FormatJSON {
 		w.Header().Set("Content-Length", "0")
 		w.WriteHeader(http.StatusNoContent)
 		return
 	}
 
+	if format != utils.FormatJSON {
+		utils.WriteTable(w, format, fmt.Sprintf("kamk_questionnaires_%d", uid), items)
+		return
+	}
+
 	resp := map[string]any{"questionnaires": items}
 	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, resp, KAMKCacheTTL)
 	utils.WriteJSON(w, http.StatusOK, resp)
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	klabsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/klab"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/klab"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
@@ -42,7 +43,11 @@ type KlabDataBundleInput struct {
 //	@Accept			json
 //	@Produce		json
 //	@Param			data	body	swagger.KlabDataBulkDoc	true	"klab data"
+//	@Param			partial	query	bool					false	"Write every item on its own and report the outcome of each"
+//	@Param			async	query	bool					false	"Queue the request as an ingest job, see /ingest-jobs"
 //	@Success		201		"Data processed successfully"
+//	@Success		202		{object}	swagger.IngestJobEnvelope	"Queued as an ingest job (async mode)"
+//	@Success		207		{object}	swagger.BulkReport	"Some items failed (partial mode)"
 //	@Failure		400		{object}	swagger.ValidationErrorResponse
 //	@Failure		401		{object}	swagger.UnauthorizedResponse
 //	@Failure		403		{object}	swagger.ForbiddenResponse
@@ -82,9 +87,13 @@ func (h *KlabDataHandler) InsertKlabDataBulk(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	if err := utils.GetValidator().Struct(bundle); err != nil {
-		utils.BadRequestResponse(w, r, err)
-		return
+	// in partial mode rows are validated one by one
+	partial := utils.PartialBulk(r)
+	if !partial {
+		if err := utils.GetValidator().Struct(bundle); err != nil {
+			utils.BadRequestResponse(w, r, err)
+			return
+		}
 	}
 
 	if len(bundle.Customer) == 0 {
@@ -115,6 +124,11 @@ func (h *KlabDataHandler) InsertKlabDataBulk(w http.ResponseWriter, r *http.Requ
 		}
 	}
 
+	if partial {
+		h.insertKlabDataPartial(w, r, sporttiID, custID, bundle)
+		return
+	}
+
 	var p klab.KlabDataPayload
 
 	// 1) customer rows
@@ -129,14 +143,12 @@ func (h *KlabDataHandler) InsertKlabDataBulk(w http.ResponseWriter, r *http.Requ
 
 	// 2) measurement_list
 	for i, m := range bundle.MeasurementList {
-		if m.IdCustomer == nil {
-			m.IdCustomer = &custID
-		} else if *m.IdCustomer != custID {
+		if m.IdCustomer != nil && *m.IdCustomer != custID {
 			utils.BadRequestResponse(w, r, fmt.Errorf("measurement_list[%d].idCustomer must equal customer[0].idCustomer (%d)", i, custID))
 			return
 		}
 
-		arg, err := mapMeasurementToParams(m)
+		arg, err := mapCustomerMeasurementToParams(m, custID)
 		if err != nil {
 			utils.BadRequestResponse(w, r, err)
 			return
@@ -196,21 +208,89 @@ func (h *KlabDataHandler) InsertKlabDataBulk(w http.ResponseWriter, r *http.Requ
 	w.WriteHeader(http.StatusCreated)
 }
 
+// insertKlabDataPartial is the partial mode (?partial=true) of
+// InsertKlabDataBulk: every row is validated, converted and written on its
+// own and the response reports the outcome of each, tagged with its array
+func (h *KlabDataHandler) insertKlabDataPartial(w http.ResponseWriter, r *http.Request, sporttiID string, custID int32, bundle KlabDataBundleInput) {
+	var items klab.KlabDataItems
+	var customers, measurements, dirTests, dirTestSteps, dirReports, dirRawData, dirResults *utils.BulkReport
+
+	items.Customers, customers = utils.PrepareBulk(bundle.Customer, func(c KlabCustomerInput) (klabsqlc.UpsertCustomerParams, error) {
+		return mapCustomerToParams(c, sporttiID)
+	})
+	items.Measurements, measurements = utils.PrepareBulk(bundle.MeasurementList, func(m KlabMeasurementInput) (klabsqlc.InsertMeasurementParams, error) {
+		return mapCustomerMeasurementToParams(m, custID)
+	})
+	items.DirTests, dirTests = utils.PrepareBulk(bundle.DirTest, mapDirTestToParams)
+	items.DirTestSteps, dirTestSteps = utils.PrepareBulk(bundle.DirTestSteps, mapDirTestStepToParams)
+	items.DirReports, dirReports = utils.PrepareBulk(bundle.DirReport, mapDirReportToParams)
+	items.DirRawData, dirRawData = utils.PrepareBulk(bundle.DirRawData, mapDirRawDataToParams)
+	items.DirResults, dirResults = utils.PrepareBulk(bundle.DirResults, mapDirResultsToParams)
+
+	results, err := h.store.InsertKlabDataPartial(r.Context(), items)
+	if err != nil {
+		utils.HandleDatabaseError(w, r, err)
+		return
+	}
+	customers.Record(results.Customers...)
+	measurements.Record(results.Measurements...)
+	dirTests.Record(results.DirTests...)
+	dirTestSteps.Record(results.DirTestSteps...)
+	dirReports.Record(results.DirReports...)
+	dirRawData.Record(results.DirRawData...)
+	dirResults.Record(results.DirResults...)
+
+	report := &utils.BulkReport{Results: []utils.BulkItemResult{}}
+	report.Append("customer", customers)
+	report.Append("measurement_list", measurements)
+	report.Append("dirtest", dirTests)
+	report.Append("dirteststeps", dirTestSteps)
+	report.Append("dirreport", dirReports)
+	report.Append("dirrawdata", dirRawData)
+	report.Append("dirresults", dirResults)
+
+	invalidateKlabAll(r.Context(), h.cache, sporttiID)
+
+	if err := utils.WriteBulkReport(w, report); err != nil {
+		utils.InternalServerError(w, r, err)
+	}
+}
+
+// mapCustomerMeasurementToParams ties a measurement to the customer of the
+// bundle
+func mapCustomerMeasurementToParams(m KlabMeasurementInput, custID int32) (klabsqlc.InsertMeasurementParams, error) {
+	if m.IdCustomer == nil {
+		m.IdCustomer = &custID
+	} else if *m.IdCustomer != custID {
+		return klabsqlc.InsertMeasurementParams{}, fmt.Errorf("idCustomer must equal customer[0].idCustomer (%d)", custID)
+	}
+	return mapMeasurementToParams(m)
+}
+
+type KlabDataParams struct {
+	ID    string `validate:"required,numeric"`
+	Table string `validate:"omitempty,oneof=dirteststeps dirresults"`
+}
+
 // GetKlabData godoc
 //
 //	@Summary		Get kLab data by Sportti ID
-//	@Description	Returns measurement_list + all child tables for the given customer (no customer row)
+//	@Description	Returns a page of measurement_list + the child tables of those measurements for the given customer (no customer row)
 //	@Tags			KLAB - Data
 //	@Accept			json
-//	@Produce		json
-//	@Param			id	query		string	true	"Sportti ID"
-//	@Success		200	{object}	swagger.KlabDataResponse
-//	@Failure		400	{object}	swagger.ValidationErrorResponse
-//	@Failure		401	{object}	swagger.UnauthorizedResponse
-//	@Failure		403	{object}	swagger.ForbiddenResponse
-//	@Failure		404	{object}	swagger.NotFoundResponse
-//	@Failure		500	{object}	swagger.InternalServerErrorResponse
-//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Produce		json,application/x-ndjson,text/csv,application/vnd.apache.parquet
+//	@Param			id		query		string	true	"Sportti ID"
+//	@Param			limit	query		int		false	"Measurements per page (default: 100, max: 1000)"
+//	@Param			cursor	query		string	false	"Opaque cursor from pagination.next_cursor of the previous page"
+//	@Param			table	query		string	false	"Child table to export as CSV or Parquet (dirteststeps, dirresults)"
+//	@Param			format	query		string	false	"Response format: json (default), csv or parquet; also negotiated with Accept"
+//	@Success		200		{object}	swagger.KlabDataResponse
+//	@Failure		400		{object}	swagger.ValidationErrorResponse
+//	@Failure		401		{object}	swagger.UnauthorizedResponse
+//	@Failure		403		{object}	swagger.ForbiddenResponse
+//	@Failure		404		{object}	swagger.NotFoundResponse
+//	@Failure		500		{object}	swagger.InternalServerErrorResponse
+//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/klab/data [get]
 func (h *KlabDataHandler) GetKlabData(w http.ResponseWriter, r *http.Request) {
@@ -219,13 +299,14 @@ func (h *KlabDataHandler) GetKlabData(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := utils.ValidateParams(r, []string{"id"}); err != nil {
+	if err := utils.ValidateParams(r, []string{"id", "table", "limit", "cursor", "format"}); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
 
-	params := KlabUserParams{
-		ID: r.URL.Query().Get("id"),
+	params := KlabDataParams{
+		ID:    r.URL.Query().Get("id"),
+		Table: r.URL.Query().Get("table"),
 	}
 
 	if err := utils.GetValidator().Struct(params); err != nil {
@@ -239,9 +320,27 @@ func (h *KlabDataHandler) GetKlabData(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if h.cache != nil {
-		cacheKey := fmt.Sprintf("%s:%s", klabDataPrefix, sporttiID)
+	page, err := utils.ParsePage(r, utils.DefaultPageLimits, utils.CursorN)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	format, err := utils.NegotiateFormat(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	if format != utils.FormatJSON && params.Table == "" {
+		utils.BadRequestResponse(w, r, fmt.Errorf("table is required for %s exports", format))
+		return
+	}
+
+	cacheKey := fmt.Sprintf("%s:%s:%s", klabDataPrefix, sporttiID, page.CacheKey())
+	if h.cache != nil && format == utils.FormatJSON && !utils.WantsNDJSON(r) {
 		if cached, err := h.cache.Get(r.Context(), cacheKey); err == nil && cached != "" {
+			utils.SetNextLink(w, r, utils.CachedNextCursor(cached))
 			utils.WriteJSON(w, http.StatusOK, json.RawMessage(cached))
 			return
 		}
@@ -257,7 +356,7 @@ func (h *KlabDataHandler) GetKlabData(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	res, err := h.store.GetDataByCustomerIDNoCustomer(r.Context(), idcustomer)
+	res, err := h.store.GetDataByCustomerIDNoCustomer(r.Context(), idcustomer, page)
 	if err == sql.ErrNoRows {
 		utils.NotFoundResponse(w, r, err)
 		return
@@ -267,16 +366,39 @@ func (h *KlabDataHandler) GetKlabData(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	body := map[string]any{
-		"customer_id":  res.CustomerID,
-		"measurements": res.Measurements,
-		"dirtest":      res.DirTests,
-		"dirteststeps": res.DirTestSteps,
-		"dirreport":    res.DirReports,
-		"dirrawdata":   res.DirRawData,
-		"dirresults":   res.DirResults,
+	if format != utils.FormatJSON {
+		name := fmt.Sprintf("klab_%s_%s", params.Table, sporttiID)
+		utils.SetNextLink(w, r, res.Pagination.NextCursor)
+		switch params.Table {
+		case "dirteststeps":
+			utils.WriteTable(w, format, name, res.DirTestSteps)
+		case "dirresults":
+			utils.WriteTable(w, format, name, res.DirResults)
+		}
+		return
+	}
+
+	// stream the arrays one by one; NDJSON rows are tagged with their array name
+	sw := utils.NewStreamWriter(w, r)
+	sw.TagRows()
+	utils.SetNextLink(w, r, res.Pagination.NextCursor)
+	sw.Field("customer_id", res.CustomerID)
+	for _, err := range []error{
+		utils.StreamArray(sw, "measurements", res.Measurements),
+		utils.StreamArray(sw, "dirtest", res.DirTests),
+		utils.StreamArray(sw, "dirteststeps", res.DirTestSteps),
+		utils.StreamArray(sw, "dirreport", res.DirReports),
+		utils.StreamArray(sw, "dirrawdata", res.DirRawData),
+		utils.StreamArray(sw, "dirresults", res.DirResults),
+	} {
+		if err != nil {
+			return
+		}
+	}
+	sw.Field("pagination", res.Pagination)
+	if err := sw.Close(); err != nil {
+		return
 	}
 
-	cache.SetCacheJSON(r.Context(), h.cache, fmt.Sprintf("%s:%s", klabDataPrefix, sporttiID), body, KLABCacheTTL)
-	utils.WriteJSON(w, http.StatusOK, body)
+	cache.SetCacheStream(r.Context(), h.cache, cacheKey, sw, KLABCacheTTL)
 }
@@ -3,16 +3,25 @@ package main
 import (
 	"context"
 	"expvar"
+	"flag"
+	"log"
+	"os"
 	"runtime"
-	"time"
 
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authn"
+	"github.com/DeRuina/KUHA-REST-API/internal/config"
 	"github.com/DeRuina/KUHA-REST-API/internal/db"
 	"github.com/DeRuina/KUHA-REST-API/internal/env"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
+	"github.com/DeRuina/KUHA-REST-API/internal/exports"
+	"github.com/DeRuina/KUHA-REST-API/internal/idempotency"
+	"github.com/DeRuina/KUHA-REST-API/internal/ingest"
 	"github.com/DeRuina/KUHA-REST-API/internal/logger"
 	"github.com/DeRuina/KUHA-REST-API/internal/ratelimiter"
 	"github.com/DeRuina/KUHA-REST-API/internal/store"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/internal/webhooks"
 )
 
 const version = "1.3.3"
@@ -29,59 +38,50 @@ const version = "1.3.3"
 // @description				Use format: Bearer your_JWT_here
 func main() {
 
-	cfg := config{
-		addr:   env.GetString("ADDR", ":8080"),
-		apiURL: env.GetString("EXTERNAL_URL", "localhost:8080"),
-		db: dbConfig{
-			fisAddr:        env.GetString("FIS_DB_ADDR", ""),
-			utvAddr:        env.GetString("UTV_DB_ADDR", ""),
-			authAddr:       env.GetString("AUTH_DB_ADDR", ""),
-			tietoevryAddr:  env.GetString("TIETOEVRY_DB_ADDR", ""),
-			kamkAddr:       env.GetString("KAMK_DB_ADDR", ""),
-			klabAddr:       env.GetString("KLAB_DB_ADDR", ""),
-			archinisisAddr: env.GetString("ARCHINISIS_DB_ADDR", ""),
-			maxOpenConns:   env.GetInt("DB_MAX_OPEN_CONNS", 30),
-			maxIdleConns:   env.GetInt("DB_MAX_IDLE_CONNS", 30),
-			maxIdleTime:    env.GetString("DB_MAX_IDLE_TIME", "15m"),
-		},
-		redisCfg: redisConfig{
-			addr:    env.GetString("REDIS_ADDR", "localhost:6379"),
-			pw:      env.GetString("REDIS_PW", ""),
-			db:      env.GetInt("REDIS_DB", 0),
-			enabled: env.GetBool("REDIS_ENABLED", false),
-		},
-		env: env.GetString("ENV", "development"),
-		auth: authConfig{
-			basic: basicConfig{
-				user: env.GetString("BASIC_AUTH_USER", ""),
-				pass: env.GetString("BASIC_AUTH_PASS", ""),
-			},
-			jwt: jwtConfig{
-				secret:   []byte(env.GetString("JWT_SECRET", "")),
-				issuer:   env.GetString("JWT_ISSUER", ""),
-				audience: env.GetString("JWT_AUDIENCE", ""),
-			},
-		},
-		rateLimiter: ratelimiter.Config{
-			RequestsPerTimeFrame: env.GetInt("RATELIMITER_REQUESTS_COUNT", 20),
-			TimeFrame:            time.Second * 5,
-			Enabled:              env.GetBool("RATE_LIMITER_ENABLED", true),
-		},
+	configPath := flag.String("config", env.GetString("CONFIG_FILE", ""), "path to a YAML or TOML configuration file")
+	printConfig := flag.Bool("print-config", false, "print the effective configuration with secrets redacted and exit")
+	flag.Parse()
+
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		log.Fatalf("failed to load configuration: %v", err)
+	}
+
+	if *printConfig {
+		if err := cfg.Print(os.Stdout); err != nil {
+			log.Fatalf("failed to print configuration: %v", err)
+		}
+		if err := cfg.Validate(); err != nil {
+			log.Fatalf("invalid configuration:\n%v", err)
+		}
+		return
+	}
+
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("invalid configuration:\n%v", err)
 	}
 
+	// Limits
+	utils.SetQueryTimeouts(cfg.DB.QueryTimeout, cfg.DB.BulkQueryTimeout)
+	utils.SetCopyThreshold(cfg.DB.CopyThreshold)
+	utils.SetDefaultBodyLimits(utils.BodyLimits{
+		MaxBytes:             cfg.HTTP.Limits.MaxBodyBytes,
+		MaxGzipBytes:         cfg.HTTP.Limits.MaxGzipBytes,
+		MaxDecompressedBytes: cfg.HTTP.Limits.MaxDecompressedBytes,
+	})
+
 	// Rate limiter
 	var redisLimiter *ratelimiter.RedisSlidingLimiter
 	var localLimiter *ratelimiter.FixedWindowRateLimiter
 
 	// Logger
-	logDir := env.GetString("LOG_DIR", "./logs")
-	logger.Init(logDir)
+	logger.Init(cfg.Log.Dir)
 	defer logger.Cleanup()
 
 	// Cache
 	var cacheStorage *cache.Storage
-	if cfg.redisCfg.enabled {
-		rdb := cache.NewRedisClient(cfg.redisCfg.addr, cfg.redisCfg.pw, cfg.redisCfg.db)
+	if cfg.Redis.Enabled {
+		rdb := cache.NewRedisClient(cfg.Redis.Addr, cfg.Redis.PW, cfg.Redis.DB)
 		defer rdb.Close()
 
 		if err := rdb.Ping(context.Background()).Err(); err != nil {
@@ -94,23 +94,23 @@ func main() {
 	} else {
 		logger.Logger.Info("Redis cache disabled by configuration")
 		localLimiter = ratelimiter.NewFixedWindowLimiter(
-			cfg.rateLimiter.RequestsPerTimeFrame,
-			cfg.rateLimiter.TimeFrame,
+			cfg.RateLimiter.RequestsPerTimeFrame,
+			cfg.RateLimiter.TimeFrame,
 		)
 	}
 
 	// Database - Connect with graceful failure handling
 	databases, dbErrors := db.NewWithGracefulFailure(
-		cfg.db.fisAddr,
-		cfg.db.utvAddr,
-		cfg.db.authAddr,
-		cfg.db.tietoevryAddr,
-		cfg.db.kamkAddr,
-		cfg.db.klabAddr,
-		cfg.db.archinisisAddr,
-		cfg.db.maxOpenConns,
-		cfg.db.maxIdleConns,
-		cfg.db.maxIdleTime,
+		cfg.DB.FISAddr,
+		cfg.DB.UTVAddr,
+		cfg.DB.AuthAddr,
+		cfg.DB.TietoevryAddr,
+		cfg.DB.KAMKAddr,
+		cfg.DB.KLABAddr,
+		cfg.DB.ArchinisisAddr,
+		cfg.DB.MaxOpenConns,
+		cfg.DB.MaxIdleConns,
+		cfg.DB.MaxIdleTime.String(),
 	)
 
 	// Log connection status for each database
@@ -151,20 +151,76 @@ func main() {
 
 	// Authentication
 	authn.LoadJWTConfig(authn.JWTConfig{
-		Secret:   cfg.auth.jwt.secret,
-		Issuer:   cfg.auth.jwt.issuer,
-		Audience: cfg.auth.jwt.audience,
+		Secret:   []byte(cfg.Auth.JWT.Secret),
+		Issuer:   cfg.Auth.JWT.Issuer,
+		Audience: cfg.Auth.JWT.Audience,
 	})
 
 	// Storage
 	store := store.NewStorage(databases)
 
 	app := &api{
-		config:           cfg,
+		config:           *cfg,
 		store:            *store,
 		cacheStorage:     cacheStorage,
 		redisRateLimiter: redisLimiter,
 		localRateLimiter: localLimiter,
+		inflight:         newInflightTracker(),
+	}
+
+	if cfg.Exports.Enabled && store.Auth != nil {
+		app.exports = exports.NewPool(store.Auth.ExportJobs(), exports.NewRegistry(*store), exports.Options{
+			Workers:      cfg.Exports.Workers,
+			PollInterval: cfg.Exports.PollInterval,
+			TTL:          cfg.Exports.TTL,
+			ChunkSize:    cfg.Exports.ChunkSize,
+			StaleAfter:   cfg.Exports.StaleAfter,
+		})
+		app.exports.Start()
+	}
+
+	if cfg.Ingest.Enabled && store.Auth != nil {
+		app.ingest = ingest.NewPool(store.Auth.IngestJobs(), ingest.Options{
+			Workers:      cfg.Ingest.Workers,
+			PollInterval: cfg.Ingest.PollInterval,
+			TTL:          cfg.Ingest.TTL,
+			BatchSize:    cfg.Ingest.BatchSize,
+			MaxAttempts:  cfg.Ingest.MaxAttempts,
+			RetryBackoff: cfg.Ingest.RetryBackoff,
+			StaleAfter:   cfg.Ingest.StaleAfter,
+		})
+	}
+
+	// writes emit change events once the outbox is set up
+	if cfg.Events.Enabled && store.Auth != nil {
+		var notify func()
+		if cfg.Webhooks.Enabled {
+			app.webhooks = webhooks.NewDispatcher(store.Auth.Webhooks(), webhooks.Options{
+				Workers:      cfg.Webhooks.Workers,
+				PollInterval: cfg.Webhooks.PollInterval,
+				BatchSize:    cfg.Webhooks.BatchSize,
+				Timeout:      cfg.Webhooks.Timeout,
+				MaxAttempts:  cfg.Webhooks.MaxAttempts,
+				RetryBackoff: cfg.Webhooks.RetryBackoff,
+				AllowPrivate: cfg.Webhooks.AllowPrivate,
+			}, version)
+			notify = app.webhooks.Notify
+			app.webhooks.Start()
+		}
+
+		app.outbox = events.NewOutbox(store.Auth.Webhooks(), cfg.Events.Retention, notify)
+		events.SetPublisher(app.outbox)
+		app.outbox.Start()
+
+		app.events = events.NewHub(store.Auth.Webhooks(), events.HubOptions{
+			PollInterval: cfg.Events.PollInterval,
+			MaxReplay:    cfg.Events.MaxReplay,
+		})
+		app.events.Start()
+	}
+
+	if cfg.Idempotency.Enabled {
+		app.idempotency = newIdempotencyStore(cfg.Idempotency.Backend, cacheStorage, store.Auth)
 	}
 
 	// metrics
@@ -215,11 +271,36 @@ func main() {
 		return nil
 	}))
 
+	expvar.Publish("inflight_writes", expvar.Func(func() any {
+		return app.inflight.Count()
+	}))
+
 	expvar.Publish("goroutines", expvar.Func(func() any {
 		return runtime.NumGoroutine()
 	}))
 
 	mux := app.mount()
 
+	// the routes register the handlers of the ingest jobs
+	if app.ingest != nil {
+		app.ingest.Start()
+	}
+
 	logger.Logger.Fatal(app.run(mux))
 }
+
+// newIdempotencyStore picks where idempotency keys are kept. It returns nil,
+// leaving Idempotency-Key headers ignored, if the backend is not available.
+func newIdempotencyStore(backend string, cacheStorage *cache.Storage, authStore store.Auth) idempotency.Store {
+	switch {
+	case backend != "postgres" && cacheStorage != nil:
+		logger.Logger.Info("idempotency keys stored in Redis")
+		return idempotency.NewRedisStore(cacheStorage)
+	case backend != "redis" && authStore != nil:
+		logger.Logger.Info("idempotency keys stored in the auth database")
+		return idempotency.NewPostgresStore(authStore.IdempotencyKeys())
+	default:
+		logger.Logger.Warnw("idempotency keys disabled: backend not available", "backend", backend)
+		return nil
+	}
+}
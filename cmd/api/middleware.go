@@ -2,14 +2,19 @@ package main
 
 import (
 	"compress/gzip"
+	"database/sql"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
 
+	fisapi "github.com/DeRuina/KUHA-REST-API/cmd/api/fis"
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authn"
 	"github.com/DeRuina/KUHA-REST-API/internal/ratelimiter"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/auth"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/go-chi/chi/v5/middleware"
 )
 
 func (app *api) BasicAuthMiddleware() func(http.Handler) http.Handler {
@@ -37,8 +42,8 @@ func (app *api) BasicAuthMiddleware() func(http.Handler) http.Handler {
 			}
 
 			// check the credentials
-			username := app.config.auth.basic.user
-			pass := app.config.auth.basic.pass
+			username := app.config.Auth.Basic.User
+			pass := app.config.Auth.Basic.Pass
 
 			creds := strings.SplitN(string(decoded), ":", 2)
 			if len(creds) != 2 || creds[0] != username || creds[1] != pass {
@@ -51,10 +56,28 @@ func (app *api) BasicAuthMiddleware() func(http.Handler) http.Handler {
 	}
 }
 
-func JWTMiddleware() func(http.Handler) http.Handler {
+// JWTMiddleware authenticates the client by its bearer token. Without an
+// Authorization header, the iCalendar of the FIS calendar can also be read
+// with the token of a calendar feed, when feeds is not nil.
+func JWTMiddleware(feeds auth.CalendarFeeds) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			authHeader := r.Header.Get("Authorization")
+			if authHeader == "" && feeds != nil && isCalendarFeedRequest(r) {
+				feed, err := feeds.UseFeed(r.Context(), r.URL.Query().Get("token"))
+				if errors.Is(err, sql.ErrNoRows) {
+					utils.UnauthorizedResponse(w, r, fmt.Errorf("invalid calendar feed token"))
+					return
+				}
+				if err != nil {
+					utils.InternalServerError(w, r, err)
+					return
+				}
+
+				ctx := authn.WithClientMetadata(r.Context(), feed.ClientName, feed.Role)
+				next.ServeHTTP(w, r.WithContext(ctx))
+				return
+			}
 			if authHeader == "" || !strings.HasPrefix(authHeader, "Bearer ") {
 				utils.UnauthorizedResponse(w, r, fmt.Errorf("missing or malformed Authorization header"))
 				return
@@ -83,6 +106,16 @@ func JWTMiddleware() func(http.Handler) http.Handler {
 	}
 }
 
+// isCalendarFeedRequest tells whether r reads the calendar as iCalendar
+// with a feed token, the only request a feed token is accepted for
+func isCalendarFeedRequest(r *http.Request) bool {
+	q := r.URL.Query()
+	return r.Method == http.MethodGet &&
+		r.URL.Path == fisapi.CalendarPath &&
+		strings.EqualFold(q.Get("format"), "ics") &&
+		q.Get("token") != ""
+}
+
 func ExtractClientIDMiddleware() func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -109,7 +142,7 @@ func ExtractClientIDMiddleware() func(http.Handler) http.Handler {
 
 func (app *api) RateLimiterMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if !app.config.rateLimiter.Enabled {
+		if !app.config.RateLimiter.Enabled {
 			next.ServeHTTP(w, r)
 			return
 		}
@@ -151,10 +184,9 @@ func GzipDecompressionMiddleware() func(http.Handler) http.Handler {
 				return
 			}
 
-			const maxCompressed = int64(200 * 1024 * 1024)    // 200 MB (compressed)
-			const maxDecompressed = int64(1024 * 1024 * 1024) // 1 GB   (after decompression)
+			limits := utils.GetBodyLimits(r.Context())
 
-			r.Body = http.MaxBytesReader(w, r.Body, maxCompressed)
+			r.Body = http.MaxBytesReader(w, r.Body, limits.MaxGzipBytes)
 
 			gz, err := gzip.NewReader(r.Body)
 			if err != nil {
@@ -163,7 +195,7 @@ func GzipDecompressionMiddleware() func(http.Handler) http.Handler {
 			}
 			defer gz.Close()
 
-			rc := http.MaxBytesReader(w, gz, maxDecompressed)
+			rc := http.MaxBytesReader(w, gz, limits.MaxDecompressedBytes)
 
 			r.Body = rc
 
@@ -174,3 +206,31 @@ func GzipDecompressionMiddleware() func(http.Handler) http.Handler {
 		})
 	}
 }
+
+// RouteLimitsMiddleware applies the deadline and body limits configured for a
+// route group. GET/HEAD requests get the read deadline, everything else the
+// write deadline, so lookups fail fast while bulk ingestion can run longer.
+func (app *api) RouteLimitsMiddleware(group string) func(http.Handler) http.Handler {
+	limits := app.config.HTTP.ForRoute(group)
+	bodyLimits := utils.BodyLimits{
+		MaxBytes:             limits.MaxBodyBytes,
+		MaxGzipBytes:         limits.MaxGzipBytes,
+		MaxDecompressedBytes: limits.MaxDecompressedBytes,
+	}
+
+	return func(next http.Handler) http.Handler {
+		read := middleware.Timeout(limits.ReadDeadline)(next)
+		write := middleware.Timeout(limits.WriteDeadline)(next)
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			r = r.WithContext(utils.WithBodyLimits(r.Context(), bodyLimits))
+
+			switch r.Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions:
+				read.ServeHTTP(w, r)
+			default:
+				write.ServeHTTP(w, r)
+			}
+		})
+	}
+}
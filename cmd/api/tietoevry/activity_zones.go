@@ -51,7 +51,11 @@ type TietoevryActivityZonesBulkInput struct {
 //	@Accept			json
 //	@Produce		json
 //	@Param			activity_zones	body	swagger.TietoevryActivityZonesBulkInput	true	"Activity zone summaries"
+//	@Param			partial			query	bool									false	"Write every item on its own and report the outcome of each"
+//	@Param			async			query	bool									false	"Queue the request as an ingest job, see /ingest-jobs"
 //	@Success		201				"Activity zones processed successfully (idempotent operation)"
+//	@Success		202				{object}	swagger.IngestJobEnvelope	"Queued as an ingest job (async mode)"
+//	@Success		207				{object}	swagger.BulkReport	"Some items failed (partial mode)"
 //	@Failure		400				{object}	swagger.ValidationErrorResponse
 //	@Failure		401				{object}	swagger.UnauthorizedResponse
 //	@Failure		403				{object}	swagger.ForbiddenResponse
@@ -71,6 +75,15 @@ func (h *TietoevryActivityZoneHandler) InsertActivityZonesBulk(w http.ResponseWr
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
+
+	if utils.PartialBulk(r) {
+		insertPartial(w, r, h.cache, tzPrefix, input.ActivityZones, activityZoneParams,
+			func(p tietoevrysqlc.InsertActivityZoneParams) uuid.UUID { return p.UserID },
+			h.store.InsertActivityZonesPartial,
+		)
+		return
+	}
+
 	if err := utils.GetValidator().Struct(input); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -94,47 +107,12 @@ func (h *TietoevryActivityZoneHandler) InsertActivityZonesBulk(w http.ResponseWr
 
 	activityZones := make([]tietoevrysqlc.InsertActivityZoneParams, len(input.ActivityZones))
 	for i, activityZone := range input.ActivityZones {
-		// Parse and convert values
-		userID, err := utils.ParseUUID(activityZone.UserID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		date, err := utils.ParseDate(activityZone.Date)
+		arg, err := activityZoneParams(activityZone)
 		if err != nil {
 			utils.BadRequestResponse(w, r, err)
 			return
 		}
-
-		createdAt, err := utils.ParseTimestamp(activityZone.CreatedAt)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		updatedAt, err := utils.ParseTimestamp(activityZone.UpdatedAt)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		rawData := utils.ParseRawJSON(activityZone.RawData)
-
-		activityZones[i] = tietoevrysqlc.InsertActivityZoneParams{
-			UserID:         userID,
-			Date:           date,
-			CreatedAt:      createdAt,
-			UpdatedAt:      updatedAt,
-			SecondsInZone0: utils.NullFloat64Ptr(activityZone.SecondsInZone0),
-			SecondsInZone1: utils.NullFloat64Ptr(activityZone.SecondsInZone1),
-			SecondsInZone2: utils.NullFloat64Ptr(activityZone.SecondsInZone2),
-			SecondsInZone3: utils.NullFloat64Ptr(activityZone.SecondsInZone3),
-			SecondsInZone4: utils.NullFloat64Ptr(activityZone.SecondsInZone4),
-			SecondsInZone5: utils.NullFloat64Ptr(activityZone.SecondsInZone5),
-			Source:         activityZone.Source,
-			RawData:        rawData,
-		}
+		activityZones[i] = arg
 	}
 
 	if err := h.store.InsertActivityZonesBulk(r.Context(), activityZones); err != nil {
@@ -157,8 +135,52 @@ func (h *TietoevryActivityZoneHandler) InsertActivityZonesBulk(w http.ResponseWr
 	w.WriteHeader(http.StatusCreated)
 }
 
+// activityZoneParams converts one item of the bulk input
+func activityZoneParams(activityZone TietoevryActivityZoneInput) (tietoevrysqlc.InsertActivityZoneParams, error) {
+	// Parse and convert values
+	userID, err := utils.ParseUUID(activityZone.UserID)
+	if err != nil {
+		return tietoevrysqlc.InsertActivityZoneParams{}, err
+	}
+
+	date, err := utils.ParseDate(activityZone.Date)
+	if err != nil {
+		return tietoevrysqlc.InsertActivityZoneParams{}, err
+	}
+
+	createdAt, err := utils.ParseTimestamp(activityZone.CreatedAt)
+	if err != nil {
+		return tietoevrysqlc.InsertActivityZoneParams{}, err
+	}
+
+	updatedAt, err := utils.ParseTimestamp(activityZone.UpdatedAt)
+	if err != nil {
+		return tietoevrysqlc.InsertActivityZoneParams{}, err
+	}
+
+	rawData := utils.ParseRawJSON(activityZone.RawData)
+
+	return tietoevrysqlc.InsertActivityZoneParams{
+		UserID:         userID,
+		Date:           date,
+		CreatedAt:      createdAt,
+		UpdatedAt:      updatedAt,
+		SecondsInZone0: utils.NullFloat64Ptr(activityZone.SecondsInZone0),
+		SecondsInZone1: utils.NullFloat64Ptr(activityZone.SecondsInZone1),
+		SecondsInZone2: utils.NullFloat64Ptr(activityZone.SecondsInZone2),
+		SecondsInZone3: utils.NullFloat64Ptr(activityZone.SecondsInZone3),
+		SecondsInZone4: utils.NullFloat64Ptr(activityZone.SecondsInZone4),
+		SecondsInZone5: utils.NullFloat64Ptr(activityZone.SecondsInZone5),
+		Source:         activityZone.Source,
+		RawData:        rawData,
+	}, nil
+}
+
 type TietoevryActivityZoneParams struct {
 	UserID string `json:"user_id" validate:"required,uuid4"`
+	From   string `json:"from" validate:"omitempty,datetime=2006-01-02"`
+	To     string `json:"to" validate:"omitempty,datetime=2006-01-02"`
+	Source string `json:"source" validate:"omitempty,max=100"`
 }
 
 // GetActivityZones godoc
@@ -169,6 +191,11 @@ type TietoevryActivityZoneParams struct {
 //	@Accept			json
 //	@Produce		json
 //	@Param			user_id	query		string	true	"User ID (UUID)"
+//	@Param			from	query		string	false	"Only rows on or after this day (date, YYYY-MM-DD)"
+//	@Param			to		query		string	false	"Only rows on or before this day (date, YYYY-MM-DD)"
+//	@Param			source	query		string	false	"Filter by source"
+//	@Param			limit	query		int		false	"Page size (default: 100, max: 1000)"
+//	@Param			cursor	query		string	false	"Opaque cursor from pagination.next_cursor of the previous page"
 //	@Success		200		{object}	swagger.TietoevryActivityZoneResponse
 //	@Failure		400		{object}	swagger.ValidationErrorResponse
 //	@Failure		401		{object}	swagger.UnauthorizedResponse
@@ -183,13 +210,16 @@ func (h *TietoevryActivityZoneHandler) GetActivityZones(w http.ResponseWriter, r
 		return
 	}
 
-	if err := utils.ValidateParams(r, []string{"user_id"}); err != nil {
+	if err := utils.ValidateParams(r, []string{"user_id", "from", "to", "source", "limit", "cursor"}); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
 
 	params := TietoevryActivityZoneParams{
 		UserID: r.URL.Query().Get("user_id"),
+		From:   r.URL.Query().Get("from"),
+		To:     r.URL.Query().Get("to"),
+		Source: r.URL.Query().Get("source"),
 	}
 
 	if err := utils.GetValidator().Struct(params); err != nil {
@@ -197,9 +227,22 @@ func (h *TietoevryActivityZoneHandler) GetActivityZones(w http.ResponseWriter, r
 		return
 	}
 
-	cacheKey := fmt.Sprintf("tietoevry:activity-zones:%s", params.UserID)
+	page, err := utils.ParsePage(r, utils.DefaultPageLimits, utils.CursorTime|utils.CursorKey)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	filter, err := readListFilter(r)
+	if err != nil {
+		utils.UnprocessableEntityResponse(w, r, err)
+		return
+	}
+
+	cacheKey := fmt.Sprintf("tietoevry:activity-zones:%s:%s:%s", params.UserID, filterCacheKey(r), page.CacheKey())
 	if h.cache != nil {
 		if cached, err := h.cache.Get(r.Context(), cacheKey); err == nil && cached != "" {
+			utils.SetNextLink(w, r, utils.CachedNextCursor(cached))
 			utils.WriteJSON(w, http.StatusOK, json.RawMessage(cached))
 			return
 		}
@@ -211,15 +254,19 @@ func (h *TietoevryActivityZoneHandler) GetActivityZones(w http.ResponseWriter, r
 		return
 	}
 
-	activityZones, err := h.store.GetActivityZonesByUser(r.Context(), userID)
+	activityZones, err := h.store.GetActivityZonesByUser(r.Context(), userID, filter, page)
 	if err != nil {
 		utils.InternalServerError(w, r, err)
 		return
 	}
+	activityZones, pageInfo := utils.NextPage(activityZones, page, func(z tietoevrysqlc.ActivityZone) utils.Cursor {
+		return utils.Cursor{Time: &z.Date, Key: &z.Source}
+	})
 
 	if len(activityZones) == 0 {
 		utils.WriteJSON(w, http.StatusOK, map[string]any{
 			"activity_zones": []swagger.TietoevryActivityZoneInput{},
+			"pagination":     pageInfo,
 		})
 		return
 	}
@@ -243,7 +290,8 @@ func (h *TietoevryActivityZoneHandler) GetActivityZones(w http.ResponseWriter, r
 		output = append(output, out)
 	}
 
-	resp := map[string]any{"activity_zones": output}
+	resp := map[string]any{"activity_zones": output, "pagination": pageInfo}
 	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, resp, TietoevryCacheTTL)
+	utils.SetNextLink(w, r, pageInfo.NextCursor)
 	utils.WriteJSON(w, http.StatusOK, resp)
 }
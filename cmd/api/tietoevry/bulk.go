@@ -0,0 +1,57 @@
+package tietoevryapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/google/uuid"
+)
+
+// insertPartial is the partial mode (?partial=true) of the bulk insert
+// handlers: every item is validated, converted and written on its own and
+// the response reports the outcome of each
+func insertPartial[In, Out any](
+	w http.ResponseWriter,
+	r *http.Request,
+	c *cache.Storage,
+	prefix string,
+	input []In,
+	convert func(In) (Out, error),
+	userOf func(Out) uuid.UUID,
+	insert func(context.Context, []utils.BulkItem[Out]) ([]utils.BulkItemResult, error),
+) {
+	if len(input) == 0 {
+		utils.BadRequestResponse(w, r, errors.New("at least one item is required"))
+		return
+	}
+
+	items, report := utils.PrepareBulk(input, convert)
+
+	if len(items) > 0 {
+		results, err := insert(r.Context(), items)
+		if err != nil {
+			utils.HandleDatabaseError(w, r, err)
+			return
+		}
+		report.Record(results...)
+	}
+
+	if c != nil {
+		seen := map[uuid.UUID]struct{}{}
+		for _, item := range items {
+			uid := userOf(item.Value)
+			if _, ok := seen[uid]; ok {
+				continue
+			}
+			seen[uid] = struct{}{}
+			invalidateTietoevry(r.Context(), c, uid, prefix)
+		}
+	}
+
+	if err := utils.WriteBulkReport(w, report); err != nil {
+		utils.InternalServerError(w, r, err)
+	}
+}
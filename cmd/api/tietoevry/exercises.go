@@ -99,7 +99,12 @@ type TietoevryExercisesBulkInput struct {
 }
 
 type TietoevryExerciseParams struct {
-	UserID string `form:"user_id" validate:"required,uuid4"`
+	UserID    string `form:"user_id" validate:"required,uuid4"`
+	From      string `form:"from" validate:"omitempty,datetime=2006-01-02"`
+	To        string `form:"to" validate:"omitempty,datetime=2006-01-02"`
+	Source    string `form:"source" validate:"omitempty,max=100"`
+	SportType string `form:"sport_type" validate:"omitempty,max=100"`
+	Include   string `form:"include" validate:"omitempty,max=100"`
 }
 
 // InsertExercise godoc
@@ -110,7 +115,11 @@ type TietoevryExerciseParams struct {
 //	@Accept			json
 //	@Produce		json
 //	@Param			exercise	body	swagger.TietoevryExercisesBulkInput	true	"Exercise data"
+//	@Param			partial		query	bool								false	"Write every item on its own and report the outcome of each"
+//	@Param			async		query	bool								false	"Queue the request as an ingest job, see /ingest-jobs"
 //	@Success		201			"Exercises processed successfully (idempotent operation)"
+//	@Success		202			{object}	swagger.IngestJobEnvelope	"Queued as an ingest job (async mode)"
+//	@Success		207			{object}	swagger.BulkReport	"Some items failed (partial mode)"
 //	@Failure		400			{object}	swagger.ValidationErrorResponse
 //	@Failure		401			{object}	swagger.UnauthorizedResponse
 //	@Failure		403			{object}	swagger.ForbiddenResponse
@@ -130,6 +139,15 @@ func (h *TietoevryExerciseHandler) InsertExercisesBulk(w http.ResponseWriter, r
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
+
+	if utils.PartialBulk(r) {
+		insertPartial(w, r, h.cache, exPrefix, input.Exercises, exercisePayload,
+			func(p tietoevry.ExercisePayload) uuid.UUID { return p.Exercise.UserID },
+			h.store.InsertExercisesPartial,
+		)
+		return
+	}
+
 	if err := utils.GetValidator().Struct(input); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -153,134 +171,13 @@ func (h *TietoevryExerciseHandler) InsertExercisesBulk(w http.ResponseWriter, r
 
 	// Convert all exercises to ExercisePayload
 	exercises := make([]tietoevry.ExercisePayload, len(input.Exercises))
-
 	for i, exercise := range input.Exercises {
-		// Parse UUIDs and timestamps
-		exerciseID, err := utils.ParseUUID(exercise.ID)
+		arg, err := exercisePayload(exercise)
 		if err != nil {
 			utils.BadRequestResponse(w, r, err)
 			return
 		}
-		userID, err := utils.ParseUUID(exercise.UserID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		createdAt, err := utils.ParseTimestamp(exercise.CreatedAt)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-		updatedAt, err := utils.ParseTimestamp(exercise.UpdatedAt)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-		startTime, err := utils.ParseTimestamp(exercise.StartTime)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		rawData := utils.ParseRawJSON(exercise.RawData)
-
-		arg := tietoevrysqlc.InsertExerciseParams{
-			ID:                exerciseID,
-			CreatedAt:         createdAt,
-			UpdatedAt:         updatedAt,
-			UserID:            userID,
-			StartTime:         startTime,
-			Duration:          exercise.Duration,
-			Comment:           utils.NullStringPtr(exercise.Comment),
-			SportType:         utils.NullStringPtr(exercise.SportType),
-			DetailedSportType: utils.NullStringPtr(exercise.DetailedSportType),
-			Distance:          utils.NullFloat64Ptr(exercise.Distance),
-			AvgHeartRate:      utils.NullFloat64Ptr(exercise.AvgHeartRate),
-			MaxHeartRate:      utils.NullFloat64Ptr(exercise.MaxHeartRate),
-			Trimp:             utils.NullFloat64Ptr(exercise.Trimp),
-			SprintCount:       utils.NullInt32Ptr(exercise.SprintCount),
-			AvgSpeed:          utils.NullFloat64Ptr(exercise.AvgSpeed),
-			MaxSpeed:          utils.NullFloat64Ptr(exercise.MaxSpeed),
-			Source:            exercise.Source,
-			Status:            utils.NullStringPtr(exercise.Status),
-			Calories:          utils.NullInt32Ptr(exercise.Calories),
-			TrainingLoad:      utils.NullInt32Ptr(exercise.TrainingLoad),
-			RawID:             utils.NullStringPtr(exercise.RawID),
-			Feeling:           utils.NullInt32Ptr(exercise.Feeling),
-			Recovery:          utils.NullInt32Ptr(exercise.Recovery),
-			Rpe:               utils.NullInt32Ptr(exercise.RPE),
-			RawData:           rawData,
-		}
-
-		var hrZones []tietoevrysqlc.InsertExerciseHRZoneParams
-		for _, z := range exercise.HRZones {
-			exerciseID, _ := utils.ParseUUID(z.ExerciseID)
-			createdAt, _ := utils.ParseTimestamp(z.CreatedAt)
-			updatedAt, _ := utils.ParseTimestamp(z.UpdatedAt)
-
-			hrZones = append(hrZones, tietoevrysqlc.InsertExerciseHRZoneParams{
-				ExerciseID:    exerciseID,
-				ZoneIndex:     z.ZoneIndex,
-				SecondsInZone: z.SecondsInZone,
-				LowerLimit:    z.LowerLimit,
-				UpperLimit:    z.UpperLimit,
-				CreatedAt:     createdAt,
-				UpdatedAt:     updatedAt,
-			})
-		}
-
-		var samples []tietoevrysqlc.InsertExerciseSampleParams
-		for _, s := range exercise.Samples {
-			id, _ := utils.ParseUUID(s.ID)
-			userID, _ := utils.ParseUUID(s.UserID)
-			exerciseID, _ := utils.ParseUUID(s.ExerciseID)
-
-			samples = append(samples, tietoevrysqlc.InsertExerciseSampleParams{
-				ID:            id,
-				UserID:        userID,
-				ExerciseID:    exerciseID,
-				SampleType:    s.SampleType,
-				RecordingRate: s.RecordingRate,
-				Samples:       s.Samples,
-				Source:        s.Source,
-			})
-		}
-
-		var sections []tietoevrysqlc.InsertExerciseSectionParams
-		for _, sec := range exercise.Sections {
-			id, _ := utils.ParseUUID(sec.ID)
-			userID, _ := utils.ParseUUID(sec.UserID)
-			exerciseID, _ := utils.ParseUUID(sec.ExerciseID)
-			createdAt, _ := utils.ParseTimestamp(sec.CreatedAt)
-			updatedAt, _ := utils.ParseTimestamp(sec.UpdatedAt)
-			startTime, _ := utils.ParseTimestamp(sec.StartTime)
-			endTime, _ := utils.ParseTimestamp(sec.EndTime)
-			rawData := utils.ParseRawJSON(sec.RawData)
-
-			sections = append(sections, tietoevrysqlc.InsertExerciseSectionParams{
-				ID:          id,
-				UserID:      userID,
-				ExerciseID:  exerciseID,
-				CreatedAt:   createdAt,
-				UpdatedAt:   updatedAt,
-				StartTime:   startTime,
-				EndTime:     endTime,
-				SectionType: utils.NullStringPtr(sec.SectionType),
-				Name:        utils.NullStringPtr(sec.Name),
-				Comment:     utils.NullStringPtr(sec.Comment),
-				Source:      sec.Source,
-				RawID:       utils.NullStringPtr(sec.RawID),
-				RawData:     rawData,
-			})
-		}
-
-		exercises[i] = tietoevry.ExercisePayload{
-			Exercise: arg,
-			HRZones:  hrZones,
-			Samples:  samples,
-			Sections: sections,
-		}
+		exercises[i] = arg
 	}
 
 	if err := h.store.InsertExercisesBulk(r.Context(), exercises); err != nil {
@@ -303,20 +200,152 @@ func (h *TietoevryExerciseHandler) InsertExercisesBulk(w http.ResponseWriter, r
 	w.WriteHeader(http.StatusCreated)
 }
 
+// exercisePayload converts one item of the bulk input
+func exercisePayload(exercise TietoevryExerciseUpsertInput) (tietoevry.ExercisePayload, error) {
+	// Parse UUIDs and timestamps
+	exerciseID, err := utils.ParseUUID(exercise.ID)
+	if err != nil {
+		return tietoevry.ExercisePayload{}, err
+	}
+	userID, err := utils.ParseUUID(exercise.UserID)
+	if err != nil {
+		return tietoevry.ExercisePayload{}, err
+	}
+
+	createdAt, err := utils.ParseTimestamp(exercise.CreatedAt)
+	if err != nil {
+		return tietoevry.ExercisePayload{}, err
+	}
+	updatedAt, err := utils.ParseTimestamp(exercise.UpdatedAt)
+	if err != nil {
+		return tietoevry.ExercisePayload{}, err
+	}
+	startTime, err := utils.ParseTimestamp(exercise.StartTime)
+	if err != nil {
+		return tietoevry.ExercisePayload{}, err
+	}
+
+	rawData := utils.ParseRawJSON(exercise.RawData)
+
+	arg := tietoevrysqlc.InsertExerciseParams{
+		ID:                exerciseID,
+		CreatedAt:         createdAt,
+		UpdatedAt:         updatedAt,
+		UserID:            userID,
+		StartTime:         startTime,
+		Duration:          exercise.Duration,
+		Comment:           utils.NullStringPtr(exercise.Comment),
+		SportType:         utils.NullStringPtr(exercise.SportType),
+		DetailedSportType: utils.NullStringPtr(exercise.DetailedSportType),
+		Distance:          utils.NullFloat64Ptr(exercise.Distance),
+		AvgHeartRate:      utils.NullFloat64Ptr(exercise.AvgHeartRate),
+		MaxHeartRate:      utils.NullFloat64Ptr(exercise.MaxHeartRate),
+		Trimp:             utils.NullFloat64Ptr(exercise.Trimp),
+		SprintCount:       utils.NullInt32Ptr(exercise.SprintCount),
+		AvgSpeed:          utils.NullFloat64Ptr(exercise.AvgSpeed),
+		MaxSpeed:          utils.NullFloat64Ptr(exercise.MaxSpeed),
+		Source:            exercise.Source,
+		Status:            utils.NullStringPtr(exercise.Status),
+		Calories:          utils.NullInt32Ptr(exercise.Calories),
+		TrainingLoad:      utils.NullInt32Ptr(exercise.TrainingLoad),
+		RawID:             utils.NullStringPtr(exercise.RawID),
+		Feeling:           utils.NullInt32Ptr(exercise.Feeling),
+		Recovery:          utils.NullInt32Ptr(exercise.Recovery),
+		Rpe:               utils.NullInt32Ptr(exercise.RPE),
+		RawData:           rawData,
+	}
+
+	var hrZones []tietoevrysqlc.InsertExerciseHRZoneParams
+	for _, z := range exercise.HRZones {
+		exerciseID, _ := utils.ParseUUID(z.ExerciseID)
+		createdAt, _ := utils.ParseTimestamp(z.CreatedAt)
+		updatedAt, _ := utils.ParseTimestamp(z.UpdatedAt)
+
+		hrZones = append(hrZones, tietoevrysqlc.InsertExerciseHRZoneParams{
+			ExerciseID:    exerciseID,
+			ZoneIndex:     z.ZoneIndex,
+			SecondsInZone: z.SecondsInZone,
+			LowerLimit:    z.LowerLimit,
+			UpperLimit:    z.UpperLimit,
+			CreatedAt:     createdAt,
+			UpdatedAt:     updatedAt,
+		})
+	}
+
+	var samples []tietoevrysqlc.InsertExerciseSampleParams
+	for _, s := range exercise.Samples {
+		id, _ := utils.ParseUUID(s.ID)
+		userID, _ := utils.ParseUUID(s.UserID)
+		exerciseID, _ := utils.ParseUUID(s.ExerciseID)
+
+		samples = append(samples, tietoevrysqlc.InsertExerciseSampleParams{
+			ID:            id,
+			UserID:        userID,
+			ExerciseID:    exerciseID,
+			SampleType:    s.SampleType,
+			RecordingRate: s.RecordingRate,
+			Samples:       s.Samples,
+			Source:        s.Source,
+		})
+	}
+
+	var sections []tietoevrysqlc.InsertExerciseSectionParams
+	for _, sec := range exercise.Sections {
+		id, _ := utils.ParseUUID(sec.ID)
+		userID, _ := utils.ParseUUID(sec.UserID)
+		exerciseID, _ := utils.ParseUUID(sec.ExerciseID)
+		createdAt, _ := utils.ParseTimestamp(sec.CreatedAt)
+		updatedAt, _ := utils.ParseTimestamp(sec.UpdatedAt)
+		startTime, _ := utils.ParseTimestamp(sec.StartTime)
+		endTime, _ := utils.ParseTimestamp(sec.EndTime)
+		rawData := utils.ParseRawJSON(sec.RawData)
+
+		sections = append(sections, tietoevrysqlc.InsertExerciseSectionParams{
+			ID:          id,
+			UserID:      userID,
+			ExerciseID:  exerciseID,
+			CreatedAt:   createdAt,
+			UpdatedAt:   updatedAt,
+			StartTime:   startTime,
+			EndTime:     endTime,
+			SectionType: utils.NullStringPtr(sec.SectionType),
+			Name:        utils.NullStringPtr(sec.Name),
+			Comment:     utils.NullStringPtr(sec.Comment),
+			Source:      sec.Source,
+			RawID:       utils.NullStringPtr(sec.RawID),
+			RawData:     rawData,
+		})
+	}
+
+	return tietoevry.ExercisePayload{
+		Exercise: arg,
+		HRZones:  hrZones,
+		Samples:  samples,
+		Sections: sections,
+	}, nil
+}
+
 // GetExercises godoc
 //
 //	@Summary		Get exercises by user ID
-//	@Description	Get all exercises (HR_Zones, Samples, Sections) for a specific user
+//	@Description	Get exercises for a specific user, newest first. HR zones, samples and sections are only loaded when listed in include
 //	@Tags			Tietoevry - Exercise
 //	@Accept			json
-//	@Produce		json
-//	@Param			user_id	query		string	true	"User ID (UUID)"
-//	@Success		200		{object}	swagger.TietoevryExerciseResponse
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Produce		json,application/x-ndjson
+//	@Param			user_id		query		string	true	"User ID (UUID)"
+//	@Param			from		query		string	false	"Only rows on or after this UTC day (start_time, YYYY-MM-DD)"
+//	@Param			to			query		string	false	"Only rows on or before this UTC day (start_time, YYYY-MM-DD)"
+//	@Param			source		query		string	false	"Filter by source"
+//	@Param			sport_type	query		string	false	"Filter by sport type"
+//	@Param			include		query		string	false	"Child rows to load: comma-separated hr_zones, samples, sections"
+//	@Param			limit		query		int		false	"Page size (default: 100, max: 1000)"
+//	@Param			cursor		query		string	false	"Opaque cursor from pagination.next_cursor of the previous page"
+//	@Success		200			{object}	swagger.TietoevryExerciseResponse
+//	@Failure		400			{object}	swagger.ValidationErrorResponse
+//	@Failure		401			{object}	swagger.UnauthorizedResponse
+//	@Failure		403			{object}	swagger.ForbiddenResponse
+//	@Failure		500			{object}	swagger.InternalServerErrorResponse
+//	@Failure		503			{object}	swagger.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/tietoevry/exercises [get]
 func (h *TietoevryExerciseHandler) GetExercises(w http.ResponseWriter, r *http.Request) {
@@ -325,13 +354,18 @@ func (h *TietoevryExerciseHandler) GetExercises(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	if err := utils.ValidateParams(r, []string{"user_id"}); err != nil {
+	if err := utils.ValidateParams(r, []string{"user_id", "from", "to", "source", "sport_type", "include", "limit", "cursor"}); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
 
 	params := TietoevryExerciseParams{
-		UserID: r.URL.Query().Get("user_id"),
+		UserID:    r.URL.Query().Get("user_id"),
+		From:      r.URL.Query().Get("from"),
+		To:        r.URL.Query().Get("to"),
+		Source:    r.URL.Query().Get("source"),
+		SportType: r.URL.Query().Get("sport_type"),
+		Include:   r.URL.Query().Get("include"),
 	}
 
 	if err := utils.GetValidator().Struct(params); err != nil {
@@ -339,9 +373,28 @@ func (h *TietoevryExerciseHandler) GetExercises(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	cacheKey := fmt.Sprintf("tietoevry:exercises:%s", params.UserID)
-	if h.cache != nil {
+	page, err := utils.ParsePage(r, utils.DefaultPageLimits, utils.CursorTime|utils.CursorID)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	filter, err := readListFilter(r)
+	if err != nil {
+		utils.UnprocessableEntityResponse(w, r, err)
+		return
+	}
+
+	include, err := parseInclude(params.Include)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	cacheKey := fmt.Sprintf("tietoevry:exercises:%s:%s:%s", params.UserID, filterCacheKey(r), page.CacheKey())
+	if h.cache != nil && !utils.WantsNDJSON(r) {
 		if cached, err := h.cache.Get(r.Context(), cacheKey); err == nil && cached != "" {
+			utils.SetNextLink(w, r, utils.CachedNextCursor(cached))
 			utils.WriteJSON(w, http.StatusOK, json.RawMessage(cached))
 			return
 		}
@@ -353,102 +406,120 @@ func (h *TietoevryExerciseHandler) GetExercises(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	exercises, err := h.store.GetExercisesByUser(r.Context(), userID)
+	exercises, err := h.store.GetExercisesByUser(r.Context(), userID, filter, page)
 	if err != nil {
 		utils.InternalServerError(w, r, err)
 		return
 	}
+	exercises, pageInfo := utils.NextPage(exercises, page, func(e tietoevrysqlc.Exercise) utils.Cursor {
+		return utils.TimeCursor(e.StartTime, e.ID)
+	})
 
-	if len(exercises) == 0 {
-		utils.WriteJSON(w, http.StatusOK, map[string]any{
-			"exercises": []swagger.TietoevryExerciseUpsertInput{},
-		})
+	ids := make([]uuid.UUID, len(exercises))
+	for i, ex := range exercises {
+		ids[i] = ex.ID
+	}
+
+	details, err := h.store.GetExerciseDetails(r.Context(), ids, include)
+	if err != nil {
+		utils.InternalServerError(w, r, err)
 		return
 	}
 
-	var output []swagger.TietoevryExerciseUpsertInput
+	sw := utils.NewStreamWriter(w, r)
+	utils.SetNextLink(w, r, pageInfo.NextCursor)
+	sw.Array("exercises")
 	for _, ex := range exercises {
-		hrZones, _ := h.store.GetExerciseHRZones(r.Context(), ex.ID)
-		samples, _ := h.store.GetExerciseSamples(r.Context(), ex.ID)
-		sections, _ := h.store.GetExerciseSections(r.Context(), ex.ID)
-
-		out := swagger.TietoevryExerciseUpsertInput{
-			ID:                ex.ID.String(),
-			CreatedAt:         ex.CreatedAt.Format(time.RFC3339),
-			UpdatedAt:         ex.UpdatedAt.Format(time.RFC3339),
-			UserID:            ex.UserID.String(),
-			StartTime:         ex.StartTime.Format(time.RFC3339),
-			Duration:          ex.Duration,
-			Comment:           utils.StringPtrOrNil(ex.Comment),
-			SportType:         utils.StringPtrOrNil(ex.SportType),
-			DetailedSportType: utils.StringPtrOrNil(ex.DetailedSportType),
-			Distance:          utils.Float64PtrOrNil(ex.Distance),
-			AvgHeartRate:      utils.Float64PtrOrNil(ex.AvgHeartRate),
-			MaxHeartRate:      utils.Float64PtrOrNil(ex.MaxHeartRate),
-			Trimp:             utils.Float64PtrOrNil(ex.Trimp),
-			SprintCount:       utils.Int32PtrOrNil(ex.SprintCount),
-			AvgSpeed:          utils.Float64PtrOrNil(ex.AvgSpeed),
-			MaxSpeed:          utils.Float64PtrOrNil(ex.MaxSpeed),
-			Source:            ex.Source,
-			Status:            utils.StringPtrOrNil(ex.Status),
-			Calories:          utils.Int32PtrOrNil(ex.Calories),
-			TrainingLoad:      utils.Int32PtrOrNil(ex.TrainingLoad),
-			RawID:             utils.StringPtrOrNil(ex.RawID),
-			Feeling:           utils.Int32PtrOrNil(ex.Feeling),
-			Recovery:          utils.Int32PtrOrNil(ex.Recovery),
-			RPE:               utils.Int32PtrOrNil(ex.Rpe),
-			RawData:           utils.RawMessagePtrOrNil(ex.RawData),
+		if err := sw.Row(exerciseOutput(ex, details)); err != nil {
+			return
 		}
+	}
+	sw.Field("pagination", pageInfo)
+	if err := sw.Close(); err != nil {
+		return
+	}
 
-		// HR Zones
-		for _, z := range hrZones {
-			out.HRZones = append(out.HRZones, swagger.HRZone{
-				ExerciseID:    z.ExerciseID.String(),
-				ZoneIndex:     z.ZoneIndex,
-				SecondsInZone: z.SecondsInZone,
-				LowerLimit:    z.LowerLimit,
-				UpperLimit:    z.UpperLimit,
-				CreatedAt:     z.CreatedAt.Format(time.RFC3339),
-				UpdatedAt:     z.UpdatedAt.Format(time.RFC3339),
-			})
-		}
+	cache.SetCacheStream(r.Context(), h.cache, cacheKey, sw, TietoevryCacheTTL)
+}
 
-		// Samples
-		for _, s := range samples {
-			out.Samples = append(out.Samples, swagger.Sample{
-				ID:            s.ID.String(),
-				UserID:        s.UserID.String(),
-				ExerciseID:    s.ExerciseID.String(),
-				SampleType:    s.SampleType,
-				RecordingRate: s.RecordingRate,
-				Samples:       s.Samples,
-				Source:        s.Source,
-			})
-		}
+// exerciseOutput converts an exercise and its loaded child rows to the response shape
+func exerciseOutput(ex tietoevrysqlc.Exercise, details tietoevry.ExerciseDetails) swagger.TietoevryExerciseUpsertInput {
+	hrZones := details.HRZones[ex.ID]
+	samples := details.Samples[ex.ID]
+	sections := details.Sections[ex.ID]
+
+	out := swagger.TietoevryExerciseUpsertInput{
+		ID:                ex.ID.String(),
+		CreatedAt:         ex.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:         ex.UpdatedAt.Format(time.RFC3339),
+		UserID:            ex.UserID.String(),
+		StartTime:         ex.StartTime.Format(time.RFC3339),
+		Duration:          ex.Duration,
+		Comment:           utils.StringPtrOrNil(ex.Comment),
+		SportType:         utils.StringPtrOrNil(ex.SportType),
+		DetailedSportType: utils.StringPtrOrNil(ex.DetailedSportType),
+		Distance:          utils.Float64PtrOrNil(ex.Distance),
+		AvgHeartRate:      utils.Float64PtrOrNil(ex.AvgHeartRate),
+		MaxHeartRate:      utils.Float64PtrOrNil(ex.MaxHeartRate),
+		Trimp:             utils.Float64PtrOrNil(ex.Trimp),
+		SprintCount:       utils.Int32PtrOrNil(ex.SprintCount),
+		AvgSpeed:          utils.Float64PtrOrNil(ex.AvgSpeed),
+		MaxSpeed:          utils.Float64PtrOrNil(ex.MaxSpeed),
+		Source:            ex.Source,
+		Status:            utils.StringPtrOrNil(ex.Status),
+		Calories:          utils.Int32PtrOrNil(ex.Calories),
+		TrainingLoad:      utils.Int32PtrOrNil(ex.TrainingLoad),
+		RawID:             utils.StringPtrOrNil(ex.RawID),
+		Feeling:           utils.Int32PtrOrNil(ex.Feeling),
+		Recovery:          utils.Int32PtrOrNil(ex.Recovery),
+		RPE:               utils.Int32PtrOrNil(ex.Rpe),
+		RawData:           utils.RawMessagePtrOrNil(ex.RawData),
+	}
 
-		// Sections
-		for _, sec := range sections {
-			out.Sections = append(out.Sections, swagger.Section{
-				ID:          sec.ID.String(),
-				UserID:      sec.UserID.String(),
-				ExerciseID:  sec.ExerciseID.String(),
-				CreatedAt:   sec.CreatedAt.Format(time.RFC3339),
-				UpdatedAt:   sec.UpdatedAt.Format(time.RFC3339),
-				StartTime:   sec.StartTime.Format(time.RFC3339),
-				EndTime:     sec.EndTime.Format(time.RFC3339),
-				SectionType: utils.StringPtrOrNil(sec.SectionType),
-				Name:        utils.StringPtrOrNil(sec.Name),
-				Comment:     utils.StringPtrOrNil(sec.Comment),
-				Source:      sec.Source,
-				RawID:       utils.StringPtrOrNil(sec.RawID),
-				RawData:     utils.RawMessagePtrOrNil(sec.RawData),
-			})
-		}
+	// HR Zones
+	for _, z := range hrZones {
+		out.HRZones = append(out.HRZones, swagger.HRZone{
+			ExerciseID:    z.ExerciseID.String(),
+			ZoneIndex:     z.ZoneIndex,
+			SecondsInZone: z.SecondsInZone,
+			LowerLimit:    z.LowerLimit,
+			UpperLimit:    z.UpperLimit,
+			CreatedAt:     z.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:     z.UpdatedAt.Format(time.RFC3339),
+		})
+	}
+
+	// Samples
+	for _, s := range samples {
+		out.Samples = append(out.Samples, swagger.Sample{
+			ID:            s.ID.String(),
+			UserID:        s.UserID.String(),
+			ExerciseID:    s.ExerciseID.String(),
+			SampleType:    s.SampleType,
+			RecordingRate: s.RecordingRate,
+			Samples:       s.Samples,
+			Source:        s.Source,
+		})
+	}
 
-		output = append(output, out)
+	// Sections
+	for _, sec := range sections {
+		out.Sections = append(out.Sections, swagger.Section{
+			ID:          sec.ID.String(),
+			UserID:      sec.UserID.String(),
+			ExerciseID:  sec.ExerciseID.String(),
+			CreatedAt:   sec.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:   sec.UpdatedAt.Format(time.RFC3339),
+			StartTime:   sec.StartTime.Format(time.RFC3339),
+			EndTime:     sec.EndTime.Format(time.RFC3339),
+			SectionType: utils.StringPtrOrNil(sec.SectionType),
+			Name:        utils.StringPtrOrNil(sec.Name),
+			Comment:     utils.StringPtrOrNil(sec.Comment),
+			Source:      sec.Source,
+			RawID:       utils.StringPtrOrNil(sec.RawID),
+			RawData:     utils.RawMessagePtrOrNil(sec.RawData),
+		})
 	}
 
-	resp := map[string]any{"exercises": output}
-	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, resp, TietoevryCacheTTL)
-	utils.WriteJSON(w, http.StatusOK, resp)
+	return out
 }
@@ -0,0 +1,86 @@
+package tietoevryapi
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/store/tietoevry"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+// query parameters that narrow a list, in the order used in cache keys
+var filterParams = []string{"from", "to", "source", "sport_type", "name", "symptom", "questionnaire_key", "include"}
+
+// readListFilter builds the store filter from the query string. The raw
+// values have already been checked by the handler's params struct.
+func readListFilter(r *http.Request) (tietoevry.ListFilter, error) {
+	q := r.URL.Query()
+	opt := func(key string) *string {
+		v := q.Get(key)
+		return utils.NilIfEmpty(&v)
+	}
+
+	from, err := utils.ParseDatePtr(opt("from"))
+	if err != nil {
+		return tietoevry.ListFilter{}, err
+	}
+	to, err := utils.ParseDatePtr(opt("to"))
+	if err != nil {
+		return tietoevry.ListFilter{}, err
+	}
+	if from != nil && to != nil && from.After(*to) {
+		return tietoevry.ListFilter{}, utils.ErrInvalidDateRange
+	}
+
+	return tietoevry.ListFilter{
+		From:             from,
+		To:               to,
+		Source:           opt("source"),
+		SportType:        opt("sport_type"),
+		Name:             opt("name"),
+		Symptom:          opt("symptom"),
+		QuestionnaireKey: opt("questionnaire_key"),
+	}, nil
+}
+
+// filterCacheKey encodes the filters of the request for use in cache keys
+func filterCacheKey(r *http.Request) string {
+	q := r.URL.Query()
+	out := url.Values{}
+	for _, key := range filterParams {
+		if v := q.Get(key); v != "" {
+			out.Set(key, v)
+		}
+	}
+	return out.Encode()
+}
+
+// exercise child rows that can be requested with include=
+const (
+	includeHRZones  = "hr_zones"
+	includeSamples  = "samples"
+	includeSections = "sections"
+)
+
+// parseInclude reads the comma-separated include parameter of exercise reads
+func parseInclude(val string) (tietoevry.ExerciseIncludes, error) {
+	var include tietoevry.ExerciseIncludes
+	if val == "" {
+		return include, nil
+	}
+	for _, part := range strings.Split(val, ",") {
+		switch part = strings.TrimSpace(part); part {
+		case includeHRZones:
+			include.HRZones = true
+		case includeSamples:
+			include.Samples = true
+		case includeSections:
+			include.Sections = true
+		default:
+			return include, fmt.Errorf("invalid include value %q: allowed values are %s, %s, %s", part, includeHRZones, includeSamples, includeSections)
+		}
+	}
+	return include, nil
+}
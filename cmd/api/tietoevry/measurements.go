@@ -55,7 +55,11 @@ type TietoevryMeasurementsBulkInput struct {
 //	@Accept			json
 //	@Produce		json
 //	@Param			measurements	body	swagger.TietoevryMeasurementsBulkInput	true	"Measurement data"
+//	@Param			partial			query	bool									false	"Write every item on its own and report the outcome of each"
+//	@Param			async			query	bool									false	"Queue the request as an ingest job, see /ingest-jobs"
 //	@Success		201				"Measurements processed successfully"
+//	@Success		202				{object}	swagger.IngestJobEnvelope	"Queued as an ingest job (async mode)"
+//	@Success		207				{object}	swagger.BulkReport	"Some items failed (partial mode)"
 //	@Failure		400				{object}	swagger.ValidationErrorResponse
 //	@Failure		401				{object}	swagger.UnauthorizedResponse
 //	@Failure		403				{object}	swagger.ForbiddenResponse
@@ -75,6 +79,15 @@ func (h *TietoevryMeasurementHandler) InsertMeasurementsBulk(w http.ResponseWrit
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
+
+	if utils.PartialBulk(r) {
+		insertPartial(w, r, h.cache, msPrefix, input.Measurements, measurementParams,
+			func(p tietoevrysqlc.InsertMeasurementParams) uuid.UUID { return p.UserID },
+			h.store.InsertMeasurementsPartial,
+		)
+		return
+	}
+
 	if err := utils.GetValidator().Struct(input); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -98,50 +111,12 @@ func (h *TietoevryMeasurementHandler) InsertMeasurementsBulk(w http.ResponseWrit
 
 	params := make([]tietoevrysqlc.InsertMeasurementParams, len(input.Measurements))
 	for i, m := range input.Measurements {
-		id, err := utils.ParseUUID(m.ID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-		userID, err := utils.ParseUUID(m.UserID)
+		arg, err := measurementParams(m)
 		if err != nil {
 			utils.BadRequestResponse(w, r, err)
 			return
 		}
-		createdAt, err := utils.ParseTimestamp(m.CreatedAt)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-		updatedAt, err := utils.ParseTimestamp(m.UpdatedAt)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-		date, err := utils.ParseDate(m.Date)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-		rawData := utils.ParseRawJSON(m.RawData)
-		additionalInfo := utils.ParseRawJSON(m.AdditionalInfo)
-
-		params[i] = tietoevrysqlc.InsertMeasurementParams{
-			ID:             id,
-			CreatedAt:      createdAt,
-			UpdatedAt:      updatedAt,
-			UserID:         userID,
-			Date:           date,
-			Name:           m.Name,
-			NameType:       m.NameType,
-			Source:         m.Source,
-			Value:          m.Value,
-			ValueNumeric:   utils.NullFloat64Ptr(m.ValueNumeric),
-			Comment:        utils.NullStringPtr(m.Comment),
-			RawID:          utils.NullStringPtr(m.RawID),
-			RawData:        rawData,
-			AdditionalInfo: additionalInfo,
-		}
+		params[i] = arg
 	}
 
 	if err := h.store.InsertMeasurementsBulk(r.Context(), params); err != nil {
@@ -164,8 +139,55 @@ func (h *TietoevryMeasurementHandler) InsertMeasurementsBulk(w http.ResponseWrit
 	w.WriteHeader(http.StatusCreated)
 }
 
+// measurementParams converts one item of the bulk input
+func measurementParams(m TietoevryMeasurementInput) (tietoevrysqlc.InsertMeasurementParams, error) {
+	id, err := utils.ParseUUID(m.ID)
+	if err != nil {
+		return tietoevrysqlc.InsertMeasurementParams{}, err
+	}
+	userID, err := utils.ParseUUID(m.UserID)
+	if err != nil {
+		return tietoevrysqlc.InsertMeasurementParams{}, err
+	}
+	createdAt, err := utils.ParseTimestamp(m.CreatedAt)
+	if err != nil {
+		return tietoevrysqlc.InsertMeasurementParams{}, err
+	}
+	updatedAt, err := utils.ParseTimestamp(m.UpdatedAt)
+	if err != nil {
+		return tietoevrysqlc.InsertMeasurementParams{}, err
+	}
+	date, err := utils.ParseDate(m.Date)
+	if err != nil {
+		return tietoevrysqlc.InsertMeasurementParams{}, err
+	}
+	rawData := utils.ParseRawJSON(m.RawData)
+	additionalInfo := utils.ParseRawJSON(m.AdditionalInfo)
+
+	return tietoevrysqlc.InsertMeasurementParams{
+		ID:             id,
+		CreatedAt:      createdAt,
+		UpdatedAt:      updatedAt,
+		UserID:         userID,
+		Date:           date,
+		Name:           m.Name,
+		NameType:       m.NameType,
+		Source:         m.Source,
+		Value:          m.Value,
+		ValueNumeric:   utils.NullFloat64Ptr(m.ValueNumeric),
+		Comment:        utils.NullStringPtr(m.Comment),
+		RawID:          utils.NullStringPtr(m.RawID),
+		RawData:        rawData,
+		AdditionalInfo: additionalInfo,
+	}, nil
+}
+
 type TietoevryMeasurementParams struct {
 	UserID string `form:"user_id" validate:"required,uuid4"`
+	From   string `form:"from" validate:"omitempty,datetime=2006-01-02"`
+	To     string `form:"to" validate:"omitempty,datetime=2006-01-02"`
+	Source string `form:"source" validate:"omitempty,max=100"`
+	Name   string `form:"name" validate:"omitempty,max=100"`
 }
 
 // GetMeasurements godoc
@@ -174,8 +196,15 @@ type TietoevryMeasurementParams struct {
 //	@Description	Get all measurements for a specific user
 //	@Tags			Tietoevry - Measurements
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,text/csv,application/vnd.apache.parquet
 //	@Param			user_id	query		string	true	"User ID (UUID)"
+//	@Param			from	query		string	false	"Only rows on or after this day (date, YYYY-MM-DD)"
+//	@Param			to		query		string	false	"Only rows on or before this day (date, YYYY-MM-DD)"
+//	@Param			source	query		string	false	"Filter by source"
+//	@Param			name	query		string	false	"Filter by measurement name"
+//	@Param			limit	query		int		false	"Page size (default: 100, max: 1000)"
+//	@Param			cursor	query		string	false	"Opaque cursor from pagination.next_cursor of the previous page"
+//	@Param			format	query		string	false	"Response format: json (default), csv or parquet; also negotiated with Accept"
 //	@Success		200		{object}	swagger.TietoevryMeasurementResponse
 //	@Failure		400		{object}	swagger.ValidationErrorResponse
 //	@Failure		401		{object}	swagger.UnauthorizedResponse
@@ -190,13 +219,17 @@ func (h *TietoevryMeasurementHandler) GetMeasurements(w http.ResponseWriter, r *
 		return
 	}
 
-	if err := utils.ValidateParams(r, []string{"user_id"}); err != nil {
+	if err := utils.ValidateParams(r, []string{"user_id", "from", "to", "source", "name", "limit", "cursor", "format"}); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
 
 	params := TietoevryMeasurementParams{
 		UserID: r.URL.Query().Get("user_id"),
+		From:   r.URL.Query().Get("from"),
+		To:     r.URL.Query().Get("to"),
+		Source: r.URL.Query().Get("source"),
+		Name:   r.URL.Query().Get("name"),
 	}
 
 	if err := utils.GetValidator().Struct(params); err != nil {
@@ -204,9 +237,28 @@ func (h *TietoevryMeasurementHandler) GetMeasurements(w http.ResponseWriter, r *
 		return
 	}
 
-	cacheKey := fmt.Sprintf("tietoevry:measurements:%s", params.UserID)
-	if h.cache != nil {
+	page, err := utils.ParsePage(r, utils.DefaultPageLimits, utils.CursorTime|utils.CursorID)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	filter, err := readListFilter(r)
+	if err != nil {
+		utils.UnprocessableEntityResponse(w, r, err)
+		return
+	}
+
+	format, err := utils.NegotiateFormat(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	cacheKey := fmt.Sprintf("tietoevry:measurements:%s:%s:%s", params.UserID, filterCacheKey(r), page.CacheKey())
+	if h.cache != nil && format == utils.FormatJSON {
 		if cached, err := h.cache.Get(r.Context(), cacheKey); err == nil && cached != "" {
+			utils.SetNextLink(w, r, utils.CachedNextCursor(cached))
 			utils.WriteJSON(w, http.StatusOK, json.RawMessage(cached))
 			return
 		}
@@ -218,15 +270,19 @@ func (h *TietoevryMeasurementHandler) GetMeasurements(w http.ResponseWriter, r *
 		return
 	}
 
-	measurements, err := h.store.GetMeasurementsByUser(r.Context(), userID)
+	measurements, err := h.store.GetMeasurementsByUser(r.Context(), userID, filter, page)
 	if err != nil {
 		utils.InternalServerError(w, r, err)
 		return
 	}
+	measurements, pageInfo := utils.NextPage(measurements, page, func(m tietoevrysqlc.Measurement) utils.Cursor {
+		return utils.TimeCursor(m.Date, m.ID)
+	})
 
-	if len(measurements) == 0 {
+	if len(measurements) == 0 && format == utils.FormatJSON {
 		utils.WriteJSON(w, http.StatusOK, map[string]any{
 			"measurements": []swagger.TietoevryMeasurementInput{},
+			"pagination":   pageInfo,
 		})
 		return
 	}
@@ -252,7 +308,14 @@ func (h *TietoevryMeasurementHandler) GetMeasurements(w http.ResponseWriter, r *
 		output = append(output, out)
 	}
 
-	resp := map[string]any{"measurements": output}
+	if format != utils.FormatJSON {
+		utils.SetNextLink(w, r, pageInfo.NextCursor)
+		utils.WriteTable(w, format, "tietoevry_measurements", output)
+		return
+	}
+
+	resp := map[string]any{"measurements": output, "pagination": pageInfo}
 	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, resp, TietoevryCacheTTL)
+	utils.SetNextLink(w, r, pageInfo.NextCursor)
 	utils.WriteJSON(w, http.StatusOK, resp)
 }
@@ -56,7 +56,11 @@ type TietoevryQuestionnaireAnswersBulkInput struct {
 //	@Accept			json
 //	@Produce		json
 //	@Param			questionnaires	body	swagger.TietoevryQuestionnaireAnswersBulkInput	true	"Questionnaire answers"
+//	@Param			partial			query	bool											false	"Write every item on its own and report the outcome of each"
+//	@Param			async			query	bool											false	"Queue the request as an ingest job, see /ingest-jobs"
 //	@Success		201				"Questionnaire answers processed successfully"
+//	@Success		202				{object}	swagger.IngestJobEnvelope	"Queued as an ingest job (async mode)"
+//	@Success		207				{object}	swagger.BulkReport	"Some items failed (partial mode)"
 //	@Failure		400				{object}	swagger.ValidationErrorResponse
 //	@Failure		401				{object}	swagger.UnauthorizedResponse
 //	@Failure		403				{object}	swagger.ForbiddenResponse
@@ -76,6 +80,15 @@ func (h *TietoevryQuestionnaireHandler) InsertQuestionnaireAnswersBulk(w http.Re
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
+
+	if utils.PartialBulk(r) {
+		insertPartial(w, r, h.cache, qnPrefix, input.Questionnaires, questionnaireAnswerParams,
+			func(p tietoevrysqlc.InsertQuestionnaireAnswerParams) uuid.UUID { return p.UserID },
+			h.store.InsertQuestionnaireAnswersPartial,
+		)
+		return
+	}
+
 	if err := utils.GetValidator().Struct(input); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -99,64 +112,12 @@ func (h *TietoevryQuestionnaireHandler) InsertQuestionnaireAnswersBulk(w http.Re
 
 	questionnaires := make([]tietoevrysqlc.InsertQuestionnaireAnswerParams, len(input.Questionnaires))
 	for i, questionnaire := range input.Questionnaires {
-		// Parse and convert values
-		userID, err := utils.ParseUUID(questionnaire.UserID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		questionnaireInstanceID, err := utils.ParseUUID(questionnaire.QuestionnaireInstanceID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		questionID, err := utils.ParseUUID(questionnaire.QuestionID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		createdAt, err := utils.ParseTimestamp(questionnaire.CreatedAt)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		updatedAt, err := utils.ParseTimestamp(questionnaire.UpdatedAt)
+		arg, err := questionnaireAnswerParams(questionnaire)
 		if err != nil {
 			utils.BadRequestResponse(w, r, err)
 			return
 		}
-
-		optionID, err := utils.ParseUUIDPtr(questionnaire.OptionID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		valueJSON := utils.ParseRawJSON(questionnaire.Value)
-
-		questionnaires[i] = tietoevrysqlc.InsertQuestionnaireAnswerParams{
-			UserID:                  userID,
-			QuestionnaireInstanceID: questionnaireInstanceID,
-			QuestionnaireNameFi:     utils.NullStringPtr(questionnaire.QuestionnaireNameFi),
-			QuestionnaireNameEn:     utils.NullStringPtr(questionnaire.QuestionnaireNameEn),
-			QuestionnaireKey:        questionnaire.QuestionnaireKey,
-			QuestionID:              questionID,
-			QuestionLabelFi:         utils.NullStringPtr(questionnaire.QuestionLabelFi),
-			QuestionLabelEn:         utils.NullStringPtr(questionnaire.QuestionLabelEn),
-			QuestionType:            questionnaire.QuestionType,
-			OptionID:                optionID,
-			OptionValue:             utils.NullInt32Ptr(questionnaire.OptionValue),
-			OptionLabelFi:           utils.NullStringPtr(questionnaire.OptionLabelFi),
-			OptionLabelEn:           utils.NullStringPtr(questionnaire.OptionLabelEn),
-			FreeText:                utils.NullStringPtr(questionnaire.FreeText),
-			CreatedAt:               createdAt,
-			UpdatedAt:               updatedAt,
-			Value:                   valueJSON,
-		}
+		questionnaires[i] = arg
 	}
 
 	if err := h.store.InsertQuestionnaireAnswersBulk(r.Context(), questionnaires); err != nil {
@@ -179,8 +140,67 @@ func (h *TietoevryQuestionnaireHandler) InsertQuestionnaireAnswersBulk(w http.Re
 	w.WriteHeader(http.StatusCreated)
 }
 
+// questionnaireAnswerParams converts one item of the bulk input
+func questionnaireAnswerParams(questionnaire TietoevryQuestionnaireAnswerInput) (tietoevrysqlc.InsertQuestionnaireAnswerParams, error) {
+	// Parse and convert values
+	userID, err := utils.ParseUUID(questionnaire.UserID)
+	if err != nil {
+		return tietoevrysqlc.InsertQuestionnaireAnswerParams{}, err
+	}
+
+	questionnaireInstanceID, err := utils.ParseUUID(questionnaire.QuestionnaireInstanceID)
+	if err != nil {
+		return tietoevrysqlc.InsertQuestionnaireAnswerParams{}, err
+	}
+
+	questionID, err := utils.ParseUUID(questionnaire.QuestionID)
+	if err != nil {
+		return tietoevrysqlc.InsertQuestionnaireAnswerParams{}, err
+	}
+
+	createdAt, err := utils.ParseTimestamp(questionnaire.CreatedAt)
+	if err != nil {
+		return tietoevrysqlc.InsertQuestionnaireAnswerParams{}, err
+	}
+
+	updatedAt, err := utils.ParseTimestamp(questionnaire.UpdatedAt)
+	if err != nil {
+		return tietoevrysqlc.InsertQuestionnaireAnswerParams{}, err
+	}
+
+	optionID, err := utils.ParseUUIDPtr(questionnaire.OptionID)
+	if err != nil {
+		return tietoevrysqlc.InsertQuestionnaireAnswerParams{}, err
+	}
+
+	valueJSON := utils.ParseRawJSON(questionnaire.Value)
+
+	return tietoevrysqlc.InsertQuestionnaireAnswerParams{
+		UserID:                  userID,
+		QuestionnaireInstanceID: questionnaireInstanceID,
+		QuestionnaireNameFi:     utils.NullStringPtr(questionnaire.QuestionnaireNameFi),
+		QuestionnaireNameEn:     utils.NullStringPtr(questionnaire.QuestionnaireNameEn),
+		QuestionnaireKey:        questionnaire.QuestionnaireKey,
+		QuestionID:              questionID,
+		QuestionLabelFi:         utils.NullStringPtr(questionnaire.QuestionLabelFi),
+		QuestionLabelEn:         utils.NullStringPtr(questionnaire.QuestionLabelEn),
+		QuestionType:            questionnaire.QuestionType,
+		OptionID:                optionID,
+		OptionValue:             utils.NullInt32Ptr(questionnaire.OptionValue),
+		OptionLabelFi:           utils.NullStringPtr(questionnaire.OptionLabelFi),
+		OptionLabelEn:           utils.NullStringPtr(questionnaire.OptionLabelEn),
+		FreeText:                utils.NullStringPtr(questionnaire.FreeText),
+		CreatedAt:               createdAt,
+		UpdatedAt:               updatedAt,
+		Value:                   valueJSON,
+	}, nil
+}
+
 type TietoevryQuestionnaireParams struct {
-	UserID string `form:"user_id" validate:"required,uuid4"`
+	UserID           string `form:"user_id" validate:"required,uuid4"`
+	From             string `form:"from" validate:"omitempty,datetime=2006-01-02"`
+	To               string `form:"to" validate:"omitempty,datetime=2006-01-02"`
+	QuestionnaireKey string `form:"questionnaire_key" validate:"omitempty,max=100"`
 }
 
 // GetQuestionnaires godoc
@@ -190,13 +210,18 @@ type TietoevryQuestionnaireParams struct {
 //	@Tags			Tietoevry - Questionnaires
 //	@Accept			json
 //	@Produce		json
-//	@Param			user_id	query		string	true	"User ID (UUID)"
-//	@Success		200		{object}	swagger.TietoevryQuestionnaireAnswerResponse
-//	@Failure		400		{object}	swagger.ValidationErrorResponse
-//	@Failure		401		{object}	swagger.UnauthorizedResponse
-//	@Failure		403		{object}	swagger.ForbiddenResponse
-//	@Failure		500		{object}	swagger.InternalServerErrorResponse
-//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Param			user_id				query		string	true	"User ID (UUID)"
+//	@Param			from				query		string	false	"Only rows on or after this UTC day (created_at, YYYY-MM-DD)"
+//	@Param			to					query		string	false	"Only rows on or before this UTC day (created_at, YYYY-MM-DD)"
+//	@Param			questionnaire_key	query		string	false	"Filter by questionnaire key"
+//	@Param			limit				query		int		false	"Page size (default: 100, max: 1000)"
+//	@Param			cursor				query		string	false	"Opaque cursor from pagination.next_cursor of the previous page"
+//	@Success		200					{object}	swagger.TietoevryQuestionnaireAnswerResponse
+//	@Failure		400					{object}	swagger.ValidationErrorResponse
+//	@Failure		401					{object}	swagger.UnauthorizedResponse
+//	@Failure		403					{object}	swagger.ForbiddenResponse
+//	@Failure		500					{object}	swagger.InternalServerErrorResponse
+//	@Failure		503					{object}	swagger.ServiceUnavailableResponse
 //	@Security		BearerAuth
 //	@Router			/tietoevry/questionnaires [get]
 func (h *TietoevryQuestionnaireHandler) GetQuestionnaires(w http.ResponseWriter, r *http.Request) {
@@ -205,13 +230,16 @@ func (h *TietoevryQuestionnaireHandler) GetQuestionnaires(w http.ResponseWriter,
 		return
 	}
 
-	if err := utils.ValidateParams(r, []string{"user_id"}); err != nil {
+	if err := utils.ValidateParams(r, []string{"user_id", "from", "to", "questionnaire_key", "limit", "cursor"}); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
 
 	params := TietoevryQuestionnaireParams{
-		UserID: r.URL.Query().Get("user_id"),
+		UserID:           r.URL.Query().Get("user_id"),
+		From:             r.URL.Query().Get("from"),
+		To:               r.URL.Query().Get("to"),
+		QuestionnaireKey: r.URL.Query().Get("questionnaire_key"),
 	}
 
 	if err := utils.GetValidator().Struct(params); err != nil {
@@ -219,9 +247,22 @@ func (h *TietoevryQuestionnaireHandler) GetQuestionnaires(w http.ResponseWriter,
 		return
 	}
 
-	cacheKey := fmt.Sprintf("tietoevry:questionnaires:%s", params.UserID)
+	page, err := utils.ParsePage(r, utils.DefaultPageLimits, utils.CursorTime|utils.CursorID|utils.CursorSubID)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	filter, err := readListFilter(r)
+	if err != nil {
+		utils.UnprocessableEntityResponse(w, r, err)
+		return
+	}
+
+	cacheKey := fmt.Sprintf("tietoevry:questionnaires:%s:%s:%s", params.UserID, filterCacheKey(r), page.CacheKey())
 	if h.cache != nil {
 		if cached, err := h.cache.Get(r.Context(), cacheKey); err == nil && cached != "" {
+			utils.SetNextLink(w, r, utils.CachedNextCursor(cached))
 			utils.WriteJSON(w, http.StatusOK, json.RawMessage(cached))
 			return
 		}
@@ -233,15 +274,19 @@ func (h *TietoevryQuestionnaireHandler) GetQuestionnaires(w http.ResponseWriter,
 		return
 	}
 
-	questionnaires, err := h.store.GetQuestionnairesByUser(r.Context(), userID)
+	questionnaires, err := h.store.GetQuestionnairesByUser(r.Context(), userID, filter, page)
 	if err != nil {
 		utils.InternalServerError(w, r, err)
 		return
 	}
+	questionnaires, pageInfo := utils.NextPage(questionnaires, page, func(q tietoevrysqlc.QuestionAnswer) utils.Cursor {
+		return utils.Cursor{Time: &q.CreatedAt, ID: &q.QuestionnaireInstanceID, SubID: &q.QuestionID}
+	})
 
 	if len(questionnaires) == 0 {
 		utils.WriteJSON(w, http.StatusOK, map[string]any{
 			"questionnaires": []swagger.TietoevryQuestionnaireAnswerInput{},
+			"pagination":     pageInfo,
 		})
 		return
 	}
@@ -270,7 +315,8 @@ func (h *TietoevryQuestionnaireHandler) GetQuestionnaires(w http.ResponseWriter,
 		output = append(output, out)
 	}
 
-	resp := map[string]any{"questionnaires": output}
+	resp := map[string]any{"questionnaires": output, "pagination": pageInfo}
 	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, resp, TietoevryCacheTTL)
+	utils.SetNextLink(w, r, pageInfo.NextCursor)
 	utils.WriteJSON(w, http.StatusOK, resp)
 }
@@ -57,7 +57,11 @@ type TietoevrySymptomsBulkInput struct {
 //	@Accept			json
 //	@Produce		json
 //	@Param			symptoms	body	swagger.TietoevrySymptomsBulkInput	true	"Symptom data"
+//	@Param			partial		query	bool								false	"Write every item on its own and report the outcome of each"
+//	@Param			async		query	bool								false	"Queue the request as an ingest job, see /ingest-jobs"
 //	@Success		201			"Symptoms processed successfully (idempotent operation)"
+//	@Success		202			{object}	swagger.IngestJobEnvelope	"Queued as an ingest job (async mode)"
+//	@Success		207			{object}	swagger.BulkReport	"Some items failed (partial mode)"
 //	@Failure		400			{object}	swagger.ValidationErrorResponse
 //	@Failure		401			{object}	swagger.UnauthorizedResponse
 //	@Failure		403			{object}	swagger.ForbiddenResponse
@@ -77,6 +81,15 @@ func (h *TietoevrySymptomHandler) InsertSymptomsBulk(w http.ResponseWriter, r *h
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
+
+	if utils.PartialBulk(r) {
+		insertPartial(w, r, h.cache, syPrefix, input.Symptoms, symptomParams,
+			func(p tietoevrysqlc.InsertSymptomParams) uuid.UUID { return p.UserID },
+			h.store.InsertSymptomsPartial,
+		)
+		return
+	}
+
 	if err := utils.GetValidator().Struct(input); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -101,63 +114,12 @@ func (h *TietoevrySymptomHandler) InsertSymptomsBulk(w http.ResponseWriter, r *h
 	// Convert to database parameters
 	symptoms := make([]tietoevrysqlc.InsertSymptomParams, len(input.Symptoms))
 	for i, symptom := range input.Symptoms {
-		// Parse and convert values
-		id, err := utils.ParseUUID(symptom.ID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		userID, err := utils.ParseUUID(symptom.UserID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		date, err := utils.ParseDate(symptom.Date)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		createdAt, err := utils.ParseTimestamp(symptom.CreatedAt)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		updatedAt, err := utils.ParseTimestamp(symptom.UpdatedAt)
+		arg, err := symptomParams(symptom)
 		if err != nil {
 			utils.BadRequestResponse(w, r, err)
 			return
 		}
-
-		originalID, err := utils.ParseUUIDPtr(symptom.OriginalID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		rawData := utils.ParseRawJSON(symptom.AdditionalData)
-
-		symptoms[i] = tietoevrysqlc.InsertSymptomParams{
-			ID:             id,
-			UserID:         userID,
-			Date:           date,
-			Symptom:        symptom.Symptom,
-			Severity:       symptom.Severity,
-			Comment:        utils.NullStringPtr(symptom.Comment),
-			Source:         symptom.Source,
-			CreatedAt:      createdAt,
-			UpdatedAt:      updatedAt,
-			RawID:          utils.NullStringPtr(symptom.RawID),
-			OriginalID:     originalID,
-			Recovered:      utils.NullBoolPtr(symptom.Recovered),
-			PainIndex:      utils.NullInt32Ptr(symptom.PainIndex),
-			Side:           utils.NullStringPtr(symptom.Side),
-			Category:       utils.NullStringPtr(symptom.Category),
-			AdditionalData: rawData,
-		}
+		symptoms[i] = arg
 	}
 
 	if err := h.store.InsertSymptomsBulk(r.Context(), symptoms); err != nil {
@@ -180,8 +142,67 @@ func (h *TietoevrySymptomHandler) InsertSymptomsBulk(w http.ResponseWriter, r *h
 	w.WriteHeader(http.StatusCreated)
 }
 
+// symptomParams converts one item of the bulk input
+func symptomParams(symptom TietoevrySymptomInput) (tietoevrysqlc.InsertSymptomParams, error) {
+	// Parse and convert values
+	id, err := utils.ParseUUID(symptom.ID)
+	if err != nil {
+		return tietoevrysqlc.InsertSymptomParams{}, err
+	}
+
+	userID, err := utils.ParseUUID(symptom.UserID)
+	if err != nil {
+		return tietoevrysqlc.InsertSymptomParams{}, err
+	}
+
+	date, err := utils.ParseDate(symptom.Date)
+	if err != nil {
+		return tietoevrysqlc.InsertSymptomParams{}, err
+	}
+
+	createdAt, err := utils.ParseTimestamp(symptom.CreatedAt)
+	if err != nil {
+		return tietoevrysqlc.InsertSymptomParams{}, err
+	}
+
+	updatedAt, err := utils.ParseTimestamp(symptom.UpdatedAt)
+	if err != nil {
+		return tietoevrysqlc.InsertSymptomParams{}, err
+	}
+
+	originalID, err := utils.ParseUUIDPtr(symptom.OriginalID)
+	if err != nil {
+		return tietoevrysqlc.InsertSymptomParams{}, err
+	}
+
+	rawData := utils.ParseRawJSON(symptom.AdditionalData)
+
+	return tietoevrysqlc.InsertSymptomParams{
+		ID:             id,
+		UserID:         userID,
+		Date:           date,
+		Symptom:        symptom.Symptom,
+		Severity:       symptom.Severity,
+		Comment:        utils.NullStringPtr(symptom.Comment),
+		Source:         symptom.Source,
+		CreatedAt:      createdAt,
+		UpdatedAt:      updatedAt,
+		RawID:          utils.NullStringPtr(symptom.RawID),
+		OriginalID:     originalID,
+		Recovered:      utils.NullBoolPtr(symptom.Recovered),
+		PainIndex:      utils.NullInt32Ptr(symptom.PainIndex),
+		Side:           utils.NullStringPtr(symptom.Side),
+		Category:       utils.NullStringPtr(symptom.Category),
+		AdditionalData: rawData,
+	}, nil
+}
+
 type TietoevrySymptomParams struct {
-	UserID string `form:"user_id" validate:"required,uuid4"`
+	UserID  string `form:"user_id" validate:"required,uuid4"`
+	From    string `form:"from" validate:"omitempty,datetime=2006-01-02"`
+	To      string `form:"to" validate:"omitempty,datetime=2006-01-02"`
+	Source  string `form:"source" validate:"omitempty,max=100"`
+	Symptom string `form:"symptom" validate:"omitempty,max=100"`
 }
 
 // GetSymptoms godoc
@@ -190,8 +211,15 @@ type TietoevrySymptomParams struct {
 //	@Description	Get all symptoms for a specific user
 //	@Tags			Tietoevry - Symptoms
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,text/csv,application/vnd.apache.parquet
 //	@Param			user_id	query		string	true	"User ID (UUID)"
+//	@Param			from	query		string	false	"Only rows on or after this day (date, YYYY-MM-DD)"
+//	@Param			to		query		string	false	"Only rows on or before this day (date, YYYY-MM-DD)"
+//	@Param			source	query		string	false	"Filter by source"
+//	@Param			symptom	query		string	false	"Filter by symptom"
+//	@Param			limit	query		int		false	"Page size (default: 100, max: 1000)"
+//	@Param			cursor	query		string	false	"Opaque cursor from pagination.next_cursor of the previous page"
+//	@Param			format	query		string	false	"Response format: json (default), csv or parquet; also negotiated with Accept"
 //	@Success		200		{object}	swagger.TietoevrySymptomResponse
 //	@Failure		400		{object}	swagger.ValidationErrorResponse
 //	@Failure		401		{object}	swagger.UnauthorizedResponse
@@ -206,13 +234,17 @@ func (h *TietoevrySymptomHandler) GetSymptoms(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	if err := utils.ValidateParams(r, []string{"user_id"}); err != nil {
+	if err := utils.ValidateParams(r, []string{"user_id", "from", "to", "source", "symptom", "limit", "cursor", "format"}); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
 
 	params := TietoevrySymptomParams{
-		UserID: r.URL.Query().Get("user_id"),
+		UserID:  r.URL.Query().Get("user_id"),
+		From:    r.URL.Query().Get("from"),
+		To:      r.URL.Query().Get("to"),
+		Source:  r.URL.Query().Get("source"),
+		Symptom: r.URL.Query().Get("symptom"),
 	}
 
 	if err := utils.GetValidator().Struct(params); err != nil {
@@ -220,9 +252,28 @@ func (h *TietoevrySymptomHandler) GetSymptoms(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	cacheKey := fmt.Sprintf("tietoevry:symptoms:%s", params.UserID)
-	if h.cache != nil {
+	page, err := utils.ParsePage(r, utils.DefaultPageLimits, utils.CursorTime|utils.CursorID)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	filter, err := readListFilter(r)
+	if err != nil {
+		utils.UnprocessableEntityResponse(w, r, err)
+		return
+	}
+
+	format, err := utils.NegotiateFormat(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	cacheKey := fmt.Sprintf("tietoevry:symptoms:%s:%s:%s", params.UserID, filterCacheKey(r), page.CacheKey())
+	if h.cache != nil && format == utils.FormatJSON {
 		if cached, err := h.cache.Get(r.Context(), cacheKey); err == nil && cached != "" {
+			utils.SetNextLink(w, r, utils.CachedNextCursor(cached))
 			utils.WriteJSON(w, http.StatusOK, json.RawMessage(cached))
 			return
 		}
@@ -234,15 +285,19 @@ func (h *TietoevrySymptomHandler) GetSymptoms(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	symptoms, err := h.store.GetSymptomsByUser(r.Context(), userID)
+	symptoms, err := h.store.GetSymptomsByUser(r.Context(), userID, filter, page)
 	if err != nil {
 		utils.InternalServerError(w, r, err)
 		return
 	}
+	symptoms, pageInfo := utils.NextPage(symptoms, page, func(s tietoevrysqlc.Symptom) utils.Cursor {
+		return utils.TimeCursor(s.Date, s.ID)
+	})
 
-	if len(symptoms) == 0 {
+	if len(symptoms) == 0 && format == utils.FormatJSON {
 		utils.WriteJSON(w, http.StatusOK, map[string]any{
-			"symptoms": []swagger.TietoevrySymptomInput{},
+			"symptoms":   []swagger.TietoevrySymptomInput{},
+			"pagination": pageInfo,
 		})
 		return
 	}
@@ -270,7 +325,14 @@ func (h *TietoevrySymptomHandler) GetSymptoms(w http.ResponseWriter, r *http.Req
 		output = append(output, out)
 	}
 
-	resp := map[string]any{"symptoms": output}
+	if format != utils.FormatJSON {
+		utils.SetNextLink(w, r, pageInfo.NextCursor)
+		utils.WriteTable(w, format, "tietoevry_symptoms", output)
+		return
+	}
+
+	resp := map[string]any{"symptoms": output, "pagination": pageInfo}
 	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, resp, TietoevryCacheTTL)
+	utils.SetNextLink(w, r, pageInfo.NextCursor)
 	utils.WriteJSON(w, http.StatusOK, resp)
 }
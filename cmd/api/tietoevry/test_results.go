@@ -57,7 +57,11 @@ type TietoevryTestResultsBulkInput struct {
 //	@Accept			json
 //	@Produce		json
 //	@Param			test_results	body	swagger.TietoevryTestResultsBulkInput	true	"Test result data"
+//	@Param			partial			query	bool									false	"Write every item on its own and report the outcome of each"
+//	@Param			async			query	bool									false	"Queue the request as an ingest job, see /ingest-jobs"
 //	@Success		201				"Test results processed successfully"
+//	@Success		202				{object}	swagger.IngestJobEnvelope	"Queued as an ingest job (async mode)"
+//	@Success		207				{object}	swagger.BulkReport	"Some items failed (partial mode)"
 //	@Failure		400				{object}	swagger.ValidationErrorResponse
 //	@Failure		401				{object}	swagger.UnauthorizedResponse
 //	@Failure		403				{object}	swagger.ForbiddenResponse
@@ -77,6 +81,15 @@ func (h *TietoevryTestResultHandler) InsertTestResultsBulk(w http.ResponseWriter
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
+
+	if utils.PartialBulk(r) {
+		insertPartial(w, r, h.cache, trPrefix, input.TestResults, testResultParams,
+			func(p tietoevrysqlc.InsertTestResultParams) uuid.UUID { return p.UserID },
+			h.store.InsertTestResultsPartial,
+		)
+		return
+	}
+
 	if err := utils.GetValidator().Struct(input); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
@@ -100,84 +113,12 @@ func (h *TietoevryTestResultHandler) InsertTestResultsBulk(w http.ResponseWriter
 
 	testResults := make([]tietoevrysqlc.InsertTestResultParams, len(input.TestResults))
 	for i, testResult := range input.TestResults {
-		// Parse and convert values
-		id, err := utils.ParseUUID(testResult.ID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		userID, err := utils.ParseUUID(testResult.UserID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		typeID, err := utils.ParseUUID(testResult.TypeID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		timestamp, err := utils.ParseTimestamp(testResult.Timestamp)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		createdAt, err := utils.ParseTimestamp(testResult.CreatedAt)
+		arg, err := testResultParams(testResult)
 		if err != nil {
 			utils.BadRequestResponse(w, r, err)
 			return
 		}
-
-		updatedAt, err := utils.ParseTimestamp(testResult.UpdatedAt)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		testEventID, err := utils.ParseUUIDPtr(testResult.TestEventID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		testEventDate, err := utils.ParseDatePtr(testResult.TestEventDate)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		testEventTemplateTestID, err := utils.ParseUUIDPtr(testResult.TestEventTemplateTestID)
-		if err != nil {
-			utils.BadRequestResponse(w, r, err)
-			return
-		}
-
-		dataJSON := utils.ParseRequiredJSON(testResult.Data)
-		templateLimitsJSON := utils.ParseRawJSON(testResult.TestEventTemplateTestLimits)
-
-		testResults[i] = tietoevrysqlc.InsertTestResultParams{
-			ID:                          id,
-			UserID:                      userID,
-			TypeID:                      typeID,
-			TypeType:                    utils.NullStringPtr(testResult.TypeType),
-			TypeResultType:              testResult.TypeResultType,
-			TypeName:                    utils.NullStringPtr(testResult.TypeName),
-			Timestamp:                   timestamp,
-			Name:                        utils.NullStringPtr(testResult.Name),
-			Comment:                     utils.NullStringPtr(testResult.Comment),
-			Data:                        dataJSON,
-			CreatedAt:                   createdAt,
-			UpdatedAt:                   updatedAt,
-			TestEventID:                 testEventID,
-			TestEventName:               utils.NullStringPtr(testResult.TestEventName),
-			TestEventDate:               utils.NullTimePtr(testEventDate),
-			TestEventTemplateTestID:     testEventTemplateTestID,
-			TestEventTemplateTestName:   utils.NullStringPtr(testResult.TestEventTemplateTestName),
-			TestEventTemplateTestLimits: templateLimitsJSON,
-		}
+		testResults[i] = arg
 	}
 
 	if err := h.store.InsertTestResultsBulk(r.Context(), testResults); err != nil {
@@ -200,8 +141,83 @@ func (h *TietoevryTestResultHandler) InsertTestResultsBulk(w http.ResponseWriter
 	w.WriteHeader(http.StatusCreated)
 }
 
+// testResultParams converts one item of the bulk input
+func testResultParams(testResult TietoevryTestResultInput) (tietoevrysqlc.InsertTestResultParams, error) {
+	// Parse and convert values
+	id, err := utils.ParseUUID(testResult.ID)
+	if err != nil {
+		return tietoevrysqlc.InsertTestResultParams{}, err
+	}
+
+	userID, err := utils.ParseUUID(testResult.UserID)
+	if err != nil {
+		return tietoevrysqlc.InsertTestResultParams{}, err
+	}
+
+	typeID, err := utils.ParseUUID(testResult.TypeID)
+	if err != nil {
+		return tietoevrysqlc.InsertTestResultParams{}, err
+	}
+
+	timestamp, err := utils.ParseTimestamp(testResult.Timestamp)
+	if err != nil {
+		return tietoevrysqlc.InsertTestResultParams{}, err
+	}
+
+	createdAt, err := utils.ParseTimestamp(testResult.CreatedAt)
+	if err != nil {
+		return tietoevrysqlc.InsertTestResultParams{}, err
+	}
+
+	updatedAt, err := utils.ParseTimestamp(testResult.UpdatedAt)
+	if err != nil {
+		return tietoevrysqlc.InsertTestResultParams{}, err
+	}
+
+	testEventID, err := utils.ParseUUIDPtr(testResult.TestEventID)
+	if err != nil {
+		return tietoevrysqlc.InsertTestResultParams{}, err
+	}
+
+	testEventDate, err := utils.ParseDatePtr(testResult.TestEventDate)
+	if err != nil {
+		return tietoevrysqlc.InsertTestResultParams{}, err
+	}
+
+	testEventTemplateTestID, err := utils.ParseUUIDPtr(testResult.TestEventTemplateTestID)
+	if err != nil {
+		return tietoevrysqlc.InsertTestResultParams{}, err
+	}
+
+	dataJSON := utils.ParseRequiredJSON(testResult.Data)
+	templateLimitsJSON := utils.ParseRawJSON(testResult.TestEventTemplateTestLimits)
+
+	return tietoevrysqlc.InsertTestResultParams{
+		ID:                          id,
+		UserID:                      userID,
+		TypeID:                      typeID,
+		TypeType:                    utils.NullStringPtr(testResult.TypeType),
+		TypeResultType:              testResult.TypeResultType,
+		TypeName:                    utils.NullStringPtr(testResult.TypeName),
+		Timestamp:                   timestamp,
+		Name:                        utils.NullStringPtr(testResult.Name),
+		Comment:                     utils.NullStringPtr(testResult.Comment),
+		Data:                        dataJSON,
+		CreatedAt:                   createdAt,
+		UpdatedAt:                   updatedAt,
+		TestEventID:                 testEventID,
+		TestEventName:               utils.NullStringPtr(testResult.TestEventName),
+		TestEventDate:               utils.NullTimePtr(testEventDate),
+		TestEventTemplateTestID:     testEventTemplateTestID,
+		TestEventTemplateTestName:   utils.NullStringPtr(testResult.TestEventTemplateTestName),
+		TestEventTemplateTestLimits: templateLimitsJSON,
+	}, nil
+}
+
 type TietoevryTestResultParams struct {
 	UserID string `form:"user_id" validate:"required,uuid4"`
+	From   string `form:"from" validate:"omitempty,datetime=2006-01-02"`
+	To     string `form:"to" validate:"omitempty,datetime=2006-01-02"`
 }
 
 // GetTestResults godoc
@@ -210,8 +226,13 @@ type TietoevryTestResultParams struct {
 //	@Description	Get all test results for a specific user
 //	@Tags			Tietoevry - Test_Results
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,text/csv,application/vnd.apache.parquet
 //	@Param			user_id	query		string	true	"User ID (UUID)"
+//	@Param			from	query		string	false	"Only rows on or after this UTC day (timestamp, YYYY-MM-DD)"
+//	@Param			to		query		string	false	"Only rows on or before this UTC day (timestamp, YYYY-MM-DD)"
+//	@Param			limit	query		int		false	"Page size (default: 100, max: 1000)"
+//	@Param			cursor	query		string	false	"Opaque cursor from pagination.next_cursor of the previous page"
+//	@Param			format	query		string	false	"Response format: json (default), csv or parquet; also negotiated with Accept"
 //	@Success		200		{object}	swagger.TietoevryTestResultResponse
 //	@Failure		400		{object}	swagger.ValidationErrorResponse
 //	@Failure		401		{object}	swagger.UnauthorizedResponse
@@ -226,13 +247,15 @@ func (h *TietoevryTestResultHandler) GetTestResults(w http.ResponseWriter, r *ht
 		return
 	}
 
-	if err := utils.ValidateParams(r, []string{"user_id"}); err != nil {
+	if err := utils.ValidateParams(r, []string{"user_id", "from", "to", "limit", "cursor", "format"}); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
 
 	params := TietoevryTestResultParams{
 		UserID: r.URL.Query().Get("user_id"),
+		From:   r.URL.Query().Get("from"),
+		To:     r.URL.Query().Get("to"),
 	}
 
 	if err := utils.GetValidator().Struct(params); err != nil {
@@ -240,9 +263,28 @@ func (h *TietoevryTestResultHandler) GetTestResults(w http.ResponseWriter, r *ht
 		return
 	}
 
-	cacheKey := fmt.Sprintf("tietoevry:test-results:%s", params.UserID)
-	if h.cache != nil {
+	page, err := utils.ParsePage(r, utils.DefaultPageLimits, utils.CursorTime|utils.CursorID)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	filter, err := readListFilter(r)
+	if err != nil {
+		utils.UnprocessableEntityResponse(w, r, err)
+		return
+	}
+
+	format, err := utils.NegotiateFormat(r)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	cacheKey := fmt.Sprintf("tietoevry:test-results:%s:%s:%s", params.UserID, filterCacheKey(r), page.CacheKey())
+	if h.cache != nil && format == utils.FormatJSON {
 		if cached, err := h.cache.Get(r.Context(), cacheKey); err == nil && cached != "" {
+			utils.SetNextLink(w, r, utils.CachedNextCursor(cached))
 			utils.WriteJSON(w, http.StatusOK, json.RawMessage(cached))
 			return
 		}
@@ -254,15 +296,19 @@ func (h *TietoevryTestResultHandler) GetTestResults(w http.ResponseWriter, r *ht
 		return
 	}
 
-	testResults, err := h.store.GetTestResultsByUser(r.Context(), userID)
+	testResults, err := h.store.GetTestResultsByUser(r.Context(), userID, filter, page)
 	if err != nil {
 		utils.InternalServerError(w, r, err)
 		return
 	}
+	testResults, pageInfo := utils.NextPage(testResults, page, func(t tietoevrysqlc.TestResult) utils.Cursor {
+		return utils.TimeCursor(t.Timestamp, t.ID)
+	})
 
-	if len(testResults) == 0 {
+	if len(testResults) == 0 && format == utils.FormatJSON {
 		utils.WriteJSON(w, http.StatusOK, map[string]any{
 			"test_results": []swagger.TietoevryTestResultInput{},
+			"pagination":   pageInfo,
 		})
 		return
 	}
@@ -292,7 +338,14 @@ func (h *TietoevryTestResultHandler) GetTestResults(w http.ResponseWriter, r *ht
 		output = append(output, out)
 	}
 
-	resp := map[string]any{"test_results": output}
+	if format != utils.FormatJSON {
+		utils.SetNextLink(w, r, pageInfo.NextCursor)
+		utils.WriteTable(w, format, "tietoevry_test_results", output)
+		return
+	}
+
+	resp := map[string]any{"test_results": output, "pagination": pageInfo}
 	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, resp, TietoevryCacheTTL)
+	utils.SetNextLink(w, r, pageInfo.NextCursor)
 	utils.WriteJSON(w, http.StatusOK, resp)
 }
@@ -51,7 +51,9 @@ type TietoevryUserDeleteParams struct {
 //	@Accept			json
 //	@Produce		json
 //	@Param			user	body	swagger.TietoevryUserUpsertInput	true	"User data"
+//	@Param			async	query	bool								false	"Queue the request as an ingest job, see /ingest-jobs"
 //	@Success		201		"created"
+//	@Success		202		{object}	swagger.IngestJobEnvelope	"Queued as an ingest job (async mode)"
 //	@Failure		400		{object}	swagger.ValidationErrorResponse
 //	@Failure		401		{object}	swagger.UnauthorizedResponse
 //	@Failure		403		{object}	swagger.ForbiddenResponse
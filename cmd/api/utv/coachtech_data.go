@@ -231,7 +231,7 @@ func (h *CoachtechDataHandler) Insert(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.store.InsertCoachtechData(r.Context(), input.CoachtechID, date, input.TestID, input.Data); err != nil {
+	if err := h.store.InsertCoachtechData(r.Context(), userID, input.CoachtechID, date, input.TestID, input.Data); err != nil {
 		utils.InternalServerError(w, r, err)
 		return
 	}
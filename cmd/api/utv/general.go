@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	utvsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/utv"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
 	"github.com/DeRuina/KUHA-REST-API/internal/store/utv"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
@@ -205,7 +206,7 @@ func (h *GeneralDataHandler) GetLatestData(w http.ResponseWriter, r *http.Reques
 //	@Description	Returns all entries of a specific data type for a user, across all wearable devices, optionally filtered by date range, paginated by limit (default 3) and offset.
 //	@Tags			UTV - General
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,application/x-ndjson
 //	@Param			user_id		query	string						true	"User ID (UUID)"
 //	@Param			type		query	string						true	"Data type (e.g., 'sleep', 'activity')"
 //	@Param			after_date	query	string						false	"Filter data after this date (YYYY-MM-DD)"
@@ -295,46 +296,51 @@ func (h *GeneralDataHandler) GetAllByType(w http.ResponseWriter, r *http.Request
 
 	cacheKey := fmt.Sprintf("utv:all:%s:%s:after:%s:before:%s,limit:%d,offset:%d", userID, params.Type, after, before, params.Limit, params.Offset)
 
-	if h.cache != nil {
+	if h.cache != nil && !utils.WantsNDJSON(r) {
 		if cached, err := h.cache.Get(r.Context(), cacheKey); err == nil && cached != "" {
 			utils.WriteJSON(w, http.StatusOK, json.RawMessage(cached))
 			return
 		}
 	}
 
-	var results []LatestDataResponse
+	// Rows are streamed device by device as they are read
+	sw := utils.NewArrayStreamWriter(w, r)
 
 	// Helper to query one device with pagination
 	fetch := func(name string, store interface {
 		GetAllByType(ctx context.Context, userID uuid.UUID, typ string, after, before *time.Time, limit, offset int32) ([]utv.LatestDataEntry, error)
-	}) {
+	}) error {
 		data, err := store.GetAllByType(r.Context(), userID, params.Type, after, before, params.Limit, params.Offset)
 		if err != nil {
-			return
+			return nil // silently ignore errors per device
 		}
 		for _, row := range data {
-			results = append(results, LatestDataResponse{
+			if err := sw.Row(LatestDataResponse{
 				Device: name,
 				Date:   row.Date.Format("2006-01-02"),
 				Data:   row.Data,
-			})
+			}); err != nil {
+				return err
+			}
 		}
+		return nil
 	}
 
 	// Query all 4 devices
-	fetch("garmin", h.garmin)
-	fetch("oura", h.oura)
-	fetch("polar", h.polar)
-	fetch("suunto", h.suunto)
+	if fetch("garmin", h.garmin) != nil || fetch("oura", h.oura) != nil ||
+		fetch("polar", h.polar) != nil || fetch("suunto", h.suunto) != nil {
+		return
+	}
 
-	if len(results) == 0 {
+	if !sw.Started() {
 		w.WriteHeader(http.StatusNoContent)
 		return
 	}
+	if err := sw.Close(); err != nil {
+		return
+	}
 
-	cache.SetCacheJSON(r.Context(), h.cache, cacheKey, results, UTVCacheTTL)
-
-	utils.WriteJSON(w, http.StatusOK, results)
+	cache.SetCacheStream(r.Context(), h.cache, cacheKey, sw, UTVCacheTTL)
 }
 
 type DisconnectParams struct {
@@ -428,9 +434,11 @@ type UserDataResponse struct {
 //	@Tags			UTV - General
 //	@Accept			json
 //	@Produce		json
-//	@Param			source	query	string					true	"Source device (one of: 'polar', 'oura', 'suunto', 'garmin')"
-//	@Param			hours	query	int						true	"Number of hours to look back (1-8760)"
-//	@Success		200		{array}	swagger.PolarTokenInput	"List of tokens needing update"
+//	@Param			source	query		string								true	"Source device (one of: 'polar', 'oura', 'suunto', 'garmin')"
+//	@Param			hours	query		int									true	"Number of hours to look back (1-8760)"
+//	@Param			limit	query		int									false	"Page size (default: 100, max: 1000)"
+//	@Param			cursor	query		string								false	"Opaque cursor from pagination.next_cursor of the previous page"
+//	@Success		200		{object}	swagger.UTVTokensForUpdateResponse	"Page of tokens needing update"
 //	@Success		204		"No Content: No tokens found"
 //	@Failure		400		{object}	swagger.ValidationErrorResponse
 //	@Failure		401		{object}	swagger.UnauthorizedResponse
@@ -445,7 +453,7 @@ func (h *GeneralDataHandler) GetTokensForUpdate(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	if err := utils.ValidateParams(r, []string{"source", "hours"}); err != nil {
+	if err := utils.ValidateParams(r, []string{"source", "hours", "limit", "cursor"}); err != nil {
 		utils.BadRequestResponse(w, r, err)
 		return
 	}
@@ -468,11 +476,17 @@ func (h *GeneralDataHandler) GetTokensForUpdate(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	page, err := utils.ParsePage(r, utils.DefaultPageLimits, utils.CursorID)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
 	cutoff := time.Now().Add(-time.Duration(params.Hours) * time.Hour)
 
 	switch params.Source {
 	case "polar":
-		tokens, err := h.polarToken.GetTokensForUpdate(r.Context(), cutoff)
+		tokens, err := h.polarToken.GetTokensForUpdate(r.Context(), cutoff, page)
 		if err != nil {
 			utils.InternalServerError(w, r, err)
 			return
@@ -481,14 +495,18 @@ func (h *GeneralDataHandler) GetTokensForUpdate(w http.ResponseWriter, r *http.R
 			w.WriteHeader(http.StatusNoContent)
 			return
 		}
+		tokens, pageInfo := utils.NextPage(tokens, page, func(t utvsqlc.PolarToken) utils.Cursor {
+			return utils.Cursor{ID: &t.UserID}
+		})
 		resp := make([]UserDataResponse, 0, len(tokens))
 		for _, t := range tokens {
 			resp = append(resp, UserDataResponse{UserID: t.UserID.String(), Data: t.Data})
 		}
-		utils.WriteJSON(w, http.StatusOK, resp)
+		utils.SetNextLink(w, r, pageInfo.NextCursor)
+		utils.WriteJSON(w, http.StatusOK, map[string]any{"tokens": resp, "pagination": pageInfo})
 
 	case "oura":
-		tokens, err := h.ouraToken.GetTokensForUpdate(r.Context(), cutoff)
+		tokens, err := h.ouraToken.GetTokensForUpdate(r.Context(), cutoff, page)
 		if err != nil {
 			utils.InternalServerError(w, r, err)
 			return
@@ -497,14 +515,18 @@ func (h *GeneralDataHandler) GetTokensForUpdate(w http.ResponseWriter, r *http.R
 			w.WriteHeader(http.StatusNoContent)
 			return
 		}
+		tokens, pageInfo := utils.NextPage(tokens, page, func(t utvsqlc.OuraToken) utils.Cursor {
+			return utils.Cursor{ID: &t.UserID}
+		})
 		resp := make([]UserDataResponse, 0, len(tokens))
 		for _, t := range tokens {
 			resp = append(resp, UserDataResponse{UserID: t.UserID.String(), Data: t.Data})
 		}
-		utils.WriteJSON(w, http.StatusOK, resp)
+		utils.SetNextLink(w, r, pageInfo.NextCursor)
+		utils.WriteJSON(w, http.StatusOK, map[string]any{"tokens": resp, "pagination": pageInfo})
 
 	case "suunto":
-		tokens, err := h.suuntoToken.GetTokensForUpdate(r.Context(), cutoff)
+		tokens, err := h.suuntoToken.GetTokensForUpdate(r.Context(), cutoff, page)
 		if err != nil {
 			utils.InternalServerError(w, r, err)
 			return
@@ -513,14 +535,18 @@ func (h *GeneralDataHandler) GetTokensForUpdate(w http.ResponseWriter, r *http.R
 			w.WriteHeader(http.StatusNoContent)
 			return
 		}
+		tokens, pageInfo := utils.NextPage(tokens, page, func(t utvsqlc.SuuntoToken) utils.Cursor {
+			return utils.Cursor{ID: &t.UserID}
+		})
 		resp := make([]UserDataResponse, 0, len(tokens))
 		for _, t := range tokens {
 			resp = append(resp, UserDataResponse{UserID: t.UserID.String(), Data: t.Data})
 		}
-		utils.WriteJSON(w, http.StatusOK, resp)
+		utils.SetNextLink(w, r, pageInfo.NextCursor)
+		utils.WriteJSON(w, http.StatusOK, map[string]any{"tokens": resp, "pagination": pageInfo})
 
 	case "garmin":
-		tokens, err := h.garminToken.GetTokensForUpdate(r.Context(), cutoff)
+		tokens, err := h.garminToken.GetTokensForUpdate(r.Context(), cutoff, page)
 		if err != nil {
 			utils.InternalServerError(w, r, err)
 			return
@@ -529,11 +555,15 @@ func (h *GeneralDataHandler) GetTokensForUpdate(w http.ResponseWriter, r *http.R
 			w.WriteHeader(http.StatusNoContent)
 			return
 		}
+		tokens, pageInfo := utils.NextPage(tokens, page, func(t utvsqlc.GarminToken) utils.Cursor {
+			return utils.Cursor{ID: &t.UserID}
+		})
 		resp := make([]UserDataResponse, 0, len(tokens))
 		for _, t := range tokens {
 			resp = append(resp, UserDataResponse{UserID: t.UserID.String(), Data: t.Data})
 		}
-		utils.WriteJSON(w, http.StatusOK, resp)
+		utils.SetNextLink(w, r, pageInfo.NextCursor)
+		utils.WriteJSON(w, http.StatusOK, map[string]any{"tokens": resp, "pagination": pageInfo})
 
 	default:
 		utils.BadRequestResponse(w, r, fmt.Errorf("invalid source: must be one of polar, oura, suunto, garmin"))
@@ -288,7 +288,9 @@ func (h *PolarDataHandler) GetData(w http.ResponseWriter, r *http.Request) {
 //	@Accept			json
 //	@Produce		json
 //	@Param			body	body	swagger.PolarPostDataInput	true	"Polar data input"
+//	@Param			async	query	bool						false	"Queue the request as an ingest job, see /ingest-jobs"
 //	@Success		201		"Created: Data successfully stored (no content in response body)"
+//	@Success		202		{object}	swagger.IngestJobEnvelope	"Queued as an ingest job (async mode)"
 //	@Failure		400		{object}	swagger.ValidationErrorResponse
 //	@Failure		401		{object}	swagger.UnauthorizedResponse
 //	@Failure		403		{object}	swagger.ForbiddenResponse
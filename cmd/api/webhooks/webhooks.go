@@ -0,0 +1,599 @@
+package webhooksapi
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authn"
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	authsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/auth"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/auth"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/DeRuina/KUHA-REST-API/internal/webhooks"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+var (
+	errWebhookURL      = errors.New("url must be an absolute http(s) URL")
+	errWebhookHTTPS    = errors.New("url must use https")
+	errWebhookHost     = errors.New("url host could not be resolved")
+	errNoDomains       = errors.New("no readable domains to follow")
+	errDeliveryNotDead = errors.New("only dead deliveries can be redelivered")
+)
+
+// Handler struct
+type WebhooksHandler struct {
+	store        auth.Webhooks
+	dispatcher   *webhooks.Dispatcher
+	allowHTTP    bool
+	allowPrivate bool
+}
+
+func NewWebhooksHandler(store auth.Webhooks, dispatcher *webhooks.Dispatcher, allowHTTP, allowPrivate bool) *WebhooksHandler {
+	return &WebhooksHandler{store: store, dispatcher: dispatcher, allowHTTP: allowHTTP, allowPrivate: allowPrivate}
+}
+
+// Input struct for validation
+type WebhookInput struct {
+	URL        string   `json:"url" validate:"required,max=2048"`
+	Domains    []string `json:"domains" validate:"omitempty,dive,oneof=fis utv tietoevry kamk klab archinisis"`
+	Entities   []string `json:"entities" validate:"omitempty,max=50,dive,required,max=100"`
+	Operations []string `json:"operations" validate:"omitempty,dive,oneof=insert update upsert delete"`
+	AthleteIDs []string `json:"athlete_ids" validate:"omitempty,max=1000,dive,required,max=100"`
+	Active     *bool    `json:"active"`
+}
+
+// WebhookResponse is a webhook subscription. The secret is only returned
+// when it is created or rotated.
+type WebhookResponse struct {
+	ID         uuid.UUID `json:"id"`
+	URL        string    `json:"url"`
+	Secret     string    `json:"secret,omitempty"`
+	Domains    []string  `json:"domains"`
+	Entities   []string  `json:"entities"`
+	Operations []string  `json:"operations"`
+	AthleteIDs []string  `json:"athlete_ids"`
+	Active     bool      `json:"active"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+func webhookResponse(sub authsqlc.WebhookSubscription) WebhookResponse {
+	return WebhookResponse{
+		ID:         sub.ID,
+		URL:        sub.Url,
+		Domains:    sub.Domains,
+		Entities:   sub.Entities,
+		Operations: sub.Operations,
+		AthleteIDs: sub.AthleteIds,
+		Active:     sub.Active,
+		CreatedAt:  sub.CreatedAt,
+		UpdatedAt:  sub.UpdatedAt,
+	}
+}
+
+// WebhookDeliveryResponse is a delivery of an event to a subscription
+type WebhookDeliveryResponse struct {
+	ID             int64        `json:"id"`
+	Status         string       `json:"status"`
+	Attempts       int32        `json:"attempts"`
+	NextAttemptAt  *time.Time   `json:"next_attempt_at,omitempty"`
+	ResponseStatus *int32       `json:"response_status,omitempty"`
+	Error          *string      `json:"error,omitempty"`
+	CreatedAt      time.Time    `json:"created_at"`
+	FinishedAt     *time.Time   `json:"finished_at,omitempty"`
+	Event          EventSummary `json:"event"`
+}
+
+// EventSummary is the event a delivery carries
+type EventSummary struct {
+	ID   int64  `json:"id"`
+	Type string `json:"type"`
+	events.Event
+}
+
+func webhookDeliveryResponse(d authsqlc.ListWebhookDeliveriesRow) WebhookDeliveryResponse {
+	e := events.Event{
+		Domain:     d.Domain,
+		Entity:     d.Entity,
+		Operation:  d.Operation,
+		AthleteIDs: d.AthleteIds,
+		Records:    int(d.Records),
+	}
+	resp := WebhookDeliveryResponse{
+		ID:             d.ID,
+		Status:         d.Status,
+		Attempts:       d.Attempts,
+		ResponseStatus: utils.Int32PtrOrNil(d.ResponseStatus),
+		Error:          utils.StringPtrOrNil(d.Error),
+		CreatedAt:      d.CreatedAt,
+		FinishedAt:     utils.TimePtrOrNil(d.FinishedAt),
+		Event:          EventSummary{ID: d.EventID, Type: e.Type(), Event: e},
+	}
+	if d.Status == auth.DeliveryPending {
+		resp.NextAttemptAt = &d.NextAttemptAt
+	}
+	return resp
+}
+
+// CreateWebhook godoc
+//
+//	@Summary		Subscribe a webhook
+//	@Description	Registers a URL that receives change events as signed POST requests. Events can be filtered by domain, entity, operation and athlete ID; an empty filter matches everything, and no domains means every domain the client can read. The signing secret is only returned in this response and when it is rotated. Each request carries `X-Kuha-Signature: t=<unix seconds>,v1=<hex HMAC-SHA256 of "<t>.<body>">`; failed deliveries are retried with exponential backoff before moving to the dead letters.
+//	@Tags			Webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Param			webhook	body		swagger.WebhookInput	true	"Webhook subscription"
+//	@Success		201		{object}	swagger.WebhookEnvelope
+//	@Header			201		{string}	Location	"URL of the subscription"
+//	@Failure		400		{object}	swagger.ValidationErrorResponse
+//	@Failure		401		{object}	swagger.UnauthorizedResponse
+//	@Failure		403		{object}	swagger.ForbiddenResponse
+//	@Failure		500		{object}	swagger.InternalServerErrorResponse
+//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/webhooks [post]
+func (h *WebhooksHandler) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	input, domains, ok := h.readInput(w, r)
+	if !ok {
+		return
+	}
+
+	secret, err := webhooks.NewSecret()
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+
+	sub, err := h.store.CreateSubscription(r.Context(), authsqlc.CreateWebhookSubscriptionParams{
+		ClientName: authn.GetClientName(r.Context()),
+		Url:        input.URL,
+		Secret:     secret,
+		Domains:    domains,
+		Entities:   orEmpty(input.Entities),
+		Operations: orEmpty(input.Operations),
+		AthleteIds: orEmpty(input.AthleteIDs),
+		Active:     input.Active == nil || *input.Active,
+	})
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+
+	resp := webhookResponse(sub)
+	resp.Secret = sub.Secret
+	w.Header().Set("Location", fmt.Sprintf("/v1/webhooks/%s", sub.ID))
+	utils.WriteJSON(w, http.StatusCreated, map[string]any{"webhook": resp})
+}
+
+// ListWebhooks godoc
+//
+//	@Summary		List webhooks
+//	@Description	Lists the webhook subscriptions of the calling client, newest first
+//	@Tags			Webhooks
+//	@Produce		json
+//	@Param			limit	query		int		false	"Page size (default 100, max 1000)"
+//	@Param			cursor	query		string	false	"Cursor from the previous page"
+//	@Success		200		{object}	swagger.WebhookListResponse
+//	@Failure		400		{object}	swagger.ValidationErrorResponse
+//	@Failure		401		{object}	swagger.UnauthorizedResponse
+//	@Failure		500		{object}	swagger.InternalServerErrorResponse
+//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/webhooks [get]
+func (h *WebhooksHandler) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	if err := utils.ValidateParams(r, []string{"limit", "cursor"}); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	page, err := utils.ParsePage(r, utils.DefaultPageLimits, utils.CursorTime|utils.CursorID)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	subs, err := h.store.ListSubscriptions(r.Context(), authn.GetClientName(r.Context()), page)
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+
+	subs, pageInfo := utils.NextPage(subs, page, func(s authsqlc.WebhookSubscription) utils.Cursor {
+		return utils.TimeCursor(s.CreatedAt, s.ID)
+	})
+	utils.SetNextLink(w, r, pageInfo.NextCursor)
+
+	resp := make([]WebhookResponse, len(subs))
+	for i, sub := range subs {
+		resp[i] = webhookResponse(sub)
+	}
+	utils.WriteJSON(w, http.StatusOK, map[string]any{"webhooks": resp, "pagination": pageInfo})
+}
+
+// GetWebhook godoc
+//
+//	@Summary		Get a webhook
+//	@Description	Returns a webhook subscription of the calling client
+//	@Tags			Webhooks
+//	@Produce		json
+//	@Param			id	path		string	true	"Webhook ID (UUID)"
+//	@Success		200	{object}	swagger.WebhookEnvelope
+//	@Failure		400	{object}	swagger.ValidationErrorResponse
+//	@Failure		401	{object}	swagger.UnauthorizedResponse
+//	@Failure		404	{object}	swagger.NotFoundResponse
+//	@Failure		500	{object}	swagger.InternalServerErrorResponse
+//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/webhooks/{id} [get]
+func (h *WebhooksHandler) GetWebhook(w http.ResponseWriter, r *http.Request) {
+	sub, ok := h.loadSubscription(w, r)
+	if !ok {
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, map[string]any{"webhook": webhookResponse(sub)})
+}
+
+// UpdateWebhook godoc
+//
+//	@Summary		Update a webhook
+//	@Description	Replaces the URL, filters and state of a webhook subscription. Deliveries queued while a subscription is inactive are sent once it is active again.
+//	@Tags			Webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string					true	"Webhook ID (UUID)"
+//	@Param			webhook	body		swagger.WebhookInput	true	"Webhook subscription"
+//	@Success		200		{object}	swagger.WebhookEnvelope
+//	@Failure		400		{object}	swagger.ValidationErrorResponse
+//	@Failure		401		{object}	swagger.UnauthorizedResponse
+//	@Failure		403		{object}	swagger.ForbiddenResponse
+//	@Failure		404		{object}	swagger.NotFoundResponse
+//	@Failure		500		{object}	swagger.InternalServerErrorResponse
+//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/webhooks/{id} [put]
+func (h *WebhooksHandler) UpdateWebhook(w http.ResponseWriter, r *http.Request) {
+	id, err := utils.ParseUUID(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	input, domains, ok := h.readInput(w, r)
+	if !ok {
+		return
+	}
+
+	sub, err := h.store.UpdateSubscription(r.Context(), authsqlc.UpdateWebhookSubscriptionParams{
+		ID:         id,
+		ClientName: authn.GetClientName(r.Context()),
+		Url:        input.URL,
+		Domains:    domains,
+		Entities:   orEmpty(input.Entities),
+		Operations: orEmpty(input.Operations),
+		AthleteIds: orEmpty(input.AthleteIDs),
+		Active:     input.Active == nil || *input.Active,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		utils.NotFoundResponse(w, r, err)
+		return
+	}
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+
+	// deliveries held back while the subscription was inactive
+	if sub.Active {
+		h.dispatcher.Notify()
+	}
+	utils.WriteJSON(w, http.StatusOK, map[string]any{"webhook": webhookResponse(sub)})
+}
+
+// DeleteWebhook godoc
+//
+//	@Summary		Delete a webhook
+//	@Description	Deletes a webhook subscription with its queued and past deliveries
+//	@Tags			Webhooks
+//	@Param			id	path	string	true	"Webhook ID (UUID)"
+//	@Success		200	"Webhook deleted"
+//	@Failure		400	{object}	swagger.ValidationErrorResponse
+//	@Failure		401	{object}	swagger.UnauthorizedResponse
+//	@Failure		404	{object}	swagger.NotFoundResponse
+//	@Failure		500	{object}	swagger.InternalServerErrorResponse
+//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/webhooks/{id} [delete]
+func (h *WebhooksHandler) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	id, err := utils.ParseUUID(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	n, err := h.store.DeleteSubscription(r.Context(), id, authn.GetClientName(r.Context()))
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+	if n == 0 {
+		utils.NotFoundResponse(w, r, fmt.Errorf("webhook not found"))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// RotateWebhookSecret godoc
+//
+//	@Summary		Rotate a webhook secret
+//	@Description	Replaces the signing secret of a webhook subscription and returns the new one. Deliveries sent from now on are signed with the new secret.
+//	@Tags			Webhooks
+//	@Produce		json
+//	@Param			id	path		string	true	"Webhook ID (UUID)"
+//	@Success		200	{object}	swagger.WebhookEnvelope
+//	@Failure		400	{object}	swagger.ValidationErrorResponse
+//	@Failure		401	{object}	swagger.UnauthorizedResponse
+//	@Failure		404	{object}	swagger.NotFoundResponse
+//	@Failure		500	{object}	swagger.InternalServerErrorResponse
+//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/webhooks/{id}/rotate-secret [post]
+func (h *WebhooksHandler) RotateWebhookSecret(w http.ResponseWriter, r *http.Request) {
+	id, err := utils.ParseUUID(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	secret, err := webhooks.NewSecret()
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+
+	sub, err := h.store.RotateSecret(r.Context(), id, authn.GetClientName(r.Context()), secret)
+	if errors.Is(err, sql.ErrNoRows) {
+		utils.NotFoundResponse(w, r, err)
+		return
+	}
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+
+	resp := webhookResponse(sub)
+	resp.Secret = sub.Secret
+	utils.WriteJSON(w, http.StatusOK, map[string]any{"webhook": resp})
+}
+
+// ListWebhookDeliveries godoc
+//
+//	@Summary		List webhook deliveries
+//	@Description	Lists the deliveries of a webhook subscription, newest first. `status=dead` lists the dead letters: deliveries that ran out of attempts and wait for a redelivery.
+//	@Tags			Webhooks
+//	@Produce		json
+//	@Param			id		path		string	true	"Webhook ID (UUID)"
+//	@Param			status	query		string	false	"Delivery status"	Enums(pending, succeeded, dead)
+//	@Param			limit	query		int		false	"Page size (default 100, max 1000)"
+//	@Param			cursor	query		string	false	"Cursor from the previous page"
+//	@Success		200		{object}	swagger.WebhookDeliveryListResponse
+//	@Failure		400		{object}	swagger.ValidationErrorResponse
+//	@Failure		401		{object}	swagger.UnauthorizedResponse
+//	@Failure		404		{object}	swagger.NotFoundResponse
+//	@Failure		500		{object}	swagger.InternalServerErrorResponse
+//	@Failure		503		{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/webhooks/{id}/deliveries [get]
+func (h *WebhooksHandler) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+	if err := utils.ValidateParams(r, []string{"status", "limit", "cursor"}); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	status := r.URL.Query().Get("status")
+	if status != "" && !slices.Contains([]string{auth.DeliveryPending, auth.DeliverySucceeded, auth.DeliveryDead}, status) {
+		utils.BadRequestResponse(w, r, utils.ErrInvalidChoice)
+		return
+	}
+
+	page, err := utils.ParsePage(r, utils.DefaultPageLimits, utils.CursorN)
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return
+	}
+
+	sub, ok := h.loadSubscription(w, r)
+	if !ok {
+		return
+	}
+
+	deliveries, err := h.store.ListDeliveries(r.Context(), sub.ID, status, page)
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+
+	deliveries, pageInfo := utils.NextPage(deliveries, page, func(d authsqlc.ListWebhookDeliveriesRow) utils.Cursor {
+		return utils.IntCursor(d.ID)
+	})
+	utils.SetNextLink(w, r, pageInfo.NextCursor)
+
+	resp := make([]WebhookDeliveryResponse, len(deliveries))
+	for i, d := range deliveries {
+		resp[i] = webhookDeliveryResponse(d)
+	}
+	utils.WriteJSON(w, http.StatusOK, map[string]any{"deliveries": resp, "pagination": pageInfo})
+}
+
+// RedeliverWebhookDelivery godoc
+//
+//	@Summary		Redeliver a dead delivery
+//	@Description	Queues a dead delivery again with a fresh set of attempts
+//	@Tags			Webhooks
+//	@Param			id			path	string	true	"Webhook ID (UUID)"
+//	@Param			delivery_id	path	int		true	"Delivery ID"
+//	@Success		202			"Delivery queued"
+//	@Failure		400			{object}	swagger.ValidationErrorResponse
+//	@Failure		401			{object}	swagger.UnauthorizedResponse
+//	@Failure		404			{object}	swagger.NotFoundResponse
+//	@Failure		409			{object}	swagger.ConflictResponse
+//	@Failure		500			{object}	swagger.InternalServerErrorResponse
+//	@Failure		503			{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/webhooks/{id}/deliveries/{delivery_id}/redeliver [post]
+func (h *WebhooksHandler) RedeliverWebhookDelivery(w http.ResponseWriter, r *http.Request) {
+	deliveryID, err := strconv.ParseInt(chi.URLParam(r, "delivery_id"), 10, 64)
+	if err != nil || deliveryID <= 0 {
+		utils.BadRequestResponse(w, r, utils.ErrInvalidParameter)
+		return
+	}
+
+	sub, ok := h.loadSubscription(w, r)
+	if !ok {
+		return
+	}
+
+	n, err := h.store.Redeliver(r.Context(), deliveryID, sub.ID)
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+	if n == 0 {
+		utils.ConflictResponse(w, r, errDeliveryNotDead)
+		return
+	}
+
+	h.dispatcher.Notify()
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// RedeliverDeadWebhookDeliveries godoc
+//
+//	@Summary		Redeliver all dead deliveries
+//	@Description	Queues every dead delivery of a webhook subscription again with a fresh set of attempts
+//	@Tags			Webhooks
+//	@Produce		json
+//	@Param			id	path		string	true	"Webhook ID (UUID)"
+//	@Success		202	{object}	swagger.WebhookRedeliverResponse
+//	@Failure		400	{object}	swagger.ValidationErrorResponse
+//	@Failure		401	{object}	swagger.UnauthorizedResponse
+//	@Failure		404	{object}	swagger.NotFoundResponse
+//	@Failure		500	{object}	swagger.InternalServerErrorResponse
+//	@Failure		503	{object}	swagger.ServiceUnavailableResponse
+//	@Security		BearerAuth
+//	@Router			/webhooks/{id}/redeliver-dead [post]
+func (h *WebhooksHandler) RedeliverDeadWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+	sub, ok := h.loadSubscription(w, r)
+	if !ok {
+		return
+	}
+
+	n, err := h.store.RedeliverDead(r.Context(), sub.ID)
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return
+	}
+
+	if n > 0 {
+		h.dispatcher.Notify()
+	}
+	utils.WriteJSON(w, http.StatusAccepted, map[string]any{"requeued": n})
+}
+
+// readInput reads and validates a subscription, answering 400 or 403 if it
+// is invalid or follows a domain the client cannot read. It returns the
+// domains to store: those requested, or all readable ones.
+func (h *WebhooksHandler) readInput(w http.ResponseWriter, r *http.Request) (WebhookInput, []string, bool) {
+	var input WebhookInput
+	if err := utils.ReadJSON(w, r, &input); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return input, nil, false
+	}
+	if err := utils.GetValidator().Struct(input); err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return input, nil, false
+	}
+
+	u, err := url.Parse(input.URL)
+	if err != nil || !u.IsAbs() || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		utils.BadRequestResponse(w, r, errWebhookURL)
+		return input, nil, false
+	}
+	if u.Scheme != "https" && !h.allowHTTP {
+		utils.BadRequestResponse(w, r, errWebhookHTTPS)
+		return input, nil, false
+	}
+	// the dispatcher checks the address again when it connects
+	if !h.allowPrivate {
+		if err := webhooks.CheckHost(r.Context(), u.Hostname()); errors.Is(err, webhooks.ErrPrivateAddress) {
+			utils.BadRequestResponse(w, r, err)
+			return input, nil, false
+		} else if err != nil {
+			utils.BadRequestResponse(w, r, errWebhookHost)
+			return input, nil, false
+		}
+	}
+
+	var domains []string
+	if len(input.Domains) == 0 {
+		for _, d := range events.Domains {
+			if authz.Allowed(r.Context(), http.MethodGet, "/v1/"+d) {
+				domains = append(domains, d)
+			}
+		}
+		if len(domains) == 0 {
+			utils.ForbiddenResponse(w, r, errNoDomains)
+			return input, nil, false
+		}
+		return input, domains, true
+	}
+
+	for _, d := range input.Domains {
+		if !authz.Allowed(r.Context(), http.MethodGet, "/v1/"+d) {
+			utils.ForbiddenResponse(w, r, fmt.Errorf("access denied to domain %s", d))
+			return input, nil, false
+		}
+	}
+	domains = slices.Clone(input.Domains)
+	slices.Sort(domains)
+	return input, slices.Compact(domains), true
+}
+
+// loadSubscription reads the subscription in the id path parameter,
+// answering 400 or 404 if it is invalid or does not belong to the client
+func (h *WebhooksHandler) loadSubscription(w http.ResponseWriter, r *http.Request) (authsqlc.WebhookSubscription, bool) {
+	id, err := utils.ParseUUID(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.BadRequestResponse(w, r, err)
+		return authsqlc.WebhookSubscription{}, false
+	}
+
+	sub, err := h.store.GetSubscription(r.Context(), id, authn.GetClientName(r.Context()))
+	if errors.Is(err, sql.ErrNoRows) {
+		utils.NotFoundResponse(w, r, err)
+		return sub, false
+	}
+	if err != nil {
+		utils.InternalServerError(w, r, err)
+		return sub, false
+	}
+	return sub, true
+}
+
+// orEmpty turns a missing filter into an empty one, which matches
+// everything
+func orEmpty(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
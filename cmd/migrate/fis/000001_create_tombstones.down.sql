@@ -0,0 +1,19 @@
+DROP INDEX IF EXISTS public.a_resultnk_lastupdate_idx;
+DROP INDEX IF EXISTS public.a_resultjp_lastupdate_idx;
+DROP INDEX IF EXISTS public.a_resultcc_lastupdate_idx;
+DROP INDEX IF EXISTS public.a_racenk_lastupdate_idx;
+DROP INDEX IF EXISTS public.a_racejp_lastupdate_idx;
+DROP INDEX IF EXISTS public.a_racecc_lastupdate_idx;
+DROP INDEX IF EXISTS public.a_competitor_lastupdate_idx;
+
+DROP TRIGGER IF EXISTS a_resultnk_tombstone ON public.a_resultnk;
+DROP TRIGGER IF EXISTS a_resultjp_tombstone ON public.a_resultjp;
+DROP TRIGGER IF EXISTS a_resultcc_tombstone ON public.a_resultcc;
+DROP TRIGGER IF EXISTS a_racenk_tombstone ON public.a_racenk;
+DROP TRIGGER IF EXISTS a_racejp_tombstone ON public.a_racejp;
+DROP TRIGGER IF EXISTS a_racecc_tombstone ON public.a_racecc;
+DROP TRIGGER IF EXISTS a_competitor_tombstone ON public.a_competitor;
+
+DROP FUNCTION IF EXISTS public.fis_record_tombstone();
+
+DROP TABLE IF EXISTS public.fis_tombstones;
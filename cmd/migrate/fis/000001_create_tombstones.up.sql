@@ -0,0 +1,45 @@
+CREATE TABLE IF NOT EXISTS public.fis_tombstones (
+    entity character varying(16) NOT NULL,
+    id integer NOT NULL,
+    deleted_at timestamp without time zone NOT NULL DEFAULT (now() AT TIME ZONE 'UTC'),
+    PRIMARY KEY (entity, id)
+);
+
+CREATE INDEX IF NOT EXISTS fis_tombstones_entity_deleted_at_idx
+    ON public.fis_tombstones (entity, deleted_at, id);
+
+-- Records the key of a deleted row. The entity and the key column are
+-- passed as trigger arguments. Deleting a row again refreshes deleted_at.
+CREATE OR REPLACE FUNCTION public.fis_record_tombstone() RETURNS trigger
+LANGUAGE plpgsql AS $$
+BEGIN
+    INSERT INTO public.fis_tombstones (entity, id)
+    VALUES (TG_ARGV[0], (to_jsonb(OLD) ->> TG_ARGV[1])::integer)
+    ON CONFLICT (entity, id) DO UPDATE SET deleted_at = EXCLUDED.deleted_at;
+    RETURN OLD;
+END;
+$$;
+
+CREATE TRIGGER a_competitor_tombstone AFTER DELETE ON public.a_competitor
+    FOR EACH ROW EXECUTE FUNCTION public.fis_record_tombstone('competitor', 'competitorid');
+CREATE TRIGGER a_racecc_tombstone AFTER DELETE ON public.a_racecc
+    FOR EACH ROW EXECUTE FUNCTION public.fis_record_tombstone('racecc', 'raceid');
+CREATE TRIGGER a_racejp_tombstone AFTER DELETE ON public.a_racejp
+    FOR EACH ROW EXECUTE FUNCTION public.fis_record_tombstone('racejp', 'raceid');
+CREATE TRIGGER a_racenk_tombstone AFTER DELETE ON public.a_racenk
+    FOR EACH ROW EXECUTE FUNCTION public.fis_record_tombstone('racenk', 'raceid');
+CREATE TRIGGER a_resultcc_tombstone AFTER DELETE ON public.a_resultcc
+    FOR EACH ROW EXECUTE FUNCTION public.fis_record_tombstone('resultcc', 'recid');
+CREATE TRIGGER a_resultjp_tombstone AFTER DELETE ON public.a_resultjp
+    FOR EACH ROW EXECUTE FUNCTION public.fis_record_tombstone('resultjp', 'recid');
+CREATE TRIGGER a_resultnk_tombstone AFTER DELETE ON public.a_resultnk
+    FOR EACH ROW EXECUTE FUNCTION public.fis_record_tombstone('resultnk', 'recid');
+
+-- keyset pagination of the changes by (lastupdate, key)
+CREATE INDEX IF NOT EXISTS a_competitor_lastupdate_idx ON public.a_competitor (lastupdate, competitorid);
+CREATE INDEX IF NOT EXISTS a_racecc_lastupdate_idx ON public.a_racecc (lastupdate, raceid);
+CREATE INDEX IF NOT EXISTS a_racejp_lastupdate_idx ON public.a_racejp (lastupdate, raceid);
+CREATE INDEX IF NOT EXISTS a_racenk_lastupdate_idx ON public.a_racenk (lastupdate, raceid);
+CREATE INDEX IF NOT EXISTS a_resultcc_lastupdate_idx ON public.a_resultcc (lastupdate, recid);
+CREATE INDEX IF NOT EXISTS a_resultjp_lastupdate_idx ON public.a_resultjp (lastupdate, recid);
+CREATE INDEX IF NOT EXISTS a_resultnk_lastupdate_idx ON public.a_resultnk (lastupdate, recid);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS public.a_competitor_fiscode_prefix_idx;
+DROP INDEX IF EXISTS public.a_competitor_skiclub_trgm_idx;
+DROP INDEX IF EXISTS public.a_competitor_name_trgm_idx;
+
+DROP FUNCTION IF EXISTS public.fis_unaccent(text);
+
+-- the pg_trgm and unaccent extensions are left installed
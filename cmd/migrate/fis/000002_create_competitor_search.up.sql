@@ -0,0 +1,21 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm WITH SCHEMA public;
+CREATE EXTENSION IF NOT EXISTS unaccent WITH SCHEMA public;
+
+-- unaccent() is only STABLE because its dictionary can be changed; pinning
+-- the dictionary makes it usable in index expressions.
+CREATE OR REPLACE FUNCTION public.fis_unaccent(text) RETURNS text
+LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT AS $$
+    SELECT public.unaccent('public.unaccent'::regdictionary, $1)
+$$;
+
+-- trigram matching of the competitor search; the expressions must match the
+-- ones of SearchCompetitors
+CREATE INDEX IF NOT EXISTS a_competitor_name_trgm_idx ON public.a_competitor
+    USING gin (public.fis_unaccent(COALESCE(firstname, '') || ' ' || COALESCE(lastname, '')) public.gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS a_competitor_skiclub_trgm_idx ON public.a_competitor
+    USING gin (public.fis_unaccent(COALESCE(skiclub, '')) public.gin_trgm_ops);
+
+-- fiscode prefix search, as a range of the text fiscode; the expression must
+-- match the one of SearchCompetitors
+CREATE INDEX IF NOT EXISTS a_competitor_fiscode_prefix_idx ON public.a_competitor
+    ((COALESCE(fiscode::text, '')) text_pattern_ops);
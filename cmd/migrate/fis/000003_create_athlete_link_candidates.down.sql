@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS public.fis_athlete_link_candidates_sporttiid_idx;
+DROP INDEX IF EXISTS public.fis_athlete_link_candidates_status_idx;
+
+DROP TABLE IF EXISTS public.fis_athlete_link_candidates;
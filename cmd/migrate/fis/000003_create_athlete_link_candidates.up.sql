@@ -0,0 +1,24 @@
+-- Proposed fiscode <-> sportti_id links found by the athlete matching job.
+-- Approving a candidate inserts it into athlete; evidence holds the
+-- identities it was matched with, per source.
+CREATE TABLE IF NOT EXISTS public.fis_athlete_link_candidates (
+    id serial PRIMARY KEY,
+    fiscode integer NOT NULL,
+    sporttiid integer NOT NULL,
+    confidence numeric(4,3) NOT NULL,
+    sources text[] NOT NULL,
+    evidence jsonb NOT NULL,
+    status character varying(16) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'approved', 'rejected')),
+    created_at timestamp without time zone NOT NULL DEFAULT (now() AT TIME ZONE 'UTC'),
+    updated_at timestamp without time zone NOT NULL DEFAULT (now() AT TIME ZONE 'UTC'),
+    reviewed_by character varying(255),
+    reviewed_at timestamp without time zone,
+    UNIQUE (fiscode, sporttiid)
+);
+
+-- review queue, best candidates first
+CREATE INDEX IF NOT EXISTS fis_athlete_link_candidates_status_idx
+    ON public.fis_athlete_link_candidates (status, confidence DESC, id);
+CREATE INDEX IF NOT EXISTS fis_athlete_link_candidates_sporttiid_idx
+    ON public.fis_athlete_link_candidates (sporttiid);
@@ -0,0 +1,11 @@
+DROP TRIGGER IF EXISTS a_resultnk_write ON public.a_resultnk;
+DROP TRIGGER IF EXISTS a_resultjp_write ON public.a_resultjp;
+DROP TRIGGER IF EXISTS a_resultcc_write ON public.a_resultcc;
+DROP TRIGGER IF EXISTS a_racenk_write ON public.a_racenk;
+DROP TRIGGER IF EXISTS a_racejp_write ON public.a_racejp;
+DROP TRIGGER IF EXISTS a_racecc_write ON public.a_racecc;
+DROP TRIGGER IF EXISTS a_competitor_write ON public.a_competitor;
+
+DROP FUNCTION IF EXISTS public.fis_record_write();
+
+DROP TABLE IF EXISTS public.fis_writes;
@@ -0,0 +1,62 @@
+CREATE TABLE IF NOT EXISTS public.fis_writes (
+    entity character varying(16) NOT NULL,
+    id integer NOT NULL,
+    written_at timestamp without time zone NOT NULL DEFAULT (now() AT TIME ZONE 'UTC'),
+    PRIMARY KEY (entity, id)
+);
+
+CREATE INDEX IF NOT EXISTS fis_writes_entity_written_at_idx
+    ON public.fis_writes (entity, written_at, id);
+
+-- Records when a row was last inserted or updated, on the same clock as
+-- fis_tombstones.deleted_at. lastupdate comes from the loader and cannot
+-- be compared with the deletion times.
+CREATE OR REPLACE FUNCTION public.fis_record_write() RETURNS trigger
+LANGUAGE plpgsql AS $$
+BEGIN
+    INSERT INTO public.fis_writes (entity, id)
+    VALUES (TG_ARGV[0], (to_jsonb(NEW) ->> TG_ARGV[1])::integer)
+    ON CONFLICT (entity, id) DO UPDATE SET written_at = EXCLUDED.written_at;
+    RETURN NEW;
+END;
+$$;
+
+CREATE TRIGGER a_competitor_write AFTER INSERT OR UPDATE ON public.a_competitor
+    FOR EACH ROW EXECUTE FUNCTION public.fis_record_write('competitor', 'competitorid');
+CREATE TRIGGER a_racecc_write AFTER INSERT OR UPDATE ON public.a_racecc
+    FOR EACH ROW EXECUTE FUNCTION public.fis_record_write('racecc', 'raceid');
+CREATE TRIGGER a_racejp_write AFTER INSERT OR UPDATE ON public.a_racejp
+    FOR EACH ROW EXECUTE FUNCTION public.fis_record_write('racejp', 'raceid');
+CREATE TRIGGER a_racenk_write AFTER INSERT OR UPDATE ON public.a_racenk
+    FOR EACH ROW EXECUTE FUNCTION public.fis_record_write('racenk', 'raceid');
+CREATE TRIGGER a_resultcc_write AFTER INSERT OR UPDATE ON public.a_resultcc
+    FOR EACH ROW EXECUTE FUNCTION public.fis_record_write('resultcc', 'recid');
+CREATE TRIGGER a_resultjp_write AFTER INSERT OR UPDATE ON public.a_resultjp
+    FOR EACH ROW EXECUTE FUNCTION public.fis_record_write('resultjp', 'recid');
+CREATE TRIGGER a_resultnk_write AFTER INSERT OR UPDATE ON public.a_resultnk
+    FOR EACH ROW EXECUTE FUNCTION public.fis_record_write('resultnk', 'recid');
+
+-- The rows already stored are dated by their lastupdate, capped at now, so
+-- that mirrors following the changes by lastupdate are not sent every row
+-- again. Rows without a lastupdate date from the epoch.
+INSERT INTO public.fis_writes (entity, id, written_at)
+    SELECT 'competitor', competitorid, COALESCE(LEAST(lastupdate, now() AT TIME ZONE 'UTC'), 'epoch') FROM public.a_competitor
+    ON CONFLICT DO NOTHING;
+INSERT INTO public.fis_writes (entity, id, written_at)
+    SELECT 'racecc', raceid, COALESCE(LEAST(lastupdate, now() AT TIME ZONE 'UTC'), 'epoch') FROM public.a_racecc
+    ON CONFLICT DO NOTHING;
+INSERT INTO public.fis_writes (entity, id, written_at)
+    SELECT 'racejp', raceid, COALESCE(LEAST(lastupdate, now() AT TIME ZONE 'UTC'), 'epoch') FROM public.a_racejp
+    ON CONFLICT DO NOTHING;
+INSERT INTO public.fis_writes (entity, id, written_at)
+    SELECT 'racenk', raceid, COALESCE(LEAST(lastupdate, now() AT TIME ZONE 'UTC'), 'epoch') FROM public.a_racenk
+    ON CONFLICT DO NOTHING;
+INSERT INTO public.fis_writes (entity, id, written_at)
+    SELECT 'resultcc', recid, COALESCE(LEAST(lastupdate, now() AT TIME ZONE 'UTC'), 'epoch') FROM public.a_resultcc
+    ON CONFLICT DO NOTHING;
+INSERT INTO public.fis_writes (entity, id, written_at)
+    SELECT 'resultjp', recid, COALESCE(LEAST(lastupdate, now() AT TIME ZONE 'UTC'), 'epoch') FROM public.a_resultjp
+    ON CONFLICT DO NOTHING;
+INSERT INTO public.fis_writes (entity, id, written_at)
+    SELECT 'resultnk', recid, COALESCE(LEAST(lastupdate, now() AT TIME ZONE 'UTC'), 'epoch') FROM public.a_resultnk
+    ON CONFLICT DO NOTHING;
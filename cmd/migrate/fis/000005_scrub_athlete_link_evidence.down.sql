@@ -0,0 +1,2 @@
+-- The identities removed by the up migration cannot be restored.
+SELECT 1;
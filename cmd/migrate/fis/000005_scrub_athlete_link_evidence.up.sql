@@ -0,0 +1,5 @@
+-- The evidence of a candidate used to copy the competitor and the
+-- identities of the other systems; keep only how the fields matched.
+UPDATE public.fis_athlete_link_candidates
+SET evidence = evidence - 'competitor' - 'identities'
+WHERE evidence ? 'competitor' OR evidence ? 'identities';
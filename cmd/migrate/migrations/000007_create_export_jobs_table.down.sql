@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS export_job_chunks;
+DROP TABLE IF EXISTS export_jobs;
@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS export_jobs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    client_name TEXT NOT NULL,
+    kind TEXT NOT NULL,
+    format TEXT NOT NULL,
+    params JSONB NOT NULL,
+    status TEXT NOT NULL DEFAULT 'queued'
+        CHECK (status IN ('queued', 'running', 'succeeded', 'failed', 'canceled')),
+    error TEXT,
+    rows_written BIGINT NOT NULL DEFAULT 0,
+    size_bytes BIGINT NOT NULL DEFAULT 0,
+    chunk_size INT NOT NULL,
+    worker TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    started_at TIMESTAMPTZ,
+    heartbeat_at TIMESTAMPTZ,
+    finished_at TIMESTAMPTZ,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS export_jobs_queue_idx ON export_jobs (created_at) WHERE status = 'queued';
+CREATE INDEX IF NOT EXISTS export_jobs_client_idx ON export_jobs (client_name, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS export_jobs_expires_idx ON export_jobs (expires_at);
+
+CREATE TABLE IF NOT EXISTS export_job_chunks (
+    job_id UUID NOT NULL REFERENCES export_jobs(id) ON DELETE CASCADE,
+    seq INT NOT NULL,
+    data BYTEA NOT NULL,
+    PRIMARY KEY (job_id, seq)
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS ingest_job_payloads;
+DROP TABLE IF EXISTS ingest_jobs;
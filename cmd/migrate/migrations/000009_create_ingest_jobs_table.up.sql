@@ -0,0 +1,32 @@
+CREATE TABLE IF NOT EXISTS ingest_jobs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    client_name TEXT NOT NULL,
+    roles TEXT[] NOT NULL,
+    kind TEXT NOT NULL,
+    path TEXT NOT NULL,
+    query TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL DEFAULT 'queued'
+        CHECK (status IN ('queued', 'running', 'succeeded', 'failed')),
+    error TEXT,
+    records_total INT NOT NULL,
+    records_done INT NOT NULL DEFAULT 0,
+    records_failed INT NOT NULL DEFAULT 0,
+    record_errors JSONB NOT NULL DEFAULT '[]',
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    worker TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    started_at TIMESTAMPTZ,
+    heartbeat_at TIMESTAMPTZ,
+    finished_at TIMESTAMPTZ,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS ingest_jobs_queue_idx ON ingest_jobs (next_attempt_at) WHERE status = 'queued';
+CREATE INDEX IF NOT EXISTS ingest_jobs_client_idx ON ingest_jobs (client_name, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS ingest_jobs_expires_idx ON ingest_jobs (expires_at);
+
+CREATE TABLE IF NOT EXISTS ingest_job_payloads (
+    job_id UUID PRIMARY KEY REFERENCES ingest_jobs(id) ON DELETE CASCADE,
+    data BYTEA NOT NULL
+);
@@ -0,0 +1,2 @@
+-- The response bodies removed by the up migration cannot be restored.
+SELECT 1;
@@ -0,0 +1,5 @@
+-- Delivery errors used to keep the first bytes of the subscriber's
+-- response; keep only "<status code> <status text>".
+UPDATE webhook_deliveries
+SET error = substring(error FROM '^[0-9]{3} [^:]*')
+WHERE error ~ '^[0-9]{3} [^:]*: ';
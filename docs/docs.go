@@ -550,37 +550,58 @@ const docTemplate = `{
                 }
             }
         },
-        "/fis/athlete": {
+        "/events/stream": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Streams the change events of the domains the client can read as Server-Sent Events, starting from now. Each event has its ID in the ` + "`" + `id` + "`" + ` field and the event as JSON in ` + "`" + `data` + "`" + `. A client reconnecting with ` + "`" + `Last-Event-ID` + "`" + ` (or ` + "`" + `last_event_id` + "`" + `) first receives the events it missed; if some are no longer available, a ` + "`" + `reset` + "`" + ` event tells it to reload its data. Lines starting with ` + "`" + `:` + "`" + ` are keep-alives. A stream ends at the read deadline of the route and the client reconnects.",
                 "produces": [
-                    "application/json"
+                    "text/event-stream"
                 ],
                 "tags": [
-                    "FIS - Athlete"
+                    "Events"
                 ],
-                "summary": "Get all athletes for a given sector",
+                "summary": "Stream change events",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Sector code (JP, NK, CC)",
-                        "name": "sectorcode",
-                        "in": "query",
-                        "required": true
+                        "description": "Comma-separated domains (default: all readable)",
+                        "name": "domains",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated athlete IDs (user UUIDs, sportti IDs or FIS codes, depending on the domain)",
+                        "name": "athlete_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "UTV group ID (UUID); follows the members of the group",
+                        "name": "group_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Resume after this event ID",
+                        "name": "last_event_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Resume after this event ID",
+                        "name": "Last-Event-ID",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Stream of events",
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISAthletesResponse"
+                            "$ref": "#/definitions/swagger.ChangeEvent"
                         }
                     },
                     "400": {
@@ -601,6 +622,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -614,38 +641,43 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/exports": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Updates an existing athlete in athlete table",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Lists the export jobs of the calling client, newest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Athlete Management"
+                    "Exports"
                 ],
-                "summary": "Update athlete by fiscode",
+                "summary": "List export jobs",
                 "parameters": [
                     {
-                        "description": "Athlete payload",
-                        "name": "athlete",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/swagger.FISUpdateAthleteExample"
-                        }
+                        "type": "integer",
+                        "description": "Page size (default 100, max 1000)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from the previous page",
+                        "name": "cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ExportJobListResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -659,18 +691,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.UnauthorizedResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.ForbiddenResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.NotFoundResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -691,7 +711,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Inserts a new athlete into athlete table",
+                "description": "Queues a bulk export that runs in the background. Kinds: ` + "`" + `fis_results` + "`" + ` (params: sector cc|jp|nk, season_from, season_to; needs FIS read access) and ` + "`" + `utv_data` + "`" + ` (params: source oura|polar|garmin|suunto, group_id and/or user_ids, optional from/to days; needs UTV read access). Poll the returned Location until the status is ` + "`" + `succeeded` + "`" + `, then download the file.",
                 "consumes": [
                     "application/json"
                 ],
@@ -699,23 +719,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Athlete Management"
+                    "Exports"
                 ],
-                "summary": "Add new athlete",
+                "summary": "Submit an export job",
                 "parameters": [
                     {
-                        "description": "Athlete payload",
-                        "name": "athlete",
+                        "description": "Export job",
+                        "name": "export",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISInsertAthleteExample"
+                            "$ref": "#/definitions/swagger.ExportJobInput"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created"
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ExportJobEnvelope"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the job"
+                            }
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -735,12 +764,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.ConflictResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -754,36 +777,38 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/exports/{id}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes an athlete by FIS code",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Returns the status and progress of an export job of the calling client",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Athlete Management"
+                    "Exports"
                 ],
-                "summary": "Delete athlete",
+                "summary": "Get an export job",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "FIS code",
-                        "name": "fiscode",
-                        "in": "query",
+                        "type": "string",
+                        "description": "Export job ID (UUID)",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Deleted"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ExportJobEnvelope"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -797,12 +822,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.UnauthorizedResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.ForbiddenResponse"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -824,28 +843,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/fis/catcodeCC": {
-            "get": {
+        "/exports/{id}/cancel": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Cancels a queued or running export job. A running export stops within a few seconds and its partial file is discarded.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Season Discipline \u0026 Category Codes"
+                    "Exports"
+                ],
+                "summary": "Cancel an export job",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Export job ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get Cross-Country category codes",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISCategoriesCCResponse"
+                            "$ref": "#/definitions/swagger.ExportJobEnvelope"
                         }
                     },
                     "400": {
@@ -860,10 +886,16 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.UnauthorizedResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ConflictResponse"
                         }
                     },
                     "500": {
@@ -881,28 +913,49 @@ const docTemplate = `{
                 }
             }
         },
-        "/fis/catcodeJP": {
+        "/exports/{id}/download": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Downloads the file of a succeeded export job. Supports ` + "`" + `Range` + "`" + ` and ` + "`" + `If-Range` + "`" + ` requests, so interrupted downloads can be resumed.",
                 "produces": [
-                    "application/json"
+                    "text/csv",
+                    "application/vnd.apache.parquet",
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "FIS - Season Discipline \u0026 Category Codes"
+                    "Exports"
+                ],
+                "summary": "Download an export file",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Export job ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Byte range, e.g. bytes=1048576-",
+                        "name": "Range",
+                        "in": "header"
+                    }
                 ],
-                "summary": "Get Ski Jumping category codes",
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Export file",
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISCategoriesJPResponse"
+                            "type": "file"
+                        }
+                    },
+                    "206": {
+                        "description": "Requested range of the export file",
+                        "schema": {
+                            "type": "file"
                         }
                     },
                     "400": {
@@ -923,6 +976,21 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ConflictResponse"
+                        }
+                    },
+                    "416": {
+                        "description": "Range not satisfiable"
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -938,7 +1006,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/fis/catcodeNK": {
+        "/fis/athlete": {
             "get": {
                 "security": [
                     {
@@ -952,14 +1020,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Season Discipline \u0026 Category Codes"
+                    "FIS - Athlete"
+                ],
+                "summary": "Get all athletes for a given sector",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sector code (JP, NK, CC)",
+                        "name": "sectorcode",
+                        "in": "query",
+                        "required": true
+                    }
                 ],
-                "summary": "Get Nordic Combined category codes",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISCategoriesNKResponse"
+                            "$ref": "#/definitions/swagger.FISAthletesResponse"
                         }
                     },
                     "400": {
@@ -993,16 +1070,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/fis/competitor": {
+            },
             "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Updates an existing competitor",
+                "description": "Updates an existing athlete in athlete table",
                 "consumes": [
                     "application/json"
                 ],
@@ -1010,17 +1085,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Competitor Management"
+                    "FIS - Athlete Management"
                 ],
-                "summary": "Update competitor by ID",
+                "summary": "Update athlete by fiscode",
                 "parameters": [
                     {
-                        "description": "Competitor payload",
-                        "name": "competitor",
+                        "description": "Athlete payload",
+                        "name": "athlete",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISUpdateCompetitorExample"
+                            "$ref": "#/definitions/swagger.FISUpdateAthleteExample"
                         }
                     }
                 ],
@@ -1072,7 +1147,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Inserts a new competitor",
+                "description": "Inserts a new athlete into athlete table",
                 "consumes": [
                     "application/json"
                 ],
@@ -1080,17 +1155,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Competitor Management"
+                    "FIS - Athlete Management"
                 ],
-                "summary": "Add new competitor",
+                "summary": "Add new athlete",
                 "parameters": [
                     {
-                        "description": "Competitor payload",
-                        "name": "competitor",
+                        "description": "Athlete payload",
+                        "name": "athlete",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISInsertCompetitorExample"
+                            "$ref": "#/definitions/swagger.FISInsertAthleteExample"
                         }
                     }
                 ],
@@ -1142,7 +1217,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes a competitor by ID",
+                "description": "Deletes an athlete by FIS code",
                 "consumes": [
                     "application/json"
                 ],
@@ -1150,14 +1225,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Competitor Management"
+                    "FIS - Athlete Management"
                 ],
-                "summary": "Delete competitor",
+                "summary": "Delete athlete",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Competitor ID",
-                        "name": "id",
+                        "description": "FIS code",
+                        "name": "fiscode",
                         "in": "query",
                         "required": true
                     }
@@ -1205,47 +1280,62 @@ const docTemplate = `{
                 }
             }
         },
-        "/fis/competitor/count-by-nation": {
+        "/fis/athlete-links": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Gets counts of competitors grouped by Nationcode.\nOptional filters: sectorcode, gender, age range (agemin/agemax, in years).",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Lists the proposed fiscode \u003c-\u003e sportti_id links, best first. evidence holds how the name, birthdate and gender matched, without the identities themselves. The athlete-links routes also need read access to K-Lab, Archinisis and Tietoevry.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - KAMK"
+                    "FIS - Athlete Linking"
                 ],
-                "summary": "Get competitor counts by nation",
+                "summary": "List athlete link candidates",
                 "parameters": [
                     {
+                        "enum": [
+                            "pending",
+                            "approved",
+                            "rejected",
+                            "all"
+                        ],
                         "type": "string",
-                        "description": "Sector code filter (CC,JP,NK)",
-                        "name": "sectorcode",
+                        "description": "Status (default: pending)",
+                        "name": "status",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Gender filter (M/W)",
-                        "name": "gender",
+                        "type": "number",
+                        "description": "Minimum confidence, 0 to 1",
+                        "name": "min_confidence",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "Minimum age in years",
-                        "name": "agemin",
+                        "description": "FIS code",
+                        "name": "fiscode",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "Maximum age in years",
-                        "name": "agemax",
+                        "description": "Sportti ID",
+                        "name": "sporttiid",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default: 100, max: 1000)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from pagination.next_cursor of the previous page",
+                        "name": "cursor",
                         "in": "query"
                     }
                 ],
@@ -1253,7 +1343,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISCompetitorNationCountsResponse"
+                            "$ref": "#/definitions/swagger.FISAthleteLinkCandidateListResponse"
                         }
                     },
                     "400": {
@@ -1289,73 +1379,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/fis/competitor/latest-results": {
-            "get": {
+        "/fis/athlete-links/match": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Gets an athlete's latest race results filtered by sector and optional season and catcodes. Results are ordered by Racedate DESC.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Matches the Finnish FIS competitors whose fiscode is not in athlete yet with the athletes known by sportti_id to K-Lab and Archinisis whose sportti_id is not in athlete either, by name and birthdate. Tietoevry has no names, so its birthdates and genders only corroborate the other sources; databases that are not connected are skipped. Candidates with a confidence of at least 0.6 are saved as pending for review, and pending candidates the run no longer finds are dropped; reviewed candidates are left as they are. Nothing is linked until a candidate is approved. The athlete-links routes also need read access to K-Lab, Archinisis and Tietoevry.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - KAMK"
-                ],
-                "summary": "Get competitor latest race results",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "FIS Code",
-                        "name": "fiscode",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Sector code (CC,JP,NK)",
-                        "name": "sector",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Season code filter",
-                        "name": "seasoncode",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Category code(s) – repeat or comma-separated (e.g. catcode=WC\u0026catcode=COC or catcode=WC,COC)",
-                        "name": "catcode",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Maximum number of results to return (default 50)",
-                        "name": "limit",
-                        "in": "query"
-                    }
+                    "FIS - Athlete Linking"
                 ],
+                "summary": "Match FIS competitors to sportti_ids",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISLatestResultsResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                            "$ref": "#/definitions/swagger.FISAthleteLinkMatchResponse"
                         }
                     },
                     "401": {
@@ -1385,61 +1428,34 @@ const docTemplate = `{
                 }
             }
         },
-        "/fis/competitor/search": {
+        "/fis/athlete-links/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Gets competitors filtered by optional Nationcode, Sectorcode, Gender and age range (in years).\nAge filters (agemin/agemax) are converted internally to a birthdate range based on today's date.",
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - KAMK"
+                    "FIS - Athlete Linking"
                 ],
-                "summary": "Search competitors",
+                "summary": "Get an athlete link candidate",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Nation code filter (e.g. FIN)",
-                        "name": "nationcode",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Sector code filter (CC,JP,NK)",
-                        "name": "sectorcode",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Gender filter (M/W)",
-                        "name": "gender",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Minimum age in years (inclusive). For example, agemin=18 means competitors who are at least 18.",
-                        "name": "agemin",
-                        "in": "query"
-                    },
                     {
                         "type": "integer",
-                        "description": "Maximum age in years (inclusive). For example, agemax=30 means competitors who are at most 30.",
-                        "name": "agemax",
-                        "in": "query"
+                        "description": "Candidate ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISCompetitorSearchResponse"
+                            "$ref": "#/definitions/swagger.FISAthleteLinkCandidateResponse"
                         }
                     },
                     "400": {
@@ -1460,6 +1476,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1475,37 +1497,27 @@ const docTemplate = `{
                 }
             }
         },
-        "/fis/competitor/seasons-catcodes": {
-            "get": {
+        "/fis/athlete-links/{id}/approve": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Gets distinct (Seasoncode, Catcode) combinations for the races a competitor has actually competed in, for a given sector (CC, JP, NK). Uses both race and result tables.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Links the fiscode to the sportti_id by inserting it into athlete, named after the FIS competitor, and rejects the other pending candidates of the fiscode and of the sportti_id. Fails with 409 if the candidate has already been reviewed or the fiscode has been linked since.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - KAMK"
+                    "FIS - Athlete Linking"
                 ],
-                "summary": "Get competitor seasons and categories",
+                "summary": "Approve an athlete link candidate",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "FIS Code",
-                        "name": "fiscode",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Sector code (CC,JP,NK)",
-                        "name": "sector",
-                        "in": "query",
+                        "description": "Candidate ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -1513,7 +1525,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISCompetitorSeasonsCatcodesResponse"
+                            "$ref": "#/definitions/swagger.FISAthleteLinkCandidateResponse"
                         }
                     },
                     "400": {
@@ -1534,6 +1546,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ConflictResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1549,30 +1573,27 @@ const docTemplate = `{
                 }
             }
         },
-        "/fis/competitor/sectorcode": {
-            "get": {
+        "/fis/athlete-links/{id}/reject": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns the sectorcode for a competitor based on fiscode.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Rejects a pending candidate. Later matching runs leave it rejected. Fails with 409 if the candidate has already been reviewed.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - KAMK"
+                    "FIS - Athlete Linking"
                 ],
-                "summary": "Get sector code by fiscode",
+                "summary": "Reject an athlete link candidate",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "FIS code",
-                        "name": "fiscode",
-                        "in": "query",
+                        "description": "Candidate ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -1580,7 +1601,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISSectorcodeByFiscodeResponse"
+                            "$ref": "#/definitions/swagger.FISAthleteLinkCandidateResponse"
                         }
                     },
                     "400": {
@@ -1601,6 +1622,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ConflictResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1616,13 +1649,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/fis/disciplinecodeCC": {
+        "/fis/athletes/{fiscode}/results": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "Returns the results of the FIS code in the sectors it competes in, e.g. a Nordic combined athlete who also has Cross-Country or Ski Jumping results, ordered by race date. competitors maps each sector the athlete was found in to the competitor ID there.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1630,14 +1664,75 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Season Discipline \u0026 Category Codes"
+                    "FIS - Sectors"
+                ],
+                "summary": "Get the results of an athlete in every sector",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "FIS Code",
+                        "name": "fiscode",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Sector codes (repeat or comma-separated; default: all)",
+                        "name": "sectors",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Season code (repeat or comma-separated)",
+                        "name": "seasoncode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Discipline code (repeat or comma-separated)",
+                        "name": "disciplinecode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Category code (repeat or comma-separated)",
+                        "name": "catcode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default: 100, max: 1000)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from pagination.next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get Cross-Country discipline codes",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISDisciplinesCCResponse"
+                            "$ref": "#/definitions/swagger.FISCrossSectorResultsResponse"
                         }
                     },
                     "400": {
@@ -1658,6 +1753,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1673,37 +1774,109 @@ const docTemplate = `{
                 }
             }
         },
-        "/fis/disciplinecodeJP": {
+        "/fis/calendar": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "Returns the races of every sector dated from..to grouped into events by eventid, in the order of their first race. Races without an eventid are events of their own. A race is cancelled when its calstatuscode is C, an event when all its races are.\nWith format=ics or an Accept header of text/calendar the calendar is returned as iCalendar, one all-day event per FIS event. Calendar applications that cannot send a bearer token subscribe with format=ics and the token of a calendar feed.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/calendar"
                 ],
                 "tags": [
-                    "FIS - Season Discipline \u0026 Category Codes"
+                    "FIS - Calendar"
                 ],
-                "summary": "Get Ski Jumping discipline codes",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.FISDisciplinesJPResponse"
-                        }
+                "summary": "Get the FIS competition calendar",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "First race date (YYYY-MM-DD, default: today)",
+                        "name": "from",
+                        "in": "query"
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
-                        }
+                    {
+                        "type": "string",
+                        "description": "Last race date (YYYY-MM-DD, default: 90 days after from, at most 400 days after it)",
+                        "name": "to",
+                        "in": "query"
                     },
-                    "401": {
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Sector code: cc, jp, nk (repeat or comma-separated)",
+                        "name": "sector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Nation code of the venue (repeat or comma-separated)",
+                        "name": "nationcode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Category code (repeat or comma-separated)",
+                        "name": "catcode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Gender (repeat or comma-separated)",
+                        "name": "gender",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "ics"
+                        ],
+                        "type": "string",
+                        "description": "Response format",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Calendar feed token, instead of the bearer token (format=ics only)",
+                        "name": "token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISCalendarResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
                         "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/swagger.UnauthorizedResponse"
@@ -1730,34 +1903,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/fis/disciplinecodeNK": {
+        "/fis/calendar/feeds": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Lists the calendar feeds of the calling client, newest first, without their tokens",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Season Discipline \u0026 Category Codes"
+                    "FIS - Calendar"
                 ],
-                "summary": "Get Nordic Combined discipline codes",
+                "summary": "List calendar feeds",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISDisciplinesNKResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                            "$ref": "#/definitions/swagger.FISCalendarFeedListResponse"
                         }
                     },
                     "401": {
@@ -1785,45 +1950,32 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/fis/fiscode": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Creates a read-only feed token for calendar applications that cannot send a bearer token. The token only reads GET /fis/calendar with format=ics, passed as the token query parameter, with the roles the client has when the feed is read. It is only returned in this response; a feed is revoked by deleting it.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Athlete"
-                ],
-                "summary": "Get all athletes for a given SporttiID",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "SporttiID",
-                        "name": "sporttiid",
-                        "in": "query",
-                        "required": true
-                    }
+                    "FIS - Calendar"
                 ],
+                "summary": "Create a calendar feed",
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.FISAthletesResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                            "$ref": "#/definitions/swagger.FISCalendarFeedEnvelope"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the feed"
+                            }
                         }
                     },
                     "401": {
@@ -1838,12 +1990,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.NotFoundResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1859,29 +2005,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/fis/lastrow/competitor": {
-            "get": {
+        "/fis/calendar/feeds/{id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns the last row in the competitor table",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Deletes a calendar feed of the calling client; its token stops working at once",
                 "tags": [
-                    "FIS - Competitor Management"
+                    "FIS - Calendar"
+                ],
+                "summary": "Revoke a calendar feed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Feed ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get last competitor record",
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Feed revoked"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISLastCompetitorResponse"
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
                         }
                     },
                     "401": {
@@ -1917,14 +2069,13 @@ const docTemplate = `{
                 }
             }
         },
-        "/fis/lastrow/racecc": {
+        "/fis/catcodeCC": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns the last row in a_racecc (by RaceID DESC)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1932,14 +2083,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Race Management – Cross-Country"
+                    "FIS - Season Discipline \u0026 Category Codes"
                 ],
-                "summary": "Get last Cross-Country race record",
+                "summary": "Get Cross-Country category codes",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISLastRaceCCResponse"
+                            "$ref": "#/definitions/swagger.FISCategoriesCCResponse"
                         }
                     },
                     "400": {
@@ -1960,12 +2111,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.NotFoundResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1981,14 +2126,13 @@ const docTemplate = `{
                 }
             }
         },
-        "/fis/lastrow/racejp": {
+        "/fis/catcodeJP": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns the last row in a_racejp (by RaceID DESC)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1996,14 +2140,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Race Management – Ski Jumping"
+                    "FIS - Season Discipline \u0026 Category Codes"
                 ],
-                "summary": "Get last Ski Jumping race record",
+                "summary": "Get Ski Jumping category codes",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISLastRaceJPResponse"
+                            "$ref": "#/definitions/swagger.FISCategoriesJPResponse"
                         }
                     },
                     "400": {
@@ -2024,12 +2168,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.NotFoundResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2045,14 +2183,13 @@ const docTemplate = `{
                 }
             }
         },
-        "/fis/lastrow/racenk": {
+        "/fis/catcodeNK": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns the last row in a_racenk (by RaceID DESC)",
                 "consumes": [
                     "application/json"
                 ],
@@ -2060,14 +2197,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Race Management – Nordic Combined"
+                    "FIS - Season Discipline \u0026 Category Codes"
                 ],
-                "summary": "Get last Nordic Combined race record",
+                "summary": "Get Nordic Combined category codes",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISLastRaceNKResponse"
+                            "$ref": "#/definitions/swagger.FISCategoriesNKResponse"
                         }
                     },
                     "400": {
@@ -2088,12 +2225,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.NotFoundResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2109,29 +2240,63 @@ const docTemplate = `{
                 }
             }
         },
-        "/fis/lastrow/resultcc": {
+        "/fis/changes": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns the last row in a_resultcc (by RecID DESC)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Lists the rows of a FIS table written (` + "`" + `op` + "`" + ` upsert) or deleted (` + "`" + `op` + "`" + ` delete) at or after ` + "`" + `since` + "`" + `, ordered by the time the database recorded the change and by key. Page through the changes with the cursor; on the last page, ` + "`" + `watermark` + "`" + ` is the ` + "`" + `since` + "`" + ` to use next time. The watermark is held back to the start of the oldest transaction in progress, whose changes are dated when it started, so rows may be listed again. Rows are listed again if they change again.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Result Management – Cross-Country"
+                    "FIS - Changes"
+                ],
+                "summary": "List FIS changes since a watermark",
+                "parameters": [
+                    {
+                        "enum": [
+                            "competitor",
+                            "racecc",
+                            "racejp",
+                            "racenk",
+                            "resultcc",
+                            "resultjp",
+                            "resultnk"
+                        ],
+                        "type": "string",
+                        "description": "Table",
+                        "name": "entity",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Watermark (RFC3339)",
+                        "name": "since",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default: 100, max: 1000)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from pagination.next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get last Cross-Country result record",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISLastResultCCResponse"
+                            "$ref": "#/definitions/swagger.FISChangesResponse"
                         }
                     },
                     "400": {
@@ -2152,12 +2317,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.NotFoundResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2173,14 +2332,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/fis/lastrow/resultjp": {
-            "get": {
+        "/fis/competitor": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns the last row in a_resultjp (by RecID DESC)",
+                "description": "Updates an existing competitor",
                 "consumes": [
                     "application/json"
                 ],
@@ -2188,15 +2347,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Result Management – Ski Jumping"
+                    "FIS - Competitor Management"
                 ],
-                "summary": "Get last Ski Jumping result record",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                "summary": "Update competitor by ID",
+                "parameters": [
+                    {
+                        "description": "Competitor payload",
+                        "name": "competitor",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISLastResultJPResponse"
+                            "$ref": "#/definitions/swagger.FISUpdateCompetitorExample"
                         }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2235,16 +2402,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/fis/lastrow/resultnk": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns the last row in a_resultnk (by RecID DESC)",
+                "description": "Inserts a new competitor",
                 "consumes": [
                     "application/json"
                 ],
@@ -2252,15 +2417,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Result Management – Nordic Combined"
+                    "FIS - Competitor Management"
                 ],
-                "summary": "Get last Nordic Combined result record",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                "summary": "Add new competitor",
+                "parameters": [
+                    {
+                        "description": "Competitor payload",
+                        "name": "competitor",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISLastResultNKResponse"
+                            "$ref": "#/definitions/swagger.FISInsertCompetitorExample"
                         }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2280,10 +2453,10 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
-                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                            "$ref": "#/definitions/swagger.ConflictResponse"
                         }
                     },
                     "500": {
@@ -2299,15 +2472,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/fis/nation": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "Deletes a competitor by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -2315,24 +2487,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Athlete"
+                    "FIS - Competitor Management"
                 ],
-                "summary": "Get distinct nation codes for a given sector",
+                "summary": "Delete competitor",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Sector code (JP, NK, CC)",
-                        "name": "sectorcode",
+                        "type": "integer",
+                        "description": "Competitor ID",
+                        "name": "id",
                         "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.FISNationsBySectorResponse"
-                        }
+                        "description": "Deleted"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2352,6 +2521,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2367,13 +2542,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/fis/racecc": {
+        "/fis/competitor/count-by-nation": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "Gets counts of competitors grouped by Nationcode.\nOptional filters: sectorcode, gender, age range (agemin/agemax, in years).",
                 "consumes": [
                     "application/json"
                 ],
@@ -2381,38 +2557,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Race Data"
+                    "FIS - KAMK"
                 ],
-                "summary": "Get list of Cross-Country races",
+                "summary": "Get competitor counts by nation",
                 "parameters": [
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "integer"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Season code (repeat or comma-separated)",
-                        "name": "seasoncode",
+                        "type": "string",
+                        "description": "Sector code filter (CC,JP,NK)",
+                        "name": "sectorcode",
                         "in": "query"
                     },
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Discipline code (repeat or comma-separated)",
-                        "name": "disciplinecode",
+                        "type": "string",
+                        "description": "Gender filter (M/W)",
+                        "name": "gender",
                         "in": "query"
                     },
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Category code (repeat or comma-separated)",
-                        "name": "catcode",
+                        "type": "integer",
+                        "description": "Minimum age in years",
+                        "name": "agemin",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum age in years",
+                        "name": "agemax",
                         "in": "query"
                     }
                 ],
@@ -2420,7 +2590,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISRacesCCResponse"
+                            "$ref": "#/definitions/swagger.FISCompetitorNationCountsResponse"
                         }
                     },
                     "400": {
@@ -2454,14 +2624,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/fis/competitor/latest-results": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Updates an existing a_racecc row",
+                "description": "Gets an athlete's latest race results filtered by sector and optional season and catcodes. Results are ordered by Racedate DESC.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2469,23 +2641,53 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Race Management – Cross-Country"
+                    "FIS - KAMK"
                 ],
-                "summary": "Update Cross-Country race by ID",
+                "summary": "Get competitor latest race results",
                 "parameters": [
                     {
-                        "description": "Race payload",
-                        "name": "racecc",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/swagger.FISUpdateRaceCCExample"
-                        }
+                        "type": "integer",
+                        "description": "FIS Code",
+                        "name": "fiscode",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sector code (CC,JP,NK)",
+                        "name": "sector",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Season code filter",
+                        "name": "seasoncode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Category code(s) – repeat or comma-separated (e.g. catcode=WC\u0026catcode=COC or catcode=WC,COC)",
+                        "name": "catcode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of results to return (default 50)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISLatestResultsResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2505,12 +2707,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.NotFoundResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2524,14 +2720,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/fis/competitor/search": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Inserts a new a_racecc row",
+                "description": "Gets competitors filtered by optional Nationcode, Sectorcode, Gender and age range (in years).\nAge filters (agemin/agemax) are converted internally to a birthdate range based on today's date.\nq matches the names and ski clubs of the competitors ignoring accents and tolerating typos (\"Maki\" finds \"Mäki\"); the matches are ordered by relevance, best first. fiscode matches the FIS codes starting with the given digits.\nResults are paginated: a page holds at most 100 competitors unless limit is given (max 1000); follow pagination.next_cursor for the rest.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2539,23 +2737,71 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Race Management – Cross-Country"
+                    "FIS - KAMK"
                 ],
-                "summary": "Add new Cross-Country race",
+                "summary": "Search competitors",
                 "parameters": [
                     {
-                        "description": "Race payload",
-                        "name": "racecc",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/swagger.FISInsertRaceCCExample"
-                        }
+                        "type": "string",
+                        "description": "Nation code filter (e.g. FIN)",
+                        "name": "nationcode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sector code filter (CC,JP,NK)",
+                        "name": "sectorcode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Gender filter (M/W)",
+                        "name": "gender",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Minimum age in years (inclusive). For example, agemin=18 means competitors who are at least 18.",
+                        "name": "agemin",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum age in years (inclusive). For example, agemax=30 means competitors who are at most 30.",
+                        "name": "agemax",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name or ski club, at least 2 characters",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "FIS code prefix (digits)",
+                        "name": "fiscode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default: 100, max: 1000)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from pagination.next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISCompetitorSearchResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2575,12 +2821,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.ConflictResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2594,14 +2834,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/fis/competitor/seasons-catcodes": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes a race by RaceID",
+                "description": "Gets distinct (Seasoncode, Catcode) combinations for the races a competitor has actually competed in, for a given sector (CC, JP, NK). Uses both race and result tables.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2609,21 +2851,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Race Management – Cross-Country"
+                    "FIS - KAMK"
                 ],
-                "summary": "Delete Cross-Country race",
+                "summary": "Get competitor seasons and categories",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Race ID",
-                        "name": "id",
+                        "description": "FIS Code",
+                        "name": "fiscode",
                         "in": "query",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Deleted"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sector code (CC,JP,NK)",
+                        "name": "sector",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISCompetitorSeasonsCatcodesResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2643,12 +2895,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.NotFoundResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2664,13 +2910,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/fis/racejp": {
+        "/fis/competitor/sectorcode": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "Returns the sectorcode for a competitor based on fiscode.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2678,46 +2925,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Race Data"
+                    "FIS - KAMK"
                 ],
-                "summary": "Get list of Ski Jumping races",
+                "summary": "Get sector code by fiscode",
                 "parameters": [
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "integer"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Season code (repeat or comma-separated)",
-                        "name": "seasoncode",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Discipline code (repeat or comma-separated)",
-                        "name": "disciplinecode",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Category code (repeat or comma-separated)",
-                        "name": "catcode",
-                        "in": "query"
+                        "type": "integer",
+                        "description": "FIS code",
+                        "name": "fiscode",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISRacesJPResponse"
+                            "$ref": "#/definitions/swagger.FISSectorcodeByFiscodeResponse"
                         }
                     },
                     "400": {
@@ -2751,14 +2975,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/fis/competitor/{fiscode}/stats": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Updates an existing a_racejp row",
+                "description": "Aggregates the results of a FIS code per sector: starts, wins, podiums, top-10s, DNF and DSQ counts, best position, average race points and cup points per season and over the career, with the change of the average race points from the previous season, and the best result per discipline and category. Starts exclude DNS results.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2766,23 +2992,34 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Race Management – Ski Jumping"
+                    "FIS - Athlete"
                 ],
-                "summary": "Update Ski Jumping race by ID",
+                "summary": "Get the career statistics of a competitor",
                 "parameters": [
                     {
-                        "description": "Race payload",
-                        "name": "racejp",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/swagger.FISUpdateRaceJPExample"
-                        }
+                        "type": "integer",
+                        "description": "FIS Code",
+                        "name": "fiscode",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Sector codes (repeat or comma-separated; default: all)",
+                        "name": "sectors",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISCompetitorStatsResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2821,14 +3058,15 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/fis/disciplinecodeCC": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Inserts a new a_racejp row",
                 "consumes": [
                     "application/json"
                 ],
@@ -2836,23 +3074,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Race Management – Ski Jumping"
+                    "FIS - Season Discipline \u0026 Category Codes"
                 ],
-                "summary": "Add new Ski Jumping race",
-                "parameters": [
-                    {
-                        "description": "Race payload",
-                        "name": "racejp",
-                        "in": "body",
-                        "required": true,
+                "summary": "Get Cross-Country discipline codes",
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISInsertRaceJPExample"
+                            "$ref": "#/definitions/swagger.FISDisciplinesCCResponse"
                         }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Created"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2872,12 +3102,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.ConflictResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2891,14 +3115,15 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/fis/disciplinecodeJP": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes a race by RaceID",
                 "consumes": [
                     "application/json"
                 ],
@@ -2906,21 +3131,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Race Management – Ski Jumping"
-                ],
-                "summary": "Delete Ski Jumping race",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Race ID",
-                        "name": "id",
-                        "in": "query",
-                        "required": true
-                    }
+                    "FIS - Season Discipline \u0026 Category Codes"
                 ],
+                "summary": "Get Ski Jumping discipline codes",
                 "responses": {
                     "200": {
-                        "description": "Deleted"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISDisciplinesJPResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2940,12 +3159,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.NotFoundResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2961,7 +3174,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/fis/racenk": {
+        "/fis/disciplinecodeNK": {
             "get": {
                 "security": [
                     {
@@ -2975,46 +3188,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Race Data"
-                ],
-                "summary": "Get list of Nordic Combined races",
-                "parameters": [
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "integer"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Season code (repeat or comma-separated)",
-                        "name": "seasoncode",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Discipline code (repeat or comma-separated)",
-                        "name": "disciplinecode",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Category code (repeat or comma-separated)",
-                        "name": "catcode",
-                        "in": "query"
-                    }
+                    "FIS - Season Discipline \u0026 Category Codes"
                 ],
+                "summary": "Get Nordic Combined discipline codes",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISRacesNKResponse"
+                            "$ref": "#/definitions/swagger.FISDisciplinesNKResponse"
                         }
                     },
                     "400": {
@@ -3048,14 +3229,15 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/fis/fiscode": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Updates an existing a_racenk row",
                 "consumes": [
                     "application/json"
                 ],
@@ -3063,23 +3245,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Race Management – Nordic Combined"
+                    "FIS - Athlete"
                 ],
-                "summary": "Update Nordic Combined race by ID",
+                "summary": "Get all athletes for a given SporttiID",
                 "parameters": [
                     {
-                        "description": "Race payload",
-                        "name": "racenk",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/swagger.FISUpdateRaceNKExample"
-                        }
+                        "type": "integer",
+                        "description": "SporttiID",
+                        "name": "sporttiid",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISAthletesResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -3118,14 +3301,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/fis/lastrow/competitor": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Inserts a new a_racenk row",
+                "description": "Returns the last row in the competitor table",
                 "consumes": [
                     "application/json"
                 ],
@@ -3133,28 +3318,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Race Management – Nordic Combined"
+                    "FIS - Competitor Management"
                 ],
-                "summary": "Add new Nordic Combined race",
-                "parameters": [
-                    {
-                        "description": "Race payload",
-                        "name": "racenk",
-                        "in": "body",
-                        "required": true,
+                "summary": "Get last competitor record",
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISInsertRaceNKExample"
-                        }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Created"
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                            "$ref": "#/definitions/swagger.FISLastCompetitorResponse"
                         }
                     },
                     "401": {
@@ -3169,10 +3340,10 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/swagger.ConflictResponse"
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
                         }
                     },
                     "500": {
@@ -3188,14 +3359,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/fis/lastrow/racecc": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes a race by RaceID",
+                "description": "Returns the last row in a_racecc (by RaceID DESC)",
                 "consumes": [
                     "application/json"
                 ],
@@ -3203,21 +3376,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Race Management – Nordic Combined"
-                ],
-                "summary": "Delete Nordic Combined race",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Race ID",
-                        "name": "id",
-                        "in": "query",
-                        "required": true
-                    }
+                    "FIS - Race Management – Cross-Country"
                 ],
+                "summary": "Get last Cross-Country race record",
                 "responses": {
                     "200": {
-                        "description": "Deleted"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISLastRaceCCResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -3258,14 +3425,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/fis/races/by-ids": {
+        "/fis/lastrow/racejp": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Gets race(s) for a given sector and one or more race IDs.",
+                "description": "Returns the last row in a_racejp (by RaceID DESC)",
                 "consumes": [
                     "application/json"
                 ],
@@ -3273,34 +3440,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - KAMK"
-                ],
-                "summary": "Get races by IDs",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Sector code (CC,JP,NK)",
-                        "name": "sector",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "integer"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Race ID(s) – repeat or comma-separated (e.g. raceid=123\u0026raceid=456 or raceid=123,456)",
-                        "name": "raceid",
-                        "in": "query",
-                        "required": true
-                    }
+                    "FIS - Race Management – Ski Jumping"
                 ],
+                "summary": "Get last Ski Jumping race record",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISRacesByIDsResponse"
+                            "$ref": "#/definitions/swagger.FISLastRaceJPResponse"
                         }
                     },
                     "400": {
@@ -3321,6 +3468,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3336,14 +3489,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/fis/races/count-by-category": {
+        "/fis/lastrow/racenk": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Gets counts of races grouped by category code (Catcode) for a given season and selected sector(s). Optional filters: Nationcode, Gender.",
+                "description": "Returns the last row in a_racenk (by RaceID DESC)",
                 "consumes": [
                     "application/json"
                 ],
@@ -3351,46 +3504,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - KAMK"
-                ],
-                "summary": "Get race counts by category",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Season code",
-                        "name": "seasoncode",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Sector code(s) (CC,JP,NK – repeat or comma-separated, e.g. sector=CC\u0026sector=JP or sector=CC,JP)",
-                        "name": "sector",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Nation code filter (e.g. FIN)",
-                        "name": "nationcode",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Gender filter (e.g. M/W)",
-                        "name": "gender",
-                        "in": "query"
-                    }
+                    "FIS - Race Management – Nordic Combined"
                 ],
+                "summary": "Get last Nordic Combined race record",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISRacesCategoryCountsResponse"
+                            "$ref": "#/definitions/swagger.FISLastRaceNKResponse"
                         }
                     },
                     "400": {
@@ -3411,6 +3532,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3426,14 +3553,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/fis/races/count-by-nation": {
+        "/fis/lastrow/resultcc": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get counts of races grouped by Nationcodes for a given season and one or more sectors (CC, JP, NK). Optional filters: gender, catcode.",
+                "description": "Returns the last row in a_resultcc (by RecID DESC)",
                 "consumes": [
                     "application/json"
                 ],
@@ -3441,46 +3568,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - KAMK"
-                ],
-                "summary": "Get race counts by nation",
-                "parameters": [
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Sector code(s) (CC,JP,NK – repeat or comma-separated, e.g. sector=CC\u0026sector=JP or sector=CC,JP)",
-                        "name": "sector",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Season code",
-                        "name": "seasoncode",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Gender (M/W)",
-                        "name": "gender",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Category code (e.g. WC)",
-                        "name": "catcode",
-                        "in": "query"
-                    }
+                    "FIS - Result Management – Cross-Country"
                 ],
+                "summary": "Get last Cross-Country result record",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISRacesNationCountsResponse"
+                            "$ref": "#/definitions/swagger.FISLastResultCCResponse"
                         }
                     },
                     "400": {
@@ -3501,6 +3596,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3516,14 +3617,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/fis/races/count-total": {
+        "/fis/lastrow/resultjp": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Gets total count of races for a given season and one or more sectors (CC, JP, NK). Optional filters: Catcode, Gender.",
+                "description": "Returns the last row in a_resultjp (by RecID DESC)",
                 "consumes": [
                     "application/json"
                 ],
@@ -3531,46 +3632,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - KAMK"
-                ],
-                "summary": "Get total race count",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Season code",
-                        "name": "seasoncode",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Sector code(s) (CC,JP,NK – repeat or comma-separated, e.g. sector=CC\u0026sector=JP or sector=CC,JP)",
-                        "name": "sector",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Category code filter (e.g. WC)",
-                        "name": "catcode",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Gender filter (e.g. M/W)",
-                        "name": "gender",
-                        "in": "query"
-                    }
+                    "FIS - Result Management – Ski Jumping"
                 ],
+                "summary": "Get last Ski Jumping result record",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISRacesTotalsResponse"
+                            "$ref": "#/definitions/swagger.FISLastResultJPResponse"
                         }
                     },
                     "400": {
@@ -3591,6 +3660,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3606,14 +3681,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/fis/races/search": {
+        "/fis/lastrow/resultnk": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Gets all Races with optional filters (Nationcode, Seasoncode, Gender, Catcode) for each selected sector (NK, CC, JP). If sector is omitted, all sectors are used.",
+                "description": "Returns the last row in a_resultnk (by RecID DESC)",
                 "consumes": [
                     "application/json"
                 ],
@@ -3621,57 +3696,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - KAMK"
+                    "FIS - Result Management – Nordic Combined"
                 ],
-                "summary": "Search races across sectors",
-                "parameters": [
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Sector code (CC,JP,NK – repeat or comma-separated; if omitted, all sectors are used)",
-                        "name": "sector",
-                        "in": "query"
+                "summary": "Get last Nordic Combined result record",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISLastResultNKResponse"
+                        }
                     },
-                    {
-                        "type": "integer",
-                        "description": "Season code",
-                        "name": "seasoncode",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Nation code (e.g. FIN)",
-                        "name": "nationcode",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Gender (M/W)",
-                        "name": "gender",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Category code (e.g. WC)",
-                        "name": "catcode",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.FISRacesSearchResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
-                        }
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
                     },
                     "401": {
                         "description": "Unauthorized",
@@ -3685,6 +3724,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3700,7 +3745,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/fis/resultathletecc": {
+        "/fis/nation": {
             "get": {
                 "security": [
                     {
@@ -3716,51 +3761,21 @@ const docTemplate = `{
                 "tags": [
                     "FIS - Athlete"
                 ],
-                "summary": "Get Cross-Country results for an athlete",
+                "summary": "Get distinct nation codes for a given sector",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "FIS Code",
-                        "name": "fiscode",
+                        "type": "string",
+                        "description": "Sector code (JP, NK, CC)",
+                        "name": "sectorcode",
                         "in": "query",
                         "required": true
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "integer"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Season code (repeat or comma-separated)",
-                        "name": "seasoncode",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Discipline code (repeat or comma-separated)",
-                        "name": "disciplinecode",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Category code (repeat or comma-separated)",
-                        "name": "catcode",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISAthleteResultsCCResponse"
+                            "$ref": "#/definitions/swagger.FISNationsBySectorResponse"
                         }
                     },
                     "400": {
@@ -3781,12 +3796,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.NotFoundResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3802,7 +3811,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/fis/resultathletejp": {
+        "/fis/racecc": {
             "get": {
                 "security": [
                     {
@@ -3813,20 +3822,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/csv",
+                    "application/vnd.apache.parquet"
                 ],
                 "tags": [
-                    "FIS - Athlete"
+                    "FIS - Race Data"
                 ],
-                "summary": "Get Ski Jumping results for an athlete",
+                "summary": "Get list of Cross-Country races",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "FIS Code",
-                        "name": "fiscode",
-                        "in": "query",
-                        "required": true
-                    },
                     {
                         "type": "array",
                         "items": {
@@ -3856,13 +3860,31 @@ const docTemplate = `{
                         "description": "Category code (repeat or comma-separated)",
                         "name": "catcode",
                         "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default: 100, max: 1000)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from pagination.next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Response format: json (default), csv or parquet; also negotiated with Accept",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISAthleteResultsJPResponse"
+                            "$ref": "#/definitions/swagger.FISRacesCCResponse"
                         }
                     },
                     "400": {
@@ -3883,12 +3905,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.NotFoundResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3902,15 +3918,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/fis/resultathletenk": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "Updates an existing a_racecc row",
                 "consumes": [
                     "application/json"
                 ],
@@ -3918,54 +3933,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Athlete"
+                    "FIS - Race Management – Cross-Country"
                 ],
-                "summary": "Get Nordic Combined results for an athlete",
+                "summary": "Update Cross-Country race by ID",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "FIS Code",
-                        "name": "fiscode",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "integer"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Season code (repeat or comma-separated)",
-                        "name": "seasoncode",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Discipline code (repeat or comma-separated)",
-                        "name": "disciplinecode",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Category code (repeat or comma-separated)",
-                        "name": "catcode",
-                        "in": "query"
+                        "description": "Race payload",
+                        "name": "racecc",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISUpdateRaceCCExample"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.FISAthleteResultsNKResponse"
-                        }
+                        "description": "Updated"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -4004,15 +3988,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/fis/resultcc": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "Inserts a new a_racecc row",
                 "consumes": [
                     "application/json"
                 ],
@@ -4020,24 +4003,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Race Results"
+                    "FIS - Race Management – Cross-Country"
                 ],
-                "summary": "Get results for a Cross-Country race",
+                "summary": "Add new Cross-Country race",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Race ID",
-                        "name": "raceid",
-                        "in": "query",
-                        "required": true
+                        "description": "Race payload",
+                        "name": "racecc",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISInsertRaceCCExample"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.FISRaceResultsCCResponse"
-                        }
+                    "201": {
+                        "description": "Created"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -4057,6 +4039,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ConflictResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -4071,13 +4059,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Updates an existing a_resultcc row",
+                "description": "Deletes a race by RaceID",
                 "consumes": [
                     "application/json"
                 ],
@@ -4085,23 +4073,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Result Management – Cross-Country"
+                    "FIS - Race Management – Cross-Country"
                 ],
-                "summary": "Update Cross-Country result by RecID",
+                "summary": "Delete Cross-Country race",
                 "parameters": [
                     {
-                        "description": "Result payload",
-                        "name": "resultcc",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/swagger.FISUpdateResultCCExample"
-                        }
+                        "type": "integer",
+                        "description": "Race ID",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated"
+                        "description": "Deleted"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -4140,177 +4126,82 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/fis/racejp": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Inserts a new a_resultcc row",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/csv",
+                    "application/vnd.apache.parquet"
                 ],
                 "tags": [
-                    "FIS - Result Management – Cross-Country"
+                    "FIS - Race Data"
                 ],
-                "summary": "Add new Cross-Country result",
+                "summary": "Get list of Ski Jumping races",
                 "parameters": [
                     {
-                        "description": "Result payload",
-                        "name": "resultcc",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/swagger.FISInsertResultCCExample"
-                        }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Created"
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
-                        }
-                    },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.ForbiddenResponse"
-                        }
-                    },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.ConflictResponse"
-                        }
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Season code (repeat or comma-separated)",
+                        "name": "seasoncode",
+                        "in": "query"
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
-                        }
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Discipline code (repeat or comma-separated)",
+                        "name": "disciplinecode",
+                        "in": "query"
                     },
-                    "503": {
-                        "description": "Service Unavailable",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
-                        }
-                    }
-                }
-            },
-            "delete": {
-                "security": [
                     {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Deletes a result by RecID",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "FIS - Result Management – Cross-Country"
-                ],
-                "summary": "Delete Cross-Country result",
-                "parameters": [
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Category code (repeat or comma-separated)",
+                        "name": "catcode",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
-                        "description": "Result RecID",
-                        "name": "id",
-                        "in": "query",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Deleted"
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
-                        }
-                    },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.ForbiddenResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.NotFoundResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
-                        }
+                        "description": "Page size (default: 100, max: 1000)",
+                        "name": "limit",
+                        "in": "query"
                     },
-                    "503": {
-                        "description": "Service Unavailable",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/fis/resultjp": {
-            "get": {
-                "security": [
                     {
-                        "BearerAuth": []
-                    }
-                ],
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "FIS - Race Results"
-                ],
-                "summary": "Get results for a Ski Jumping race",
-                "parameters": [
+                        "type": "string",
+                        "description": "Opaque cursor from pagination.next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
                     {
-                        "type": "integer",
-                        "description": "Race ID",
-                        "name": "raceid",
-                        "in": "query",
-                        "required": true
+                        "type": "string",
+                        "description": "Response format: json (default), csv or parquet; also negotiated with Accept",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISRaceResultsJPResponse"
+                            "$ref": "#/definitions/swagger.FISRacesJPResponse"
                         }
                     },
                     "400": {
@@ -4351,7 +4242,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Updates an existing a_resultjp row",
+                "description": "Updates an existing a_racejp row",
                 "consumes": [
                     "application/json"
                 ],
@@ -4359,17 +4250,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Result Management – Ski Jumping"
+                    "FIS - Race Management – Ski Jumping"
                 ],
-                "summary": "Update Ski Jumping result by RecID",
+                "summary": "Update Ski Jumping race by ID",
                 "parameters": [
                     {
-                        "description": "Result payload",
-                        "name": "resultjp",
+                        "description": "Race payload",
+                        "name": "racejp",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISUpdateResultJPExample"
+                            "$ref": "#/definitions/swagger.FISUpdateRaceJPExample"
                         }
                     }
                 ],
@@ -4421,7 +4312,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Inserts a new a_resultjp row",
+                "description": "Inserts a new a_racejp row",
                 "consumes": [
                     "application/json"
                 ],
@@ -4429,17 +4320,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Result Management – Ski Jumping"
+                    "FIS - Race Management – Ski Jumping"
                 ],
-                "summary": "Add new Ski Jumping result",
+                "summary": "Add new Ski Jumping race",
                 "parameters": [
                     {
-                        "description": "Result payload",
-                        "name": "resultjp",
+                        "description": "Race payload",
+                        "name": "racejp",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISInsertResultJPExample"
+                            "$ref": "#/definitions/swagger.FISInsertRaceJPExample"
                         }
                     }
                 ],
@@ -4491,7 +4382,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes a result by RecID",
+                "description": "Deletes a race by RaceID",
                 "consumes": [
                     "application/json"
                 ],
@@ -4499,13 +4390,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Result Management – Ski Jumping"
+                    "FIS - Race Management – Ski Jumping"
                 ],
-                "summary": "Delete Ski Jumping result",
+                "summary": "Delete Ski Jumping race",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Result RecID",
+                        "description": "Race ID",
                         "name": "id",
                         "in": "query",
                         "required": true
@@ -4554,7 +4445,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/fis/resultnk": {
+        "/fis/racenk": {
             "get": {
                 "security": [
                     {
@@ -4565,26 +4456,69 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/csv",
+                    "application/vnd.apache.parquet"
                 ],
                 "tags": [
-                    "FIS - Race Results"
+                    "FIS - Race Data"
                 ],
-                "summary": "Get results for a Nordic Combined race",
+                "summary": "Get list of Nordic Combined races",
                 "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Season code (repeat or comma-separated)",
+                        "name": "seasoncode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Discipline code (repeat or comma-separated)",
+                        "name": "disciplinecode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Category code (repeat or comma-separated)",
+                        "name": "catcode",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
-                        "description": "Race ID",
-                        "name": "raceid",
-                        "in": "query",
-                        "required": true
+                        "description": "Page size (default: 100, max: 1000)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from pagination.next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Response format: json (default), csv or parquet; also negotiated with Accept",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISRaceResultsNKResponse"
+                            "$ref": "#/definitions/swagger.FISRacesNKResponse"
                         }
                     },
                     "400": {
@@ -4625,7 +4559,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Updates an existing a_resultnk row",
+                "description": "Updates an existing a_racenk row",
                 "consumes": [
                     "application/json"
                 ],
@@ -4633,17 +4567,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Result Management – Nordic Combined"
+                    "FIS - Race Management – Nordic Combined"
                 ],
-                "summary": "Update Nordic Combined result by RecID",
+                "summary": "Update Nordic Combined race by ID",
                 "parameters": [
                     {
-                        "description": "Result payload",
-                        "name": "resultnk",
+                        "description": "Race payload",
+                        "name": "racenk",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISUpdateResultNKExample"
+                            "$ref": "#/definitions/swagger.FISUpdateRaceNKExample"
                         }
                     }
                 ],
@@ -4695,7 +4629,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Inserts a new a_resultnk row",
+                "description": "Inserts a new a_racenk row",
                 "consumes": [
                     "application/json"
                 ],
@@ -4703,17 +4637,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Result Management – Nordic Combined"
+                    "FIS - Race Management – Nordic Combined"
                 ],
-                "summary": "Add new Nordic Combined result",
+                "summary": "Add new Nordic Combined race",
                 "parameters": [
                     {
-                        "description": "Result payload",
-                        "name": "resultnk",
+                        "description": "Race payload",
+                        "name": "racenk",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISInsertResultNKExample"
+                            "$ref": "#/definitions/swagger.FISInsertRaceNKExample"
                         }
                     }
                 ],
@@ -4765,7 +4699,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes a result by RecID",
+                "description": "Deletes a race by RaceID",
                 "consumes": [
                     "application/json"
                 ],
@@ -4773,13 +4707,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Result Management – Nordic Combined"
+                    "FIS - Race Management – Nordic Combined"
                 ],
-                "summary": "Delete Nordic Combined result",
+                "summary": "Delete Nordic Combined race",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Result RecID",
+                        "description": "Race ID",
                         "name": "id",
                         "in": "query",
                         "required": true
@@ -4828,13 +4762,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/fis/seasoncodeCC": {
+        "/fis/races/by-ids": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "Gets race(s) for a given sector and one or more race IDs.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4842,14 +4777,34 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Season Discipline \u0026 Category Codes"
+                    "FIS - KAMK"
+                ],
+                "summary": "Get races by IDs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sector code (CC,JP,NK)",
+                        "name": "sector",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Race ID(s) – repeat or comma-separated (e.g. raceid=123\u0026raceid=456 or raceid=123,456)",
+                        "name": "raceid",
+                        "in": "query",
+                        "required": true
+                    }
                 ],
-                "summary": "Get Cross-Country season codes",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISSeasonsCCResponse"
+                            "$ref": "#/definitions/swagger.FISRacesByIDsResponse"
                         }
                     },
                     "400": {
@@ -4885,13 +4840,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/fis/seasoncodeJP": {
+        "/fis/races/count-by-category": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "Gets counts of races grouped by category code (Catcode) for a given season and selected sector(s). Optional filters: Nationcode, Gender.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4899,14 +4855,46 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Season Discipline \u0026 Category Codes"
+                    "FIS - KAMK"
+                ],
+                "summary": "Get race counts by category",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Season code",
+                        "name": "seasoncode",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Sector code(s) (CC,JP,NK – repeat or comma-separated, e.g. sector=CC\u0026sector=JP or sector=CC,JP)",
+                        "name": "sector",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Nation code filter (e.g. FIN)",
+                        "name": "nationcode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Gender filter (e.g. M/W)",
+                        "name": "gender",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get Ski Jumping season codes",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISSeasonsJPResponse"
+                            "$ref": "#/definitions/swagger.FISRacesCategoryCountsResponse"
                         }
                     },
                     "400": {
@@ -4942,13 +4930,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/fis/seasoncodeNK": {
+        "/fis/races/count-by-nation": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "Get counts of races grouped by Nationcodes for a given season and one or more sectors (CC, JP, NK). Optional filters: gender, catcode.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4956,14 +4945,46 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FIS - Season Discipline \u0026 Category Codes"
+                    "FIS - KAMK"
+                ],
+                "summary": "Get race counts by nation",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Sector code(s) (CC,JP,NK – repeat or comma-separated, e.g. sector=CC\u0026sector=JP or sector=CC,JP)",
+                        "name": "sector",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Season code",
+                        "name": "seasoncode",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Gender (M/W)",
+                        "name": "gender",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category code (e.g. WC)",
+                        "name": "catcode",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get Nordic Combined season codes",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.FISSeasonsNKResponse"
+                            "$ref": "#/definitions/swagger.FISRacesNationCountsResponse"
                         }
                     },
                     "400": {
@@ -4999,40 +5020,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/health": {
+        "/fis/races/count-total": {
             "get": {
-                "description": "Healthcheck endpoint",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Health"
-                ],
-                "summary": "Healthcheck",
-                "responses": {
-                    "200": {
-                        "description": "Health status",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.HealthStatusResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/kamk/delete-quiz": {
-            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes a questionnaire row (competitor_id=user_id AND id=id)",
+                "description": "Gets total count of races for a given season and one or more sectors (CC, JP, NK). Optional filters: Catcode, Gender.",
                 "consumes": [
                     "application/json"
                 ],
@@ -5040,28 +5035,47 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "KAMK - Queries"
+                    "FIS - KAMK"
                 ],
-                "summary": "Delete a questionnaire by id",
+                "summary": "Get total race count",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "sportti_id",
-                        "name": "user_id",
+                        "description": "Season code",
+                        "name": "seasoncode",
                         "in": "query",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Questionnaire ID",
-                        "name": "id",
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Sector code(s) (CC,JP,NK – repeat or comma-separated, e.g. sector=CC\u0026sector=JP or sector=CC,JP)",
+                        "name": "sector",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category code filter (e.g. WC)",
+                        "name": "catcode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Gender filter (e.g. M/W)",
+                        "name": "gender",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK: deleted"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISRacesTotalsResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -5081,12 +5095,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.NotFoundResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -5102,14 +5110,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/kamk/injury": {
+        "/fis/races/search": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns active injuries (status=0) for a competitor",
+                "description": "Gets all Races with optional filters (Nationcode, Seasoncode, Gender, Catcode) for each selected sector (NK, CC, JP). If sector is omitted, all sectors are used.",
                 "consumes": [
                     "application/json"
                 ],
@@ -5117,28 +5125,52 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "KAMK - Injuries"
+                    "FIS - KAMK"
                 ],
-                "summary": "List active injuries",
+                "summary": "Search races across sectors",
                 "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Sector code (CC,JP,NK – repeat or comma-separated; if omitted, all sectors are used)",
+                        "name": "sector",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
-                        "description": "Competitor sportti_id",
-                        "name": "user_id",
-                        "in": "query",
-                        "required": true
+                        "description": "Season code",
+                        "name": "seasoncode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Nation code (e.g. FIN)",
+                        "name": "nationcode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Gender (M/W)",
+                        "name": "gender",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category code (e.g. WC)",
+                        "name": "catcode",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.KamkInjuriesListResponse"
+                            "$ref": "#/definitions/swagger.FISRacesSearchResponse"
                         }
                     },
-                    "204": {
-                        "description": "No Content: no injuries"
-                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -5170,38 +5202,91 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/fis/resultathletecc": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Creates a new injury row (status=0, date_start=NOW()) for a competitor",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/x-ndjson",
+                    "text/csv",
+                    "application/vnd.apache.parquet"
                 ],
                 "tags": [
-                    "KAMK - Injuries"
+                    "FIS - Athlete"
                 ],
-                "summary": "Create injury",
+                "summary": "Get Cross-Country results for an athlete",
                 "parameters": [
                     {
-                        "description": "Injury payload",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/swagger.KamkAddInjuryRequest"
-                        }
+                        "type": "integer",
+                        "description": "FIS Code",
+                        "name": "fiscode",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Season code (repeat or comma-separated)",
+                        "name": "seasoncode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Discipline code (repeat or comma-separated)",
+                        "name": "disciplinecode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Category code (repeat or comma-separated)",
+                        "name": "catcode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default: 100, max: 1000)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from pagination.next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Response format: json (default), csv or parquet; also negotiated with Accept",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created: Injury stored (no content in response body)"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISAthleteResultsCCResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -5221,6 +5306,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -5234,43 +5325,91 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/fis/resultathletejp": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes a single injury for a competitor",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/x-ndjson",
+                    "text/csv",
+                    "application/vnd.apache.parquet"
                 ],
                 "tags": [
-                    "KAMK - Injuries"
+                    "FIS - Athlete"
                 ],
-                "summary": "Delete an injury by injury_id",
+                "summary": "Get Ski Jumping results for an athlete",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "sportti_id",
-                        "name": "user_id",
+                        "description": "FIS Code",
+                        "name": "fiscode",
                         "in": "query",
                         "required": true
                     },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Season code (repeat or comma-separated)",
+                        "name": "seasoncode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Discipline code (repeat or comma-separated)",
+                        "name": "disciplinecode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Category code (repeat or comma-separated)",
+                        "name": "catcode",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
-                        "description": "Injury ID",
-                        "name": "injury_id",
-                        "in": "query",
-                        "required": true
+                        "description": "Page size (default: 100, max: 1000)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from pagination.next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Response format: json (default), csv or parquet; also negotiated with Accept",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK: deleted"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISAthleteResultsJPResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -5311,38 +5450,88 @@ const docTemplate = `{
                 }
             }
         },
-        "/kamk/injury-id": {
+        "/fis/resultathletenk": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns the current maximum injury_id for a competitor (0 if none exist)",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/x-ndjson",
+                    "text/csv",
+                    "application/vnd.apache.parquet"
                 ],
                 "tags": [
-                    "KAMK - Injuries"
+                    "FIS - Athlete"
                 ],
-                "summary": "Get next injury id helper",
+                "summary": "Get Nordic Combined results for an athlete",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Competitor sportti_id",
-                        "name": "user_id",
+                        "description": "FIS Code",
+                        "name": "fiscode",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Season code (repeat or comma-separated)",
+                        "name": "seasoncode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Discipline code (repeat or comma-separated)",
+                        "name": "disciplinecode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Category code (repeat or comma-separated)",
+                        "name": "catcode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default: 100, max: 1000)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from pagination.next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Response format: json (default), csv or parquet; also negotiated with Accept",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.KamkMaxInjuryIDResponse"
+                            "$ref": "#/definitions/swagger.FISAthleteResultsNKResponse"
                         }
                     },
                     "400": {
@@ -5363,6 +5552,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -5378,38 +5573,46 @@ const docTemplate = `{
                 }
             }
         },
-        "/kamk/injury-recovered": {
-            "post": {
+        "/fis/resultcc": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Sets status=1 and date_end=NOW() for an injury recovery",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/csv",
+                    "application/vnd.apache.parquet"
                 ],
                 "tags": [
-                    "KAMK - Injuries"
+                    "FIS - Race Results"
                 ],
-                "summary": "Mark injury recovered",
+                "summary": "Get results for a Cross-Country race",
                 "parameters": [
                     {
-                        "description": "Recovery payload",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/swagger.KamkMarkRecoveredRequest"
-                        }
+                        "type": "integer",
+                        "description": "Race ID",
+                        "name": "raceid",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Response format: json (default), csv or parquet; also negotiated with Accept",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK: Marked recovered"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISRaceResultsCCResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -5442,16 +5645,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/kamk/is-quiz-done": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Return the quiz if it was done today",
+                "description": "Updates an existing a_resultcc row",
                 "consumes": [
                     "application/json"
                 ],
@@ -5459,34 +5660,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "KAMK - Queries"
+                    "FIS - Result Management – Cross-Country"
                 ],
-                "summary": "Check if certain quiz is due for the user. Used for daily quizzes.",
+                "summary": "Update Cross-Country result by RecID",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "sportti_id",
-                        "name": "user_id",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Quiz type",
-                        "name": "quiz_type",
-                        "in": "query",
-                        "required": true
+                        "description": "Result payload",
+                        "name": "resultcc",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISUpdateResultCCExample"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.KamkQuestionnairesListResponse"
-                        }
-                    },
-                    "204": {
-                        "description": "No Content: no rows today"
+                        "description": "Updated"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -5506,6 +5696,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -5519,16 +5715,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/kamk/questionnaire": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns questionnaires for a competitor ordered by timestamp DESC",
+                "description": "Inserts a new a_resultcc row",
                 "consumes": [
                     "application/json"
                 ],
@@ -5536,27 +5730,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "KAMK - Queries"
+                    "FIS - Result Management – Cross-Country"
                 ],
-                "summary": "List questionnaires",
+                "summary": "Add new Cross-Country result",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "sportti_id",
-                        "name": "user_id",
-                        "in": "query",
-                        "required": true
+                        "description": "Result payload",
+                        "name": "resultcc",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISInsertResultCCExample"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.KamkQuestionnairesListResponse"
-                        }
-                    },
-                    "204": {
-                        "description": "No Content: no rows"
+                    "201": {
+                        "description": "Created"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -5576,6 +5766,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ConflictResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -5590,13 +5786,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Inserts a questionnaire row for a competitor (timestamp=NOW())",
+                "description": "Deletes a result by RecID",
                 "consumes": [
                     "application/json"
                 ],
@@ -5604,26 +5800,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "KAMK - Queries"
+                    "FIS - Result Management – Cross-Country"
                 ],
-                "summary": "Create questionnaire entry",
+                "summary": "Delete Cross-Country result",
                 "parameters": [
                     {
-                        "description": "Questionnaire payload",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/swagger.KamkAddQuestionnaireRequest"
-                        }
+                        "type": "integer",
+                        "description": "Result RecID",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.KamkCreateQuestionnaireResponse"
-                        }
+                    "200": {
+                        "description": "Deleted"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -5643,6 +5834,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -5658,52 +5855,46 @@ const docTemplate = `{
                 }
             }
         },
-        "/kamk/update-quiz": {
-            "post": {
+        "/fis/resultjp": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Updates the questionnaire row identified by id (and user_id)",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/csv",
+                    "application/vnd.apache.parquet"
                 ],
                 "tags": [
-                    "KAMK - Queries"
+                    "FIS - Race Results"
                 ],
-                "summary": "Update questionnaire by id",
+                "summary": "Get results for a Ski Jumping race",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "sportti_id",
-                        "name": "user_id",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Questionnaire ID",
-                        "name": "id",
+                        "description": "Race ID",
+                        "name": "raceid",
                         "in": "query",
                         "required": true
                     },
                     {
-                        "description": "Update payload",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/swagger.KamkUpdateQuestionnaireBody"
-                        }
+                        "type": "string",
+                        "description": "Response format: json (default), csv or parquet; also negotiated with Accept",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK: updated"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISRaceResultsJPResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -5723,12 +5914,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.NotFoundResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -5742,16 +5927,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/klab/data": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns measurement_list + all child tables for the given customer (no customer row)",
+                "description": "Updates an existing a_resultjp row",
                 "consumes": [
                     "application/json"
                 ],
@@ -5759,24 +5942,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "KLAB - Data"
+                    "FIS - Result Management – Ski Jumping"
                 ],
-                "summary": "Get kLab data by Sportti ID",
+                "summary": "Update Ski Jumping result by RecID",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Sportti ID",
-                        "name": "id",
-                        "in": "query",
-                        "required": true
+                        "description": "Result payload",
+                        "name": "resultjp",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISUpdateResultJPExample"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.KlabDataResponse"
-                        }
+                        "description": "Updated"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -5822,7 +6004,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Upsert a full k-Lab bundle for a single customer. The JSON root object key must be the customer id.",
+                "description": "Inserts a new a_resultjp row",
                 "consumes": [
                     "application/json"
                 ],
@@ -5830,23 +6012,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "KLAB - Data"
+                    "FIS - Result Management – Ski Jumping"
                 ],
-                "summary": "Upsert k-Lab data (one customer per request)",
+                "summary": "Add new Ski Jumping result",
                 "parameters": [
                     {
-                        "description": "klab data",
-                        "name": "data",
+                        "description": "Result payload",
+                        "name": "resultjp",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/swagger.KlabDataBulkDoc"
+                            "$ref": "#/definitions/swagger.FISInsertResultJPExample"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Data processed successfully"
+                        "description": "Created"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -5866,6 +6048,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ConflictResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -5879,16 +6067,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/klab/user": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a single KLAB customer by sportti_id",
+                "description": "Deletes a result by RecID",
                 "consumes": [
                     "application/json"
                 ],
@@ -5896,13 +6082,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "KLAB - User"
+                    "FIS - Result Management – Ski Jumping"
                 ],
-                "summary": "Get customer by Sportti ID",
+                "summary": "Delete Ski Jumping result",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Sportti ID",
+                        "description": "Result RecID",
                         "name": "id",
                         "in": "query",
                         "required": true
@@ -5910,10 +6096,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.UserKlabResponse"
-                        }
+                        "description": "Deleted"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -5952,36 +6135,48 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/fis/resultnk": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Removes a KLab customer by sportti_id. Related measurement_list and dir* tables are removed via FK cascades.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/csv",
+                    "application/vnd.apache.parquet"
                 ],
                 "tags": [
-                    "KLAB - User"
+                    "FIS - Race Results"
                 ],
-                "summary": "Delete a customer (hard delete)",
+                "summary": "Get results for a Nordic Combined race",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Sportti ID",
-                        "name": "sportti_id",
+                        "type": "integer",
+                        "description": "Race ID",
+                        "name": "raceid",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Response format: json (default), csv or parquet; also negotiated with Accept",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISRaceResultsNKResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -6001,12 +6196,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.NotFoundResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -6020,16 +6209,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/tietoevry/activity-zones": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all activity zones for a specific user",
+                "description": "Updates an existing a_resultnk row",
                 "consumes": [
                     "application/json"
                 ],
@@ -6037,24 +6224,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tietoevry - Activity_Zones"
+                    "FIS - Result Management – Nordic Combined"
                 ],
-                "summary": "Get activity zones by user ID",
+                "summary": "Update Nordic Combined result by RecID",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "User ID (UUID)",
-                        "name": "user_id",
-                        "in": "query",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                        "description": "Result payload",
+                        "name": "resultnk",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/swagger.TietoevryActivityZoneResponse"
+                            "$ref": "#/definitions/swagger.FISUpdateResultNKExample"
                         }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -6074,6 +6260,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -6094,7 +6286,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Insert multiple activity zone summaries for user (idempotent)",
+                "description": "Inserts a new a_resultnk row",
                 "consumes": [
                     "application/json"
                 ],
@@ -6102,23 +6294,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tietoevry - Activity_Zones"
+                    "FIS - Result Management – Nordic Combined"
                 ],
-                "summary": "Insert activity zones (bulk)",
+                "summary": "Add new Nordic Combined result",
                 "parameters": [
                     {
-                        "description": "Activity zone summaries",
-                        "name": "activity_zones",
+                        "description": "Result payload",
+                        "name": "resultnk",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/swagger.TietoevryActivityZonesBulkInput"
+                            "$ref": "#/definitions/swagger.FISInsertResultNKExample"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Activity zones processed successfully (idempotent operation)"
+                        "description": "Created"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -6157,28 +6349,41 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/tietoevry/deleted-users": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns a list of deleted users with timestamps",
+                "description": "Deletes a result by RecID",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tietoevry - User"
+                    "FIS - Result Management – Nordic Combined"
+                ],
+                "summary": "Delete Nordic Combined result",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Result RecID",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
                 ],
-                "summary": "List deleted users",
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Deleted"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/swagger.TietoevryDeletedUsersResponse"
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
                         }
                     },
                     "401": {
@@ -6193,6 +6398,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -6208,14 +6419,13 @@ const docTemplate = `{
                 }
             }
         },
-        "/tietoevry/exercises": {
+        "/fis/seasoncodeCC": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all exercises (HR_Zones, Samples, Sections) for a specific user",
                 "consumes": [
                     "application/json"
                 ],
@@ -6223,23 +6433,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tietoevry - Exercise"
-                ],
-                "summary": "Get exercises by user ID",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "User ID (UUID)",
-                        "name": "user_id",
-                        "in": "query",
-                        "required": true
-                    }
+                    "FIS - Season Discipline \u0026 Category Codes"
                 ],
+                "summary": "Get Cross-Country season codes",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.TietoevryExerciseResponse"
+                            "$ref": "#/definitions/swagger.FISSeasonsCCResponse"
                         }
                     },
                     "400": {
@@ -6273,14 +6474,15 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/fis/seasoncodeJP": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Insert multiple exercise bundles for user (idempotent)",
                 "consumes": [
                     "application/json"
                 ],
@@ -6288,23 +6490,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tietoevry - Exercise"
+                    "FIS - Season Discipline \u0026 Category Codes"
                 ],
-                "summary": "Insert exercise (bulk)",
-                "parameters": [
-                    {
-                        "description": "Exercise data",
-                        "name": "exercise",
-                        "in": "body",
-                        "required": true,
+                "summary": "Get Ski Jumping season codes",
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.TietoevryExercisesBulkInput"
+                            "$ref": "#/definitions/swagger.FISSeasonsJPResponse"
                         }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Exercises processed successfully (idempotent operation)"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -6324,12 +6518,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.ConflictResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -6345,14 +6533,13 @@ const docTemplate = `{
                 }
             }
         },
-        "/tietoevry/measurements": {
+        "/fis/seasoncodeNK": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all measurements for a specific user",
                 "consumes": [
                     "application/json"
                 ],
@@ -6360,23 +6547,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tietoevry - Measurements"
-                ],
-                "summary": "Get measurements by user ID",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "User ID (UUID)",
-                        "name": "user_id",
-                        "in": "query",
-                        "required": true
-                    }
+                    "FIS - Season Discipline \u0026 Category Codes"
                 ],
+                "summary": "Get Nordic Combined season codes",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.TietoevryMeasurementResponse"
+                            "$ref": "#/definitions/swagger.FISSeasonsNKResponse"
                         }
                     },
                     "400": {
@@ -6410,14 +6588,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/fis/sync/competitor": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Insert multiple measurements for user (idempotent)",
+                "description": "Inserts or updates many competitors in one transaction. A row replaces the stored one only if its lastupdate is newer, or equally new with a newer version; older rows are skipped. The response counts the rows inserted, updated and skipped.",
                 "consumes": [
                     "application/json"
                 ],
@@ -6425,23 +6605,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tietoevry - Measurements"
+                    "FIS - Competitor Management"
                 ],
-                "summary": "Insert measurements (bulk)",
+                "summary": "Sync competitors (bulk upsert)",
                 "parameters": [
                     {
-                        "description": "Measurement data",
-                        "name": "measurements",
+                        "description": "Rows to upsert",
+                        "name": "competitors",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/swagger.TietoevryMeasurementsBulkInput"
+                            "$ref": "#/definitions/swagger.FISSyncCompetitorsInput"
                         }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Queue the request as an ingest job, see /ingest-jobs",
+                        "name": "async",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Measurements processed successfully"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISSyncResponse"
+                        }
+                    },
+                    "202": {
+                        "description": "Queued as an ingest job (async mode)",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.IngestJobEnvelope"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -6482,14 +6677,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/tietoevry/questionnaires": {
-            "get": {
+        "/fis/sync/racecc": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all questionnaire answers for a specific user",
+                "description": "Inserts or updates many Cross-Country races in one transaction. A row replaces the stored one only if its lastupdate is newer, or equally new with a newer version; older rows are skipped. The response counts the rows inserted, updated and skipped.",
                 "consumes": [
                     "application/json"
                 ],
@@ -6497,23 +6692,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tietoevry - Questionnaires"
+                    "FIS - Race Management – Cross-Country"
                 ],
-                "summary": "Get questionnaires by user ID",
+                "summary": "Sync Cross-Country races (bulk upsert)",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "User ID (UUID)",
-                        "name": "user_id",
-                        "in": "query",
-                        "required": true
+                        "description": "Rows to upsert",
+                        "name": "races",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISSyncRacesCCInput"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Queue the request as an ingest job, see /ingest-jobs",
+                        "name": "async",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.TietoevryQuestionnaireAnswerResponse"
+                            "$ref": "#/definitions/swagger.FISSyncResponse"
+                        }
+                    },
+                    "202": {
+                        "description": "Queued as an ingest job (async mode)",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.IngestJobEnvelope"
                         }
                     },
                     "400": {
@@ -6534,6 +6743,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ConflictResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -6547,14 +6762,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/fis/sync/racejp": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Insert multiple questionnaire answers for users (idempotent)",
+                "description": "Inserts or updates many Ski Jumping races in one transaction. A row replaces the stored one only if its lastupdate is newer, or equally new with a newer version; older rows are skipped. The response counts the rows inserted, updated and skipped.",
                 "consumes": [
                     "application/json"
                 ],
@@ -6562,23 +6779,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tietoevry - Questionnaires"
+                    "FIS - Race Management – Ski Jumping"
                 ],
-                "summary": "Insert questionnaire answers (bulk)",
+                "summary": "Sync Ski Jumping races (bulk upsert)",
                 "parameters": [
                     {
-                        "description": "Questionnaire answers",
-                        "name": "questionnaires",
+                        "description": "Rows to upsert",
+                        "name": "races",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/swagger.TietoevryQuestionnaireAnswersBulkInput"
+                            "$ref": "#/definitions/swagger.FISSyncRacesJPInput"
                         }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Queue the request as an ingest job, see /ingest-jobs",
+                        "name": "async",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Questionnaire answers processed successfully"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISSyncResponse"
+                        }
+                    },
+                    "202": {
+                        "description": "Queued as an ingest job (async mode)",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.IngestJobEnvelope"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -6619,14 +6851,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/tietoevry/symptoms": {
-            "get": {
+        "/fis/sync/racenk": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all symptoms for a specific user",
+                "description": "Inserts or updates many Nordic Combined races in one transaction. A row replaces the stored one only if its lastupdate is newer, or equally new with a newer version; older rows are skipped. The response counts the rows inserted, updated and skipped.",
                 "consumes": [
                     "application/json"
                 ],
@@ -6634,23 +6866,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tietoevry - Symptoms"
+                    "FIS - Race Management – Nordic Combined"
                 ],
-                "summary": "Get symptoms by user ID",
+                "summary": "Sync Nordic Combined races (bulk upsert)",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "User ID (UUID)",
-                        "name": "user_id",
-                        "in": "query",
-                        "required": true
+                        "description": "Rows to upsert",
+                        "name": "races",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISSyncRacesNKInput"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Queue the request as an ingest job, see /ingest-jobs",
+                        "name": "async",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.TietoevrySymptomResponse"
+                            "$ref": "#/definitions/swagger.FISSyncResponse"
+                        }
+                    },
+                    "202": {
+                        "description": "Queued as an ingest job (async mode)",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.IngestJobEnvelope"
                         }
                     },
                     "400": {
@@ -6671,6 +6917,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ConflictResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -6684,14 +6936,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/fis/sync/resultcc": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Insert multiple symptoms for user (idempotent)",
+                "description": "Inserts or updates many Cross-Country results in one transaction. A row replaces the stored one only if its lastupdate is newer, or equally new with a newer version; older rows are skipped. The response counts the rows inserted, updated and skipped.",
                 "consumes": [
                     "application/json"
                 ],
@@ -6699,24 +6953,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tietoevry - Symptoms"
+                    "FIS - Result Management – Cross-Country"
                 ],
-                "summary": "Insert symptoms (bulk)",
+                "summary": "Sync Cross-Country results (bulk upsert)",
                 "parameters": [
                     {
-                        "description": "Symptom data",
-                        "name": "symptoms",
+                        "description": "Rows to upsert",
+                        "name": "results",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/swagger.TietoevrySymptomsBulkInput"
+                            "$ref": "#/definitions/swagger.FISSyncResultsCCInput"
                         }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Queue the request as an ingest job, see /ingest-jobs",
+                        "name": "async",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Symptoms processed successfully (idempotent operation)"
-                    },
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISSyncResponse"
+                        }
+                    },
+                    "202": {
+                        "description": "Queued as an ingest job (async mode)",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.IngestJobEnvelope"
+                        }
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -6756,14 +7025,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/tietoevry/test-results": {
-            "get": {
+        "/fis/sync/resultjp": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all test results for a specific user",
+                "description": "Inserts or updates many Ski Jumping results in one transaction. A row replaces the stored one only if its lastupdate is newer, or equally new with a newer version; older rows are skipped. The response counts the rows inserted, updated and skipped.",
                 "consumes": [
                     "application/json"
                 ],
@@ -6771,23 +7040,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tietoevry - Test_Results"
+                    "FIS - Result Management – Ski Jumping"
                 ],
-                "summary": "Get test results by user ID",
+                "summary": "Sync Ski Jumping results (bulk upsert)",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "User ID (UUID)",
-                        "name": "user_id",
-                        "in": "query",
-                        "required": true
+                        "description": "Rows to upsert",
+                        "name": "results",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISSyncResultsJPInput"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Queue the request as an ingest job, see /ingest-jobs",
+                        "name": "async",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.TietoevryTestResultResponse"
+                            "$ref": "#/definitions/swagger.FISSyncResponse"
+                        }
+                    },
+                    "202": {
+                        "description": "Queued as an ingest job (async mode)",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.IngestJobEnvelope"
                         }
                     },
                     "400": {
@@ -6808,6 +7091,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ConflictResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -6821,14 +7110,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/fis/sync/resultnk": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Insert multiple test results for users (idempotent)",
+                "description": "Inserts or updates many Nordic Combined results in one transaction. A row replaces the stored one only if its lastupdate is newer, or equally new with a newer version; older rows are skipped. The response counts the rows inserted, updated and skipped.",
                 "consumes": [
                     "application/json"
                 ],
@@ -6836,23 +7127,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tietoevry - Test_Results"
+                    "FIS - Result Management – Nordic Combined"
                 ],
-                "summary": "Insert test results (bulk)",
+                "summary": "Sync Nordic Combined results (bulk upsert)",
                 "parameters": [
                     {
-                        "description": "Test result data",
-                        "name": "test_results",
+                        "description": "Rows to upsert",
+                        "name": "results",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/swagger.TietoevryTestResultsBulkInput"
+                            "$ref": "#/definitions/swagger.FISSyncResultsNKInput"
                         }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Queue the request as an ingest job, see /ingest-jobs",
+                        "name": "async",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Test results processed successfully"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISSyncResponse"
+                        }
+                    },
+                    "202": {
+                        "description": "Queued as an ingest job (async mode)",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.IngestJobEnvelope"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -6893,14 +7199,13 @@ const docTemplate = `{
                 }
             }
         },
-        "/tietoevry/users": {
+        "/fis/{sector}/categories": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a single user by UUID",
                 "consumes": [
                     "application/json"
                 ],
@@ -6908,15 +7213,20 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tietoevry - User"
+                    "FIS - Sectors"
                 ],
-                "summary": "Get user by ID",
+                "summary": "Get the category codes of a sector",
                 "parameters": [
                     {
+                        "enum": [
+                            "cc",
+                            "jp",
+                            "nk"
+                        ],
                         "type": "string",
-                        "description": "User ID (UUID)",
-                        "name": "id",
-                        "in": "query",
+                        "description": "Sector code",
+                        "name": "sector",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -6924,7 +7234,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.TietoevryUserResponse"
+                            "$ref": "#/definitions/swagger.FISCategoriesCCResponse"
                         }
                     },
                     "400": {
@@ -6945,12 +7255,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.NotFoundResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -6964,14 +7268,15 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/fis/{sector}/disciplines": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Upserts a user with the provided data",
                 "consumes": [
                     "application/json"
                 ],
@@ -6979,23 +7284,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tietoevry - User"
+                    "FIS - Sectors"
                 ],
-                "summary": "Upsert user",
+                "summary": "Get the discipline codes of a sector",
                 "parameters": [
                     {
-                        "description": "User data",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/swagger.TietoevryUserUpsertInput"
-                        }
+                        "enum": [
+                            "cc",
+                            "jp",
+                            "nk"
+                        ],
+                        "type": "string",
+                        "description": "Sector code",
+                        "name": "sector",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "created"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISDisciplinesCCResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -7028,14 +7339,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/fis/{sector}/head-to-head": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Removes a user by ID",
+                "description": "Returns every race of the sector at least two of the competitors competed in, newest first, with their positions and their gaps to the best placed of them: behind is in seconds in Cross-Country and Nordic combined and in points in Ski Jumping, racepoints_behind in race points. records holds the head-to-head record of every pair in the races both competed in; a ranked result beats an unranked one.",
                 "consumes": [
                     "application/json"
                 ],
@@ -7043,24 +7356,70 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tietoevry - User"
+                    "FIS - Athlete"
                 ],
-                "summary": "Delete a user",
+                "summary": "Compare FIS competitors head to head",
                 "parameters": [
                     {
+                        "enum": [
+                            "cc",
+                            "jp",
+                            "nk"
+                        ],
                         "type": "string",
-                        "description": "User ID (UUID)",
-                        "name": "id",
+                        "description": "Sector code",
+                        "name": "sector",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "FIS codes, 2 to 10 (repeat or comma-separated)",
+                        "name": "fiscode",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Season code (repeat or comma-separated)",
+                        "name": "seasoncode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Discipline code (repeat or comma-separated)",
+                        "name": "disciplinecode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Category code (repeat or comma-separated)",
+                        "name": "catcode",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User deleted successfully"
-                    },
-                    "204": {
-                        "description": "No content, user not found"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISHeadToHeadResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -7095,14 +7454,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/utv/all": {
+        "/fis/{sector}/points": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns all entries of a specific data type for a user, across all wearable devices, optionally filtered by date range, paginated by limit (default 3) and offset.",
+                "description": "Computes the FIS points standings of the sector at date: per list discipline and gender, the average of the best race points plus race penalty of each competitor in the races valid for FIS points of the period before the date, counting only races with a FIS list and a known penalty, ranked overall (rank) and within the nation (nation_rank). Lower points are better. Only cc and nk have points lists; jp answers 400. With nationcode the list is the national ranking and top caps the national rank; with fiscode it is the standing of one competitor.",
                 "consumes": [
                     "application/json"
                 ],
@@ -7110,62 +7469,87 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - General"
+                    "FIS - Points"
                 ],
-                "summary": "Get all data by type",
+                "summary": "Get a FIS points list of a sector",
                 "parameters": [
                     {
+                        "enum": [
+                            "cc",
+                            "nk"
+                        ],
                         "type": "string",
-                        "description": "User ID (UUID)",
-                        "name": "user_id",
-                        "in": "query",
+                        "description": "Sector code",
+                        "name": "sector",
+                        "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Data type (e.g., 'sleep', 'activity')",
-                        "name": "type",
-                        "in": "query",
-                        "required": true
+                        "description": "List date (YYYY-MM-DD, default: today)",
+                        "name": "date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Months of results counted (default: 12, max: 60)",
+                        "name": "period_months",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of best results averaged (default: 5, max: 50)",
+                        "name": "best",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Results needed to be listed (default: 1, max: best)",
+                        "name": "min_results",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "List discipline code (repeat or comma-separated)",
+                        "name": "disciplinecode",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter data after this date (YYYY-MM-DD)",
-                        "name": "after_date",
+                        "description": "Gender",
+                        "name": "gender",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter data before this date (YYYY-MM-DD)",
-                        "name": "before_date",
+                        "description": "Nation code; ranks within the nation",
+                        "name": "nationcode",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "Limit the number of results returned (default: 3, max: 10)",
-                        "name": "limit",
+                        "description": "FIS Code; returns the standing of one competitor",
+                        "name": "fiscode",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "Offset for pagination (default: 0)",
-                        "name": "offset",
+                        "description": "Ranks listed per discipline and gender (default: 100, max: 1000)",
+                        "name": "top",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of data entries across devices",
+                        "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/swagger.LatestDataResponse"
-                            }
+                            "$ref": "#/definitions/swagger.FISPointsListResponse"
                         }
                     },
-                    "204": {
-                        "description": "No Content: No data available"
-                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -7184,12 +7568,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.InvalidDateRange"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -7205,14 +7583,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/utv/archinisis/sport_ids": {
+        "/fis/{sector}/points/history": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns distinct sport_id values from Archinisis tokens",
+                "description": "Computes the FIS points lists of the sector every step_months from from to to, as /fis/{sector}/points does, and returns the standings of the competitor in them.",
                 "consumes": [
                     "application/json"
                 ],
@@ -7220,81 +7598,86 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Archinisis"
+                    "FIS - Points"
                 ],
-                "summary": "List Archinisis sport IDs",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.SportIDsResponse"
-                        }
+                "summary": "Get the FIS points history of a competitor",
+                "parameters": [
+                    {
+                        "enum": [
+                            "cc",
+                            "nk"
+                        ],
+                        "type": "string",
+                        "description": "Sector code",
+                        "name": "sector",
+                        "in": "path",
+                        "required": true
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
-                        }
+                    {
+                        "type": "integer",
+                        "description": "FIS Code",
+                        "name": "fiscode",
+                        "in": "query",
+                        "required": true
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
-                        }
+                    {
+                        "type": "string",
+                        "description": "First list date (YYYY-MM-DD, default: two years before to)",
+                        "name": "from",
+                        "in": "query"
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.ForbiddenResponse"
-                        }
+                    {
+                        "type": "string",
+                        "description": "Last list date (YYYY-MM-DD, default: today)",
+                        "name": "to",
+                        "in": "query"
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
-                        }
+                    {
+                        "type": "integer",
+                        "description": "Months between lists (default: 1, max: 24)",
+                        "name": "step_months",
+                        "in": "query"
                     },
-                    "503": {
-                        "description": "Service Unavailable",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/utv/archinisis/status": {
-            "get": {
-                "security": [
                     {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Returns whether a user has connected their Archinisis account",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "UTV - Archinisis"
-                ],
-                "summary": "Check Archinisis connection",
-                "parameters": [
+                        "type": "integer",
+                        "description": "Months of results counted (default: 12, max: 60)",
+                        "name": "period_months",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of best results averaged (default: 5, max: 50)",
+                        "name": "best",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Results needed to be listed (default: 1, max: best)",
+                        "name": "min_results",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "List discipline code (repeat or comma-separated)",
+                        "name": "disciplinecode",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "User ID (UUID)",
-                        "name": "user_id",
-                        "in": "query",
-                        "required": true
+                        "description": "Gender",
+                        "name": "gender",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.ArchinisisStatusResponse"
+                            "$ref": "#/definitions/swagger.FISPointsHistoryResponse"
                         }
                     },
                     "400": {
@@ -7315,6 +7698,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -7330,14 +7719,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/utv/archinisis/token": {
-            "post": {
+        "/fis/{sector}/races": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Upserts a Archinisis token for a user",
+                "description": "Returns the races of the sector in a common shape; details holds the race with the fields of the sector, as on /racecc, /racejp or /racenk.",
                 "consumes": [
                     "application/json"
                 ],
@@ -7345,23 +7734,71 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Archinisis"
+                    "FIS - Sectors"
                 ],
-                "summary": "Upsert Archinisis token",
+                "summary": "Get list of races of a sector",
                 "parameters": [
                     {
-                        "description": "Archinisis token input",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/swagger.ArchinisisTokenInput"
-                        }
+                        "enum": [
+                            "cc",
+                            "jp",
+                            "nk"
+                        ],
+                        "type": "string",
+                        "description": "Sector code",
+                        "name": "sector",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Season code (repeat or comma-separated)",
+                        "name": "seasoncode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Discipline code (repeat or comma-separated)",
+                        "name": "disciplinecode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Category code (repeat or comma-separated)",
+                        "name": "catcode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default: 100, max: 1000)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from pagination.next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISRacesResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -7396,14 +7833,13 @@ const docTemplate = `{
                 }
             }
         },
-        "/utv/coachtech/data": {
+        "/fis/{sector}/races/{raceid}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns Coachtech data for a user",
                 "consumes": [
                     "application/json"
                 ],
@@ -7411,36 +7847,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Coachtech"
+                    "FIS - Sectors"
                 ],
-                "summary": "Get Coachtech data",
+                "summary": "Get a race of a sector",
                 "parameters": [
                     {
+                        "enum": [
+                            "cc",
+                            "jp",
+                            "nk"
+                        ],
                         "type": "string",
-                        "description": "User ID (UUID)",
-                        "name": "user_id",
-                        "in": "query",
+                        "description": "Sector code",
+                        "name": "sector",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Filter data after this date (YYYY-MM-DD)",
-                        "name": "after_date",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter data before this date (YYYY-MM-DD)",
-                        "name": "before_date",
-                        "in": "query"
+                        "type": "integer",
+                        "description": "Race ID",
+                        "name": "raceid",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
-                    },
-                    "204": {
-                        "description": "No Content"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISRaceEnvelope"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -7460,6 +7896,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -7475,14 +7917,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/utv/coachtech/insert": {
-            "post": {
+        "/fis/{sector}/races/{raceid}/results": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Adds a Coachtech ID and corresponding data for a user",
+                "description": "Returns the results of the race in a common shape; position and bib are strings in every sector, and details holds the result with the fields of the sector, as on /resultcc, /resultjp or /resultnk.",
                 "consumes": [
                     "application/json"
                 ],
@@ -7490,23 +7932,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Coachtech"
+                    "FIS - Sectors"
                 ],
-                "summary": "Insert Coachtech data",
+                "summary": "Get the results of a race of a sector",
                 "parameters": [
                     {
-                        "description": "Coachtech data input",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/swagger.CoachtechInsertInput"
-                        }
+                        "enum": [
+                            "cc",
+                            "jp",
+                            "nk"
+                        ],
+                        "type": "string",
+                        "description": "Sector code",
+                        "name": "sector",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Race ID",
+                        "name": "raceid",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISResultsResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -7526,6 +7981,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -7541,14 +8002,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/utv/coachtech/status": {
+        "/fis/{sector}/races/{raceid}/results/enriched": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns whether Coachtech data exists for a given user",
+                "description": "Returns the race with its results, the birthdate, ski club and nation of the competitors, and the FIS strings parsed: times in milliseconds with the gap to the winner in Cross-Country and Nordic combined, points with the gap to the winner in Ski Jumping, and the rank among the ranked results of the same nation. Jumping results have a breakdown per round with the five judge marks and the judge points computed from them (the highest and lowest mark dropped).",
                 "consumes": [
                     "application/json"
                 ],
@@ -7556,15 +8017,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Coachtech"
+                    "FIS - Sectors"
                 ],
-                "summary": "Check Coachtech data availability",
+                "summary": "Get the enriched results of a race of a sector",
                 "parameters": [
                     {
+                        "enum": [
+                            "cc",
+                            "jp",
+                            "nk"
+                        ],
                         "type": "string",
-                        "description": "User ID (UUID)",
-                        "name": "user_id",
-                        "in": "query",
+                        "description": "Sector code",
+                        "name": "sector",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Race ID",
+                        "name": "raceid",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -7572,7 +8045,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.CoachtechStatusResponse"
+                            "$ref": "#/definitions/swagger.FISEnrichedResultsResponse"
                         }
                     },
                     "400": {
@@ -7593,6 +8066,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -7608,14 +8087,13 @@ const docTemplate = `{
                 }
             }
         },
-        "/utv/data4update": {
+        "/fis/{sector}/results": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns token records where 'data_last_fetched' is older than the cutoff",
                 "consumes": [
                     "application/json"
                 ],
@@ -7623,43 +8101,84 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - General"
+                    "FIS - Sectors"
                 ],
-                "summary": "Get data for update",
+                "summary": "Get the results of an athlete in a sector",
                 "parameters": [
                     {
+                        "enum": [
+                            "cc",
+                            "jp",
+                            "nk"
+                        ],
                         "type": "string",
-                        "description": "Source (one of: 'polar', 'oura', 'suunto', 'garmin')",
-                        "name": "source",
-                        "in": "query",
+                        "description": "Sector code",
+                        "name": "sector",
+                        "in": "path",
                         "required": true
                     },
                     {
                         "type": "integer",
-                        "description": "Number of hours to look back (1-8760)",
-                        "name": "hours",
+                        "description": "FIS Code",
+                        "name": "fiscode",
                         "in": "query",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "List of tokens needing data update",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/swagger.PolarTokenInput"
-                            }
-                        }
-                    },
-                    "204": {
-                        "description": "No Content: No data found"
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
-                        }
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Season code (repeat or comma-separated)",
+                        "name": "seasoncode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Discipline code (repeat or comma-separated)",
+                        "name": "disciplinecode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Category code (repeat or comma-separated)",
+                        "name": "catcode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default: 100, max: 1000)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from pagination.next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISAthleteResultsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
                     },
                     "401": {
                         "description": "Unauthorized",
@@ -7673,6 +8192,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -7688,14 +8213,13 @@ const docTemplate = `{
                 }
             }
         },
-        "/utv/disconnect": {
-            "delete": {
+        "/fis/{sector}/seasons": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Disconnects a user's wearable device by deleting the associated token",
                 "consumes": [
                     "application/json"
                 ],
@@ -7703,28 +8227,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - General"
+                    "FIS - Sectors"
                 ],
-                "summary": "Disconnect a wearable device",
+                "summary": "Get the season codes of a sector",
                 "parameters": [
                     {
+                        "enum": [
+                            "cc",
+                            "jp",
+                            "nk"
+                        ],
                         "type": "string",
-                        "description": "User ID (UUID)",
-                        "name": "user_id",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Source device to disconnect (one of: 'polar', 'oura', 'suunto', 'garmin', 'klab', 'archinisis')",
-                        "name": "source",
-                        "in": "query",
+                        "description": "Sector code",
+                        "name": "sector",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully disconnected"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FISSeasonsCCResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -7759,56 +8284,68 @@ const docTemplate = `{
                 }
             }
         },
-        "/utv/garmin/data": {
+        "/health": {
+            "get": {
+                "description": "Healthcheck endpoint",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Healthcheck",
+                "responses": {
+                    "200": {
+                        "description": "Health status",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HealthStatusResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/ingest-jobs": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns data for the specified user on the specified date (optionally filtered by key)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Lists the ingest jobs of the calling client, newest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Garmin"
+                    "Ingest jobs"
                 ],
-                "summary": "Get available data",
+                "summary": "List ingest jobs",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "User ID (UUID)",
-                        "name": "user_id",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Date (YYYY-MM-DD)",
-                        "name": "date",
-                        "in": "query",
-                        "required": true
+                        "type": "integer",
+                        "description": "Page size (default 100, max 1000)",
+                        "name": "limit",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Type",
-                        "name": "key",
+                        "description": "Cursor from the previous page",
+                        "name": "cursor",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Data",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.GarminDataResponse"
+                            "$ref": "#/definitions/swagger.IngestJobListResponse"
                         }
                     },
-                    "204": {
-                        "description": "No Content: No data found"
-                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -7821,12 +8358,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.UnauthorizedResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.ForbiddenResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -7840,38 +8371,38 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/ingest-jobs/{id}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Posts Garmin data for the specified user on the specified date",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Returns the status, progress and per-record errors of an ingest job of the calling client",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Garmin"
+                    "Ingest jobs"
                 ],
-                "summary": "Post Garmin data",
+                "summary": "Get an ingest job",
                 "parameters": [
                     {
-                        "description": "Garmin data input",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/swagger.GarminPostDataInput"
-                        }
+                        "type": "string",
+                        "description": "Ingest job ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created: Data successfully stored (no content in response body)"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.IngestJobEnvelope"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -7885,10 +8416,10 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.UnauthorizedResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
                         }
                     },
                     "500": {
@@ -7904,39 +8435,38 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/ingest-jobs/{id}/retry": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes all Garmin data entries for a specific user",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Queues a failed ingest job again, e.g. after creating the users its records refer to. A job processed in batches resumes after the last batch written.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Garmin"
+                    "Ingest jobs"
                 ],
-                "summary": "Delete all Garmin data for a user",
+                "summary": "Retry an ingest job",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID (UUID)",
-                        "name": "user_id",
-                        "in": "query",
+                        "description": "Ingest job ID (UUID)",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Deleted: Data successfully removed"
-                    },
-                    "204": {
-                        "description": "No Content: No matching data"
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.IngestJobEnvelope"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -7950,10 +8480,16 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.UnauthorizedResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ConflictResponse"
                         }
                     },
                     "500": {
@@ -7971,14 +8507,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/utv/garmin/dates": {
-            "get": {
+        "/kamk/delete-quiz": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns available dates for the specified user (optionally filtered by date range)",
+                "description": "Deletes a questionnaire row (competitor_id=user_id AND id=id)",
                 "consumes": [
                     "application/json"
                 ],
@@ -7986,39 +8522,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Garmin"
+                    "KAMK - Queries"
                 ],
-                "summary": "Get available dates",
+                "summary": "Delete a questionnaire by id",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "User ID (UUID)",
+                        "type": "integer",
+                        "description": "sportti_id",
                         "name": "user_id",
                         "in": "query",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Filter dates after this date (YYYY-MM-DD)",
-                        "name": "after_date",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter dates before this date (YYYY-MM-DD)",
-                        "name": "before_date",
-                        "in": "query"
+                        "type": "integer",
+                        "description": "Questionnaire ID",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of available dates",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.DatesResponse"
-                        }
-                    },
-                    "204": {
-                        "description": "No Content: No available dates found"
+                        "description": "OK: deleted"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -8038,10 +8563,10 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/swagger.InvalidDateRange"
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
                         }
                     },
                     "500": {
@@ -8059,14 +8584,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/utv/garmin/status": {
+        "/kamk/injury": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns whether a user has connected their Garmin account and whether any Garmin data exists",
+                "description": "Returns active injuries (status=0) for a competitor",
                 "consumes": [
                     "application/json"
                 ],
@@ -8074,13 +8599,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Garmin"
+                    "KAMK - Injuries"
                 ],
-                "summary": "Check Garmin connection \u0026 data status",
+                "summary": "List active injuries",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "User ID (UUID)",
+                        "type": "integer",
+                        "description": "Competitor sportti_id",
                         "name": "user_id",
                         "in": "query",
                         "required": true
@@ -8090,9 +8615,12 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.GarminStatusResponse"
+                            "$ref": "#/definitions/swagger.KamkInjuriesListResponse"
                         }
                     },
+                    "204": {
+                        "description": "No Content: no injuries"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -8124,16 +8652,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/utv/garmin/token": {
+            },
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Saves or updates the Garmin token for a user",
+                "description": "Creates a new injury row (status=0, date_start=NOW()) for a competitor",
                 "consumes": [
                     "application/json"
                 ],
@@ -8141,23 +8667,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Garmin"
+                    "KAMK - Injuries"
                 ],
-                "summary": "Save or update Garmin token",
+                "summary": "Create injury",
                 "parameters": [
                     {
-                        "description": "Garmin token input",
+                        "description": "Injury payload",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/swagger.GarminTokenInput"
+                            "$ref": "#/definitions/swagger.KamkAddInjuryRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Created"
+                        "description": "Created: Injury stored (no content in response body)"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -8190,16 +8716,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/utv/garmin/token-exists": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Checks whether a given Garmin access token is stored in the system",
+                "description": "Deletes a single injury for a competitor",
                 "consumes": [
                     "application/json"
                 ],
@@ -8207,24 +8731,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Garmin"
+                    "KAMK - Injuries"
                 ],
-                "summary": "Check if Garmin token exists",
+                "summary": "Delete an injury by injury_id",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Garmin access token",
-                        "name": "token",
+                        "type": "integer",
+                        "description": "sportti_id",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Injury ID",
+                        "name": "injury_id",
                         "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.GarminTokenExistsResponse"
-                        }
+                        "description": "OK: deleted"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -8244,6 +8772,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -8259,14 +8793,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/utv/garmin/types": {
+        "/kamk/injury-id": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns available types for the specified user on the specified date",
+                "description": "Returns the current maximum injury_id for a competitor (0 if none exist)",
                 "consumes": [
                     "application/json"
                 ],
@@ -8274,35 +8808,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Garmin"
+                    "KAMK - Injuries"
                 ],
-                "summary": "Get available types",
+                "summary": "Get next injury id helper",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "User ID (UUID)",
+                        "type": "integer",
+                        "description": "Competitor sportti_id",
                         "name": "user_id",
                         "in": "query",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Date (YYYY-MM-DD)",
-                        "name": "date",
-                        "in": "query",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of available types",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.GarminTypesResponse"
+                            "$ref": "#/definitions/swagger.KamkMaxInjuryIDResponse"
                         }
                     },
-                    "204": {
-                        "description": "No Content: No available types found"
-                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -8336,14 +8860,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/utv/garmin/user-id-by-token": {
-            "get": {
+        "/kamk/injury-recovered": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns the user ID associated with a given Garmin access token",
+                "description": "Sets status=1 and date_end=NOW() for an injury recovery",
                 "consumes": [
                     "application/json"
                 ],
@@ -8351,24 +8875,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Garmin"
+                    "KAMK - Injuries"
                 ],
-                "summary": "Get user_id by Garmin access token",
+                "summary": "Mark injury recovered",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Garmin access token",
-                        "name": "token",
-                        "in": "query",
-                        "required": true
+                        "description": "Recovery payload",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/swagger.KamkMarkRecoveredRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.GarminUserIDResponse"
-                        }
+                        "description": "OK: Marked recovered"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -8403,14 +8926,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/utv/klab/sport_ids": {
+        "/kamk/is-quiz-done": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns distinct sport_id values from Klab tokens",
+                "description": "Return the quiz if it was done today",
                 "consumes": [
                     "application/json"
                 ],
@@ -8418,16 +8941,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Klab"
+                    "KAMK - Queries"
+                ],
+                "summary": "Check if certain quiz is due for the user. Used for daily quizzes.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "sportti_id",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Quiz type",
+                        "name": "quiz_type",
+                        "in": "query",
+                        "required": true
+                    }
                 ],
-                "summary": "List Klab sport IDs",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.SportIDsResponse"
+                            "$ref": "#/definitions/swagger.KamkQuestionnairesListResponse"
                         }
                     },
+                    "204": {
+                        "description": "No Content: no rows today"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -8461,40 +9003,51 @@ const docTemplate = `{
                 }
             }
         },
-        "/utv/klab/status": {
+        "/kamk/questionnaire": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns whether a user has connected their Klab account",
+                "description": "Returns questionnaires for a competitor ordered by timestamp DESC",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/csv",
+                    "application/vnd.apache.parquet"
                 ],
                 "tags": [
-                    "UTV - Klab"
+                    "KAMK - Queries"
                 ],
-                "summary": "Check Klab connection",
+                "summary": "List questionnaires",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "User ID (UUID)",
+                        "type": "integer",
+                        "description": "sportti_id",
                         "name": "user_id",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Response format: json (default), csv or parquet; also negotiated with Accept",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.KlabStatusResponse"
+                            "$ref": "#/definitions/swagger.KamkQuestionnairesListResponse"
                         }
                     },
+                    "204": {
+                        "description": "No Content: no rows"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -8526,16 +9079,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/utv/klab/token": {
+            },
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Upserts a Klab token for a user",
+                "description": "Inserts a questionnaire row for a competitor (timestamp=NOW())",
                 "consumes": [
                     "application/json"
                 ],
@@ -8543,23 +9094,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Klab"
+                    "KAMK - Queries"
                 ],
-                "summary": "Upsert Klab token",
+                "summary": "Create questionnaire entry",
                 "parameters": [
                     {
-                        "description": "Klab token input",
+                        "description": "Questionnaire payload",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/swagger.KlabTokenInput"
+                            "$ref": "#/definitions/swagger.KamkAddQuestionnaireRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Created"
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.KamkCreateQuestionnaireResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -8594,14 +9148,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/utv/latest": {
-            "get": {
+        "/kamk/update-quiz": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns latest entries of a specific type for a user, optionally filtered by device and limited in number (defaults to 1).",
+                "description": "Updates the questionnaire row identified by id (and user_id)",
                 "consumes": [
                     "application/json"
                 ],
@@ -8609,49 +9163,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - General"
+                    "KAMK - Queries"
                 ],
-                "summary": "Get latest data by type",
+                "summary": "Update questionnaire by id",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "User ID (UUID)",
+                        "type": "integer",
+                        "description": "sportti_id",
                         "name": "user_id",
                         "in": "query",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Data type (e.g., 'sleep', 'activity')",
-                        "name": "type",
+                        "type": "integer",
+                        "description": "Questionnaire ID",
+                        "name": "id",
                         "in": "query",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Device type (one of: 'garmin', 'oura', 'polar', 'suunto')",
-                        "name": "device",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limit the number of results (default: 1, max: 100)",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Update payload",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/swagger.KamkUpdateQuestionnaireBody"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Latest Data",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/swagger.LatestDataResponse"
-                            }
-                        }
-                    },
-                    "204": {
-                        "description": "No Content: No data found"
+                        "description": "OK: updated"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -8671,6 +9213,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -8686,56 +9234,67 @@ const docTemplate = `{
                 }
             }
         },
-        "/utv/oura/data": {
+        "/klab/data": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns data for the specified user on the specified date (optionally filtered by key)",
+                "description": "Returns a page of measurement_list + the child tables of those measurements for the given customer (no customer row)",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/x-ndjson",
+                    "text/csv",
+                    "application/vnd.apache.parquet"
                 ],
                 "tags": [
-                    "UTV - Oura"
+                    "KLAB - Data"
                 ],
-                "summary": "Get available data",
+                "summary": "Get kLab data by Sportti ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID (UUID)",
-                        "name": "user_id",
+                        "description": "Sportti ID",
+                        "name": "id",
                         "in": "query",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Date (YYYY-MM-DD)",
-                        "name": "date",
-                        "in": "query",
-                        "required": true
+                        "type": "integer",
+                        "description": "Measurements per page (default: 100, max: 1000)",
+                        "name": "limit",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Type",
-                        "name": "key",
+                        "description": "Opaque cursor from pagination.next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Child table to export as CSV or Parquet (dirteststeps, dirresults)",
+                        "name": "table",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Response format: json (default), csv or parquet; also negotiated with Accept",
+                        "name": "format",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Data",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.OuraDataResponse"
+                            "$ref": "#/definitions/swagger.KlabDataResponse"
                         }
                     },
-                    "204": {
-                        "description": "No Content: No data found"
-                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -8754,6 +9313,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -8774,7 +9339,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Posts Oura data for the specified user on the specified date",
+                "description": "Upsert a full k-Lab bundle for a single customer. The JSON root object key must be the customer id.",
                 "consumes": [
                     "application/json"
                 ],
@@ -8782,23 +9347,47 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Oura"
+                    "KLAB - Data"
                 ],
-                "summary": "Post Oura data",
+                "summary": "Upsert k-Lab data (one customer per request)",
                 "parameters": [
                     {
-                        "description": "Oura data input",
-                        "name": "body",
+                        "description": "klab data",
+                        "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/swagger.OuraPostDataInput"
+                            "$ref": "#/definitions/swagger.KlabDataBulkDoc"
                         }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Write every item on its own and report the outcome of each",
+                        "name": "partial",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Queue the request as an ingest job, see /ingest-jobs",
+                        "name": "async",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Created: Data successfully stored (no content in response body)"
+                        "description": "Data processed successfully"
+                    },
+                    "202": {
+                        "description": "Queued as an ingest job (async mode)",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.IngestJobEnvelope"
+                        }
+                    },
+                    "207": {
+                        "description": "Some items failed (partial mode)",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.BulkReport"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -8831,14 +9420,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/klab/user": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes all Oura data entries for a specific user",
+                "description": "Retrieve a single KLAB customer by sportti_id",
                 "consumes": [
                     "application/json"
                 ],
@@ -8846,24 +9437,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Oura"
+                    "KLAB - User"
                 ],
-                "summary": "Delete all Oura data for a user",
+                "summary": "Get customer by Sportti ID",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "User ID (UUID)",
-                        "name": "user_id",
+                        "type": "integer",
+                        "description": "Sportti ID",
+                        "name": "id",
                         "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Deleted: Data successfully removed"
-                    },
-                    "204": {
-                        "description": "No Content: No matching data"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UserKlabResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -8883,6 +9474,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -8896,16 +9493,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/utv/oura/dates": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns available dates for the specified user (optionally filtered by date range)",
+                "description": "Removes a KLab customer by sportti_id. Related measurement_list and dir* tables are removed via FK cascades.",
                 "consumes": [
                     "application/json"
                 ],
@@ -8913,39 +9508,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Oura"
+                    "KLAB - User"
                 ],
-                "summary": "Get available dates",
+                "summary": "Delete a customer (hard delete)",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID (UUID)",
-                        "name": "user_id",
+                        "description": "Sportti ID",
+                        "name": "sportti_id",
                         "in": "query",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter dates after this date (YYYY-MM-DD)",
-                        "name": "after_date",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter dates before this date (YYYY-MM-DD)",
-                        "name": "before_date",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of available dates",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.DatesResponse"
-                        }
-                    },
-                    "204": {
-                        "description": "No Content: No available dates found"
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -8965,10 +9542,10 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/swagger.InvalidDateRange"
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
                         }
                     },
                     "500": {
@@ -8986,14 +9563,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/utv/oura/status": {
+        "/ready": {
+            "get": {
+                "description": "Returns 503 once the server has started draining for shutdown so load balancers stop routing new requests to it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Readiness check",
+                "responses": {
+                    "200": {
+                        "description": "Ready to receive traffic",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ReadinessResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Draining for shutdown",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ReadinessResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tietoevry/activity-zones": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns whether a user has connected their Oura account and whether any Oura data exists",
+                "description": "Get all activity zones for a specific user",
                 "consumes": [
                     "application/json"
                 ],
@@ -9001,9 +9604,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Oura"
+                    "Tietoevry - Activity_Zones"
                 ],
-                "summary": "Check Oura connection \u0026 data status",
+                "summary": "Get activity zones by user ID",
                 "parameters": [
                     {
                         "type": "string",
@@ -9011,13 +9614,43 @@ const docTemplate = `{
                         "name": "user_id",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only rows on or after this day (date, YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only rows on or before this day (date, YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by source",
+                        "name": "source",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default: 100, max: 1000)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from pagination.next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.OuraStatusResponse"
+                            "$ref": "#/definitions/swagger.TietoevryActivityZoneResponse"
                         }
                     },
                     "400": {
@@ -9051,16 +9684,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/utv/oura/token": {
+            },
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Saves or updates the Oura token for a user",
+                "description": "Insert multiple activity zone summaries for user (idempotent)",
                 "consumes": [
                     "application/json"
                 ],
@@ -9068,23 +9699,47 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Oura"
+                    "Tietoevry - Activity_Zones"
                 ],
-                "summary": "Save or update Oura token",
+                "summary": "Insert activity zones (bulk)",
                 "parameters": [
                     {
-                        "description": "Oura token input",
-                        "name": "body",
+                        "description": "Activity zone summaries",
+                        "name": "activity_zones",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/swagger.OuraTokenInput"
+                            "$ref": "#/definitions/swagger.TietoevryActivityZonesBulkInput"
                         }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Write every item on its own and report the outcome of each",
+                        "name": "partial",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Queue the request as an ingest job, see /ingest-jobs",
+                        "name": "async",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Created"
+                        "description": "Activity zones processed successfully (idempotent operation)"
+                    },
+                    "202": {
+                        "description": "Queued as an ingest job (async mode)",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.IngestJobEnvelope"
+                        }
+                    },
+                    "207": {
+                        "description": "Some items failed (partial mode)",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.BulkReport"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -9104,6 +9759,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ConflictResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -9119,44 +9780,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/utv/oura/token-by-id": {
+        "/tietoevry/deleted-users": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves the user ID and token data associated with a specific Oura ID",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Returns a list of deleted users with timestamps",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Oura"
-                ],
-                "summary": "Get Oura token by Oura ID",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Oura ID",
-                        "name": "oura_id",
-                        "in": "query",
-                        "required": true
-                    }
+                    "Tietoevry - User"
                 ],
+                "summary": "List deleted users",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.OuraTokenByIDResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                            "$ref": "#/definitions/swagger.TietoevryDeletedUsersResponse"
                         }
                     },
                     "401": {
@@ -9186,24 +9829,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/utv/oura/types": {
+        "/tietoevry/exercises": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns available types for the specified user on the specified date",
+                "description": "Get exercises for a specific user, newest first. HR zones, samples and sections are only loaded when listed in include",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "UTV - Oura"
+                    "Tietoevry - Exercise"
                 ],
-                "summary": "Get available types",
+                "summary": "Get exercises by user ID",
                 "parameters": [
                     {
                         "type": "string",
@@ -9214,105 +9858,54 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Date (YYYY-MM-DD)",
-                        "name": "date",
-                        "in": "query",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "List of available types",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.OuraTypesResponse"
-                        }
-                    },
-                    "204": {
-                        "description": "No Content: No available types found"
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
-                        }
-                    },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.ForbiddenResponse"
-                        }
+                        "description": "Only rows on or after this UTC day (start_time, YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
-                        }
+                    {
+                        "type": "string",
+                        "description": "Only rows on or before this UTC day (start_time, YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
                     },
-                    "503": {
-                        "description": "Service Unavailable",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/utv/polar/data": {
-            "get": {
-                "security": [
                     {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Returns data for the specified user on the specified date (optionally filtered by key)",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "UTV - Polar"
-                ],
-                "summary": "Get available data",
-                "parameters": [
+                        "type": "string",
+                        "description": "Filter by source",
+                        "name": "source",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "User ID (UUID)",
-                        "name": "user_id",
-                        "in": "query",
-                        "required": true
+                        "description": "Filter by sport type",
+                        "name": "sport_type",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Date (YYYY-MM-DD)",
-                        "name": "date",
-                        "in": "query",
-                        "required": true
+                        "description": "Child rows to load: comma-separated hr_zones, samples, sections",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default: 100, max: 1000)",
+                        "name": "limit",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Type",
-                        "name": "key",
+                        "description": "Opaque cursor from pagination.next_cursor of the previous page",
+                        "name": "cursor",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Data",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.PolarDataResponse"
+                            "$ref": "#/definitions/swagger.TietoevryExerciseResponse"
                         }
                     },
-                    "204": {
-                        "description": "No Content: No data found"
-                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -9351,7 +9944,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Posts Polar data for the specified user on the specified date",
+                "description": "Insert multiple exercise bundles for user (idempotent)",
                 "consumes": [
                     "application/json"
                 ],
@@ -9359,23 +9952,47 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Polar"
+                    "Tietoevry - Exercise"
                 ],
-                "summary": "Post Polar data",
+                "summary": "Insert exercise (bulk)",
                 "parameters": [
                     {
-                        "description": "Polar data input",
-                        "name": "body",
+                        "description": "Exercise data",
+                        "name": "exercise",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/swagger.PolarPostDataInput"
+                            "$ref": "#/definitions/swagger.TietoevryExercisesBulkInput"
                         }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Write every item on its own and report the outcome of each",
+                        "name": "partial",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Queue the request as an ingest job, see /ingest-jobs",
+                        "name": "async",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Created: Data successfully stored (no content in response body)"
+                        "description": "Exercises processed successfully (idempotent operation)"
+                    },
+                    "202": {
+                        "description": "Queued as an ingest job (async mode)",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.IngestJobEnvelope"
+                        }
+                    },
+                    "207": {
+                        "description": "Some items failed (partial mode)",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.BulkReport"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -9395,6 +10012,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ConflictResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -9408,24 +10031,28 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/tietoevry/measurements": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes all Polar data entries for a specific user",
+                "description": "Get all measurements for a specific user",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/csv",
+                    "application/vnd.apache.parquet"
                 ],
                 "tags": [
-                    "UTV - Polar"
+                    "Tietoevry - Measurements"
                 ],
-                "summary": "Delete all Polar data for a user",
+                "summary": "Get measurements by user ID",
                 "parameters": [
                     {
                         "type": "string",
@@ -9433,14 +10060,56 @@ const docTemplate = `{
                         "name": "user_id",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only rows on or after this day (date, YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only rows on or before this day (date, YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by source",
+                        "name": "source",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by measurement name",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default: 100, max: 1000)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from pagination.next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Response format: json (default), csv or parquet; also negotiated with Accept",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Deleted: Data successfully removed"
-                    },
-                    "204": {
-                        "description": "No Content: No matching data"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.TietoevryMeasurementResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -9473,16 +10142,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/utv/polar/dates": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns available dates for the specified user (optionally filtered by date range)",
+                "description": "Insert multiple measurements for user (idempotent)",
                 "consumes": [
                     "application/json"
                 ],
@@ -9490,39 +10157,47 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Polar"
+                    "Tietoevry - Measurements"
                 ],
-                "summary": "Get available dates",
+                "summary": "Insert measurements (bulk)",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "User ID (UUID)",
-                        "name": "user_id",
-                        "in": "query",
-                        "required": true
+                        "description": "Measurement data",
+                        "name": "measurements",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/swagger.TietoevryMeasurementsBulkInput"
+                        }
                     },
                     {
-                        "type": "string",
-                        "description": "Filter dates after this date (YYYY-MM-DD)",
-                        "name": "after_date",
+                        "type": "boolean",
+                        "description": "Write every item on its own and report the outcome of each",
+                        "name": "partial",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Filter dates before this date (YYYY-MM-DD)",
-                        "name": "before_date",
+                        "type": "boolean",
+                        "description": "Queue the request as an ingest job, see /ingest-jobs",
+                        "name": "async",
                         "in": "query"
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "List of available dates",
+                    "201": {
+                        "description": "Measurements processed successfully"
+                    },
+                    "202": {
+                        "description": "Queued as an ingest job (async mode)",
                         "schema": {
-                            "$ref": "#/definitions/swagger.DatesResponse"
+                            "$ref": "#/definitions/swagger.IngestJobEnvelope"
                         }
                     },
-                    "204": {
-                        "description": "No Content: No available dates found"
+                    "207": {
+                        "description": "Some items failed (partial mode)",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.BulkReport"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -9542,10 +10217,10 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
-                            "$ref": "#/definitions/swagger.InvalidDateRange"
+                            "$ref": "#/definitions/swagger.ConflictResponse"
                         }
                     },
                     "500": {
@@ -9563,14 +10238,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/utv/polar/status": {
+        "/tietoevry/questionnaires": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns whether a user has connected their Polar account and whether any Polar data exists",
+                "description": "Get all questionnaire answers for a specific user",
                 "consumes": [
                     "application/json"
                 ],
@@ -9578,9 +10253,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Polar"
+                    "Tietoevry - Questionnaires"
                 ],
-                "summary": "Check Polar connection \u0026 data status",
+                "summary": "Get questionnaires by user ID",
                 "parameters": [
                     {
                         "type": "string",
@@ -9588,13 +10263,43 @@ const docTemplate = `{
                         "name": "user_id",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only rows on or after this UTC day (created_at, YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only rows on or before this UTC day (created_at, YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by questionnaire key",
+                        "name": "questionnaire_key",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default: 100, max: 1000)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from pagination.next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.PolarStatusResponse"
+                            "$ref": "#/definitions/swagger.TietoevryQuestionnaireAnswerResponse"
                         }
                     },
                     "400": {
@@ -9628,16 +10333,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/utv/polar/token": {
+            },
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Upserts the Polar token details for a specific user",
+                "description": "Insert multiple questionnaire answers for users (idempotent)",
                 "consumes": [
                     "application/json"
                 ],
@@ -9645,23 +10348,47 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Polar"
+                    "Tietoevry - Questionnaires"
                 ],
-                "summary": "Save or update Polar token",
+                "summary": "Insert questionnaire answers (bulk)",
                 "parameters": [
                     {
-                        "description": "Polar token input",
-                        "name": "body",
+                        "description": "Questionnaire answers",
+                        "name": "questionnaires",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/swagger.PolarTokenInput"
+                            "$ref": "#/definitions/swagger.TietoevryQuestionnaireAnswersBulkInput"
                         }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Write every item on its own and report the outcome of each",
+                        "name": "partial",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Queue the request as an ingest job, see /ingest-jobs",
+                        "name": "async",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Created"
+                        "description": "Questionnaire answers processed successfully"
+                    },
+                    "202": {
+                        "description": "Queued as an ingest job (async mode)",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.IngestJobEnvelope"
+                        }
+                    },
+                    "207": {
+                        "description": "Some items failed (partial mode)",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.BulkReport"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -9681,6 +10408,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ConflictResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -9696,38 +10429,82 @@ const docTemplate = `{
                 }
             }
         },
-        "/utv/polar/token-by-id": {
+        "/tietoevry/symptoms": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns user_id and token data associated with a given Polar x_user_id",
+                "description": "Get all symptoms for a specific user",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/csv",
+                    "application/vnd.apache.parquet"
                 ],
                 "tags": [
-                    "UTV - Polar"
+                    "Tietoevry - Symptoms"
                 ],
-                "summary": "Get user token by Polar ID",
+                "summary": "Get symptoms by user ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Polar x_user_id",
-                        "name": "polar_id",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only rows on or after this day (date, YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only rows on or before this day (date, YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by source",
+                        "name": "source",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by symptom",
+                        "name": "symptom",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default: 100, max: 1000)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from pagination.next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Response format: json (default), csv or parquet; also negotiated with Accept",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.PolarTokenByIDResponse"
+                            "$ref": "#/definitions/swagger.TietoevrySymptomResponse"
                         }
                     },
                     "400": {
@@ -9761,16 +10538,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/utv/polar/types": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns available types for the specified user on the specified date",
+                "description": "Insert multiple symptoms for user (idempotent)",
                 "consumes": [
                     "application/json"
                 ],
@@ -9778,34 +10553,47 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Polar"
+                    "Tietoevry - Symptoms"
                 ],
-                "summary": "Get available types",
+                "summary": "Insert symptoms (bulk)",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "User ID (UUID)",
-                        "name": "user_id",
-                        "in": "query",
-                        "required": true
+                        "description": "Symptom data",
+                        "name": "symptoms",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/swagger.TietoevrySymptomsBulkInput"
+                        }
                     },
                     {
-                        "type": "string",
-                        "description": "Date (YYYY-MM-DD)",
-                        "name": "date",
-                        "in": "query",
-                        "required": true
+                        "type": "boolean",
+                        "description": "Write every item on its own and report the outcome of each",
+                        "name": "partial",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Queue the request as an ingest job, see /ingest-jobs",
+                        "name": "async",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "List of available types",
+                    "201": {
+                        "description": "Symptoms processed successfully (idempotent operation)"
+                    },
+                    "202": {
+                        "description": "Queued as an ingest job (async mode)",
                         "schema": {
-                            "$ref": "#/definitions/swagger.PolarTypesResponse"
+                            "$ref": "#/definitions/swagger.IngestJobEnvelope"
                         }
                     },
-                    "204": {
-                        "description": "No Content: No available types found"
+                    "207": {
+                        "description": "Some items failed (partial mode)",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.BulkReport"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -9825,6 +10613,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ConflictResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -9840,29 +10634,62 @@ const docTemplate = `{
                 }
             }
         },
-        "/utv/source_cache/data-types": {
+        "/tietoevry/test-results": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns data types. If source is provided, returns only that source's keys.",
+                "description": "Get all test results for a specific user",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/csv",
+                    "application/vnd.apache.parquet"
                 ],
                 "tags": [
-                    "UTV - Source_Cache"
+                    "Tietoevry - Test_Results"
                 ],
-                "summary": "Get data types (source_cache)",
+                "summary": "Get test results by user ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Source name (e.g., oura, garmin, polar, suunto)",
-                        "name": "source",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only rows on or after this UTC day (timestamp, YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only rows on or before this UTC day (timestamp, YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default: 100, max: 1000)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from pagination.next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Response format: json (default), csv or parquet; also negotiated with Accept",
+                        "name": "format",
                         "in": "query"
                     }
                 ],
@@ -9870,7 +10697,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.SourceCacheSingleResponse"
+                            "$ref": "#/definitions/swagger.TietoevryTestResultResponse"
                         }
                     },
                     "400": {
@@ -9891,12 +10718,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.NotFoundResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -9917,7 +10738,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Replaces the entire key list for the given source. One source per request.",
+                "description": "Insert multiple test results for users (idempotent)",
                 "consumes": [
                     "application/json"
                 ],
@@ -9925,23 +10746,47 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Source_Cache"
+                    "Tietoevry - Test_Results"
                 ],
-                "summary": "Replace keys for a source (upsert)",
+                "summary": "Insert test results (bulk)",
                 "parameters": [
                     {
-                        "description": "source + data array",
-                        "name": "body",
+                        "description": "Test result data",
+                        "name": "test_results",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/swagger.SourceCacheUpsertInput"
+                            "$ref": "#/definitions/swagger.TietoevryTestResultsBulkInput"
                         }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Write every item on its own and report the outcome of each",
+                        "name": "partial",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Queue the request as an ingest job, see /ingest-jobs",
+                        "name": "async",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Created"
+                        "description": "Test results processed successfully"
+                    },
+                    "202": {
+                        "description": "Queued as an ingest job (async mode)",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.IngestJobEnvelope"
+                        }
+                    },
+                    "207": {
+                        "description": "Some items failed (partial mode)",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.BulkReport"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -9961,23 +10806,35 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ConflictResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/swagger.InternalServerErrorResponse"
                         }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
                     }
                 }
             }
         },
-        "/utv/suunto/data": {
+        "/tietoevry/users": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns data for the specified user on the specified date (optionally filtered by key)",
+                "description": "Retrieve a single user by UUID",
                 "consumes": [
                     "application/json"
                 ],
@@ -9985,41 +10842,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Suunto"
+                    "Tietoevry - User"
                 ],
-                "summary": "Get available data",
+                "summary": "Get user by ID",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "User ID (UUID)",
-                        "name": "user_id",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Date (YYYY-MM-DD)",
-                        "name": "date",
+                        "name": "id",
                         "in": "query",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Type",
-                        "name": "key",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Data",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.SuuntoDataResponse"
+                            "$ref": "#/definitions/swagger.TietoevryUserResponse"
                         }
                     },
-                    "204": {
-                        "description": "No Content: No data found"
-                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -10038,6 +10879,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -10058,7 +10905,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Posts Suunto data for the specified user on the specified date",
+                "description": "Upserts a user with the provided data",
                 "consumes": [
                     "application/json"
                 ],
@@ -10066,23 +10913,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Suunto"
+                    "Tietoevry - User"
                 ],
-                "summary": "Post Suunto data",
+                "summary": "Upsert user",
                 "parameters": [
                     {
-                        "description": "suunto data input",
-                        "name": "body",
+                        "description": "User data",
+                        "name": "user",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/swagger.SuuntoPostDataInput"
+                            "$ref": "#/definitions/swagger.TietoevryUserUpsertInput"
                         }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Queue the request as an ingest job, see /ingest-jobs",
+                        "name": "async",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Created: Data successfully stored (no content in response body)"
+                        "description": "created"
+                    },
+                    "202": {
+                        "description": "Queued as an ingest job (async mode)",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.IngestJobEnvelope"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -10122,7 +10981,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes all Suunto data entries for a specific user",
+                "description": "Removes a user by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -10130,24 +10989,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Suunto"
+                    "Tietoevry - User"
                 ],
-                "summary": "Delete all Suunto data for a user",
+                "summary": "Delete a user",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "User ID (UUID)",
-                        "name": "user_id",
+                        "name": "id",
                         "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Deleted: Data successfully removed"
+                        "description": "User deleted successfully"
                     },
                     "204": {
-                        "description": "No Content: No matching data"
+                        "description": "No content, user not found"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -10182,24 +11041,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/utv/suunto/dates": {
+        "/utv/all": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns available dates for the specified user (optionally filtered by date range)",
+                "description": "Returns all entries of a specific data type for a user, across all wearable devices, optionally filtered by date range, paginated by limit (default 3) and offset.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "UTV - Suunto"
+                    "UTV - General"
                 ],
-                "summary": "Get available dates",
+                "summary": "Get all data by type",
                 "parameters": [
                     {
                         "type": "string",
@@ -10210,26 +11070,48 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Filter dates after this date (YYYY-MM-DD)",
+                        "description": "Data type (e.g., 'sleep', 'activity')",
+                        "name": "type",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter data after this date (YYYY-MM-DD)",
                         "name": "after_date",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter dates before this date (YYYY-MM-DD)",
+                        "description": "Filter data before this date (YYYY-MM-DD)",
                         "name": "before_date",
                         "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit the number of results returned (default: 3, max: 10)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset for pagination (default: 0)",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of available dates",
+                        "description": "List of data entries across devices",
                         "schema": {
-                            "$ref": "#/definitions/swagger.DatesResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/swagger.LatestDataResponse"
+                            }
                         }
                     },
                     "204": {
-                        "description": "No Content: No available dates found"
+                        "description": "No Content: No data available"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -10270,14 +11152,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/utv/suunto/status": {
+        "/utv/archinisis/sport_ids": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns whether a user has connected their Suunto account and whether any Suunto data exists",
+                "description": "Returns distinct sport_id values from Archinisis tokens",
                 "consumes": [
                     "application/json"
                 ],
@@ -10285,9 +11167,67 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Suunto"
+                    "UTV - Archinisis"
                 ],
-                "summary": "Check Suunto connection \u0026 data status",
+                "summary": "List Archinisis sport IDs",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.SportIDsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/utv/archinisis/status": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns whether a user has connected their Archinisis account",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Archinisis"
+                ],
+                "summary": "Check Archinisis connection",
                 "parameters": [
                     {
                         "type": "string",
@@ -10301,7 +11241,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.SuuntoStatusResponse"
+                            "$ref": "#/definitions/swagger.ArchinisisStatusResponse"
                         }
                     },
                     "400": {
@@ -10337,14 +11277,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/utv/suunto/token": {
+        "/utv/archinisis/token": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Upserts the Suunto token details for a specific user",
+                "description": "Upserts a Archinisis token for a user",
                 "consumes": [
                     "application/json"
                 ],
@@ -10352,17 +11292,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Suunto"
+                    "UTV - Archinisis"
                 ],
-                "summary": "Save or update Suunto token",
+                "summary": "Upsert Archinisis token",
                 "parameters": [
                     {
-                        "description": "Suunto token input",
+                        "description": "Archinisis token input",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/swagger.SuuntoTokenInput"
+                            "$ref": "#/definitions/swagger.ArchinisisTokenInput"
                         }
                     }
                 ],
@@ -10403,14 +11343,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/utv/suunto/token-by-username": {
+        "/utv/coachtech/data": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns user_id and token data associated with a given Suunto username",
+                "description": "Returns Coachtech data for a user",
                 "consumes": [
                     "application/json"
                 ],
@@ -10418,24 +11358,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Suunto"
+                    "UTV - Coachtech"
                 ],
-                "summary": "Get Suunto token by username",
+                "summary": "Get Coachtech data",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Suunto username",
-                        "name": "username",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter data after this date (YYYY-MM-DD)",
+                        "name": "after_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter data before this date (YYYY-MM-DD)",
+                        "name": "before_date",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.SuuntoTokenByUsernameResponse"
-                        }
+                        "description": "OK"
+                    },
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -10470,14 +11422,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/utv/suunto/types": {
-            "get": {
+        "/utv/coachtech/insert": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns available types for the specified user on the specified date",
+                "description": "Adds a Coachtech ID and corresponding data for a user",
                 "consumes": [
                     "application/json"
                 ],
@@ -10485,34 +11437,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - Suunto"
+                    "UTV - Coachtech"
                 ],
-                "summary": "Get available types",
+                "summary": "Insert Coachtech data",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "User ID (UUID)",
-                        "name": "user_id",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Date (YYYY-MM-DD)",
-                        "name": "date",
-                        "in": "query",
-                        "required": true
+                        "description": "Coachtech data input",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/swagger.CoachtechInsertInput"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "List of available types",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.SuuntoTypesResponse"
-                        }
-                    },
-                    "204": {
-                        "description": "No Content: No available types found"
+                    "201": {
+                        "description": "Created"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -10547,14 +11488,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/utv/token": {
+        "/utv/coachtech/status": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns token JSON for the given source. Shapes:\n- oura/suunto: {\"access_token\"}\n- polar: {\"access_token\", \"x_user_id\"}\n- garmin: {\"access_token\", \"access_token_secret\"}",
+                "description": "Returns whether Coachtech data exists for a given user",
                 "consumes": [
                     "application/json"
                 ],
@@ -10562,9 +11503,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - General"
+                    "UTV - Coachtech"
                 ],
-                "summary": "Get wearable access token",
+                "summary": "Check Coachtech data availability",
                 "parameters": [
                     {
                         "type": "string",
@@ -10572,25 +11513,15 @@ const docTemplate = `{
                         "name": "user_id",
                         "in": "query",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Source (one of: 'garmin', 'oura', 'polar', 'suunto')",
-                        "name": "source",
-                        "in": "query",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.GarminTokenResponse"
+                            "$ref": "#/definitions/swagger.CoachtechStatusResponse"
                         }
                     },
-                    "204": {
-                        "description": "No Content: Token not found"
-                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -10624,14 +11555,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/utv/tokens4update": {
+        "/utv/data4update": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves tokens that need to be updated based on the source and time cutoff",
+                "description": "Returns token records where 'data_last_fetched' is older than the cutoff",
                 "consumes": [
                     "application/json"
                 ],
@@ -10641,11 +11572,11 @@ const docTemplate = `{
                 "tags": [
                     "UTV - General"
                 ],
-                "summary": "Get tokens for update",
+                "summary": "Get data for update",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Source device (one of: 'polar', 'oura', 'suunto', 'garmin')",
+                        "description": "Source (one of: 'polar', 'oura', 'suunto', 'garmin')",
                         "name": "source",
                         "in": "query",
                         "required": true
@@ -10660,7 +11591,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of tokens needing update",
+                        "description": "List of tokens needing data update",
                         "schema": {
                             "type": "array",
                             "items": {
@@ -10669,7 +11600,7 @@ const docTemplate = `{
                         }
                     },
                     "204": {
-                        "description": "No Content: No tokens found"
+                        "description": "No Content: No data found"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -10704,14 +11635,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/utv/user": {
-            "get": {
+        "/utv/disconnect": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns JSON user data for a given UTV user ID",
+                "description": "Disconnects a user's wearable device by deleting the associated token",
                 "consumes": [
                     "application/json"
                 ],
@@ -10719,9 +11650,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - User"
+                    "UTV - General"
                 ],
-                "summary": "Get user",
+                "summary": "Disconnect a wearable device",
                 "parameters": [
                     {
                         "type": "string",
@@ -10729,14 +11660,18 @@ const docTemplate = `{
                         "name": "user_id",
                         "in": "query",
                         "required": true
-                    }
-                ],
-                "responses": {
+                    },
+                    {
+                        "type": "string",
+                        "description": "Source device to disconnect (one of: 'polar', 'oura', 'suunto', 'garmin', 'klab', 'archinisis')",
+                        "name": "source",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.UserDataResponse"
-                        }
+                        "description": "Successfully disconnected"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -10756,12 +11691,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.NotFoundResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -10775,14 +11704,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/utv/garmin/data": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Upserts the user data JSON blob for a specific user",
+                "description": "Returns data for the specified user on the specified date (optionally filtered by key)",
                 "consumes": [
                     "application/json"
                 ],
@@ -10790,9 +11721,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - User"
+                    "UTV - Garmin"
                 ],
-                "summary": "Save or update user",
+                "summary": "Get available data",
                 "parameters": [
                     {
                         "type": "string",
@@ -10802,18 +11733,104 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "User data input",
+                        "type": "string",
+                        "description": "Date (YYYY-MM-DD)",
+                        "name": "date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Type",
+                        "name": "key",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Data",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.GarminDataResponse"
+                        }
+                    },
+                    "204": {
+                        "description": "No Content: No data found"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Posts Garmin data for the specified user on the specified date",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Garmin"
+                ],
+                "summary": "Post Garmin data",
+                "parameters": [
+                    {
+                        "description": "Garmin data input",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/swagger.UserDataInput"
+                            "$ref": "#/definitions/swagger.GarminPostDataInput"
                         }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Queue the request as an ingest job, see /ingest-jobs",
+                        "name": "async",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Created"
+                        "description": "Created: Data successfully stored (no content in response body)"
+                    },
+                    "202": {
+                        "description": "Queued as an ingest job (async mode)",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.IngestJobEnvelope"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -10853,7 +11870,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Removes a user",
+                "description": "Deletes all Garmin data entries for a specific user",
                 "consumes": [
                     "application/json"
                 ],
@@ -10861,9 +11878,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - User"
+                    "UTV - Garmin"
                 ],
-                "summary": "Delete a user",
+                "summary": "Delete all Garmin data for a user",
                 "parameters": [
                     {
                         "type": "string",
@@ -10875,7 +11892,10 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "Deleted: Data successfully removed"
+                    },
+                    "204": {
+                        "description": "No Content: No matching data"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -10910,14 +11930,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/utv/user-id-by-sport-id": {
+        "/utv/garmin/dates": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Find user_id from sport_id",
+                "description": "Returns available dates for the specified user (optionally filtered by date range)",
                 "consumes": [
                     "application/json"
                 ],
@@ -10925,25 +11945,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - User"
+                    "UTV - Garmin"
                 ],
-                "summary": "Get UTV user ID by sport ID",
+                "summary": "Get available dates",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Sport ID",
-                        "name": "sport_id",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter dates after this date (YYYY-MM-DD)",
+                        "name": "after_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter dates before this date (YYYY-MM-DD)",
+                        "name": "before_date",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "List of available dates",
                         "schema": {
-                            "$ref": "#/definitions/swagger.UserIDResponse"
+                            "$ref": "#/definitions/swagger.DatesResponse"
                         }
                     },
+                    "204": {
+                        "description": "No Content: No available dates found"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -10962,10 +11997,10 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.ForbiddenResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
-                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                            "$ref": "#/definitions/swagger.InvalidDateRange"
                         }
                     },
                     "500": {
@@ -10983,14 +12018,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/utv/user-linked-devices": {
+        "/utv/garmin/status": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns the status of linked devices for a user and if they include data.",
+                "description": "Returns whether a user has connected their Garmin account and whether any Garmin data exists",
                 "consumes": [
                     "application/json"
                 ],
@@ -10998,9 +12033,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UTV - User"
+                    "UTV - Garmin"
                 ],
-                "summary": "Get linked devices for a user",
+                "summary": "Check Garmin connection \u0026 data status",
                 "parameters": [
                     {
                         "type": "string",
@@ -11014,7 +12049,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/swagger.DeviceStatusResponse"
+                            "$ref": "#/definitions/swagger.GarminStatusResponse"
                         }
                     },
                     "400": {
@@ -11049,24 +12084,3615 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "authapi.RefreshRequest": {
-            "type": "object",
-            "required": [
-                "refresh_token"
-            ],
-            "properties": {
-                "refresh_token": {
-                    "type": "string"
+        },
+        "/utv/garmin/token": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Saves or updates the Garmin token for a user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Garmin"
+                ],
+                "summary": "Save or update Garmin token",
+                "parameters": [
+                    {
+                        "description": "Garmin token input",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/swagger.GarminTokenInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
                 }
             }
         },
-        "authapi.RefreshResponse": {
-            "type": "object",
-            "properties": {
-                "jwt": {
+        "/utv/garmin/token-exists": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Checks whether a given Garmin access token is stored in the system",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Garmin"
+                ],
+                "summary": "Check if Garmin token exists",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Garmin access token",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.GarminTokenExistsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/utv/garmin/types": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns available types for the specified user on the specified date",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Garmin"
+                ],
+                "summary": "Get available types",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date (YYYY-MM-DD)",
+                        "name": "date",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of available types",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.GarminTypesResponse"
+                        }
+                    },
+                    "204": {
+                        "description": "No Content: No available types found"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/utv/garmin/user-id-by-token": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the user ID associated with a given Garmin access token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Garmin"
+                ],
+                "summary": "Get user_id by Garmin access token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Garmin access token",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.GarminUserIDResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/utv/klab/sport_ids": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns distinct sport_id values from Klab tokens",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Klab"
+                ],
+                "summary": "List Klab sport IDs",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.SportIDsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/utv/klab/status": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns whether a user has connected their Klab account",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Klab"
+                ],
+                "summary": "Check Klab connection",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.KlabStatusResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/utv/klab/token": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upserts a Klab token for a user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Klab"
+                ],
+                "summary": "Upsert Klab token",
+                "parameters": [
+                    {
+                        "description": "Klab token input",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/swagger.KlabTokenInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/utv/latest": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns latest entries of a specific type for a user, optionally filtered by device and limited in number (defaults to 1).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - General"
+                ],
+                "summary": "Get latest data by type",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data type (e.g., 'sleep', 'activity')",
+                        "name": "type",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Device type (one of: 'garmin', 'oura', 'polar', 'suunto')",
+                        "name": "device",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit the number of results (default: 1, max: 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Latest Data",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/swagger.LatestDataResponse"
+                            }
+                        }
+                    },
+                    "204": {
+                        "description": "No Content: No data found"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/utv/oura/data": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns data for the specified user on the specified date (optionally filtered by key)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Oura"
+                ],
+                "summary": "Get available data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date (YYYY-MM-DD)",
+                        "name": "date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Type",
+                        "name": "key",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Data",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.OuraDataResponse"
+                        }
+                    },
+                    "204": {
+                        "description": "No Content: No data found"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Posts Oura data for the specified user on the specified date",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Oura"
+                ],
+                "summary": "Post Oura data",
+                "parameters": [
+                    {
+                        "description": "Oura data input",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/swagger.OuraPostDataInput"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Queue the request as an ingest job, see /ingest-jobs",
+                        "name": "async",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created: Data successfully stored (no content in response body)"
+                    },
+                    "202": {
+                        "description": "Queued as an ingest job (async mode)",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.IngestJobEnvelope"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes all Oura data entries for a specific user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Oura"
+                ],
+                "summary": "Delete all Oura data for a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Deleted: Data successfully removed"
+                    },
+                    "204": {
+                        "description": "No Content: No matching data"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/utv/oura/dates": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns available dates for the specified user (optionally filtered by date range)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Oura"
+                ],
+                "summary": "Get available dates",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter dates after this date (YYYY-MM-DD)",
+                        "name": "after_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter dates before this date (YYYY-MM-DD)",
+                        "name": "before_date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of available dates",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.DatesResponse"
+                        }
+                    },
+                    "204": {
+                        "description": "No Content: No available dates found"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InvalidDateRange"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/utv/oura/status": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns whether a user has connected their Oura account and whether any Oura data exists",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Oura"
+                ],
+                "summary": "Check Oura connection \u0026 data status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.OuraStatusResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/utv/oura/token": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Saves or updates the Oura token for a user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Oura"
+                ],
+                "summary": "Save or update Oura token",
+                "parameters": [
+                    {
+                        "description": "Oura token input",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/swagger.OuraTokenInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/utv/oura/token-by-id": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the user ID and token data associated with a specific Oura ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Oura"
+                ],
+                "summary": "Get Oura token by Oura ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Oura ID",
+                        "name": "oura_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.OuraTokenByIDResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/utv/oura/types": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns available types for the specified user on the specified date",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Oura"
+                ],
+                "summary": "Get available types",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date (YYYY-MM-DD)",
+                        "name": "date",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of available types",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.OuraTypesResponse"
+                        }
+                    },
+                    "204": {
+                        "description": "No Content: No available types found"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/utv/polar/data": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns data for the specified user on the specified date (optionally filtered by key)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Polar"
+                ],
+                "summary": "Get available data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date (YYYY-MM-DD)",
+                        "name": "date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Type",
+                        "name": "key",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Data",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.PolarDataResponse"
+                        }
+                    },
+                    "204": {
+                        "description": "No Content: No data found"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Posts Polar data for the specified user on the specified date",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Polar"
+                ],
+                "summary": "Post Polar data",
+                "parameters": [
+                    {
+                        "description": "Polar data input",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/swagger.PolarPostDataInput"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Queue the request as an ingest job, see /ingest-jobs",
+                        "name": "async",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created: Data successfully stored (no content in response body)"
+                    },
+                    "202": {
+                        "description": "Queued as an ingest job (async mode)",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.IngestJobEnvelope"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes all Polar data entries for a specific user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Polar"
+                ],
+                "summary": "Delete all Polar data for a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Deleted: Data successfully removed"
+                    },
+                    "204": {
+                        "description": "No Content: No matching data"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/utv/polar/dates": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns available dates for the specified user (optionally filtered by date range)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Polar"
+                ],
+                "summary": "Get available dates",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter dates after this date (YYYY-MM-DD)",
+                        "name": "after_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter dates before this date (YYYY-MM-DD)",
+                        "name": "before_date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of available dates",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.DatesResponse"
+                        }
+                    },
+                    "204": {
+                        "description": "No Content: No available dates found"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InvalidDateRange"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/utv/polar/status": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns whether a user has connected their Polar account and whether any Polar data exists",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Polar"
+                ],
+                "summary": "Check Polar connection \u0026 data status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.PolarStatusResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/utv/polar/token": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upserts the Polar token details for a specific user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Polar"
+                ],
+                "summary": "Save or update Polar token",
+                "parameters": [
+                    {
+                        "description": "Polar token input",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/swagger.PolarTokenInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/utv/polar/token-by-id": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns user_id and token data associated with a given Polar x_user_id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Polar"
+                ],
+                "summary": "Get user token by Polar ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Polar x_user_id",
+                        "name": "polar_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.PolarTokenByIDResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/utv/polar/types": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns available types for the specified user on the specified date",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Polar"
+                ],
+                "summary": "Get available types",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date (YYYY-MM-DD)",
+                        "name": "date",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of available types",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.PolarTypesResponse"
+                        }
+                    },
+                    "204": {
+                        "description": "No Content: No available types found"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/utv/source_cache/data-types": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns data types. If source is provided, returns only that source's keys.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Source_Cache"
+                ],
+                "summary": "Get data types (source_cache)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Source name (e.g., oura, garmin, polar, suunto)",
+                        "name": "source",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.SourceCacheSingleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the entire key list for the given source. One source per request.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Source_Cache"
+                ],
+                "summary": "Replace keys for a source (upsert)",
+                "parameters": [
+                    {
+                        "description": "source + data array",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/swagger.SourceCacheUpsertInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/utv/suunto/data": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns data for the specified user on the specified date (optionally filtered by key)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Suunto"
+                ],
+                "summary": "Get available data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date (YYYY-MM-DD)",
+                        "name": "date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Type",
+                        "name": "key",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Data",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.SuuntoDataResponse"
+                        }
+                    },
+                    "204": {
+                        "description": "No Content: No data found"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Posts Suunto data for the specified user on the specified date",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Suunto"
+                ],
+                "summary": "Post Suunto data",
+                "parameters": [
+                    {
+                        "description": "suunto data input",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/swagger.SuuntoPostDataInput"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Queue the request as an ingest job, see /ingest-jobs",
+                        "name": "async",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created: Data successfully stored (no content in response body)"
+                    },
+                    "202": {
+                        "description": "Queued as an ingest job (async mode)",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.IngestJobEnvelope"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes all Suunto data entries for a specific user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Suunto"
+                ],
+                "summary": "Delete all Suunto data for a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Deleted: Data successfully removed"
+                    },
+                    "204": {
+                        "description": "No Content: No matching data"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/utv/suunto/dates": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns available dates for the specified user (optionally filtered by date range)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Suunto"
+                ],
+                "summary": "Get available dates",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter dates after this date (YYYY-MM-DD)",
+                        "name": "after_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter dates before this date (YYYY-MM-DD)",
+                        "name": "before_date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of available dates",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.DatesResponse"
+                        }
+                    },
+                    "204": {
+                        "description": "No Content: No available dates found"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InvalidDateRange"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/utv/suunto/status": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns whether a user has connected their Suunto account and whether any Suunto data exists",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Suunto"
+                ],
+                "summary": "Check Suunto connection \u0026 data status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.SuuntoStatusResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/utv/suunto/token": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upserts the Suunto token details for a specific user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Suunto"
+                ],
+                "summary": "Save or update Suunto token",
+                "parameters": [
+                    {
+                        "description": "Suunto token input",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/swagger.SuuntoTokenInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/utv/suunto/token-by-username": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns user_id and token data associated with a given Suunto username",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Suunto"
+                ],
+                "summary": "Get Suunto token by username",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Suunto username",
+                        "name": "username",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.SuuntoTokenByUsernameResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/utv/suunto/types": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns available types for the specified user on the specified date",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - Suunto"
+                ],
+                "summary": "Get available types",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date (YYYY-MM-DD)",
+                        "name": "date",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of available types",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.SuuntoTypesResponse"
+                        }
+                    },
+                    "204": {
+                        "description": "No Content: No available types found"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/utv/token": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns token JSON for the given source. Shapes:\n- oura/suunto: {\"access_token\"}\n- polar: {\"access_token\", \"x_user_id\"}\n- garmin: {\"access_token\", \"access_token_secret\"}",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - General"
+                ],
+                "summary": "Get wearable access token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Source (one of: 'garmin', 'oura', 'polar', 'suunto')",
+                        "name": "source",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.GarminTokenResponse"
+                        }
+                    },
+                    "204": {
+                        "description": "No Content: Token not found"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/utv/tokens4update": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves tokens that need to be updated based on the source and time cutoff",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - General"
+                ],
+                "summary": "Get tokens for update",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Source device (one of: 'polar', 'oura', 'suunto', 'garmin')",
+                        "name": "source",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of hours to look back (1-8760)",
+                        "name": "hours",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default: 100, max: 1000)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from pagination.next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page of tokens needing update",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UTVTokensForUpdateResponse"
+                        }
+                    },
+                    "204": {
+                        "description": "No Content: No tokens found"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/utv/user": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns JSON user data for a given UTV user ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - User"
+                ],
+                "summary": "Get user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UserDataResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upserts the user data JSON blob for a specific user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - User"
+                ],
+                "summary": "Save or update user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "User data input",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UserDataInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes a user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - User"
+                ],
+                "summary": "Delete a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/utv/user-id-by-sport-id": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Find user_id from sport_id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - User"
+                ],
+                "summary": "Get UTV user ID by sport ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sport ID",
+                        "name": "sport_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UserIDResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/utv/user-linked-devices": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the status of linked devices for a user and if they include data.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UTV - User"
+                ],
+                "summary": "Get linked devices for a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.DeviceStatusResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the webhook subscriptions of the calling client, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "List webhooks",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 100, max 1000)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.WebhookListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Registers a URL that receives change events as signed POST requests. Events can be filtered by domain, entity, operation and athlete ID; an empty filter matches everything, and no domains means every domain the client can read. The signing secret is only returned in this response and when it is rotated. Each request carries ` + "`" + `X-Kuha-Signature: t=\u003cunix seconds\u003e,v1=\u003chex HMAC-SHA256 of \"\u003ct\u003e.\u003cbody\u003e\"\u003e` + "`" + `; failed deliveries are retried with exponential backoff before moving to the dead letters.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Subscribe a webhook",
+                "parameters": [
+                    {
+                        "description": "Webhook subscription",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/swagger.WebhookInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.WebhookEnvelope"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the subscription"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a webhook subscription of the calling client",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Get a webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.WebhookEnvelope"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the URL, filters and state of a webhook subscription. Deliveries queued while a subscription is inactive are sent once it is active again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Update a webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Webhook subscription",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/swagger.WebhookInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.WebhookEnvelope"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ForbiddenResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a webhook subscription with its queued and past deliveries",
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Delete a webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Webhook deleted"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the deliveries of a webhook subscription, newest first. ` + "`" + `status=dead` + "`" + ` lists the dead letters: deliveries that ran out of attempts and wait for a redelivery.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "List webhook deliveries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "succeeded",
+                            "dead"
+                        ],
+                        "type": "string",
+                        "description": "Delivery status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 100, max 1000)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.WebhookDeliveryListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/deliveries/{delivery_id}/redeliver": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Queues a dead delivery again with a fresh set of attempts",
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Redeliver a dead delivery",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Delivery ID",
+                        "name": "delivery_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Delivery queued"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ConflictResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/redeliver-dead": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Queues every dead delivery of a webhook subscription again with a fresh set of attempts",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Redeliver all dead deliveries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.WebhookRedeliverResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/rotate-secret": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the signing secret of a webhook subscription and returns the new one. Deliveries sent from now on are signed with the new secret.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Rotate a webhook secret",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.WebhookEnvelope"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.UnauthorizedResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotFoundResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.InternalServerErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ServiceUnavailableResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "authapi.RefreshRequest": {
+            "type": "object",
+            "required": [
+                "refresh_token"
+            ],
+            "properties": {
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "authapi.RefreshResponse": {
+            "type": "object",
+            "properties": {
+                "jwt": {
                     "type": "string"
                 }
             }
@@ -11257,58 +15883,178 @@ const docTemplate = `{
                 },
                 "stop_time": {
                     "type": "string",
-                    "example": "2024-01-01T09:15:00Z"
+                    "example": "2024-01-01T09:15:00Z"
+                }
+            }
+        },
+        "swagger.ArchRaceReportUpsertRequest": {
+            "type": "object",
+            "properties": {
+                "race_report": {
+                    "type": "string",
+                    "example": "\u003c!DOCTYPE html\u003e\u003chtml\u003e\u003chead\u003e\u003ctitle\u003eRace\u003c/title\u003e\u003c/head\u003e\u003cbody\u003e\u003ch1\u003eReport\u003c/h1\u003e\u003c/body\u003e\u003c/html\u003e"
+                },
+                "session_id": {
+                    "type": "integer",
+                    "example": 1842
+                },
+                "sportti_id": {
+                    "type": "string",
+                    "example": "27578816"
+                }
+            }
+        },
+        "swagger.ArchinisisStatusResponse": {
+            "type": "object",
+            "properties": {
+                "connected": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "swagger.ArchinisisTokenDetails": {
+            "type": "object",
+            "properties": {
+                "sport_id": {
+                    "type": "integer",
+                    "example": 51678043
+                },
+                "suomisport_id": {
+                    "type": "string",
+                    "example": "138538"
+                }
+            }
+        },
+        "swagger.ArchinisisTokenInput": {
+            "type": "object",
+            "properties": {
+                "details": {
+                    "$ref": "#/definitions/swagger.ArchinisisTokenDetails"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "208e2ffb-ac68-4980-a8b6-b7e0136e4172"
                 }
             }
         },
-        "swagger.ArchRaceReportUpsertRequest": {
+        "swagger.BulkItemResult": {
             "type": "object",
             "properties": {
-                "race_report": {
+                "error": {
                     "type": "string",
-                    "example": "\u003c!DOCTYPE html\u003e\u003chtml\u003e\u003chead\u003e\u003ctitle\u003eRace\u003c/title\u003e\u003c/head\u003e\u003cbody\u003e\u003ch1\u003eReport\u003c/h1\u003e\u003c/body\u003e\u003c/html\u003e"
+                    "example": "user does not exist. Please create the user first"
                 },
-                "session_id": {
+                "fields": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "index": {
                     "type": "integer",
-                    "example": 1842
+                    "example": 3
                 },
-                "sportti_id": {
+                "status": {
                     "type": "string",
-                    "example": "27578816"
+                    "enum": [
+                        "inserted",
+                        "updated",
+                        "skipped",
+                        "failed"
+                    ],
+                    "example": "failed"
+                },
+                "table": {
+                    "type": "string",
+                    "example": "dirtest"
                 }
             }
         },
-        "swagger.ArchinisisStatusResponse": {
+        "swagger.BulkReport": {
             "type": "object",
             "properties": {
-                "connected": {
-                    "type": "boolean",
-                    "example": true
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.BulkItemResult"
+                    }
+                },
+                "summary": {
+                    "$ref": "#/definitions/swagger.BulkSummary"
                 }
             }
         },
-        "swagger.ArchinisisTokenDetails": {
+        "swagger.BulkSummary": {
             "type": "object",
             "properties": {
-                "sport_id": {
+                "failed": {
                     "type": "integer",
-                    "example": 51678043
+                    "example": 1
                 },
-                "suomisport_id": {
-                    "type": "string",
-                    "example": "138538"
+                "inserted": {
+                    "type": "integer",
+                    "example": 9000
+                },
+                "skipped": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 10000
+                },
+                "updated": {
+                    "type": "integer",
+                    "example": 998
                 }
             }
         },
-        "swagger.ArchinisisTokenInput": {
+        "swagger.ChangeEvent": {
             "type": "object",
             "properties": {
-                "details": {
-                    "$ref": "#/definitions/swagger.ArchinisisTokenDetails"
+                "athlete_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "3fa85f64-5717-4562-b3fc-2c963f66afa6"
+                    ]
                 },
-                "user_id": {
+                "domain": {
                     "type": "string",
-                    "example": "208e2ffb-ac68-4980-a8b6-b7e0136e4172"
+                    "example": "utv"
+                },
+                "entity": {
+                    "type": "string",
+                    "example": "oura_data"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1042
+                },
+                "occurred_at": {
+                    "type": "string",
+                    "example": "2025-01-15T13:11:02Z"
+                },
+                "operation": {
+                    "type": "string",
+                    "enum": [
+                        "insert",
+                        "update",
+                        "upsert",
+                        "delete"
+                    ],
+                    "example": "upsert"
+                },
+                "records": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "type": {
+                    "type": "string",
+                    "example": "utv.oura_data.upsert"
                 }
             }
         },
@@ -11494,20 +16240,320 @@ const docTemplate = `{
                 }
             }
         },
+        "swagger.ExportJob": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-15T13:11:02Z"
+                },
+                "download_url": {
+                    "type": "string",
+                    "example": "/v1/exports/3fa85f64-5717-4562-b3fc-2c963f66afa6/download"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2025-01-16T13:11:02Z"
+                },
+                "finished_at": {
+                    "type": "string"
+                },
+                "format": {
+                    "type": "string",
+                    "example": "parquet"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "3fa85f64-5717-4562-b3fc-2c963f66afa6"
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "fis_results"
+                },
+                "params": {
+                    "type": "object"
+                },
+                "rows_written": {
+                    "type": "integer",
+                    "example": 250000
+                },
+                "size_bytes": {
+                    "type": "integer",
+                    "example": 18874368
+                },
+                "started_at": {
+                    "type": "string",
+                    "example": "2025-01-15T13:11:03Z"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "queued",
+                        "running",
+                        "succeeded",
+                        "failed",
+                        "canceled"
+                    ],
+                    "example": "running"
+                }
+            }
+        },
+        "swagger.ExportJobEnvelope": {
+            "type": "object",
+            "properties": {
+                "export": {
+                    "$ref": "#/definitions/swagger.ExportJob"
+                }
+            }
+        },
+        "swagger.ExportJobInput": {
+            "type": "object",
+            "properties": {
+                "format": {
+                    "type": "string",
+                    "enum": [
+                        "csv",
+                        "parquet",
+                        "ndjson"
+                    ],
+                    "example": "parquet"
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "fis_results"
+                },
+                "params": {
+                    "type": "object"
+                }
+            }
+        },
+        "swagger.ExportJobListResponse": {
+            "type": "object",
+            "properties": {
+                "exports": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.ExportJob"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/swagger.Pagination"
+                }
+            }
+        },
         "swagger.FISAthleteItem": {
             "type": "object",
             "properties": {
                 "firstname": {
                     "type": "string",
-                    "example": "Iivo"
+                    "example": "Iivo"
+                },
+                "fiscode": {
+                    "type": "integer",
+                    "example": 342001
+                },
+                "lastname": {
+                    "type": "string",
+                    "example": "Niskanen"
+                }
+            }
+        },
+        "swagger.FISAthleteLinkCandidate": {
+            "type": "object",
+            "properties": {
+                "confidence": {
+                    "type": "string",
+                    "example": "0.996"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2026-10-19T08:00:00Z"
+                },
+                "evidence": {
+                    "$ref": "#/definitions/swagger.FISAthleteLinkEvidence"
+                },
+                "fiscode": {
+                    "type": "integer",
+                    "example": 3420586
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 17
+                },
+                "reviewed_at": {
+                    "type": "string",
+                    "example": "2026-10-19T09:30:00Z"
+                },
+                "reviewed_by": {
+                    "type": "string",
+                    "example": "kuha-admin"
+                },
+                "sources": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "klab",
+                        "tietoevry"
+                    ]
+                },
+                "sporttiid": {
+                    "type": "integer",
+                    "example": 123456
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "approved",
+                        "rejected"
+                    ],
+                    "example": "pending"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2026-10-19T08:00:00Z"
+                }
+            }
+        },
+        "swagger.FISAthleteLinkCandidateListResponse": {
+            "type": "object",
+            "properties": {
+                "candidates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.FISAthleteLinkCandidate"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/swagger.Pagination"
+                }
+            }
+        },
+        "swagger.FISAthleteLinkCandidateResponse": {
+            "type": "object",
+            "properties": {
+                "candidate": {
+                    "$ref": "#/definitions/swagger.FISAthleteLinkCandidate"
+                }
+            }
+        },
+        "swagger.FISAthleteLinkEvidence": {
+            "type": "object",
+            "properties": {
+                "birthdate_conflict": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "birthdate_match": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "gender_conflict": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "gender_match": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "name_similarity": {
+                    "type": "number",
+                    "example": 1
+                }
+            }
+        },
+        "swagger.FISAthleteLinkMatchResponse": {
+            "type": "object",
+            "properties": {
+                "summary": {
+                    "$ref": "#/definitions/swagger.FISAthleteLinkMatchSummary"
+                }
+            }
+        },
+        "swagger.FISAthleteLinkMatchSummary": {
+            "type": "object",
+            "properties": {
+                "athletes": {
+                    "type": "integer",
+                    "example": 2315
+                },
+                "candidates": {
+                    "type": "integer",
+                    "example": 412
+                },
+                "competitors": {
+                    "type": "integer",
+                    "example": 1840
+                },
+                "saved": {
+                    "type": "integer",
+                    "example": 398
+                },
+                "sources": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "klab",
+                        "archinisis",
+                        "tietoevry"
+                    ]
+                }
+            }
+        },
+        "swagger.FISAthleteResult": {
+            "type": "object",
+            "properties": {
+                "catcode": {
+                    "type": "string",
+                    "example": "WC"
+                },
+                "details": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "disciplinecode": {
+                    "type": "string",
+                    "example": "IND"
+                },
+                "place": {
+                    "type": "string",
+                    "example": "Lahti"
+                },
+                "position": {
+                    "type": "string",
+                    "example": "1"
                 },
-                "fiscode": {
+                "racedate": {
+                    "type": "string",
+                    "example": "2025-02-15"
+                },
+                "raceid": {
                     "type": "integer",
-                    "example": 342001
+                    "example": 98765
                 },
-                "lastname": {
+                "recid": {
+                    "type": "integer",
+                    "example": 12345
+                },
+                "seasoncode": {
+                    "type": "integer",
+                    "example": 2025
+                },
+                "sector": {
                     "type": "string",
-                    "example": "Niskanen"
+                    "enum": [
+                        "cc",
+                        "jp",
+                        "nk"
+                    ],
+                    "example": "nk"
                 }
             }
         },
@@ -11749,6 +16795,9 @@ const docTemplate = `{
         "swagger.FISAthleteResultsCCResponse": {
             "type": "object",
             "properties": {
+                "pagination": {
+                    "$ref": "#/definitions/swagger.Pagination"
+                },
                 "results": {
                     "type": "array",
                     "items": {
@@ -11760,6 +16809,9 @@ const docTemplate = `{
         "swagger.FISAthleteResultsJPResponse": {
             "type": "object",
             "properties": {
+                "pagination": {
+                    "$ref": "#/definitions/swagger.Pagination"
+                },
                 "results": {
                     "type": "array",
                     "items": {
@@ -11771,6 +16823,9 @@ const docTemplate = `{
         "swagger.FISAthleteResultsNKResponse": {
             "type": "object",
             "properties": {
+                "pagination": {
+                    "$ref": "#/definitions/swagger.Pagination"
+                },
                 "results": {
                     "type": "array",
                     "items": {
@@ -11779,6 +16834,20 @@ const docTemplate = `{
                 }
             }
         },
+        "swagger.FISAthleteResultsResponse": {
+            "type": "object",
+            "properties": {
+                "pagination": {
+                    "$ref": "#/definitions/swagger.Pagination"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.FISAthleteResult"
+                    }
+                }
+            }
+        },
         "swagger.FISAthletesResponse": {
             "type": "object",
             "properties": {
@@ -11790,6 +16859,206 @@ const docTemplate = `{
                 }
             }
         },
+        "swagger.FISBestResult": {
+            "type": "object",
+            "properties": {
+                "best_position": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "best_racepoints": {
+                    "type": "string",
+                    "example": "8.51000"
+                },
+                "catcode": {
+                    "type": "string",
+                    "example": "WC"
+                },
+                "disciplinecode": {
+                    "type": "string",
+                    "example": "SP"
+                },
+                "place": {
+                    "type": "string",
+                    "example": "Lahti"
+                },
+                "racedate": {
+                    "type": "string",
+                    "example": "2025-02-14"
+                },
+                "raceid": {
+                    "type": "integer",
+                    "example": 123456
+                },
+                "seasoncode": {
+                    "type": "integer",
+                    "example": 2025
+                },
+                "starts": {
+                    "type": "integer",
+                    "example": 31
+                }
+            }
+        },
+        "swagger.FISCalendarEvent": {
+            "type": "object",
+            "properties": {
+                "cancelled": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "catcodes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "WC"
+                    ]
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "2026-03-01"
+                },
+                "eventid": {
+                    "type": "integer",
+                    "example": 55123
+                },
+                "nationcode": {
+                    "type": "string",
+                    "example": "FIN"
+                },
+                "place": {
+                    "type": "string",
+                    "example": "Lahti"
+                },
+                "races": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.FISCalendarRace"
+                    }
+                },
+                "sectors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "cc",
+                        "jp",
+                        "nk"
+                    ]
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2026-02-27"
+                }
+            }
+        },
+        "swagger.FISCalendarFeed": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2026-01-15T13:11:02Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "3fa85f64-5717-4562-b3fc-2c963f66afa6"
+                },
+                "last_used_at": {
+                    "type": "string",
+                    "example": "2026-01-16T06:00:00Z"
+                },
+                "token": {
+                    "type": "string",
+                    "example": "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "/v1/fis/calendar?format=ics\u0026token=9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+                }
+            }
+        },
+        "swagger.FISCalendarFeedEnvelope": {
+            "type": "object",
+            "properties": {
+                "feed": {
+                    "$ref": "#/definitions/swagger.FISCalendarFeed"
+                }
+            }
+        },
+        "swagger.FISCalendarFeedListResponse": {
+            "type": "object",
+            "properties": {
+                "feeds": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.FISCalendarFeed"
+                    }
+                }
+            }
+        },
+        "swagger.FISCalendarRace": {
+            "type": "object",
+            "properties": {
+                "calstatuscode": {
+                    "type": "string",
+                    "example": "O"
+                },
+                "catcode": {
+                    "type": "string",
+                    "example": "WC"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Men's 10km Interval Start"
+                },
+                "disciplinecode": {
+                    "type": "string",
+                    "example": "DI"
+                },
+                "gender": {
+                    "type": "string",
+                    "example": "M"
+                },
+                "racedate": {
+                    "type": "string",
+                    "example": "2026-03-01"
+                },
+                "raceid": {
+                    "type": "integer",
+                    "example": 123456
+                },
+                "sector": {
+                    "type": "string",
+                    "example": "cc"
+                }
+            }
+        },
+        "swagger.FISCalendarResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.FISCalendarEvent"
+                    }
+                },
+                "from": {
+                    "type": "string",
+                    "example": "2026-01-01"
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2026-03-31"
+                }
+            }
+        },
         "swagger.FISCategoriesCCResponse": {
             "type": "object",
             "properties": {
@@ -11838,6 +17107,53 @@ const docTemplate = `{
                 }
             }
         },
+        "swagger.FISChange": {
+            "type": "object",
+            "properties": {
+                "changed_at": {
+                    "type": "string",
+                    "example": "2025-01-15T13:11:02Z"
+                },
+                "data": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 123456
+                },
+                "op": {
+                    "type": "string",
+                    "enum": [
+                        "upsert",
+                        "delete"
+                    ],
+                    "example": "upsert"
+                }
+            }
+        },
+        "swagger.FISChangesResponse": {
+            "type": "object",
+            "properties": {
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.FISChange"
+                    }
+                },
+                "entity": {
+                    "type": "string",
+                    "example": "racecc"
+                },
+                "pagination": {
+                    "$ref": "#/definitions/swagger.Pagination"
+                },
+                "watermark": {
+                    "type": "string",
+                    "example": "2025-01-15T13:11:02Z"
+                }
+            }
+        },
         "swagger.FISCompetitor": {
             "type": "object",
             "properties": {
@@ -11911,11 +17227,65 @@ const docTemplate = `{
                 },
                 "type": {
                     "type": "string",
-                    "example": "ATHLETE"
+                    "example": "ATHLETE"
+                }
+            }
+        },
+        "swagger.FISCompetitorNationCountItem": {
+            "type": "object",
+            "properties": {
+                "competitors": {
+                    "type": "integer",
+                    "example": 123
+                },
+                "nationcode": {
+                    "type": "string",
+                    "example": "FIN"
+                }
+            }
+        },
+        "swagger.FISCompetitorNationCountsResponse": {
+            "type": "object",
+            "properties": {
+                "agemax": {
+                    "type": "integer",
+                    "example": 35
+                },
+                "agemin": {
+                    "type": "integer",
+                    "example": 18
+                },
+                "gender": {
+                    "type": "string",
+                    "example": "M"
+                },
+                "nations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.FISCompetitorNationCountItem"
+                    }
+                },
+                "sectorcode": {
+                    "type": "string",
+                    "example": "CC"
+                }
+            }
+        },
+        "swagger.FISCompetitorSearchResponse": {
+            "type": "object",
+            "properties": {
+                "competitors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.FISCompetitorSearchResult"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/swagger.Pagination"
                 }
             }
         },
-        "swagger.FISCompetitorFull": {
+        "swagger.FISCompetitorSearchResult": {
             "type": "object",
             "properties": {
                 "alternatenamecheck": {
@@ -12033,6 +17403,10 @@ const docTemplate = `{
                     "type": "integer",
                     "example": 1
                 },
+                "relevance": {
+                    "type": "string",
+                    "example": "0.8750"
+                },
                 "sectorcode": {
                     "type": "string",
                     "example": "CC"
@@ -12075,131 +17449,397 @@ const docTemplate = `{
                 }
             }
         },
-        "swagger.FISCompetitorNationCountItem": {
+        "swagger.FISCompetitorSeasonCatcodeItem": {
             "type": "object",
             "properties": {
-                "competitors": {
+                "catcode": {
+                    "type": "string",
+                    "example": "WC"
+                },
+                "seasoncode": {
                     "type": "integer",
-                    "example": 123
+                    "example": 2025
+                }
+            }
+        },
+        "swagger.FISCompetitorSeasonsCatcodesResponse": {
+            "type": "object",
+            "properties": {
+                "competitorid": {
+                    "type": "integer",
+                    "example": 123456
                 },
-                "nationcode": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.FISCompetitorSeasonCatcodeItem"
+                    }
+                },
+                "sector": {
                     "type": "string",
-                    "example": "FIN"
+                    "example": "CC"
                 }
             }
         },
-        "swagger.FISCompetitorNationCountsResponse": {
+        "swagger.FISCompetitorStatsResponse": {
             "type": "object",
             "properties": {
-                "agemax": {
+                "fiscode": {
                     "type": "integer",
-                    "example": 35
+                    "example": 1234567
                 },
-                "agemin": {
+                "sectors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.FISSectorStats"
+                    }
+                }
+            }
+        },
+        "swagger.FISCrossSectorResultsResponse": {
+            "type": "object",
+            "properties": {
+                "competitors": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "fiscode": {
                     "type": "integer",
-                    "example": 18
+                    "example": 1234567
                 },
-                "gender": {
-                    "type": "string",
-                    "example": "M"
+                "pagination": {
+                    "$ref": "#/definitions/swagger.Pagination"
                 },
-                "nations": {
+                "results": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/swagger.FISCompetitorNationCountItem"
+                        "$ref": "#/definitions/swagger.FISAthleteResult"
                     }
-                },
-                "sectorcode": {
-                    "type": "string",
-                    "example": "CC"
                 }
             }
         },
-        "swagger.FISCompetitorSearchResponse": {
+        "swagger.FISDisciplinesCCResponse": {
             "type": "object",
             "properties": {
-                "competitors": {
-                    "$ref": "#/definitions/swagger.FISCompetitorFull"
+                "disciplines": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "SP",
+                        "DI",
+                        "PU"
+                    ]
                 }
             }
         },
-        "swagger.FISCompetitorSeasonCatcodeItem": {
+        "swagger.FISDisciplinesJPResponse": {
             "type": "object",
             "properties": {
-                "catcode": {
+                "disciplines": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "NH",
+                        "LH",
+                        "FH"
+                    ]
+                }
+            }
+        },
+        "swagger.FISDisciplinesNKResponse": {
+            "type": "object",
+            "properties": {
+                "disciplines": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "NH",
+                        "LH",
+                        "GH"
+                    ]
+                }
+            }
+        },
+        "swagger.FISEnrichedResult": {
+            "type": "object",
+            "properties": {
+                "behind_ms": {
+                    "type": "integer",
+                    "example": 14800
+                },
+                "behind_points": {
+                    "type": "number",
+                    "example": 12.6
+                },
+                "bib": {
+                    "type": "integer",
+                    "example": 31
+                },
+                "birthdate": {
                     "type": "string",
-                    "example": "WC"
+                    "example": "1998-03-14"
                 },
-                "seasoncode": {
+                "bonus_time_ms": {
                     "type": "integer",
-                    "example": 2025
+                    "example": 6000
+                },
+                "cc_position": {
+                    "type": "integer",
+                    "example": 9
+                },
+                "cc_time": {
+                    "type": "string",
+                    "example": "24:10.2"
+                },
+                "cc_time_ms": {
+                    "type": "integer",
+                    "example": 1450200
+                },
+                "competitorname": {
+                    "type": "string",
+                    "example": "DOE John"
+                },
+                "cuppoints": {
+                    "type": "string",
+                    "example": "36.00000"
+                },
+                "fiscode": {
+                    "type": "integer",
+                    "example": 1234567
+                },
+                "jump_points": {
+                    "type": "number",
+                    "example": 121.3
+                },
+                "jump_position": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "nation_rank": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "nationcode": {
+                    "type": "string",
+                    "example": "FIN"
+                },
+                "points": {
+                    "type": "number",
+                    "example": 265.4
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "racepoints": {
+                    "type": "string",
+                    "example": "25.10000"
+                },
+                "recid": {
+                    "type": "integer",
+                    "example": 987654
+                },
+                "rounds": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.FISJumpRound"
+                    }
+                },
+                "run_times": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.FISRunTime"
+                    }
+                },
+                "skiclub": {
+                    "type": "string",
+                    "example": "Lahden Hiihtoseura"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "QLF"
+                },
+                "time": {
+                    "type": "string",
+                    "example": "26:45.0"
+                },
+                "time_ms": {
+                    "type": "integer",
+                    "example": 1605000
                 }
             }
         },
-        "swagger.FISCompetitorSeasonsCatcodesResponse": {
+        "swagger.FISEnrichedResultsResponse": {
             "type": "object",
             "properties": {
-                "competitorid": {
-                    "type": "integer",
-                    "example": 123456
+                "race": {
+                    "$ref": "#/definitions/swagger.FISRace"
                 },
-                "items": {
+                "results": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/swagger.FISCompetitorSeasonCatcodeItem"
+                        "$ref": "#/definitions/swagger.FISEnrichedResult"
                     }
                 },
-                "sector": {
+                "score_kind": {
                     "type": "string",
-                    "example": "CC"
+                    "enum": [
+                        "time",
+                        "points"
+                    ],
+                    "example": "time"
                 }
             }
         },
-        "swagger.FISDisciplinesCCResponse": {
+        "swagger.FISHeadToHeadRace": {
             "type": "object",
             "properties": {
-                "disciplines": {
+                "catcode": {
+                    "type": "string",
+                    "example": "WC"
+                },
+                "disciplinecode": {
+                    "type": "string",
+                    "example": "SP"
+                },
+                "nationcode": {
+                    "type": "string",
+                    "example": "FIN"
+                },
+                "place": {
+                    "type": "string",
+                    "example": "Lahti"
+                },
+                "racedate": {
+                    "type": "string",
+                    "example": "2025-02-14"
+                },
+                "raceid": {
+                    "type": "integer",
+                    "example": 123456
+                },
+                "results": {
                     "type": "array",
                     "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "SP",
-                        "DI",
-                        "PU"
-                    ]
+                        "$ref": "#/definitions/swagger.FISHeadToHeadResult"
+                    }
+                },
+                "seasoncode": {
+                    "type": "integer",
+                    "example": 2025
+                }
+            }
+        },
+        "swagger.FISHeadToHeadRecord": {
+            "type": "object",
+            "properties": {
+                "fiscode": {
+                    "type": "integer",
+                    "example": 1234567
+                },
+                "losses": {
+                    "type": "integer",
+                    "example": 6
+                },
+                "opponent": {
+                    "type": "integer",
+                    "example": 7654321
+                },
+                "races": {
+                    "type": "integer",
+                    "example": 18
+                },
+                "undecided": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "wins": {
+                    "type": "integer",
+                    "example": 11
                 }
             }
         },
-        "swagger.FISDisciplinesJPResponse": {
+        "swagger.FISHeadToHeadResponse": {
             "type": "object",
             "properties": {
-                "disciplines": {
+                "fiscodes": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "type": "integer"
                     },
                     "example": [
-                        "NH",
-                        "LH",
-                        "FH"
+                        1234567,
+                        7654321
                     ]
+                },
+                "races": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.FISHeadToHeadRace"
+                    }
+                },
+                "records": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.FISHeadToHeadRecord"
+                    }
+                },
+                "score_kind": {
+                    "type": "string",
+                    "enum": [
+                        "time",
+                        "points"
+                    ],
+                    "example": "time"
                 }
             }
         },
-        "swagger.FISDisciplinesNKResponse": {
+        "swagger.FISHeadToHeadResult": {
             "type": "object",
             "properties": {
-                "disciplines": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "NH",
-                        "LH",
-                        "GH"
-                    ]
+                "behind": {
+                    "type": "number",
+                    "example": 14.8
+                },
+                "competitorname": {
+                    "type": "string",
+                    "example": "DOE John"
+                },
+                "fiscode": {
+                    "type": "integer",
+                    "example": 1234567
+                },
+                "nationcode": {
+                    "type": "string",
+                    "example": "FIN"
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "racepoints": {
+                    "type": "string",
+                    "example": "25.10000"
+                },
+                "racepoints_behind": {
+                    "type": "number",
+                    "example": 4.6
+                },
+                "score": {
+                    "type": "string",
+                    "example": "26:45.0"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "QLF"
                 }
             }
         },
@@ -14043,6 +19683,71 @@ const docTemplate = `{
                 }
             }
         },
+        "swagger.FISJumpRound": {
+            "type": "object",
+            "properties": {
+                "distance": {
+                    "type": "number",
+                    "example": 131.5
+                },
+                "distance_points": {
+                    "type": "number",
+                    "example": 63
+                },
+                "gate": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "gate_points": {
+                    "type": "number",
+                    "example": 3.5
+                },
+                "judge_marks": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    },
+                    "example": [
+                        18,
+                        18.5,
+                        17.5,
+                        19,
+                        18
+                    ]
+                },
+                "judge_points": {
+                    "type": "number",
+                    "example": 54.5
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "round": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "speed": {
+                    "type": "number",
+                    "example": 91.2
+                },
+                "status": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "number",
+                    "example": 125.1
+                },
+                "wind": {
+                    "type": "number",
+                    "example": -0.42
+                },
+                "wind_points": {
+                    "type": "number",
+                    "example": 4.1
+                }
+            }
+        },
         "swagger.FISLastCompetitorResponse": {
             "type": "object",
             "properties": {
@@ -14202,43 +19907,198 @@ const docTemplate = `{
                         "COC"
                     ]
                 },
-                "competitorid": {
+                "competitorid": {
+                    "type": "integer",
+                    "example": 123456
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.FISLatestResultItem"
+                    }
+                },
+                "seasoncode": {
+                    "type": "integer",
+                    "example": 2025
+                },
+                "sector": {
+                    "type": "string",
+                    "example": "CC"
+                }
+            }
+        },
+        "swagger.FISNationsBySectorResponse": {
+            "type": "object",
+            "properties": {
+                "nations": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "FIN",
+                        "NOR",
+                        "SWE"
+                    ]
+                }
+            }
+        },
+        "swagger.FISPointsHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "best": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "fiscode": {
+                    "type": "integer",
+                    "example": 1234567
+                },
+                "history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.FISPointsStanding"
+                    }
+                },
+                "period_months": {
+                    "type": "integer",
+                    "example": 12
+                }
+            }
+        },
+        "swagger.FISPointsListResponse": {
+            "type": "object",
+            "properties": {
+                "best": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "list_date": {
+                    "type": "string",
+                    "example": "2025-06-01"
+                },
+                "period_months": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "standings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.FISPointsStanding"
+                    }
+                }
+            }
+        },
+        "swagger.FISPointsStanding": {
+            "type": "object",
+            "properties": {
+                "competitorname": {
+                    "type": "string",
+                    "example": "DOE John"
+                },
+                "disciplinecode": {
+                    "type": "string",
+                    "example": "DI"
+                },
+                "fiscode": {
+                    "type": "integer",
+                    "example": 1234567
+                },
+                "gender": {
+                    "type": "string",
+                    "example": "M"
+                },
+                "list_date": {
+                    "type": "string",
+                    "example": "2025-06-01"
+                },
+                "nation_rank": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "nationcode": {
+                    "type": "string",
+                    "example": "FIN"
+                },
+                "points": {
+                    "type": "string",
+                    "example": "31.42"
+                },
+                "rank": {
+                    "type": "integer",
+                    "example": 57
+                },
+                "results": {
+                    "type": "integer",
+                    "example": 5
+                }
+            }
+        },
+        "swagger.FISRace": {
+            "type": "object",
+            "properties": {
+                "catcode": {
+                    "type": "string",
+                    "example": "WC"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Individual Gundersen"
+                },
+                "details": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "disciplinecode": {
+                    "type": "string",
+                    "example": "IND"
+                },
+                "eventid": {
+                    "type": "integer",
+                    "example": 7890
+                },
+                "gender": {
+                    "type": "string",
+                    "example": "M"
+                },
+                "lastupdate": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-02-16T08:00:00Z"
+                },
+                "nationcode": {
+                    "type": "string",
+                    "example": "FIN"
+                },
+                "place": {
+                    "type": "string",
+                    "example": "Lahti"
+                },
+                "racedate": {
+                    "type": "string",
+                    "format": "date",
+                    "example": "2025-02-14"
+                },
+                "raceid": {
                     "type": "integer",
                     "example": 123456
                 },
-                "limit": {
-                    "type": "integer",
-                    "example": 50
-                },
-                "results": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/swagger.FISLatestResultItem"
-                    }
-                },
                 "seasoncode": {
                     "type": "integer",
                     "example": 2025
                 },
                 "sector": {
                     "type": "string",
-                    "example": "CC"
-                }
-            }
-        },
-        "swagger.FISNationsBySectorResponse": {
-            "type": "object",
-            "properties": {
-                "nations": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "FIN",
-                        "NOR",
-                        "SWE"
-                    ]
+                    "enum": [
+                        "cc",
+                        "jp",
+                        "nk"
+                    ],
+                    "example": "nk"
                 }
             }
         },
@@ -14844,6 +20704,14 @@ const docTemplate = `{
                 }
             }
         },
+        "swagger.FISRaceEnvelope": {
+            "type": "object",
+            "properties": {
+                "race": {
+                    "$ref": "#/definitions/swagger.FISRace"
+                }
+            }
+        },
         "swagger.FISRaceJP": {
             "type": "object",
             "properties": {
@@ -15540,6 +21408,9 @@ const docTemplate = `{
         "swagger.FISRacesCCResponse": {
             "type": "object",
             "properties": {
+                "pagination": {
+                    "$ref": "#/definitions/swagger.Pagination"
+                },
                 "races": {
                     "type": "array",
                     "items": {
@@ -15597,6 +21468,9 @@ const docTemplate = `{
         "swagger.FISRacesJPResponse": {
             "type": "object",
             "properties": {
+                "pagination": {
+                    "$ref": "#/definitions/swagger.Pagination"
+                },
                 "races": {
                     "type": "array",
                     "items": {
@@ -15608,6 +21482,9 @@ const docTemplate = `{
         "swagger.FISRacesNKResponse": {
             "type": "object",
             "properties": {
+                "pagination": {
+                    "$ref": "#/definitions/swagger.Pagination"
+                },
                 "races": {
                     "type": "array",
                     "items": {
@@ -15662,6 +21539,20 @@ const docTemplate = `{
                 }
             }
         },
+        "swagger.FISRacesResponse": {
+            "type": "object",
+            "properties": {
+                "pagination": {
+                    "$ref": "#/definitions/swagger.Pagination"
+                },
+                "races": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.FISRace"
+                    }
+                }
+            }
+        },
         "swagger.FISRacesSearchItem": {
             "type": "object",
             "properties": {
@@ -15745,6 +21636,72 @@ const docTemplate = `{
                 }
             }
         },
+        "swagger.FISResult": {
+            "type": "object",
+            "properties": {
+                "bib": {
+                    "type": "string",
+                    "example": "10"
+                },
+                "competitorid": {
+                    "type": "integer",
+                    "example": 11111
+                },
+                "competitorname": {
+                    "type": "string",
+                    "example": "DOE John"
+                },
+                "cuppoints": {
+                    "type": "string",
+                    "example": "100.00000"
+                },
+                "details": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "fiscode": {
+                    "type": "integer",
+                    "example": 1234567
+                },
+                "lastupdate": {
+                    "type": "string",
+                    "example": "2025-01-01T12:00:00Z"
+                },
+                "nationcode": {
+                    "type": "string",
+                    "example": "NOR"
+                },
+                "position": {
+                    "type": "string",
+                    "example": "1"
+                },
+                "raceid": {
+                    "type": "integer",
+                    "example": 98765
+                },
+                "racepoints": {
+                    "type": "string",
+                    "example": "2.34"
+                },
+                "recid": {
+                    "type": "integer",
+                    "example": 12345
+                },
+                "sector": {
+                    "type": "string",
+                    "enum": [
+                        "cc",
+                        "jp",
+                        "nk"
+                    ],
+                    "example": "nk"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "QLF"
+                }
+            }
+        },
         "swagger.FISResultCC": {
             "type": "object",
             "properties": {
@@ -16431,6 +22388,83 @@ const docTemplate = `{
                 }
             }
         },
+        "swagger.FISResultsResponse": {
+            "type": "object",
+            "properties": {
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.FISResult"
+                    }
+                }
+            }
+        },
+        "swagger.FISRunTime": {
+            "type": "object",
+            "properties": {
+                "run": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "time": {
+                    "type": "string",
+                    "example": "12:01.3"
+                },
+                "time_ms": {
+                    "type": "integer",
+                    "example": 721300
+                }
+            }
+        },
+        "swagger.FISSeasonStats": {
+            "type": "object",
+            "properties": {
+                "avg_racepoints": {
+                    "type": "string",
+                    "example": "24.37"
+                },
+                "best_position": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "cuppoints": {
+                    "type": "string",
+                    "example": "845.00000"
+                },
+                "dnf": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "dsq": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "podiums": {
+                    "type": "integer",
+                    "example": 6
+                },
+                "racepoints_change": {
+                    "type": "string",
+                    "example": "-3.12"
+                },
+                "seasoncode": {
+                    "type": "integer",
+                    "example": 2025
+                },
+                "starts": {
+                    "type": "integer",
+                    "example": 24
+                },
+                "top10": {
+                    "type": "integer",
+                    "example": 15
+                },
+                "wins": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
         "swagger.FISSeasonsCCResponse": {
             "type": "object",
             "properties": {
@@ -16479,6 +22513,39 @@ const docTemplate = `{
                 }
             }
         },
+        "swagger.FISSectorStats": {
+            "type": "object",
+            "properties": {
+                "best": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.FISBestResult"
+                    }
+                },
+                "career": {
+                    "$ref": "#/definitions/swagger.FISSeasonStats"
+                },
+                "competitorid": {
+                    "type": "integer",
+                    "example": 11111
+                },
+                "seasons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.FISSeasonStats"
+                    }
+                },
+                "sector": {
+                    "type": "string",
+                    "enum": [
+                        "cc",
+                        "jp",
+                        "nk"
+                    ],
+                    "example": "cc"
+                }
+            }
+        },
         "swagger.FISSectorcodeByFiscodeResponse": {
             "type": "object",
             "properties": {
@@ -16492,6 +22559,100 @@ const docTemplate = `{
                 }
             }
         },
+        "swagger.FISSyncCompetitorsInput": {
+            "type": "object",
+            "properties": {
+                "competitors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.FISInsertCompetitorExample"
+                    }
+                }
+            }
+        },
+        "swagger.FISSyncRacesCCInput": {
+            "type": "object",
+            "properties": {
+                "races": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.FISInsertRaceCCExample"
+                    }
+                }
+            }
+        },
+        "swagger.FISSyncRacesJPInput": {
+            "type": "object",
+            "properties": {
+                "races": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.FISInsertRaceJPExample"
+                    }
+                }
+            }
+        },
+        "swagger.FISSyncRacesNKInput": {
+            "type": "object",
+            "properties": {
+                "races": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.FISInsertRaceNKExample"
+                    }
+                }
+            }
+        },
+        "swagger.FISSyncResponse": {
+            "type": "object",
+            "properties": {
+                "inserted": {
+                    "type": "integer",
+                    "example": 1200
+                },
+                "skipped": {
+                    "type": "integer",
+                    "example": 5460
+                },
+                "updated": {
+                    "type": "integer",
+                    "example": 340
+                }
+            }
+        },
+        "swagger.FISSyncResultsCCInput": {
+            "type": "object",
+            "properties": {
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.FISInsertResultCCExample"
+                    }
+                }
+            }
+        },
+        "swagger.FISSyncResultsJPInput": {
+            "type": "object",
+            "properties": {
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.FISInsertResultJPExample"
+                    }
+                }
+            }
+        },
+        "swagger.FISSyncResultsNKInput": {
+            "type": "object",
+            "properties": {
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.FISInsertResultNKExample"
+                    }
+                }
+            }
+        },
         "swagger.FISUpdateAthleteExample": {
             "type": "object",
             "properties": {
@@ -18715,6 +24876,99 @@ const docTemplate = `{
                 }
             }
         },
+        "swagger.IngestJob": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-15T13:11:02Z"
+                },
+                "error": {
+                    "type": "string",
+                    "example": "404 Not Found: users do not exist, please create them first"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2025-01-18T13:11:02Z"
+                },
+                "finished_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "3fa85f64-5717-4562-b3fc-2c963f66afa6"
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "tietoevry_measurements"
+                },
+                "next_attempt_at": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string",
+                    "example": "/v1/tietoevry/measurements"
+                },
+                "record_errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.BulkItemResult"
+                    }
+                },
+                "records_done": {
+                    "type": "integer",
+                    "example": 20000
+                },
+                "records_failed": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "records_total": {
+                    "type": "integer",
+                    "example": 50000
+                },
+                "started_at": {
+                    "type": "string",
+                    "example": "2025-01-15T13:11:03Z"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "queued",
+                        "running",
+                        "succeeded",
+                        "failed"
+                    ],
+                    "example": "running"
+                }
+            }
+        },
+        "swagger.IngestJobEnvelope": {
+            "type": "object",
+            "properties": {
+                "ingest_job": {
+                    "$ref": "#/definitions/swagger.IngestJob"
+                }
+            }
+        },
+        "swagger.IngestJobListResponse": {
+            "type": "object",
+            "properties": {
+                "ingest_jobs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.IngestJob"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/swagger.Pagination"
+                }
+            }
+        },
         "swagger.InternalServerError": {
             "type": "object",
             "properties": {
@@ -19476,6 +25730,9 @@ const docTemplate = `{
                         "$ref": "#/definitions/swagger.KlabMeasurement"
                     }
                 },
+                "pagination": {
+                    "$ref": "#/definitions/swagger.Pagination"
+                },
                 "sportti_id": {
                     "type": "integer",
                     "example": 27353728
@@ -20749,6 +27006,19 @@ const docTemplate = `{
                 }
             }
         },
+        "swagger.Pagination": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "next_cursor": {
+                    "type": "string",
+                    "example": "eyJ0IjoiMjAyNS0wMS0xNVQxMzoxMTowMloiLCJpZCI6IjEyMyJ9"
+                }
+            }
+        },
         "swagger.PolarAlertness": {
             "type": "object",
             "properties": {
@@ -20956,6 +27226,19 @@ const docTemplate = `{
                 }
             }
         },
+        "swagger.ReadinessResponse": {
+            "type": "object",
+            "properties": {
+                "inflight_writes": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "status": {
+                    "type": "string",
+                    "example": "ready"
+                }
+            }
+        },
         "swagger.Sample": {
             "type": "object",
             "properties": {
@@ -21442,6 +27725,9 @@ const docTemplate = `{
             "properties": {
                 "activity_zones": {
                     "$ref": "#/definitions/swagger.TietoevryActivityZoneInput"
+                },
+                "pagination": {
+                    "$ref": "#/definitions/swagger.Pagination"
                 }
             }
         },
@@ -21492,6 +27778,9 @@ const docTemplate = `{
             "properties": {
                 "exercises": {
                     "$ref": "#/definitions/swagger.TietoevryExerciseUpsertInput"
+                },
+                "pagination": {
+                    "$ref": "#/definitions/swagger.Pagination"
                 }
             }
         },
@@ -21699,6 +27988,9 @@ const docTemplate = `{
             "properties": {
                 "measurements": {
                     "$ref": "#/definitions/swagger.TietoevryMeasurementInput"
+                },
+                "pagination": {
+                    "$ref": "#/definitions/swagger.Pagination"
                 }
             }
         },
@@ -21792,6 +28084,9 @@ const docTemplate = `{
         "swagger.TietoevryQuestionnaireAnswerResponse": {
             "type": "object",
             "properties": {
+                "pagination": {
+                    "$ref": "#/definitions/swagger.Pagination"
+                },
                 "questionnaires": {
                     "$ref": "#/definitions/swagger.TietoevryQuestionnaireAnswerInput"
                 }
@@ -21893,6 +28188,9 @@ const docTemplate = `{
         "swagger.TietoevrySymptomResponse": {
             "type": "object",
             "properties": {
+                "pagination": {
+                    "$ref": "#/definitions/swagger.Pagination"
+                },
                 "symptoms": {
                     "$ref": "#/definitions/swagger.TietoevrySymptomInput"
                 }
@@ -21992,6 +28290,9 @@ const docTemplate = `{
         "swagger.TietoevryTestResultResponse": {
             "type": "object",
             "properties": {
+                "pagination": {
+                    "$ref": "#/definitions/swagger.Pagination"
+                },
                 "test_results": {
                     "$ref": "#/definitions/swagger.TietoevryTestResultInput"
                 }
@@ -22069,6 +28370,20 @@ const docTemplate = `{
                 }
             }
         },
+        "swagger.UTVTokensForUpdateResponse": {
+            "type": "object",
+            "properties": {
+                "pagination": {
+                    "$ref": "#/definitions/swagger.Pagination"
+                },
+                "tokens": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.PolarTokenInput"
+                    }
+                }
+            }
+        },
         "swagger.UnauthorizedError": {
             "type": "object",
             "properties": {
@@ -22235,6 +28550,256 @@ const docTemplate = `{
                     }
                 }
             }
+        },
+        "swagger.Webhook": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "athlete_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-15T13:11:02Z"
+                },
+                "domains": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "tietoevry",
+                        "utv"
+                    ]
+                },
+                "entities": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "measurements",
+                        "oura_data"
+                    ]
+                },
+                "id": {
+                    "type": "string",
+                    "example": "3fa85f64-5717-4562-b3fc-2c963f66afa6"
+                },
+                "operations": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "insert",
+                        "upsert"
+                    ]
+                },
+                "secret": {
+                    "type": "string",
+                    "example": "whsec_9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-01-15T13:11:02Z"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://example.com/kuha/events"
+                }
+            }
+        },
+        "swagger.WebhookDelivery": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer",
+                    "example": 8
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-15T13:11:02Z"
+                },
+                "error": {
+                    "type": "string",
+                    "example": "503 Service Unavailable (gave up after 8 attempts)"
+                },
+                "event": {
+                    "$ref": "#/definitions/swagger.WebhookEvent"
+                },
+                "finished_at": {
+                    "type": "string",
+                    "example": "2025-01-15T15:20:41Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 5120
+                },
+                "next_attempt_at": {
+                    "type": "string"
+                },
+                "response_status": {
+                    "type": "integer",
+                    "example": 503
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "succeeded",
+                        "dead"
+                    ],
+                    "example": "dead"
+                }
+            }
+        },
+        "swagger.WebhookDeliveryListResponse": {
+            "type": "object",
+            "properties": {
+                "deliveries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.WebhookDelivery"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/swagger.Pagination"
+                }
+            }
+        },
+        "swagger.WebhookEnvelope": {
+            "type": "object",
+            "properties": {
+                "webhook": {
+                    "$ref": "#/definitions/swagger.Webhook"
+                }
+            }
+        },
+        "swagger.WebhookEvent": {
+            "type": "object",
+            "properties": {
+                "athlete_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "3fa85f64-5717-4562-b3fc-2c963f66afa6"
+                    ]
+                },
+                "domain": {
+                    "type": "string",
+                    "example": "tietoevry"
+                },
+                "entity": {
+                    "type": "string",
+                    "example": "measurements"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1042
+                },
+                "operation": {
+                    "type": "string",
+                    "enum": [
+                        "insert",
+                        "update",
+                        "upsert",
+                        "delete"
+                    ],
+                    "example": "upsert"
+                },
+                "records": {
+                    "type": "integer",
+                    "example": 250
+                },
+                "type": {
+                    "type": "string",
+                    "example": "tietoevry.measurements.upsert"
+                }
+            }
+        },
+        "swagger.WebhookInput": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "athlete_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "3fa85f64-5717-4562-b3fc-2c963f66afa6"
+                    ]
+                },
+                "domains": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "tietoevry",
+                        "utv"
+                    ]
+                },
+                "entities": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "measurements",
+                        "oura_data"
+                    ]
+                },
+                "operations": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "insert",
+                        "upsert"
+                    ]
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://example.com/kuha/events"
+                }
+            }
+        },
+        "swagger.WebhookListResponse": {
+            "type": "object",
+            "properties": {
+                "pagination": {
+                    "$ref": "#/definitions/swagger.Pagination"
+                },
+                "webhooks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.Webhook"
+                    }
+                }
+            }
+        },
+        "swagger.WebhookRedeliverResponse": {
+            "type": "object",
+            "properties": {
+                "requeued": {
+                    "type": "integer",
+                    "example": 12
+                }
+            }
         }
     },
     "securityDefinitions": {
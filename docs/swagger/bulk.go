@@ -0,0 +1,24 @@
+package swagger
+
+// BulkItemResult is the outcome of one item of a bulk request in partial mode
+type BulkItemResult struct {
+	Table  string            `json:"table,omitempty" example:"dirtest"`
+	Index  int               `json:"index" example:"3"`
+	Status string            `json:"status" example:"failed" enums:"inserted,updated,skipped,failed"`
+	Error  string            `json:"error,omitempty" example:"user does not exist. Please create the user first"`
+	Fields map[string]string `json:"fields,omitempty"`
+}
+
+type BulkSummary struct {
+	Total    int `json:"total" example:"10000"`
+	Inserted int `json:"inserted" example:"9000"`
+	Updated  int `json:"updated" example:"998"`
+	Skipped  int `json:"skipped" example:"1"`
+	Failed   int `json:"failed" example:"1"`
+}
+
+// BulkReport is returned by bulk endpoints called with partial=true
+type BulkReport struct {
+	Summary BulkSummary      `json:"summary"`
+	Results []BulkItemResult `json:"results"`
+}
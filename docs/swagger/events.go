@@ -0,0 +1,14 @@
+package swagger
+
+// ChangeEvent is the data of an event in the event stream and the body of
+// a webhook delivery
+type ChangeEvent struct {
+	ID         int64    `json:"id" example:"1042"`
+	Type       string   `json:"type" example:"utv.oura_data.upsert"`
+	Domain     string   `json:"domain" example:"utv"`
+	Entity     string   `json:"entity" example:"oura_data"`
+	Operation  string   `json:"operation" example:"upsert" enums:"insert,update,upsert,delete"`
+	AthleteIDs []string `json:"athlete_ids" example:"3fa85f64-5717-4562-b3fc-2c963f66afa6"`
+	Records    int      `json:"records" example:"1"`
+	OccurredAt string   `json:"occurred_at" example:"2025-01-15T13:11:02Z"`
+}
@@ -0,0 +1,37 @@
+package swagger
+
+import "encoding/json"
+
+// ExportJobInput submits an export job
+type ExportJobInput struct {
+	Kind   string          `json:"kind" example:"fis_results"`
+	Format string          `json:"format" example:"parquet" enums:"csv,parquet,ndjson"`
+	Params json.RawMessage `json:"params" swaggertype:"object"`
+}
+
+// ExportJob is the status of an export job. download_url is set once the
+// job has succeeded.
+type ExportJob struct {
+	ID          string          `json:"id" example:"3fa85f64-5717-4562-b3fc-2c963f66afa6"`
+	Kind        string          `json:"kind" example:"fis_results"`
+	Format      string          `json:"format" example:"parquet"`
+	Params      json.RawMessage `json:"params" swaggertype:"object"`
+	Status      string          `json:"status" example:"running" enums:"queued,running,succeeded,failed,canceled"`
+	Error       *string         `json:"error,omitempty"`
+	RowsWritten int64           `json:"rows_written" example:"250000"`
+	SizeBytes   int64           `json:"size_bytes" example:"18874368"`
+	CreatedAt   string          `json:"created_at" example:"2025-01-15T13:11:02Z"`
+	StartedAt   *string         `json:"started_at,omitempty" example:"2025-01-15T13:11:03Z"`
+	FinishedAt  *string         `json:"finished_at,omitempty"`
+	ExpiresAt   string          `json:"expires_at" example:"2025-01-16T13:11:02Z"`
+	DownloadURL string          `json:"download_url,omitempty" example:"/v1/exports/3fa85f64-5717-4562-b3fc-2c963f66afa6/download"`
+}
+
+type ExportJobEnvelope struct {
+	Export ExportJob `json:"export"`
+}
+
+type ExportJobListResponse struct {
+	Exports    []ExportJob `json:"exports"`
+	Pagination Pagination  `json:"pagination"`
+}
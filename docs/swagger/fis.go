@@ -235,7 +235,8 @@ type FISRaceCC struct {
 }
 
 type FISRacesCCResponse struct {
-	Races []FISRaceCC `json:"races"`
+	Races      []FISRaceCC `json:"races"`
+	Pagination Pagination  `json:"pagination"`
 }
 
 type FISLastRaceCCResponse struct {
@@ -528,7 +529,8 @@ type FISRaceJP struct {
 }
 
 type FISRacesJPResponse struct {
-	Races []FISRaceJP `json:"races"`
+	Races      []FISRaceJP `json:"races"`
+	Pagination Pagination  `json:"pagination"`
 }
 
 type FISLastRaceJPResponse struct {
@@ -828,7 +830,8 @@ type FISRaceNK struct {
 }
 
 type FISRacesNKResponse struct {
-	Races []FISRaceNK `json:"races"`
+	Races      []FISRaceNK `json:"races"`
+	Pagination Pagination  `json:"pagination"`
 }
 
 type FISLastRaceNKResponse struct {
@@ -1091,7 +1094,8 @@ type FISRaceResultsCCResponse struct {
 }
 
 type FISAthleteResultsCCResponse struct {
-	Results []FISAthleteResultCC `json:"results"`
+	Results    []FISAthleteResultCC `json:"results"`
+	Pagination Pagination           `json:"pagination"`
 }
 
 type FISInsertResultCCExample struct {
@@ -1264,7 +1268,8 @@ type FISRaceResultsJPResponse struct {
 }
 
 type FISAthleteResultsJPResponse struct {
-	Results []FISAthleteResultJP `json:"results"`
+	Results    []FISAthleteResultJP `json:"results"`
+	Pagination Pagination           `json:"pagination"`
 }
 
 type FISInsertResultJPExample struct {
@@ -1480,7 +1485,8 @@ type FISRaceResultsNKResponse struct {
 }
 
 type FISAthleteResultsNKResponse struct {
-	Results []FISAthleteResultNK `json:"results"`
+	Results    []FISAthleteResultNK `json:"results"`
+	Pagination Pagination           `json:"pagination"`
 }
 
 type FISInsertResultNKExample struct {
@@ -1839,8 +1845,14 @@ type FISCompetitorFull struct {
 	Classcode    *string `json:"classcode,omitempty"`
 }
 
+type FISCompetitorSearchResult struct {
+	FISCompetitorFull
+	Relevance string `json:"relevance" example:"0.8750"`
+}
+
 type FISCompetitorSearchResponse struct {
-	Competitor FISCompetitorFull `json:"competitors"`
+	Competitors []FISCompetitorSearchResult `json:"competitors"`
+	Pagination  Pagination                  `json:"pagination"`
 }
 
 type FISCompetitorNationCountItem struct {
@@ -1860,3 +1872,416 @@ type FISSectorcodeByFiscodeResponse struct {
 	Fiscode    int32  `json:"fiscode" example:"342001"`
 	Sectorcode string `json:"sectorcode" example:"CC"`
 }
+
+// Sync (bulk upsert) payloads
+
+type FISSyncCompetitorsInput struct {
+	Competitors []FISInsertCompetitorExample `json:"competitors"`
+}
+
+type FISSyncRacesCCInput struct {
+	Races []FISInsertRaceCCExample `json:"races"`
+}
+
+type FISSyncRacesJPInput struct {
+	Races []FISInsertRaceJPExample `json:"races"`
+}
+
+type FISSyncRacesNKInput struct {
+	Races []FISInsertRaceNKExample `json:"races"`
+}
+
+type FISSyncResultsCCInput struct {
+	Results []FISInsertResultCCExample `json:"results"`
+}
+
+type FISSyncResultsJPInput struct {
+	Results []FISInsertResultJPExample `json:"results"`
+}
+
+type FISSyncResultsNKInput struct {
+	Results []FISInsertResultNKExample `json:"results"`
+}
+
+// FISSyncResponse counts the rows of a sync. Skipped rows were not newer
+// than the stored ones.
+type FISSyncResponse struct {
+	Inserted int64 `json:"inserted" example:"1200"`
+	Updated  int64 `json:"updated" example:"340"`
+	Skipped  int64 `json:"skipped" example:"5460"`
+}
+
+// FISChange is a row written (op upsert) or deleted (op delete) since the
+// watermark. data is the row as returned by the other FIS routes.
+type FISChange struct {
+	Op        string         `json:"op" example:"upsert" enums:"upsert,delete"`
+	ID        int32          `json:"id" example:"123456"`
+	ChangedAt string         `json:"changed_at" example:"2025-01-15T13:11:02Z"`
+	Data      map[string]any `json:"data,omitempty"`
+}
+
+type FISChangesResponse struct {
+	Entity     string      `json:"entity" example:"racecc"`
+	Changes    []FISChange `json:"changes"`
+	Watermark  string      `json:"watermark,omitempty" example:"2025-01-15T13:11:02Z"`
+	Pagination Pagination  `json:"pagination"`
+}
+
+// FISRace is a race of any sector. details holds the race with the fields
+// of the sector, as returned by /racecc, /racejp or /racenk.
+type FISRace struct {
+	Sector         string         `json:"sector" example:"nk" enums:"cc,jp,nk"`
+	Raceid         int32          `json:"raceid" example:"123456"`
+	Eventid        *int32         `json:"eventid" example:"7890"`
+	Seasoncode     *int32         `json:"seasoncode" example:"2025"`
+	Disciplinecode *string        `json:"disciplinecode" example:"IND"`
+	Catcode        *string        `json:"catcode" example:"WC"`
+	Gender         *string        `json:"gender" example:"M"`
+	Racedate       *string        `json:"racedate" format:"date" example:"2025-02-14"`
+	Description    *string        `json:"description" example:"Individual Gundersen"`
+	Place          *string        `json:"place" example:"Lahti"`
+	Nationcode     *string        `json:"nationcode" example:"FIN"`
+	Lastupdate     *string        `json:"lastupdate" format:"date-time" example:"2025-02-16T08:00:00Z"`
+	Details        map[string]any `json:"details"`
+}
+
+type FISRacesResponse struct {
+	Races      []FISRace  `json:"races"`
+	Pagination Pagination `json:"pagination"`
+}
+
+type FISRaceEnvelope struct {
+	Race FISRace `json:"race"`
+}
+
+// FISResult is a result of any sector. position and bib are strings in
+// every sector; details holds the result with the fields of the sector, as
+// returned by /resultcc, /resultjp or /resultnk.
+type FISResult struct {
+	Sector         string         `json:"sector" example:"nk" enums:"cc,jp,nk"`
+	Recid          int32          `json:"recid" example:"12345"`
+	Raceid         *int32         `json:"raceid" example:"98765"`
+	Competitorid   *int32         `json:"competitorid" example:"11111"`
+	Fiscode        *int32         `json:"fiscode" example:"1234567"`
+	Competitorname *string        `json:"competitorname" example:"DOE John"`
+	Nationcode     *string        `json:"nationcode" example:"NOR"`
+	Status         *string        `json:"status" example:"QLF"`
+	Position       *string        `json:"position" example:"1"`
+	Bib            *string        `json:"bib" example:"10"`
+	Racepoints     *string        `json:"racepoints" example:"2.34"`
+	Cuppoints      *string        `json:"cuppoints" example:"100.00000"`
+	Lastupdate     *string        `json:"lastupdate" example:"2025-01-01T12:00:00Z"`
+	Details        map[string]any `json:"details"`
+}
+
+type FISResultsResponse struct {
+	Results []FISResult `json:"results"`
+}
+
+// FISAthleteResult is a result of an athlete with its race, in any sector.
+// details holds the row as returned by /resultathletecc, /resultathletejp
+// or /resultathletenk.
+type FISAthleteResult struct {
+	Sector         string         `json:"sector" example:"nk" enums:"cc,jp,nk"`
+	Recid          int32          `json:"recid" example:"12345"`
+	Raceid         *int32         `json:"raceid" example:"98765"`
+	Position       *string        `json:"position" example:"1"`
+	Racedate       *string        `json:"racedate" example:"2025-02-15"`
+	Seasoncode     *int32         `json:"seasoncode" example:"2025"`
+	Disciplinecode *string        `json:"disciplinecode" example:"IND"`
+	Catcode        *string        `json:"catcode" example:"WC"`
+	Place          *string        `json:"place" example:"Lahti"`
+	Details        map[string]any `json:"details"`
+}
+
+type FISAthleteResultsResponse struct {
+	Results    []FISAthleteResult `json:"results"`
+	Pagination Pagination         `json:"pagination"`
+}
+
+// FISCrossSectorResultsResponse holds the results of a FIS code in every
+// sector it was found in. competitors maps the sector to the competitor ID.
+type FISCrossSectorResultsResponse struct {
+	Fiscode     int32              `json:"fiscode" example:"1234567"`
+	Competitors map[string]int32   `json:"competitors"`
+	Results     []FISAthleteResult `json:"results"`
+	Pagination  Pagination         `json:"pagination"`
+}
+
+// FISSeasonStats aggregates the results of a competitor in a season, or
+// over the career (without seasoncode). racepoints_change is the change of
+// the average race points from the previous season.
+type FISSeasonStats struct {
+	Seasoncode       *int32  `json:"seasoncode,omitempty" example:"2025"`
+	Starts           int64   `json:"starts" example:"24"`
+	Wins             int64   `json:"wins" example:"2"`
+	Podiums          int64   `json:"podiums" example:"6"`
+	Top10            int64   `json:"top10" example:"15"`
+	Dnf              int64   `json:"dnf" example:"1"`
+	Dsq              int64   `json:"dsq" example:"0"`
+	BestPosition     *int32  `json:"best_position" example:"1"`
+	AvgRacepoints    *string `json:"avg_racepoints" example:"24.37"`
+	Cuppoints        *string `json:"cuppoints" example:"845.00000"`
+	RacepointsChange *string `json:"racepoints_change,omitempty" example:"-3.12"`
+}
+
+// FISBestResult is the best result of a competitor in a discipline and
+// category. starts counts the results in it; best_racepoints is the lowest.
+type FISBestResult struct {
+	Disciplinecode *string `json:"disciplinecode" example:"SP"`
+	Catcode        *string `json:"catcode" example:"WC"`
+	Starts         int64   `json:"starts" example:"31"`
+	BestPosition   *int32  `json:"best_position" example:"2"`
+	BestRacepoints *string `json:"best_racepoints" example:"8.51000"`
+	Raceid         *int32  `json:"raceid" example:"123456"`
+	Racedate       *string `json:"racedate" example:"2025-02-14"`
+	Seasoncode     *int32  `json:"seasoncode" example:"2025"`
+	Place          *string `json:"place" example:"Lahti"`
+}
+
+type FISSectorStats struct {
+	Sector       string           `json:"sector" example:"cc" enums:"cc,jp,nk"`
+	Competitorid int32            `json:"competitorid" example:"11111"`
+	Career       FISSeasonStats   `json:"career"`
+	Seasons      []FISSeasonStats `json:"seasons"`
+	Best         []FISBestResult  `json:"best"`
+}
+
+type FISCompetitorStatsResponse struct {
+	Fiscode int32            `json:"fiscode" example:"1234567"`
+	Sectors []FISSectorStats `json:"sectors"`
+}
+
+// FISHeadToHeadResult is the result of a compared competitor in a race.
+// behind is the gap to the best placed of the compared competitors, in
+// seconds (time) or points (points); racepoints_behind in race points.
+type FISHeadToHeadResult struct {
+	Fiscode          *int32   `json:"fiscode" example:"1234567"`
+	Competitorname   *string  `json:"competitorname" example:"DOE John"`
+	Nationcode       *string  `json:"nationcode" example:"FIN"`
+	Status           *string  `json:"status" example:"QLF"`
+	Position         *int32   `json:"position" example:"7"`
+	Score            *string  `json:"score" example:"26:45.0"`
+	Racepoints       *string  `json:"racepoints" example:"25.10000"`
+	Behind           *float64 `json:"behind" example:"14.8"`
+	RacepointsBehind *float64 `json:"racepoints_behind" example:"4.6"`
+}
+
+type FISHeadToHeadRace struct {
+	Raceid         int32                 `json:"raceid" example:"123456"`
+	Racedate       *string               `json:"racedate" example:"2025-02-14"`
+	Seasoncode     *int32                `json:"seasoncode" example:"2025"`
+	Disciplinecode *string               `json:"disciplinecode" example:"SP"`
+	Catcode        *string               `json:"catcode" example:"WC"`
+	Place          *string               `json:"place" example:"Lahti"`
+	Nationcode     *string               `json:"nationcode" example:"FIN"`
+	Results        []FISHeadToHeadResult `json:"results"`
+}
+
+// FISHeadToHeadRecord is the record of fiscode against opponent in the
+// races both competed in
+type FISHeadToHeadRecord struct {
+	Fiscode   int32 `json:"fiscode" example:"1234567"`
+	Opponent  int32 `json:"opponent" example:"7654321"`
+	Races     int   `json:"races" example:"18"`
+	Wins      int   `json:"wins" example:"11"`
+	Losses    int   `json:"losses" example:"6"`
+	Undecided int   `json:"undecided" example:"1"`
+}
+
+type FISHeadToHeadResponse struct {
+	Fiscodes  []int32               `json:"fiscodes" example:"1234567,7654321"`
+	ScoreKind string                `json:"score_kind" example:"time" enums:"time,points"`
+	Records   []FISHeadToHeadRecord `json:"records"`
+	Races     []FISHeadToHeadRace   `json:"races"`
+}
+
+// FISPointsStanding is the standing of a competitor in a FIS points list.
+// points is the average of the best race points of the period.
+type FISPointsStanding struct {
+	ListDate       string  `json:"list_date" example:"2025-06-01"`
+	Disciplinecode *string `json:"disciplinecode" example:"DI"`
+	Gender         *string `json:"gender" example:"M"`
+	Fiscode        *int32  `json:"fiscode" example:"1234567"`
+	Competitorname *string `json:"competitorname" example:"DOE John"`
+	Nationcode     *string `json:"nationcode" example:"FIN"`
+	Results        int64   `json:"results" example:"5"`
+	Points         string  `json:"points" example:"31.42"`
+	Rank           int64   `json:"rank" example:"57"`
+	NationRank     int64   `json:"nation_rank" example:"4"`
+}
+
+type FISPointsListResponse struct {
+	ListDate     string              `json:"list_date" example:"2025-06-01"`
+	PeriodMonths int32               `json:"period_months" example:"12"`
+	Best         int32               `json:"best" example:"5"`
+	Standings    []FISPointsStanding `json:"standings"`
+}
+
+type FISPointsHistoryResponse struct {
+	Fiscode      int32               `json:"fiscode" example:"1234567"`
+	PeriodMonths int32               `json:"period_months" example:"12"`
+	Best         int32               `json:"best" example:"5"`
+	History      []FISPointsStanding `json:"history"`
+}
+
+type FISRunTime struct {
+	Run    int    `json:"run" example:"1"`
+	Time   string `json:"time" example:"12:01.3"`
+	TimeMs int64  `json:"time_ms" example:"721300"`
+}
+
+// FISJumpRound is a round of a jumping result. judge_points is the sum of
+// the three middle judge marks.
+type FISJumpRound struct {
+	Round          int        `json:"round" example:"1"`
+	Status         *string    `json:"status"`
+	Position       *int32     `json:"position" example:"4"`
+	Speed          *float64   `json:"speed" example:"91.2"`
+	Distance       *float64   `json:"distance" example:"131.5"`
+	DistancePoints *float64   `json:"distance_points" example:"63"`
+	JudgeMarks     []*float64 `json:"judge_marks" example:"18,18.5,17.5,19,18"`
+	JudgePoints    *float64   `json:"judge_points" example:"54.5"`
+	Gate           *int32     `json:"gate" example:"12"`
+	GatePoints     *float64   `json:"gate_points" example:"3.5"`
+	Wind           *float64   `json:"wind" example:"-0.42"`
+	WindPoints     *float64   `json:"wind_points" example:"4.1"`
+	Total          *float64   `json:"total" example:"125.1"`
+}
+
+// FISEnrichedResult is a race result with competitor data and parsed times
+// and points. time fields are set in CC and NK, points fields in JP.
+type FISEnrichedResult struct {
+	Recid          int32   `json:"recid" example:"987654"`
+	Fiscode        *int32  `json:"fiscode" example:"1234567"`
+	Competitorname *string `json:"competitorname" example:"DOE John"`
+	Nationcode     *string `json:"nationcode" example:"FIN"`
+	Birthdate      *string `json:"birthdate" example:"1998-03-14"`
+	Skiclub        *string `json:"skiclub" example:"Lahden Hiihtoseura"`
+	Status         *string `json:"status" example:"QLF"`
+	Position       *int32  `json:"position" example:"7"`
+	NationRank     *int32  `json:"nation_rank" example:"2"`
+	Bib            *int32  `json:"bib" example:"31"`
+	Racepoints     *string `json:"racepoints" example:"25.10000"`
+	Cuppoints      *string `json:"cuppoints" example:"36.00000"`
+
+	Time         *string  `json:"time,omitempty" example:"26:45.0"`
+	TimeMs       *int64   `json:"time_ms,omitempty" example:"1605000"`
+	BehindMs     *int64   `json:"behind_ms,omitempty" example:"14800"`
+	Points       *float64 `json:"points,omitempty" example:"265.4"`
+	BehindPoints *float64 `json:"behind_points,omitempty" example:"12.6"`
+
+	RunTimes    []FISRunTime `json:"run_times,omitempty"`
+	BonusTimeMs *int64       `json:"bonus_time_ms,omitempty" example:"6000"`
+
+	Rounds []FISJumpRound `json:"rounds,omitempty"`
+
+	JumpPoints   *float64 `json:"jump_points,omitempty" example:"121.3"`
+	JumpPosition *int32   `json:"jump_position,omitempty" example:"5"`
+	CCTime       *string  `json:"cc_time,omitempty" example:"24:10.2"`
+	CCTimeMs     *int64   `json:"cc_time_ms,omitempty" example:"1450200"`
+	CCPosition   *int32   `json:"cc_position,omitempty" example:"9"`
+}
+
+type FISEnrichedResultsResponse struct {
+	Race      FISRace             `json:"race"`
+	ScoreKind string              `json:"score_kind" example:"time" enums:"time,points"`
+	Results   []FISEnrichedResult `json:"results"`
+}
+
+type FISCalendarRace struct {
+	Sector         string  `json:"sector" example:"cc"`
+	Raceid         int32   `json:"raceid" example:"123456"`
+	Racedate       *string `json:"racedate" example:"2026-03-01"`
+	Disciplinecode *string `json:"disciplinecode" example:"DI"`
+	Catcode        *string `json:"catcode" example:"WC"`
+	Gender         *string `json:"gender" example:"M"`
+	Description    *string `json:"description" example:"Men's 10km Interval Start"`
+	Calstatuscode  *string `json:"calstatuscode" example:"O"`
+}
+
+// FISCalendarEvent groups the races of an event; start_date and end_date
+// are the first and last race dates of the whole event
+type FISCalendarEvent struct {
+	Eventid    *int32            `json:"eventid" example:"55123"`
+	Place      *string           `json:"place" example:"Lahti"`
+	Nationcode *string           `json:"nationcode" example:"FIN"`
+	StartDate  string            `json:"start_date" example:"2026-02-27"`
+	EndDate    string            `json:"end_date" example:"2026-03-01"`
+	Sectors    []string          `json:"sectors" example:"cc,jp,nk"`
+	Catcodes   []string          `json:"catcodes" example:"WC"`
+	Cancelled  bool              `json:"cancelled" example:"false"`
+	Races      []FISCalendarRace `json:"races"`
+}
+
+type FISCalendarResponse struct {
+	From   string             `json:"from" example:"2026-01-01"`
+	To     string             `json:"to" example:"2026-03-31"`
+	Count  int                `json:"count" example:"12"`
+	Events []FISCalendarEvent `json:"events"`
+}
+
+// FISCalendarFeed is a calendar feed of a client. token and url are only
+// returned when the feed is created.
+type FISCalendarFeed struct {
+	ID         string  `json:"id" example:"3fa85f64-5717-4562-b3fc-2c963f66afa6"`
+	Token      string  `json:"token,omitempty" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	URL        string  `json:"url,omitempty" example:"/v1/fis/calendar?format=ics&token=9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	CreatedAt  string  `json:"created_at" example:"2026-01-15T13:11:02Z"`
+	LastUsedAt *string `json:"last_used_at" example:"2026-01-16T06:00:00Z"`
+}
+
+type FISCalendarFeedEnvelope struct {
+	Feed FISCalendarFeed `json:"feed"`
+}
+
+type FISCalendarFeedListResponse struct {
+	Feeds []FISCalendarFeed `json:"feeds"`
+}
+
+type FISAthleteLinkMatchSummary struct {
+	Sources     []string `json:"sources" example:"klab,archinisis,tietoevry"`
+	Competitors int      `json:"competitors" example:"1840"`
+	Athletes    int      `json:"athletes" example:"2315"`
+	Candidates  int      `json:"candidates" example:"412"`
+	Saved       int64    `json:"saved" example:"398"`
+}
+
+type FISAthleteLinkMatchResponse struct {
+	Summary FISAthleteLinkMatchSummary `json:"summary"`
+}
+
+// FISAthleteLinkEvidence is how a candidate matched, without the identities
+// themselves; birthdate_match and gender_match are left out when a side is
+// unknown, and the conflicts are set when the sources of the athlete
+// disagree
+type FISAthleteLinkEvidence struct {
+	NameSimilarity    float64 `json:"name_similarity" example:"1"`
+	BirthdateMatch    *bool   `json:"birthdate_match,omitempty" example:"true"`
+	BirthdateConflict bool    `json:"birthdate_conflict,omitempty" example:"false"`
+	GenderMatch       *bool   `json:"gender_match,omitempty" example:"true"`
+	GenderConflict    bool    `json:"gender_conflict,omitempty" example:"false"`
+}
+
+type FISAthleteLinkCandidate struct {
+	ID         int32                  `json:"id" example:"17"`
+	Fiscode    int32                  `json:"fiscode" example:"3420586"`
+	Sporttiid  int32                  `json:"sporttiid" example:"123456"`
+	Confidence string                 `json:"confidence" example:"0.996"`
+	Sources    []string               `json:"sources" example:"klab,tietoevry"`
+	Evidence   FISAthleteLinkEvidence `json:"evidence"`
+	Status     string                 `json:"status" example:"pending" enums:"pending,approved,rejected"`
+	CreatedAt  string                 `json:"created_at" example:"2026-10-19T08:00:00Z"`
+	UpdatedAt  string                 `json:"updated_at" example:"2026-10-19T08:00:00Z"`
+	ReviewedBy *string                `json:"reviewed_by" example:"kuha-admin"`
+	ReviewedAt *string                `json:"reviewed_at" example:"2026-10-19T09:30:00Z"`
+}
+
+type FISAthleteLinkCandidateResponse struct {
+	Candidate FISAthleteLinkCandidate `json:"candidate"`
+}
+
+type FISAthleteLinkCandidateListResponse struct {
+	Candidates []FISAthleteLinkCandidate `json:"candidates"`
+	Pagination Pagination                `json:"pagination"`
+}
@@ -15,3 +15,9 @@ type HealthStatusResponse struct {
 	UptimeSeconds int64  `json:"uptime_seconds" example:"149039"`
 	Version       string `json:"version" example:"1.2.1"`
 }
+
+// Readiness
+type ReadinessResponse struct {
+	Status         string `json:"status" example:"ready"`
+	InflightWrites int    `json:"inflight_writes" example:"0"`
+}
@@ -0,0 +1,30 @@
+package swagger
+
+// IngestJob is the status of an ingest job. record_errors lists the records
+// that failed in partial mode, indexed like the request body.
+type IngestJob struct {
+	ID            string           `json:"id" example:"3fa85f64-5717-4562-b3fc-2c963f66afa6"`
+	Kind          string           `json:"kind" example:"tietoevry_measurements"`
+	Path          string           `json:"path" example:"/v1/tietoevry/measurements"`
+	Status        string           `json:"status" example:"running" enums:"queued,running,succeeded,failed"`
+	Error         *string          `json:"error,omitempty" example:"404 Not Found: users do not exist, please create them first"`
+	RecordsTotal  int32            `json:"records_total" example:"50000"`
+	RecordsDone   int32            `json:"records_done" example:"20000"`
+	RecordsFailed int32            `json:"records_failed" example:"2"`
+	RecordErrors  []BulkItemResult `json:"record_errors"`
+	Attempts      int32            `json:"attempts" example:"1"`
+	NextAttemptAt *string          `json:"next_attempt_at,omitempty"`
+	CreatedAt     string           `json:"created_at" example:"2025-01-15T13:11:02Z"`
+	StartedAt     *string          `json:"started_at,omitempty" example:"2025-01-15T13:11:03Z"`
+	FinishedAt    *string          `json:"finished_at,omitempty"`
+	ExpiresAt     string           `json:"expires_at" example:"2025-01-18T13:11:02Z"`
+}
+
+type IngestJobEnvelope struct {
+	IngestJob IngestJob `json:"ingest_job"`
+}
+
+type IngestJobListResponse struct {
+	IngestJobs []IngestJob `json:"ingest_jobs"`
+	Pagination Pagination  `json:"pagination"`
+}
@@ -390,6 +390,7 @@ type KlabDataResponse struct {
 	DirReport    []KlabDirReport   `json:"dirreport"`
 	DirRawData   []KlabDirRawData  `json:"dirrawdata"`
 	DirResults   []KlabDirResults  `json:"dirresults"`
+	Pagination   Pagination        `json:"pagination"`
 }
 
 // Updated to show multiple entries in arrays
@@ -0,0 +1,8 @@
+package swagger
+
+// Pagination of list responses. next_cursor is omitted on the last page;
+// the same URL is also sent in the Link header (rel="next").
+type Pagination struct {
+	Limit      int32  `json:"limit" example:"100"`
+	NextCursor string `json:"next_cursor,omitempty" example:"eyJ0IjoiMjAyNS0wMS0xNVQxMzoxMTowMloiLCJpZCI6IjEyMyJ9"`
+}
@@ -215,25 +215,31 @@ type TietoevryDeletedUsersResponse struct {
 }
 
 type TietoevryExerciseResponse struct {
-	Exercise TietoevryExerciseUpsertInput `json:"exercises"`
+	Exercise   TietoevryExerciseUpsertInput `json:"exercises"`
+	Pagination Pagination                   `json:"pagination"`
 }
 
 type TietoevrySymptomResponse struct {
-	Symptom TietoevrySymptomInput `json:"symptoms"`
+	Symptom    TietoevrySymptomInput `json:"symptoms"`
+	Pagination Pagination            `json:"pagination"`
 }
 
 type TietoevryMeasurementResponse struct {
 	Measurement TietoevryMeasurementInput `json:"measurements"`
+	Pagination  Pagination                `json:"pagination"`
 }
 
 type TietoevryTestResultResponse struct {
 	TestResult TietoevryTestResultInput `json:"test_results"`
+	Pagination Pagination               `json:"pagination"`
 }
 
 type TietoevryQuestionnaireAnswerResponse struct {
 	QuestionnaireAnswer TietoevryQuestionnaireAnswerInput `json:"questionnaires"`
+	Pagination          Pagination                        `json:"pagination"`
 }
 
 type TietoevryActivityZoneResponse struct {
 	ActivityZone TietoevryActivityZoneInput `json:"activity_zones"`
+	Pagination   Pagination                 `json:"pagination"`
 }
@@ -249,6 +249,11 @@ type PolarTokenInput struct {
 	Data   PolarTokenDetails `json:"data"`
 }
 
+type UTVTokensForUpdateResponse struct {
+	Tokens     []PolarTokenInput `json:"tokens"`
+	Pagination Pagination        `json:"pagination"`
+}
+
 type PolarStatusResponse struct {
 	Connected bool `json:"connected" example:"true"`
 	Data      bool `json:"data" example:"true"`
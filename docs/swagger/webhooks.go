@@ -0,0 +1,69 @@
+package swagger
+
+// WebhookInput creates or replaces a webhook subscription. Empty filters
+// match everything; no domains means every domain the client can read.
+type WebhookInput struct {
+	URL        string   `json:"url" example:"https://example.com/kuha/events"`
+	Domains    []string `json:"domains" example:"tietoevry,utv"`
+	Entities   []string `json:"entities" example:"measurements,oura_data"`
+	Operations []string `json:"operations" example:"insert,upsert"`
+	AthleteIDs []string `json:"athlete_ids" example:"3fa85f64-5717-4562-b3fc-2c963f66afa6"`
+	Active     *bool    `json:"active" example:"true"`
+}
+
+// Webhook is a webhook subscription. secret is only returned when the
+// subscription is created or its secret rotated.
+type Webhook struct {
+	ID         string   `json:"id" example:"3fa85f64-5717-4562-b3fc-2c963f66afa6"`
+	URL        string   `json:"url" example:"https://example.com/kuha/events"`
+	Secret     string   `json:"secret,omitempty" example:"whsec_9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	Domains    []string `json:"domains" example:"tietoevry,utv"`
+	Entities   []string `json:"entities" example:"measurements,oura_data"`
+	Operations []string `json:"operations" example:"insert,upsert"`
+	AthleteIDs []string `json:"athlete_ids"`
+	Active     bool     `json:"active" example:"true"`
+	CreatedAt  string   `json:"created_at" example:"2025-01-15T13:11:02Z"`
+	UpdatedAt  string   `json:"updated_at" example:"2025-01-15T13:11:02Z"`
+}
+
+type WebhookEnvelope struct {
+	Webhook Webhook `json:"webhook"`
+}
+
+type WebhookListResponse struct {
+	Webhooks   []Webhook  `json:"webhooks"`
+	Pagination Pagination `json:"pagination"`
+}
+
+// WebhookEvent is a change event, as sent in the body of a delivery
+type WebhookEvent struct {
+	ID         int64    `json:"id" example:"1042"`
+	Type       string   `json:"type" example:"tietoevry.measurements.upsert"`
+	Domain     string   `json:"domain" example:"tietoevry"`
+	Entity     string   `json:"entity" example:"measurements"`
+	Operation  string   `json:"operation" example:"upsert" enums:"insert,update,upsert,delete"`
+	AthleteIDs []string `json:"athlete_ids" example:"3fa85f64-5717-4562-b3fc-2c963f66afa6"`
+	Records    int      `json:"records" example:"250"`
+}
+
+// WebhookDelivery is a delivery of an event to a subscription
+type WebhookDelivery struct {
+	ID             int64        `json:"id" example:"5120"`
+	Status         string       `json:"status" example:"dead" enums:"pending,succeeded,dead"`
+	Attempts       int32        `json:"attempts" example:"8"`
+	NextAttemptAt  *string      `json:"next_attempt_at,omitempty"`
+	ResponseStatus *int32       `json:"response_status,omitempty" example:"503"`
+	Error          *string      `json:"error,omitempty" example:"503 Service Unavailable (gave up after 8 attempts)"`
+	CreatedAt      string       `json:"created_at" example:"2025-01-15T13:11:02Z"`
+	FinishedAt     *string      `json:"finished_at,omitempty" example:"2025-01-15T15:20:41Z"`
+	Event          WebhookEvent `json:"event"`
+}
+
+type WebhookDeliveryListResponse struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+	Pagination Pagination        `json:"pagination"`
+}
+
+type WebhookRedeliverResponse struct {
+	Requeued int64 `json:"requeued" example:"12"`
+}
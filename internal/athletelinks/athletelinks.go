@@ -0,0 +1,405 @@
+// Package athletelinks proposes links between FIS competitors and Finnish
+// athletes: the Finnish competitors of the FIS database whose fiscode is not
+// in athlete yet are matched by name and birthdate with the people known by
+// sportti_id to K-Lab and Archinisis, leaving out the sportti_ids already in
+// athlete. Tietoevry has no names, so its birthdates and genders only
+// corroborate, or contradict, the other sources. Candidates are saved for
+// review; nothing is linked until a candidate is approved.
+package athletelinks
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/store"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/archinisis"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/klab"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/tietoevry"
+)
+
+const (
+	// MinNameSimilarity is the name similarity a candidate needs
+	MinNameSimilarity = 0.85
+	// MinConfidence is the confidence a candidate needs to be saved
+	MinConfidence = 0.6
+
+	// genderMismatchPenalty is taken off the confidence when the gender
+	// of the competitor and the athlete differ
+	genderMismatchPenalty = 0.3
+	// sourceConflictPenalty is taken off the confidence for each field the
+	// sources of the athlete disagree on
+	sourceConflictPenalty = 0.2
+)
+
+// Identity sources
+const (
+	SourceKLab       = "klab"
+	SourceArchinisis = "archinisis"
+	SourceTietoevry  = "tietoevry"
+)
+
+// identity is what a source knows about a sportti_id. It is only used for
+// the matching and never saved.
+type identity struct {
+	source    string
+	firstname string
+	lastname  string
+	birthdate string
+	gender    string
+}
+
+// competitor is the FIS side of a candidate
+type competitor struct {
+	birthdate string
+	gender    string
+}
+
+// Evidence is saved with a candidate for the reviewer: how each field
+// matched, without the names or birthdates of either side. BirthdateMatch
+// and GenderMatch are left out when one of the sides is unknown; the
+// conflicts tell that the sources of the athlete disagree on the field.
+type Evidence struct {
+	NameSimilarity    float64 `json:"name_similarity"`
+	BirthdateMatch    *bool   `json:"birthdate_match,omitempty"`
+	BirthdateConflict bool    `json:"birthdate_conflict,omitempty"`
+	GenderMatch       *bool   `json:"gender_match,omitempty"`
+	GenderConflict    bool    `json:"gender_conflict,omitempty"`
+}
+
+// Summary is the outcome of a matching run
+type Summary struct {
+	Sources     []string `json:"sources"`
+	Competitors int      `json:"competitors"`
+	Athletes    int      `json:"athletes"`
+	Candidates  int      `json:"candidates"`
+	Saved       int64    `json:"saved"`
+}
+
+// person gathers the identities of a sportti_id. The birthdates and
+// genders of every source are kept, so that sources that disagree are seen.
+type person struct {
+	sporttiid  int32
+	identities []identity
+	names      [][2]string
+	birthdates []string
+	genders    []string
+}
+
+func (p *person) add(id identity) {
+	p.identities = append(p.identities, id)
+	if id.firstname != "" || id.lastname != "" {
+		name := [2]string{normalizeName(id.firstname), normalizeName(id.lastname)}
+		if !slices.Contains(p.names, name) {
+			p.names = append(p.names, name)
+		}
+	}
+	if id.birthdate != "" && !slices.Contains(p.birthdates, id.birthdate) {
+		p.birthdates = append(p.birthdates, id.birthdate)
+	}
+	if id.gender != "" && !slices.Contains(p.genders, id.gender) {
+		p.genders = append(p.genders, id.gender)
+	}
+}
+
+func (p *person) sources() []string {
+	var sources []string
+	for _, id := range p.identities {
+		if !slices.Contains(sources, id.source) {
+			sources = append(sources, id.source)
+		}
+	}
+	slices.Sort(sources)
+	return sources
+}
+
+// Matcher runs the matching against the databases that are connected
+type Matcher struct {
+	fis        fis.AthleteLinks
+	klab       klab.Users
+	archinisis archinisis.Users
+	tietoevry  tietoevry.Users
+}
+
+// NewMatcher returns nil if the FIS database is not connected
+func NewMatcher(s store.Storage) *Matcher {
+	if s.FIS == nil {
+		return nil
+	}
+	m := &Matcher{fis: s.FIS.AthleteLinks()}
+	if s.KLAB != nil {
+		m.klab = s.KLAB.Users()
+	}
+	if s.ARCHINISIS != nil {
+		m.archinisis = s.ARCHINISIS.Users()
+	}
+	if s.Tietoevry != nil {
+		m.tietoevry = s.Tietoevry.Users()
+	}
+	return m
+}
+
+// Run matches the unlinked Finnish competitors and saves the candidates
+func (m *Matcher) Run(ctx context.Context) (Summary, error) {
+	people, sources, err := m.loadPeople(ctx)
+	if err != nil {
+		return Summary{}, err
+	}
+	competitors, err := m.fis.ListUnlinkedFinnishCompetitors(ctx)
+	if err != nil {
+		return Summary{}, err
+	}
+
+	candidates := match(competitors, people)
+	saved, err := m.fis.ProposeCandidates(ctx, candidates)
+	if err != nil {
+		return Summary{}, err
+	}
+
+	return Summary{
+		Sources:     sources,
+		Competitors: len(competitors),
+		Athletes:    len(people),
+		Candidates:  len(candidates),
+		Saved:       saved,
+	}, nil
+}
+
+// loadPeople reads the identities of every connected source, by sportti_id.
+// K-Lab and Archinisis store the sportti_id as text; rows whose sportti_id
+// is not a number, or is in athlete already, are skipped.
+func (m *Matcher) loadPeople(ctx context.Context) (map[int32]*person, []string, error) {
+	linkedIDs, err := m.fis.ListLinkedSporttiIDs(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	linked := make(map[int32]bool, len(linkedIDs))
+	for _, sid := range linkedIDs {
+		linked[sid] = true
+	}
+
+	people := map[int32]*person{}
+	add := func(sporttiid int32, id identity) {
+		if linked[sporttiid] {
+			return
+		}
+		p, ok := people[sporttiid]
+		if !ok {
+			p = &person{sporttiid: sporttiid}
+			people[sporttiid] = p
+		}
+		p.add(id)
+	}
+
+	var sources []string
+	if m.klab != nil {
+		rows, err := m.klab.ListCustomerIdentities(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, row := range rows {
+			if sid, ok := parseSporttiID(row.SporttiID.String); ok {
+				add(sid, identity{
+					source:    SourceKLab,
+					firstname: strings.TrimSpace(row.Firstname),
+					lastname:  strings.TrimSpace(row.Lastname),
+					birthdate: formatDate(row.Dob.Time, row.Dob.Valid),
+				})
+			}
+		}
+		sources = append(sources, SourceKLab)
+	}
+	if m.archinisis != nil {
+		rows, err := m.archinisis.ListAthleteIdentities(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, row := range rows {
+			if sid, ok := parseSporttiID(row.NationalID); ok {
+				add(sid, identity{
+					source:    SourceArchinisis,
+					firstname: strings.TrimSpace(row.FirstName.String),
+					lastname:  strings.TrimSpace(row.LastName.String),
+					birthdate: formatDate(row.DateOfBirth.Time, row.DateOfBirth.Valid),
+				})
+			}
+		}
+		sources = append(sources, SourceArchinisis)
+	}
+	if m.tietoevry != nil {
+		rows, err := m.tietoevry.ListUserIdentities(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, row := range rows {
+			// without a name a Tietoevry user can't be matched on its own
+			if p, ok := people[row.SporttiID]; ok {
+				p.add(identity{
+					source:    SourceTietoevry,
+					birthdate: formatDate(row.ProfileBirthdate.Time, row.ProfileBirthdate.Valid),
+					gender:    fisGender(row.ProfileGender.String),
+				})
+			}
+		}
+		sources = append(sources, SourceTietoevry)
+	}
+
+	return people, sources, nil
+}
+
+// match scores the people against every competitor. People are compared
+// with the competitors born the same day and, when either birthdate is
+// unknown, with those sharing a word of their first or last name, so that
+// swapped names and last names spelled differently ("Makela" and
+// "Maekelae") are still found through the other name.
+func match(competitors []fissqlc.ListUnlinkedFinnishCompetitorsRow, people map[int32]*person) []fis.LinkCandidate {
+	byBirthdate := map[string][]*person{}
+	undatedByNamePart := map[string][]*person{}
+	byNamePart := map[string][]*person{}
+	for _, p := range people {
+		for _, bd := range p.birthdates {
+			byBirthdate[bd] = append(byBirthdate[bd], p)
+		}
+		for _, name := range p.names {
+			for _, part := range nameParts(name[0], name[1]) {
+				if len(p.birthdates) == 0 && !slices.Contains(undatedByNamePart[part], p) {
+					undatedByNamePart[part] = append(undatedByNamePart[part], p)
+				}
+				if !slices.Contains(byNamePart[part], p) {
+					byNamePart[part] = append(byNamePart[part], p)
+				}
+			}
+		}
+	}
+
+	var candidates []fis.LinkCandidate
+	for _, c := range competitors {
+		first, last := normalizeName(c.Firstname.String), normalizeName(c.Lastname.String)
+		if last == "" {
+			continue
+		}
+		comp := competitor{
+			birthdate: formatDate(c.Birthdate.Time, c.Birthdate.Valid),
+			gender:    c.Gender.String,
+		}
+
+		pool := byBirthdate[comp.birthdate]
+		for _, part := range nameParts(first, last) {
+			if comp.birthdate != "" {
+				pool = slices.Concat(pool, undatedByNamePart[part])
+			} else {
+				pool = slices.Concat(pool, byNamePart[part])
+			}
+		}
+
+		seen := map[int32]bool{}
+		for _, p := range pool {
+			if seen[p.sporttiid] {
+				continue
+			}
+			seen[p.sporttiid] = true
+
+			if cand, ok := score(c.Fiscode, comp, first, last, p); ok {
+				candidates = append(candidates, cand)
+			}
+		}
+	}
+
+	slices.SortFunc(candidates, func(a, b fis.LinkCandidate) int {
+		if a.Fiscode != b.Fiscode {
+			return int(a.Fiscode) - int(b.Fiscode)
+		}
+		return int(a.Sporttiid) - int(b.Sporttiid)
+	})
+	return candidates
+}
+
+// score returns the candidate linking the competitor to p, if confident
+// enough. A birthdate match makes up most of the confidence; without
+// birthdates the name alone gives at most 0.75. Each field the sources of p
+// disagree on lowers the confidence, even when one of them matches.
+func score(fiscode int32, comp competitor, first, last string, p *person) (fis.LinkCandidate, bool) {
+	var sim float64
+	for _, name := range p.names {
+		sim = max(sim, nameSimilarity(first, last, name[0], name[1]))
+	}
+	sim = math.Round(sim*1000) / 1000
+	if sim < MinNameSimilarity {
+		return fis.LinkCandidate{}, false
+	}
+
+	ev := Evidence{NameSimilarity: sim}
+
+	confidence := 0.75 * sim
+	if comp.birthdate != "" && len(p.birthdates) > 0 {
+		ok := slices.Contains(p.birthdates, comp.birthdate)
+		if !ok {
+			return fis.LinkCandidate{}, false
+		}
+		ev.BirthdateMatch = &ok
+		confidence = 0.6 + 0.4*sim
+	}
+	if len(p.birthdates) > 1 {
+		ev.BirthdateConflict = true
+		confidence -= sourceConflictPenalty
+	}
+	if comp.gender != "" && len(p.genders) > 0 {
+		ok := slices.ContainsFunc(p.genders, func(g string) bool {
+			return strings.EqualFold(comp.gender, g)
+		})
+		ev.GenderMatch = &ok
+		if !ok {
+			confidence -= genderMismatchPenalty
+		}
+	}
+	if len(p.genders) > 1 {
+		ev.GenderConflict = true
+		confidence -= sourceConflictPenalty
+	}
+
+	confidence = math.Round(min(confidence, 1)*1000) / 1000
+	if confidence < MinConfidence {
+		return fis.LinkCandidate{}, false
+	}
+
+	evidence, err := json.Marshal(ev)
+	if err != nil {
+		return fis.LinkCandidate{}, false
+	}
+	return fis.LinkCandidate{
+		Fiscode:    fiscode,
+		Sporttiid:  p.sporttiid,
+		Confidence: confidence,
+		Sources:    p.sources(),
+		Evidence:   evidence,
+	}, true
+}
+
+func parseSporttiID(s string) (int32, bool) {
+	n, err := strconv.ParseInt(strings.TrimSpace(s), 10, 32)
+	return int32(n), err == nil && n > 0
+}
+
+func formatDate(t time.Time, valid bool) string {
+	if !valid {
+		return ""
+	}
+	return t.Format(time.DateOnly)
+}
+
+// fisGender maps a Tietoevry profile gender to the FIS code (M/W)
+func fisGender(s string) string {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "male", "m":
+		return "M"
+	case "female", "f", "w":
+		return "W"
+	}
+	return ""
+}
@@ -0,0 +1,108 @@
+package athletelinks
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// foldAccents maps the accented letters of Nordic and other European names
+// to their base letter, so that "Mäkelä" and "Makela" compare equal
+var foldAccents = strings.NewReplacer(
+	"ä", "a", "å", "a", "á", "a", "à", "a", "â", "a", "ã", "a", "æ", "ae",
+	"ö", "o", "ø", "o", "ó", "o", "ò", "o", "ô", "o", "õ", "o",
+	"ü", "u", "ú", "u", "ù", "u", "û", "u",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"š", "s", "ž", "z", "č", "c", "ç", "c", "ñ", "n", "ý", "y", "ß", "ss",
+)
+
+// normalizeName lowercases a name, folds its accents and turns hyphens and
+// other separators into single spaces
+func normalizeName(s string) string {
+	s = foldAccents.Replace(strings.ToLower(s))
+	return strings.Join(strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r)
+	}), " ")
+}
+
+// nameParts returns the words of a normalized first and last name, the
+// keys people are looked up by when a birthdate is unknown
+func nameParts(first, last string) []string {
+	parts := strings.Fields(first + " " + last)
+	slices.Sort(parts)
+	return slices.Compact(parts)
+}
+
+// jaroWinkler returns the Jaro-Winkler similarity of a and b, from 0 for
+// nothing in common to 1 for equal strings
+func jaroWinkler(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) == 0 || len(rb) == 0 {
+		return 0
+	}
+	if a == b {
+		return 1
+	}
+
+	window := max(len(ra), len(rb))/2 - 1
+	window = max(window, 0)
+	matchedA := make([]bool, len(ra))
+	matchedB := make([]bool, len(rb))
+	matches := 0
+	for i := range ra {
+		lo, hi := max(0, i-window), min(len(rb), i+window+1)
+		for j := lo; j < hi; j++ {
+			if !matchedB[j] && ra[i] == rb[j] {
+				matchedA[i], matchedB[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+
+	transpositions, j := 0, 0
+	for i := range ra {
+		if !matchedA[i] {
+			continue
+		}
+		for !matchedB[j] {
+			j++
+		}
+		if ra[i] != rb[j] {
+			transpositions++
+		}
+		j++
+	}
+
+	m := float64(matches)
+	jaro := (m/float64(len(ra)) + m/float64(len(rb)) + (m-float64(transpositions)/2)/m) / 3
+
+	prefix := 0
+	for prefix < min(4, len(ra), len(rb)) && ra[prefix] == rb[prefix] {
+		prefix++
+	}
+	return jaro + float64(prefix)*0.1*(1-jaro)
+}
+
+// firstNameSimilarity compares first names. FIS often lists only the first
+// of several given names, so a leading given name that matches counts too.
+func firstNameSimilarity(a, b string) float64 {
+	sim := jaroWinkler(a, b)
+	fa, fb := strings.Fields(a), strings.Fields(b)
+	if len(fa) > 0 && len(fb) > 0 && (len(fa) > 1 || len(fb) > 1) {
+		sim = max(sim, 0.95*jaroWinkler(fa[0], fb[0]))
+	}
+	return sim
+}
+
+// nameSimilarity compares normalized names, weighting the last name more
+// than the first one. Swapped first and last names are recognized.
+func nameSimilarity(first1, last1, first2, last2 string) float64 {
+	straight := 0.4*firstNameSimilarity(first1, first2) + 0.6*jaroWinkler(last1, last2)
+	swapped := 0.4*firstNameSimilarity(first1, last2) + 0.6*jaroWinkler(last1, first2)
+	return max(straight, 0.95*swapped)
+}
@@ -1,6 +1,7 @@
 package authz
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strings"
@@ -9,8 +10,14 @@ import (
 )
 
 func Authorize(r *http.Request) bool {
-	target := fmt.Sprintf("%s:%s", r.Method, r.URL.Path)
-	roles := authn.GetClientRoles(r.Context())
+	return Allowed(r.Context(), r.Method, r.URL.Path)
+}
+
+// Allowed reports whether the roles of the client in ctx permit method on
+// path, e.g. for work done on the client's behalf outside of the request
+func Allowed(ctx context.Context, method, path string) bool {
+	target := fmt.Sprintf("%s:%s", method, path)
+	roles := authn.GetClientRoles(ctx)
 
 	for _, role := range roles {
 		for _, perm := range RolePermissions[role] {
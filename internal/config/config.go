@@ -0,0 +1,363 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the full runtime configuration of the API server
+type Config struct {
+	Server      ServerConfig      `yaml:"server" toml:"server"`
+	HTTP        HTTPConfig        `yaml:"http" toml:"http"`
+	DB          DBConfig          `yaml:"db" toml:"db"`
+	Redis       RedisConfig       `yaml:"redis" toml:"redis"`
+	Auth        AuthConfig        `yaml:"auth" toml:"auth"`
+	RateLimiter RateLimiterConfig `yaml:"rate_limiter" toml:"rate_limiter"`
+	Log         LogConfig         `yaml:"log" toml:"log"`
+	Exports     ExportsConfig     `yaml:"exports" toml:"exports"`
+	Ingest      IngestConfig      `yaml:"ingest" toml:"ingest"`
+	Idempotency IdempotencyConfig `yaml:"idempotency" toml:"idempotency"`
+	Events      EventsConfig      `yaml:"events" toml:"events"`
+	Webhooks    WebhooksConfig    `yaml:"webhooks" toml:"webhooks"`
+}
+
+type ServerConfig struct {
+	Addr               string   `yaml:"addr" toml:"addr"`
+	ExternalURL        string   `yaml:"external_url" toml:"external_url"`
+	Env                string   `yaml:"env" toml:"env"`
+	CORSAllowedOrigins []string `yaml:"cors_allowed_origins" toml:"cors_allowed_origins"`
+
+	// On SIGTERM /ready starts failing, the server keeps serving for
+	// ReadinessDelay so load balancers can stop routing to it, and then
+	// waits up to ShutdownTimeout for in-flight requests to finish.
+	ReadinessDelay  time.Duration `yaml:"readiness_delay" toml:"readiness_delay"`
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" toml:"shutdown_timeout"`
+}
+
+type DBConfig struct {
+	FISAddr        string        `yaml:"fis_addr" toml:"fis_addr"`
+	UTVAddr        string        `yaml:"utv_addr" toml:"utv_addr"`
+	AuthAddr       string        `yaml:"auth_addr" toml:"auth_addr"`
+	TietoevryAddr  string        `yaml:"tietoevry_addr" toml:"tietoevry_addr"`
+	KAMKAddr       string        `yaml:"kamk_addr" toml:"kamk_addr"`
+	KLABAddr       string        `yaml:"klab_addr" toml:"klab_addr"`
+	ArchinisisAddr string        `yaml:"archinisis_addr" toml:"archinisis_addr"`
+	MaxOpenConns   int           `yaml:"max_open_conns" toml:"max_open_conns"`
+	MaxIdleConns   int           `yaml:"max_idle_conns" toml:"max_idle_conns"`
+	MaxIdleTime    time.Duration `yaml:"max_idle_time" toml:"max_idle_time"`
+
+	QueryTimeout     time.Duration `yaml:"query_timeout" toml:"query_timeout"`
+	BulkQueryTimeout time.Duration `yaml:"bulk_query_timeout" toml:"bulk_query_timeout"`
+
+	// CopyThreshold is the number of rows a request must carry for one
+	// table before its bulk insert uses COPY; each table is measured on its
+	// own. 0 disables COPY.
+	CopyThreshold int `yaml:"copy_threshold" toml:"copy_threshold"`
+}
+
+// HTTPConfig holds the server timeouts and the per-request limits. Routes
+// overrides Limits for a route group ("auth", "fis", "tietoevry", ...);
+// fields left empty in an override inherit the default.
+type HTTPConfig struct {
+	ReadTimeout  time.Duration           `yaml:"read_timeout" toml:"read_timeout"`
+	WriteTimeout time.Duration           `yaml:"write_timeout" toml:"write_timeout"`
+	IdleTimeout  time.Duration           `yaml:"idle_timeout" toml:"idle_timeout"`
+	Limits       LimitsConfig            `yaml:"limits" toml:"limits"`
+	Routes       map[string]LimitsConfig `yaml:"routes" toml:"routes"`
+}
+
+// LimitsConfig bounds a single request. ReadDeadline applies to GET
+// lookups, WriteDeadline to POST/PUT/DELETE (including bulk ingestion).
+type LimitsConfig struct {
+	ReadDeadline         time.Duration `yaml:"read_deadline" toml:"read_deadline"`
+	WriteDeadline        time.Duration `yaml:"write_deadline" toml:"write_deadline"`
+	MaxBodyBytes         int64         `yaml:"max_body_bytes" toml:"max_body_bytes"`
+	MaxGzipBytes         int64         `yaml:"max_gzip_bytes" toml:"max_gzip_bytes"`
+	MaxDecompressedBytes int64         `yaml:"max_decompressed_bytes" toml:"max_decompressed_bytes"`
+}
+
+// RouteGroups lists the names accepted as keys of HTTPConfig.Routes
+var RouteGroups = []string{"auth", "tietoevry", "kamk", "archinisis", "klab", "fis", "utv", "exports", "ingest", "events", "webhooks"}
+
+// ForRoute returns the limits of a route group with defaults filled in
+func (c HTTPConfig) ForRoute(group string) LimitsConfig {
+	l := c.Limits
+	o, ok := c.Routes[group]
+	if !ok {
+		return l
+	}
+	if o.ReadDeadline > 0 {
+		l.ReadDeadline = o.ReadDeadline
+	}
+	if o.WriteDeadline > 0 {
+		l.WriteDeadline = o.WriteDeadline
+	}
+	if o.MaxBodyBytes > 0 {
+		l.MaxBodyBytes = o.MaxBodyBytes
+	}
+	if o.MaxGzipBytes > 0 {
+		l.MaxGzipBytes = o.MaxGzipBytes
+	}
+	if o.MaxDecompressedBytes > 0 {
+		l.MaxDecompressedBytes = o.MaxDecompressedBytes
+	}
+	return l
+}
+
+type RedisConfig struct {
+	Addr    string `yaml:"addr" toml:"addr"`
+	PW      string `yaml:"pw" toml:"pw"`
+	DB      int    `yaml:"db" toml:"db"`
+	Enabled bool   `yaml:"enabled" toml:"enabled"`
+}
+
+type AuthConfig struct {
+	Basic BasicConfig `yaml:"basic" toml:"basic"`
+	JWT   JWTConfig   `yaml:"jwt" toml:"jwt"`
+}
+
+type BasicConfig struct {
+	User string `yaml:"user" toml:"user"`
+	Pass string `yaml:"pass" toml:"pass"`
+}
+
+type JWTConfig struct {
+	Secret   string `yaml:"secret" toml:"secret"`
+	Issuer   string `yaml:"issuer" toml:"issuer"`
+	Audience string `yaml:"audience" toml:"audience"`
+}
+
+type RateLimiterConfig struct {
+	Enabled              bool          `yaml:"enabled" toml:"enabled"`
+	RequestsPerTimeFrame int           `yaml:"requests_per_time_frame" toml:"requests_per_time_frame"`
+	TimeFrame            time.Duration `yaml:"time_frame" toml:"time_frame"`
+}
+
+type LogConfig struct {
+	Dir string `yaml:"dir" toml:"dir"`
+}
+
+// ExportsConfig controls the asynchronous export jobs. Workers poll the
+// queue every PollInterval; finished files are kept for TTL, and running
+// jobs without a heartbeat for StaleAfter are handed to another worker.
+type ExportsConfig struct {
+	Enabled      bool          `yaml:"enabled" toml:"enabled"`
+	Workers      int           `yaml:"workers" toml:"workers"`
+	PollInterval time.Duration `yaml:"poll_interval" toml:"poll_interval"`
+	TTL          time.Duration `yaml:"ttl" toml:"ttl"`
+	ChunkSize    int           `yaml:"chunk_size" toml:"chunk_size"`
+	StaleAfter   time.Duration `yaml:"stale_after" toml:"stale_after"`
+}
+
+// IdempotencyConfig controls the Idempotency-Key handling of write
+// requests. Responses are kept for TTL; a key whose request is still
+// running is locked for at most LockTimeout. Backend is "redis",
+// "postgres" or "auto" (Redis when connected, else the auth database).
+type IdempotencyConfig struct {
+	Enabled     bool          `yaml:"enabled" toml:"enabled"`
+	Backend     string        `yaml:"backend" toml:"backend"`
+	TTL         time.Duration `yaml:"ttl" toml:"ttl"`
+	LockTimeout time.Duration `yaml:"lock_timeout" toml:"lock_timeout"`
+}
+
+// IdempotencyBackends lists the accepted values of IdempotencyConfig.Backend
+var IdempotencyBackends = []string{"auto", "redis", "postgres"}
+
+// IngestConfig controls the asynchronous ingest jobs. Workers poll the
+// queue every PollInterval and replay partial mode jobs in batches of
+// BatchSize records. Jobs failing with a server error are attempted up to
+// MaxAttempts times, waiting RetryBackoff after the first failure and twice
+// as long after each next one. Finished jobs are kept for TTL, and running
+// jobs without a heartbeat for StaleAfter are handed to another worker.
+type IngestConfig struct {
+	Enabled      bool          `yaml:"enabled" toml:"enabled"`
+	Workers      int           `yaml:"workers" toml:"workers"`
+	PollInterval time.Duration `yaml:"poll_interval" toml:"poll_interval"`
+	TTL          time.Duration `yaml:"ttl" toml:"ttl"`
+	BatchSize    int           `yaml:"batch_size" toml:"batch_size"`
+	MaxAttempts  int           `yaml:"max_attempts" toml:"max_attempts"`
+	RetryBackoff time.Duration `yaml:"retry_backoff" toml:"retry_backoff"`
+	StaleAfter   time.Duration `yaml:"stale_after" toml:"stale_after"`
+}
+
+// EventsConfig controls the change events emitted by writes. Events are
+// kept in the outbox for Retention, together with their webhook
+// deliveries. The event stream reads new events every PollInterval, sends
+// a keep-alive comment every Heartbeat and replays at most MaxReplay events
+// to a client resuming with Last-Event-ID.
+type EventsConfig struct {
+	Enabled      bool          `yaml:"enabled" toml:"enabled"`
+	Retention    time.Duration `yaml:"retention" toml:"retention"`
+	PollInterval time.Duration `yaml:"poll_interval" toml:"poll_interval"`
+	Heartbeat    time.Duration `yaml:"heartbeat" toml:"heartbeat"`
+	MaxReplay    int           `yaml:"max_replay" toml:"max_replay"`
+}
+
+// WebhooksConfig controls the delivery of change events to webhook
+// subscribers. Workers poll the delivery queue every PollInterval, claiming
+// up to BatchSize deliveries at a time, and wait up to Timeout for each
+// subscriber. Failed deliveries are attempted up to MaxAttempts times,
+// waiting RetryBackoff after the first failure and twice as long after each
+// next one. Subscription URLs must use https unless AllowHTTP is set, and
+// resolve to public addresses unless AllowPrivate is set (local testing).
+type WebhooksConfig struct {
+	Enabled      bool          `yaml:"enabled" toml:"enabled"`
+	Workers      int           `yaml:"workers" toml:"workers"`
+	PollInterval time.Duration `yaml:"poll_interval" toml:"poll_interval"`
+	BatchSize    int           `yaml:"batch_size" toml:"batch_size"`
+	Timeout      time.Duration `yaml:"timeout" toml:"timeout"`
+	MaxAttempts  int           `yaml:"max_attempts" toml:"max_attempts"`
+	RetryBackoff time.Duration `yaml:"retry_backoff" toml:"retry_backoff"`
+	AllowHTTP    bool          `yaml:"allow_http" toml:"allow_http"`
+	AllowPrivate bool          `yaml:"allow_private" toml:"allow_private"`
+}
+
+// Default returns the configuration used when nothing else is set
+func Default() Config {
+	return Config{
+		Server: ServerConfig{
+			Addr:        ":8080",
+			ExternalURL: "localhost:8080",
+			Env:         "development",
+
+			ReadinessDelay:  5 * time.Second,
+			ShutdownTimeout: 4 * time.Minute,
+		},
+		HTTP: HTTPConfig{
+			ReadTimeout:  3 * time.Minute,
+			WriteTimeout: 3 * time.Minute,
+			IdleTimeout:  time.Minute,
+			Limits: LimitsConfig{
+				ReadDeadline:         3 * time.Minute,
+				WriteDeadline:        3 * time.Minute,
+				MaxBodyBytes:         50 * 1024 * 1024,   // 50 MB
+				MaxGzipBytes:         200 * 1024 * 1024,  // 200 MB (compressed)
+				MaxDecompressedBytes: 1024 * 1024 * 1024, // 1 GB (after decompression)
+			},
+		},
+		DB: DBConfig{
+			MaxOpenConns:     30,
+			MaxIdleConns:     30,
+			MaxIdleTime:      15 * time.Minute,
+			QueryTimeout:     30 * time.Second,
+			BulkQueryTimeout: 3 * time.Minute,
+			CopyThreshold:    1000,
+		},
+		Redis: RedisConfig{
+			Addr: "localhost:6379",
+		},
+		RateLimiter: RateLimiterConfig{
+			Enabled:              true,
+			RequestsPerTimeFrame: 20,
+			TimeFrame:            5 * time.Second,
+		},
+		Log: LogConfig{
+			Dir: "./logs",
+		},
+		Exports: ExportsConfig{
+			Enabled:      true,
+			Workers:      2,
+			PollInterval: 5 * time.Second,
+			TTL:          24 * time.Hour,
+			ChunkSize:    4 * 1024 * 1024, // 4 MB
+			StaleAfter:   2 * time.Minute,
+		},
+		Ingest: IngestConfig{
+			Enabled:      true,
+			Workers:      2,
+			PollInterval: 5 * time.Second,
+			TTL:          72 * time.Hour,
+			BatchSize:    1000,
+			MaxAttempts:  5,
+			RetryBackoff: 30 * time.Second,
+			StaleAfter:   2 * time.Minute,
+		},
+		Idempotency: IdempotencyConfig{
+			Enabled:     true,
+			Backend:     "auto",
+			TTL:         24 * time.Hour,
+			LockTimeout: 5 * time.Minute,
+		},
+		Events: EventsConfig{
+			Enabled:      true,
+			Retention:    7 * 24 * time.Hour,
+			PollInterval: time.Second,
+			Heartbeat:    15 * time.Second,
+			MaxReplay:    10000,
+		},
+		Webhooks: WebhooksConfig{
+			Enabled:      true,
+			Workers:      2,
+			PollInterval: 5 * time.Second,
+			BatchSize:    20,
+			Timeout:      10 * time.Second,
+			MaxAttempts:  8,
+			RetryBackoff: 30 * time.Second,
+		},
+	}
+}
+
+// Load builds the configuration from defaults, the optional file at path
+// (YAML or TOML) and environment overrides, in that order
+func Load(path string) (*Config, error) {
+	cfg := Default()
+
+	if path != "" {
+		if err := loadFile(path, &cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := applyEnv(&cfg); err != nil {
+		return nil, err
+	}
+
+	cfg.Server.CORSAllowedOrigins = cleanList(cfg.Server.CORSAllowedOrigins)
+
+	return &cfg, nil
+}
+
+func loadFile(path string, cfg *Config) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading config file: %w", err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		dec := yaml.NewDecoder(strings.NewReader(string(data)))
+		dec.KnownFields(true)
+		if err := dec.Decode(cfg); err != nil {
+			return fmt.Errorf("parsing %s: %w", path, err)
+		}
+	case ".toml":
+		md, err := toml.Decode(string(data), cfg)
+		if err != nil {
+			return fmt.Errorf("parsing %s: %w", path, err)
+		}
+		if undecoded := md.Undecoded(); len(undecoded) > 0 {
+			return fmt.Errorf("parsing %s: unknown keys %v", path, undecoded)
+		}
+	default:
+		return fmt.Errorf("unsupported config file extension %q (use .yaml, .yml or .toml)", filepath.Ext(path))
+	}
+
+	return nil
+}
+
+func cleanList(in []string) []string {
+	var out []string
+	for _, s := range in {
+		s = strings.TrimSpace(s)
+		if s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
@@ -0,0 +1,181 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/env"
+)
+
+// applyEnv overrides cfg with any of the supported environment variables.
+// Every variable may also be supplied as <NAME>_FILE pointing at a secret file.
+func applyEnv(cfg *Config) error {
+	strs := map[string]*string{
+		"ADDR":                &cfg.Server.Addr,
+		"EXTERNAL_URL":        &cfg.Server.ExternalURL,
+		"ENV":                 &cfg.Server.Env,
+		"FIS_DB_ADDR":         &cfg.DB.FISAddr,
+		"UTV_DB_ADDR":         &cfg.DB.UTVAddr,
+		"AUTH_DB_ADDR":        &cfg.DB.AuthAddr,
+		"TIETOEVRY_DB_ADDR":   &cfg.DB.TietoevryAddr,
+		"KAMK_DB_ADDR":        &cfg.DB.KAMKAddr,
+		"KLAB_DB_ADDR":        &cfg.DB.KLABAddr,
+		"ARCHINISIS_DB_ADDR":  &cfg.DB.ArchinisisAddr,
+		"REDIS_ADDR":          &cfg.Redis.Addr,
+		"REDIS_PW":            &cfg.Redis.PW,
+		"BASIC_AUTH_USER":     &cfg.Auth.Basic.User,
+		"BASIC_AUTH_PASS":     &cfg.Auth.Basic.Pass,
+		"JWT_SECRET":          &cfg.Auth.JWT.Secret,
+		"JWT_ISSUER":          &cfg.Auth.JWT.Issuer,
+		"JWT_AUDIENCE":        &cfg.Auth.JWT.Audience,
+		"LOG_DIR":             &cfg.Log.Dir,
+		"IDEMPOTENCY_BACKEND": &cfg.Idempotency.Backend,
+	}
+	ints := map[string]*int{
+		"DB_MAX_OPEN_CONNS":          &cfg.DB.MaxOpenConns,
+		"DB_MAX_IDLE_CONNS":          &cfg.DB.MaxIdleConns,
+		"DB_COPY_THRESHOLD":          &cfg.DB.CopyThreshold,
+		"REDIS_DB":                   &cfg.Redis.DB,
+		"RATELIMITER_REQUESTS_COUNT": &cfg.RateLimiter.RequestsPerTimeFrame,
+		"EXPORTS_WORKERS":            &cfg.Exports.Workers,
+		"EXPORTS_CHUNK_SIZE":         &cfg.Exports.ChunkSize,
+		"INGEST_WORKERS":             &cfg.Ingest.Workers,
+		"INGEST_BATCH_SIZE":          &cfg.Ingest.BatchSize,
+		"INGEST_MAX_ATTEMPTS":        &cfg.Ingest.MaxAttempts,
+		"EVENTS_MAX_REPLAY":          &cfg.Events.MaxReplay,
+		"WEBHOOKS_WORKERS":           &cfg.Webhooks.Workers,
+		"WEBHOOKS_BATCH_SIZE":        &cfg.Webhooks.BatchSize,
+		"WEBHOOKS_MAX_ATTEMPTS":      &cfg.Webhooks.MaxAttempts,
+	}
+	int64s := map[string]*int64{
+		"HTTP_MAX_BODY_BYTES":         &cfg.HTTP.Limits.MaxBodyBytes,
+		"HTTP_MAX_GZIP_BYTES":         &cfg.HTTP.Limits.MaxGzipBytes,
+		"HTTP_MAX_DECOMPRESSED_BYTES": &cfg.HTTP.Limits.MaxDecompressedBytes,
+	}
+	bools := map[string]*bool{
+		"REDIS_ENABLED":          &cfg.Redis.Enabled,
+		"RATE_LIMITER_ENABLED":   &cfg.RateLimiter.Enabled,
+		"EXPORTS_ENABLED":        &cfg.Exports.Enabled,
+		"INGEST_ENABLED":         &cfg.Ingest.Enabled,
+		"IDEMPOTENCY_ENABLED":    &cfg.Idempotency.Enabled,
+		"EVENTS_ENABLED":         &cfg.Events.Enabled,
+		"WEBHOOKS_ENABLED":       &cfg.Webhooks.Enabled,
+		"WEBHOOKS_ALLOW_HTTP":    &cfg.Webhooks.AllowHTTP,
+		"WEBHOOKS_ALLOW_PRIVATE": &cfg.Webhooks.AllowPrivate,
+	}
+	durations := map[string]*time.Duration{
+		"DB_MAX_IDLE_TIME":         &cfg.DB.MaxIdleTime,
+		"RATELIMITER_TIME_FRAME":   &cfg.RateLimiter.TimeFrame,
+		"DB_QUERY_TIMEOUT":         &cfg.DB.QueryTimeout,
+		"DB_BULK_QUERY_TIMEOUT":    &cfg.DB.BulkQueryTimeout,
+		"HTTP_READ_TIMEOUT":        &cfg.HTTP.ReadTimeout,
+		"HTTP_WRITE_TIMEOUT":       &cfg.HTTP.WriteTimeout,
+		"HTTP_IDLE_TIMEOUT":        &cfg.HTTP.IdleTimeout,
+		"HTTP_READ_DEADLINE":       &cfg.HTTP.Limits.ReadDeadline,
+		"HTTP_WRITE_DEADLINE":      &cfg.HTTP.Limits.WriteDeadline,
+		"SHUTDOWN_READINESS_DELAY": &cfg.Server.ReadinessDelay,
+		"SHUTDOWN_TIMEOUT":         &cfg.Server.ShutdownTimeout,
+		"EXPORTS_POLL_INTERVAL":    &cfg.Exports.PollInterval,
+		"EXPORTS_TTL":              &cfg.Exports.TTL,
+		"EXPORTS_STALE_AFTER":      &cfg.Exports.StaleAfter,
+		"INGEST_POLL_INTERVAL":     &cfg.Ingest.PollInterval,
+		"INGEST_TTL":               &cfg.Ingest.TTL,
+		"INGEST_RETRY_BACKOFF":     &cfg.Ingest.RetryBackoff,
+		"INGEST_STALE_AFTER":       &cfg.Ingest.StaleAfter,
+		"IDEMPOTENCY_TTL":          &cfg.Idempotency.TTL,
+		"IDEMPOTENCY_LOCK_TIMEOUT": &cfg.Idempotency.LockTimeout,
+		"EVENTS_RETENTION":         &cfg.Events.Retention,
+		"EVENTS_POLL_INTERVAL":     &cfg.Events.PollInterval,
+		"EVENTS_HEARTBEAT":         &cfg.Events.Heartbeat,
+		"WEBHOOKS_POLL_INTERVAL":   &cfg.Webhooks.PollInterval,
+		"WEBHOOKS_TIMEOUT":         &cfg.Webhooks.Timeout,
+		"WEBHOOKS_RETRY_BACKOFF":   &cfg.Webhooks.RetryBackoff,
+	}
+	lists := map[string]*[]string{
+		"CORS_ALLOWED_ORIGIN": &cfg.Server.CORSAllowedOrigins,
+	}
+
+	for key, dst := range strs {
+		val, ok, err := env.Lookup(key)
+		if err != nil {
+			return err
+		}
+		if ok {
+			*dst = val
+		}
+	}
+
+	for key, dst := range ints {
+		val, ok, err := env.Lookup(key)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(val))
+		if err != nil {
+			return fmt.Errorf("%s: expected an integer, got %q", key, val)
+		}
+		*dst = n
+	}
+
+	for key, dst := range int64s {
+		val, ok, err := env.Lookup(key)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		n, err := strconv.ParseInt(strings.TrimSpace(val), 10, 64)
+		if err != nil {
+			return fmt.Errorf("%s: expected an integer, got %q", key, val)
+		}
+		*dst = n
+	}
+
+	for key, dst := range bools {
+		val, ok, err := env.Lookup(key)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		b, err := strconv.ParseBool(strings.TrimSpace(val))
+		if err != nil {
+			return fmt.Errorf("%s: expected a boolean, got %q", key, val)
+		}
+		*dst = b
+	}
+
+	for key, dst := range durations {
+		val, ok, err := env.Lookup(key)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		d, err := time.ParseDuration(strings.TrimSpace(val))
+		if err != nil {
+			return fmt.Errorf("%s: expected a duration such as 15m, got %q", key, val)
+		}
+		*dst = d
+	}
+
+	for key, dst := range lists {
+		val, ok, err := env.Lookup(key)
+		if err != nil {
+			return err
+		}
+		if ok {
+			*dst = strings.Split(val, ",")
+		}
+	}
+
+	return nil
+}
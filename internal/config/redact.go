@@ -0,0 +1,63 @@
+package config
+
+import (
+	"io"
+	"net/url"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Same marker net/url uses for URL.Redacted
+const redacted = "xxxxx"
+
+var dsnPasswordRe = regexp.MustCompile(`(password=)('[^']*'|\S+)`)
+
+// Redacted returns a copy of the configuration with every secret masked
+func (c Config) Redacted() Config {
+	out := c
+	out.Server.CORSAllowedOrigins = append([]string(nil), c.Server.CORSAllowedOrigins...)
+
+	for _, addr := range []*string{
+		&out.DB.FISAddr,
+		&out.DB.UTVAddr,
+		&out.DB.AuthAddr,
+		&out.DB.TietoevryAddr,
+		&out.DB.KAMKAddr,
+		&out.DB.KLABAddr,
+		&out.DB.ArchinisisAddr,
+	} {
+		*addr = redactDSN(*addr)
+	}
+
+	for _, secret := range []*string{
+		&out.Redis.PW,
+		&out.Auth.Basic.Pass,
+		&out.Auth.JWT.Secret,
+	} {
+		if *secret != "" {
+			*secret = redacted
+		}
+	}
+
+	return out
+}
+
+// Print writes the redacted configuration as YAML
+func (c Config) Print(w io.Writer) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	defer enc.Close()
+	return enc.Encode(c.Redacted())
+}
+
+// redactDSN masks the password of a URL or key=value Postgres connection string
+func redactDSN(dsn string) string {
+	if dsn == "" {
+		return dsn
+	}
+	if u, err := url.Parse(dsn); err == nil && u.User != nil {
+		return u.Redacted()
+	}
+	return dsnPasswordRe.ReplaceAllString(dsn, "${1}"+redacted)
+}
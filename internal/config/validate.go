@@ -0,0 +1,231 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Minimum length of the HS256 signing key (256 bits)
+const minJWTSecretLength = 32
+
+// Validate reports every problem in the configuration at once so that a
+// misconfigured deployment fails at startup instead of answering with 401s
+func (c *Config) Validate() error {
+	var errs []error
+	fail := func(field, format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%s: %s", field, fmt.Sprintf(format, args...)))
+	}
+
+	if c.Server.Addr == "" {
+		fail("server.addr", "is required")
+	}
+	if c.Server.Env == "" {
+		fail("server.env", "is required")
+	}
+
+	if c.Server.ReadinessDelay < 0 {
+		fail("server.readiness_delay", "must not be negative")
+	}
+	if c.Server.ShutdownTimeout < c.DB.BulkQueryTimeout {
+		fail("server.shutdown_timeout", "must be at least db.bulk_query_timeout (%s) so bulk inserts can finish", c.DB.BulkQueryTimeout)
+	}
+
+	if c.HTTP.ReadTimeout <= 0 {
+		fail("http.read_timeout", "must be positive")
+	}
+	if c.HTTP.WriteTimeout <= 0 {
+		fail("http.write_timeout", "must be positive")
+	}
+	if c.HTTP.IdleTimeout <= 0 {
+		fail("http.idle_timeout", "must be positive")
+	}
+	c.validateLimits("http.limits", c.HTTP.Limits, fail)
+	for group := range c.HTTP.Routes {
+		if !slices.Contains(RouteGroups, group) {
+			fail("http.routes."+group, "unknown route group (allowed: %s)", strings.Join(RouteGroups, ", "))
+			continue
+		}
+		c.validateLimits("http.routes."+group, c.HTTP.ForRoute(group), fail)
+	}
+
+	if c.DB.MaxOpenConns <= 0 {
+		fail("db.max_open_conns", "must be positive")
+	}
+	if c.DB.MaxIdleConns < 0 {
+		fail("db.max_idle_conns", "must not be negative")
+	}
+	if c.DB.MaxIdleConns > c.DB.MaxOpenConns {
+		fail("db.max_idle_conns", "must not exceed db.max_open_conns")
+	}
+	if c.DB.MaxIdleTime <= 0 {
+		fail("db.max_idle_time", "must be positive")
+	}
+
+	if c.DB.QueryTimeout <= 0 {
+		fail("db.query_timeout", "must be positive")
+	}
+	if c.DB.BulkQueryTimeout < c.DB.QueryTimeout {
+		fail("db.bulk_query_timeout", "must not be shorter than db.query_timeout")
+	}
+	if c.DB.CopyThreshold < 0 {
+		fail("db.copy_threshold", "must not be negative")
+	}
+
+	if c.Redis.Enabled && c.Redis.Addr == "" {
+		fail("redis.addr", "is required when redis is enabled")
+	}
+	if c.Redis.DB < 0 {
+		fail("redis.db", "must not be negative")
+	}
+
+	if c.Auth.JWT.Secret == "" {
+		fail("auth.jwt.secret", "is required (set JWT_SECRET or JWT_SECRET_FILE)")
+	} else if len(c.Auth.JWT.Secret) < minJWTSecretLength {
+		fail("auth.jwt.secret", "must be at least %d bytes", minJWTSecretLength)
+	}
+	if c.Auth.JWT.Issuer == "" {
+		fail("auth.jwt.issuer", "is required")
+	}
+	if c.Auth.JWT.Audience == "" {
+		fail("auth.jwt.audience", "is required")
+	}
+	if c.Auth.Basic.User == "" || c.Auth.Basic.Pass == "" {
+		fail("auth.basic", "user and pass are required to protect /metrics")
+	}
+
+	if c.RateLimiter.Enabled {
+		if c.RateLimiter.RequestsPerTimeFrame <= 0 {
+			fail("rate_limiter.requests_per_time_frame", "must be positive")
+		}
+		if c.RateLimiter.TimeFrame <= 0 {
+			fail("rate_limiter.time_frame", "must be positive")
+		}
+	}
+
+	if c.Log.Dir == "" {
+		fail("log.dir", "is required")
+	}
+
+	if c.Exports.Enabled {
+		if c.Exports.Workers <= 0 {
+			fail("exports.workers", "must be positive")
+		}
+		if c.Exports.PollInterval <= 0 {
+			fail("exports.poll_interval", "must be positive")
+		}
+		if c.Exports.TTL <= 0 {
+			fail("exports.ttl", "must be positive")
+		}
+		if c.Exports.ChunkSize < 64*1024 {
+			fail("exports.chunk_size", "must be at least 64 KiB")
+		}
+		if c.Exports.StaleAfter <= c.Exports.PollInterval {
+			fail("exports.stale_after", "must be longer than exports.poll_interval")
+		}
+	}
+
+	if c.Ingest.Enabled {
+		if c.Ingest.Workers <= 0 {
+			fail("ingest.workers", "must be positive")
+		}
+		if c.Ingest.PollInterval <= 0 {
+			fail("ingest.poll_interval", "must be positive")
+		}
+		if c.Ingest.TTL <= 0 {
+			fail("ingest.ttl", "must be positive")
+		}
+		if c.Ingest.BatchSize <= 0 {
+			fail("ingest.batch_size", "must be positive")
+		}
+		if c.Ingest.MaxAttempts <= 0 {
+			fail("ingest.max_attempts", "must be positive")
+		}
+		if c.Ingest.RetryBackoff <= 0 {
+			fail("ingest.retry_backoff", "must be positive")
+		}
+		if c.Ingest.StaleAfter <= c.Ingest.PollInterval {
+			fail("ingest.stale_after", "must be longer than ingest.poll_interval")
+		}
+	}
+
+	if c.Events.Enabled {
+		if c.Events.Retention <= 0 {
+			fail("events.retention", "must be positive")
+		}
+		if c.Events.PollInterval <= 0 {
+			fail("events.poll_interval", "must be positive")
+		}
+		if c.Events.Heartbeat <= 0 {
+			fail("events.heartbeat", "must be positive")
+		}
+		if c.Events.MaxReplay <= 0 {
+			fail("events.max_replay", "must be positive")
+		}
+	}
+
+	if c.Webhooks.Enabled {
+		if !c.Events.Enabled {
+			fail("webhooks.enabled", "requires events.enabled")
+		}
+		if c.Webhooks.Workers <= 0 {
+			fail("webhooks.workers", "must be positive")
+		}
+		if c.Webhooks.PollInterval <= 0 {
+			fail("webhooks.poll_interval", "must be positive")
+		}
+		if c.Webhooks.BatchSize <= 0 {
+			fail("webhooks.batch_size", "must be positive")
+		}
+		if c.Webhooks.Timeout <= 0 {
+			fail("webhooks.timeout", "must be positive")
+		}
+		if c.Webhooks.MaxAttempts <= 0 {
+			fail("webhooks.max_attempts", "must be positive")
+		}
+		if c.Webhooks.RetryBackoff <= 0 {
+			fail("webhooks.retry_backoff", "must be positive")
+		}
+	}
+
+	if c.Idempotency.Enabled {
+		if !slices.Contains(IdempotencyBackends, c.Idempotency.Backend) {
+			fail("idempotency.backend", "must be one of %s", strings.Join(IdempotencyBackends, ", "))
+		}
+		if c.Idempotency.TTL <= 0 {
+			fail("idempotency.ttl", "must be positive")
+		}
+		// A key must stay locked for as long as its request may run
+		if c.Idempotency.LockTimeout < c.HTTP.WriteTimeout {
+			fail("idempotency.lock_timeout", "must be at least http.write_timeout (%s)", c.HTTP.WriteTimeout)
+		}
+		if c.Idempotency.TTL < c.Idempotency.LockTimeout {
+			fail("idempotency.ttl", "must be at least idempotency.lock_timeout")
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c *Config) validateLimits(field string, l LimitsConfig, fail func(field, format string, args ...any)) {
+	if l.ReadDeadline <= 0 {
+		fail(field+".read_deadline", "must be positive")
+	}
+	if l.WriteDeadline <= 0 {
+		fail(field+".write_deadline", "must be positive")
+	}
+	// The server would cut the connection before the handler could answer
+	if max(l.ReadDeadline, l.WriteDeadline) > c.HTTP.WriteTimeout {
+		fail(field, "deadlines must not exceed http.write_timeout (%s)", c.HTTP.WriteTimeout)
+	}
+	if l.MaxBodyBytes <= 0 {
+		fail(field+".max_body_bytes", "must be positive")
+	}
+	if l.MaxGzipBytes <= 0 {
+		fail(field+".max_gzip_bytes", "must be positive")
+	}
+	if l.MaxDecompressedBytes < l.MaxGzipBytes {
+		fail(field+".max_decompressed_bytes", "must not be smaller than max_gzip_bytes")
+	}
+}
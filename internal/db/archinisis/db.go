@@ -42,6 +42,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getSporttiIDsBySessionIDStmt, err = db.PrepareContext(ctx, getSporttiIDsBySessionID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetSporttiIDsBySessionID: %w", err)
 	}
+	if q.listAthleteIdentitiesStmt, err = db.PrepareContext(ctx, listAthleteIdentities); err != nil {
+		return nil, fmt.Errorf("error preparing query ListAthleteIdentities: %w", err)
+	}
 	if q.upsertAthleteStmt, err = db.PrepareContext(ctx, upsertAthlete); err != nil {
 		return nil, fmt.Errorf("error preparing query UpsertAthlete: %w", err)
 	}
@@ -89,6 +92,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getSporttiIDsBySessionIDStmt: %w", cerr)
 		}
 	}
+	if q.listAthleteIdentitiesStmt != nil {
+		if cerr := q.listAthleteIdentitiesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listAthleteIdentitiesStmt: %w", cerr)
+		}
+	}
 	if q.upsertAthleteStmt != nil {
 		if cerr := q.upsertAthleteStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing upsertAthleteStmt: %w", cerr)
@@ -154,6 +162,7 @@ type Queries struct {
 	getRaceReportStmt                      *sql.Stmt
 	getRaceReportSessionIDsBySporttiIDStmt *sql.Stmt
 	getSporttiIDsBySessionIDStmt           *sql.Stmt
+	listAthleteIdentitiesStmt              *sql.Stmt
 	upsertAthleteStmt                      *sql.Stmt
 	upsertMeasurementStmt                  *sql.Stmt
 	upsertReportStmt                       *sql.Stmt
@@ -170,6 +179,7 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		getRaceReportStmt:                      q.getRaceReportStmt,
 		getRaceReportSessionIDsBySporttiIDStmt: q.getRaceReportSessionIDsBySporttiIDStmt,
 		getSporttiIDsBySessionIDStmt:           q.getSporttiIDsBySessionIDStmt,
+		listAthleteIdentitiesStmt:              q.listAthleteIdentitiesStmt,
 		upsertAthleteStmt:                      q.upsertAthleteStmt,
 		upsertMeasurementStmt:                  q.upsertMeasurementStmt,
 		upsertReportStmt:                       q.upsertReportStmt,
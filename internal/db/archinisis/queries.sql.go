@@ -303,3 +303,43 @@ func (q *Queries) UpsertReportUser(ctx context.Context, arg UpsertReportUserPara
 	_, err := q.exec(ctx, q.upsertReportUserStmt, upsertReportUser, arg.SessionID, arg.SporttiID)
 	return err
 }
+
+const listAthleteIdentities = `-- name: ListAthleteIdentities :many
+SELECT national_id, first_name, last_name, date_of_birth
+FROM athlete
+`
+
+type ListAthleteIdentitiesRow struct {
+	NationalID  string
+	FirstName   sql.NullString
+	LastName    sql.NullString
+	DateOfBirth sql.NullTime
+}
+
+func (q *Queries) ListAthleteIdentities(ctx context.Context) ([]ListAthleteIdentitiesRow, error) {
+	rows, err := q.query(ctx, q.listAthleteIdentitiesStmt, listAthleteIdentities)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAthleteIdentitiesRow
+	for rows.Next() {
+		var i ListAthleteIdentitiesRow
+		if err := rows.Scan(
+			&i.NationalID,
+			&i.FirstName,
+			&i.LastName,
+			&i.DateOfBirth,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -93,3 +93,8 @@ ORDER BY measurement_group_id ASC;
 DELETE FROM athlete
 WHERE national_id = $1
 RETURNING national_id;
+
+
+-- name: ListAthleteIdentities :many
+SELECT national_id, first_name, last_name, date_of_birth
+FROM athlete;
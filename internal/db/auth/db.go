@@ -27,9 +27,36 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.addClientRoleStmt, err = db.PrepareContext(ctx, addClientRole); err != nil {
 		return nil, fmt.Errorf("error preparing query AddClientRole: %w", err)
 	}
+	if q.cancelExportJobStmt, err = db.PrepareContext(ctx, cancelExportJob); err != nil {
+		return nil, fmt.Errorf("error preparing query CancelExportJob: %w", err)
+	}
+	if q.checkpointIngestJobStmt, err = db.PrepareContext(ctx, checkpointIngestJob); err != nil {
+		return nil, fmt.Errorf("error preparing query CheckpointIngestJob: %w", err)
+	}
+	if q.claimExportJobStmt, err = db.PrepareContext(ctx, claimExportJob); err != nil {
+		return nil, fmt.Errorf("error preparing query ClaimExportJob: %w", err)
+	}
+	if q.claimIngestJobStmt, err = db.PrepareContext(ctx, claimIngestJob); err != nil {
+		return nil, fmt.Errorf("error preparing query ClaimIngestJob: %w", err)
+	}
+	if q.claimWebhookDeliveriesStmt, err = db.PrepareContext(ctx, claimWebhookDeliveries); err != nil {
+		return nil, fmt.Errorf("error preparing query ClaimWebhookDeliveries: %w", err)
+	}
+	if q.completeIdempotencyKeyStmt, err = db.PrepareContext(ctx, completeIdempotencyKey); err != nil {
+		return nil, fmt.Errorf("error preparing query CompleteIdempotencyKey: %w", err)
+	}
+	if q.createCalendarFeedStmt, err = db.PrepareContext(ctx, createCalendarFeed); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateCalendarFeed: %w", err)
+	}
 	if q.createClientStmt, err = db.PrepareContext(ctx, createClient); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateClient: %w", err)
 	}
+	if q.createExportJobStmt, err = db.PrepareContext(ctx, createExportJob); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateExportJob: %w", err)
+	}
+	if q.createIngestJobStmt, err = db.PrepareContext(ctx, createIngestJob); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateIngestJob: %w", err)
+	}
 	if q.createRefreshTokenStmt, err = db.PrepareContext(ctx, createRefreshToken); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateRefreshToken: %w", err)
 	}
@@ -39,15 +66,42 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.createRevokedTokenStmt, err = db.PrepareContext(ctx, createRevokedToken); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateRevokedToken: %w", err)
 	}
+	if q.createWebhookSubscriptionStmt, err = db.PrepareContext(ctx, createWebhookSubscription); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateWebhookSubscription: %w", err)
+	}
 	if q.deleteAllRefreshTokensForClientStmt, err = db.PrepareContext(ctx, deleteAllRefreshTokensForClient); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteAllRefreshTokensForClient: %w", err)
 	}
+	if q.deleteCalendarFeedStmt, err = db.PrepareContext(ctx, deleteCalendarFeed); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteCalendarFeed: %w", err)
+	}
 	if q.deleteClientStmt, err = db.PrepareContext(ctx, deleteClient); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteClient: %w", err)
 	}
+	if q.deleteExpiredExportJobsStmt, err = db.PrepareContext(ctx, deleteExpiredExportJobs); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteExpiredExportJobs: %w", err)
+	}
+	if q.deleteExpiredIdempotencyKeysStmt, err = db.PrepareContext(ctx, deleteExpiredIdempotencyKeys); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteExpiredIdempotencyKeys: %w", err)
+	}
+	if q.deleteExpiredIngestJobsStmt, err = db.PrepareContext(ctx, deleteExpiredIngestJobs); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteExpiredIngestJobs: %w", err)
+	}
+	if q.deleteExpiredOutboxEventsStmt, err = db.PrepareContext(ctx, deleteExpiredOutboxEvents); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteExpiredOutboxEvents: %w", err)
+	}
 	if q.deleteExpiredRefreshTokensStmt, err = db.PrepareContext(ctx, deleteExpiredRefreshTokens); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteExpiredRefreshTokens: %w", err)
 	}
+	if q.deleteExportJobChunksStmt, err = db.PrepareContext(ctx, deleteExportJobChunks); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteExportJobChunks: %w", err)
+	}
+	if q.deleteIdempotencyKeyStmt, err = db.PrepareContext(ctx, deleteIdempotencyKey); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteIdempotencyKey: %w", err)
+	}
+	if q.deleteIngestJobPayloadStmt, err = db.PrepareContext(ctx, deleteIngestJobPayload); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteIngestJobPayload: %w", err)
+	}
 	if q.deleteRefreshTokenStmt, err = db.PrepareContext(ctx, deleteRefreshToken); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteRefreshToken: %w", err)
 	}
@@ -60,6 +114,24 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.deleteRevokedTokenStmt, err = db.PrepareContext(ctx, deleteRevokedToken); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteRevokedToken: %w", err)
 	}
+	if q.deleteWebhookSubscriptionStmt, err = db.PrepareContext(ctx, deleteWebhookSubscription); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteWebhookSubscription: %w", err)
+	}
+	if q.disableWebhookSubscriptionStmt, err = db.PrepareContext(ctx, disableWebhookSubscription); err != nil {
+		return nil, fmt.Errorf("error preparing query DisableWebhookSubscription: %w", err)
+	}
+	if q.failExportJobStmt, err = db.PrepareContext(ctx, failExportJob); err != nil {
+		return nil, fmt.Errorf("error preparing query FailExportJob: %w", err)
+	}
+	if q.failIngestJobStmt, err = db.PrepareContext(ctx, failIngestJob); err != nil {
+		return nil, fmt.Errorf("error preparing query FailIngestJob: %w", err)
+	}
+	if q.finishExportJobStmt, err = db.PrepareContext(ctx, finishExportJob); err != nil {
+		return nil, fmt.Errorf("error preparing query FinishExportJob: %w", err)
+	}
+	if q.finishIngestJobStmt, err = db.PrepareContext(ctx, finishIngestJob); err != nil {
+		return nil, fmt.Errorf("error preparing query FinishIngestJob: %w", err)
+	}
 	if q.getClientByNameStmt, err = db.PrepareContext(ctx, getClientByName); err != nil {
 		return nil, fmt.Errorf("error preparing query GetClientByName: %w", err)
 	}
@@ -72,6 +144,24 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getClientsByRoleStmt, err = db.PrepareContext(ctx, getClientsByRole); err != nil {
 		return nil, fmt.Errorf("error preparing query GetClientsByRole: %w", err)
 	}
+	if q.getExportJobStmt, err = db.PrepareContext(ctx, getExportJob); err != nil {
+		return nil, fmt.Errorf("error preparing query GetExportJob: %w", err)
+	}
+	if q.getExportJobChunkStmt, err = db.PrepareContext(ctx, getExportJobChunk); err != nil {
+		return nil, fmt.Errorf("error preparing query GetExportJobChunk: %w", err)
+	}
+	if q.getIdempotencyKeyStmt, err = db.PrepareContext(ctx, getIdempotencyKey); err != nil {
+		return nil, fmt.Errorf("error preparing query GetIdempotencyKey: %w", err)
+	}
+	if q.getIngestJobStmt, err = db.PrepareContext(ctx, getIngestJob); err != nil {
+		return nil, fmt.Errorf("error preparing query GetIngestJob: %w", err)
+	}
+	if q.getIngestJobClientRolesStmt, err = db.PrepareContext(ctx, getIngestJobClientRoles); err != nil {
+		return nil, fmt.Errorf("error preparing query GetIngestJobClientRoles: %w", err)
+	}
+	if q.getIngestJobPayloadStmt, err = db.PrepareContext(ctx, getIngestJobPayload); err != nil {
+		return nil, fmt.Errorf("error preparing query GetIngestJobPayload: %w", err)
+	}
 	if q.getLogsByActionStmt, err = db.PrepareContext(ctx, getLogsByAction); err != nil {
 		return nil, fmt.Errorf("error preparing query GetLogsByAction: %w", err)
 	}
@@ -81,15 +171,30 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getLogsByTokenTypeStmt, err = db.PrepareContext(ctx, getLogsByTokenType); err != nil {
 		return nil, fmt.Errorf("error preparing query GetLogsByTokenType: %w", err)
 	}
+	if q.getOutboxEventBoundsStmt, err = db.PrepareContext(ctx, getOutboxEventBounds); err != nil {
+		return nil, fmt.Errorf("error preparing query GetOutboxEventBounds: %w", err)
+	}
+	if q.getOutboxHorizonStmt, err = db.PrepareContext(ctx, getOutboxHorizon); err != nil {
+		return nil, fmt.Errorf("error preparing query GetOutboxHorizon: %w", err)
+	}
 	if q.getRefreshTokenStmt, err = db.PrepareContext(ctx, getRefreshToken); err != nil {
 		return nil, fmt.Errorf("error preparing query GetRefreshToken: %w", err)
 	}
 	if q.getRefreshTokenByClientStmt, err = db.PrepareContext(ctx, getRefreshTokenByClient); err != nil {
 		return nil, fmt.Errorf("error preparing query GetRefreshTokenByClient: %w", err)
 	}
+	if q.getWebhookSubscriptionStmt, err = db.PrepareContext(ctx, getWebhookSubscription); err != nil {
+		return nil, fmt.Errorf("error preparing query GetWebhookSubscription: %w", err)
+	}
 	if q.hasRoleStmt, err = db.PrepareContext(ctx, hasRole); err != nil {
 		return nil, fmt.Errorf("error preparing query HasRole: %w", err)
 	}
+	if q.insertExportJobChunkStmt, err = db.PrepareContext(ctx, insertExportJobChunk); err != nil {
+		return nil, fmt.Errorf("error preparing query InsertExportJobChunk: %w", err)
+	}
+	if q.insertIngestJobPayloadStmt, err = db.PrepareContext(ctx, insertIngestJobPayload); err != nil {
+		return nil, fmt.Errorf("error preparing query InsertIngestJobPayload: %w", err)
+	}
 	if q.insertNewRefreshTokenStmt, err = db.PrepareContext(ctx, insertNewRefreshToken); err != nil {
 		return nil, fmt.Errorf("error preparing query InsertNewRefreshToken: %w", err)
 	}
@@ -108,18 +213,90 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.isRevokedTokenStmt, err = db.PrepareContext(ctx, isRevokedToken); err != nil {
 		return nil, fmt.Errorf("error preparing query IsRevokedToken: %w", err)
 	}
+	if q.killWebhookDeliveryStmt, err = db.PrepareContext(ctx, killWebhookDelivery); err != nil {
+		return nil, fmt.Errorf("error preparing query KillWebhookDelivery: %w", err)
+	}
+	if q.listCalendarFeedsByClientStmt, err = db.PrepareContext(ctx, listCalendarFeedsByClient); err != nil {
+		return nil, fmt.Errorf("error preparing query ListCalendarFeedsByClient: %w", err)
+	}
 	if q.listClientsStmt, err = db.PrepareContext(ctx, listClients); err != nil {
 		return nil, fmt.Errorf("error preparing query ListClients: %w", err)
 	}
+	if q.listExportJobsByClientStmt, err = db.PrepareContext(ctx, listExportJobsByClient); err != nil {
+		return nil, fmt.Errorf("error preparing query ListExportJobsByClient: %w", err)
+	}
+	if q.listIngestJobsByClientStmt, err = db.PrepareContext(ctx, listIngestJobsByClient); err != nil {
+		return nil, fmt.Errorf("error preparing query ListIngestJobsByClient: %w", err)
+	}
+	if q.listOutboxEventsAfterStmt, err = db.PrepareContext(ctx, listOutboxEventsAfter); err != nil {
+		return nil, fmt.Errorf("error preparing query ListOutboxEventsAfter: %w", err)
+	}
+	if q.listWebhookDeliveriesStmt, err = db.PrepareContext(ctx, listWebhookDeliveries); err != nil {
+		return nil, fmt.Errorf("error preparing query ListWebhookDeliveries: %w", err)
+	}
+	if q.listWebhookSubscriptionsByClientStmt, err = db.PrepareContext(ctx, listWebhookSubscriptionsByClient); err != nil {
+		return nil, fmt.Errorf("error preparing query ListWebhookSubscriptionsByClient: %w", err)
+	}
+	if q.publishOutboxEventStmt, err = db.PrepareContext(ctx, publishOutboxEvent); err != nil {
+		return nil, fmt.Errorf("error preparing query PublishOutboxEvent: %w", err)
+	}
+	if q.redeliverDeadWebhookDeliveriesStmt, err = db.PrepareContext(ctx, redeliverDeadWebhookDeliveries); err != nil {
+		return nil, fmt.Errorf("error preparing query RedeliverDeadWebhookDeliveries: %w", err)
+	}
+	if q.redeliverWebhookDeliveryStmt, err = db.PrepareContext(ctx, redeliverWebhookDelivery); err != nil {
+		return nil, fmt.Errorf("error preparing query RedeliverWebhookDelivery: %w", err)
+	}
 	if q.removeClientRoleStmt, err = db.PrepareContext(ctx, removeClientRole); err != nil {
 		return nil, fmt.Errorf("error preparing query RemoveClientRole: %w", err)
 	}
+	if q.requeueExportJobStmt, err = db.PrepareContext(ctx, requeueExportJob); err != nil {
+		return nil, fmt.Errorf("error preparing query RequeueExportJob: %w", err)
+	}
+	if q.requeueIngestJobStmt, err = db.PrepareContext(ctx, requeueIngestJob); err != nil {
+		return nil, fmt.Errorf("error preparing query RequeueIngestJob: %w", err)
+	}
+	if q.requeueStaleExportJobsStmt, err = db.PrepareContext(ctx, requeueStaleExportJobs); err != nil {
+		return nil, fmt.Errorf("error preparing query RequeueStaleExportJobs: %w", err)
+	}
+	if q.requeueStaleIngestJobsStmt, err = db.PrepareContext(ctx, requeueStaleIngestJobs); err != nil {
+		return nil, fmt.Errorf("error preparing query RequeueStaleIngestJobs: %w", err)
+	}
+	if q.reserveIdempotencyKeyStmt, err = db.PrepareContext(ctx, reserveIdempotencyKey); err != nil {
+		return nil, fmt.Errorf("error preparing query ReserveIdempotencyKey: %w", err)
+	}
+	if q.resubmitIngestJobStmt, err = db.PrepareContext(ctx, resubmitIngestJob); err != nil {
+		return nil, fmt.Errorf("error preparing query ResubmitIngestJob: %w", err)
+	}
+	if q.retryIngestJobStmt, err = db.PrepareContext(ctx, retryIngestJob); err != nil {
+		return nil, fmt.Errorf("error preparing query RetryIngestJob: %w", err)
+	}
+	if q.retryWebhookDeliveryStmt, err = db.PrepareContext(ctx, retryWebhookDelivery); err != nil {
+		return nil, fmt.Errorf("error preparing query RetryWebhookDelivery: %w", err)
+	}
+	if q.rotateWebhookSecretStmt, err = db.PrepareContext(ctx, rotateWebhookSecret); err != nil {
+		return nil, fmt.Errorf("error preparing query RotateWebhookSecret: %w", err)
+	}
+	if q.succeedWebhookDeliveryStmt, err = db.PrepareContext(ctx, succeedWebhookDelivery); err != nil {
+		return nil, fmt.Errorf("error preparing query SucceedWebhookDelivery: %w", err)
+	}
+	if q.touchExportJobStmt, err = db.PrepareContext(ctx, touchExportJob); err != nil {
+		return nil, fmt.Errorf("error preparing query TouchExportJob: %w", err)
+	}
+	if q.touchIngestJobStmt, err = db.PrepareContext(ctx, touchIngestJob); err != nil {
+		return nil, fmt.Errorf("error preparing query TouchIngestJob: %w", err)
+	}
 	if q.updateClientRolesStmt, err = db.PrepareContext(ctx, updateClientRoles); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateClientRoles: %w", err)
 	}
 	if q.updateClientTokenStmt, err = db.PrepareContext(ctx, updateClientToken); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateClientToken: %w", err)
 	}
+	if q.updateWebhookSubscriptionStmt, err = db.PrepareContext(ctx, updateWebhookSubscription); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateWebhookSubscription: %w", err)
+	}
+	if q.useCalendarFeedStmt, err = db.PrepareContext(ctx, useCalendarFeed); err != nil {
+		return nil, fmt.Errorf("error preparing query UseCalendarFeed: %w", err)
+	}
 	return &q, nil
 }
 
@@ -130,11 +307,56 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing addClientRoleStmt: %w", cerr)
 		}
 	}
+	if q.cancelExportJobStmt != nil {
+		if cerr := q.cancelExportJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing cancelExportJobStmt: %w", cerr)
+		}
+	}
+	if q.checkpointIngestJobStmt != nil {
+		if cerr := q.checkpointIngestJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing checkpointIngestJobStmt: %w", cerr)
+		}
+	}
+	if q.claimExportJobStmt != nil {
+		if cerr := q.claimExportJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing claimExportJobStmt: %w", cerr)
+		}
+	}
+	if q.claimIngestJobStmt != nil {
+		if cerr := q.claimIngestJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing claimIngestJobStmt: %w", cerr)
+		}
+	}
+	if q.claimWebhookDeliveriesStmt != nil {
+		if cerr := q.claimWebhookDeliveriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing claimWebhookDeliveriesStmt: %w", cerr)
+		}
+	}
+	if q.completeIdempotencyKeyStmt != nil {
+		if cerr := q.completeIdempotencyKeyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing completeIdempotencyKeyStmt: %w", cerr)
+		}
+	}
+	if q.createCalendarFeedStmt != nil {
+		if cerr := q.createCalendarFeedStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createCalendarFeedStmt: %w", cerr)
+		}
+	}
 	if q.createClientStmt != nil {
 		if cerr := q.createClientStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createClientStmt: %w", cerr)
 		}
 	}
+	if q.createExportJobStmt != nil {
+		if cerr := q.createExportJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createExportJobStmt: %w", cerr)
+		}
+	}
+	if q.createIngestJobStmt != nil {
+		if cerr := q.createIngestJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createIngestJobStmt: %w", cerr)
+		}
+	}
 	if q.createRefreshTokenStmt != nil {
 		if cerr := q.createRefreshTokenStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createRefreshTokenStmt: %w", cerr)
@@ -150,21 +372,66 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing createRevokedTokenStmt: %w", cerr)
 		}
 	}
+	if q.createWebhookSubscriptionStmt != nil {
+		if cerr := q.createWebhookSubscriptionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createWebhookSubscriptionStmt: %w", cerr)
+		}
+	}
 	if q.deleteAllRefreshTokensForClientStmt != nil {
 		if cerr := q.deleteAllRefreshTokensForClientStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteAllRefreshTokensForClientStmt: %w", cerr)
 		}
 	}
+	if q.deleteCalendarFeedStmt != nil {
+		if cerr := q.deleteCalendarFeedStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteCalendarFeedStmt: %w", cerr)
+		}
+	}
 	if q.deleteClientStmt != nil {
 		if cerr := q.deleteClientStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteClientStmt: %w", cerr)
 		}
 	}
+	if q.deleteExpiredExportJobsStmt != nil {
+		if cerr := q.deleteExpiredExportJobsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteExpiredExportJobsStmt: %w", cerr)
+		}
+	}
+	if q.deleteExpiredIdempotencyKeysStmt != nil {
+		if cerr := q.deleteExpiredIdempotencyKeysStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteExpiredIdempotencyKeysStmt: %w", cerr)
+		}
+	}
+	if q.deleteExpiredIngestJobsStmt != nil {
+		if cerr := q.deleteExpiredIngestJobsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteExpiredIngestJobsStmt: %w", cerr)
+		}
+	}
+	if q.deleteExpiredOutboxEventsStmt != nil {
+		if cerr := q.deleteExpiredOutboxEventsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteExpiredOutboxEventsStmt: %w", cerr)
+		}
+	}
 	if q.deleteExpiredRefreshTokensStmt != nil {
 		if cerr := q.deleteExpiredRefreshTokensStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteExpiredRefreshTokensStmt: %w", cerr)
 		}
 	}
+	if q.deleteExportJobChunksStmt != nil {
+		if cerr := q.deleteExportJobChunksStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteExportJobChunksStmt: %w", cerr)
+		}
+	}
+	if q.deleteIdempotencyKeyStmt != nil {
+		if cerr := q.deleteIdempotencyKeyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteIdempotencyKeyStmt: %w", cerr)
+		}
+	}
+	if q.deleteIngestJobPayloadStmt != nil {
+		if cerr := q.deleteIngestJobPayloadStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteIngestJobPayloadStmt: %w", cerr)
+		}
+	}
 	if q.deleteRefreshTokenStmt != nil {
 		if cerr := q.deleteRefreshTokenStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteRefreshTokenStmt: %w", cerr)
@@ -185,6 +452,36 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deleteRevokedTokenStmt: %w", cerr)
 		}
 	}
+	if q.deleteWebhookSubscriptionStmt != nil {
+		if cerr := q.deleteWebhookSubscriptionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteWebhookSubscriptionStmt: %w", cerr)
+		}
+	}
+	if q.disableWebhookSubscriptionStmt != nil {
+		if cerr := q.disableWebhookSubscriptionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing disableWebhookSubscriptionStmt: %w", cerr)
+		}
+	}
+	if q.failExportJobStmt != nil {
+		if cerr := q.failExportJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing failExportJobStmt: %w", cerr)
+		}
+	}
+	if q.failIngestJobStmt != nil {
+		if cerr := q.failIngestJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing failIngestJobStmt: %w", cerr)
+		}
+	}
+	if q.finishExportJobStmt != nil {
+		if cerr := q.finishExportJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing finishExportJobStmt: %w", cerr)
+		}
+	}
+	if q.finishIngestJobStmt != nil {
+		if cerr := q.finishIngestJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing finishIngestJobStmt: %w", cerr)
+		}
+	}
 	if q.getClientByNameStmt != nil {
 		if cerr := q.getClientByNameStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getClientByNameStmt: %w", cerr)
@@ -205,6 +502,36 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getClientsByRoleStmt: %w", cerr)
 		}
 	}
+	if q.getExportJobStmt != nil {
+		if cerr := q.getExportJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getExportJobStmt: %w", cerr)
+		}
+	}
+	if q.getExportJobChunkStmt != nil {
+		if cerr := q.getExportJobChunkStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getExportJobChunkStmt: %w", cerr)
+		}
+	}
+	if q.getIdempotencyKeyStmt != nil {
+		if cerr := q.getIdempotencyKeyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getIdempotencyKeyStmt: %w", cerr)
+		}
+	}
+	if q.getIngestJobStmt != nil {
+		if cerr := q.getIngestJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getIngestJobStmt: %w", cerr)
+		}
+	}
+	if q.getIngestJobClientRolesStmt != nil {
+		if cerr := q.getIngestJobClientRolesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getIngestJobClientRolesStmt: %w", cerr)
+		}
+	}
+	if q.getIngestJobPayloadStmt != nil {
+		if cerr := q.getIngestJobPayloadStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getIngestJobPayloadStmt: %w", cerr)
+		}
+	}
 	if q.getLogsByActionStmt != nil {
 		if cerr := q.getLogsByActionStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getLogsByActionStmt: %w", cerr)
@@ -220,6 +547,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getLogsByTokenTypeStmt: %w", cerr)
 		}
 	}
+	if q.getOutboxEventBoundsStmt != nil {
+		if cerr := q.getOutboxEventBoundsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getOutboxEventBoundsStmt: %w", cerr)
+		}
+	}
+	if q.getOutboxHorizonStmt != nil {
+		if cerr := q.getOutboxHorizonStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getOutboxHorizonStmt: %w", cerr)
+		}
+	}
 	if q.getRefreshTokenStmt != nil {
 		if cerr := q.getRefreshTokenStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getRefreshTokenStmt: %w", cerr)
@@ -230,11 +567,26 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getRefreshTokenByClientStmt: %w", cerr)
 		}
 	}
+	if q.getWebhookSubscriptionStmt != nil {
+		if cerr := q.getWebhookSubscriptionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getWebhookSubscriptionStmt: %w", cerr)
+		}
+	}
 	if q.hasRoleStmt != nil {
 		if cerr := q.hasRoleStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing hasRoleStmt: %w", cerr)
 		}
 	}
+	if q.insertExportJobChunkStmt != nil {
+		if cerr := q.insertExportJobChunkStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing insertExportJobChunkStmt: %w", cerr)
+		}
+	}
+	if q.insertIngestJobPayloadStmt != nil {
+		if cerr := q.insertIngestJobPayloadStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing insertIngestJobPayloadStmt: %w", cerr)
+		}
+	}
 	if q.insertNewRefreshTokenStmt != nil {
 		if cerr := q.insertNewRefreshTokenStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing insertNewRefreshTokenStmt: %w", cerr)
@@ -265,16 +617,126 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing isRevokedTokenStmt: %w", cerr)
 		}
 	}
+	if q.killWebhookDeliveryStmt != nil {
+		if cerr := q.killWebhookDeliveryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing killWebhookDeliveryStmt: %w", cerr)
+		}
+	}
+	if q.listCalendarFeedsByClientStmt != nil {
+		if cerr := q.listCalendarFeedsByClientStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listCalendarFeedsByClientStmt: %w", cerr)
+		}
+	}
 	if q.listClientsStmt != nil {
 		if cerr := q.listClientsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listClientsStmt: %w", cerr)
 		}
 	}
+	if q.listExportJobsByClientStmt != nil {
+		if cerr := q.listExportJobsByClientStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listExportJobsByClientStmt: %w", cerr)
+		}
+	}
+	if q.listIngestJobsByClientStmt != nil {
+		if cerr := q.listIngestJobsByClientStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listIngestJobsByClientStmt: %w", cerr)
+		}
+	}
+	if q.listOutboxEventsAfterStmt != nil {
+		if cerr := q.listOutboxEventsAfterStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listOutboxEventsAfterStmt: %w", cerr)
+		}
+	}
+	if q.listWebhookDeliveriesStmt != nil {
+		if cerr := q.listWebhookDeliveriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listWebhookDeliveriesStmt: %w", cerr)
+		}
+	}
+	if q.listWebhookSubscriptionsByClientStmt != nil {
+		if cerr := q.listWebhookSubscriptionsByClientStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listWebhookSubscriptionsByClientStmt: %w", cerr)
+		}
+	}
+	if q.publishOutboxEventStmt != nil {
+		if cerr := q.publishOutboxEventStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing publishOutboxEventStmt: %w", cerr)
+		}
+	}
+	if q.redeliverDeadWebhookDeliveriesStmt != nil {
+		if cerr := q.redeliverDeadWebhookDeliveriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing redeliverDeadWebhookDeliveriesStmt: %w", cerr)
+		}
+	}
+	if q.redeliverWebhookDeliveryStmt != nil {
+		if cerr := q.redeliverWebhookDeliveryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing redeliverWebhookDeliveryStmt: %w", cerr)
+		}
+	}
 	if q.removeClientRoleStmt != nil {
 		if cerr := q.removeClientRoleStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing removeClientRoleStmt: %w", cerr)
 		}
 	}
+	if q.requeueExportJobStmt != nil {
+		if cerr := q.requeueExportJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing requeueExportJobStmt: %w", cerr)
+		}
+	}
+	if q.requeueIngestJobStmt != nil {
+		if cerr := q.requeueIngestJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing requeueIngestJobStmt: %w", cerr)
+		}
+	}
+	if q.requeueStaleExportJobsStmt != nil {
+		if cerr := q.requeueStaleExportJobsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing requeueStaleExportJobsStmt: %w", cerr)
+		}
+	}
+	if q.requeueStaleIngestJobsStmt != nil {
+		if cerr := q.requeueStaleIngestJobsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing requeueStaleIngestJobsStmt: %w", cerr)
+		}
+	}
+	if q.reserveIdempotencyKeyStmt != nil {
+		if cerr := q.reserveIdempotencyKeyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing reserveIdempotencyKeyStmt: %w", cerr)
+		}
+	}
+	if q.resubmitIngestJobStmt != nil {
+		if cerr := q.resubmitIngestJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing resubmitIngestJobStmt: %w", cerr)
+		}
+	}
+	if q.retryIngestJobStmt != nil {
+		if cerr := q.retryIngestJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing retryIngestJobStmt: %w", cerr)
+		}
+	}
+	if q.retryWebhookDeliveryStmt != nil {
+		if cerr := q.retryWebhookDeliveryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing retryWebhookDeliveryStmt: %w", cerr)
+		}
+	}
+	if q.rotateWebhookSecretStmt != nil {
+		if cerr := q.rotateWebhookSecretStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing rotateWebhookSecretStmt: %w", cerr)
+		}
+	}
+	if q.succeedWebhookDeliveryStmt != nil {
+		if cerr := q.succeedWebhookDeliveryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing succeedWebhookDeliveryStmt: %w", cerr)
+		}
+	}
+	if q.touchExportJobStmt != nil {
+		if cerr := q.touchExportJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing touchExportJobStmt: %w", cerr)
+		}
+	}
+	if q.touchIngestJobStmt != nil {
+		if cerr := q.touchIngestJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing touchIngestJobStmt: %w", cerr)
+		}
+	}
 	if q.updateClientRolesStmt != nil {
 		if cerr := q.updateClientRolesStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateClientRolesStmt: %w", cerr)
@@ -285,6 +747,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updateClientTokenStmt: %w", cerr)
 		}
 	}
+	if q.updateWebhookSubscriptionStmt != nil {
+		if cerr := q.updateWebhookSubscriptionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateWebhookSubscriptionStmt: %w", cerr)
+		}
+	}
+	if q.useCalendarFeedStmt != nil {
+		if cerr := q.useCalendarFeedStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing useCalendarFeedStmt: %w", cerr)
+		}
+	}
 	return err
 }
 
@@ -322,77 +794,195 @@ func (q *Queries) queryRow(ctx context.Context, stmt *sql.Stmt, query string, ar
 }
 
 type Queries struct {
-	db                                  DBTX
-	tx                                  *sql.Tx
-	addClientRoleStmt                   *sql.Stmt
-	createClientStmt                    *sql.Stmt
-	createRefreshTokenStmt              *sql.Stmt
-	createRevokedRefreshTokenStmt       *sql.Stmt
-	createRevokedTokenStmt              *sql.Stmt
-	deleteAllRefreshTokensForClientStmt *sql.Stmt
-	deleteClientStmt                    *sql.Stmt
-	deleteExpiredRefreshTokensStmt      *sql.Stmt
-	deleteRefreshTokenStmt              *sql.Stmt
-	deleteRefreshTokenByTokenStmt       *sql.Stmt
-	deleteRevokedRefreshTokenStmt       *sql.Stmt
-	deleteRevokedTokenStmt              *sql.Stmt
-	getClientByNameStmt                 *sql.Stmt
-	getClientByTokenStmt                *sql.Stmt
-	getClientRolesStmt                  *sql.Stmt
-	getClientsByRoleStmt                *sql.Stmt
-	getLogsByActionStmt                 *sql.Stmt
-	getLogsByClientStmt                 *sql.Stmt
-	getLogsByTokenTypeStmt              *sql.Stmt
-	getRefreshTokenStmt                 *sql.Stmt
-	getRefreshTokenByClientStmt         *sql.Stmt
-	hasRoleStmt                         *sql.Stmt
-	insertNewRefreshTokenStmt           *sql.Stmt
-	insertRevokedRefreshTokenStmt       *sql.Stmt
-	insertTokenLogStmt                  *sql.Stmt
-	isRefreshTokenExpiredStmt           *sql.Stmt
-	isRevokedRefreshTokenStmt           *sql.Stmt
-	isRevokedTokenStmt                  *sql.Stmt
-	listClientsStmt                     *sql.Stmt
-	removeClientRoleStmt                *sql.Stmt
-	updateClientRolesStmt               *sql.Stmt
-	updateClientTokenStmt               *sql.Stmt
+	db                                   DBTX
+	tx                                   *sql.Tx
+	addClientRoleStmt                    *sql.Stmt
+	cancelExportJobStmt                  *sql.Stmt
+	checkpointIngestJobStmt              *sql.Stmt
+	claimExportJobStmt                   *sql.Stmt
+	claimIngestJobStmt                   *sql.Stmt
+	claimWebhookDeliveriesStmt           *sql.Stmt
+	completeIdempotencyKeyStmt           *sql.Stmt
+	createCalendarFeedStmt               *sql.Stmt
+	createClientStmt                     *sql.Stmt
+	createExportJobStmt                  *sql.Stmt
+	createIngestJobStmt                  *sql.Stmt
+	createRefreshTokenStmt               *sql.Stmt
+	createRevokedRefreshTokenStmt        *sql.Stmt
+	createRevokedTokenStmt               *sql.Stmt
+	createWebhookSubscriptionStmt        *sql.Stmt
+	deleteAllRefreshTokensForClientStmt  *sql.Stmt
+	deleteCalendarFeedStmt               *sql.Stmt
+	deleteClientStmt                     *sql.Stmt
+	deleteExpiredExportJobsStmt          *sql.Stmt
+	deleteExpiredIdempotencyKeysStmt     *sql.Stmt
+	deleteExpiredIngestJobsStmt          *sql.Stmt
+	deleteExpiredOutboxEventsStmt        *sql.Stmt
+	deleteExpiredRefreshTokensStmt       *sql.Stmt
+	deleteExportJobChunksStmt            *sql.Stmt
+	deleteIdempotencyKeyStmt             *sql.Stmt
+	deleteIngestJobPayloadStmt           *sql.Stmt
+	deleteRefreshTokenStmt               *sql.Stmt
+	deleteRefreshTokenByTokenStmt        *sql.Stmt
+	deleteRevokedRefreshTokenStmt        *sql.Stmt
+	deleteRevokedTokenStmt               *sql.Stmt
+	deleteWebhookSubscriptionStmt        *sql.Stmt
+	disableWebhookSubscriptionStmt       *sql.Stmt
+	failExportJobStmt                    *sql.Stmt
+	failIngestJobStmt                    *sql.Stmt
+	finishExportJobStmt                  *sql.Stmt
+	finishIngestJobStmt                  *sql.Stmt
+	getClientByNameStmt                  *sql.Stmt
+	getClientByTokenStmt                 *sql.Stmt
+	getClientRolesStmt                   *sql.Stmt
+	getClientsByRoleStmt                 *sql.Stmt
+	getExportJobStmt                     *sql.Stmt
+	getExportJobChunkStmt                *sql.Stmt
+	getIdempotencyKeyStmt                *sql.Stmt
+	getIngestJobStmt                     *sql.Stmt
+	getIngestJobClientRolesStmt          *sql.Stmt
+	getIngestJobPayloadStmt              *sql.Stmt
+	getLogsByActionStmt                  *sql.Stmt
+	getLogsByClientStmt                  *sql.Stmt
+	getLogsByTokenTypeStmt               *sql.Stmt
+	getOutboxEventBoundsStmt             *sql.Stmt
+	getOutboxHorizonStmt                 *sql.Stmt
+	getRefreshTokenStmt                  *sql.Stmt
+	getRefreshTokenByClientStmt          *sql.Stmt
+	getWebhookSubscriptionStmt           *sql.Stmt
+	hasRoleStmt                          *sql.Stmt
+	insertExportJobChunkStmt             *sql.Stmt
+	insertIngestJobPayloadStmt           *sql.Stmt
+	insertNewRefreshTokenStmt            *sql.Stmt
+	insertRevokedRefreshTokenStmt        *sql.Stmt
+	insertTokenLogStmt                   *sql.Stmt
+	isRefreshTokenExpiredStmt            *sql.Stmt
+	isRevokedRefreshTokenStmt            *sql.Stmt
+	isRevokedTokenStmt                   *sql.Stmt
+	killWebhookDeliveryStmt              *sql.Stmt
+	listCalendarFeedsByClientStmt        *sql.Stmt
+	listClientsStmt                      *sql.Stmt
+	listExportJobsByClientStmt           *sql.Stmt
+	listIngestJobsByClientStmt           *sql.Stmt
+	listOutboxEventsAfterStmt            *sql.Stmt
+	listWebhookDeliveriesStmt            *sql.Stmt
+	listWebhookSubscriptionsByClientStmt *sql.Stmt
+	publishOutboxEventStmt               *sql.Stmt
+	redeliverDeadWebhookDeliveriesStmt   *sql.Stmt
+	redeliverWebhookDeliveryStmt         *sql.Stmt
+	removeClientRoleStmt                 *sql.Stmt
+	requeueExportJobStmt                 *sql.Stmt
+	requeueIngestJobStmt                 *sql.Stmt
+	requeueStaleExportJobsStmt           *sql.Stmt
+	requeueStaleIngestJobsStmt           *sql.Stmt
+	reserveIdempotencyKeyStmt            *sql.Stmt
+	resubmitIngestJobStmt                *sql.Stmt
+	retryIngestJobStmt                   *sql.Stmt
+	retryWebhookDeliveryStmt             *sql.Stmt
+	rotateWebhookSecretStmt              *sql.Stmt
+	succeedWebhookDeliveryStmt           *sql.Stmt
+	touchExportJobStmt                   *sql.Stmt
+	touchIngestJobStmt                   *sql.Stmt
+	updateClientRolesStmt                *sql.Stmt
+	updateClientTokenStmt                *sql.Stmt
+	updateWebhookSubscriptionStmt        *sql.Stmt
+	useCalendarFeedStmt                  *sql.Stmt
 }
 
 func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 	return &Queries{
-		db:                                  tx,
-		tx:                                  tx,
-		addClientRoleStmt:                   q.addClientRoleStmt,
-		createClientStmt:                    q.createClientStmt,
-		createRefreshTokenStmt:              q.createRefreshTokenStmt,
-		createRevokedRefreshTokenStmt:       q.createRevokedRefreshTokenStmt,
-		createRevokedTokenStmt:              q.createRevokedTokenStmt,
-		deleteAllRefreshTokensForClientStmt: q.deleteAllRefreshTokensForClientStmt,
-		deleteClientStmt:                    q.deleteClientStmt,
-		deleteExpiredRefreshTokensStmt:      q.deleteExpiredRefreshTokensStmt,
-		deleteRefreshTokenStmt:              q.deleteRefreshTokenStmt,
-		deleteRefreshTokenByTokenStmt:       q.deleteRefreshTokenByTokenStmt,
-		deleteRevokedRefreshTokenStmt:       q.deleteRevokedRefreshTokenStmt,
-		deleteRevokedTokenStmt:              q.deleteRevokedTokenStmt,
-		getClientByNameStmt:                 q.getClientByNameStmt,
-		getClientByTokenStmt:                q.getClientByTokenStmt,
-		getClientRolesStmt:                  q.getClientRolesStmt,
-		getClientsByRoleStmt:                q.getClientsByRoleStmt,
-		getLogsByActionStmt:                 q.getLogsByActionStmt,
-		getLogsByClientStmt:                 q.getLogsByClientStmt,
-		getLogsByTokenTypeStmt:              q.getLogsByTokenTypeStmt,
-		getRefreshTokenStmt:                 q.getRefreshTokenStmt,
-		getRefreshTokenByClientStmt:         q.getRefreshTokenByClientStmt,
-		hasRoleStmt:                         q.hasRoleStmt,
-		insertNewRefreshTokenStmt:           q.insertNewRefreshTokenStmt,
-		insertRevokedRefreshTokenStmt:       q.insertRevokedRefreshTokenStmt,
-		insertTokenLogStmt:                  q.insertTokenLogStmt,
-		isRefreshTokenExpiredStmt:           q.isRefreshTokenExpiredStmt,
-		isRevokedRefreshTokenStmt:           q.isRevokedRefreshTokenStmt,
-		isRevokedTokenStmt:                  q.isRevokedTokenStmt,
-		listClientsStmt:                     q.listClientsStmt,
-		removeClientRoleStmt:                q.removeClientRoleStmt,
-		updateClientRolesStmt:               q.updateClientRolesStmt,
-		updateClientTokenStmt:               q.updateClientTokenStmt,
+		db:                                   tx,
+		tx:                                   tx,
+		addClientRoleStmt:                    q.addClientRoleStmt,
+		cancelExportJobStmt:                  q.cancelExportJobStmt,
+		checkpointIngestJobStmt:              q.checkpointIngestJobStmt,
+		claimExportJobStmt:                   q.claimExportJobStmt,
+		claimIngestJobStmt:                   q.claimIngestJobStmt,
+		claimWebhookDeliveriesStmt:           q.claimWebhookDeliveriesStmt,
+		completeIdempotencyKeyStmt:           q.completeIdempotencyKeyStmt,
+		createCalendarFeedStmt:               q.createCalendarFeedStmt,
+		createClientStmt:                     q.createClientStmt,
+		createExportJobStmt:                  q.createExportJobStmt,
+		createIngestJobStmt:                  q.createIngestJobStmt,
+		createRefreshTokenStmt:               q.createRefreshTokenStmt,
+		createRevokedRefreshTokenStmt:        q.createRevokedRefreshTokenStmt,
+		createRevokedTokenStmt:               q.createRevokedTokenStmt,
+		createWebhookSubscriptionStmt:        q.createWebhookSubscriptionStmt,
+		deleteAllRefreshTokensForClientStmt:  q.deleteAllRefreshTokensForClientStmt,
+		deleteCalendarFeedStmt:               q.deleteCalendarFeedStmt,
+		deleteClientStmt:                     q.deleteClientStmt,
+		deleteExpiredExportJobsStmt:          q.deleteExpiredExportJobsStmt,
+		deleteExpiredIdempotencyKeysStmt:     q.deleteExpiredIdempotencyKeysStmt,
+		deleteExpiredIngestJobsStmt:          q.deleteExpiredIngestJobsStmt,
+		deleteExpiredOutboxEventsStmt:        q.deleteExpiredOutboxEventsStmt,
+		deleteExpiredRefreshTokensStmt:       q.deleteExpiredRefreshTokensStmt,
+		deleteExportJobChunksStmt:            q.deleteExportJobChunksStmt,
+		deleteIdempotencyKeyStmt:             q.deleteIdempotencyKeyStmt,
+		deleteIngestJobPayloadStmt:           q.deleteIngestJobPayloadStmt,
+		deleteRefreshTokenStmt:               q.deleteRefreshTokenStmt,
+		deleteRefreshTokenByTokenStmt:        q.deleteRefreshTokenByTokenStmt,
+		deleteRevokedRefreshTokenStmt:        q.deleteRevokedRefreshTokenStmt,
+		deleteRevokedTokenStmt:               q.deleteRevokedTokenStmt,
+		deleteWebhookSubscriptionStmt:        q.deleteWebhookSubscriptionStmt,
+		disableWebhookSubscriptionStmt:       q.disableWebhookSubscriptionStmt,
+		failExportJobStmt:                    q.failExportJobStmt,
+		failIngestJobStmt:                    q.failIngestJobStmt,
+		finishExportJobStmt:                  q.finishExportJobStmt,
+		finishIngestJobStmt:                  q.finishIngestJobStmt,
+		getClientByNameStmt:                  q.getClientByNameStmt,
+		getClientByTokenStmt:                 q.getClientByTokenStmt,
+		getClientRolesStmt:                   q.getClientRolesStmt,
+		getClientsByRoleStmt:                 q.getClientsByRoleStmt,
+		getExportJobStmt:                     q.getExportJobStmt,
+		getExportJobChunkStmt:                q.getExportJobChunkStmt,
+		getIdempotencyKeyStmt:                q.getIdempotencyKeyStmt,
+		getIngestJobStmt:                     q.getIngestJobStmt,
+		getIngestJobClientRolesStmt:          q.getIngestJobClientRolesStmt,
+		getIngestJobPayloadStmt:              q.getIngestJobPayloadStmt,
+		getLogsByActionStmt:                  q.getLogsByActionStmt,
+		getLogsByClientStmt:                  q.getLogsByClientStmt,
+		getLogsByTokenTypeStmt:               q.getLogsByTokenTypeStmt,
+		getOutboxEventBoundsStmt:             q.getOutboxEventBoundsStmt,
+		getOutboxHorizonStmt:                 q.getOutboxHorizonStmt,
+		getRefreshTokenStmt:                  q.getRefreshTokenStmt,
+		getRefreshTokenByClientStmt:          q.getRefreshTokenByClientStmt,
+		getWebhookSubscriptionStmt:           q.getWebhookSubscriptionStmt,
+		hasRoleStmt:                          q.hasRoleStmt,
+		insertExportJobChunkStmt:             q.insertExportJobChunkStmt,
+		insertIngestJobPayloadStmt:           q.insertIngestJobPayloadStmt,
+		insertNewRefreshTokenStmt:            q.insertNewRefreshTokenStmt,
+		insertRevokedRefreshTokenStmt:        q.insertRevokedRefreshTokenStmt,
+		insertTokenLogStmt:                   q.insertTokenLogStmt,
+		isRefreshTokenExpiredStmt:            q.isRefreshTokenExpiredStmt,
+		isRevokedRefreshTokenStmt:            q.isRevokedRefreshTokenStmt,
+		isRevokedTokenStmt:                   q.isRevokedTokenStmt,
+		killWebhookDeliveryStmt:              q.killWebhookDeliveryStmt,
+		listCalendarFeedsByClientStmt:        q.listCalendarFeedsByClientStmt,
+		listClientsStmt:                      q.listClientsStmt,
+		listExportJobsByClientStmt:           q.listExportJobsByClientStmt,
+		listIngestJobsByClientStmt:           q.listIngestJobsByClientStmt,
+		listOutboxEventsAfterStmt:            q.listOutboxEventsAfterStmt,
+		listWebhookDeliveriesStmt:            q.listWebhookDeliveriesStmt,
+		listWebhookSubscriptionsByClientStmt: q.listWebhookSubscriptionsByClientStmt,
+		publishOutboxEventStmt:               q.publishOutboxEventStmt,
+		redeliverDeadWebhookDeliveriesStmt:   q.redeliverDeadWebhookDeliveriesStmt,
+		redeliverWebhookDeliveryStmt:         q.redeliverWebhookDeliveryStmt,
+		removeClientRoleStmt:                 q.removeClientRoleStmt,
+		requeueExportJobStmt:                 q.requeueExportJobStmt,
+		requeueIngestJobStmt:                 q.requeueIngestJobStmt,
+		requeueStaleExportJobsStmt:           q.requeueStaleExportJobsStmt,
+		requeueStaleIngestJobsStmt:           q.requeueStaleIngestJobsStmt,
+		reserveIdempotencyKeyStmt:            q.reserveIdempotencyKeyStmt,
+		resubmitIngestJobStmt:                q.resubmitIngestJobStmt,
+		retryIngestJobStmt:                   q.retryIngestJobStmt,
+		retryWebhookDeliveryStmt:             q.retryWebhookDeliveryStmt,
+		rotateWebhookSecretStmt:              q.rotateWebhookSecretStmt,
+		succeedWebhookDeliveryStmt:           q.succeedWebhookDeliveryStmt,
+		touchExportJobStmt:                   q.touchExportJobStmt,
+		touchIngestJobStmt:                   q.touchIngestJobStmt,
+		updateClientRolesStmt:                q.updateClientRolesStmt,
+		updateClientTokenStmt:                q.updateClientTokenStmt,
+		updateWebhookSubscriptionStmt:        q.updateWebhookSubscriptionStmt,
+		useCalendarFeedStmt:                  q.useCalendarFeedStmt,
 	}
 }
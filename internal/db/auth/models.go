@@ -6,11 +6,21 @@ package authsqlc
 
 import (
 	"database/sql"
+	"encoding/json"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/sqlc-dev/pqtype"
 )
 
+type CalendarFeed struct {
+	ID         uuid.UUID
+	ClientName string
+	TokenHash  string
+	CreatedAt  time.Time
+	LastUsedAt sql.NullTime
+}
+
 type Client struct {
 	ID          int32
 	ClientName  string
@@ -19,6 +29,80 @@ type Client struct {
 	CreatedAt   sql.NullTime
 }
 
+type ExportJob struct {
+	ID          uuid.UUID
+	ClientName  string
+	Kind        string
+	Format      string
+	Params      json.RawMessage
+	Status      string
+	Error       sql.NullString
+	RowsWritten int64
+	SizeBytes   int64
+	ChunkSize   int32
+	Worker      sql.NullString
+	CreatedAt   time.Time
+	StartedAt   sql.NullTime
+	HeartbeatAt sql.NullTime
+	FinishedAt  sql.NullTime
+	ExpiresAt   time.Time
+}
+
+type ExportJobChunk struct {
+	JobID uuid.UUID
+	Seq   int32
+	Data  []byte
+}
+
+type IdempotencyKey struct {
+	Key         string
+	Fingerprint string
+	Token       string
+	Status      sql.NullInt32
+	Headers     pqtype.NullRawMessage
+	Body        []byte
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+type IngestJob struct {
+	ID            uuid.UUID
+	ClientName    string
+	Roles         []string
+	Kind          string
+	Path          string
+	Query         string
+	Status        string
+	Error         sql.NullString
+	RecordsTotal  int32
+	RecordsDone   int32
+	RecordsFailed int32
+	RecordErrors  json.RawMessage
+	Attempts      int32
+	NextAttemptAt time.Time
+	Worker        sql.NullString
+	CreatedAt     time.Time
+	StartedAt     sql.NullTime
+	HeartbeatAt   sql.NullTime
+	FinishedAt    sql.NullTime
+	ExpiresAt     time.Time
+}
+
+type IngestJobPayload struct {
+	JobID uuid.UUID
+	Data  []byte
+}
+
+type OutboxEvent struct {
+	ID         int64
+	Domain     string
+	Entity     string
+	Operation  string
+	AthleteIds []string
+	Records    int32
+	CreatedAt  time.Time
+}
+
 type RefreshToken struct {
 	ID          int32
 	ClientToken string
@@ -48,3 +132,30 @@ type TokenLog struct {
 	Metadata    pqtype.NullRawMessage
 	CreatedAt   sql.NullTime
 }
+
+type WebhookDelivery struct {
+	ID             int64
+	SubscriptionID uuid.UUID
+	EventID        int64
+	Status         string
+	Attempts       int32
+	NextAttemptAt  time.Time
+	ResponseStatus sql.NullInt32
+	Error          sql.NullString
+	CreatedAt      time.Time
+	FinishedAt     sql.NullTime
+}
+
+type WebhookSubscription struct {
+	ID         uuid.UUID
+	ClientName string
+	Url        string
+	Secret     string
+	Domains    []string
+	Entities   []string
+	Operations []string
+	AthleteIds []string
+	Active     bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
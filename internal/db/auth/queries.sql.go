@@ -8,8 +8,10 @@ package authsqlc
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/lib/pq"
 	"github.com/sqlc-dev/pqtype"
 )
@@ -592,3 +594,1639 @@ func (q *Queries) UpdateClientToken(ctx context.Context, arg UpdateClientTokenPa
 	_, err := q.exec(ctx, q.updateClientTokenStmt, updateClientToken, arg.ClientName, arg.ClientToken)
 	return err
 }
+
+const createExportJob = `-- name: CreateExportJob :one
+INSERT INTO export_jobs (client_name, kind, format, params, chunk_size, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, client_name, kind, format, params, status, error, rows_written, size_bytes, chunk_size, worker, created_at, started_at, heartbeat_at, finished_at, expires_at
+`
+
+type CreateExportJobParams struct {
+	ClientName string
+	Kind       string
+	Format     string
+	Params     json.RawMessage
+	ChunkSize  int32
+	ExpiresAt  time.Time
+}
+
+func (q *Queries) CreateExportJob(ctx context.Context, arg CreateExportJobParams) (ExportJob, error) {
+	row := q.queryRow(ctx, q.createExportJobStmt, createExportJob,
+		arg.ClientName,
+		arg.Kind,
+		arg.Format,
+		arg.Params,
+		arg.ChunkSize,
+		arg.ExpiresAt,
+	)
+	var i ExportJob
+	err := row.Scan(
+		&i.ID,
+		&i.ClientName,
+		&i.Kind,
+		&i.Format,
+		&i.Params,
+		&i.Status,
+		&i.Error,
+		&i.RowsWritten,
+		&i.SizeBytes,
+		&i.ChunkSize,
+		&i.Worker,
+		&i.CreatedAt,
+		&i.StartedAt,
+		&i.HeartbeatAt,
+		&i.FinishedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const getExportJob = `-- name: GetExportJob :one
+SELECT id, client_name, kind, format, params, status, error, rows_written, size_bytes, chunk_size, worker, created_at, started_at, heartbeat_at, finished_at, expires_at FROM export_jobs
+WHERE id = $1 AND client_name = $2
+`
+
+type GetExportJobParams struct {
+	ID         uuid.UUID
+	ClientName string
+}
+
+func (q *Queries) GetExportJob(ctx context.Context, arg GetExportJobParams) (ExportJob, error) {
+	row := q.queryRow(ctx, q.getExportJobStmt, getExportJob, arg.ID, arg.ClientName)
+	var i ExportJob
+	err := row.Scan(
+		&i.ID,
+		&i.ClientName,
+		&i.Kind,
+		&i.Format,
+		&i.Params,
+		&i.Status,
+		&i.Error,
+		&i.RowsWritten,
+		&i.SizeBytes,
+		&i.ChunkSize,
+		&i.Worker,
+		&i.CreatedAt,
+		&i.StartedAt,
+		&i.HeartbeatAt,
+		&i.FinishedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const listExportJobsByClient = `-- name: ListExportJobsByClient :many
+SELECT id, client_name, kind, format, params, status, error, rows_written, size_bytes, chunk_size, worker, created_at, started_at, heartbeat_at, finished_at, expires_at FROM export_jobs
+WHERE client_name = $1
+  AND ($2::timestamptz IS NULL OR (created_at, id) < ($2::timestamptz, $3::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT $4::int4
+`
+
+type ListExportJobsByClientParams struct {
+	ClientName string
+	AfterTime  sql.NullTime
+	AfterID    uuid.NullUUID
+	PageLimit  int32
+}
+
+func (q *Queries) ListExportJobsByClient(ctx context.Context, arg ListExportJobsByClientParams) ([]ExportJob, error) {
+	rows, err := q.query(ctx, q.listExportJobsByClientStmt, listExportJobsByClient,
+		arg.ClientName,
+		arg.AfterTime,
+		arg.AfterID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ExportJob
+	for rows.Next() {
+		var i ExportJob
+		if err := rows.Scan(
+			&i.ID,
+			&i.ClientName,
+			&i.Kind,
+			&i.Format,
+			&i.Params,
+			&i.Status,
+			&i.Error,
+			&i.RowsWritten,
+			&i.SizeBytes,
+			&i.ChunkSize,
+			&i.Worker,
+			&i.CreatedAt,
+			&i.StartedAt,
+			&i.HeartbeatAt,
+			&i.FinishedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const claimExportJob = `-- name: ClaimExportJob :one
+UPDATE export_jobs
+SET status = 'running', worker = $1, started_at = now(), heartbeat_at = now(),
+    rows_written = 0, size_bytes = 0
+WHERE id = (
+  SELECT id FROM export_jobs
+  WHERE status = 'queued'
+  ORDER BY created_at
+  LIMIT 1
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING id, client_name, kind, format, params, status, error, rows_written, size_bytes, chunk_size, worker, created_at, started_at, heartbeat_at, finished_at, expires_at
+`
+
+func (q *Queries) ClaimExportJob(ctx context.Context, worker sql.NullString) (ExportJob, error) {
+	row := q.queryRow(ctx, q.claimExportJobStmt, claimExportJob, worker)
+	var i ExportJob
+	err := row.Scan(
+		&i.ID,
+		&i.ClientName,
+		&i.Kind,
+		&i.Format,
+		&i.Params,
+		&i.Status,
+		&i.Error,
+		&i.RowsWritten,
+		&i.SizeBytes,
+		&i.ChunkSize,
+		&i.Worker,
+		&i.CreatedAt,
+		&i.StartedAt,
+		&i.HeartbeatAt,
+		&i.FinishedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const touchExportJob = `-- name: TouchExportJob :one
+UPDATE export_jobs
+SET heartbeat_at = now(), rows_written = $2, size_bytes = $3
+WHERE id = $1
+RETURNING status
+`
+
+type TouchExportJobParams struct {
+	ID          uuid.UUID
+	RowsWritten int64
+	SizeBytes   int64
+}
+
+func (q *Queries) TouchExportJob(ctx context.Context, arg TouchExportJobParams) (string, error) {
+	row := q.queryRow(ctx, q.touchExportJobStmt, touchExportJob, arg.ID, arg.RowsWritten, arg.SizeBytes)
+	var status string
+	err := row.Scan(&status)
+	return status, err
+}
+
+const finishExportJob = `-- name: FinishExportJob :execrows
+UPDATE export_jobs
+SET status = 'succeeded', rows_written = $2, size_bytes = $3, finished_at = now(), expires_at = $4
+WHERE id = $1 AND status = 'running'
+`
+
+type FinishExportJobParams struct {
+	ID          uuid.UUID
+	RowsWritten int64
+	SizeBytes   int64
+	ExpiresAt   time.Time
+}
+
+func (q *Queries) FinishExportJob(ctx context.Context, arg FinishExportJobParams) (int64, error) {
+	result, err := q.exec(ctx, q.finishExportJobStmt, finishExportJob,
+		arg.ID,
+		arg.RowsWritten,
+		arg.SizeBytes,
+		arg.ExpiresAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const failExportJob = `-- name: FailExportJob :exec
+UPDATE export_jobs
+SET status = 'failed', error = $2, finished_at = now(), expires_at = $3
+WHERE id = $1 AND status = 'running'
+`
+
+type FailExportJobParams struct {
+	ID        uuid.UUID
+	Error     sql.NullString
+	ExpiresAt time.Time
+}
+
+func (q *Queries) FailExportJob(ctx context.Context, arg FailExportJobParams) error {
+	_, err := q.exec(ctx, q.failExportJobStmt, failExportJob, arg.ID, arg.Error, arg.ExpiresAt)
+	return err
+}
+
+const cancelExportJob = `-- name: CancelExportJob :one
+UPDATE export_jobs
+SET status = 'canceled', finished_at = now(), expires_at = $3
+WHERE id = $1 AND client_name = $2 AND status IN ('queued', 'running')
+RETURNING id, client_name, kind, format, params, status, error, rows_written, size_bytes, chunk_size, worker, created_at, started_at, heartbeat_at, finished_at, expires_at
+`
+
+type CancelExportJobParams struct {
+	ID         uuid.UUID
+	ClientName string
+	ExpiresAt  time.Time
+}
+
+func (q *Queries) CancelExportJob(ctx context.Context, arg CancelExportJobParams) (ExportJob, error) {
+	row := q.queryRow(ctx, q.cancelExportJobStmt, cancelExportJob, arg.ID, arg.ClientName, arg.ExpiresAt)
+	var i ExportJob
+	err := row.Scan(
+		&i.ID,
+		&i.ClientName,
+		&i.Kind,
+		&i.Format,
+		&i.Params,
+		&i.Status,
+		&i.Error,
+		&i.RowsWritten,
+		&i.SizeBytes,
+		&i.ChunkSize,
+		&i.Worker,
+		&i.CreatedAt,
+		&i.StartedAt,
+		&i.HeartbeatAt,
+		&i.FinishedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const requeueExportJob = `-- name: RequeueExportJob :exec
+UPDATE export_jobs
+SET status = 'queued', worker = NULL, started_at = NULL, heartbeat_at = NULL
+WHERE id = $1 AND status = 'running'
+`
+
+func (q *Queries) RequeueExportJob(ctx context.Context, id uuid.UUID) error {
+	_, err := q.exec(ctx, q.requeueExportJobStmt, requeueExportJob, id)
+	return err
+}
+
+const requeueStaleExportJobs = `-- name: RequeueStaleExportJobs :execrows
+UPDATE export_jobs
+SET status = 'queued', worker = NULL, started_at = NULL, heartbeat_at = NULL
+WHERE status = 'running' AND heartbeat_at < $1
+`
+
+func (q *Queries) RequeueStaleExportJobs(ctx context.Context, heartbeatAt sql.NullTime) (int64, error) {
+	result, err := q.exec(ctx, q.requeueStaleExportJobsStmt, requeueStaleExportJobs, heartbeatAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteExpiredExportJobs = `-- name: DeleteExpiredExportJobs :execrows
+DELETE FROM export_jobs
+WHERE expires_at < now()
+`
+
+func (q *Queries) DeleteExpiredExportJobs(ctx context.Context) (int64, error) {
+	result, err := q.exec(ctx, q.deleteExpiredExportJobsStmt, deleteExpiredExportJobs)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const insertExportJobChunk = `-- name: InsertExportJobChunk :exec
+INSERT INTO export_job_chunks (job_id, seq, data)
+VALUES ($1, $2, $3)
+`
+
+type InsertExportJobChunkParams struct {
+	JobID uuid.UUID
+	Seq   int32
+	Data  []byte
+}
+
+func (q *Queries) InsertExportJobChunk(ctx context.Context, arg InsertExportJobChunkParams) error {
+	_, err := q.exec(ctx, q.insertExportJobChunkStmt, insertExportJobChunk, arg.JobID, arg.Seq, arg.Data)
+	return err
+}
+
+const getExportJobChunk = `-- name: GetExportJobChunk :one
+SELECT data FROM export_job_chunks
+WHERE job_id = $1 AND seq = $2
+`
+
+type GetExportJobChunkParams struct {
+	JobID uuid.UUID
+	Seq   int32
+}
+
+func (q *Queries) GetExportJobChunk(ctx context.Context, arg GetExportJobChunkParams) ([]byte, error) {
+	row := q.queryRow(ctx, q.getExportJobChunkStmt, getExportJobChunk, arg.JobID, arg.Seq)
+	var data []byte
+	err := row.Scan(&data)
+	return data, err
+}
+
+const deleteExportJobChunks = `-- name: DeleteExportJobChunks :exec
+DELETE FROM export_job_chunks
+WHERE job_id = $1
+`
+
+func (q *Queries) DeleteExportJobChunks(ctx context.Context, jobID uuid.UUID) error {
+	_, err := q.exec(ctx, q.deleteExportJobChunksStmt, deleteExportJobChunks, jobID)
+	return err
+}
+
+const reserveIdempotencyKey = `-- name: ReserveIdempotencyKey :execrows
+INSERT INTO idempotency_keys (key, fingerprint, token, expires_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (key) DO UPDATE
+SET fingerprint = EXCLUDED.fingerprint, token = EXCLUDED.token, status = NULL, headers = NULL, body = NULL,
+    created_at = now(), expires_at = EXCLUDED.expires_at
+WHERE idempotency_keys.expires_at < now()
+`
+
+type ReserveIdempotencyKeyParams struct {
+	Key         string
+	Fingerprint string
+	Token       string
+	ExpiresAt   time.Time
+}
+
+func (q *Queries) ReserveIdempotencyKey(ctx context.Context, arg ReserveIdempotencyKeyParams) (int64, error) {
+	result, err := q.exec(ctx, q.reserveIdempotencyKeyStmt, reserveIdempotencyKey,
+		arg.Key,
+		arg.Fingerprint,
+		arg.Token,
+		arg.ExpiresAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getIdempotencyKey = `-- name: GetIdempotencyKey :one
+SELECT key, fingerprint, token, status, headers, body, created_at, expires_at FROM idempotency_keys
+WHERE key = $1
+`
+
+func (q *Queries) GetIdempotencyKey(ctx context.Context, key string) (IdempotencyKey, error) {
+	row := q.queryRow(ctx, q.getIdempotencyKeyStmt, getIdempotencyKey, key)
+	var i IdempotencyKey
+	err := row.Scan(
+		&i.Key,
+		&i.Fingerprint,
+		&i.Token,
+		&i.Status,
+		&i.Headers,
+		&i.Body,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const completeIdempotencyKey = `-- name: CompleteIdempotencyKey :execrows
+UPDATE idempotency_keys
+SET status = $3, headers = $4, body = $5, expires_at = $6
+WHERE key = $1 AND token = $2 AND status IS NULL
+`
+
+type CompleteIdempotencyKeyParams struct {
+	Key       string
+	Token     string
+	Status    sql.NullInt32
+	Headers   pqtype.NullRawMessage
+	Body      []byte
+	ExpiresAt time.Time
+}
+
+func (q *Queries) CompleteIdempotencyKey(ctx context.Context, arg CompleteIdempotencyKeyParams) (int64, error) {
+	result, err := q.exec(ctx, q.completeIdempotencyKeyStmt, completeIdempotencyKey,
+		arg.Key,
+		arg.Token,
+		arg.Status,
+		arg.Headers,
+		arg.Body,
+		arg.ExpiresAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteIdempotencyKey = `-- name: DeleteIdempotencyKey :execrows
+DELETE FROM idempotency_keys
+WHERE key = $1 AND token = $2 AND status IS NULL
+`
+
+type DeleteIdempotencyKeyParams struct {
+	Key   string
+	Token string
+}
+
+func (q *Queries) DeleteIdempotencyKey(ctx context.Context, arg DeleteIdempotencyKeyParams) (int64, error) {
+	result, err := q.exec(ctx, q.deleteIdempotencyKeyStmt, deleteIdempotencyKey, arg.Key, arg.Token)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteExpiredIdempotencyKeys = `-- name: DeleteExpiredIdempotencyKeys :execrows
+DELETE FROM idempotency_keys
+WHERE expires_at < now()
+`
+
+func (q *Queries) DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error) {
+	result, err := q.exec(ctx, q.deleteExpiredIdempotencyKeysStmt, deleteExpiredIdempotencyKeys)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const createIngestJob = `-- name: CreateIngestJob :one
+INSERT INTO ingest_jobs (client_name, roles, kind, path, query, records_total, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, client_name, roles, kind, path, query, status, error, records_total, records_done, records_failed, record_errors, attempts, next_attempt_at, worker, created_at, started_at, heartbeat_at, finished_at, expires_at
+`
+
+type CreateIngestJobParams struct {
+	ClientName   string
+	Roles        []string
+	Kind         string
+	Path         string
+	Query        string
+	RecordsTotal int32
+	ExpiresAt    time.Time
+}
+
+func (q *Queries) CreateIngestJob(ctx context.Context, arg CreateIngestJobParams) (IngestJob, error) {
+	row := q.queryRow(ctx, q.createIngestJobStmt, createIngestJob,
+		arg.ClientName,
+		pq.Array(arg.Roles),
+		arg.Kind,
+		arg.Path,
+		arg.Query,
+		arg.RecordsTotal,
+		arg.ExpiresAt,
+	)
+	var i IngestJob
+	err := row.Scan(
+		&i.ID,
+		&i.ClientName,
+		pq.Array(&i.Roles),
+		&i.Kind,
+		&i.Path,
+		&i.Query,
+		&i.Status,
+		&i.Error,
+		&i.RecordsTotal,
+		&i.RecordsDone,
+		&i.RecordsFailed,
+		&i.RecordErrors,
+		&i.Attempts,
+		&i.NextAttemptAt,
+		&i.Worker,
+		&i.CreatedAt,
+		&i.StartedAt,
+		&i.HeartbeatAt,
+		&i.FinishedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const insertIngestJobPayload = `-- name: InsertIngestJobPayload :exec
+INSERT INTO ingest_job_payloads (job_id, data)
+VALUES ($1, $2)
+`
+
+type InsertIngestJobPayloadParams struct {
+	JobID uuid.UUID
+	Data  []byte
+}
+
+func (q *Queries) InsertIngestJobPayload(ctx context.Context, arg InsertIngestJobPayloadParams) error {
+	_, err := q.exec(ctx, q.insertIngestJobPayloadStmt, insertIngestJobPayload, arg.JobID, arg.Data)
+	return err
+}
+
+const getIngestJobPayload = `-- name: GetIngestJobPayload :one
+SELECT data FROM ingest_job_payloads
+WHERE job_id = $1
+`
+
+func (q *Queries) GetIngestJobPayload(ctx context.Context, jobID uuid.UUID) ([]byte, error) {
+	row := q.queryRow(ctx, q.getIngestJobPayloadStmt, getIngestJobPayload, jobID)
+	var data []byte
+	err := row.Scan(&data)
+	return data, err
+}
+
+const deleteIngestJobPayload = `-- name: DeleteIngestJobPayload :exec
+DELETE FROM ingest_job_payloads
+WHERE job_id = $1
+`
+
+func (q *Queries) DeleteIngestJobPayload(ctx context.Context, jobID uuid.UUID) error {
+	_, err := q.exec(ctx, q.deleteIngestJobPayloadStmt, deleteIngestJobPayload, jobID)
+	return err
+}
+
+const getIngestJob = `-- name: GetIngestJob :one
+SELECT id, client_name, roles, kind, path, query, status, error, records_total, records_done, records_failed, record_errors, attempts, next_attempt_at, worker, created_at, started_at, heartbeat_at, finished_at, expires_at FROM ingest_jobs
+WHERE id = $1 AND client_name = $2
+`
+
+type GetIngestJobParams struct {
+	ID         uuid.UUID
+	ClientName string
+}
+
+func (q *Queries) GetIngestJob(ctx context.Context, arg GetIngestJobParams) (IngestJob, error) {
+	row := q.queryRow(ctx, q.getIngestJobStmt, getIngestJob, arg.ID, arg.ClientName)
+	var i IngestJob
+	err := row.Scan(
+		&i.ID,
+		&i.ClientName,
+		pq.Array(&i.Roles),
+		&i.Kind,
+		&i.Path,
+		&i.Query,
+		&i.Status,
+		&i.Error,
+		&i.RecordsTotal,
+		&i.RecordsDone,
+		&i.RecordsFailed,
+		&i.RecordErrors,
+		&i.Attempts,
+		&i.NextAttemptAt,
+		&i.Worker,
+		&i.CreatedAt,
+		&i.StartedAt,
+		&i.HeartbeatAt,
+		&i.FinishedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const listIngestJobsByClient = `-- name: ListIngestJobsByClient :many
+SELECT id, client_name, roles, kind, path, query, status, error, records_total, records_done, records_failed, record_errors, attempts, next_attempt_at, worker, created_at, started_at, heartbeat_at, finished_at, expires_at FROM ingest_jobs
+WHERE client_name = $1
+  AND ($2::timestamptz IS NULL OR (created_at, id) < ($2::timestamptz, $3::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT $4::int4
+`
+
+type ListIngestJobsByClientParams struct {
+	ClientName string
+	AfterTime  sql.NullTime
+	AfterID    uuid.NullUUID
+	PageLimit  int32
+}
+
+func (q *Queries) ListIngestJobsByClient(ctx context.Context, arg ListIngestJobsByClientParams) ([]IngestJob, error) {
+	rows, err := q.query(ctx, q.listIngestJobsByClientStmt, listIngestJobsByClient,
+		arg.ClientName,
+		arg.AfterTime,
+		arg.AfterID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []IngestJob
+	for rows.Next() {
+		var i IngestJob
+		if err := rows.Scan(
+			&i.ID,
+			&i.ClientName,
+			pq.Array(&i.Roles),
+			&i.Kind,
+			&i.Path,
+			&i.Query,
+			&i.Status,
+			&i.Error,
+			&i.RecordsTotal,
+			&i.RecordsDone,
+			&i.RecordsFailed,
+			&i.RecordErrors,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.Worker,
+			&i.CreatedAt,
+			&i.StartedAt,
+			&i.HeartbeatAt,
+			&i.FinishedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const claimIngestJob = `-- name: ClaimIngestJob :one
+UPDATE ingest_jobs
+SET status = 'running', worker = $1, started_at = now(), heartbeat_at = now(),
+    attempts = attempts + 1
+WHERE id = (
+  SELECT id FROM ingest_jobs
+  WHERE status = 'queued' AND next_attempt_at <= now()
+  ORDER BY next_attempt_at
+  LIMIT 1
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING id, client_name, roles, kind, path, query, status, error, records_total, records_done, records_failed, record_errors, attempts, next_attempt_at, worker, created_at, started_at, heartbeat_at, finished_at, expires_at
+`
+
+func (q *Queries) ClaimIngestJob(ctx context.Context, worker sql.NullString) (IngestJob, error) {
+	row := q.queryRow(ctx, q.claimIngestJobStmt, claimIngestJob, worker)
+	var i IngestJob
+	err := row.Scan(
+		&i.ID,
+		&i.ClientName,
+		pq.Array(&i.Roles),
+		&i.Kind,
+		&i.Path,
+		&i.Query,
+		&i.Status,
+		&i.Error,
+		&i.RecordsTotal,
+		&i.RecordsDone,
+		&i.RecordsFailed,
+		&i.RecordErrors,
+		&i.Attempts,
+		&i.NextAttemptAt,
+		&i.Worker,
+		&i.CreatedAt,
+		&i.StartedAt,
+		&i.HeartbeatAt,
+		&i.FinishedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const getIngestJobClientRoles = `-- name: GetIngestJobClientRoles :one
+SELECT ARRAY(
+  SELECT DISTINCT unnest(cl.role) FROM clients AS cl
+  WHERE cl.client_name = $1
+    AND NOT EXISTS (SELECT 1 FROM revoked_tokens AS r WHERE r.client_token = cl.client_token)
+)::text[] AS roles
+`
+
+func (q *Queries) GetIngestJobClientRoles(ctx context.Context, clientName string) ([]string, error) {
+	row := q.queryRow(ctx, q.getIngestJobClientRolesStmt, getIngestJobClientRoles, clientName)
+	var roles []string
+	err := row.Scan(pq.Array(&roles))
+	return roles, err
+}
+
+const touchIngestJob = `-- name: TouchIngestJob :exec
+UPDATE ingest_jobs
+SET heartbeat_at = now()
+WHERE id = $1 AND status = 'running'
+`
+
+func (q *Queries) TouchIngestJob(ctx context.Context, id uuid.UUID) error {
+	_, err := q.exec(ctx, q.touchIngestJobStmt, touchIngestJob, id)
+	return err
+}
+
+const checkpointIngestJob = `-- name: CheckpointIngestJob :exec
+UPDATE ingest_jobs
+SET heartbeat_at = now(), records_done = $2, records_failed = $3, record_errors = $4
+WHERE id = $1 AND status = 'running'
+`
+
+type CheckpointIngestJobParams struct {
+	ID            uuid.UUID
+	RecordsDone   int32
+	RecordsFailed int32
+	RecordErrors  json.RawMessage
+}
+
+func (q *Queries) CheckpointIngestJob(ctx context.Context, arg CheckpointIngestJobParams) error {
+	_, err := q.exec(ctx, q.checkpointIngestJobStmt, checkpointIngestJob,
+		arg.ID,
+		arg.RecordsDone,
+		arg.RecordsFailed,
+		arg.RecordErrors,
+	)
+	return err
+}
+
+const finishIngestJob = `-- name: FinishIngestJob :exec
+UPDATE ingest_jobs
+SET status = 'succeeded', error = NULL, records_done = $2, records_failed = $3, record_errors = $4,
+    finished_at = now(), expires_at = $5
+WHERE id = $1 AND status = 'running'
+`
+
+type FinishIngestJobParams struct {
+	ID            uuid.UUID
+	RecordsDone   int32
+	RecordsFailed int32
+	RecordErrors  json.RawMessage
+	ExpiresAt     time.Time
+}
+
+func (q *Queries) FinishIngestJob(ctx context.Context, arg FinishIngestJobParams) error {
+	_, err := q.exec(ctx, q.finishIngestJobStmt, finishIngestJob,
+		arg.ID,
+		arg.RecordsDone,
+		arg.RecordsFailed,
+		arg.RecordErrors,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const failIngestJob = `-- name: FailIngestJob :exec
+UPDATE ingest_jobs
+SET status = 'failed', error = $2, finished_at = now(), expires_at = $3
+WHERE id = $1 AND status = 'running'
+`
+
+type FailIngestJobParams struct {
+	ID        uuid.UUID
+	Error     sql.NullString
+	ExpiresAt time.Time
+}
+
+func (q *Queries) FailIngestJob(ctx context.Context, arg FailIngestJobParams) error {
+	_, err := q.exec(ctx, q.failIngestJobStmt, failIngestJob, arg.ID, arg.Error, arg.ExpiresAt)
+	return err
+}
+
+const retryIngestJob = `-- name: RetryIngestJob :exec
+UPDATE ingest_jobs
+SET status = 'queued', error = $2, next_attempt_at = $3,
+    worker = NULL, started_at = NULL, heartbeat_at = NULL
+WHERE id = $1 AND status = 'running'
+`
+
+type RetryIngestJobParams struct {
+	ID            uuid.UUID
+	Error         sql.NullString
+	NextAttemptAt time.Time
+}
+
+func (q *Queries) RetryIngestJob(ctx context.Context, arg RetryIngestJobParams) error {
+	_, err := q.exec(ctx, q.retryIngestJobStmt, retryIngestJob, arg.ID, arg.Error, arg.NextAttemptAt)
+	return err
+}
+
+const requeueIngestJob = `-- name: RequeueIngestJob :exec
+UPDATE ingest_jobs
+SET status = 'queued', attempts = attempts - 1,
+    worker = NULL, started_at = NULL, heartbeat_at = NULL
+WHERE id = $1 AND status = 'running'
+`
+
+func (q *Queries) RequeueIngestJob(ctx context.Context, id uuid.UUID) error {
+	_, err := q.exec(ctx, q.requeueIngestJobStmt, requeueIngestJob, id)
+	return err
+}
+
+const requeueStaleIngestJobs = `-- name: RequeueStaleIngestJobs :execrows
+UPDATE ingest_jobs
+SET status = 'queued', worker = NULL, started_at = NULL, heartbeat_at = NULL
+WHERE status = 'running' AND heartbeat_at < $1
+`
+
+func (q *Queries) RequeueStaleIngestJobs(ctx context.Context, heartbeatAt sql.NullTime) (int64, error) {
+	result, err := q.exec(ctx, q.requeueStaleIngestJobsStmt, requeueStaleIngestJobs, heartbeatAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const resubmitIngestJob = `-- name: ResubmitIngestJob :one
+UPDATE ingest_jobs
+SET status = 'queued', error = NULL, attempts = 0, next_attempt_at = now(),
+    worker = NULL, started_at = NULL, heartbeat_at = NULL, finished_at = NULL, expires_at = $3
+WHERE id = $1 AND client_name = $2 AND status = 'failed'
+RETURNING id, client_name, roles, kind, path, query, status, error, records_total, records_done, records_failed, record_errors, attempts, next_attempt_at, worker, created_at, started_at, heartbeat_at, finished_at, expires_at
+`
+
+type ResubmitIngestJobParams struct {
+	ID         uuid.UUID
+	ClientName string
+	ExpiresAt  time.Time
+}
+
+func (q *Queries) ResubmitIngestJob(ctx context.Context, arg ResubmitIngestJobParams) (IngestJob, error) {
+	row := q.queryRow(ctx, q.resubmitIngestJobStmt, resubmitIngestJob, arg.ID, arg.ClientName, arg.ExpiresAt)
+	var i IngestJob
+	err := row.Scan(
+		&i.ID,
+		&i.ClientName,
+		pq.Array(&i.Roles),
+		&i.Kind,
+		&i.Path,
+		&i.Query,
+		&i.Status,
+		&i.Error,
+		&i.RecordsTotal,
+		&i.RecordsDone,
+		&i.RecordsFailed,
+		&i.RecordErrors,
+		&i.Attempts,
+		&i.NextAttemptAt,
+		&i.Worker,
+		&i.CreatedAt,
+		&i.StartedAt,
+		&i.HeartbeatAt,
+		&i.FinishedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const deleteExpiredIngestJobs = `-- name: DeleteExpiredIngestJobs :execrows
+DELETE FROM ingest_jobs
+WHERE expires_at < now() AND status IN ('succeeded', 'failed')
+`
+
+func (q *Queries) DeleteExpiredIngestJobs(ctx context.Context) (int64, error) {
+	result, err := q.exec(ctx, q.deleteExpiredIngestJobsStmt, deleteExpiredIngestJobs)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const publishOutboxEvent = `-- name: PublishOutboxEvent :one
+WITH tx AS MATERIALIZED (
+  SELECT pg_current_xact_id()
+), event AS (
+  INSERT INTO outbox_events (domain, entity, operation, athlete_ids, records)
+  SELECT $1::text, $2::text, $3::text, $4::text[], $5::int
+  FROM tx
+  RETURNING id, domain, entity, operation, athlete_ids, created_at
+), fanout AS (
+  INSERT INTO webhook_deliveries (subscription_id, event_id)
+  SELECT s.id, event.id
+  FROM webhook_subscriptions s, event
+  WHERE s.active
+    AND event.domain = ANY(s.domains)
+    AND (cardinality(s.entities) = 0 OR event.entity = ANY(s.entities))
+    AND (cardinality(s.operations) = 0 OR event.operation = ANY(s.operations))
+    AND (cardinality(s.athlete_ids) = 0 OR s.athlete_ids && event.athlete_ids)
+  RETURNING id
+)
+SELECT event.id, event.created_at, (SELECT count(*) FROM fanout) AS deliveries
+FROM event
+`
+
+type PublishOutboxEventParams struct {
+	Domain     string
+	Entity     string
+	Operation  string
+	AthleteIds []string
+	Records    int32
+}
+
+type PublishOutboxEventRow struct {
+	ID         int64
+	CreatedAt  time.Time
+	Deliveries int64
+}
+
+func (q *Queries) PublishOutboxEvent(ctx context.Context, arg PublishOutboxEventParams) (PublishOutboxEventRow, error) {
+	row := q.queryRow(ctx, q.publishOutboxEventStmt, publishOutboxEvent,
+		arg.Domain,
+		arg.Entity,
+		arg.Operation,
+		pq.Array(arg.AthleteIds),
+		arg.Records,
+	)
+	var i PublishOutboxEventRow
+	err := row.Scan(&i.ID, &i.CreatedAt, &i.Deliveries)
+	return i, err
+}
+
+const deleteExpiredOutboxEvents = `-- name: DeleteExpiredOutboxEvents :execrows
+DELETE FROM outbox_events
+WHERE created_at < $1
+`
+
+func (q *Queries) DeleteExpiredOutboxEvents(ctx context.Context, createdAt time.Time) (int64, error) {
+	result, err := q.exec(ctx, q.deleteExpiredOutboxEventsStmt, deleteExpiredOutboxEvents, createdAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const createWebhookSubscription = `-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions (client_name, url, secret, domains, entities, operations, athlete_ids, active)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, client_name, url, secret, domains, entities, operations, athlete_ids, active, created_at, updated_at
+`
+
+type CreateWebhookSubscriptionParams struct {
+	ClientName string
+	Url        string
+	Secret     string
+	Domains    []string
+	Entities   []string
+	Operations []string
+	AthleteIds []string
+	Active     bool
+}
+
+func (q *Queries) CreateWebhookSubscription(ctx context.Context, arg CreateWebhookSubscriptionParams) (WebhookSubscription, error) {
+	row := q.queryRow(ctx, q.createWebhookSubscriptionStmt, createWebhookSubscription,
+		arg.ClientName,
+		arg.Url,
+		arg.Secret,
+		pq.Array(arg.Domains),
+		pq.Array(arg.Entities),
+		pq.Array(arg.Operations),
+		pq.Array(arg.AthleteIds),
+		arg.Active,
+	)
+	var i WebhookSubscription
+	err := row.Scan(
+		&i.ID,
+		&i.ClientName,
+		&i.Url,
+		&i.Secret,
+		pq.Array(&i.Domains),
+		pq.Array(&i.Entities),
+		pq.Array(&i.Operations),
+		pq.Array(&i.AthleteIds),
+		&i.Active,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getWebhookSubscription = `-- name: GetWebhookSubscription :one
+SELECT id, client_name, url, secret, domains, entities, operations, athlete_ids, active, created_at, updated_at FROM webhook_subscriptions
+WHERE id = $1 AND client_name = $2
+`
+
+type GetWebhookSubscriptionParams struct {
+	ID         uuid.UUID
+	ClientName string
+}
+
+func (q *Queries) GetWebhookSubscription(ctx context.Context, arg GetWebhookSubscriptionParams) (WebhookSubscription, error) {
+	row := q.queryRow(ctx, q.getWebhookSubscriptionStmt, getWebhookSubscription, arg.ID, arg.ClientName)
+	var i WebhookSubscription
+	err := row.Scan(
+		&i.ID,
+		&i.ClientName,
+		&i.Url,
+		&i.Secret,
+		pq.Array(&i.Domains),
+		pq.Array(&i.Entities),
+		pq.Array(&i.Operations),
+		pq.Array(&i.AthleteIds),
+		&i.Active,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listWebhookSubscriptionsByClient = `-- name: ListWebhookSubscriptionsByClient :many
+SELECT id, client_name, url, secret, domains, entities, operations, athlete_ids, active, created_at, updated_at FROM webhook_subscriptions
+WHERE client_name = $1
+  AND ($2::timestamptz IS NULL OR (created_at, id) < ($2::timestamptz, $3::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT $4::int4
+`
+
+type ListWebhookSubscriptionsByClientParams struct {
+	ClientName string
+	AfterTime  sql.NullTime
+	AfterID    uuid.NullUUID
+	PageLimit  int32
+}
+
+func (q *Queries) ListWebhookSubscriptionsByClient(ctx context.Context, arg ListWebhookSubscriptionsByClientParams) ([]WebhookSubscription, error) {
+	rows, err := q.query(ctx, q.listWebhookSubscriptionsByClientStmt, listWebhookSubscriptionsByClient,
+		arg.ClientName,
+		arg.AfterTime,
+		arg.AfterID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebhookSubscription
+	for rows.Next() {
+		var i WebhookSubscription
+		if err := rows.Scan(
+			&i.ID,
+			&i.ClientName,
+			&i.Url,
+			&i.Secret,
+			pq.Array(&i.Domains),
+			pq.Array(&i.Entities),
+			pq.Array(&i.Operations),
+			pq.Array(&i.AthleteIds),
+			&i.Active,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateWebhookSubscription = `-- name: UpdateWebhookSubscription :one
+UPDATE webhook_subscriptions
+SET url = $3, domains = $4, entities = $5, operations = $6, athlete_ids = $7, active = $8,
+    updated_at = now()
+WHERE id = $1 AND client_name = $2
+RETURNING id, client_name, url, secret, domains, entities, operations, athlete_ids, active, created_at, updated_at
+`
+
+type UpdateWebhookSubscriptionParams struct {
+	ID         uuid.UUID
+	ClientName string
+	Url        string
+	Domains    []string
+	Entities   []string
+	Operations []string
+	AthleteIds []string
+	Active     bool
+}
+
+func (q *Queries) UpdateWebhookSubscription(ctx context.Context, arg UpdateWebhookSubscriptionParams) (WebhookSubscription, error) {
+	row := q.queryRow(ctx, q.updateWebhookSubscriptionStmt, updateWebhookSubscription,
+		arg.ID,
+		arg.ClientName,
+		arg.Url,
+		pq.Array(arg.Domains),
+		pq.Array(arg.Entities),
+		pq.Array(arg.Operations),
+		pq.Array(arg.AthleteIds),
+		arg.Active,
+	)
+	var i WebhookSubscription
+	err := row.Scan(
+		&i.ID,
+		&i.ClientName,
+		&i.Url,
+		&i.Secret,
+		pq.Array(&i.Domains),
+		pq.Array(&i.Entities),
+		pq.Array(&i.Operations),
+		pq.Array(&i.AthleteIds),
+		&i.Active,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const rotateWebhookSecret = `-- name: RotateWebhookSecret :one
+UPDATE webhook_subscriptions
+SET secret = $3, updated_at = now()
+WHERE id = $1 AND client_name = $2
+RETURNING id, client_name, url, secret, domains, entities, operations, athlete_ids, active, created_at, updated_at
+`
+
+type RotateWebhookSecretParams struct {
+	ID         uuid.UUID
+	ClientName string
+	Secret     string
+}
+
+func (q *Queries) RotateWebhookSecret(ctx context.Context, arg RotateWebhookSecretParams) (WebhookSubscription, error) {
+	row := q.queryRow(ctx, q.rotateWebhookSecretStmt, rotateWebhookSecret, arg.ID, arg.ClientName, arg.Secret)
+	var i WebhookSubscription
+	err := row.Scan(
+		&i.ID,
+		&i.ClientName,
+		&i.Url,
+		&i.Secret,
+		pq.Array(&i.Domains),
+		pq.Array(&i.Entities),
+		pq.Array(&i.Operations),
+		pq.Array(&i.AthleteIds),
+		&i.Active,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteWebhookSubscription = `-- name: DeleteWebhookSubscription :execrows
+DELETE FROM webhook_subscriptions
+WHERE id = $1 AND client_name = $2
+`
+
+type DeleteWebhookSubscriptionParams struct {
+	ID         uuid.UUID
+	ClientName string
+}
+
+func (q *Queries) DeleteWebhookSubscription(ctx context.Context, arg DeleteWebhookSubscriptionParams) (int64, error) {
+	result, err := q.exec(ctx, q.deleteWebhookSubscriptionStmt, deleteWebhookSubscription, arg.ID, arg.ClientName)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const claimWebhookDeliveries = `-- name: ClaimWebhookDeliveries :many
+WITH claimed AS (
+  UPDATE webhook_deliveries
+  SET attempts = attempts + 1, next_attempt_at = $1
+  WHERE id IN (
+    SELECT d.id FROM webhook_deliveries d
+    JOIN webhook_subscriptions s ON s.id = d.subscription_id
+    WHERE d.status = 'pending' AND d.next_attempt_at <= now() AND s.active
+    ORDER BY d.next_attempt_at, d.id
+    LIMIT $2::int4
+    FOR UPDATE OF d SKIP LOCKED
+  )
+  RETURNING id, subscription_id, event_id, attempts
+)
+SELECT c.id, c.subscription_id, c.event_id, c.attempts, s.url, s.secret,
+       e.domain, e.entity, e.operation, e.athlete_ids, e.records, e.created_at,
+       s.client_name,
+       ARRAY(
+         SELECT DISTINCT unnest(cl.role) FROM clients cl
+         WHERE cl.client_name = s.client_name
+           AND NOT EXISTS (SELECT 1 FROM revoked_tokens r WHERE r.client_token = cl.client_token)
+       )::text[] AS client_roles
+FROM claimed c
+JOIN webhook_subscriptions s ON s.id = c.subscription_id
+JOIN outbox_events e ON e.id = c.event_id
+ORDER BY c.event_id
+`
+
+type ClaimWebhookDeliveriesParams struct {
+	LeaseUntil time.Time
+	BatchLimit int32
+}
+
+type ClaimWebhookDeliveriesRow struct {
+	ID             int64
+	SubscriptionID uuid.UUID
+	EventID        int64
+	Attempts       int32
+	Url            string
+	Secret         string
+	Domain         string
+	Entity         string
+	Operation      string
+	AthleteIds     []string
+	Records        int32
+	CreatedAt      time.Time
+	ClientName     string
+	ClientRoles    []string
+}
+
+func (q *Queries) ClaimWebhookDeliveries(ctx context.Context, arg ClaimWebhookDeliveriesParams) ([]ClaimWebhookDeliveriesRow, error) {
+	rows, err := q.query(ctx, q.claimWebhookDeliveriesStmt, claimWebhookDeliveries, arg.LeaseUntil, arg.BatchLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClaimWebhookDeliveriesRow
+	for rows.Next() {
+		var i ClaimWebhookDeliveriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.SubscriptionID,
+			&i.EventID,
+			&i.Attempts,
+			&i.Url,
+			&i.Secret,
+			&i.Domain,
+			&i.Entity,
+			&i.Operation,
+			pq.Array(&i.AthleteIds),
+			&i.Records,
+			&i.CreatedAt,
+			&i.ClientName,
+			pq.Array(&i.ClientRoles),
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const succeedWebhookDelivery = `-- name: SucceedWebhookDelivery :exec
+UPDATE webhook_deliveries
+SET status = 'succeeded', response_status = $2, error = NULL, finished_at = now()
+WHERE id = $1 AND status = 'pending'
+`
+
+type SucceedWebhookDeliveryParams struct {
+	ID             int64
+	ResponseStatus sql.NullInt32
+}
+
+func (q *Queries) SucceedWebhookDelivery(ctx context.Context, arg SucceedWebhookDeliveryParams) error {
+	_, err := q.exec(ctx, q.succeedWebhookDeliveryStmt, succeedWebhookDelivery, arg.ID, arg.ResponseStatus)
+	return err
+}
+
+const retryWebhookDelivery = `-- name: RetryWebhookDelivery :exec
+UPDATE webhook_deliveries
+SET response_status = $2, error = $3, next_attempt_at = $4
+WHERE id = $1 AND status = 'pending'
+`
+
+type RetryWebhookDeliveryParams struct {
+	ID             int64
+	ResponseStatus sql.NullInt32
+	Error          sql.NullString
+	NextAttemptAt  time.Time
+}
+
+func (q *Queries) RetryWebhookDelivery(ctx context.Context, arg RetryWebhookDeliveryParams) error {
+	_, err := q.exec(ctx, q.retryWebhookDeliveryStmt, retryWebhookDelivery,
+		arg.ID,
+		arg.ResponseStatus,
+		arg.Error,
+		arg.NextAttemptAt,
+	)
+	return err
+}
+
+const killWebhookDelivery = `-- name: KillWebhookDelivery :exec
+UPDATE webhook_deliveries
+SET status = 'dead', response_status = $2, error = $3, finished_at = now()
+WHERE id = $1 AND status = 'pending'
+`
+
+type KillWebhookDeliveryParams struct {
+	ID             int64
+	ResponseStatus sql.NullInt32
+	Error          sql.NullString
+}
+
+func (q *Queries) KillWebhookDelivery(ctx context.Context, arg KillWebhookDeliveryParams) error {
+	_, err := q.exec(ctx, q.killWebhookDeliveryStmt, killWebhookDelivery, arg.ID, arg.ResponseStatus, arg.Error)
+	return err
+}
+
+const listWebhookDeliveries = `-- name: ListWebhookDeliveries :many
+SELECT d.id, d.event_id, d.status, d.attempts, d.next_attempt_at, d.response_status, d.error,
+       d.created_at, d.finished_at, e.domain, e.entity, e.operation, e.athlete_ids, e.records
+FROM webhook_deliveries d
+JOIN outbox_events e ON e.id = d.event_id
+WHERE d.subscription_id = $1
+  AND ($2::text IS NULL OR d.status = $2::text)
+  AND ($3::bigint IS NULL OR d.id < $3::bigint)
+ORDER BY d.id DESC
+LIMIT $4::int4
+`
+
+type ListWebhookDeliveriesParams struct {
+	SubscriptionID uuid.UUID
+	Status         sql.NullString
+	AfterN         sql.NullInt64
+	PageLimit      int32
+}
+
+type ListWebhookDeliveriesRow struct {
+	ID             int64
+	EventID        int64
+	Status         string
+	Attempts       int32
+	NextAttemptAt  time.Time
+	ResponseStatus sql.NullInt32
+	Error          sql.NullString
+	CreatedAt      time.Time
+	FinishedAt     sql.NullTime
+	Domain         string
+	Entity         string
+	Operation      string
+	AthleteIds     []string
+	Records        int32
+}
+
+func (q *Queries) ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]ListWebhookDeliveriesRow, error) {
+	rows, err := q.query(ctx, q.listWebhookDeliveriesStmt, listWebhookDeliveries,
+		arg.SubscriptionID,
+		arg.Status,
+		arg.AfterN,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListWebhookDeliveriesRow
+	for rows.Next() {
+		var i ListWebhookDeliveriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventID,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.ResponseStatus,
+			&i.Error,
+			&i.CreatedAt,
+			&i.FinishedAt,
+			&i.Domain,
+			&i.Entity,
+			&i.Operation,
+			pq.Array(&i.AthleteIds),
+			&i.Records,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const redeliverWebhookDelivery = `-- name: RedeliverWebhookDelivery :execrows
+UPDATE webhook_deliveries
+SET status = 'pending', attempts = 0, next_attempt_at = now(), finished_at = NULL
+WHERE id = $1 AND subscription_id = $2 AND status = 'dead'
+`
+
+type RedeliverWebhookDeliveryParams struct {
+	ID             int64
+	SubscriptionID uuid.UUID
+}
+
+func (q *Queries) RedeliverWebhookDelivery(ctx context.Context, arg RedeliverWebhookDeliveryParams) (int64, error) {
+	result, err := q.exec(ctx, q.redeliverWebhookDeliveryStmt, redeliverWebhookDelivery, arg.ID, arg.SubscriptionID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const redeliverDeadWebhookDeliveries = `-- name: RedeliverDeadWebhookDeliveries :execrows
+UPDATE webhook_deliveries
+SET status = 'pending', attempts = 0, next_attempt_at = now(), finished_at = NULL
+WHERE subscription_id = $1 AND status = 'dead'
+`
+
+func (q *Queries) RedeliverDeadWebhookDeliveries(ctx context.Context, subscriptionID uuid.UUID) (int64, error) {
+	result, err := q.exec(ctx, q.redeliverDeadWebhookDeliveriesStmt, redeliverDeadWebhookDeliveries, subscriptionID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const listOutboxEventsAfter = `-- name: ListOutboxEventsAfter :many
+SELECT id, domain, entity, operation, athlete_ids, records, created_at FROM outbox_events
+WHERE id > $1
+  AND id <= $2
+ORDER BY id
+LIMIT $3
+`
+
+type ListOutboxEventsAfterParams struct {
+	AfterID    int64
+	ThroughID  int64
+	BatchLimit int32
+}
+
+func (q *Queries) ListOutboxEventsAfter(ctx context.Context, arg ListOutboxEventsAfterParams) ([]OutboxEvent, error) {
+	rows, err := q.query(ctx, q.listOutboxEventsAfterStmt, listOutboxEventsAfter, arg.AfterID, arg.ThroughID, arg.BatchLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []OutboxEvent
+	for rows.Next() {
+		var i OutboxEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.Domain,
+			&i.Entity,
+			&i.Operation,
+			pq.Array(&i.AthleteIds),
+			&i.Records,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOutboxEventBounds = `-- name: GetOutboxEventBounds :one
+SELECT COALESCE(MIN(id), 0)::bigint AS oldest_id, COALESCE(MAX(id), 0)::bigint AS latest_id
+FROM outbox_events
+`
+
+type GetOutboxEventBoundsRow struct {
+	OldestID int64
+	LatestID int64
+}
+
+func (q *Queries) GetOutboxEventBounds(ctx context.Context) (GetOutboxEventBoundsRow, error) {
+	row := q.queryRow(ctx, q.getOutboxEventBoundsStmt, getOutboxEventBounds)
+	var i GetOutboxEventBoundsRow
+	err := row.Scan(&i.OldestID, &i.LatestID)
+	return i, err
+}
+
+const getOutboxHorizon = `-- name: GetOutboxHorizon :one
+SELECT
+  COALESCE((SELECT MAX(id) FROM outbox_events), 0)::bigint AS latest_id,
+  ARRAY(
+    SELECT x::text::bigint
+    FROM unnest(pg_snapshot_xip(pg_current_snapshot())) AS x
+    WHERE (x::text::bigint % 4294967296)::text NOT IN (
+      SELECT backend_xid::text FROM pg_stat_activity
+      WHERE backend_xid IS NOT NULL AND datname <> current_database()
+    )
+  )::bigint[] AS open_xids
+`
+
+type GetOutboxHorizonRow struct {
+	LatestID int64
+	OpenXids []int64
+}
+
+func (q *Queries) GetOutboxHorizon(ctx context.Context) (GetOutboxHorizonRow, error) {
+	row := q.queryRow(ctx, q.getOutboxHorizonStmt, getOutboxHorizon)
+	var i GetOutboxHorizonRow
+	err := row.Scan(&i.LatestID, pq.Array(&i.OpenXids))
+	return i, err
+}
+
+const createCalendarFeed = `-- name: CreateCalendarFeed :one
+INSERT INTO calendar_feeds (client_name, token_hash)
+VALUES ($1, $2)
+RETURNING id, client_name, token_hash, created_at, last_used_at
+`
+
+type CreateCalendarFeedParams struct {
+	ClientName string
+	TokenHash  string
+}
+
+func (q *Queries) CreateCalendarFeed(ctx context.Context, arg CreateCalendarFeedParams) (CalendarFeed, error) {
+	row := q.queryRow(ctx, q.createCalendarFeedStmt, createCalendarFeed, arg.ClientName, arg.TokenHash)
+	var i CalendarFeed
+	err := row.Scan(
+		&i.ID,
+		&i.ClientName,
+		&i.TokenHash,
+		&i.CreatedAt,
+		&i.LastUsedAt,
+	)
+	return i, err
+}
+
+const listCalendarFeedsByClient = `-- name: ListCalendarFeedsByClient :many
+SELECT id, client_name, token_hash, created_at, last_used_at FROM calendar_feeds
+WHERE client_name = $1
+ORDER BY created_at DESC, id DESC
+`
+
+func (q *Queries) ListCalendarFeedsByClient(ctx context.Context, clientName string) ([]CalendarFeed, error) {
+	rows, err := q.query(ctx, q.listCalendarFeedsByClientStmt, listCalendarFeedsByClient, clientName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CalendarFeed
+	for rows.Next() {
+		var i CalendarFeed
+		if err := rows.Scan(
+			&i.ID,
+			&i.ClientName,
+			&i.TokenHash,
+			&i.CreatedAt,
+			&i.LastUsedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteCalendarFeed = `-- name: DeleteCalendarFeed :execrows
+DELETE FROM calendar_feeds
+WHERE id = $1 AND client_name = $2
+`
+
+type DeleteCalendarFeedParams struct {
+	ID         uuid.UUID
+	ClientName string
+}
+
+func (q *Queries) DeleteCalendarFeed(ctx context.Context, arg DeleteCalendarFeedParams) (int64, error) {
+	result, err := q.exec(ctx, q.deleteCalendarFeedStmt, deleteCalendarFeed, arg.ID, arg.ClientName)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const useCalendarFeed = `-- name: UseCalendarFeed :one
+UPDATE calendar_feeds AS f
+SET last_used_at = now()
+FROM clients AS c
+WHERE f.token_hash = $1
+  AND c.client_name = f.client_name
+  AND NOT EXISTS (SELECT 1 FROM revoked_tokens AS r WHERE r.client_token = c.client_token)
+RETURNING f.client_name, c.role
+`
+
+type UseCalendarFeedRow struct {
+	ClientName string
+	Role       []string
+}
+
+func (q *Queries) UseCalendarFeed(ctx context.Context, tokenHash string) (UseCalendarFeedRow, error) {
+	row := q.queryRow(ctx, q.useCalendarFeedStmt, useCalendarFeed, tokenHash)
+	var i UseCalendarFeedRow
+	err := row.Scan(&i.ClientName, pq.Array(&i.Role))
+	return i, err
+}
+
+const disableWebhookSubscription = `-- name: DisableWebhookSubscription :exec
+UPDATE webhook_subscriptions
+SET active = false, updated_at = now()
+WHERE id = $1
+`
+
+func (q *Queries) DisableWebhookSubscription(ctx context.Context, id uuid.UUID) error {
+	_, err := q.exec(ctx, q.disableWebhookSubscriptionStmt, disableWebhookSubscription, id)
+	return err
+}
@@ -160,3 +160,375 @@ WHERE action = $1
 ORDER BY created_at DESC;
 
 
+-- name: CreateExportJob :one
+INSERT INTO export_jobs (client_name, kind, format, params, chunk_size, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetExportJob :one
+SELECT * FROM export_jobs
+WHERE id = $1 AND client_name = $2;
+
+-- name: ListExportJobsByClient :many
+SELECT * FROM export_jobs
+WHERE client_name = @client_name
+  AND (sqlc.narg(after_time)::timestamptz IS NULL OR (created_at, id) < (sqlc.narg(after_time)::timestamptz, sqlc.narg(after_id)::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT @page_limit::int4;
+
+-- name: ClaimExportJob :one
+UPDATE export_jobs
+SET status = 'running', worker = $1, started_at = now(), heartbeat_at = now(),
+    rows_written = 0, size_bytes = 0
+WHERE id = (
+  SELECT id FROM export_jobs
+  WHERE status = 'queued'
+  ORDER BY created_at
+  LIMIT 1
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: TouchExportJob :one
+UPDATE export_jobs
+SET heartbeat_at = now(), rows_written = $2, size_bytes = $3
+WHERE id = $1
+RETURNING status;
+
+-- name: FinishExportJob :execrows
+UPDATE export_jobs
+SET status = 'succeeded', rows_written = $2, size_bytes = $3, finished_at = now(), expires_at = $4
+WHERE id = $1 AND status = 'running';
+
+-- name: FailExportJob :exec
+UPDATE export_jobs
+SET status = 'failed', error = $2, finished_at = now(), expires_at = $3
+WHERE id = $1 AND status = 'running';
+
+-- name: CancelExportJob :one
+UPDATE export_jobs
+SET status = 'canceled', finished_at = now(), expires_at = $3
+WHERE id = $1 AND client_name = $2 AND status IN ('queued', 'running')
+RETURNING *;
+
+-- name: RequeueExportJob :exec
+UPDATE export_jobs
+SET status = 'queued', worker = NULL, started_at = NULL, heartbeat_at = NULL
+WHERE id = $1 AND status = 'running';
+
+-- name: RequeueStaleExportJobs :execrows
+UPDATE export_jobs
+SET status = 'queued', worker = NULL, started_at = NULL, heartbeat_at = NULL
+WHERE status = 'running' AND heartbeat_at < $1;
+
+-- name: DeleteExpiredExportJobs :execrows
+DELETE FROM export_jobs
+WHERE expires_at < now();
+
+-- name: InsertExportJobChunk :exec
+INSERT INTO export_job_chunks (job_id, seq, data)
+VALUES ($1, $2, $3);
+
+-- name: GetExportJobChunk :one
+SELECT data FROM export_job_chunks
+WHERE job_id = $1 AND seq = $2;
+
+-- name: DeleteExportJobChunks :exec
+DELETE FROM export_job_chunks
+WHERE job_id = $1;
+
+-- name: ReserveIdempotencyKey :execrows
+INSERT INTO idempotency_keys (key, fingerprint, token, expires_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (key) DO UPDATE
+SET fingerprint = EXCLUDED.fingerprint, token = EXCLUDED.token, status = NULL, headers = NULL, body = NULL,
+    created_at = now(), expires_at = EXCLUDED.expires_at
+WHERE idempotency_keys.expires_at < now();
+
+-- name: GetIdempotencyKey :one
+SELECT * FROM idempotency_keys
+WHERE key = $1;
+
+-- name: CompleteIdempotencyKey :execrows
+UPDATE idempotency_keys
+SET status = $3, headers = $4, body = $5, expires_at = $6
+WHERE key = $1 AND token = $2 AND status IS NULL;
+
+-- name: DeleteIdempotencyKey :execrows
+DELETE FROM idempotency_keys
+WHERE key = $1 AND token = $2 AND status IS NULL;
+
+-- name: DeleteExpiredIdempotencyKeys :execrows
+DELETE FROM idempotency_keys
+WHERE expires_at < now();
+
+-- name: CreateIngestJob :one
+INSERT INTO ingest_jobs (client_name, roles, kind, path, query, records_total, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: InsertIngestJobPayload :exec
+INSERT INTO ingest_job_payloads (job_id, data)
+VALUES ($1, $2);
+
+-- name: GetIngestJobPayload :one
+SELECT data FROM ingest_job_payloads
+WHERE job_id = $1;
+
+-- name: DeleteIngestJobPayload :exec
+DELETE FROM ingest_job_payloads
+WHERE job_id = $1;
+
+-- name: GetIngestJob :one
+SELECT * FROM ingest_jobs
+WHERE id = $1 AND client_name = $2;
+
+-- name: ListIngestJobsByClient :many
+SELECT * FROM ingest_jobs
+WHERE client_name = @client_name
+  AND (sqlc.narg(after_time)::timestamptz IS NULL OR (created_at, id) < (sqlc.narg(after_time)::timestamptz, sqlc.narg(after_id)::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT @page_limit::int4;
+
+-- name: ClaimIngestJob :one
+UPDATE ingest_jobs
+SET status = 'running', worker = $1, started_at = now(), heartbeat_at = now(),
+    attempts = attempts + 1
+WHERE id = (
+  SELECT id FROM ingest_jobs
+  WHERE status = 'queued' AND next_attempt_at <= now()
+  ORDER BY next_attempt_at
+  LIMIT 1
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: GetIngestJobClientRoles :one
+SELECT ARRAY(
+  SELECT DISTINCT unnest(cl.role) FROM clients AS cl
+  WHERE cl.client_name = $1
+    AND NOT EXISTS (SELECT 1 FROM revoked_tokens AS r WHERE r.client_token = cl.client_token)
+)::text[] AS roles;
+
+-- name: TouchIngestJob :exec
+UPDATE ingest_jobs
+SET heartbeat_at = now()
+WHERE id = $1 AND status = 'running';
+
+-- name: CheckpointIngestJob :exec
+UPDATE ingest_jobs
+SET heartbeat_at = now(), records_done = $2, records_failed = $3, record_errors = $4
+WHERE id = $1 AND status = 'running';
+
+-- name: FinishIngestJob :exec
+UPDATE ingest_jobs
+SET status = 'succeeded', error = NULL, records_done = $2, records_failed = $3, record_errors = $4,
+    finished_at = now(), expires_at = $5
+WHERE id = $1 AND status = 'running';
+
+-- name: FailIngestJob :exec
+UPDATE ingest_jobs
+SET status = 'failed', error = $2, finished_at = now(), expires_at = $3
+WHERE id = $1 AND status = 'running';
+
+-- name: RetryIngestJob :exec
+UPDATE ingest_jobs
+SET status = 'queued', error = $2, next_attempt_at = $3,
+    worker = NULL, started_at = NULL, heartbeat_at = NULL
+WHERE id = $1 AND status = 'running';
+
+-- name: RequeueIngestJob :exec
+UPDATE ingest_jobs
+SET status = 'queued', attempts = attempts - 1,
+    worker = NULL, started_at = NULL, heartbeat_at = NULL
+WHERE id = $1 AND status = 'running';
+
+-- name: RequeueStaleIngestJobs :execrows
+UPDATE ingest_jobs
+SET status = 'queued', worker = NULL, started_at = NULL, heartbeat_at = NULL
+WHERE status = 'running' AND heartbeat_at < $1;
+
+-- name: ResubmitIngestJob :one
+UPDATE ingest_jobs
+SET status = 'queued', error = NULL, attempts = 0, next_attempt_at = now(),
+    worker = NULL, started_at = NULL, heartbeat_at = NULL, finished_at = NULL, expires_at = $3
+WHERE id = $1 AND client_name = $2 AND status = 'failed'
+RETURNING *;
+
+-- name: DeleteExpiredIngestJobs :execrows
+DELETE FROM ingest_jobs
+WHERE expires_at < now() AND status IN ('succeeded', 'failed');
+
+-- name: PublishOutboxEvent :one
+WITH tx AS MATERIALIZED (
+  SELECT pg_current_xact_id()
+), event AS (
+  INSERT INTO outbox_events (domain, entity, operation, athlete_ids, records)
+  SELECT $1::text, $2::text, $3::text, $4::text[], $5::int
+  FROM tx
+  RETURNING id, domain, entity, operation, athlete_ids, created_at
+), fanout AS (
+  INSERT INTO webhook_deliveries (subscription_id, event_id)
+  SELECT s.id, event.id
+  FROM webhook_subscriptions s, event
+  WHERE s.active
+    AND event.domain = ANY(s.domains)
+    AND (cardinality(s.entities) = 0 OR event.entity = ANY(s.entities))
+    AND (cardinality(s.operations) = 0 OR event.operation = ANY(s.operations))
+    AND (cardinality(s.athlete_ids) = 0 OR s.athlete_ids && event.athlete_ids)
+  RETURNING id
+)
+SELECT event.id, event.created_at, (SELECT count(*) FROM fanout) AS deliveries
+FROM event;
+
+-- name: DeleteExpiredOutboxEvents :execrows
+DELETE FROM outbox_events
+WHERE created_at < $1;
+
+-- name: ListOutboxEventsAfter :many
+SELECT * FROM outbox_events
+WHERE id > sqlc.arg(after_id)
+  AND id <= sqlc.arg(through_id)
+ORDER BY id
+LIMIT sqlc.arg(batch_limit);
+
+-- name: GetOutboxEventBounds :one
+SELECT COALESCE(MIN(id), 0)::bigint AS oldest_id, COALESCE(MAX(id), 0)::bigint AS latest_id
+FROM outbox_events;
+
+-- name: GetOutboxHorizon :one
+SELECT
+  COALESCE((SELECT MAX(id) FROM outbox_events), 0)::bigint AS latest_id,
+  ARRAY(
+    SELECT x::text::bigint
+    FROM unnest(pg_snapshot_xip(pg_current_snapshot())) AS x
+    WHERE (x::text::bigint % 4294967296)::text NOT IN (
+      SELECT backend_xid::text FROM pg_stat_activity
+      WHERE backend_xid IS NOT NULL AND datname <> current_database()
+    )
+  )::bigint[] AS open_xids;
+
+-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions (client_name, url, secret, domains, entities, operations, athlete_ids, active)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
+
+-- name: GetWebhookSubscription :one
+SELECT * FROM webhook_subscriptions
+WHERE id = $1 AND client_name = $2;
+
+-- name: ListWebhookSubscriptionsByClient :many
+SELECT * FROM webhook_subscriptions
+WHERE client_name = @client_name
+  AND (sqlc.narg(after_time)::timestamptz IS NULL OR (created_at, id) < (sqlc.narg(after_time)::timestamptz, sqlc.narg(after_id)::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT @page_limit::int4;
+
+-- name: UpdateWebhookSubscription :one
+UPDATE webhook_subscriptions
+SET url = $3, domains = $4, entities = $5, operations = $6, athlete_ids = $7, active = $8,
+    updated_at = now()
+WHERE id = $1 AND client_name = $2
+RETURNING *;
+
+-- name: RotateWebhookSecret :one
+UPDATE webhook_subscriptions
+SET secret = $3, updated_at = now()
+WHERE id = $1 AND client_name = $2
+RETURNING *;
+
+-- name: DeleteWebhookSubscription :execrows
+DELETE FROM webhook_subscriptions
+WHERE id = $1 AND client_name = $2;
+
+-- name: DisableWebhookSubscription :exec
+UPDATE webhook_subscriptions
+SET active = false, updated_at = now()
+WHERE id = $1;
+
+-- name: ClaimWebhookDeliveries :many
+WITH claimed AS (
+  UPDATE webhook_deliveries
+  SET attempts = attempts + 1, next_attempt_at = @lease_until
+  WHERE id IN (
+    SELECT d.id FROM webhook_deliveries d
+    JOIN webhook_subscriptions s ON s.id = d.subscription_id
+    WHERE d.status = 'pending' AND d.next_attempt_at <= now() AND s.active
+    ORDER BY d.next_attempt_at, d.id
+    LIMIT @batch_limit::int4
+    FOR UPDATE OF d SKIP LOCKED
+  )
+  RETURNING id, subscription_id, event_id, attempts
+)
+SELECT c.id, c.subscription_id, c.event_id, c.attempts, s.url, s.secret,
+       e.domain, e.entity, e.operation, e.athlete_ids, e.records, e.created_at,
+       s.client_name,
+       ARRAY(
+         SELECT DISTINCT unnest(cl.role) FROM clients cl
+         WHERE cl.client_name = s.client_name
+           AND NOT EXISTS (SELECT 1 FROM revoked_tokens r WHERE r.client_token = cl.client_token)
+       )::text[] AS client_roles
+FROM claimed c
+JOIN webhook_subscriptions s ON s.id = c.subscription_id
+JOIN outbox_events e ON e.id = c.event_id
+ORDER BY c.event_id;
+
+-- name: SucceedWebhookDelivery :exec
+UPDATE webhook_deliveries
+SET status = 'succeeded', response_status = $2, error = NULL, finished_at = now()
+WHERE id = $1 AND status = 'pending';
+
+-- name: RetryWebhookDelivery :exec
+UPDATE webhook_deliveries
+SET response_status = $2, error = $3, next_attempt_at = $4
+WHERE id = $1 AND status = 'pending';
+
+-- name: KillWebhookDelivery :exec
+UPDATE webhook_deliveries
+SET status = 'dead', response_status = $2, error = $3, finished_at = now()
+WHERE id = $1 AND status = 'pending';
+
+-- name: ListWebhookDeliveries :many
+SELECT d.id, d.event_id, d.status, d.attempts, d.next_attempt_at, d.response_status, d.error,
+       d.created_at, d.finished_at, e.domain, e.entity, e.operation, e.athlete_ids, e.records
+FROM webhook_deliveries d
+JOIN outbox_events e ON e.id = d.event_id
+WHERE d.subscription_id = @subscription_id
+  AND (sqlc.narg(status)::text IS NULL OR d.status = sqlc.narg(status)::text)
+  AND (sqlc.narg(after_n)::bigint IS NULL OR d.id < sqlc.narg(after_n)::bigint)
+ORDER BY d.id DESC
+LIMIT @page_limit::int4;
+
+-- name: RedeliverWebhookDelivery :execrows
+UPDATE webhook_deliveries
+SET status = 'pending', attempts = 0, next_attempt_at = now(), finished_at = NULL
+WHERE id = $1 AND subscription_id = $2 AND status = 'dead';
+
+-- name: RedeliverDeadWebhookDeliveries :execrows
+UPDATE webhook_deliveries
+SET status = 'pending', attempts = 0, next_attempt_at = now(), finished_at = NULL
+WHERE subscription_id = $1 AND status = 'dead';
+
+
+-- name: CreateCalendarFeed :one
+INSERT INTO calendar_feeds (client_name, token_hash)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: ListCalendarFeedsByClient :many
+SELECT * FROM calendar_feeds
+WHERE client_name = $1
+ORDER BY created_at DESC, id DESC;
+
+-- name: DeleteCalendarFeed :execrows
+DELETE FROM calendar_feeds
+WHERE id = $1 AND client_name = $2;
+
+-- name: UseCalendarFeed :one
+UPDATE calendar_feeds AS f
+SET last_used_at = now()
+FROM clients AS c
+WHERE f.token_hash = $1
+  AND c.client_name = f.client_name
+  AND NOT EXISTS (SELECT 1 FROM revoked_tokens AS r WHERE r.client_token = c.client_token)
+RETURNING f.client_name, c.role;
@@ -30,6 +30,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.deleteCompetitorByIDStmt, err = db.PrepareContext(ctx, deleteCompetitorByID); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteCompetitorByID: %w", err)
 	}
+	if q.deleteLinkedAthleteLinkCandidatesStmt, err = db.PrepareContext(ctx, deleteLinkedAthleteLinkCandidates); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteLinkedAthleteLinkCandidates: %w", err)
+	}
 	if q.deleteRaceCCByIDStmt, err = db.PrepareContext(ctx, deleteRaceCCByID); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteRaceCCByID: %w", err)
 	}
@@ -48,6 +51,21 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.deleteResultNKByRecIDStmt, err = db.PrepareContext(ctx, deleteResultNKByRecID); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteResultNKByRecID: %w", err)
 	}
+	if q.deleteStaleAthleteLinkCandidatesStmt, err = db.PrepareContext(ctx, deleteStaleAthleteLinkCandidates); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteStaleAthleteLinkCandidates: %w", err)
+	}
+	if q.exportResultsCCStmt, err = db.PrepareContext(ctx, exportResultsCC); err != nil {
+		return nil, fmt.Errorf("error preparing query ExportResultsCC: %w", err)
+	}
+	if q.exportResultsJPStmt, err = db.PrepareContext(ctx, exportResultsJP); err != nil {
+		return nil, fmt.Errorf("error preparing query ExportResultsJP: %w", err)
+	}
+	if q.exportResultsNKStmt, err = db.PrepareContext(ctx, exportResultsNK); err != nil {
+		return nil, fmt.Errorf("error preparing query ExportResultsNK: %w", err)
+	}
+	if q.getAthleteLinkCandidateStmt, err = db.PrepareContext(ctx, getAthleteLinkCandidate); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAthleteLinkCandidate: %w", err)
+	}
 	if q.getAthleteResultsCCStmt, err = db.PrepareContext(ctx, getAthleteResultsCC); err != nil {
 		return nil, fmt.Errorf("error preparing query GetAthleteResultsCC: %w", err)
 	}
@@ -63,6 +81,21 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getAthletesBySporttiIDStmt, err = db.PrepareContext(ctx, getAthletesBySporttiID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetAthletesBySporttiID: %w", err)
 	}
+	if q.getCalendarRacesStmt, err = db.PrepareContext(ctx, getCalendarRaces); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCalendarRaces: %w", err)
+	}
+	if q.getChangesHorizonStmt, err = db.PrepareContext(ctx, getChangesHorizon); err != nil {
+		return nil, fmt.Errorf("error preparing query GetChangesHorizon: %w", err)
+	}
+	if q.getCompetitorBestResultsCCStmt, err = db.PrepareContext(ctx, getCompetitorBestResultsCC); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCompetitorBestResultsCC: %w", err)
+	}
+	if q.getCompetitorBestResultsJPStmt, err = db.PrepareContext(ctx, getCompetitorBestResultsJP); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCompetitorBestResultsJP: %w", err)
+	}
+	if q.getCompetitorBestResultsNKStmt, err = db.PrepareContext(ctx, getCompetitorBestResultsNK); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCompetitorBestResultsNK: %w", err)
+	}
 	if q.getCompetitorCountsByNationStmt, err = db.PrepareContext(ctx, getCompetitorCountsByNation); err != nil {
 		return nil, fmt.Errorf("error preparing query GetCompetitorCountsByNation: %w", err)
 	}
@@ -75,6 +108,15 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getCompetitorIDByFiscodeNKStmt, err = db.PrepareContext(ctx, getCompetitorIDByFiscodeNK); err != nil {
 		return nil, fmt.Errorf("error preparing query GetCompetitorIDByFiscodeNK: %w", err)
 	}
+	if q.getCompetitorSeasonStatsCCStmt, err = db.PrepareContext(ctx, getCompetitorSeasonStatsCC); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCompetitorSeasonStatsCC: %w", err)
+	}
+	if q.getCompetitorSeasonStatsJPStmt, err = db.PrepareContext(ctx, getCompetitorSeasonStatsJP); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCompetitorSeasonStatsJP: %w", err)
+	}
+	if q.getCompetitorSeasonStatsNKStmt, err = db.PrepareContext(ctx, getCompetitorSeasonStatsNK); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCompetitorSeasonStatsNK: %w", err)
+	}
 	if q.getCrossCountryCategoriesStmt, err = db.PrepareContext(ctx, getCrossCountryCategories); err != nil {
 		return nil, fmt.Errorf("error preparing query GetCrossCountryCategories: %w", err)
 	}
@@ -84,6 +126,30 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getCrossCountrySeasonsStmt, err = db.PrepareContext(ctx, getCrossCountrySeasons); err != nil {
 		return nil, fmt.Errorf("error preparing query GetCrossCountrySeasons: %w", err)
 	}
+	if q.getEnrichedRaceResultsCCStmt, err = db.PrepareContext(ctx, getEnrichedRaceResultsCC); err != nil {
+		return nil, fmt.Errorf("error preparing query GetEnrichedRaceResultsCC: %w", err)
+	}
+	if q.getEnrichedRaceResultsJPStmt, err = db.PrepareContext(ctx, getEnrichedRaceResultsJP); err != nil {
+		return nil, fmt.Errorf("error preparing query GetEnrichedRaceResultsJP: %w", err)
+	}
+	if q.getEnrichedRaceResultsNKStmt, err = db.PrepareContext(ctx, getEnrichedRaceResultsNK); err != nil {
+		return nil, fmt.Errorf("error preparing query GetEnrichedRaceResultsNK: %w", err)
+	}
+	if q.getFISPointsListCCStmt, err = db.PrepareContext(ctx, getFISPointsListCC); err != nil {
+		return nil, fmt.Errorf("error preparing query GetFISPointsListCC: %w", err)
+	}
+	if q.getFISPointsListNKStmt, err = db.PrepareContext(ctx, getFISPointsListNK); err != nil {
+		return nil, fmt.Errorf("error preparing query GetFISPointsListNK: %w", err)
+	}
+	if q.getHeadToHeadCCStmt, err = db.PrepareContext(ctx, getHeadToHeadCC); err != nil {
+		return nil, fmt.Errorf("error preparing query GetHeadToHeadCC: %w", err)
+	}
+	if q.getHeadToHeadJPStmt, err = db.PrepareContext(ctx, getHeadToHeadJP); err != nil {
+		return nil, fmt.Errorf("error preparing query GetHeadToHeadJP: %w", err)
+	}
+	if q.getHeadToHeadNKStmt, err = db.PrepareContext(ctx, getHeadToHeadNK); err != nil {
+		return nil, fmt.Errorf("error preparing query GetHeadToHeadNK: %w", err)
+	}
 	if q.getLastRowCompetitorStmt, err = db.PrepareContext(ctx, getLastRowCompetitor); err != nil {
 		return nil, fmt.Errorf("error preparing query GetLastRowCompetitor: %w", err)
 	}
@@ -204,6 +270,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.insertAthleteStmt, err = db.PrepareContext(ctx, insertAthlete); err != nil {
 		return nil, fmt.Errorf("error preparing query InsertAthlete: %w", err)
 	}
+	if q.insertAthleteFromCompetitorStmt, err = db.PrepareContext(ctx, insertAthleteFromCompetitor); err != nil {
+		return nil, fmt.Errorf("error preparing query InsertAthleteFromCompetitor: %w", err)
+	}
 	if q.insertCompetitorStmt, err = db.PrepareContext(ctx, insertCompetitor); err != nil {
 		return nil, fmt.Errorf("error preparing query InsertCompetitor: %w", err)
 	}
@@ -225,6 +294,45 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.insertResultNKStmt, err = db.PrepareContext(ctx, insertResultNK); err != nil {
 		return nil, fmt.Errorf("error preparing query InsertResultNK: %w", err)
 	}
+	if q.listAthleteLinkCandidatesStmt, err = db.PrepareContext(ctx, listAthleteLinkCandidates); err != nil {
+		return nil, fmt.Errorf("error preparing query ListAthleteLinkCandidates: %w", err)
+	}
+	if q.listCompetitorsChangesStmt, err = db.PrepareContext(ctx, listCompetitorsChanges); err != nil {
+		return nil, fmt.Errorf("error preparing query ListCompetitorsChanges: %w", err)
+	}
+	if q.listLinkedSporttiIDsStmt, err = db.PrepareContext(ctx, listLinkedSporttiIDs); err != nil {
+		return nil, fmt.Errorf("error preparing query ListLinkedSporttiIDs: %w", err)
+	}
+	if q.listRacesCCChangesStmt, err = db.PrepareContext(ctx, listRacesCCChanges); err != nil {
+		return nil, fmt.Errorf("error preparing query ListRacesCCChanges: %w", err)
+	}
+	if q.listRacesJPChangesStmt, err = db.PrepareContext(ctx, listRacesJPChanges); err != nil {
+		return nil, fmt.Errorf("error preparing query ListRacesJPChanges: %w", err)
+	}
+	if q.listRacesNKChangesStmt, err = db.PrepareContext(ctx, listRacesNKChanges); err != nil {
+		return nil, fmt.Errorf("error preparing query ListRacesNKChanges: %w", err)
+	}
+	if q.listResultsCCChangesStmt, err = db.PrepareContext(ctx, listResultsCCChanges); err != nil {
+		return nil, fmt.Errorf("error preparing query ListResultsCCChanges: %w", err)
+	}
+	if q.listResultsJPChangesStmt, err = db.PrepareContext(ctx, listResultsJPChanges); err != nil {
+		return nil, fmt.Errorf("error preparing query ListResultsJPChanges: %w", err)
+	}
+	if q.listResultsNKChangesStmt, err = db.PrepareContext(ctx, listResultsNKChanges); err != nil {
+		return nil, fmt.Errorf("error preparing query ListResultsNKChanges: %w", err)
+	}
+	if q.listTombstonesStmt, err = db.PrepareContext(ctx, listTombstones); err != nil {
+		return nil, fmt.Errorf("error preparing query ListTombstones: %w", err)
+	}
+	if q.listUnlinkedFinnishCompetitorsStmt, err = db.PrepareContext(ctx, listUnlinkedFinnishCompetitors); err != nil {
+		return nil, fmt.Errorf("error preparing query ListUnlinkedFinnishCompetitors: %w", err)
+	}
+	if q.rejectCompetingAthleteLinkCandidatesStmt, err = db.PrepareContext(ctx, rejectCompetingAthleteLinkCandidates); err != nil {
+		return nil, fmt.Errorf("error preparing query RejectCompetingAthleteLinkCandidates: %w", err)
+	}
+	if q.reviewAthleteLinkCandidateStmt, err = db.PrepareContext(ctx, reviewAthleteLinkCandidate); err != nil {
+		return nil, fmt.Errorf("error preparing query ReviewAthleteLinkCandidate: %w", err)
+	}
 	if q.searchCompetitorsStmt, err = db.PrepareContext(ctx, searchCompetitors); err != nil {
 		return nil, fmt.Errorf("error preparing query SearchCompetitors: %w", err)
 	}
@@ -261,6 +369,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.updateResultNKByRecIDStmt, err = db.PrepareContext(ctx, updateResultNKByRecID); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateResultNKByRecID: %w", err)
 	}
+	if q.upsertAthleteLinkCandidateStmt, err = db.PrepareContext(ctx, upsertAthleteLinkCandidate); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertAthleteLinkCandidate: %w", err)
+	}
 	return &q, nil
 }
 
@@ -276,6 +387,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deleteCompetitorByIDStmt: %w", cerr)
 		}
 	}
+	if q.deleteLinkedAthleteLinkCandidatesStmt != nil {
+		if cerr := q.deleteLinkedAthleteLinkCandidatesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteLinkedAthleteLinkCandidatesStmt: %w", cerr)
+		}
+	}
 	if q.deleteRaceCCByIDStmt != nil {
 		if cerr := q.deleteRaceCCByIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteRaceCCByIDStmt: %w", cerr)
@@ -306,6 +422,31 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deleteResultNKByRecIDStmt: %w", cerr)
 		}
 	}
+	if q.deleteStaleAthleteLinkCandidatesStmt != nil {
+		if cerr := q.deleteStaleAthleteLinkCandidatesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteStaleAthleteLinkCandidatesStmt: %w", cerr)
+		}
+	}
+	if q.exportResultsCCStmt != nil {
+		if cerr := q.exportResultsCCStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing exportResultsCCStmt: %w", cerr)
+		}
+	}
+	if q.exportResultsJPStmt != nil {
+		if cerr := q.exportResultsJPStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing exportResultsJPStmt: %w", cerr)
+		}
+	}
+	if q.exportResultsNKStmt != nil {
+		if cerr := q.exportResultsNKStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing exportResultsNKStmt: %w", cerr)
+		}
+	}
+	if q.getAthleteLinkCandidateStmt != nil {
+		if cerr := q.getAthleteLinkCandidateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAthleteLinkCandidateStmt: %w", cerr)
+		}
+	}
 	if q.getAthleteResultsCCStmt != nil {
 		if cerr := q.getAthleteResultsCCStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getAthleteResultsCCStmt: %w", cerr)
@@ -331,6 +472,31 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getAthletesBySporttiIDStmt: %w", cerr)
 		}
 	}
+	if q.getCalendarRacesStmt != nil {
+		if cerr := q.getCalendarRacesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCalendarRacesStmt: %w", cerr)
+		}
+	}
+	if q.getChangesHorizonStmt != nil {
+		if cerr := q.getChangesHorizonStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getChangesHorizonStmt: %w", cerr)
+		}
+	}
+	if q.getCompetitorBestResultsCCStmt != nil {
+		if cerr := q.getCompetitorBestResultsCCStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCompetitorBestResultsCCStmt: %w", cerr)
+		}
+	}
+	if q.getCompetitorBestResultsJPStmt != nil {
+		if cerr := q.getCompetitorBestResultsJPStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCompetitorBestResultsJPStmt: %w", cerr)
+		}
+	}
+	if q.getCompetitorBestResultsNKStmt != nil {
+		if cerr := q.getCompetitorBestResultsNKStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCompetitorBestResultsNKStmt: %w", cerr)
+		}
+	}
 	if q.getCompetitorCountsByNationStmt != nil {
 		if cerr := q.getCompetitorCountsByNationStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getCompetitorCountsByNationStmt: %w", cerr)
@@ -351,6 +517,21 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getCompetitorIDByFiscodeNKStmt: %w", cerr)
 		}
 	}
+	if q.getCompetitorSeasonStatsCCStmt != nil {
+		if cerr := q.getCompetitorSeasonStatsCCStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCompetitorSeasonStatsCCStmt: %w", cerr)
+		}
+	}
+	if q.getCompetitorSeasonStatsJPStmt != nil {
+		if cerr := q.getCompetitorSeasonStatsJPStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCompetitorSeasonStatsJPStmt: %w", cerr)
+		}
+	}
+	if q.getCompetitorSeasonStatsNKStmt != nil {
+		if cerr := q.getCompetitorSeasonStatsNKStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCompetitorSeasonStatsNKStmt: %w", cerr)
+		}
+	}
 	if q.getCrossCountryCategoriesStmt != nil {
 		if cerr := q.getCrossCountryCategoriesStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getCrossCountryCategoriesStmt: %w", cerr)
@@ -366,6 +547,46 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getCrossCountrySeasonsStmt: %w", cerr)
 		}
 	}
+	if q.getEnrichedRaceResultsCCStmt != nil {
+		if cerr := q.getEnrichedRaceResultsCCStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getEnrichedRaceResultsCCStmt: %w", cerr)
+		}
+	}
+	if q.getEnrichedRaceResultsJPStmt != nil {
+		if cerr := q.getEnrichedRaceResultsJPStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getEnrichedRaceResultsJPStmt: %w", cerr)
+		}
+	}
+	if q.getEnrichedRaceResultsNKStmt != nil {
+		if cerr := q.getEnrichedRaceResultsNKStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getEnrichedRaceResultsNKStmt: %w", cerr)
+		}
+	}
+	if q.getFISPointsListCCStmt != nil {
+		if cerr := q.getFISPointsListCCStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getFISPointsListCCStmt: %w", cerr)
+		}
+	}
+	if q.getFISPointsListNKStmt != nil {
+		if cerr := q.getFISPointsListNKStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getFISPointsListNKStmt: %w", cerr)
+		}
+	}
+	if q.getHeadToHeadCCStmt != nil {
+		if cerr := q.getHeadToHeadCCStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getHeadToHeadCCStmt: %w", cerr)
+		}
+	}
+	if q.getHeadToHeadJPStmt != nil {
+		if cerr := q.getHeadToHeadJPStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getHeadToHeadJPStmt: %w", cerr)
+		}
+	}
+	if q.getHeadToHeadNKStmt != nil {
+		if cerr := q.getHeadToHeadNKStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getHeadToHeadNKStmt: %w", cerr)
+		}
+	}
 	if q.getLastRowCompetitorStmt != nil {
 		if cerr := q.getLastRowCompetitorStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getLastRowCompetitorStmt: %w", cerr)
@@ -566,6 +787,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing insertAthleteStmt: %w", cerr)
 		}
 	}
+	if q.insertAthleteFromCompetitorStmt != nil {
+		if cerr := q.insertAthleteFromCompetitorStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing insertAthleteFromCompetitorStmt: %w", cerr)
+		}
+	}
 	if q.insertCompetitorStmt != nil {
 		if cerr := q.insertCompetitorStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing insertCompetitorStmt: %w", cerr)
@@ -601,6 +827,71 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing insertResultNKStmt: %w", cerr)
 		}
 	}
+	if q.listAthleteLinkCandidatesStmt != nil {
+		if cerr := q.listAthleteLinkCandidatesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listAthleteLinkCandidatesStmt: %w", cerr)
+		}
+	}
+	if q.listCompetitorsChangesStmt != nil {
+		if cerr := q.listCompetitorsChangesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listCompetitorsChangesStmt: %w", cerr)
+		}
+	}
+	if q.listLinkedSporttiIDsStmt != nil {
+		if cerr := q.listLinkedSporttiIDsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listLinkedSporttiIDsStmt: %w", cerr)
+		}
+	}
+	if q.listRacesCCChangesStmt != nil {
+		if cerr := q.listRacesCCChangesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listRacesCCChangesStmt: %w", cerr)
+		}
+	}
+	if q.listRacesJPChangesStmt != nil {
+		if cerr := q.listRacesJPChangesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listRacesJPChangesStmt: %w", cerr)
+		}
+	}
+	if q.listRacesNKChangesStmt != nil {
+		if cerr := q.listRacesNKChangesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listRacesNKChangesStmt: %w", cerr)
+		}
+	}
+	if q.listResultsCCChangesStmt != nil {
+		if cerr := q.listResultsCCChangesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listResultsCCChangesStmt: %w", cerr)
+		}
+	}
+	if q.listResultsJPChangesStmt != nil {
+		if cerr := q.listResultsJPChangesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listResultsJPChangesStmt: %w", cerr)
+		}
+	}
+	if q.listResultsNKChangesStmt != nil {
+		if cerr := q.listResultsNKChangesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listResultsNKChangesStmt: %w", cerr)
+		}
+	}
+	if q.listTombstonesStmt != nil {
+		if cerr := q.listTombstonesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listTombstonesStmt: %w", cerr)
+		}
+	}
+	if q.listUnlinkedFinnishCompetitorsStmt != nil {
+		if cerr := q.listUnlinkedFinnishCompetitorsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listUnlinkedFinnishCompetitorsStmt: %w", cerr)
+		}
+	}
+	if q.rejectCompetingAthleteLinkCandidatesStmt != nil {
+		if cerr := q.rejectCompetingAthleteLinkCandidatesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing rejectCompetingAthleteLinkCandidatesStmt: %w", cerr)
+		}
+	}
+	if q.reviewAthleteLinkCandidateStmt != nil {
+		if cerr := q.reviewAthleteLinkCandidateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing reviewAthleteLinkCandidateStmt: %w", cerr)
+		}
+	}
 	if q.searchCompetitorsStmt != nil {
 		if cerr := q.searchCompetitorsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing searchCompetitorsStmt: %w", cerr)
@@ -661,6 +952,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updateResultNKByRecIDStmt: %w", cerr)
 		}
 	}
+	if q.upsertAthleteLinkCandidateStmt != nil {
+		if cerr := q.upsertAthleteLinkCandidateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertAthleteLinkCandidateStmt: %w", cerr)
+		}
+	}
 	return err
 }
 
@@ -698,171 +994,245 @@ func (q *Queries) queryRow(ctx context.Context, stmt *sql.Stmt, query string, ar
 }
 
 type Queries struct {
-	db                                   DBTX
-	tx                                   *sql.Tx
-	deleteAthleteByFiscodeStmt           *sql.Stmt
-	deleteCompetitorByIDStmt             *sql.Stmt
-	deleteRaceCCByIDStmt                 *sql.Stmt
-	deleteRaceJPByIDStmt                 *sql.Stmt
-	deleteRaceNKByIDStmt                 *sql.Stmt
-	deleteResultCCByRecIDStmt            *sql.Stmt
-	deleteResultJPByRecIDStmt            *sql.Stmt
-	deleteResultNKByRecIDStmt            *sql.Stmt
-	getAthleteResultsCCStmt              *sql.Stmt
-	getAthleteResultsJPStmt              *sql.Stmt
-	getAthleteResultsNKStmt              *sql.Stmt
-	getAthletesBySectorStmt              *sql.Stmt
-	getAthletesBySporttiIDStmt           *sql.Stmt
-	getCompetitorCountsByNationStmt      *sql.Stmt
-	getCompetitorIDByFiscodeCCStmt       *sql.Stmt
-	getCompetitorIDByFiscodeJPStmt       *sql.Stmt
-	getCompetitorIDByFiscodeNKStmt       *sql.Stmt
-	getCrossCountryCategoriesStmt        *sql.Stmt
-	getCrossCountryDisciplinesStmt       *sql.Stmt
-	getCrossCountrySeasonsStmt           *sql.Stmt
-	getLastRowCompetitorStmt             *sql.Stmt
-	getLastRowRaceCCStmt                 *sql.Stmt
-	getLastRowRaceJPStmt                 *sql.Stmt
-	getLastRowRaceNKStmt                 *sql.Stmt
-	getLastRowResultCCStmt               *sql.Stmt
-	getLastRowResultJPStmt               *sql.Stmt
-	getLastRowResultNKStmt               *sql.Stmt
-	getLatestResultsCCStmt               *sql.Stmt
-	getLatestResultsJPStmt               *sql.Stmt
-	getLatestResultsNKStmt               *sql.Stmt
-	getNationsBySectorStmt               *sql.Stmt
-	getNordicCombinedCategoriesStmt      *sql.Stmt
-	getNordicCombinedDisciplinesStmt     *sql.Stmt
-	getNordicCombinedSeasonsStmt         *sql.Stmt
-	getRaceCountsByCategoryCCStmt        *sql.Stmt
-	getRaceCountsByCategoryJPStmt        *sql.Stmt
-	getRaceCountsByCategoryNKStmt        *sql.Stmt
-	getRaceCountsByNationCCStmt          *sql.Stmt
-	getRaceCountsByNationJPStmt          *sql.Stmt
-	getRaceCountsByNationNKStmt          *sql.Stmt
-	getRaceResultsCCByRaceIDStmt         *sql.Stmt
-	getRaceResultsJPByRaceIDStmt         *sql.Stmt
-	getRaceResultsNKByRaceIDStmt         *sql.Stmt
-	getRaceTotalCCStmt                   *sql.Stmt
-	getRaceTotalJPStmt                   *sql.Stmt
-	getRaceTotalNKStmt                   *sql.Stmt
-	getRacesByIDsCCStmt                  *sql.Stmt
-	getRacesByIDsJPStmt                  *sql.Stmt
-	getRacesByIDsNKStmt                  *sql.Stmt
-	getRacesCCStmt                       *sql.Stmt
-	getRacesJPStmt                       *sql.Stmt
-	getRacesNKStmt                       *sql.Stmt
-	getSeasonsCatcodesCCByCompetitorStmt *sql.Stmt
-	getSeasonsCatcodesJPByCompetitorStmt *sql.Stmt
-	getSeasonsCatcodesNKByCompetitorStmt *sql.Stmt
-	getSectorcodeByFiscodeStmt           *sql.Stmt
-	getSkiJumpingCategoriesStmt          *sql.Stmt
-	getSkiJumpingDisciplinesStmt         *sql.Stmt
-	getSkiJumpingSeasonsStmt             *sql.Stmt
-	insertAthleteStmt                    *sql.Stmt
-	insertCompetitorStmt                 *sql.Stmt
-	insertRaceCCStmt                     *sql.Stmt
-	insertRaceJPStmt                     *sql.Stmt
-	insertRaceNKStmt                     *sql.Stmt
-	insertResultCCStmt                   *sql.Stmt
-	insertResultJPStmt                   *sql.Stmt
-	insertResultNKStmt                   *sql.Stmt
-	searchCompetitorsStmt                *sql.Stmt
-	searchRacesCCStmt                    *sql.Stmt
-	searchRacesJPStmt                    *sql.Stmt
-	searchRacesNKStmt                    *sql.Stmt
-	updateAthleteByFiscodeStmt           *sql.Stmt
-	updateCompetitorByIDStmt             *sql.Stmt
-	updateRaceCCByIDStmt                 *sql.Stmt
-	updateRaceJPByIDStmt                 *sql.Stmt
-	updateRaceNKByIDStmt                 *sql.Stmt
-	updateResultCCByRecIDStmt            *sql.Stmt
-	updateResultJPByRecIDStmt            *sql.Stmt
-	updateResultNKByRecIDStmt            *sql.Stmt
+	db                                       DBTX
+	tx                                       *sql.Tx
+	deleteAthleteByFiscodeStmt               *sql.Stmt
+	deleteCompetitorByIDStmt                 *sql.Stmt
+	deleteLinkedAthleteLinkCandidatesStmt    *sql.Stmt
+	deleteRaceCCByIDStmt                     *sql.Stmt
+	deleteRaceJPByIDStmt                     *sql.Stmt
+	deleteRaceNKByIDStmt                     *sql.Stmt
+	deleteResultCCByRecIDStmt                *sql.Stmt
+	deleteResultJPByRecIDStmt                *sql.Stmt
+	deleteResultNKByRecIDStmt                *sql.Stmt
+	deleteStaleAthleteLinkCandidatesStmt     *sql.Stmt
+	exportResultsCCStmt                      *sql.Stmt
+	exportResultsJPStmt                      *sql.Stmt
+	exportResultsNKStmt                      *sql.Stmt
+	getAthleteLinkCandidateStmt              *sql.Stmt
+	getAthleteResultsCCStmt                  *sql.Stmt
+	getAthleteResultsJPStmt                  *sql.Stmt
+	getAthleteResultsNKStmt                  *sql.Stmt
+	getAthletesBySectorStmt                  *sql.Stmt
+	getAthletesBySporttiIDStmt               *sql.Stmt
+	getCalendarRacesStmt                     *sql.Stmt
+	getChangesHorizonStmt                    *sql.Stmt
+	getCompetitorBestResultsCCStmt           *sql.Stmt
+	getCompetitorBestResultsJPStmt           *sql.Stmt
+	getCompetitorBestResultsNKStmt           *sql.Stmt
+	getCompetitorCountsByNationStmt          *sql.Stmt
+	getCompetitorIDByFiscodeCCStmt           *sql.Stmt
+	getCompetitorIDByFiscodeJPStmt           *sql.Stmt
+	getCompetitorIDByFiscodeNKStmt           *sql.Stmt
+	getCompetitorSeasonStatsCCStmt           *sql.Stmt
+	getCompetitorSeasonStatsJPStmt           *sql.Stmt
+	getCompetitorSeasonStatsNKStmt           *sql.Stmt
+	getCrossCountryCategoriesStmt            *sql.Stmt
+	getCrossCountryDisciplinesStmt           *sql.Stmt
+	getCrossCountrySeasonsStmt               *sql.Stmt
+	getEnrichedRaceResultsCCStmt             *sql.Stmt
+	getEnrichedRaceResultsJPStmt             *sql.Stmt
+	getEnrichedRaceResultsNKStmt             *sql.Stmt
+	getFISPointsListCCStmt                   *sql.Stmt
+	getFISPointsListNKStmt                   *sql.Stmt
+	getHeadToHeadCCStmt                      *sql.Stmt
+	getHeadToHeadJPStmt                      *sql.Stmt
+	getHeadToHeadNKStmt                      *sql.Stmt
+	getLastRowCompetitorStmt                 *sql.Stmt
+	getLastRowRaceCCStmt                     *sql.Stmt
+	getLastRowRaceJPStmt                     *sql.Stmt
+	getLastRowRaceNKStmt                     *sql.Stmt
+	getLastRowResultCCStmt                   *sql.Stmt
+	getLastRowResultJPStmt                   *sql.Stmt
+	getLastRowResultNKStmt                   *sql.Stmt
+	getLatestResultsCCStmt                   *sql.Stmt
+	getLatestResultsJPStmt                   *sql.Stmt
+	getLatestResultsNKStmt                   *sql.Stmt
+	getNationsBySectorStmt                   *sql.Stmt
+	getNordicCombinedCategoriesStmt          *sql.Stmt
+	getNordicCombinedDisciplinesStmt         *sql.Stmt
+	getNordicCombinedSeasonsStmt             *sql.Stmt
+	getRaceCountsByCategoryCCStmt            *sql.Stmt
+	getRaceCountsByCategoryJPStmt            *sql.Stmt
+	getRaceCountsByCategoryNKStmt            *sql.Stmt
+	getRaceCountsByNationCCStmt              *sql.Stmt
+	getRaceCountsByNationJPStmt              *sql.Stmt
+	getRaceCountsByNationNKStmt              *sql.Stmt
+	getRaceResultsCCByRaceIDStmt             *sql.Stmt
+	getRaceResultsJPByRaceIDStmt             *sql.Stmt
+	getRaceResultsNKByRaceIDStmt             *sql.Stmt
+	getRaceTotalCCStmt                       *sql.Stmt
+	getRaceTotalJPStmt                       *sql.Stmt
+	getRaceTotalNKStmt                       *sql.Stmt
+	getRacesByIDsCCStmt                      *sql.Stmt
+	getRacesByIDsJPStmt                      *sql.Stmt
+	getRacesByIDsNKStmt                      *sql.Stmt
+	getRacesCCStmt                           *sql.Stmt
+	getRacesJPStmt                           *sql.Stmt
+	getRacesNKStmt                           *sql.Stmt
+	getSeasonsCatcodesCCByCompetitorStmt     *sql.Stmt
+	getSeasonsCatcodesJPByCompetitorStmt     *sql.Stmt
+	getSeasonsCatcodesNKByCompetitorStmt     *sql.Stmt
+	getSectorcodeByFiscodeStmt               *sql.Stmt
+	getSkiJumpingCategoriesStmt              *sql.Stmt
+	getSkiJumpingDisciplinesStmt             *sql.Stmt
+	getSkiJumpingSeasonsStmt                 *sql.Stmt
+	insertAthleteStmt                        *sql.Stmt
+	insertAthleteFromCompetitorStmt          *sql.Stmt
+	insertCompetitorStmt                     *sql.Stmt
+	insertRaceCCStmt                         *sql.Stmt
+	insertRaceJPStmt                         *sql.Stmt
+	insertRaceNKStmt                         *sql.Stmt
+	insertResultCCStmt                       *sql.Stmt
+	insertResultJPStmt                       *sql.Stmt
+	insertResultNKStmt                       *sql.Stmt
+	listAthleteLinkCandidatesStmt            *sql.Stmt
+	listCompetitorsChangesStmt               *sql.Stmt
+	listLinkedSporttiIDsStmt                 *sql.Stmt
+	listRacesCCChangesStmt                   *sql.Stmt
+	listRacesJPChangesStmt                   *sql.Stmt
+	listRacesNKChangesStmt                   *sql.Stmt
+	listResultsCCChangesStmt                 *sql.Stmt
+	listResultsJPChangesStmt                 *sql.Stmt
+	listResultsNKChangesStmt                 *sql.Stmt
+	listTombstonesStmt                       *sql.Stmt
+	listUnlinkedFinnishCompetitorsStmt       *sql.Stmt
+	rejectCompetingAthleteLinkCandidatesStmt *sql.Stmt
+	reviewAthleteLinkCandidateStmt           *sql.Stmt
+	searchCompetitorsStmt                    *sql.Stmt
+	searchRacesCCStmt                        *sql.Stmt
+	searchRacesJPStmt                        *sql.Stmt
+	searchRacesNKStmt                        *sql.Stmt
+	updateAthleteByFiscodeStmt               *sql.Stmt
+	updateCompetitorByIDStmt                 *sql.Stmt
+	updateRaceCCByIDStmt                     *sql.Stmt
+	updateRaceJPByIDStmt                     *sql.Stmt
+	updateRaceNKByIDStmt                     *sql.Stmt
+	updateResultCCByRecIDStmt                *sql.Stmt
+	updateResultJPByRecIDStmt                *sql.Stmt
+	updateResultNKByRecIDStmt                *sql.Stmt
+	upsertAthleteLinkCandidateStmt           *sql.Stmt
 }
 
 func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 	return &Queries{
-		db:                                   tx,
-		tx:                                   tx,
-		deleteAthleteByFiscodeStmt:           q.deleteAthleteByFiscodeStmt,
-		deleteCompetitorByIDStmt:             q.deleteCompetitorByIDStmt,
-		deleteRaceCCByIDStmt:                 q.deleteRaceCCByIDStmt,
-		deleteRaceJPByIDStmt:                 q.deleteRaceJPByIDStmt,
-		deleteRaceNKByIDStmt:                 q.deleteRaceNKByIDStmt,
-		deleteResultCCByRecIDStmt:            q.deleteResultCCByRecIDStmt,
-		deleteResultJPByRecIDStmt:            q.deleteResultJPByRecIDStmt,
-		deleteResultNKByRecIDStmt:            q.deleteResultNKByRecIDStmt,
-		getAthleteResultsCCStmt:              q.getAthleteResultsCCStmt,
-		getAthleteResultsJPStmt:              q.getAthleteResultsJPStmt,
-		getAthleteResultsNKStmt:              q.getAthleteResultsNKStmt,
-		getAthletesBySectorStmt:              q.getAthletesBySectorStmt,
-		getAthletesBySporttiIDStmt:           q.getAthletesBySporttiIDStmt,
-		getCompetitorCountsByNationStmt:      q.getCompetitorCountsByNationStmt,
-		getCompetitorIDByFiscodeCCStmt:       q.getCompetitorIDByFiscodeCCStmt,
-		getCompetitorIDByFiscodeJPStmt:       q.getCompetitorIDByFiscodeJPStmt,
-		getCompetitorIDByFiscodeNKStmt:       q.getCompetitorIDByFiscodeNKStmt,
-		getCrossCountryCategoriesStmt:        q.getCrossCountryCategoriesStmt,
-		getCrossCountryDisciplinesStmt:       q.getCrossCountryDisciplinesStmt,
-		getCrossCountrySeasonsStmt:           q.getCrossCountrySeasonsStmt,
-		getLastRowCompetitorStmt:             q.getLastRowCompetitorStmt,
-		getLastRowRaceCCStmt:                 q.getLastRowRaceCCStmt,
-		getLastRowRaceJPStmt:                 q.getLastRowRaceJPStmt,
-		getLastRowRaceNKStmt:                 q.getLastRowRaceNKStmt,
-		getLastRowResultCCStmt:               q.getLastRowResultCCStmt,
-		getLastRowResultJPStmt:               q.getLastRowResultJPStmt,
-		getLastRowResultNKStmt:               q.getLastRowResultNKStmt,
-		getLatestResultsCCStmt:               q.getLatestResultsCCStmt,
-		getLatestResultsJPStmt:               q.getLatestResultsJPStmt,
-		getLatestResultsNKStmt:               q.getLatestResultsNKStmt,
-		getNationsBySectorStmt:               q.getNationsBySectorStmt,
-		getNordicCombinedCategoriesStmt:      q.getNordicCombinedCategoriesStmt,
-		getNordicCombinedDisciplinesStmt:     q.getNordicCombinedDisciplinesStmt,
-		getNordicCombinedSeasonsStmt:         q.getNordicCombinedSeasonsStmt,
-		getRaceCountsByCategoryCCStmt:        q.getRaceCountsByCategoryCCStmt,
-		getRaceCountsByCategoryJPStmt:        q.getRaceCountsByCategoryJPStmt,
-		getRaceCountsByCategoryNKStmt:        q.getRaceCountsByCategoryNKStmt,
-		getRaceCountsByNationCCStmt:          q.getRaceCountsByNationCCStmt,
-		getRaceCountsByNationJPStmt:          q.getRaceCountsByNationJPStmt,
-		getRaceCountsByNationNKStmt:          q.getRaceCountsByNationNKStmt,
-		getRaceResultsCCByRaceIDStmt:         q.getRaceResultsCCByRaceIDStmt,
-		getRaceResultsJPByRaceIDStmt:         q.getRaceResultsJPByRaceIDStmt,
-		getRaceResultsNKByRaceIDStmt:         q.getRaceResultsNKByRaceIDStmt,
-		getRaceTotalCCStmt:                   q.getRaceTotalCCStmt,
-		getRaceTotalJPStmt:                   q.getRaceTotalJPStmt,
-		getRaceTotalNKStmt:                   q.getRaceTotalNKStmt,
-		getRacesByIDsCCStmt:                  q.getRacesByIDsCCStmt,
-		getRacesByIDsJPStmt:                  q.getRacesByIDsJPStmt,
-		getRacesByIDsNKStmt:                  q.getRacesByIDsNKStmt,
-		getRacesCCStmt:                       q.getRacesCCStmt,
-		getRacesJPStmt:                       q.getRacesJPStmt,
-		getRacesNKStmt:                       q.getRacesNKStmt,
-		getSeasonsCatcodesCCByCompetitorStmt: q.getSeasonsCatcodesCCByCompetitorStmt,
-		getSeasonsCatcodesJPByCompetitorStmt: q.getSeasonsCatcodesJPByCompetitorStmt,
-		getSeasonsCatcodesNKByCompetitorStmt: q.getSeasonsCatcodesNKByCompetitorStmt,
-		getSectorcodeByFiscodeStmt:           q.getSectorcodeByFiscodeStmt,
-		getSkiJumpingCategoriesStmt:          q.getSkiJumpingCategoriesStmt,
-		getSkiJumpingDisciplinesStmt:         q.getSkiJumpingDisciplinesStmt,
-		getSkiJumpingSeasonsStmt:             q.getSkiJumpingSeasonsStmt,
-		insertAthleteStmt:                    q.insertAthleteStmt,
-		insertCompetitorStmt:                 q.insertCompetitorStmt,
-		insertRaceCCStmt:                     q.insertRaceCCStmt,
-		insertRaceJPStmt:                     q.insertRaceJPStmt,
-		insertRaceNKStmt:                     q.insertRaceNKStmt,
-		insertResultCCStmt:                   q.insertResultCCStmt,
-		insertResultJPStmt:                   q.insertResultJPStmt,
-		insertResultNKStmt:                   q.insertResultNKStmt,
-		searchCompetitorsStmt:                q.searchCompetitorsStmt,
-		searchRacesCCStmt:                    q.searchRacesCCStmt,
-		searchRacesJPStmt:                    q.searchRacesJPStmt,
-		searchRacesNKStmt:                    q.searchRacesNKStmt,
-		updateAthleteByFiscodeStmt:           q.updateAthleteByFiscodeStmt,
-		updateCompetitorByIDStmt:             q.updateCompetitorByIDStmt,
-		updateRaceCCByIDStmt:                 q.updateRaceCCByIDStmt,
-		updateRaceJPByIDStmt:                 q.updateRaceJPByIDStmt,
-		updateRaceNKByIDStmt:                 q.updateRaceNKByIDStmt,
-		updateResultCCByRecIDStmt:            q.updateResultCCByRecIDStmt,
-		updateResultJPByRecIDStmt:            q.updateResultJPByRecIDStmt,
-		updateResultNKByRecIDStmt:            q.updateResultNKByRecIDStmt,
+		db:                                       tx,
+		tx:                                       tx,
+		deleteAthleteByFiscodeStmt:               q.deleteAthleteByFiscodeStmt,
+		deleteCompetitorByIDStmt:                 q.deleteCompetitorByIDStmt,
+		deleteLinkedAthleteLinkCandidatesStmt:    q.deleteLinkedAthleteLinkCandidatesStmt,
+		deleteRaceCCByIDStmt:                     q.deleteRaceCCByIDStmt,
+		deleteRaceJPByIDStmt:                     q.deleteRaceJPByIDStmt,
+		deleteRaceNKByIDStmt:                     q.deleteRaceNKByIDStmt,
+		deleteResultCCByRecIDStmt:                q.deleteResultCCByRecIDStmt,
+		deleteResultJPByRecIDStmt:                q.deleteResultJPByRecIDStmt,
+		deleteResultNKByRecIDStmt:                q.deleteResultNKByRecIDStmt,
+		deleteStaleAthleteLinkCandidatesStmt:     q.deleteStaleAthleteLinkCandidatesStmt,
+		exportResultsCCStmt:                      q.exportResultsCCStmt,
+		exportResultsJPStmt:                      q.exportResultsJPStmt,
+		exportResultsNKStmt:                      q.exportResultsNKStmt,
+		getAthleteLinkCandidateStmt:              q.getAthleteLinkCandidateStmt,
+		getAthleteResultsCCStmt:                  q.getAthleteResultsCCStmt,
+		getAthleteResultsJPStmt:                  q.getAthleteResultsJPStmt,
+		getAthleteResultsNKStmt:                  q.getAthleteResultsNKStmt,
+		getAthletesBySectorStmt:                  q.getAthletesBySectorStmt,
+		getAthletesBySporttiIDStmt:               q.getAthletesBySporttiIDStmt,
+		getCalendarRacesStmt:                     q.getCalendarRacesStmt,
+		getChangesHorizonStmt:                    q.getChangesHorizonStmt,
+		getCompetitorBestResultsCCStmt:           q.getCompetitorBestResultsCCStmt,
+		getCompetitorBestResultsJPStmt:           q.getCompetitorBestResultsJPStmt,
+		getCompetitorBestResultsNKStmt:           q.getCompetitorBestResultsNKStmt,
+		getCompetitorCountsByNationStmt:          q.getCompetitorCountsByNationStmt,
+		getCompetitorIDByFiscodeCCStmt:           q.getCompetitorIDByFiscodeCCStmt,
+		getCompetitorIDByFiscodeJPStmt:           q.getCompetitorIDByFiscodeJPStmt,
+		getCompetitorIDByFiscodeNKStmt:           q.getCompetitorIDByFiscodeNKStmt,
+		getCompetitorSeasonStatsCCStmt:           q.getCompetitorSeasonStatsCCStmt,
+		getCompetitorSeasonStatsJPStmt:           q.getCompetitorSeasonStatsJPStmt,
+		getCompetitorSeasonStatsNKStmt:           q.getCompetitorSeasonStatsNKStmt,
+		getCrossCountryCategoriesStmt:            q.getCrossCountryCategoriesStmt,
+		getCrossCountryDisciplinesStmt:           q.getCrossCountryDisciplinesStmt,
+		getCrossCountrySeasonsStmt:               q.getCrossCountrySeasonsStmt,
+		getEnrichedRaceResultsCCStmt:             q.getEnrichedRaceResultsCCStmt,
+		getEnrichedRaceResultsJPStmt:             q.getEnrichedRaceResultsJPStmt,
+		getEnrichedRaceResultsNKStmt:             q.getEnrichedRaceResultsNKStmt,
+		getFISPointsListCCStmt:                   q.getFISPointsListCCStmt,
+		getFISPointsListNKStmt:                   q.getFISPointsListNKStmt,
+		getHeadToHeadCCStmt:                      q.getHeadToHeadCCStmt,
+		getHeadToHeadJPStmt:                      q.getHeadToHeadJPStmt,
+		getHeadToHeadNKStmt:                      q.getHeadToHeadNKStmt,
+		getLastRowCompetitorStmt:                 q.getLastRowCompetitorStmt,
+		getLastRowRaceCCStmt:                     q.getLastRowRaceCCStmt,
+		getLastRowRaceJPStmt:                     q.getLastRowRaceJPStmt,
+		getLastRowRaceNKStmt:                     q.getLastRowRaceNKStmt,
+		getLastRowResultCCStmt:                   q.getLastRowResultCCStmt,
+		getLastRowResultJPStmt:                   q.getLastRowResultJPStmt,
+		getLastRowResultNKStmt:                   q.getLastRowResultNKStmt,
+		getLatestResultsCCStmt:                   q.getLatestResultsCCStmt,
+		getLatestResultsJPStmt:                   q.getLatestResultsJPStmt,
+		getLatestResultsNKStmt:                   q.getLatestResultsNKStmt,
+		getNationsBySectorStmt:                   q.getNationsBySectorStmt,
+		getNordicCombinedCategoriesStmt:          q.getNordicCombinedCategoriesStmt,
+		getNordicCombinedDisciplinesStmt:         q.getNordicCombinedDisciplinesStmt,
+		getNordicCombinedSeasonsStmt:             q.getNordicCombinedSeasonsStmt,
+		getRaceCountsByCategoryCCStmt:            q.getRaceCountsByCategoryCCStmt,
+		getRaceCountsByCategoryJPStmt:            q.getRaceCountsByCategoryJPStmt,
+		getRaceCountsByCategoryNKStmt:            q.getRaceCountsByCategoryNKStmt,
+		getRaceCountsByNationCCStmt:              q.getRaceCountsByNationCCStmt,
+		getRaceCountsByNationJPStmt:              q.getRaceCountsByNationJPStmt,
+		getRaceCountsByNationNKStmt:              q.getRaceCountsByNationNKStmt,
+		getRaceResultsCCByRaceIDStmt:             q.getRaceResultsCCByRaceIDStmt,
+		getRaceResultsJPByRaceIDStmt:             q.getRaceResultsJPByRaceIDStmt,
+		getRaceResultsNKByRaceIDStmt:             q.getRaceResultsNKByRaceIDStmt,
+		getRaceTotalCCStmt:                       q.getRaceTotalCCStmt,
+		getRaceTotalJPStmt:                       q.getRaceTotalJPStmt,
+		getRaceTotalNKStmt:                       q.getRaceTotalNKStmt,
+		getRacesByIDsCCStmt:                      q.getRacesByIDsCCStmt,
+		getRacesByIDsJPStmt:                      q.getRacesByIDsJPStmt,
+		getRacesByIDsNKStmt:                      q.getRacesByIDsNKStmt,
+		getRacesCCStmt:                           q.getRacesCCStmt,
+		getRacesJPStmt:                           q.getRacesJPStmt,
+		getRacesNKStmt:                           q.getRacesNKStmt,
+		getSeasonsCatcodesCCByCompetitorStmt:     q.getSeasonsCatcodesCCByCompetitorStmt,
+		getSeasonsCatcodesJPByCompetitorStmt:     q.getSeasonsCatcodesJPByCompetitorStmt,
+		getSeasonsCatcodesNKByCompetitorStmt:     q.getSeasonsCatcodesNKByCompetitorStmt,
+		getSectorcodeByFiscodeStmt:               q.getSectorcodeByFiscodeStmt,
+		getSkiJumpingCategoriesStmt:              q.getSkiJumpingCategoriesStmt,
+		getSkiJumpingDisciplinesStmt:             q.getSkiJumpingDisciplinesStmt,
+		getSkiJumpingSeasonsStmt:                 q.getSkiJumpingSeasonsStmt,
+		insertAthleteStmt:                        q.insertAthleteStmt,
+		insertAthleteFromCompetitorStmt:          q.insertAthleteFromCompetitorStmt,
+		insertCompetitorStmt:                     q.insertCompetitorStmt,
+		insertRaceCCStmt:                         q.insertRaceCCStmt,
+		insertRaceJPStmt:                         q.insertRaceJPStmt,
+		insertRaceNKStmt:                         q.insertRaceNKStmt,
+		insertResultCCStmt:                       q.insertResultCCStmt,
+		insertResultJPStmt:                       q.insertResultJPStmt,
+		insertResultNKStmt:                       q.insertResultNKStmt,
+		listAthleteLinkCandidatesStmt:            q.listAthleteLinkCandidatesStmt,
+		listCompetitorsChangesStmt:               q.listCompetitorsChangesStmt,
+		listLinkedSporttiIDsStmt:                 q.listLinkedSporttiIDsStmt,
+		listRacesCCChangesStmt:                   q.listRacesCCChangesStmt,
+		listRacesJPChangesStmt:                   q.listRacesJPChangesStmt,
+		listRacesNKChangesStmt:                   q.listRacesNKChangesStmt,
+		listResultsCCChangesStmt:                 q.listResultsCCChangesStmt,
+		listResultsJPChangesStmt:                 q.listResultsJPChangesStmt,
+		listResultsNKChangesStmt:                 q.listResultsNKChangesStmt,
+		listTombstonesStmt:                       q.listTombstonesStmt,
+		listUnlinkedFinnishCompetitorsStmt:       q.listUnlinkedFinnishCompetitorsStmt,
+		rejectCompetingAthleteLinkCandidatesStmt: q.rejectCompetingAthleteLinkCandidatesStmt,
+		reviewAthleteLinkCandidateStmt:           q.reviewAthleteLinkCandidateStmt,
+		searchCompetitorsStmt:                    q.searchCompetitorsStmt,
+		searchRacesCCStmt:                        q.searchRacesCCStmt,
+		searchRacesJPStmt:                        q.searchRacesJPStmt,
+		searchRacesNKStmt:                        q.searchRacesNKStmt,
+		updateAthleteByFiscodeStmt:               q.updateAthleteByFiscodeStmt,
+		updateCompetitorByIDStmt:                 q.updateCompetitorByIDStmt,
+		updateRaceCCByIDStmt:                     q.updateRaceCCByIDStmt,
+		updateRaceJPByIDStmt:                     q.updateRaceJPByIDStmt,
+		updateRaceNKByIDStmt:                     q.updateRaceNKByIDStmt,
+		updateResultCCByRecIDStmt:                q.updateResultCCByRecIDStmt,
+		updateResultJPByRecIDStmt:                q.updateResultJPByRecIDStmt,
+		updateResultNKByRecIDStmt:                q.updateResultNKByRecIDStmt,
+		upsertAthleteLinkCandidateStmt:           q.upsertAthleteLinkCandidateStmt,
 	}
 }
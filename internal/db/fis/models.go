@@ -6,6 +6,8 @@ package fissqlc
 
 import (
 	"database/sql"
+	"encoding/json"
+	"time"
 )
 
 type ACompetitor struct {
@@ -519,3 +521,29 @@ type Athlete struct {
 	Firstname sql.NullString
 	Lastname  sql.NullString
 }
+
+type FisAthleteLinkCandidate struct {
+	ID         int32
+	Fiscode    int32
+	Sporttiid  int32
+	Confidence string
+	Sources    []string
+	Evidence   json.RawMessage
+	Status     string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	ReviewedBy sql.NullString
+	ReviewedAt sql.NullTime
+}
+
+type FisTombstone struct {
+	Entity    string
+	ID        int32
+	DeletedAt time.Time
+}
+
+type FisWrite struct {
+	Entity    string
+	ID        int32
+	WrittenAt time.Time
+}
@@ -8,6 +8,7 @@ package fissqlc
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/lib/pq"
@@ -127,7 +128,9 @@ WHERE rCC.CompetitorID = $1
   AND ($2::int[]  IS NULL OR aCC.SeasonCode     = ANY($2))
   AND ($3::text[] IS NULL OR aCC.DisciplineCode = ANY($3))
   AND ($4::text[] IS NULL OR aCC.CatCode        = ANY($4))
-ORDER BY aCC.RaceDate
+  AND (COALESCE(aCC.RaceDate, DATE '9999-12-31'), rCC.RecID) > ($5::date, $6::int)
+ORDER BY COALESCE(aCC.RaceDate, DATE '9999-12-31'), rCC.RecID
+LIMIT $7::int
 `
 
 type GetAthleteResultsCCParams struct {
@@ -135,6 +138,9 @@ type GetAthleteResultsCCParams struct {
 	Column2      []int32
 	Column3      []string
 	Column4      []string
+	Column5      time.Time
+	Column6      int32
+	Column7      int32
 }
 
 type GetAthleteResultsCCRow struct {
@@ -156,6 +162,9 @@ func (q *Queries) GetAthleteResultsCC(ctx context.Context, arg GetAthleteResults
 		pq.Array(arg.Column2),
 		pq.Array(arg.Column3),
 		pq.Array(arg.Column4),
+		arg.Column5,
+		arg.Column6,
+		arg.Column7,
 	)
 	if err != nil {
 		return nil, err
@@ -191,6 +200,7 @@ func (q *Queries) GetAthleteResultsCC(ctx context.Context, arg GetAthleteResults
 
 const getAthleteResultsJP = `-- name: GetAthleteResultsJP :many
 SELECT 
+    rJP.RecID,
     rJP.RaceID,
     rJP.Position,
     aJP.RaceDate,
@@ -220,7 +230,9 @@ WHERE rJP.CompetitorID = $1
   AND ($2::int[]    IS NULL OR aJP.SeasonCode     = ANY($2))
   AND ($3::text[]   IS NULL OR aJP.DisciplineCode = ANY($3))
   AND ($4::text[]   IS NULL OR aJP.CatCode        = ANY($4))
-ORDER BY aJP.RaceDate
+  AND (COALESCE(aJP.RaceDate, DATE '9999-12-31'), rJP.RecID) > ($5::date, $6::int)
+ORDER BY COALESCE(aJP.RaceDate, DATE '9999-12-31'), rJP.RecID
+LIMIT $7::int
 `
 
 type GetAthleteResultsJPParams struct {
@@ -228,9 +240,13 @@ type GetAthleteResultsJPParams struct {
 	Column2      []int32
 	Column3      []string
 	Column4      []string
+	Column5      time.Time
+	Column6      int32
+	Column7      int32
 }
 
 type GetAthleteResultsJPRow struct {
+	Recid          int32
 	Raceid         sql.NullInt32
 	Position       sql.NullInt32
 	Racedate       sql.NullTime
@@ -262,6 +278,9 @@ func (q *Queries) GetAthleteResultsJP(ctx context.Context, arg GetAthleteResults
 		pq.Array(arg.Column2),
 		pq.Array(arg.Column3),
 		pq.Array(arg.Column4),
+		arg.Column5,
+		arg.Column6,
+		arg.Column7,
 	)
 	if err != nil {
 		return nil, err
@@ -271,6 +290,7 @@ func (q *Queries) GetAthleteResultsJP(ctx context.Context, arg GetAthleteResults
 	for rows.Next() {
 		var i GetAthleteResultsJPRow
 		if err := rows.Scan(
+			&i.Recid,
 			&i.Raceid,
 			&i.Position,
 			&i.Racedate,
@@ -338,7 +358,9 @@ WHERE rNK.CompetitorID = $1
   AND ($2::int[]    IS NULL OR aNK.SeasonCode     = ANY($2))
   AND ($3::text[]   IS NULL OR aNK.DisciplineCode = ANY($3))
   AND ($4::text[]   IS NULL OR aNK.CatCode        = ANY($4))
-ORDER BY aNK.RaceDate
+  AND (COALESCE(aNK.RaceDate, DATE '9999-12-31'), rNK.RecID) > ($5::date, $6::int)
+ORDER BY COALESCE(aNK.RaceDate, DATE '9999-12-31'), rNK.RecID
+LIMIT $7::int
 `
 
 type GetAthleteResultsNKParams struct {
@@ -346,6 +368,9 @@ type GetAthleteResultsNKParams struct {
 	Column2      []int32
 	Column3      []string
 	Column4      []string
+	Column5      time.Time
+	Column6      int32
+	Column7      int32
 }
 
 type GetAthleteResultsNKRow struct {
@@ -379,6 +404,9 @@ func (q *Queries) GetAthleteResultsNK(ctx context.Context, arg GetAthleteResults
 		pq.Array(arg.Column2),
 		pq.Array(arg.Column3),
 		pq.Array(arg.Column4),
+		arg.Column5,
+		arg.Column6,
+		arg.Column7,
 	)
 	if err != nil {
 		return nil, err
@@ -2683,17 +2711,27 @@ FROM A_raceCC
 WHERE ($1::int[]  IS NULL OR SeasonCode     = ANY($1))
   AND ($2::text[] IS NULL OR DisciplineCode = ANY($2))
   AND ($3::text[] IS NULL OR CatCode        = ANY($3))
+  AND RaceID > $4::int
 ORDER BY RaceID
+LIMIT $5::int
 `
 
 type GetRacesCCParams struct {
 	Column1 []int32
 	Column2 []string
 	Column3 []string
+	Column4 int32
+	Column5 int32
 }
 
 func (q *Queries) GetRacesCC(ctx context.Context, arg GetRacesCCParams) ([]ARacecc, error) {
-	rows, err := q.query(ctx, q.getRacesCCStmt, getRacesCC, pq.Array(arg.Column1), pq.Array(arg.Column2), pq.Array(arg.Column3))
+	rows, err := q.query(ctx, q.getRacesCCStmt, getRacesCC,
+		pq.Array(arg.Column1),
+		pq.Array(arg.Column2),
+		pq.Array(arg.Column3),
+		arg.Column4,
+		arg.Column5,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -2810,17 +2848,27 @@ FROM A_raceJP
 WHERE ($1::int[]  IS NULL OR SeasonCode     = ANY($1))
   AND ($2::text[] IS NULL OR DisciplineCode = ANY($2))
   AND ($3::text[] IS NULL OR CatCode        = ANY($3))
+  AND RaceID > $4::int
 ORDER BY RaceID
+LIMIT $5::int
 `
 
 type GetRacesJPParams struct {
 	Column1 []int32
 	Column2 []string
 	Column3 []string
+	Column4 int32
+	Column5 int32
 }
 
 func (q *Queries) GetRacesJP(ctx context.Context, arg GetRacesJPParams) ([]ARacejp, error) {
-	rows, err := q.query(ctx, q.getRacesJPStmt, getRacesJP, pq.Array(arg.Column1), pq.Array(arg.Column2), pq.Array(arg.Column3))
+	rows, err := q.query(ctx, q.getRacesJPStmt, getRacesJP,
+		pq.Array(arg.Column1),
+		pq.Array(arg.Column2),
+		pq.Array(arg.Column3),
+		arg.Column4,
+		arg.Column5,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -2937,17 +2985,27 @@ FROM A_raceNK
 WHERE ($1::int[]  IS NULL OR SeasonCode     = ANY($1))
   AND ($2::text[] IS NULL OR DisciplineCode = ANY($2))
   AND ($3::text[] IS NULL OR CatCode        = ANY($3))
+  AND RaceID > $4::int
 ORDER BY RaceID
+LIMIT $5::int
 `
 
 type GetRacesNKParams struct {
 	Column1 []int32
 	Column2 []string
 	Column3 []string
+	Column4 int32
+	Column5 int32
 }
 
 func (q *Queries) GetRacesNK(ctx context.Context, arg GetRacesNKParams) ([]ARacenk, error) {
-	rows, err := q.query(ctx, q.getRacesNKStmt, getRacesNK, pq.Array(arg.Column1), pq.Array(arg.Column2), pq.Array(arg.Column3))
+	rows, err := q.query(ctx, q.getRacesNKStmt, getRacesNK,
+		pq.Array(arg.Column1),
+		pq.Array(arg.Column2),
+		pq.Array(arg.Column3),
+		arg.Column4,
+		arg.Column5,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -4560,83 +4618,118 @@ func (q *Queries) InsertResultNK(ctx context.Context, arg InsertResultNKParams)
 }
 
 const searchCompetitors = `-- name: SearchCompetitors :many
-SELECT competitorid, personid, ipcid, type, sectorcode, fiscode, lastname, firstname, gender, birthdate, nationcode, nationalcode, skiclub, association, status, status_old, status_by, status_date, statusnextlist, alternatenamecheck, fee, dateofcreation, createdby, injury, version, compidmssql, carving, photo, notallowed, natteam, tragroup, published, doped, team, photo_big, data, lastupdateby, disciplines, lastupdate, deletedat, categorycode, classname, classcode
-FROM a_competitor
-WHERE ($1::text = '' OR nationcode  = $1::text)
-  AND ($2::text = '' OR sectorcode  = $2::text)
-  AND ($3::text = '' OR gender      = $3::text)
-  AND ($4::date = '0001-01-01' OR birthdate >= $4::date)
-  AND ($5::date = '0001-01-01' OR birthdate <= $5::date)
-ORDER BY competitorid
+SELECT c.competitorid, c.personid, c.ipcid, c.type, c.sectorcode, c.fiscode, c.lastname, c.firstname, c.gender, c.birthdate, c.nationcode, c.nationalcode, c.skiclub, c.association, c.status, c.status_old, c.status_by, c.status_date, c.statusnextlist, c.alternatenamecheck, c.fee, c.dateofcreation, c.createdby, c.injury, c.version, c.compidmssql, c.carving, c.photo, c.notallowed, c.natteam, c.tragroup, c.published, c.doped, c.team, c.photo_big, c.data, c.lastupdateby, c.disciplines, c.lastupdate, c.deletedat, c.categorycode, c.classname, c.classcode, s.relevance::numeric AS relevance
+FROM a_competitor AS c
+CROSS JOIN LATERAL (
+  SELECT CASE WHEN $1::text = '' THEN 0
+    ELSE ROUND(GREATEST(
+      word_similarity(public.fis_unaccent($1::text), public.fis_unaccent(COALESCE(c.firstname, '') || ' ' || COALESCE(c.lastname, ''))),
+      0.8 * word_similarity(public.fis_unaccent($1::text), public.fis_unaccent(COALESCE(c.skiclub, '')))
+    )::numeric, 4)
+  END AS relevance
+) AS s
+WHERE ($2::text = '' OR c.nationcode  = $2::text)
+  AND ($3::text = '' OR c.sectorcode  = $3::text)
+  AND ($4::text = '' OR c.gender      = $4::text)
+  AND ($5::date = '0001-01-01' OR c.birthdate >= $5::date)
+  AND ($6::date = '0001-01-01' OR c.birthdate <= $6::date)
+  AND ($1::text = ''
+       OR public.fis_unaccent($1::text) OPERATOR(public.<%) public.fis_unaccent(COALESCE(c.firstname, '') || ' ' || COALESCE(c.lastname, ''))
+       OR public.fis_unaccent($1::text) OPERATOR(public.<%) public.fis_unaccent(COALESCE(c.skiclub, '')))
+  AND COALESCE(c.fiscode::text, '') OPERATOR(pg_catalog.~>=~) $7::text
+  AND COALESCE(c.fiscode::text, '') OPERATOR(pg_catalog.~<~) $8::text
+  AND ($9::numeric IS NULL
+       OR s.relevance < $9::numeric
+       OR (s.relevance = $9::numeric AND c.competitorid > $10::int))
+ORDER BY s.relevance DESC, c.competitorid
+LIMIT $11::int
 `
 
 type SearchCompetitorsParams struct {
-	Column1 string
-	Column2 string
-	Column3 string
-	Column4 time.Time
-	Column5 time.Time
+	Query          string
+	Nationcode     string
+	Sectorcode     string
+	Gender         string
+	BirthdateMin   time.Time
+	BirthdateMax   time.Time
+	FiscodeFrom    string
+	FiscodeTo      string
+	AfterRelevance sql.NullString
+	AfterID        sql.NullInt32
+	PageLimit      int32
+}
+
+type SearchCompetitorsRow struct {
+	ACompetitor ACompetitor
+	Relevance   string
 }
 
-func (q *Queries) SearchCompetitors(ctx context.Context, arg SearchCompetitorsParams) ([]ACompetitor, error) {
+func (q *Queries) SearchCompetitors(ctx context.Context, arg SearchCompetitorsParams) ([]SearchCompetitorsRow, error) {
 	rows, err := q.query(ctx, q.searchCompetitorsStmt, searchCompetitors,
-		arg.Column1,
-		arg.Column2,
-		arg.Column3,
-		arg.Column4,
-		arg.Column5,
+		arg.Query,
+		arg.Nationcode,
+		arg.Sectorcode,
+		arg.Gender,
+		arg.BirthdateMin,
+		arg.BirthdateMax,
+		arg.FiscodeFrom,
+		arg.FiscodeTo,
+		arg.AfterRelevance,
+		arg.AfterID,
+		arg.PageLimit,
 	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ACompetitor
+	var items []SearchCompetitorsRow
 	for rows.Next() {
-		var i ACompetitor
+		var i SearchCompetitorsRow
 		if err := rows.Scan(
-			&i.Competitorid,
-			&i.Personid,
-			&i.Ipcid,
-			&i.Type,
-			&i.Sectorcode,
-			&i.Fiscode,
-			&i.Lastname,
-			&i.Firstname,
-			&i.Gender,
-			&i.Birthdate,
-			&i.Nationcode,
-			&i.Nationalcode,
-			&i.Skiclub,
-			&i.Association,
-			&i.Status,
-			&i.StatusOld,
-			&i.StatusBy,
-			&i.StatusDate,
-			&i.Statusnextlist,
-			&i.Alternatenamecheck,
-			&i.Fee,
-			&i.Dateofcreation,
-			&i.Createdby,
-			&i.Injury,
-			&i.Version,
-			&i.Compidmssql,
-			&i.Carving,
-			&i.Photo,
-			&i.Notallowed,
-			&i.Natteam,
-			&i.Tragroup,
-			&i.Published,
-			&i.Doped,
-			&i.Team,
-			&i.PhotoBig,
-			&i.Data,
-			&i.Lastupdateby,
-			&i.Disciplines,
-			&i.Lastupdate,
-			&i.Deletedat,
-			&i.Categorycode,
-			&i.Classname,
-			&i.Classcode,
+			&i.ACompetitor.Competitorid,
+			&i.ACompetitor.Personid,
+			&i.ACompetitor.Ipcid,
+			&i.ACompetitor.Type,
+			&i.ACompetitor.Sectorcode,
+			&i.ACompetitor.Fiscode,
+			&i.ACompetitor.Lastname,
+			&i.ACompetitor.Firstname,
+			&i.ACompetitor.Gender,
+			&i.ACompetitor.Birthdate,
+			&i.ACompetitor.Nationcode,
+			&i.ACompetitor.Nationalcode,
+			&i.ACompetitor.Skiclub,
+			&i.ACompetitor.Association,
+			&i.ACompetitor.Status,
+			&i.ACompetitor.StatusOld,
+			&i.ACompetitor.StatusBy,
+			&i.ACompetitor.StatusDate,
+			&i.ACompetitor.Statusnextlist,
+			&i.ACompetitor.Alternatenamecheck,
+			&i.ACompetitor.Fee,
+			&i.ACompetitor.Dateofcreation,
+			&i.ACompetitor.Createdby,
+			&i.ACompetitor.Injury,
+			&i.ACompetitor.Version,
+			&i.ACompetitor.Compidmssql,
+			&i.ACompetitor.Carving,
+			&i.ACompetitor.Photo,
+			&i.ACompetitor.Notallowed,
+			&i.ACompetitor.Natteam,
+			&i.ACompetitor.Tragroup,
+			&i.ACompetitor.Published,
+			&i.ACompetitor.Doped,
+			&i.ACompetitor.Team,
+			&i.ACompetitor.PhotoBig,
+			&i.ACompetitor.Data,
+			&i.ACompetitor.Lastupdateby,
+			&i.ACompetitor.Disciplines,
+			&i.ACompetitor.Lastupdate,
+			&i.ACompetitor.Deletedat,
+			&i.ACompetitor.Categorycode,
+			&i.ACompetitor.Classname,
+			&i.ACompetitor.Classcode,
+			&i.Relevance,
 		); err != nil {
 			return nil, err
 		}
@@ -6349,3 +6442,3029 @@ func (q *Queries) UpdateResultNKByRecID(ctx context.Context, arg UpdateResultNKB
 	err := row.Scan(&recid)
 	return recid, err
 }
+
+const exportResultsCC = `-- name: ExportResultsCC :many
+SELECT recid, raceid, competitorid, status, reason, position, pf, status2, bib, bibcolor, fiscode, competitorname, nationcode, stage, level, heat, timer1, timer2, timer3, timetot, valid, racepoints, cuppoints, bonustime, bonuscuppoints, version, rg1, rg2, lastupdate
+FROM public.a_resultcc
+WHERE raceid IN (
+  SELECT raceid FROM public.a_racecc
+  WHERE seasoncode BETWEEN $1::int AND $2::int
+)
+  AND recid > $3::int
+ORDER BY recid
+LIMIT $4::int
+`
+
+type ExportResultsCCParams struct {
+	Column1 int32
+	Column2 int32
+	Column3 int32
+	Column4 int32
+}
+
+func (q *Queries) ExportResultsCC(ctx context.Context, arg ExportResultsCCParams) ([]AResultcc, error) {
+	rows, err := q.query(ctx, q.exportResultsCCStmt, exportResultsCC,
+		arg.Column1,
+		arg.Column2,
+		arg.Column3,
+		arg.Column4,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AResultcc
+	for rows.Next() {
+		var i AResultcc
+		if err := rows.Scan(
+			&i.Recid,
+			&i.Raceid,
+			&i.Competitorid,
+			&i.Status,
+			&i.Reason,
+			&i.Position,
+			&i.Pf,
+			&i.Status2,
+			&i.Bib,
+			&i.Bibcolor,
+			&i.Fiscode,
+			&i.Competitorname,
+			&i.Nationcode,
+			&i.Stage,
+			&i.Level,
+			&i.Heat,
+			&i.Timer1,
+			&i.Timer2,
+			&i.Timer3,
+			&i.Timetot,
+			&i.Valid,
+			&i.Racepoints,
+			&i.Cuppoints,
+			&i.Bonustime,
+			&i.Bonuscuppoints,
+			&i.Version,
+			&i.Rg1,
+			&i.Rg2,
+			&i.Lastupdate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const exportResultsJP = `-- name: ExportResultsJP :many
+SELECT recid, raceid, competitorid, status, status2, position, bib, fiscode, competitorname, nationcode, level, heat, stage, j1r1, j2r1, j3r1, j4r1, j5r1, speedr1, distr1, disptsr1, judptsr1, totrun1, posr1, statusr1, j1r2, j2r2, j3r2, j4r2, j5r2, speedr2, distr2, disptsr2, judptsr2, totrun2, posr2, statusr2, j1r3, j2r3, j3r3, j4r3, j5r3, speedr3, distr3, disptsr3, judptsr3, totrun3, posr3, statusr3, j1r4, j2r4, j3r4, j4r4, j5r4, speedr4, distr4, disptsr4, judptsr4, gater1, gater2, gater3, gater4, gateptsr1, gateptsr2, gateptsr3, gateptsr4, windr1, windr2, windr3, windr4, windptsr1, windptsr2, windptsr3, windptsr4, reason, totrun4, tot, valid, racepoints, cuppoints, version, lastupdate, posr4, statusr4
+FROM public.a_resultjp
+WHERE raceid IN (
+  SELECT raceid FROM public.a_racejp
+  WHERE seasoncode BETWEEN $1::int AND $2::int
+)
+  AND recid > $3::int
+ORDER BY recid
+LIMIT $4::int
+`
+
+type ExportResultsJPParams struct {
+	Column1 int32
+	Column2 int32
+	Column3 int32
+	Column4 int32
+}
+
+func (q *Queries) ExportResultsJP(ctx context.Context, arg ExportResultsJPParams) ([]AResultjp, error) {
+	rows, err := q.query(ctx, q.exportResultsJPStmt, exportResultsJP,
+		arg.Column1,
+		arg.Column2,
+		arg.Column3,
+		arg.Column4,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AResultjp
+	for rows.Next() {
+		var i AResultjp
+		if err := rows.Scan(
+			&i.Recid,
+			&i.Raceid,
+			&i.Competitorid,
+			&i.Status,
+			&i.Status2,
+			&i.Position,
+			&i.Bib,
+			&i.Fiscode,
+			&i.Competitorname,
+			&i.Nationcode,
+			&i.Level,
+			&i.Heat,
+			&i.Stage,
+			&i.J1r1,
+			&i.J2r1,
+			&i.J3r1,
+			&i.J4r1,
+			&i.J5r1,
+			&i.Speedr1,
+			&i.Distr1,
+			&i.Disptsr1,
+			&i.Judptsr1,
+			&i.Totrun1,
+			&i.Posr1,
+			&i.Statusr1,
+			&i.J1r2,
+			&i.J2r2,
+			&i.J3r2,
+			&i.J4r2,
+			&i.J5r2,
+			&i.Speedr2,
+			&i.Distr2,
+			&i.Disptsr2,
+			&i.Judptsr2,
+			&i.Totrun2,
+			&i.Posr2,
+			&i.Statusr2,
+			&i.J1r3,
+			&i.J2r3,
+			&i.J3r3,
+			&i.J4r3,
+			&i.J5r3,
+			&i.Speedr3,
+			&i.Distr3,
+			&i.Disptsr3,
+			&i.Judptsr3,
+			&i.Totrun3,
+			&i.Posr3,
+			&i.Statusr3,
+			&i.J1r4,
+			&i.J2r4,
+			&i.J3r4,
+			&i.J4r4,
+			&i.J5r4,
+			&i.Speedr4,
+			&i.Distr4,
+			&i.Disptsr4,
+			&i.Judptsr4,
+			&i.Gater1,
+			&i.Gater2,
+			&i.Gater3,
+			&i.Gater4,
+			&i.Gateptsr1,
+			&i.Gateptsr2,
+			&i.Gateptsr3,
+			&i.Gateptsr4,
+			&i.Windr1,
+			&i.Windr2,
+			&i.Windr3,
+			&i.Windr4,
+			&i.Windptsr1,
+			&i.Windptsr2,
+			&i.Windptsr3,
+			&i.Windptsr4,
+			&i.Reason,
+			&i.Totrun4,
+			&i.Tot,
+			&i.Valid,
+			&i.Racepoints,
+			&i.Cuppoints,
+			&i.Version,
+			&i.Lastupdate,
+			&i.Posr4,
+			&i.Statusr4,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const exportResultsNK = `-- name: ExportResultsNK :many
+SELECT recid, raceid, competitorid, status, reason, position, pf, status2, bib, bibcolor, fiscode, competitorname, nationcode, level, heat, stage, j1r1, j2r1, j3r1, j4r1, j5r1, speedr1, distr1, disptsr1, judptsr1, gater1, gateptsr1, windr1, windptsr1, totrun1, posr1, statusr1, j1r2, j2r2, j3r2, j4r2, j5r2, speedr2, distr2, disptsr2, judptsr2, gater2, gateptsr2, windr2, windptsr2, totrun2, posr2, statusr2, pointsjump, behindjump, posjump, timecc, timeccint, poscc, starttime, statuscc, totbehind, timetot, timetotint, valid, racepoints, cuppoints, version, lastupdate
+FROM public.a_resultnk
+WHERE raceid IN (
+  SELECT raceid FROM public.a_racenk
+  WHERE seasoncode BETWEEN $1::int AND $2::int
+)
+  AND recid > $3::int
+ORDER BY recid
+LIMIT $4::int
+`
+
+type ExportResultsNKParams struct {
+	Column1 int32
+	Column2 int32
+	Column3 int32
+	Column4 int32
+}
+
+func (q *Queries) ExportResultsNK(ctx context.Context, arg ExportResultsNKParams) ([]AResultnk, error) {
+	rows, err := q.query(ctx, q.exportResultsNKStmt, exportResultsNK,
+		arg.Column1,
+		arg.Column2,
+		arg.Column3,
+		arg.Column4,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AResultnk
+	for rows.Next() {
+		var i AResultnk
+		if err := rows.Scan(
+			&i.Recid,
+			&i.Raceid,
+			&i.Competitorid,
+			&i.Status,
+			&i.Reason,
+			&i.Position,
+			&i.Pf,
+			&i.Status2,
+			&i.Bib,
+			&i.Bibcolor,
+			&i.Fiscode,
+			&i.Competitorname,
+			&i.Nationcode,
+			&i.Level,
+			&i.Heat,
+			&i.Stage,
+			&i.J1r1,
+			&i.J2r1,
+			&i.J3r1,
+			&i.J4r1,
+			&i.J5r1,
+			&i.Speedr1,
+			&i.Distr1,
+			&i.Disptsr1,
+			&i.Judptsr1,
+			&i.Gater1,
+			&i.Gateptsr1,
+			&i.Windr1,
+			&i.Windptsr1,
+			&i.Totrun1,
+			&i.Posr1,
+			&i.Statusr1,
+			&i.J1r2,
+			&i.J2r2,
+			&i.J3r2,
+			&i.J4r2,
+			&i.J5r2,
+			&i.Speedr2,
+			&i.Distr2,
+			&i.Disptsr2,
+			&i.Judptsr2,
+			&i.Gater2,
+			&i.Gateptsr2,
+			&i.Windr2,
+			&i.Windptsr2,
+			&i.Totrun2,
+			&i.Posr2,
+			&i.Statusr2,
+			&i.Pointsjump,
+			&i.Behindjump,
+			&i.Posjump,
+			&i.Timecc,
+			&i.Timeccint,
+			&i.Poscc,
+			&i.Starttime,
+			&i.Statuscc,
+			&i.Totbehind,
+			&i.Timetot,
+			&i.Timetotint,
+			&i.Valid,
+			&i.Racepoints,
+			&i.Cuppoints,
+			&i.Version,
+			&i.Lastupdate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCompetitorsChanges = `-- name: ListCompetitorsChanges :many
+SELECT t.competitorid, t.personid, t.ipcid, t.type, t.sectorcode, t.fiscode, t.lastname, t.firstname, t.gender, t.birthdate, t.nationcode, t.nationalcode, t.skiclub, t.association, t.status, t.status_old, t.status_by, t.status_date, t.statusnextlist, t.alternatenamecheck, t.fee, t.dateofcreation, t.createdby, t.injury, t.version, t.compidmssql, t.carving, t.photo, t.notallowed, t.natteam, t.tragroup, t.published, t.doped, t.team, t.photo_big, t.data, t.lastupdateby, t.disciplines, t.lastupdate, t.deletedat, t.categorycode, t.classname, t.classcode, w.written_at
+FROM public.fis_writes w
+JOIN public.a_competitor t ON t.competitorid = w.id
+WHERE w.entity = 'competitor'
+  AND w.written_at >= $1::timestamp
+  AND ($2::timestamp IS NULL
+       OR (w.written_at, w.id) > ($2::timestamp, $3::int))
+ORDER BY w.written_at, w.id
+LIMIT $4::int
+`
+
+type ListCompetitorsChangesParams struct {
+	Since     time.Time
+	AfterTime sql.NullTime
+	AfterID   sql.NullInt32
+	PageLimit int32
+}
+
+type ListCompetitorsChangesRow struct {
+	ACompetitor ACompetitor
+	WrittenAt   time.Time
+}
+
+func (q *Queries) ListCompetitorsChanges(ctx context.Context, arg ListCompetitorsChangesParams) ([]ListCompetitorsChangesRow, error) {
+	rows, err := q.query(ctx, q.listCompetitorsChangesStmt, listCompetitorsChanges,
+		arg.Since,
+		arg.AfterTime,
+		arg.AfterID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCompetitorsChangesRow
+	for rows.Next() {
+		var i ListCompetitorsChangesRow
+		if err := rows.Scan(
+			&i.ACompetitor.Competitorid,
+			&i.ACompetitor.Personid,
+			&i.ACompetitor.Ipcid,
+			&i.ACompetitor.Type,
+			&i.ACompetitor.Sectorcode,
+			&i.ACompetitor.Fiscode,
+			&i.ACompetitor.Lastname,
+			&i.ACompetitor.Firstname,
+			&i.ACompetitor.Gender,
+			&i.ACompetitor.Birthdate,
+			&i.ACompetitor.Nationcode,
+			&i.ACompetitor.Nationalcode,
+			&i.ACompetitor.Skiclub,
+			&i.ACompetitor.Association,
+			&i.ACompetitor.Status,
+			&i.ACompetitor.StatusOld,
+			&i.ACompetitor.StatusBy,
+			&i.ACompetitor.StatusDate,
+			&i.ACompetitor.Statusnextlist,
+			&i.ACompetitor.Alternatenamecheck,
+			&i.ACompetitor.Fee,
+			&i.ACompetitor.Dateofcreation,
+			&i.ACompetitor.Createdby,
+			&i.ACompetitor.Injury,
+			&i.ACompetitor.Version,
+			&i.ACompetitor.Compidmssql,
+			&i.ACompetitor.Carving,
+			&i.ACompetitor.Photo,
+			&i.ACompetitor.Notallowed,
+			&i.ACompetitor.Natteam,
+			&i.ACompetitor.Tragroup,
+			&i.ACompetitor.Published,
+			&i.ACompetitor.Doped,
+			&i.ACompetitor.Team,
+			&i.ACompetitor.PhotoBig,
+			&i.ACompetitor.Data,
+			&i.ACompetitor.Lastupdateby,
+			&i.ACompetitor.Disciplines,
+			&i.ACompetitor.Lastupdate,
+			&i.ACompetitor.Deletedat,
+			&i.ACompetitor.Categorycode,
+			&i.ACompetitor.Classname,
+			&i.ACompetitor.Classcode,
+			&i.WrittenAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRacesCCChanges = `-- name: ListRacesCCChanges :many
+SELECT t.raceid, t.eventid, t.seasoncode, t.racecodex, t.disciplineid, t.disciplinecode, t.catcode, t.catcode2, t.catcode3, t.catcode4, t.gender, t.racedate, t.starteventdate, t.description, t.place, t.nationcode, t.td1id, t.td1name, t.td1nation, t.td1code, t.td2id, t.td2name, t.td2nation, t.td2code, t.calstatuscode, t.procstatuscode, t.receiveddate, t.pursuit, t.masse, t.relay, t.distance, t.hill, t.style, t.qualif, t.finale, t.homol, t.webcomment, t.displaystatus, t.fisinterncomment, t.published, t.validforfispoints, t.usedfislist, t.tolist, t.discforlistcode, t.calculatedpenalty, t.appliedpenalty, t.appliedscala, t.penscafixed, t.version, t.nationraceid, t.provraceid, t.msql7evid, t.mssql7id, t.results, t.pdf, t.topbanner, t.bottombanner, t.toplogo, t.bottomlogo, t.gallery, t.indi, t.team, t.tabcount, t.columncount, t.level, t.hloc1, t.hloc2, t.hloc3, t.hcet1, t.hcet2, t.hcet3, t.live, t.livestatus1, t.livestatus2, t.livestatus3, t.liveinfo1, t.liveinfo2, t.liveinfo3, t.passwd, t.timinglogo, t.validdate, t.noepr, t.tddoc, t.timingreport, t.special_cup_points, t.skip_wcsl, t.validforowg, t.lastupdate, w.written_at
+FROM public.fis_writes w
+JOIN public.a_racecc t ON t.raceid = w.id
+WHERE w.entity = 'racecc'
+  AND w.written_at >= $1::timestamp
+  AND ($2::timestamp IS NULL
+       OR (w.written_at, w.id) > ($2::timestamp, $3::int))
+ORDER BY w.written_at, w.id
+LIMIT $4::int
+`
+
+type ListRacesCCChangesParams struct {
+	Since     time.Time
+	AfterTime sql.NullTime
+	AfterID   sql.NullInt32
+	PageLimit int32
+}
+
+type ListRacesCCChangesRow struct {
+	ARacecc   ARacecc
+	WrittenAt time.Time
+}
+
+func (q *Queries) ListRacesCCChanges(ctx context.Context, arg ListRacesCCChangesParams) ([]ListRacesCCChangesRow, error) {
+	rows, err := q.query(ctx, q.listRacesCCChangesStmt, listRacesCCChanges,
+		arg.Since,
+		arg.AfterTime,
+		arg.AfterID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRacesCCChangesRow
+	for rows.Next() {
+		var i ListRacesCCChangesRow
+		if err := rows.Scan(
+			&i.ARacecc.Raceid,
+			&i.ARacecc.Eventid,
+			&i.ARacecc.Seasoncode,
+			&i.ARacecc.Racecodex,
+			&i.ARacecc.Disciplineid,
+			&i.ARacecc.Disciplinecode,
+			&i.ARacecc.Catcode,
+			&i.ARacecc.Catcode2,
+			&i.ARacecc.Catcode3,
+			&i.ARacecc.Catcode4,
+			&i.ARacecc.Gender,
+			&i.ARacecc.Racedate,
+			&i.ARacecc.Starteventdate,
+			&i.ARacecc.Description,
+			&i.ARacecc.Place,
+			&i.ARacecc.Nationcode,
+			&i.ARacecc.Td1id,
+			&i.ARacecc.Td1name,
+			&i.ARacecc.Td1nation,
+			&i.ARacecc.Td1code,
+			&i.ARacecc.Td2id,
+			&i.ARacecc.Td2name,
+			&i.ARacecc.Td2nation,
+			&i.ARacecc.Td2code,
+			&i.ARacecc.Calstatuscode,
+			&i.ARacecc.Procstatuscode,
+			&i.ARacecc.Receiveddate,
+			&i.ARacecc.Pursuit,
+			&i.ARacecc.Masse,
+			&i.ARacecc.Relay,
+			&i.ARacecc.Distance,
+			&i.ARacecc.Hill,
+			&i.ARacecc.Style,
+			&i.ARacecc.Qualif,
+			&i.ARacecc.Finale,
+			&i.ARacecc.Homol,
+			&i.ARacecc.Webcomment,
+			&i.ARacecc.Displaystatus,
+			&i.ARacecc.Fisinterncomment,
+			&i.ARacecc.Published,
+			&i.ARacecc.Validforfispoints,
+			&i.ARacecc.Usedfislist,
+			&i.ARacecc.Tolist,
+			&i.ARacecc.Discforlistcode,
+			&i.ARacecc.Calculatedpenalty,
+			&i.ARacecc.Appliedpenalty,
+			&i.ARacecc.Appliedscala,
+			&i.ARacecc.Penscafixed,
+			&i.ARacecc.Version,
+			&i.ARacecc.Nationraceid,
+			&i.ARacecc.Provraceid,
+			&i.ARacecc.Msql7evid,
+			&i.ARacecc.Mssql7id,
+			&i.ARacecc.Results,
+			&i.ARacecc.Pdf,
+			&i.ARacecc.Topbanner,
+			&i.ARacecc.Bottombanner,
+			&i.ARacecc.Toplogo,
+			&i.ARacecc.Bottomlogo,
+			&i.ARacecc.Gallery,
+			&i.ARacecc.Indi,
+			&i.ARacecc.Team,
+			&i.ARacecc.Tabcount,
+			&i.ARacecc.Columncount,
+			&i.ARacecc.Level,
+			&i.ARacecc.Hloc1,
+			&i.ARacecc.Hloc2,
+			&i.ARacecc.Hloc3,
+			&i.ARacecc.Hcet1,
+			&i.ARacecc.Hcet2,
+			&i.ARacecc.Hcet3,
+			&i.ARacecc.Live,
+			&i.ARacecc.Livestatus1,
+			&i.ARacecc.Livestatus2,
+			&i.ARacecc.Livestatus3,
+			&i.ARacecc.Liveinfo1,
+			&i.ARacecc.Liveinfo2,
+			&i.ARacecc.Liveinfo3,
+			&i.ARacecc.Passwd,
+			&i.ARacecc.Timinglogo,
+			&i.ARacecc.Validdate,
+			&i.ARacecc.Noepr,
+			&i.ARacecc.Tddoc,
+			&i.ARacecc.Timingreport,
+			&i.ARacecc.SpecialCupPoints,
+			&i.ARacecc.SkipWcsl,
+			&i.ARacecc.Validforowg,
+			&i.ARacecc.Lastupdate,
+			&i.WrittenAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRacesJPChanges = `-- name: ListRacesJPChanges :many
+SELECT t.raceid, t.eventid, t.seasoncode, t.racecodex, t.disciplineid, t.disciplinecode, t.catcode, t.catcode2, t.catcode3, t.catcode4, t.gender, t.racedate, t.starteventdate, t.description, t.place, t.nationcode, t.td1id, t.td1name, t.td1nation, t.td1code, t.td2id, t.td2name, t.td2nation, t.td2code, t.calstatuscode, t.procstatuscode, t.receiveddate, t.pursuit, t.masse, t.relay, t.distance, t.hill, t.style, t.qualif, t.finale, t.homol, t.webcomment, t.displaystatus, t.fisinterncomment, t.published, t.validforfispoints, t.usedfislist, t.tolist, t.discforlistcode, t.calculatedpenalty, t.appliedpenalty, t.appliedscala, t.penscafixed, t.version, t.nationraceid, t.provraceid, t.msql7evid, t.mssql7id, t.results, t.pdf, t.topbanner, t.bottombanner, t.toplogo, t.bottomlogo, t.gallery, t.indi, t.team, t.tabcount, t.columncount, t.level, t.hloc1, t.hloc2, t.hloc3, t.hcet1, t.hcet2, t.hcet3, t.live, t.livestatus1, t.livestatus2, t.livestatus3, t.liveinfo1, t.liveinfo2, t.liveinfo3, t.passwd, t.timinglogo, t.validdate, t.noepr, t.tddoc, t.timingreport, t.special_cup_points, t.skip_wcsl, t.lastupdate, t.validforowg, w.written_at
+FROM public.fis_writes w
+JOIN public.a_racejp t ON t.raceid = w.id
+WHERE w.entity = 'racejp'
+  AND w.written_at >= $1::timestamp
+  AND ($2::timestamp IS NULL
+       OR (w.written_at, w.id) > ($2::timestamp, $3::int))
+ORDER BY w.written_at, w.id
+LIMIT $4::int
+`
+
+type ListRacesJPChangesParams struct {
+	Since     time.Time
+	AfterTime sql.NullTime
+	AfterID   sql.NullInt32
+	PageLimit int32
+}
+
+type ListRacesJPChangesRow struct {
+	ARacejp   ARacejp
+	WrittenAt time.Time
+}
+
+func (q *Queries) ListRacesJPChanges(ctx context.Context, arg ListRacesJPChangesParams) ([]ListRacesJPChangesRow, error) {
+	rows, err := q.query(ctx, q.listRacesJPChangesStmt, listRacesJPChanges,
+		arg.Since,
+		arg.AfterTime,
+		arg.AfterID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRacesJPChangesRow
+	for rows.Next() {
+		var i ListRacesJPChangesRow
+		if err := rows.Scan(
+			&i.ARacejp.Raceid,
+			&i.ARacejp.Eventid,
+			&i.ARacejp.Seasoncode,
+			&i.ARacejp.Racecodex,
+			&i.ARacejp.Disciplineid,
+			&i.ARacejp.Disciplinecode,
+			&i.ARacejp.Catcode,
+			&i.ARacejp.Catcode2,
+			&i.ARacejp.Catcode3,
+			&i.ARacejp.Catcode4,
+			&i.ARacejp.Gender,
+			&i.ARacejp.Racedate,
+			&i.ARacejp.Starteventdate,
+			&i.ARacejp.Description,
+			&i.ARacejp.Place,
+			&i.ARacejp.Nationcode,
+			&i.ARacejp.Td1id,
+			&i.ARacejp.Td1name,
+			&i.ARacejp.Td1nation,
+			&i.ARacejp.Td1code,
+			&i.ARacejp.Td2id,
+			&i.ARacejp.Td2name,
+			&i.ARacejp.Td2nation,
+			&i.ARacejp.Td2code,
+			&i.ARacejp.Calstatuscode,
+			&i.ARacejp.Procstatuscode,
+			&i.ARacejp.Receiveddate,
+			&i.ARacejp.Pursuit,
+			&i.ARacejp.Masse,
+			&i.ARacejp.Relay,
+			&i.ARacejp.Distance,
+			&i.ARacejp.Hill,
+			&i.ARacejp.Style,
+			&i.ARacejp.Qualif,
+			&i.ARacejp.Finale,
+			&i.ARacejp.Homol,
+			&i.ARacejp.Webcomment,
+			&i.ARacejp.Displaystatus,
+			&i.ARacejp.Fisinterncomment,
+			&i.ARacejp.Published,
+			&i.ARacejp.Validforfispoints,
+			&i.ARacejp.Usedfislist,
+			&i.ARacejp.Tolist,
+			&i.ARacejp.Discforlistcode,
+			&i.ARacejp.Calculatedpenalty,
+			&i.ARacejp.Appliedpenalty,
+			&i.ARacejp.Appliedscala,
+			&i.ARacejp.Penscafixed,
+			&i.ARacejp.Version,
+			&i.ARacejp.Nationraceid,
+			&i.ARacejp.Provraceid,
+			&i.ARacejp.Msql7evid,
+			&i.ARacejp.Mssql7id,
+			&i.ARacejp.Results,
+			&i.ARacejp.Pdf,
+			&i.ARacejp.Topbanner,
+			&i.ARacejp.Bottombanner,
+			&i.ARacejp.Toplogo,
+			&i.ARacejp.Bottomlogo,
+			&i.ARacejp.Gallery,
+			&i.ARacejp.Indi,
+			&i.ARacejp.Team,
+			&i.ARacejp.Tabcount,
+			&i.ARacejp.Columncount,
+			&i.ARacejp.Level,
+			&i.ARacejp.Hloc1,
+			&i.ARacejp.Hloc2,
+			&i.ARacejp.Hloc3,
+			&i.ARacejp.Hcet1,
+			&i.ARacejp.Hcet2,
+			&i.ARacejp.Hcet3,
+			&i.ARacejp.Live,
+			&i.ARacejp.Livestatus1,
+			&i.ARacejp.Livestatus2,
+			&i.ARacejp.Livestatus3,
+			&i.ARacejp.Liveinfo1,
+			&i.ARacejp.Liveinfo2,
+			&i.ARacejp.Liveinfo3,
+			&i.ARacejp.Passwd,
+			&i.ARacejp.Timinglogo,
+			&i.ARacejp.Validdate,
+			&i.ARacejp.Noepr,
+			&i.ARacejp.Tddoc,
+			&i.ARacejp.Timingreport,
+			&i.ARacejp.SpecialCupPoints,
+			&i.ARacejp.SkipWcsl,
+			&i.ARacejp.Lastupdate,
+			&i.ARacejp.Validforowg,
+			&i.WrittenAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRacesNKChanges = `-- name: ListRacesNKChanges :many
+SELECT t.raceid, t.eventid, t.seasoncode, t.racecodex, t.disciplineid, t.disciplinecode, t.catcode, t.catcode2, t.catcode3, t.catcode4, t.gender, t.racedate, t.starteventdate, t.description, t.place, t.nationcode, t.td1id, t.td1name, t.td1nation, t.td1code, t.td2id, t.td2name, t.td2nation, t.td2code, t.calstatuscode, t.procstatuscode, t.receiveddate, t.pursuit, t.masse, t.relay, t.distance, t.hill, t.style, t.qualif, t.finale, t.homol, t.webcomment, t.displaystatus, t.fisinterncomment, t.published, t.validforfispoints, t.usedfislist, t.tolist, t.discforlistcode, t.calculatedpenalty, t.appliedpenalty, t.appliedscala, t.penscafixed, t.version, t.nationraceid, t.provraceid, t.msql7evid, t.mssql7id, t.results, t.pdf, t.topbanner, t.bottombanner, t.toplogo, t.bottomlogo, t.gallery, t.indi, t.team, t.tabcount, t.columncount, t.level, t.hloc1, t.hloc2, t.hloc3, t.hcet1, t.hcet2, t.hcet3, t.live, t.livestatus1, t.livestatus2, t.livestatus3, t.liveinfo1, t.liveinfo2, t.liveinfo3, t.passwd, t.timinglogo, t.validdate, t.noepr, t.tddoc, t.timingreport, t.special_cup_points, t.skip_wcsl, t.validforowg, t.lastupdate, w.written_at
+FROM public.fis_writes w
+JOIN public.a_racenk t ON t.raceid = w.id
+WHERE w.entity = 'racenk'
+  AND w.written_at >= $1::timestamp
+  AND ($2::timestamp IS NULL
+       OR (w.written_at, w.id) > ($2::timestamp, $3::int))
+ORDER BY w.written_at, w.id
+LIMIT $4::int
+`
+
+type ListRacesNKChangesParams struct {
+	Since     time.Time
+	AfterTime sql.NullTime
+	AfterID   sql.NullInt32
+	PageLimit int32
+}
+
+type ListRacesNKChangesRow struct {
+	ARacenk   ARacenk
+	WrittenAt time.Time
+}
+
+func (q *Queries) ListRacesNKChanges(ctx context.Context, arg ListRacesNKChangesParams) ([]ListRacesNKChangesRow, error) {
+	rows, err := q.query(ctx, q.listRacesNKChangesStmt, listRacesNKChanges,
+		arg.Since,
+		arg.AfterTime,
+		arg.AfterID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRacesNKChangesRow
+	for rows.Next() {
+		var i ListRacesNKChangesRow
+		if err := rows.Scan(
+			&i.ARacenk.Raceid,
+			&i.ARacenk.Eventid,
+			&i.ARacenk.Seasoncode,
+			&i.ARacenk.Racecodex,
+			&i.ARacenk.Disciplineid,
+			&i.ARacenk.Disciplinecode,
+			&i.ARacenk.Catcode,
+			&i.ARacenk.Catcode2,
+			&i.ARacenk.Catcode3,
+			&i.ARacenk.Catcode4,
+			&i.ARacenk.Gender,
+			&i.ARacenk.Racedate,
+			&i.ARacenk.Starteventdate,
+			&i.ARacenk.Description,
+			&i.ARacenk.Place,
+			&i.ARacenk.Nationcode,
+			&i.ARacenk.Td1id,
+			&i.ARacenk.Td1name,
+			&i.ARacenk.Td1nation,
+			&i.ARacenk.Td1code,
+			&i.ARacenk.Td2id,
+			&i.ARacenk.Td2name,
+			&i.ARacenk.Td2nation,
+			&i.ARacenk.Td2code,
+			&i.ARacenk.Calstatuscode,
+			&i.ARacenk.Procstatuscode,
+			&i.ARacenk.Receiveddate,
+			&i.ARacenk.Pursuit,
+			&i.ARacenk.Masse,
+			&i.ARacenk.Relay,
+			&i.ARacenk.Distance,
+			&i.ARacenk.Hill,
+			&i.ARacenk.Style,
+			&i.ARacenk.Qualif,
+			&i.ARacenk.Finale,
+			&i.ARacenk.Homol,
+			&i.ARacenk.Webcomment,
+			&i.ARacenk.Displaystatus,
+			&i.ARacenk.Fisinterncomment,
+			&i.ARacenk.Published,
+			&i.ARacenk.Validforfispoints,
+			&i.ARacenk.Usedfislist,
+			&i.ARacenk.Tolist,
+			&i.ARacenk.Discforlistcode,
+			&i.ARacenk.Calculatedpenalty,
+			&i.ARacenk.Appliedpenalty,
+			&i.ARacenk.Appliedscala,
+			&i.ARacenk.Penscafixed,
+			&i.ARacenk.Version,
+			&i.ARacenk.Nationraceid,
+			&i.ARacenk.Provraceid,
+			&i.ARacenk.Msql7evid,
+			&i.ARacenk.Mssql7id,
+			&i.ARacenk.Results,
+			&i.ARacenk.Pdf,
+			&i.ARacenk.Topbanner,
+			&i.ARacenk.Bottombanner,
+			&i.ARacenk.Toplogo,
+			&i.ARacenk.Bottomlogo,
+			&i.ARacenk.Gallery,
+			&i.ARacenk.Indi,
+			&i.ARacenk.Team,
+			&i.ARacenk.Tabcount,
+			&i.ARacenk.Columncount,
+			&i.ARacenk.Level,
+			&i.ARacenk.Hloc1,
+			&i.ARacenk.Hloc2,
+			&i.ARacenk.Hloc3,
+			&i.ARacenk.Hcet1,
+			&i.ARacenk.Hcet2,
+			&i.ARacenk.Hcet3,
+			&i.ARacenk.Live,
+			&i.ARacenk.Livestatus1,
+			&i.ARacenk.Livestatus2,
+			&i.ARacenk.Livestatus3,
+			&i.ARacenk.Liveinfo1,
+			&i.ARacenk.Liveinfo2,
+			&i.ARacenk.Liveinfo3,
+			&i.ARacenk.Passwd,
+			&i.ARacenk.Timinglogo,
+			&i.ARacenk.Validdate,
+			&i.ARacenk.Noepr,
+			&i.ARacenk.Tddoc,
+			&i.ARacenk.Timingreport,
+			&i.ARacenk.SpecialCupPoints,
+			&i.ARacenk.SkipWcsl,
+			&i.ARacenk.Validforowg,
+			&i.ARacenk.Lastupdate,
+			&i.WrittenAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listResultsCCChanges = `-- name: ListResultsCCChanges :many
+SELECT t.recid, t.raceid, t.competitorid, t.status, t.reason, t.position, t.pf, t.status2, t.bib, t.bibcolor, t.fiscode, t.competitorname, t.nationcode, t.stage, t.level, t.heat, t.timer1, t.timer2, t.timer3, t.timetot, t.valid, t.racepoints, t.cuppoints, t.bonustime, t.bonuscuppoints, t.version, t.rg1, t.rg2, t.lastupdate, w.written_at
+FROM public.fis_writes w
+JOIN public.a_resultcc t ON t.recid = w.id
+WHERE w.entity = 'resultcc'
+  AND w.written_at >= $1::timestamp
+  AND ($2::timestamp IS NULL
+       OR (w.written_at, w.id) > ($2::timestamp, $3::int))
+ORDER BY w.written_at, w.id
+LIMIT $4::int
+`
+
+type ListResultsCCChangesParams struct {
+	Since     time.Time
+	AfterTime sql.NullTime
+	AfterID   sql.NullInt32
+	PageLimit int32
+}
+
+type ListResultsCCChangesRow struct {
+	AResultcc AResultcc
+	WrittenAt time.Time
+}
+
+func (q *Queries) ListResultsCCChanges(ctx context.Context, arg ListResultsCCChangesParams) ([]ListResultsCCChangesRow, error) {
+	rows, err := q.query(ctx, q.listResultsCCChangesStmt, listResultsCCChanges,
+		arg.Since,
+		arg.AfterTime,
+		arg.AfterID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListResultsCCChangesRow
+	for rows.Next() {
+		var i ListResultsCCChangesRow
+		if err := rows.Scan(
+			&i.AResultcc.Recid,
+			&i.AResultcc.Raceid,
+			&i.AResultcc.Competitorid,
+			&i.AResultcc.Status,
+			&i.AResultcc.Reason,
+			&i.AResultcc.Position,
+			&i.AResultcc.Pf,
+			&i.AResultcc.Status2,
+			&i.AResultcc.Bib,
+			&i.AResultcc.Bibcolor,
+			&i.AResultcc.Fiscode,
+			&i.AResultcc.Competitorname,
+			&i.AResultcc.Nationcode,
+			&i.AResultcc.Stage,
+			&i.AResultcc.Level,
+			&i.AResultcc.Heat,
+			&i.AResultcc.Timer1,
+			&i.AResultcc.Timer2,
+			&i.AResultcc.Timer3,
+			&i.AResultcc.Timetot,
+			&i.AResultcc.Valid,
+			&i.AResultcc.Racepoints,
+			&i.AResultcc.Cuppoints,
+			&i.AResultcc.Bonustime,
+			&i.AResultcc.Bonuscuppoints,
+			&i.AResultcc.Version,
+			&i.AResultcc.Rg1,
+			&i.AResultcc.Rg2,
+			&i.AResultcc.Lastupdate,
+			&i.WrittenAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listResultsJPChanges = `-- name: ListResultsJPChanges :many
+SELECT t.recid, t.raceid, t.competitorid, t.status, t.status2, t.position, t.bib, t.fiscode, t.competitorname, t.nationcode, t.level, t.heat, t.stage, t.j1r1, t.j2r1, t.j3r1, t.j4r1, t.j5r1, t.speedr1, t.distr1, t.disptsr1, t.judptsr1, t.totrun1, t.posr1, t.statusr1, t.j1r2, t.j2r2, t.j3r2, t.j4r2, t.j5r2, t.speedr2, t.distr2, t.disptsr2, t.judptsr2, t.totrun2, t.posr2, t.statusr2, t.j1r3, t.j2r3, t.j3r3, t.j4r3, t.j5r3, t.speedr3, t.distr3, t.disptsr3, t.judptsr3, t.totrun3, t.posr3, t.statusr3, t.j1r4, t.j2r4, t.j3r4, t.j4r4, t.j5r4, t.speedr4, t.distr4, t.disptsr4, t.judptsr4, t.gater1, t.gater2, t.gater3, t.gater4, t.gateptsr1, t.gateptsr2, t.gateptsr3, t.gateptsr4, t.windr1, t.windr2, t.windr3, t.windr4, t.windptsr1, t.windptsr2, t.windptsr3, t.windptsr4, t.reason, t.totrun4, t.tot, t.valid, t.racepoints, t.cuppoints, t.version, t.lastupdate, t.posr4, t.statusr4, w.written_at
+FROM public.fis_writes w
+JOIN public.a_resultjp t ON t.recid = w.id
+WHERE w.entity = 'resultjp'
+  AND w.written_at >= $1::timestamp
+  AND ($2::timestamp IS NULL
+       OR (w.written_at, w.id) > ($2::timestamp, $3::int))
+ORDER BY w.written_at, w.id
+LIMIT $4::int
+`
+
+type ListResultsJPChangesParams struct {
+	Since     time.Time
+	AfterTime sql.NullTime
+	AfterID   sql.NullInt32
+	PageLimit int32
+}
+
+type ListResultsJPChangesRow struct {
+	AResultjp AResultjp
+	WrittenAt time.Time
+}
+
+func (q *Queries) ListResultsJPChanges(ctx context.Context, arg ListResultsJPChangesParams) ([]ListResultsJPChangesRow, error) {
+	rows, err := q.query(ctx, q.listResultsJPChangesStmt, listResultsJPChanges,
+		arg.Since,
+		arg.AfterTime,
+		arg.AfterID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListResultsJPChangesRow
+	for rows.Next() {
+		var i ListResultsJPChangesRow
+		if err := rows.Scan(
+			&i.AResultjp.Recid,
+			&i.AResultjp.Raceid,
+			&i.AResultjp.Competitorid,
+			&i.AResultjp.Status,
+			&i.AResultjp.Status2,
+			&i.AResultjp.Position,
+			&i.AResultjp.Bib,
+			&i.AResultjp.Fiscode,
+			&i.AResultjp.Competitorname,
+			&i.AResultjp.Nationcode,
+			&i.AResultjp.Level,
+			&i.AResultjp.Heat,
+			&i.AResultjp.Stage,
+			&i.AResultjp.J1r1,
+			&i.AResultjp.J2r1,
+			&i.AResultjp.J3r1,
+			&i.AResultjp.J4r1,
+			&i.AResultjp.J5r1,
+			&i.AResultjp.Speedr1,
+			&i.AResultjp.Distr1,
+			&i.AResultjp.Disptsr1,
+			&i.AResultjp.Judptsr1,
+			&i.AResultjp.Totrun1,
+			&i.AResultjp.Posr1,
+			&i.AResultjp.Statusr1,
+			&i.AResultjp.J1r2,
+			&i.AResultjp.J2r2,
+			&i.AResultjp.J3r2,
+			&i.AResultjp.J4r2,
+			&i.AResultjp.J5r2,
+			&i.AResultjp.Speedr2,
+			&i.AResultjp.Distr2,
+			&i.AResultjp.Disptsr2,
+			&i.AResultjp.Judptsr2,
+			&i.AResultjp.Totrun2,
+			&i.AResultjp.Posr2,
+			&i.AResultjp.Statusr2,
+			&i.AResultjp.J1r3,
+			&i.AResultjp.J2r3,
+			&i.AResultjp.J3r3,
+			&i.AResultjp.J4r3,
+			&i.AResultjp.J5r3,
+			&i.AResultjp.Speedr3,
+			&i.AResultjp.Distr3,
+			&i.AResultjp.Disptsr3,
+			&i.AResultjp.Judptsr3,
+			&i.AResultjp.Totrun3,
+			&i.AResultjp.Posr3,
+			&i.AResultjp.Statusr3,
+			&i.AResultjp.J1r4,
+			&i.AResultjp.J2r4,
+			&i.AResultjp.J3r4,
+			&i.AResultjp.J4r4,
+			&i.AResultjp.J5r4,
+			&i.AResultjp.Speedr4,
+			&i.AResultjp.Distr4,
+			&i.AResultjp.Disptsr4,
+			&i.AResultjp.Judptsr4,
+			&i.AResultjp.Gater1,
+			&i.AResultjp.Gater2,
+			&i.AResultjp.Gater3,
+			&i.AResultjp.Gater4,
+			&i.AResultjp.Gateptsr1,
+			&i.AResultjp.Gateptsr2,
+			&i.AResultjp.Gateptsr3,
+			&i.AResultjp.Gateptsr4,
+			&i.AResultjp.Windr1,
+			&i.AResultjp.Windr2,
+			&i.AResultjp.Windr3,
+			&i.AResultjp.Windr4,
+			&i.AResultjp.Windptsr1,
+			&i.AResultjp.Windptsr2,
+			&i.AResultjp.Windptsr3,
+			&i.AResultjp.Windptsr4,
+			&i.AResultjp.Reason,
+			&i.AResultjp.Totrun4,
+			&i.AResultjp.Tot,
+			&i.AResultjp.Valid,
+			&i.AResultjp.Racepoints,
+			&i.AResultjp.Cuppoints,
+			&i.AResultjp.Version,
+			&i.AResultjp.Lastupdate,
+			&i.AResultjp.Posr4,
+			&i.AResultjp.Statusr4,
+			&i.WrittenAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listResultsNKChanges = `-- name: ListResultsNKChanges :many
+SELECT t.recid, t.raceid, t.competitorid, t.status, t.reason, t.position, t.pf, t.status2, t.bib, t.bibcolor, t.fiscode, t.competitorname, t.nationcode, t.level, t.heat, t.stage, t.j1r1, t.j2r1, t.j3r1, t.j4r1, t.j5r1, t.speedr1, t.distr1, t.disptsr1, t.judptsr1, t.gater1, t.gateptsr1, t.windr1, t.windptsr1, t.totrun1, t.posr1, t.statusr1, t.j1r2, t.j2r2, t.j3r2, t.j4r2, t.j5r2, t.speedr2, t.distr2, t.disptsr2, t.judptsr2, t.gater2, t.gateptsr2, t.windr2, t.windptsr2, t.totrun2, t.posr2, t.statusr2, t.pointsjump, t.behindjump, t.posjump, t.timecc, t.timeccint, t.poscc, t.starttime, t.statuscc, t.totbehind, t.timetot, t.timetotint, t.valid, t.racepoints, t.cuppoints, t.version, t.lastupdate, w.written_at
+FROM public.fis_writes w
+JOIN public.a_resultnk t ON t.recid = w.id
+WHERE w.entity = 'resultnk'
+  AND w.written_at >= $1::timestamp
+  AND ($2::timestamp IS NULL
+       OR (w.written_at, w.id) > ($2::timestamp, $3::int))
+ORDER BY w.written_at, w.id
+LIMIT $4::int
+`
+
+type ListResultsNKChangesParams struct {
+	Since     time.Time
+	AfterTime sql.NullTime
+	AfterID   sql.NullInt32
+	PageLimit int32
+}
+
+type ListResultsNKChangesRow struct {
+	AResultnk AResultnk
+	WrittenAt time.Time
+}
+
+func (q *Queries) ListResultsNKChanges(ctx context.Context, arg ListResultsNKChangesParams) ([]ListResultsNKChangesRow, error) {
+	rows, err := q.query(ctx, q.listResultsNKChangesStmt, listResultsNKChanges,
+		arg.Since,
+		arg.AfterTime,
+		arg.AfterID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListResultsNKChangesRow
+	for rows.Next() {
+		var i ListResultsNKChangesRow
+		if err := rows.Scan(
+			&i.AResultnk.Recid,
+			&i.AResultnk.Raceid,
+			&i.AResultnk.Competitorid,
+			&i.AResultnk.Status,
+			&i.AResultnk.Reason,
+			&i.AResultnk.Position,
+			&i.AResultnk.Pf,
+			&i.AResultnk.Status2,
+			&i.AResultnk.Bib,
+			&i.AResultnk.Bibcolor,
+			&i.AResultnk.Fiscode,
+			&i.AResultnk.Competitorname,
+			&i.AResultnk.Nationcode,
+			&i.AResultnk.Level,
+			&i.AResultnk.Heat,
+			&i.AResultnk.Stage,
+			&i.AResultnk.J1r1,
+			&i.AResultnk.J2r1,
+			&i.AResultnk.J3r1,
+			&i.AResultnk.J4r1,
+			&i.AResultnk.J5r1,
+			&i.AResultnk.Speedr1,
+			&i.AResultnk.Distr1,
+			&i.AResultnk.Disptsr1,
+			&i.AResultnk.Judptsr1,
+			&i.AResultnk.Gater1,
+			&i.AResultnk.Gateptsr1,
+			&i.AResultnk.Windr1,
+			&i.AResultnk.Windptsr1,
+			&i.AResultnk.Totrun1,
+			&i.AResultnk.Posr1,
+			&i.AResultnk.Statusr1,
+			&i.AResultnk.J1r2,
+			&i.AResultnk.J2r2,
+			&i.AResultnk.J3r2,
+			&i.AResultnk.J4r2,
+			&i.AResultnk.J5r2,
+			&i.AResultnk.Speedr2,
+			&i.AResultnk.Distr2,
+			&i.AResultnk.Disptsr2,
+			&i.AResultnk.Judptsr2,
+			&i.AResultnk.Gater2,
+			&i.AResultnk.Gateptsr2,
+			&i.AResultnk.Windr2,
+			&i.AResultnk.Windptsr2,
+			&i.AResultnk.Totrun2,
+			&i.AResultnk.Posr2,
+			&i.AResultnk.Statusr2,
+			&i.AResultnk.Pointsjump,
+			&i.AResultnk.Behindjump,
+			&i.AResultnk.Posjump,
+			&i.AResultnk.Timecc,
+			&i.AResultnk.Timeccint,
+			&i.AResultnk.Poscc,
+			&i.AResultnk.Starttime,
+			&i.AResultnk.Statuscc,
+			&i.AResultnk.Totbehind,
+			&i.AResultnk.Timetot,
+			&i.AResultnk.Timetotint,
+			&i.AResultnk.Valid,
+			&i.AResultnk.Racepoints,
+			&i.AResultnk.Cuppoints,
+			&i.AResultnk.Version,
+			&i.AResultnk.Lastupdate,
+			&i.WrittenAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTombstones = `-- name: ListTombstones :many
+SELECT entity, id, deleted_at
+FROM public.fis_tombstones
+WHERE entity = $1
+  AND deleted_at >= $2::timestamp
+  AND ($3::timestamp IS NULL
+       OR (deleted_at, id) > ($3::timestamp, $4::int)
+       OR ((deleted_at, id) = ($3::timestamp, $4::int)
+           AND NOT $5::bool))
+ORDER BY deleted_at, id
+LIMIT $6::int
+`
+
+type ListTombstonesParams struct {
+	Entity      string
+	Since       time.Time
+	AfterTime   sql.NullTime
+	AfterID     sql.NullInt32
+	AfterDelete bool
+	PageLimit   int32
+}
+
+func (q *Queries) ListTombstones(ctx context.Context, arg ListTombstonesParams) ([]FisTombstone, error) {
+	rows, err := q.query(ctx, q.listTombstonesStmt, listTombstones,
+		arg.Entity,
+		arg.Since,
+		arg.AfterTime,
+		arg.AfterID,
+		arg.AfterDelete,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FisTombstone
+	for rows.Next() {
+		var i FisTombstone
+		if err := rows.Scan(&i.Entity, &i.ID, &i.DeletedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCompetitorSeasonStatsCC = `-- name: GetCompetitorSeasonStatsCC :many
+SELECT
+  rcc.seasoncode,
+  GROUPING(rcc.seasoncode) = 1 AS career,
+  COUNT(*) FILTER (WHERE COALESCE(UPPER(res.status), '') NOT LIKE 'DNS%') AS starts,
+  COUNT(*) FILTER (WHERE res.position = 1) AS wins,
+  COUNT(*) FILTER (WHERE res.position BETWEEN 1 AND 3) AS podiums,
+  COUNT(*) FILTER (WHERE res.position BETWEEN 1 AND 10) AS top10,
+  COUNT(*) FILTER (WHERE UPPER(res.status) LIKE 'DNF%') AS dnf,
+  COUNT(*) FILTER (WHERE UPPER(res.status) LIKE 'DSQ%' OR UPPER(res.status) = 'DQ') AS dsq,
+  MIN(NULLIF(res.position, 0))::int AS best_position,
+  ROUND(AVG(res.racepoints), 2)::numeric AS avg_racepoints,
+  SUM(res.cuppoints)::numeric AS cuppoints,
+  ROUND(
+    AVG(res.racepoints) - LAG(AVG(res.racepoints)) OVER (PARTITION BY GROUPING(rcc.seasoncode) ORDER BY rcc.seasoncode),
+    2
+  )::numeric AS racepoints_change
+FROM a_resultcc     AS res
+JOIN a_racecc       AS rcc
+  ON rcc.raceid = res.raceid
+WHERE res.competitorid = $1::int4
+GROUP BY ROLLUP (rcc.seasoncode)
+ORDER BY career, rcc.seasoncode
+`
+
+type GetCompetitorSeasonStatsCCRow struct {
+	Seasoncode       sql.NullInt32
+	Career           bool
+	Starts           int64
+	Wins             int64
+	Podiums          int64
+	Top10            int64
+	Dnf              int64
+	Dsq              int64
+	BestPosition     sql.NullInt32
+	AvgRacepoints    sql.NullString
+	Cuppoints        sql.NullString
+	RacepointsChange sql.NullString
+}
+
+func (q *Queries) GetCompetitorSeasonStatsCC(ctx context.Context, dollar_1 int32) ([]GetCompetitorSeasonStatsCCRow, error) {
+	rows, err := q.query(ctx, q.getCompetitorSeasonStatsCCStmt, getCompetitorSeasonStatsCC, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCompetitorSeasonStatsCCRow
+	for rows.Next() {
+		var i GetCompetitorSeasonStatsCCRow
+		if err := rows.Scan(
+			&i.Seasoncode,
+			&i.Career,
+			&i.Starts,
+			&i.Wins,
+			&i.Podiums,
+			&i.Top10,
+			&i.Dnf,
+			&i.Dsq,
+			&i.BestPosition,
+			&i.AvgRacepoints,
+			&i.Cuppoints,
+			&i.RacepointsChange,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCompetitorBestResultsCC = `-- name: GetCompetitorBestResultsCC :many
+SELECT DISTINCT ON (rcc.disciplinecode, rcc.catcode)
+  rcc.disciplinecode,
+  rcc.catcode,
+  COUNT(*) FILTER (WHERE COALESCE(UPPER(res.status), '') NOT LIKE 'DNS%')
+    OVER (PARTITION BY rcc.disciplinecode, rcc.catcode) AS starts,
+  NULLIF(res.position, 0)::int AS best_position,
+  MIN(res.racepoints) OVER (PARTITION BY rcc.disciplinecode, rcc.catcode)::numeric AS best_racepoints,
+  res.raceid,
+  rcc.racedate,
+  rcc.seasoncode,
+  rcc.place
+FROM a_resultcc     AS res
+JOIN a_racecc       AS rcc
+  ON rcc.raceid = res.raceid
+WHERE res.competitorid = $1::int4
+ORDER BY rcc.disciplinecode, rcc.catcode, NULLIF(res.position, 0) NULLS LAST, rcc.racedate
+`
+
+type GetCompetitorBestResultsCCRow struct {
+	Disciplinecode sql.NullString
+	Catcode        sql.NullString
+	Starts         int64
+	BestPosition   sql.NullInt32
+	BestRacepoints sql.NullString
+	Raceid         sql.NullInt32
+	Racedate       sql.NullTime
+	Seasoncode     sql.NullInt32
+	Place          sql.NullString
+}
+
+func (q *Queries) GetCompetitorBestResultsCC(ctx context.Context, dollar_1 int32) ([]GetCompetitorBestResultsCCRow, error) {
+	rows, err := q.query(ctx, q.getCompetitorBestResultsCCStmt, getCompetitorBestResultsCC, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCompetitorBestResultsCCRow
+	for rows.Next() {
+		var i GetCompetitorBestResultsCCRow
+		if err := rows.Scan(
+			&i.Disciplinecode,
+			&i.Catcode,
+			&i.Starts,
+			&i.BestPosition,
+			&i.BestRacepoints,
+			&i.Raceid,
+			&i.Racedate,
+			&i.Seasoncode,
+			&i.Place,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCompetitorSeasonStatsJP = `-- name: GetCompetitorSeasonStatsJP :many
+SELECT
+  rjp.seasoncode,
+  GROUPING(rjp.seasoncode) = 1 AS career,
+  COUNT(*) FILTER (WHERE COALESCE(UPPER(res.status), '') NOT LIKE 'DNS%') AS starts,
+  COUNT(*) FILTER (WHERE res.position = 1) AS wins,
+  COUNT(*) FILTER (WHERE res.position BETWEEN 1 AND 3) AS podiums,
+  COUNT(*) FILTER (WHERE res.position BETWEEN 1 AND 10) AS top10,
+  COUNT(*) FILTER (WHERE UPPER(res.status) LIKE 'DNF%') AS dnf,
+  COUNT(*) FILTER (WHERE UPPER(res.status) LIKE 'DSQ%' OR UPPER(res.status) = 'DQ') AS dsq,
+  MIN(NULLIF(res.position, 0))::int AS best_position,
+  ROUND(AVG(res.racepoints), 2)::numeric AS avg_racepoints,
+  SUM(res.cuppoints)::numeric AS cuppoints,
+  ROUND(
+    AVG(res.racepoints) - LAG(AVG(res.racepoints)) OVER (PARTITION BY GROUPING(rjp.seasoncode) ORDER BY rjp.seasoncode),
+    2
+  )::numeric AS racepoints_change
+FROM a_resultjp     AS res
+JOIN a_racejp       AS rjp
+  ON rjp.raceid = res.raceid
+WHERE res.competitorid = $1::int4
+GROUP BY ROLLUP (rjp.seasoncode)
+ORDER BY career, rjp.seasoncode
+`
+
+type GetCompetitorSeasonStatsJPRow struct {
+	Seasoncode       sql.NullInt32
+	Career           bool
+	Starts           int64
+	Wins             int64
+	Podiums          int64
+	Top10            int64
+	Dnf              int64
+	Dsq              int64
+	BestPosition     sql.NullInt32
+	AvgRacepoints    sql.NullString
+	Cuppoints        sql.NullString
+	RacepointsChange sql.NullString
+}
+
+func (q *Queries) GetCompetitorSeasonStatsJP(ctx context.Context, dollar_1 int32) ([]GetCompetitorSeasonStatsJPRow, error) {
+	rows, err := q.query(ctx, q.getCompetitorSeasonStatsJPStmt, getCompetitorSeasonStatsJP, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCompetitorSeasonStatsJPRow
+	for rows.Next() {
+		var i GetCompetitorSeasonStatsJPRow
+		if err := rows.Scan(
+			&i.Seasoncode,
+			&i.Career,
+			&i.Starts,
+			&i.Wins,
+			&i.Podiums,
+			&i.Top10,
+			&i.Dnf,
+			&i.Dsq,
+			&i.BestPosition,
+			&i.AvgRacepoints,
+			&i.Cuppoints,
+			&i.RacepointsChange,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCompetitorBestResultsJP = `-- name: GetCompetitorBestResultsJP :many
+SELECT DISTINCT ON (rjp.disciplinecode, rjp.catcode)
+  rjp.disciplinecode,
+  rjp.catcode,
+  COUNT(*) FILTER (WHERE COALESCE(UPPER(res.status), '') NOT LIKE 'DNS%')
+    OVER (PARTITION BY rjp.disciplinecode, rjp.catcode) AS starts,
+  NULLIF(res.position, 0)::int AS best_position,
+  MIN(res.racepoints) OVER (PARTITION BY rjp.disciplinecode, rjp.catcode)::numeric AS best_racepoints,
+  res.raceid,
+  rjp.racedate,
+  rjp.seasoncode,
+  rjp.place
+FROM a_resultjp     AS res
+JOIN a_racejp       AS rjp
+  ON rjp.raceid = res.raceid
+WHERE res.competitorid = $1::int4
+ORDER BY rjp.disciplinecode, rjp.catcode, NULLIF(res.position, 0) NULLS LAST, rjp.racedate
+`
+
+type GetCompetitorBestResultsJPRow struct {
+	Disciplinecode sql.NullString
+	Catcode        sql.NullString
+	Starts         int64
+	BestPosition   sql.NullInt32
+	BestRacepoints sql.NullString
+	Raceid         sql.NullInt32
+	Racedate       sql.NullTime
+	Seasoncode     sql.NullInt32
+	Place          sql.NullString
+}
+
+func (q *Queries) GetCompetitorBestResultsJP(ctx context.Context, dollar_1 int32) ([]GetCompetitorBestResultsJPRow, error) {
+	rows, err := q.query(ctx, q.getCompetitorBestResultsJPStmt, getCompetitorBestResultsJP, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCompetitorBestResultsJPRow
+	for rows.Next() {
+		var i GetCompetitorBestResultsJPRow
+		if err := rows.Scan(
+			&i.Disciplinecode,
+			&i.Catcode,
+			&i.Starts,
+			&i.BestPosition,
+			&i.BestRacepoints,
+			&i.Raceid,
+			&i.Racedate,
+			&i.Seasoncode,
+			&i.Place,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCompetitorSeasonStatsNK = `-- name: GetCompetitorSeasonStatsNK :many
+SELECT
+  rnk.seasoncode,
+  GROUPING(rnk.seasoncode) = 1 AS career,
+  COUNT(*) FILTER (WHERE COALESCE(UPPER(res.status), '') NOT LIKE 'DNS%') AS starts,
+  COUNT(*) FILTER (WHERE res.position = 1) AS wins,
+  COUNT(*) FILTER (WHERE res.position BETWEEN 1 AND 3) AS podiums,
+  COUNT(*) FILTER (WHERE res.position BETWEEN 1 AND 10) AS top10,
+  COUNT(*) FILTER (WHERE UPPER(res.status) LIKE 'DNF%') AS dnf,
+  COUNT(*) FILTER (WHERE UPPER(res.status) LIKE 'DSQ%' OR UPPER(res.status) = 'DQ') AS dsq,
+  MIN(NULLIF(res.position, 0))::int AS best_position,
+  ROUND(AVG(res.racepoints), 2)::numeric AS avg_racepoints,
+  SUM(res.cuppoints)::numeric AS cuppoints,
+  ROUND(
+    AVG(res.racepoints) - LAG(AVG(res.racepoints)) OVER (PARTITION BY GROUPING(rnk.seasoncode) ORDER BY rnk.seasoncode),
+    2
+  )::numeric AS racepoints_change
+FROM a_resultnk     AS res
+JOIN a_racenk       AS rnk
+  ON rnk.raceid = res.raceid
+WHERE res.competitorid = $1::int4
+GROUP BY ROLLUP (rnk.seasoncode)
+ORDER BY career, rnk.seasoncode
+`
+
+type GetCompetitorSeasonStatsNKRow struct {
+	Seasoncode       sql.NullInt32
+	Career           bool
+	Starts           int64
+	Wins             int64
+	Podiums          int64
+	Top10            int64
+	Dnf              int64
+	Dsq              int64
+	BestPosition     sql.NullInt32
+	AvgRacepoints    sql.NullString
+	Cuppoints        sql.NullString
+	RacepointsChange sql.NullString
+}
+
+func (q *Queries) GetCompetitorSeasonStatsNK(ctx context.Context, dollar_1 int32) ([]GetCompetitorSeasonStatsNKRow, error) {
+	rows, err := q.query(ctx, q.getCompetitorSeasonStatsNKStmt, getCompetitorSeasonStatsNK, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCompetitorSeasonStatsNKRow
+	for rows.Next() {
+		var i GetCompetitorSeasonStatsNKRow
+		if err := rows.Scan(
+			&i.Seasoncode,
+			&i.Career,
+			&i.Starts,
+			&i.Wins,
+			&i.Podiums,
+			&i.Top10,
+			&i.Dnf,
+			&i.Dsq,
+			&i.BestPosition,
+			&i.AvgRacepoints,
+			&i.Cuppoints,
+			&i.RacepointsChange,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCompetitorBestResultsNK = `-- name: GetCompetitorBestResultsNK :many
+SELECT DISTINCT ON (rnk.disciplinecode, rnk.catcode)
+  rnk.disciplinecode,
+  rnk.catcode,
+  COUNT(*) FILTER (WHERE COALESCE(UPPER(res.status), '') NOT LIKE 'DNS%')
+    OVER (PARTITION BY rnk.disciplinecode, rnk.catcode) AS starts,
+  NULLIF(res.position, 0)::int AS best_position,
+  MIN(res.racepoints) OVER (PARTITION BY rnk.disciplinecode, rnk.catcode)::numeric AS best_racepoints,
+  res.raceid,
+  rnk.racedate,
+  rnk.seasoncode,
+  rnk.place
+FROM a_resultnk     AS res
+JOIN a_racenk       AS rnk
+  ON rnk.raceid = res.raceid
+WHERE res.competitorid = $1::int4
+ORDER BY rnk.disciplinecode, rnk.catcode, NULLIF(res.position, 0) NULLS LAST, rnk.racedate
+`
+
+type GetCompetitorBestResultsNKRow struct {
+	Disciplinecode sql.NullString
+	Catcode        sql.NullString
+	Starts         int64
+	BestPosition   sql.NullInt32
+	BestRacepoints sql.NullString
+	Raceid         sql.NullInt32
+	Racedate       sql.NullTime
+	Seasoncode     sql.NullInt32
+	Place          sql.NullString
+}
+
+func (q *Queries) GetCompetitorBestResultsNK(ctx context.Context, dollar_1 int32) ([]GetCompetitorBestResultsNKRow, error) {
+	rows, err := q.query(ctx, q.getCompetitorBestResultsNKStmt, getCompetitorBestResultsNK, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCompetitorBestResultsNKRow
+	for rows.Next() {
+		var i GetCompetitorBestResultsNKRow
+		if err := rows.Scan(
+			&i.Disciplinecode,
+			&i.Catcode,
+			&i.Starts,
+			&i.BestPosition,
+			&i.BestRacepoints,
+			&i.Raceid,
+			&i.Racedate,
+			&i.Seasoncode,
+			&i.Place,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getHeadToHeadCC = `-- name: GetHeadToHeadCC :many
+WITH res AS (
+  SELECT r.raceid, r.fiscode, r.competitorname, r.nationcode, r.status, r."position", r.timetot AS score, r.racepoints
+  FROM a_resultcc AS r
+  JOIN a_racecc   AS rcc
+    ON rcc.raceid = r.raceid
+  WHERE r.fiscode = ANY($1::int[])
+    AND ($2::int[]  IS NULL OR rcc.seasoncode     = ANY($2))
+    AND ($3::text[] IS NULL OR rcc.disciplinecode = ANY($3))
+    AND ($4::text[] IS NULL OR rcc.catcode        = ANY($4))
+),
+shared AS (
+  SELECT raceid
+  FROM res
+  GROUP BY raceid
+  HAVING COUNT(DISTINCT fiscode) >= 2
+)
+SELECT
+  rcc.raceid,
+  rcc.racedate,
+  rcc.seasoncode,
+  rcc.disciplinecode,
+  rcc.catcode,
+  rcc.place,
+  rcc.nationcode,
+  res.fiscode,
+  res.competitorname,
+  res.nationcode AS competitornation,
+  res.status,
+  NULLIF(res."position", 0)::int AS position,
+  res.score::text AS score,
+  res.racepoints
+FROM res
+JOIN shared    ON shared.raceid = res.raceid
+JOIN a_racecc AS rcc
+  ON rcc.raceid = res.raceid
+ORDER BY rcc.racedate DESC NULLS LAST, rcc.raceid, NULLIF(res."position", 0) NULLS LAST, res.fiscode
+`
+
+type GetHeadToHeadCCParams struct {
+	Column1 []int32
+	Column2 []int32
+	Column3 []string
+	Column4 []string
+}
+
+type GetHeadToHeadCCRow struct {
+	Raceid           int32
+	Racedate         sql.NullTime
+	Seasoncode       sql.NullInt32
+	Disciplinecode   sql.NullString
+	Catcode          sql.NullString
+	Place            sql.NullString
+	Nationcode       sql.NullString
+	Fiscode          sql.NullInt32
+	Competitorname   sql.NullString
+	Competitornation sql.NullString
+	Status           sql.NullString
+	Position         sql.NullInt32
+	Score            sql.NullString
+	Racepoints       sql.NullString
+}
+
+func (q *Queries) GetHeadToHeadCC(ctx context.Context, arg GetHeadToHeadCCParams) ([]GetHeadToHeadCCRow, error) {
+	rows, err := q.query(ctx, q.getHeadToHeadCCStmt, getHeadToHeadCC,
+		pq.Array(arg.Column1),
+		pq.Array(arg.Column2),
+		pq.Array(arg.Column3),
+		pq.Array(arg.Column4),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetHeadToHeadCCRow
+	for rows.Next() {
+		var i GetHeadToHeadCCRow
+		if err := rows.Scan(
+			&i.Raceid,
+			&i.Racedate,
+			&i.Seasoncode,
+			&i.Disciplinecode,
+			&i.Catcode,
+			&i.Place,
+			&i.Nationcode,
+			&i.Fiscode,
+			&i.Competitorname,
+			&i.Competitornation,
+			&i.Status,
+			&i.Position,
+			&i.Score,
+			&i.Racepoints,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getHeadToHeadJP = `-- name: GetHeadToHeadJP :many
+WITH res AS (
+  SELECT r.raceid, r.fiscode, r.competitorname, r.nationcode, r.status, r."position", r.tot AS score, r.racepoints
+  FROM a_resultjp AS r
+  JOIN a_racejp   AS rjp
+    ON rjp.raceid = r.raceid
+  WHERE r.fiscode = ANY($1::int[])
+    AND ($2::int[]  IS NULL OR rjp.seasoncode     = ANY($2))
+    AND ($3::text[] IS NULL OR rjp.disciplinecode = ANY($3))
+    AND ($4::text[] IS NULL OR rjp.catcode        = ANY($4))
+),
+shared AS (
+  SELECT raceid
+  FROM res
+  GROUP BY raceid
+  HAVING COUNT(DISTINCT fiscode) >= 2
+)
+SELECT
+  rjp.raceid,
+  rjp.racedate,
+  rjp.seasoncode,
+  rjp.disciplinecode,
+  rjp.catcode,
+  rjp.place,
+  rjp.nationcode,
+  res.fiscode,
+  res.competitorname,
+  res.nationcode AS competitornation,
+  res.status,
+  NULLIF(res."position", 0)::int AS position,
+  res.score::text AS score,
+  res.racepoints
+FROM res
+JOIN shared    ON shared.raceid = res.raceid
+JOIN a_racejp AS rjp
+  ON rjp.raceid = res.raceid
+ORDER BY rjp.racedate DESC NULLS LAST, rjp.raceid, NULLIF(res."position", 0) NULLS LAST, res.fiscode
+`
+
+type GetHeadToHeadJPParams struct {
+	Column1 []int32
+	Column2 []int32
+	Column3 []string
+	Column4 []string
+}
+
+type GetHeadToHeadJPRow struct {
+	Raceid           int32
+	Racedate         sql.NullTime
+	Seasoncode       sql.NullInt32
+	Disciplinecode   sql.NullString
+	Catcode          sql.NullString
+	Place            sql.NullString
+	Nationcode       sql.NullString
+	Fiscode          sql.NullInt32
+	Competitorname   sql.NullString
+	Competitornation sql.NullString
+	Status           sql.NullString
+	Position         sql.NullInt32
+	Score            sql.NullString
+	Racepoints       sql.NullString
+}
+
+func (q *Queries) GetHeadToHeadJP(ctx context.Context, arg GetHeadToHeadJPParams) ([]GetHeadToHeadJPRow, error) {
+	rows, err := q.query(ctx, q.getHeadToHeadJPStmt, getHeadToHeadJP,
+		pq.Array(arg.Column1),
+		pq.Array(arg.Column2),
+		pq.Array(arg.Column3),
+		pq.Array(arg.Column4),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetHeadToHeadJPRow
+	for rows.Next() {
+		var i GetHeadToHeadJPRow
+		if err := rows.Scan(
+			&i.Raceid,
+			&i.Racedate,
+			&i.Seasoncode,
+			&i.Disciplinecode,
+			&i.Catcode,
+			&i.Place,
+			&i.Nationcode,
+			&i.Fiscode,
+			&i.Competitorname,
+			&i.Competitornation,
+			&i.Status,
+			&i.Position,
+			&i.Score,
+			&i.Racepoints,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getHeadToHeadNK = `-- name: GetHeadToHeadNK :many
+WITH res AS (
+  SELECT r.raceid, r.fiscode, r.competitorname, r.nationcode, r.status, r."position", r.timetot AS score, r.racepoints
+  FROM a_resultnk AS r
+  JOIN a_racenk   AS rnk
+    ON rnk.raceid = r.raceid
+  WHERE r.fiscode = ANY($1::int[])
+    AND ($2::int[]  IS NULL OR rnk.seasoncode     = ANY($2))
+    AND ($3::text[] IS NULL OR rnk.disciplinecode = ANY($3))
+    AND ($4::text[] IS NULL OR rnk.catcode        = ANY($4))
+),
+shared AS (
+  SELECT raceid
+  FROM res
+  GROUP BY raceid
+  HAVING COUNT(DISTINCT fiscode) >= 2
+)
+SELECT
+  rnk.raceid,
+  rnk.racedate,
+  rnk.seasoncode,
+  rnk.disciplinecode,
+  rnk.catcode,
+  rnk.place,
+  rnk.nationcode,
+  res.fiscode,
+  res.competitorname,
+  res.nationcode AS competitornation,
+  res.status,
+  NULLIF(res."position", 0)::int AS position,
+  res.score::text AS score,
+  res.racepoints
+FROM res
+JOIN shared    ON shared.raceid = res.raceid
+JOIN a_racenk AS rnk
+  ON rnk.raceid = res.raceid
+ORDER BY rnk.racedate DESC NULLS LAST, rnk.raceid, NULLIF(res."position", 0) NULLS LAST, res.fiscode
+`
+
+type GetHeadToHeadNKParams struct {
+	Column1 []int32
+	Column2 []int32
+	Column3 []string
+	Column4 []string
+}
+
+type GetHeadToHeadNKRow struct {
+	Raceid           int32
+	Racedate         sql.NullTime
+	Seasoncode       sql.NullInt32
+	Disciplinecode   sql.NullString
+	Catcode          sql.NullString
+	Place            sql.NullString
+	Nationcode       sql.NullString
+	Fiscode          sql.NullInt32
+	Competitorname   sql.NullString
+	Competitornation sql.NullString
+	Status           sql.NullString
+	Position         sql.NullInt32
+	Score            sql.NullString
+	Racepoints       sql.NullString
+}
+
+func (q *Queries) GetHeadToHeadNK(ctx context.Context, arg GetHeadToHeadNKParams) ([]GetHeadToHeadNKRow, error) {
+	rows, err := q.query(ctx, q.getHeadToHeadNKStmt, getHeadToHeadNK,
+		pq.Array(arg.Column1),
+		pq.Array(arg.Column2),
+		pq.Array(arg.Column3),
+		pq.Array(arg.Column4),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetHeadToHeadNKRow
+	for rows.Next() {
+		var i GetHeadToHeadNKRow
+		if err := rows.Scan(
+			&i.Raceid,
+			&i.Racedate,
+			&i.Seasoncode,
+			&i.Disciplinecode,
+			&i.Catcode,
+			&i.Place,
+			&i.Nationcode,
+			&i.Fiscode,
+			&i.Competitorname,
+			&i.Competitornation,
+			&i.Status,
+			&i.Position,
+			&i.Score,
+			&i.Racepoints,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getFISPointsListCC = `-- name: GetFISPointsListCC :many
+WITH lists AS (
+  SELECT d::date AS list_date
+  FROM generate_series($1::date, $2::date, make_interval(months => $3::int)) AS d
+),
+scored AS (
+  SELECT
+    l.list_date,
+    res.fiscode,
+    res.competitorname,
+    res.nationcode,
+    COALESCE(NULLIF(rcc.discforlistcode, ''), rcc.disciplinecode) AS disciplinecode,
+    rcc.gender,
+    res.racepoints + pen.penalty AS points,
+    ROW_NUMBER() OVER (
+      PARTITION BY l.list_date, res.fiscode, COALESCE(NULLIF(rcc.discforlistcode, ''), rcc.disciplinecode), rcc.gender
+      ORDER BY res.racepoints + pen.penalty, rcc.racedate DESC
+    ) AS n
+  FROM lists       AS l
+  JOIN a_racecc   AS rcc
+    ON rcc.racedate >  l.list_date - make_interval(months => $4::int)
+   AND rcc.racedate <= l.list_date
+  CROSS JOIN LATERAL (
+    SELECT COALESCE(
+      CASE WHEN replace(trim(rcc.appliedpenalty), ',', '.') ~ '^-?[0-9]+(\.[0-9]+)?$' THEN replace(trim(rcc.appliedpenalty), ',', '.')::numeric END,
+      CASE WHEN replace(trim(rcc.calculatedpenalty), ',', '.') ~ '^-?[0-9]+(\.[0-9]+)?$' THEN replace(trim(rcc.calculatedpenalty), ',', '.')::numeric END
+    ) AS penalty
+  ) AS pen
+  JOIN a_resultcc AS res
+    ON res.raceid = rcc.raceid
+  WHERE rcc.validforfispoints = 1
+    AND NULLIF(trim(rcc.usedfislist), '') IS NOT NULL
+    AND pen.penalty IS NOT NULL
+    AND res.fiscode IS NOT NULL
+    AND res.racepoints IS NOT NULL
+    AND ($5::text[] IS NULL OR COALESCE(NULLIF(rcc.discforlistcode, ''), rcc.disciplinecode) = ANY($5))
+    AND ($6::text = '' OR rcc.gender = $6::text)
+),
+standings AS (
+  SELECT
+    list_date,
+    fiscode,
+    MAX(competitorname) AS competitorname,
+    MAX(nationcode)     AS nationcode,
+    disciplinecode,
+    gender,
+    COUNT(*)            AS results,
+    AVG(points)         AS points,
+    RANK() OVER (PARTITION BY list_date, disciplinecode, gender ORDER BY AVG(points)) AS rank,
+    RANK() OVER (PARTITION BY list_date, disciplinecode, gender, MAX(nationcode) ORDER BY AVG(points)) AS nation_rank
+  FROM scored
+  WHERE n <= $7::int
+  GROUP BY list_date, fiscode, disciplinecode, gender
+  HAVING COUNT(*) >= $8::int
+)
+SELECT
+  list_date,
+  fiscode,
+  competitorname,
+  nationcode,
+  disciplinecode,
+  gender,
+  results,
+  ROUND(points, 2)::numeric AS points,
+  rank,
+  nation_rank
+FROM standings
+WHERE ($9::int = 0 OR fiscode = $9::int)
+  AND ($10::text = '' OR nationcode = $10::text)
+  AND ($11::int = 0 OR CASE WHEN $10::text = '' THEN rank ELSE nation_rank END <= $11::int)
+ORDER BY list_date, disciplinecode, gender, rank, fiscode
+`
+
+type GetFISPointsListCCParams struct {
+	Column1  time.Time
+	Column2  time.Time
+	Column3  int32
+	Column4  int32
+	Column5  []string
+	Column6  string
+	Column7  int32
+	Column8  int32
+	Column9  int32
+	Column10 string
+	Column11 int32
+}
+
+type GetFISPointsListCCRow struct {
+	ListDate       time.Time
+	Fiscode        sql.NullInt32
+	Competitorname sql.NullString
+	Nationcode     sql.NullString
+	Disciplinecode sql.NullString
+	Gender         sql.NullString
+	Results        int64
+	Points         string
+	Rank           int64
+	NationRank     int64
+}
+
+func (q *Queries) GetFISPointsListCC(ctx context.Context, arg GetFISPointsListCCParams) ([]GetFISPointsListCCRow, error) {
+	rows, err := q.query(ctx, q.getFISPointsListCCStmt, getFISPointsListCC,
+		arg.Column1,
+		arg.Column2,
+		arg.Column3,
+		arg.Column4,
+		pq.Array(arg.Column5),
+		arg.Column6,
+		arg.Column7,
+		arg.Column8,
+		arg.Column9,
+		arg.Column10,
+		arg.Column11,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetFISPointsListCCRow
+	for rows.Next() {
+		var i GetFISPointsListCCRow
+		if err := rows.Scan(
+			&i.ListDate,
+			&i.Fiscode,
+			&i.Competitorname,
+			&i.Nationcode,
+			&i.Disciplinecode,
+			&i.Gender,
+			&i.Results,
+			&i.Points,
+			&i.Rank,
+			&i.NationRank,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getFISPointsListNK = `-- name: GetFISPointsListNK :many
+WITH lists AS (
+  SELECT d::date AS list_date
+  FROM generate_series($1::date, $2::date, make_interval(months => $3::int)) AS d
+),
+scored AS (
+  SELECT
+    l.list_date,
+    res.fiscode,
+    res.competitorname,
+    res.nationcode,
+    COALESCE(NULLIF(rnk.discforlistcode, ''), rnk.disciplinecode) AS disciplinecode,
+    rnk.gender,
+    res.racepoints + pen.penalty AS points,
+    ROW_NUMBER() OVER (
+      PARTITION BY l.list_date, res.fiscode, COALESCE(NULLIF(rnk.discforlistcode, ''), rnk.disciplinecode), rnk.gender
+      ORDER BY res.racepoints + pen.penalty, rnk.racedate DESC
+    ) AS n
+  FROM lists       AS l
+  JOIN a_racenk   AS rnk
+    ON rnk.racedate >  l.list_date - make_interval(months => $4::int)
+   AND rnk.racedate <= l.list_date
+  CROSS JOIN LATERAL (
+    SELECT COALESCE(
+      CASE WHEN replace(trim(rnk.appliedpenalty), ',', '.') ~ '^-?[0-9]+(\.[0-9]+)?$' THEN replace(trim(rnk.appliedpenalty), ',', '.')::numeric END,
+      CASE WHEN replace(trim(rnk.calculatedpenalty), ',', '.') ~ '^-?[0-9]+(\.[0-9]+)?$' THEN replace(trim(rnk.calculatedpenalty), ',', '.')::numeric END
+    ) AS penalty
+  ) AS pen
+  JOIN a_resultnk AS res
+    ON res.raceid = rnk.raceid
+  WHERE rnk.validforfispoints = 1
+    AND NULLIF(trim(rnk.usedfislist), '') IS NOT NULL
+    AND pen.penalty IS NOT NULL
+    AND res.fiscode IS NOT NULL
+    AND res.racepoints IS NOT NULL
+    AND ($5::text[] IS NULL OR COALESCE(NULLIF(rnk.discforlistcode, ''), rnk.disciplinecode) = ANY($5))
+    AND ($6::text = '' OR rnk.gender = $6::text)
+),
+standings AS (
+  SELECT
+    list_date,
+    fiscode,
+    MAX(competitorname) AS competitorname,
+    MAX(nationcode)     AS nationcode,
+    disciplinecode,
+    gender,
+    COUNT(*)            AS results,
+    AVG(points)         AS points,
+    RANK() OVER (PARTITION BY list_date, disciplinecode, gender ORDER BY AVG(points)) AS rank,
+    RANK() OVER (PARTITION BY list_date, disciplinecode, gender, MAX(nationcode) ORDER BY AVG(points)) AS nation_rank
+  FROM scored
+  WHERE n <= $7::int
+  GROUP BY list_date, fiscode, disciplinecode, gender
+  HAVING COUNT(*) >= $8::int
+)
+SELECT
+  list_date,
+  fiscode,
+  competitorname,
+  nationcode,
+  disciplinecode,
+  gender,
+  results,
+  ROUND(points, 2)::numeric AS points,
+  rank,
+  nation_rank
+FROM standings
+WHERE ($9::int = 0 OR fiscode = $9::int)
+  AND ($10::text = '' OR nationcode = $10::text)
+  AND ($11::int = 0 OR CASE WHEN $10::text = '' THEN rank ELSE nation_rank END <= $11::int)
+ORDER BY list_date, disciplinecode, gender, rank, fiscode
+`
+
+type GetFISPointsListNKParams struct {
+	Column1  time.Time
+	Column2  time.Time
+	Column3  int32
+	Column4  int32
+	Column5  []string
+	Column6  string
+	Column7  int32
+	Column8  int32
+	Column9  int32
+	Column10 string
+	Column11 int32
+}
+
+type GetFISPointsListNKRow struct {
+	ListDate       time.Time
+	Fiscode        sql.NullInt32
+	Competitorname sql.NullString
+	Nationcode     sql.NullString
+	Disciplinecode sql.NullString
+	Gender         sql.NullString
+	Results        int64
+	Points         string
+	Rank           int64
+	NationRank     int64
+}
+
+func (q *Queries) GetFISPointsListNK(ctx context.Context, arg GetFISPointsListNKParams) ([]GetFISPointsListNKRow, error) {
+	rows, err := q.query(ctx, q.getFISPointsListNKStmt, getFISPointsListNK,
+		arg.Column1,
+		arg.Column2,
+		arg.Column3,
+		arg.Column4,
+		pq.Array(arg.Column5),
+		arg.Column6,
+		arg.Column7,
+		arg.Column8,
+		arg.Column9,
+		arg.Column10,
+		arg.Column11,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetFISPointsListNKRow
+	for rows.Next() {
+		var i GetFISPointsListNKRow
+		if err := rows.Scan(
+			&i.ListDate,
+			&i.Fiscode,
+			&i.Competitorname,
+			&i.Nationcode,
+			&i.Disciplinecode,
+			&i.Gender,
+			&i.Results,
+			&i.Points,
+			&i.Rank,
+			&i.NationRank,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getEnrichedRaceResultsCC = `-- name: GetEnrichedRaceResultsCC :many
+SELECT res.recid, res.raceid, res.competitorid, res.status, res.reason, res.position, res.pf, res.status2, res.bib, res.bibcolor, res.fiscode, res.competitorname, res.nationcode, res.stage, res.level, res.heat, res.timer1, res.timer2, res.timer3, res.timetot, res.valid, res.racepoints, res.cuppoints, res.bonustime, res.bonuscuppoints, res.version, res.rg1, res.rg2, res.lastupdate, c.birthdate, c.skiclub, c.nationcode AS competitor_nationcode
+FROM public.a_resultcc AS res
+LEFT JOIN public.a_competitor AS c ON c.competitorid = res.competitorid
+WHERE res.raceid = $1::int4
+ORDER BY res.position NULLS LAST, res.recid
+`
+
+type GetEnrichedRaceResultsCCRow struct {
+	AResultcc            AResultcc
+	Birthdate            sql.NullTime
+	Skiclub              sql.NullString
+	CompetitorNationcode sql.NullString
+}
+
+func (q *Queries) GetEnrichedRaceResultsCC(ctx context.Context, dollar_1 int32) ([]GetEnrichedRaceResultsCCRow, error) {
+	rows, err := q.query(ctx, q.getEnrichedRaceResultsCCStmt, getEnrichedRaceResultsCC, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetEnrichedRaceResultsCCRow
+	for rows.Next() {
+		var i GetEnrichedRaceResultsCCRow
+		if err := rows.Scan(
+			&i.AResultcc.Recid,
+			&i.AResultcc.Raceid,
+			&i.AResultcc.Competitorid,
+			&i.AResultcc.Status,
+			&i.AResultcc.Reason,
+			&i.AResultcc.Position,
+			&i.AResultcc.Pf,
+			&i.AResultcc.Status2,
+			&i.AResultcc.Bib,
+			&i.AResultcc.Bibcolor,
+			&i.AResultcc.Fiscode,
+			&i.AResultcc.Competitorname,
+			&i.AResultcc.Nationcode,
+			&i.AResultcc.Stage,
+			&i.AResultcc.Level,
+			&i.AResultcc.Heat,
+			&i.AResultcc.Timer1,
+			&i.AResultcc.Timer2,
+			&i.AResultcc.Timer3,
+			&i.AResultcc.Timetot,
+			&i.AResultcc.Valid,
+			&i.AResultcc.Racepoints,
+			&i.AResultcc.Cuppoints,
+			&i.AResultcc.Bonustime,
+			&i.AResultcc.Bonuscuppoints,
+			&i.AResultcc.Version,
+			&i.AResultcc.Rg1,
+			&i.AResultcc.Rg2,
+			&i.AResultcc.Lastupdate,
+			&i.Birthdate,
+			&i.Skiclub,
+			&i.CompetitorNationcode,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getEnrichedRaceResultsJP = `-- name: GetEnrichedRaceResultsJP :many
+SELECT res.recid, res.raceid, res.competitorid, res.status, res.status2, res.position, res.bib, res.fiscode, res.competitorname, res.nationcode, res.level, res.heat, res.stage, res.j1r1, res.j2r1, res.j3r1, res.j4r1, res.j5r1, res.speedr1, res.distr1, res.disptsr1, res.judptsr1, res.totrun1, res.posr1, res.statusr1, res.j1r2, res.j2r2, res.j3r2, res.j4r2, res.j5r2, res.speedr2, res.distr2, res.disptsr2, res.judptsr2, res.totrun2, res.posr2, res.statusr2, res.j1r3, res.j2r3, res.j3r3, res.j4r3, res.j5r3, res.speedr3, res.distr3, res.disptsr3, res.judptsr3, res.totrun3, res.posr3, res.statusr3, res.j1r4, res.j2r4, res.j3r4, res.j4r4, res.j5r4, res.speedr4, res.distr4, res.disptsr4, res.judptsr4, res.gater1, res.gater2, res.gater3, res.gater4, res.gateptsr1, res.gateptsr2, res.gateptsr3, res.gateptsr4, res.windr1, res.windr2, res.windr3, res.windr4, res.windptsr1, res.windptsr2, res.windptsr3, res.windptsr4, res.reason, res.totrun4, res.tot, res.valid, res.racepoints, res.cuppoints, res.version, res.lastupdate, res.posr4, res.statusr4, c.birthdate, c.skiclub, c.nationcode AS competitor_nationcode
+FROM public.a_resultjp AS res
+LEFT JOIN public.a_competitor AS c ON c.competitorid = res.competitorid
+WHERE res.raceid = $1::int4
+ORDER BY res.position NULLS LAST, res.recid
+`
+
+type GetEnrichedRaceResultsJPRow struct {
+	AResultjp            AResultjp
+	Birthdate            sql.NullTime
+	Skiclub              sql.NullString
+	CompetitorNationcode sql.NullString
+}
+
+func (q *Queries) GetEnrichedRaceResultsJP(ctx context.Context, dollar_1 int32) ([]GetEnrichedRaceResultsJPRow, error) {
+	rows, err := q.query(ctx, q.getEnrichedRaceResultsJPStmt, getEnrichedRaceResultsJP, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetEnrichedRaceResultsJPRow
+	for rows.Next() {
+		var i GetEnrichedRaceResultsJPRow
+		if err := rows.Scan(
+			&i.AResultjp.Recid,
+			&i.AResultjp.Raceid,
+			&i.AResultjp.Competitorid,
+			&i.AResultjp.Status,
+			&i.AResultjp.Status2,
+			&i.AResultjp.Position,
+			&i.AResultjp.Bib,
+			&i.AResultjp.Fiscode,
+			&i.AResultjp.Competitorname,
+			&i.AResultjp.Nationcode,
+			&i.AResultjp.Level,
+			&i.AResultjp.Heat,
+			&i.AResultjp.Stage,
+			&i.AResultjp.J1r1,
+			&i.AResultjp.J2r1,
+			&i.AResultjp.J3r1,
+			&i.AResultjp.J4r1,
+			&i.AResultjp.J5r1,
+			&i.AResultjp.Speedr1,
+			&i.AResultjp.Distr1,
+			&i.AResultjp.Disptsr1,
+			&i.AResultjp.Judptsr1,
+			&i.AResultjp.Totrun1,
+			&i.AResultjp.Posr1,
+			&i.AResultjp.Statusr1,
+			&i.AResultjp.J1r2,
+			&i.AResultjp.J2r2,
+			&i.AResultjp.J3r2,
+			&i.AResultjp.J4r2,
+			&i.AResultjp.J5r2,
+			&i.AResultjp.Speedr2,
+			&i.AResultjp.Distr2,
+			&i.AResultjp.Disptsr2,
+			&i.AResultjp.Judptsr2,
+			&i.AResultjp.Totrun2,
+			&i.AResultjp.Posr2,
+			&i.AResultjp.Statusr2,
+			&i.AResultjp.J1r3,
+			&i.AResultjp.J2r3,
+			&i.AResultjp.J3r3,
+			&i.AResultjp.J4r3,
+			&i.AResultjp.J5r3,
+			&i.AResultjp.Speedr3,
+			&i.AResultjp.Distr3,
+			&i.AResultjp.Disptsr3,
+			&i.AResultjp.Judptsr3,
+			&i.AResultjp.Totrun3,
+			&i.AResultjp.Posr3,
+			&i.AResultjp.Statusr3,
+			&i.AResultjp.J1r4,
+			&i.AResultjp.J2r4,
+			&i.AResultjp.J3r4,
+			&i.AResultjp.J4r4,
+			&i.AResultjp.J5r4,
+			&i.AResultjp.Speedr4,
+			&i.AResultjp.Distr4,
+			&i.AResultjp.Disptsr4,
+			&i.AResultjp.Judptsr4,
+			&i.AResultjp.Gater1,
+			&i.AResultjp.Gater2,
+			&i.AResultjp.Gater3,
+			&i.AResultjp.Gater4,
+			&i.AResultjp.Gateptsr1,
+			&i.AResultjp.Gateptsr2,
+			&i.AResultjp.Gateptsr3,
+			&i.AResultjp.Gateptsr4,
+			&i.AResultjp.Windr1,
+			&i.AResultjp.Windr2,
+			&i.AResultjp.Windr3,
+			&i.AResultjp.Windr4,
+			&i.AResultjp.Windptsr1,
+			&i.AResultjp.Windptsr2,
+			&i.AResultjp.Windptsr3,
+			&i.AResultjp.Windptsr4,
+			&i.AResultjp.Reason,
+			&i.AResultjp.Totrun4,
+			&i.AResultjp.Tot,
+			&i.AResultjp.Valid,
+			&i.AResultjp.Racepoints,
+			&i.AResultjp.Cuppoints,
+			&i.AResultjp.Version,
+			&i.AResultjp.Lastupdate,
+			&i.AResultjp.Posr4,
+			&i.AResultjp.Statusr4,
+			&i.Birthdate,
+			&i.Skiclub,
+			&i.CompetitorNationcode,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getEnrichedRaceResultsNK = `-- name: GetEnrichedRaceResultsNK :many
+SELECT res.recid, res.raceid, res.competitorid, res.status, res.reason, res.position, res.pf, res.status2, res.bib, res.bibcolor, res.fiscode, res.competitorname, res.nationcode, res.level, res.heat, res.stage, res.j1r1, res.j2r1, res.j3r1, res.j4r1, res.j5r1, res.speedr1, res.distr1, res.disptsr1, res.judptsr1, res.gater1, res.gateptsr1, res.windr1, res.windptsr1, res.totrun1, res.posr1, res.statusr1, res.j1r2, res.j2r2, res.j3r2, res.j4r2, res.j5r2, res.speedr2, res.distr2, res.disptsr2, res.judptsr2, res.gater2, res.gateptsr2, res.windr2, res.windptsr2, res.totrun2, res.posr2, res.statusr2, res.pointsjump, res.behindjump, res.posjump, res.timecc, res.timeccint, res.poscc, res.starttime, res.statuscc, res.totbehind, res.timetot, res.timetotint, res.valid, res.racepoints, res.cuppoints, res.version, res.lastupdate, c.birthdate, c.skiclub, c.nationcode AS competitor_nationcode
+FROM public.a_resultnk AS res
+LEFT JOIN public.a_competitor AS c ON c.competitorid = res.competitorid
+WHERE res.raceid = $1::int4
+ORDER BY res.position NULLS LAST, res.recid
+`
+
+type GetEnrichedRaceResultsNKRow struct {
+	AResultnk            AResultnk
+	Birthdate            sql.NullTime
+	Skiclub              sql.NullString
+	CompetitorNationcode sql.NullString
+}
+
+func (q *Queries) GetEnrichedRaceResultsNK(ctx context.Context, dollar_1 int32) ([]GetEnrichedRaceResultsNKRow, error) {
+	rows, err := q.query(ctx, q.getEnrichedRaceResultsNKStmt, getEnrichedRaceResultsNK, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetEnrichedRaceResultsNKRow
+	for rows.Next() {
+		var i GetEnrichedRaceResultsNKRow
+		if err := rows.Scan(
+			&i.AResultnk.Recid,
+			&i.AResultnk.Raceid,
+			&i.AResultnk.Competitorid,
+			&i.AResultnk.Status,
+			&i.AResultnk.Reason,
+			&i.AResultnk.Position,
+			&i.AResultnk.Pf,
+			&i.AResultnk.Status2,
+			&i.AResultnk.Bib,
+			&i.AResultnk.Bibcolor,
+			&i.AResultnk.Fiscode,
+			&i.AResultnk.Competitorname,
+			&i.AResultnk.Nationcode,
+			&i.AResultnk.Level,
+			&i.AResultnk.Heat,
+			&i.AResultnk.Stage,
+			&i.AResultnk.J1r1,
+			&i.AResultnk.J2r1,
+			&i.AResultnk.J3r1,
+			&i.AResultnk.J4r1,
+			&i.AResultnk.J5r1,
+			&i.AResultnk.Speedr1,
+			&i.AResultnk.Distr1,
+			&i.AResultnk.Disptsr1,
+			&i.AResultnk.Judptsr1,
+			&i.AResultnk.Gater1,
+			&i.AResultnk.Gateptsr1,
+			&i.AResultnk.Windr1,
+			&i.AResultnk.Windptsr1,
+			&i.AResultnk.Totrun1,
+			&i.AResultnk.Posr1,
+			&i.AResultnk.Statusr1,
+			&i.AResultnk.J1r2,
+			&i.AResultnk.J2r2,
+			&i.AResultnk.J3r2,
+			&i.AResultnk.J4r2,
+			&i.AResultnk.J5r2,
+			&i.AResultnk.Speedr2,
+			&i.AResultnk.Distr2,
+			&i.AResultnk.Disptsr2,
+			&i.AResultnk.Judptsr2,
+			&i.AResultnk.Gater2,
+			&i.AResultnk.Gateptsr2,
+			&i.AResultnk.Windr2,
+			&i.AResultnk.Windptsr2,
+			&i.AResultnk.Totrun2,
+			&i.AResultnk.Posr2,
+			&i.AResultnk.Statusr2,
+			&i.AResultnk.Pointsjump,
+			&i.AResultnk.Behindjump,
+			&i.AResultnk.Posjump,
+			&i.AResultnk.Timecc,
+			&i.AResultnk.Timeccint,
+			&i.AResultnk.Poscc,
+			&i.AResultnk.Starttime,
+			&i.AResultnk.Statuscc,
+			&i.AResultnk.Totbehind,
+			&i.AResultnk.Timetot,
+			&i.AResultnk.Timetotint,
+			&i.AResultnk.Valid,
+			&i.AResultnk.Racepoints,
+			&i.AResultnk.Cuppoints,
+			&i.AResultnk.Version,
+			&i.AResultnk.Lastupdate,
+			&i.Birthdate,
+			&i.Skiclub,
+			&i.CompetitorNationcode,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCalendarRaces = `-- name: GetCalendarRaces :many
+WITH picked AS (
+  SELECT sector, raceid, eventid, seasoncode, racedate, starteventdate, disciplinecode, catcode, gender, description, place, nationcode, calstatuscode, lastupdate
+  FROM (
+    SELECT 'cc'::text AS sector, raceid, eventid, seasoncode, racedate, starteventdate, disciplinecode, catcode, gender, description, place, nationcode, calstatuscode, lastupdate
+    FROM public.a_racecc
+    UNION ALL
+    SELECT 'jp'::text AS sector, raceid, eventid, seasoncode, racedate, starteventdate, disciplinecode, catcode, gender, description, place, nationcode, calstatuscode, lastupdate
+    FROM public.a_racejp
+    UNION ALL
+    SELECT 'nk'::text AS sector, raceid, eventid, seasoncode, racedate, starteventdate, disciplinecode, catcode, gender, description, place, nationcode, calstatuscode, lastupdate
+    FROM public.a_racenk
+  ) AS races
+  WHERE racedate BETWEEN $1::date AND $2::date
+    AND ($3::text[] IS NULL OR sector     = ANY($3::text[]))
+    AND ($4::text[] IS NULL OR nationcode = ANY($4::text[]))
+    AND ($5::text[]    IS NULL OR catcode    = ANY($5::text[]))
+    AND ($6::text[] IS NULL OR gender     = ANY($6::text[]))
+), event_bounds AS (
+  SELECT eventid, MIN(racedate) AS event_start, MAX(racedate) AS event_end
+  FROM (
+    SELECT eventid, racedate FROM public.a_racecc
+    UNION ALL
+    SELECT eventid, racedate FROM public.a_racejp
+    UNION ALL
+    SELECT eventid, racedate FROM public.a_racenk
+  ) AS races
+  WHERE eventid IN (SELECT eventid FROM picked)
+  GROUP BY eventid
+)
+SELECT p.sector, p.raceid, p.eventid, p.seasoncode, p.racedate, p.starteventdate, p.disciplinecode, p.catcode, p.gender, p.description, p.place, p.nationcode, p.calstatuscode, p.lastupdate,
+  COALESCE(b.event_start, p.racedate) AS event_start,
+  COALESCE(b.event_end, p.racedate) AS event_end
+FROM picked AS p
+LEFT JOIN event_bounds AS b ON b.eventid = p.eventid
+ORDER BY p.racedate, p.eventid, p.sector, p.raceid
+`
+
+type GetCalendarRacesParams struct {
+	DateFrom time.Time
+	DateTo   time.Time
+	Sectors  []string
+	Nations  []string
+	Cats     []string
+	Genders  []string
+}
+
+type GetCalendarRacesRow struct {
+	Sector         string
+	Raceid         int32
+	Eventid        sql.NullInt32
+	Seasoncode     sql.NullInt32
+	Racedate       sql.NullTime
+	Starteventdate sql.NullTime
+	Disciplinecode sql.NullString
+	Catcode        sql.NullString
+	Gender         sql.NullString
+	Description    sql.NullString
+	Place          sql.NullString
+	Nationcode     sql.NullString
+	Calstatuscode  sql.NullString
+	Lastupdate     sql.NullTime
+	EventStart     sql.NullTime
+	EventEnd       sql.NullTime
+}
+
+func (q *Queries) GetCalendarRaces(ctx context.Context, arg GetCalendarRacesParams) ([]GetCalendarRacesRow, error) {
+	rows, err := q.query(ctx, q.getCalendarRacesStmt, getCalendarRaces,
+		arg.DateFrom,
+		arg.DateTo,
+		pq.Array(arg.Sectors),
+		pq.Array(arg.Nations),
+		pq.Array(arg.Cats),
+		pq.Array(arg.Genders),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCalendarRacesRow
+	for rows.Next() {
+		var i GetCalendarRacesRow
+		if err := rows.Scan(
+			&i.Sector,
+			&i.Raceid,
+			&i.Eventid,
+			&i.Seasoncode,
+			&i.Racedate,
+			&i.Starteventdate,
+			&i.Disciplinecode,
+			&i.Catcode,
+			&i.Gender,
+			&i.Description,
+			&i.Place,
+			&i.Nationcode,
+			&i.Calstatuscode,
+			&i.Lastupdate,
+			&i.EventStart,
+			&i.EventEnd,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteLinkedAthleteLinkCandidates = `-- name: DeleteLinkedAthleteLinkCandidates :execrows
+DELETE FROM public.fis_athlete_link_candidates AS lc
+WHERE lc.status = 'pending'
+  AND EXISTS (SELECT 1 FROM athlete AS a WHERE a.fiscode = lc.fiscode OR a.sporttiid = lc.sporttiid)
+`
+
+func (q *Queries) DeleteLinkedAthleteLinkCandidates(ctx context.Context) (int64, error) {
+	result, err := q.exec(ctx, q.deleteLinkedAthleteLinkCandidatesStmt, deleteLinkedAthleteLinkCandidates)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getAthleteLinkCandidate = `-- name: GetAthleteLinkCandidate :one
+SELECT id, fiscode, sporttiid, confidence, sources, evidence, status, created_at, updated_at, reviewed_by, reviewed_at
+FROM public.fis_athlete_link_candidates
+WHERE id = $1
+`
+
+func (q *Queries) GetAthleteLinkCandidate(ctx context.Context, id int32) (FisAthleteLinkCandidate, error) {
+	row := q.queryRow(ctx, q.getAthleteLinkCandidateStmt, getAthleteLinkCandidate, id)
+	var i FisAthleteLinkCandidate
+	err := row.Scan(
+		&i.ID,
+		&i.Fiscode,
+		&i.Sporttiid,
+		&i.Confidence,
+		pq.Array(&i.Sources),
+		&i.Evidence,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ReviewedBy,
+		&i.ReviewedAt,
+	)
+	return i, err
+}
+
+const insertAthleteFromCompetitor = `-- name: InsertAthleteFromCompetitor :execrows
+INSERT INTO public.athlete (fiscode, sporttiid, firstname, lastname)
+SELECT c.fiscode, $1::int, LEFT(c.firstname, 50), LEFT(c.lastname, 50)
+FROM a_competitor AS c
+WHERE c.fiscode = $2::int
+ORDER BY c.lastupdate DESC NULLS LAST
+LIMIT 1
+`
+
+type InsertAthleteFromCompetitorParams struct {
+	Sporttiid int32
+	Fiscode   int32
+}
+
+func (q *Queries) InsertAthleteFromCompetitor(ctx context.Context, arg InsertAthleteFromCompetitorParams) (int64, error) {
+	result, err := q.exec(ctx, q.insertAthleteFromCompetitorStmt, insertAthleteFromCompetitor, arg.Sporttiid, arg.Fiscode)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const listAthleteLinkCandidates = `-- name: ListAthleteLinkCandidates :many
+SELECT id, fiscode, sporttiid, confidence, sources, evidence, status, created_at, updated_at, reviewed_by, reviewed_at
+FROM public.fis_athlete_link_candidates
+WHERE ($1::text = '' OR status = $1::text)
+  AND ($2::int IS NULL OR fiscode = $2::int)
+  AND ($3::int IS NULL OR sporttiid = $3::int)
+  AND confidence >= $4::numeric
+  AND ($5::numeric IS NULL
+       OR confidence < $5::numeric
+       OR (confidence = $5::numeric AND id > $6::int))
+ORDER BY confidence DESC, id
+LIMIT $7::int
+`
+
+type ListAthleteLinkCandidatesParams struct {
+	Status          string
+	Fiscode         sql.NullInt32
+	Sporttiid       sql.NullInt32
+	MinConfidence   string
+	AfterConfidence sql.NullString
+	AfterID         sql.NullInt32
+	PageLimit       int32
+}
+
+func (q *Queries) ListAthleteLinkCandidates(ctx context.Context, arg ListAthleteLinkCandidatesParams) ([]FisAthleteLinkCandidate, error) {
+	rows, err := q.query(ctx, q.listAthleteLinkCandidatesStmt, listAthleteLinkCandidates,
+		arg.Status,
+		arg.Fiscode,
+		arg.Sporttiid,
+		arg.MinConfidence,
+		arg.AfterConfidence,
+		arg.AfterID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FisAthleteLinkCandidate
+	for rows.Next() {
+		var i FisAthleteLinkCandidate
+		if err := rows.Scan(
+			&i.ID,
+			&i.Fiscode,
+			&i.Sporttiid,
+			&i.Confidence,
+			pq.Array(&i.Sources),
+			&i.Evidence,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ReviewedBy,
+			&i.ReviewedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnlinkedFinnishCompetitors = `-- name: ListUnlinkedFinnishCompetitors :many
+SELECT DISTINCT ON (c.fiscode) c.fiscode::int AS fiscode, c.firstname, c.lastname, c.gender, c.birthdate, c.sectorcode
+FROM a_competitor AS c
+WHERE c.nationcode = 'FIN'
+  AND c.fiscode IS NOT NULL
+  AND NOT EXISTS (SELECT 1 FROM athlete AS a WHERE a.fiscode = c.fiscode)
+ORDER BY c.fiscode, c.lastupdate DESC NULLS LAST
+`
+
+type ListUnlinkedFinnishCompetitorsRow struct {
+	Fiscode    int32
+	Firstname  sql.NullString
+	Lastname   sql.NullString
+	Gender     sql.NullString
+	Birthdate  sql.NullTime
+	Sectorcode sql.NullString
+}
+
+func (q *Queries) ListUnlinkedFinnishCompetitors(ctx context.Context) ([]ListUnlinkedFinnishCompetitorsRow, error) {
+	rows, err := q.query(ctx, q.listUnlinkedFinnishCompetitorsStmt, listUnlinkedFinnishCompetitors)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUnlinkedFinnishCompetitorsRow
+	for rows.Next() {
+		var i ListUnlinkedFinnishCompetitorsRow
+		if err := rows.Scan(
+			&i.Fiscode,
+			&i.Firstname,
+			&i.Lastname,
+			&i.Gender,
+			&i.Birthdate,
+			&i.Sectorcode,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const rejectCompetingAthleteLinkCandidates = `-- name: RejectCompetingAthleteLinkCandidates :execrows
+UPDATE public.fis_athlete_link_candidates SET
+  status      = 'rejected',
+  reviewed_by = $1::text,
+  reviewed_at = (now() AT TIME ZONE 'UTC'),
+  updated_at  = (now() AT TIME ZONE 'UTC')
+WHERE (fiscode = $2::int OR sporttiid = $3::int)
+  AND id <> $4::int
+  AND status = 'pending'
+`
+
+type RejectCompetingAthleteLinkCandidatesParams struct {
+	ReviewedBy string
+	Fiscode    int32
+	Sporttiid  int32
+	ID         int32
+}
+
+func (q *Queries) RejectCompetingAthleteLinkCandidates(ctx context.Context, arg RejectCompetingAthleteLinkCandidatesParams) (int64, error) {
+	result, err := q.exec(ctx, q.rejectCompetingAthleteLinkCandidatesStmt, rejectCompetingAthleteLinkCandidates, arg.ReviewedBy, arg.Fiscode, arg.Sporttiid, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const reviewAthleteLinkCandidate = `-- name: ReviewAthleteLinkCandidate :one
+UPDATE public.fis_athlete_link_candidates SET
+  status      = $1::text,
+  reviewed_by = $2::text,
+  reviewed_at = (now() AT TIME ZONE 'UTC'),
+  updated_at  = (now() AT TIME ZONE 'UTC')
+WHERE id = $3::int
+  AND status = 'pending'
+RETURNING id, fiscode, sporttiid, confidence, sources, evidence, status, created_at, updated_at, reviewed_by, reviewed_at
+`
+
+type ReviewAthleteLinkCandidateParams struct {
+	Status     string
+	ReviewedBy string
+	ID         int32
+}
+
+func (q *Queries) ReviewAthleteLinkCandidate(ctx context.Context, arg ReviewAthleteLinkCandidateParams) (FisAthleteLinkCandidate, error) {
+	row := q.queryRow(ctx, q.reviewAthleteLinkCandidateStmt, reviewAthleteLinkCandidate, arg.Status, arg.ReviewedBy, arg.ID)
+	var i FisAthleteLinkCandidate
+	err := row.Scan(
+		&i.ID,
+		&i.Fiscode,
+		&i.Sporttiid,
+		&i.Confidence,
+		pq.Array(&i.Sources),
+		&i.Evidence,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ReviewedBy,
+		&i.ReviewedAt,
+	)
+	return i, err
+}
+
+const upsertAthleteLinkCandidate = `-- name: UpsertAthleteLinkCandidate :execrows
+INSERT INTO public.fis_athlete_link_candidates (
+  fiscode,
+  sporttiid,
+  confidence,
+  sources,
+  evidence
+) VALUES (
+  $1::int, $2::int, $3::numeric, $4::text[], $5::jsonb
+)
+ON CONFLICT (fiscode, sporttiid) DO UPDATE SET
+  confidence = EXCLUDED.confidence,
+  sources    = EXCLUDED.sources,
+  evidence   = EXCLUDED.evidence,
+  updated_at = (now() AT TIME ZONE 'UTC')
+WHERE fis_athlete_link_candidates.status = 'pending'
+`
+
+type UpsertAthleteLinkCandidateParams struct {
+	Fiscode    int32
+	Sporttiid  int32
+	Confidence string
+	Sources    []string
+	Evidence   json.RawMessage
+}
+
+func (q *Queries) UpsertAthleteLinkCandidate(ctx context.Context, arg UpsertAthleteLinkCandidateParams) (int64, error) {
+	result, err := q.exec(ctx, q.upsertAthleteLinkCandidateStmt, upsertAthleteLinkCandidate,
+		arg.Fiscode,
+		arg.Sporttiid,
+		arg.Confidence,
+		pq.Array(arg.Sources),
+		arg.Evidence,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const listLinkedSporttiIDs = `-- name: ListLinkedSporttiIDs :many
+SELECT DISTINCT sporttiid::int AS sporttiid
+FROM athlete
+WHERE sporttiid IS NOT NULL
+`
+
+func (q *Queries) ListLinkedSporttiIDs(ctx context.Context) ([]int32, error) {
+	rows, err := q.query(ctx, q.listLinkedSporttiIDsStmt, listLinkedSporttiIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var sporttiid int32
+		if err := rows.Scan(&sporttiid); err != nil {
+			return nil, err
+		}
+		items = append(items, sporttiid)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteStaleAthleteLinkCandidates = `-- name: DeleteStaleAthleteLinkCandidates :execrows
+DELETE FROM public.fis_athlete_link_candidates AS lc
+WHERE lc.status = 'pending'
+  AND NOT EXISTS (
+    SELECT 1
+    FROM unnest($1::int[], $2::int[]) AS c(fiscode, sporttiid)
+    WHERE c.fiscode = lc.fiscode AND c.sporttiid = lc.sporttiid
+  )
+`
+
+type DeleteStaleAthleteLinkCandidatesParams struct {
+	Fiscodes   []int32
+	Sporttiids []int32
+}
+
+func (q *Queries) DeleteStaleAthleteLinkCandidates(ctx context.Context, arg DeleteStaleAthleteLinkCandidatesParams) (int64, error) {
+	result, err := q.exec(ctx, q.deleteStaleAthleteLinkCandidatesStmt, deleteStaleAthleteLinkCandidates, pq.Array(arg.Fiscodes), pq.Array(arg.Sporttiids))
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getChangesHorizon = `-- name: GetChangesHorizon :one
+SELECT (COALESCE(
+  (SELECT MIN(xact_start) FROM pg_stat_activity
+   WHERE datname = current_database() AND backend_type = 'client backend' AND xact_start IS NOT NULL),
+  now()
+) AT TIME ZONE 'UTC')::timestamp AS horizon
+`
+
+func (q *Queries) GetChangesHorizon(ctx context.Context) (time.Time, error) {
+	row := q.queryRow(ctx, q.getChangesHorizonStmt, getChangesHorizon)
+	var horizon time.Time
+	err := row.Scan(&horizon)
+	return horizon, err
+}
@@ -101,10 +101,13 @@ WHERE rNK.CompetitorID = $1
   AND ($2::int[]    IS NULL OR aNK.SeasonCode     = ANY($2))
   AND ($3::text[]   IS NULL OR aNK.DisciplineCode = ANY($3))
   AND ($4::text[]   IS NULL OR aNK.CatCode        = ANY($4))
-ORDER BY aNK.RaceDate;
+  AND (COALESCE(aNK.RaceDate, DATE '9999-12-31'), rNK.RecID) > ($5::date, $6::int)
+ORDER BY COALESCE(aNK.RaceDate, DATE '9999-12-31'), rNK.RecID
+LIMIT $7::int;
 
 -- name: GetAthleteResultsJP :many
 SELECT 
+    rJP.RecID,
     rJP.RaceID,
     rJP.Position,
     aJP.RaceDate,
@@ -134,7 +137,9 @@ WHERE rJP.CompetitorID = $1
   AND ($2::int[]    IS NULL OR aJP.SeasonCode     = ANY($2))
   AND ($3::text[]   IS NULL OR aJP.DisciplineCode = ANY($3))
   AND ($4::text[]   IS NULL OR aJP.CatCode        = ANY($4))
-ORDER BY aJP.RaceDate;
+  AND (COALESCE(aJP.RaceDate, DATE '9999-12-31'), rJP.RecID) > ($5::date, $6::int)
+ORDER BY COALESCE(aJP.RaceDate, DATE '9999-12-31'), rJP.RecID
+LIMIT $7::int;
 
 -- name: GetAthleteResultsCC :many
 SELECT
@@ -154,7 +159,9 @@ WHERE rCC.CompetitorID = $1
   AND ($2::int[]  IS NULL OR aCC.SeasonCode     = ANY($2))
   AND ($3::text[] IS NULL OR aCC.DisciplineCode = ANY($3))
   AND ($4::text[] IS NULL OR aCC.CatCode        = ANY($4))
-ORDER BY aCC.RaceDate;
+  AND (COALESCE(aCC.RaceDate, DATE '9999-12-31'), rCC.RecID) > ($5::date, $6::int)
+ORDER BY COALESCE(aCC.RaceDate, DATE '9999-12-31'), rCC.RecID
+LIMIT $7::int;
 
 -- name: GetRacesNK :many
 SELECT *
@@ -162,7 +169,9 @@ FROM A_raceNK
 WHERE ($1::int[]  IS NULL OR SeasonCode     = ANY($1))
   AND ($2::text[] IS NULL OR DisciplineCode = ANY($2))
   AND ($3::text[] IS NULL OR CatCode        = ANY($3))
-ORDER BY RaceID;
+  AND RaceID > $4::int
+ORDER BY RaceID
+LIMIT $5::int;
 
 -- name: GetRacesJP :many
 SELECT *
@@ -170,7 +179,9 @@ FROM A_raceJP
 WHERE ($1::int[]  IS NULL OR SeasonCode     = ANY($1))
   AND ($2::text[] IS NULL OR DisciplineCode = ANY($2))
   AND ($3::text[] IS NULL OR CatCode        = ANY($3))
-ORDER BY RaceID;
+  AND RaceID > $4::int
+ORDER BY RaceID
+LIMIT $5::int;
 
 -- name: GetRacesCC :many
 SELECT *
@@ -178,9 +189,44 @@ FROM A_raceCC
 WHERE ($1::int[]  IS NULL OR SeasonCode     = ANY($1))
   AND ($2::text[] IS NULL OR DisciplineCode = ANY($2))
   AND ($3::text[] IS NULL OR CatCode        = ANY($3))
-ORDER BY RaceID;
+  AND RaceID > $4::int
+ORDER BY RaceID
+LIMIT $5::int;
 
 
+-- name: ExportResultsCC :many
+SELECT *
+FROM public.a_resultcc
+WHERE raceid IN (
+  SELECT raceid FROM public.a_racecc
+  WHERE seasoncode BETWEEN $1::int AND $2::int
+)
+  AND recid > $3::int
+ORDER BY recid
+LIMIT $4::int;
+
+-- name: ExportResultsJP :many
+SELECT *
+FROM public.a_resultjp
+WHERE raceid IN (
+  SELECT raceid FROM public.a_racejp
+  WHERE seasoncode BETWEEN $1::int AND $2::int
+)
+  AND recid > $3::int
+ORDER BY recid
+LIMIT $4::int;
+
+-- name: ExportResultsNK :many
+SELECT *
+FROM public.a_resultnk
+WHERE raceid IN (
+  SELECT raceid FROM public.a_racenk
+  WHERE seasoncode BETWEEN $1::int AND $2::int
+)
+  AND recid > $3::int
+ORDER BY recid
+LIMIT $4::int;
+
 -- name: GetRaceResultsNKByRaceID :many
 SELECT *
 FROM public.a_resultnk
@@ -1088,14 +1134,31 @@ LIMIT $4::int4;
 
 
 -- name: SearchCompetitors :many
-SELECT *
-FROM a_competitor
-WHERE ($1::text = '' OR nationcode  = $1::text)
-  AND ($2::text = '' OR sectorcode  = $2::text)
-  AND ($3::text = '' OR gender      = $3::text)
-  AND ($4::date = '0001-01-01' OR birthdate >= $4::date)
-  AND ($5::date = '0001-01-01' OR birthdate <= $5::date)
-ORDER BY competitorid;
+SELECT sqlc.embed(c), s.relevance::numeric AS relevance
+FROM a_competitor AS c
+CROSS JOIN LATERAL (
+  SELECT CASE WHEN sqlc.arg(query)::text = '' THEN 0
+    ELSE ROUND(GREATEST(
+      word_similarity(public.fis_unaccent(sqlc.arg(query)::text), public.fis_unaccent(COALESCE(c.firstname, '') || ' ' || COALESCE(c.lastname, ''))),
+      0.8 * word_similarity(public.fis_unaccent(sqlc.arg(query)::text), public.fis_unaccent(COALESCE(c.skiclub, '')))
+    )::numeric, 4)
+  END AS relevance
+) AS s
+WHERE (sqlc.arg(nationcode)::text = '' OR c.nationcode  = sqlc.arg(nationcode)::text)
+  AND (sqlc.arg(sectorcode)::text = '' OR c.sectorcode  = sqlc.arg(sectorcode)::text)
+  AND (sqlc.arg(gender)::text = '' OR c.gender      = sqlc.arg(gender)::text)
+  AND (sqlc.arg(birthdate_min)::date = '0001-01-01' OR c.birthdate >= sqlc.arg(birthdate_min)::date)
+  AND (sqlc.arg(birthdate_max)::date = '0001-01-01' OR c.birthdate <= sqlc.arg(birthdate_max)::date)
+  AND (sqlc.arg(query)::text = ''
+       OR public.fis_unaccent(sqlc.arg(query)::text) OPERATOR(public.<%) public.fis_unaccent(COALESCE(c.firstname, '') || ' ' || COALESCE(c.lastname, ''))
+       OR public.fis_unaccent(sqlc.arg(query)::text) OPERATOR(public.<%) public.fis_unaccent(COALESCE(c.skiclub, '')))
+  AND COALESCE(c.fiscode::text, '') OPERATOR(pg_catalog.~>=~) sqlc.arg(fiscode_from)::text
+  AND COALESCE(c.fiscode::text, '') OPERATOR(pg_catalog.~<~) sqlc.arg(fiscode_to)::text
+  AND (sqlc.narg(after_relevance)::numeric IS NULL
+       OR s.relevance < sqlc.narg(after_relevance)::numeric
+       OR (s.relevance = sqlc.narg(after_relevance)::numeric AND c.competitorid > sqlc.narg(after_id)::int))
+ORDER BY s.relevance DESC, c.competitorid
+LIMIT sqlc.arg(page_limit)::int;
 
 
 -- name: GetRacesByIDsCC :many
@@ -1260,3 +1323,636 @@ SELECT sectorcode
 FROM a_competitor
 WHERE fiscode = $1::int4
 LIMIT 1;
+
+-- name: ListCompetitorsChanges :many
+SELECT sqlc.embed(t), w.written_at
+FROM public.fis_writes w
+JOIN public.a_competitor t ON t.competitorid = w.id
+WHERE w.entity = 'competitor'
+  AND w.written_at >= sqlc.arg(since)::timestamp
+  AND (sqlc.narg(after_time)::timestamp IS NULL
+       OR (w.written_at, w.id) > (sqlc.narg(after_time)::timestamp, sqlc.narg(after_id)::int))
+ORDER BY w.written_at, w.id
+LIMIT sqlc.arg(page_limit)::int;
+
+-- name: ListRacesCCChanges :many
+SELECT sqlc.embed(t), w.written_at
+FROM public.fis_writes w
+JOIN public.a_racecc t ON t.raceid = w.id
+WHERE w.entity = 'racecc'
+  AND w.written_at >= sqlc.arg(since)::timestamp
+  AND (sqlc.narg(after_time)::timestamp IS NULL
+       OR (w.written_at, w.id) > (sqlc.narg(after_time)::timestamp, sqlc.narg(after_id)::int))
+ORDER BY w.written_at, w.id
+LIMIT sqlc.arg(page_limit)::int;
+
+-- name: ListRacesJPChanges :many
+SELECT sqlc.embed(t), w.written_at
+FROM public.fis_writes w
+JOIN public.a_racejp t ON t.raceid = w.id
+WHERE w.entity = 'racejp'
+  AND w.written_at >= sqlc.arg(since)::timestamp
+  AND (sqlc.narg(after_time)::timestamp IS NULL
+       OR (w.written_at, w.id) > (sqlc.narg(after_time)::timestamp, sqlc.narg(after_id)::int))
+ORDER BY w.written_at, w.id
+LIMIT sqlc.arg(page_limit)::int;
+
+-- name: ListRacesNKChanges :many
+SELECT sqlc.embed(t), w.written_at
+FROM public.fis_writes w
+JOIN public.a_racenk t ON t.raceid = w.id
+WHERE w.entity = 'racenk'
+  AND w.written_at >= sqlc.arg(since)::timestamp
+  AND (sqlc.narg(after_time)::timestamp IS NULL
+       OR (w.written_at, w.id) > (sqlc.narg(after_time)::timestamp, sqlc.narg(after_id)::int))
+ORDER BY w.written_at, w.id
+LIMIT sqlc.arg(page_limit)::int;
+
+-- name: ListResultsCCChanges :many
+SELECT sqlc.embed(t), w.written_at
+FROM public.fis_writes w
+JOIN public.a_resultcc t ON t.recid = w.id
+WHERE w.entity = 'resultcc'
+  AND w.written_at >= sqlc.arg(since)::timestamp
+  AND (sqlc.narg(after_time)::timestamp IS NULL
+       OR (w.written_at, w.id) > (sqlc.narg(after_time)::timestamp, sqlc.narg(after_id)::int))
+ORDER BY w.written_at, w.id
+LIMIT sqlc.arg(page_limit)::int;
+
+-- name: ListResultsJPChanges :many
+SELECT sqlc.embed(t), w.written_at
+FROM public.fis_writes w
+JOIN public.a_resultjp t ON t.recid = w.id
+WHERE w.entity = 'resultjp'
+  AND w.written_at >= sqlc.arg(since)::timestamp
+  AND (sqlc.narg(after_time)::timestamp IS NULL
+       OR (w.written_at, w.id) > (sqlc.narg(after_time)::timestamp, sqlc.narg(after_id)::int))
+ORDER BY w.written_at, w.id
+LIMIT sqlc.arg(page_limit)::int;
+
+-- name: ListResultsNKChanges :many
+SELECT sqlc.embed(t), w.written_at
+FROM public.fis_writes w
+JOIN public.a_resultnk t ON t.recid = w.id
+WHERE w.entity = 'resultnk'
+  AND w.written_at >= sqlc.arg(since)::timestamp
+  AND (sqlc.narg(after_time)::timestamp IS NULL
+       OR (w.written_at, w.id) > (sqlc.narg(after_time)::timestamp, sqlc.narg(after_id)::int))
+ORDER BY w.written_at, w.id
+LIMIT sqlc.arg(page_limit)::int;
+
+-- name: ListTombstones :many
+SELECT entity, id, deleted_at
+FROM public.fis_tombstones
+WHERE entity = sqlc.arg(entity)
+  AND deleted_at >= sqlc.arg(since)::timestamp
+  AND (sqlc.narg(after_time)::timestamp IS NULL
+       OR (deleted_at, id) > (sqlc.narg(after_time)::timestamp, sqlc.narg(after_id)::int)
+       OR ((deleted_at, id) = (sqlc.narg(after_time)::timestamp, sqlc.narg(after_id)::int)
+           AND NOT sqlc.arg(after_delete)::bool))
+ORDER BY deleted_at, id
+LIMIT sqlc.arg(page_limit)::int;
+
+-- name: GetChangesHorizon :one
+SELECT (COALESCE(
+  (SELECT MIN(xact_start) FROM pg_stat_activity
+   WHERE datname = current_database() AND backend_type = 'client backend' AND xact_start IS NOT NULL),
+  now()
+) AT TIME ZONE 'UTC')::timestamp AS horizon;
+
+
+-- name: GetCompetitorSeasonStatsCC :many
+SELECT
+  rcc.seasoncode,
+  GROUPING(rcc.seasoncode) = 1 AS career,
+  COUNT(*) FILTER (WHERE COALESCE(UPPER(res.status), '') NOT LIKE 'DNS%') AS starts,
+  COUNT(*) FILTER (WHERE res.position = 1) AS wins,
+  COUNT(*) FILTER (WHERE res.position BETWEEN 1 AND 3) AS podiums,
+  COUNT(*) FILTER (WHERE res.position BETWEEN 1 AND 10) AS top10,
+  COUNT(*) FILTER (WHERE UPPER(res.status) LIKE 'DNF%') AS dnf,
+  COUNT(*) FILTER (WHERE UPPER(res.status) LIKE 'DSQ%' OR UPPER(res.status) = 'DQ') AS dsq,
+  MIN(NULLIF(res.position, 0))::int AS best_position,
+  ROUND(AVG(res.racepoints), 2)::numeric AS avg_racepoints,
+  SUM(res.cuppoints)::numeric AS cuppoints,
+  ROUND(
+    AVG(res.racepoints) - LAG(AVG(res.racepoints)) OVER (PARTITION BY GROUPING(rcc.seasoncode) ORDER BY rcc.seasoncode),
+    2
+  )::numeric AS racepoints_change
+FROM a_resultcc     AS res
+JOIN a_racecc       AS rcc
+  ON rcc.raceid = res.raceid
+WHERE res.competitorid = $1::int4
+GROUP BY ROLLUP (rcc.seasoncode)
+ORDER BY career, rcc.seasoncode;
+
+-- name: GetCompetitorBestResultsCC :many
+SELECT DISTINCT ON (rcc.disciplinecode, rcc.catcode)
+  rcc.disciplinecode,
+  rcc.catcode,
+  COUNT(*) FILTER (WHERE COALESCE(UPPER(res.status), '') NOT LIKE 'DNS%')
+    OVER (PARTITION BY rcc.disciplinecode, rcc.catcode) AS starts,
+  NULLIF(res.position, 0)::int AS best_position,
+  MIN(res.racepoints) OVER (PARTITION BY rcc.disciplinecode, rcc.catcode)::numeric AS best_racepoints,
+  res.raceid,
+  rcc.racedate,
+  rcc.seasoncode,
+  rcc.place
+FROM a_resultcc     AS res
+JOIN a_racecc       AS rcc
+  ON rcc.raceid = res.raceid
+WHERE res.competitorid = $1::int4
+ORDER BY rcc.disciplinecode, rcc.catcode, NULLIF(res.position, 0) NULLS LAST, rcc.racedate;
+
+-- name: GetCompetitorSeasonStatsJP :many
+SELECT
+  rjp.seasoncode,
+  GROUPING(rjp.seasoncode) = 1 AS career,
+  COUNT(*) FILTER (WHERE COALESCE(UPPER(res.status), '') NOT LIKE 'DNS%') AS starts,
+  COUNT(*) FILTER (WHERE res.position = 1) AS wins,
+  COUNT(*) FILTER (WHERE res.position BETWEEN 1 AND 3) AS podiums,
+  COUNT(*) FILTER (WHERE res.position BETWEEN 1 AND 10) AS top10,
+  COUNT(*) FILTER (WHERE UPPER(res.status) LIKE 'DNF%') AS dnf,
+  COUNT(*) FILTER (WHERE UPPER(res.status) LIKE 'DSQ%' OR UPPER(res.status) = 'DQ') AS dsq,
+  MIN(NULLIF(res.position, 0))::int AS best_position,
+  ROUND(AVG(res.racepoints), 2)::numeric AS avg_racepoints,
+  SUM(res.cuppoints)::numeric AS cuppoints,
+  ROUND(
+    AVG(res.racepoints) - LAG(AVG(res.racepoints)) OVER (PARTITION BY GROUPING(rjp.seasoncode) ORDER BY rjp.seasoncode),
+    2
+  )::numeric AS racepoints_change
+FROM a_resultjp     AS res
+JOIN a_racejp       AS rjp
+  ON rjp.raceid = res.raceid
+WHERE res.competitorid = $1::int4
+GROUP BY ROLLUP (rjp.seasoncode)
+ORDER BY career, rjp.seasoncode;
+
+-- name: GetCompetitorBestResultsJP :many
+SELECT DISTINCT ON (rjp.disciplinecode, rjp.catcode)
+  rjp.disciplinecode,
+  rjp.catcode,
+  COUNT(*) FILTER (WHERE COALESCE(UPPER(res.status), '') NOT LIKE 'DNS%')
+    OVER (PARTITION BY rjp.disciplinecode, rjp.catcode) AS starts,
+  NULLIF(res.position, 0)::int AS best_position,
+  MIN(res.racepoints) OVER (PARTITION BY rjp.disciplinecode, rjp.catcode)::numeric AS best_racepoints,
+  res.raceid,
+  rjp.racedate,
+  rjp.seasoncode,
+  rjp.place
+FROM a_resultjp     AS res
+JOIN a_racejp       AS rjp
+  ON rjp.raceid = res.raceid
+WHERE res.competitorid = $1::int4
+ORDER BY rjp.disciplinecode, rjp.catcode, NULLIF(res.position, 0) NULLS LAST, rjp.racedate;
+
+-- name: GetCompetitorSeasonStatsNK :many
+SELECT
+  rnk.seasoncode,
+  GROUPING(rnk.seasoncode) = 1 AS career,
+  COUNT(*) FILTER (WHERE COALESCE(UPPER(res.status), '') NOT LIKE 'DNS%') AS starts,
+  COUNT(*) FILTER (WHERE res.position = 1) AS wins,
+  COUNT(*) FILTER (WHERE res.position BETWEEN 1 AND 3) AS podiums,
+  COUNT(*) FILTER (WHERE res.position BETWEEN 1 AND 10) AS top10,
+  COUNT(*) FILTER (WHERE UPPER(res.status) LIKE 'DNF%') AS dnf,
+  COUNT(*) FILTER (WHERE UPPER(res.status) LIKE 'DSQ%' OR UPPER(res.status) = 'DQ') AS dsq,
+  MIN(NULLIF(res.position, 0))::int AS best_position,
+  ROUND(AVG(res.racepoints), 2)::numeric AS avg_racepoints,
+  SUM(res.cuppoints)::numeric AS cuppoints,
+  ROUND(
+    AVG(res.racepoints) - LAG(AVG(res.racepoints)) OVER (PARTITION BY GROUPING(rnk.seasoncode) ORDER BY rnk.seasoncode),
+    2
+  )::numeric AS racepoints_change
+FROM a_resultnk     AS res
+JOIN a_racenk       AS rnk
+  ON rnk.raceid = res.raceid
+WHERE res.competitorid = $1::int4
+GROUP BY ROLLUP (rnk.seasoncode)
+ORDER BY career, rnk.seasoncode;
+
+-- name: GetCompetitorBestResultsNK :many
+SELECT DISTINCT ON (rnk.disciplinecode, rnk.catcode)
+  rnk.disciplinecode,
+  rnk.catcode,
+  COUNT(*) FILTER (WHERE COALESCE(UPPER(res.status), '') NOT LIKE 'DNS%')
+    OVER (PARTITION BY rnk.disciplinecode, rnk.catcode) AS starts,
+  NULLIF(res.position, 0)::int AS best_position,
+  MIN(res.racepoints) OVER (PARTITION BY rnk.disciplinecode, rnk.catcode)::numeric AS best_racepoints,
+  res.raceid,
+  rnk.racedate,
+  rnk.seasoncode,
+  rnk.place
+FROM a_resultnk     AS res
+JOIN a_racenk       AS rnk
+  ON rnk.raceid = res.raceid
+WHERE res.competitorid = $1::int4
+ORDER BY rnk.disciplinecode, rnk.catcode, NULLIF(res.position, 0) NULLS LAST, rnk.racedate;
+
+-- name: GetHeadToHeadCC :many
+WITH res AS (
+  SELECT r.raceid, r.fiscode, r.competitorname, r.nationcode, r.status, r."position", r.timetot AS score, r.racepoints
+  FROM a_resultcc AS r
+  JOIN a_racecc   AS rcc
+    ON rcc.raceid = r.raceid
+  WHERE r.fiscode = ANY($1::int[])
+    AND ($2::int[]  IS NULL OR rcc.seasoncode     = ANY($2))
+    AND ($3::text[] IS NULL OR rcc.disciplinecode = ANY($3))
+    AND ($4::text[] IS NULL OR rcc.catcode        = ANY($4))
+),
+shared AS (
+  SELECT raceid
+  FROM res
+  GROUP BY raceid
+  HAVING COUNT(DISTINCT fiscode) >= 2
+)
+SELECT
+  rcc.raceid,
+  rcc.racedate,
+  rcc.seasoncode,
+  rcc.disciplinecode,
+  rcc.catcode,
+  rcc.place,
+  rcc.nationcode,
+  res.fiscode,
+  res.competitorname,
+  res.nationcode AS competitornation,
+  res.status,
+  NULLIF(res."position", 0)::int AS position,
+  res.score::text AS score,
+  res.racepoints
+FROM res
+JOIN shared    ON shared.raceid = res.raceid
+JOIN a_racecc AS rcc
+  ON rcc.raceid = res.raceid
+ORDER BY rcc.racedate DESC NULLS LAST, rcc.raceid, NULLIF(res."position", 0) NULLS LAST, res.fiscode;
+
+-- name: GetHeadToHeadJP :many
+WITH res AS (
+  SELECT r.raceid, r.fiscode, r.competitorname, r.nationcode, r.status, r."position", r.tot AS score, r.racepoints
+  FROM a_resultjp AS r
+  JOIN a_racejp   AS rjp
+    ON rjp.raceid = r.raceid
+  WHERE r.fiscode = ANY($1::int[])
+    AND ($2::int[]  IS NULL OR rjp.seasoncode     = ANY($2))
+    AND ($3::text[] IS NULL OR rjp.disciplinecode = ANY($3))
+    AND ($4::text[] IS NULL OR rjp.catcode        = ANY($4))
+),
+shared AS (
+  SELECT raceid
+  FROM res
+  GROUP BY raceid
+  HAVING COUNT(DISTINCT fiscode) >= 2
+)
+SELECT
+  rjp.raceid,
+  rjp.racedate,
+  rjp.seasoncode,
+  rjp.disciplinecode,
+  rjp.catcode,
+  rjp.place,
+  rjp.nationcode,
+  res.fiscode,
+  res.competitorname,
+  res.nationcode AS competitornation,
+  res.status,
+  NULLIF(res."position", 0)::int AS position,
+  res.score::text AS score,
+  res.racepoints
+FROM res
+JOIN shared    ON shared.raceid = res.raceid
+JOIN a_racejp AS rjp
+  ON rjp.raceid = res.raceid
+ORDER BY rjp.racedate DESC NULLS LAST, rjp.raceid, NULLIF(res."position", 0) NULLS LAST, res.fiscode;
+
+-- name: GetHeadToHeadNK :many
+WITH res AS (
+  SELECT r.raceid, r.fiscode, r.competitorname, r.nationcode, r.status, r."position", r.timetot AS score, r.racepoints
+  FROM a_resultnk AS r
+  JOIN a_racenk   AS rnk
+    ON rnk.raceid = r.raceid
+  WHERE r.fiscode = ANY($1::int[])
+    AND ($2::int[]  IS NULL OR rnk.seasoncode     = ANY($2))
+    AND ($3::text[] IS NULL OR rnk.disciplinecode = ANY($3))
+    AND ($4::text[] IS NULL OR rnk.catcode        = ANY($4))
+),
+shared AS (
+  SELECT raceid
+  FROM res
+  GROUP BY raceid
+  HAVING COUNT(DISTINCT fiscode) >= 2
+)
+SELECT
+  rnk.raceid,
+  rnk.racedate,
+  rnk.seasoncode,
+  rnk.disciplinecode,
+  rnk.catcode,
+  rnk.place,
+  rnk.nationcode,
+  res.fiscode,
+  res.competitorname,
+  res.nationcode AS competitornation,
+  res.status,
+  NULLIF(res."position", 0)::int AS position,
+  res.score::text AS score,
+  res.racepoints
+FROM res
+JOIN shared    ON shared.raceid = res.raceid
+JOIN a_racenk AS rnk
+  ON rnk.raceid = res.raceid
+ORDER BY rnk.racedate DESC NULLS LAST, rnk.raceid, NULLIF(res."position", 0) NULLS LAST, res.fiscode;
+
+-- name: GetFISPointsListCC :many
+WITH lists AS (
+  SELECT d::date AS list_date
+  FROM generate_series($1::date, $2::date, make_interval(months => $3::int)) AS d
+),
+scored AS (
+  SELECT
+    l.list_date,
+    res.fiscode,
+    res.competitorname,
+    res.nationcode,
+    COALESCE(NULLIF(rcc.discforlistcode, ''), rcc.disciplinecode) AS disciplinecode,
+    rcc.gender,
+    res.racepoints + pen.penalty AS points,
+    ROW_NUMBER() OVER (
+      PARTITION BY l.list_date, res.fiscode, COALESCE(NULLIF(rcc.discforlistcode, ''), rcc.disciplinecode), rcc.gender
+      ORDER BY res.racepoints + pen.penalty, rcc.racedate DESC
+    ) AS n
+  FROM lists       AS l
+  JOIN a_racecc   AS rcc
+    ON rcc.racedate >  l.list_date - make_interval(months => $4::int)
+   AND rcc.racedate <= l.list_date
+  CROSS JOIN LATERAL (
+    SELECT COALESCE(
+      CASE WHEN replace(trim(rcc.appliedpenalty), ',', '.') ~ '^-?[0-9]+(\.[0-9]+)?$' THEN replace(trim(rcc.appliedpenalty), ',', '.')::numeric END,
+      CASE WHEN replace(trim(rcc.calculatedpenalty), ',', '.') ~ '^-?[0-9]+(\.[0-9]+)?$' THEN replace(trim(rcc.calculatedpenalty), ',', '.')::numeric END
+    ) AS penalty
+  ) AS pen
+  JOIN a_resultcc AS res
+    ON res.raceid = rcc.raceid
+  WHERE rcc.validforfispoints = 1
+    AND NULLIF(trim(rcc.usedfislist), '') IS NOT NULL
+    AND pen.penalty IS NOT NULL
+    AND res.fiscode IS NOT NULL
+    AND res.racepoints IS NOT NULL
+    AND ($5::text[] IS NULL OR COALESCE(NULLIF(rcc.discforlistcode, ''), rcc.disciplinecode) = ANY($5))
+    AND ($6::text = '' OR rcc.gender = $6::text)
+),
+standings AS (
+  SELECT
+    list_date,
+    fiscode,
+    MAX(competitorname) AS competitorname,
+    MAX(nationcode)     AS nationcode,
+    disciplinecode,
+    gender,
+    COUNT(*)            AS results,
+    AVG(points)         AS points,
+    RANK() OVER (PARTITION BY list_date, disciplinecode, gender ORDER BY AVG(points)) AS rank,
+    RANK() OVER (PARTITION BY list_date, disciplinecode, gender, MAX(nationcode) ORDER BY AVG(points)) AS nation_rank
+  FROM scored
+  WHERE n <= $7::int
+  GROUP BY list_date, fiscode, disciplinecode, gender
+  HAVING COUNT(*) >= $8::int
+)
+SELECT
+  list_date,
+  fiscode,
+  competitorname,
+  nationcode,
+  disciplinecode,
+  gender,
+  results,
+  ROUND(points, 2)::numeric AS points,
+  rank,
+  nation_rank
+FROM standings
+WHERE ($9::int = 0 OR fiscode = $9::int)
+  AND ($10::text = '' OR nationcode = $10::text)
+  AND ($11::int = 0 OR CASE WHEN $10::text = '' THEN rank ELSE nation_rank END <= $11::int)
+ORDER BY list_date, disciplinecode, gender, rank, fiscode;
+
+-- name: GetFISPointsListNK :many
+WITH lists AS (
+  SELECT d::date AS list_date
+  FROM generate_series($1::date, $2::date, make_interval(months => $3::int)) AS d
+),
+scored AS (
+  SELECT
+    l.list_date,
+    res.fiscode,
+    res.competitorname,
+    res.nationcode,
+    COALESCE(NULLIF(rnk.discforlistcode, ''), rnk.disciplinecode) AS disciplinecode,
+    rnk.gender,
+    res.racepoints + pen.penalty AS points,
+    ROW_NUMBER() OVER (
+      PARTITION BY l.list_date, res.fiscode, COALESCE(NULLIF(rnk.discforlistcode, ''), rnk.disciplinecode), rnk.gender
+      ORDER BY res.racepoints + pen.penalty, rnk.racedate DESC
+    ) AS n
+  FROM lists       AS l
+  JOIN a_racenk   AS rnk
+    ON rnk.racedate >  l.list_date - make_interval(months => $4::int)
+   AND rnk.racedate <= l.list_date
+  CROSS JOIN LATERAL (
+    SELECT COALESCE(
+      CASE WHEN replace(trim(rnk.appliedpenalty), ',', '.') ~ '^-?[0-9]+(\.[0-9]+)?$' THEN replace(trim(rnk.appliedpenalty), ',', '.')::numeric END,
+      CASE WHEN replace(trim(rnk.calculatedpenalty), ',', '.') ~ '^-?[0-9]+(\.[0-9]+)?$' THEN replace(trim(rnk.calculatedpenalty), ',', '.')::numeric END
+    ) AS penalty
+  ) AS pen
+  JOIN a_resultnk AS res
+    ON res.raceid = rnk.raceid
+  WHERE rnk.validforfispoints = 1
+    AND NULLIF(trim(rnk.usedfislist), '') IS NOT NULL
+    AND pen.penalty IS NOT NULL
+    AND res.fiscode IS NOT NULL
+    AND res.racepoints IS NOT NULL
+    AND ($5::text[] IS NULL OR COALESCE(NULLIF(rnk.discforlistcode, ''), rnk.disciplinecode) = ANY($5))
+    AND ($6::text = '' OR rnk.gender = $6::text)
+),
+standings AS (
+  SELECT
+    list_date,
+    fiscode,
+    MAX(competitorname) AS competitorname,
+    MAX(nationcode)     AS nationcode,
+    disciplinecode,
+    gender,
+    COUNT(*)            AS results,
+    AVG(points)         AS points,
+    RANK() OVER (PARTITION BY list_date, disciplinecode, gender ORDER BY AVG(points)) AS rank,
+    RANK() OVER (PARTITION BY list_date, disciplinecode, gender, MAX(nationcode) ORDER BY AVG(points)) AS nation_rank
+  FROM scored
+  WHERE n <= $7::int
+  GROUP BY list_date, fiscode, disciplinecode, gender
+  HAVING COUNT(*) >= $8::int
+)
+SELECT
+  list_date,
+  fiscode,
+  competitorname,
+  nationcode,
+  disciplinecode,
+  gender,
+  results,
+  ROUND(points, 2)::numeric AS points,
+  rank,
+  nation_rank
+FROM standings
+WHERE ($9::int = 0 OR fiscode = $9::int)
+  AND ($10::text = '' OR nationcode = $10::text)
+  AND ($11::int = 0 OR CASE WHEN $10::text = '' THEN rank ELSE nation_rank END <= $11::int)
+ORDER BY list_date, disciplinecode, gender, rank, fiscode;
+
+-- name: GetEnrichedRaceResultsCC :many
+SELECT sqlc.embed(res), c.birthdate, c.skiclub, c.nationcode AS competitor_nationcode
+FROM public.a_resultcc AS res
+LEFT JOIN public.a_competitor AS c ON c.competitorid = res.competitorid
+WHERE res.raceid = $1::int4
+ORDER BY res.position NULLS LAST, res.recid;
+
+
+-- name: GetEnrichedRaceResultsJP :many
+SELECT sqlc.embed(res), c.birthdate, c.skiclub, c.nationcode AS competitor_nationcode
+FROM public.a_resultjp AS res
+LEFT JOIN public.a_competitor AS c ON c.competitorid = res.competitorid
+WHERE res.raceid = $1::int4
+ORDER BY res.position NULLS LAST, res.recid;
+
+
+-- name: GetEnrichedRaceResultsNK :many
+SELECT sqlc.embed(res), c.birthdate, c.skiclub, c.nationcode AS competitor_nationcode
+FROM public.a_resultnk AS res
+LEFT JOIN public.a_competitor AS c ON c.competitorid = res.competitorid
+WHERE res.raceid = $1::int4
+ORDER BY res.position NULLS LAST, res.recid;
+
+
+-- name: GetCalendarRaces :many
+WITH picked AS (
+  SELECT sector, raceid, eventid, seasoncode, racedate, starteventdate, disciplinecode, catcode, gender, description, place, nationcode, calstatuscode, lastupdate
+  FROM (
+    SELECT 'cc'::text AS sector, raceid, eventid, seasoncode, racedate, starteventdate, disciplinecode, catcode, gender, description, place, nationcode, calstatuscode, lastupdate
+    FROM public.a_racecc
+    UNION ALL
+    SELECT 'jp'::text AS sector, raceid, eventid, seasoncode, racedate, starteventdate, disciplinecode, catcode, gender, description, place, nationcode, calstatuscode, lastupdate
+    FROM public.a_racejp
+    UNION ALL
+    SELECT 'nk'::text AS sector, raceid, eventid, seasoncode, racedate, starteventdate, disciplinecode, catcode, gender, description, place, nationcode, calstatuscode, lastupdate
+    FROM public.a_racenk
+  ) AS races
+  WHERE racedate BETWEEN sqlc.arg(date_from)::date AND sqlc.arg(date_to)::date
+    AND (sqlc.narg(sectors)::text[] IS NULL OR sector     = ANY(sqlc.narg(sectors)::text[]))
+    AND (sqlc.narg(nations)::text[] IS NULL OR nationcode = ANY(sqlc.narg(nations)::text[]))
+    AND (sqlc.narg(cats)::text[]    IS NULL OR catcode    = ANY(sqlc.narg(cats)::text[]))
+    AND (sqlc.narg(genders)::text[] IS NULL OR gender     = ANY(sqlc.narg(genders)::text[]))
+), event_bounds AS (
+  SELECT eventid, MIN(racedate) AS event_start, MAX(racedate) AS event_end
+  FROM (
+    SELECT eventid, racedate FROM public.a_racecc
+    UNION ALL
+    SELECT eventid, racedate FROM public.a_racejp
+    UNION ALL
+    SELECT eventid, racedate FROM public.a_racenk
+  ) AS races
+  WHERE eventid IN (SELECT eventid FROM picked)
+  GROUP BY eventid
+)
+SELECT p.sector, p.raceid, p.eventid, p.seasoncode, p.racedate, p.starteventdate, p.disciplinecode, p.catcode, p.gender, p.description, p.place, p.nationcode, p.calstatuscode, p.lastupdate,
+  COALESCE(b.event_start, p.racedate) AS event_start,
+  COALESCE(b.event_end, p.racedate) AS event_end
+FROM picked AS p
+LEFT JOIN event_bounds AS b ON b.eventid = p.eventid
+ORDER BY p.racedate, p.eventid, p.sector, p.raceid;
+
+
+-- name: ListUnlinkedFinnishCompetitors :many
+SELECT DISTINCT ON (c.fiscode) c.fiscode::int AS fiscode, c.firstname, c.lastname, c.gender, c.birthdate, c.sectorcode
+FROM a_competitor AS c
+WHERE c.nationcode = 'FIN'
+  AND c.fiscode IS NOT NULL
+  AND NOT EXISTS (SELECT 1 FROM athlete AS a WHERE a.fiscode = c.fiscode)
+ORDER BY c.fiscode, c.lastupdate DESC NULLS LAST;
+
+-- name: ListLinkedSporttiIDs :many
+SELECT DISTINCT sporttiid::int AS sporttiid
+FROM athlete
+WHERE sporttiid IS NOT NULL;
+
+-- name: DeleteLinkedAthleteLinkCandidates :execrows
+DELETE FROM public.fis_athlete_link_candidates AS lc
+WHERE lc.status = 'pending'
+  AND EXISTS (SELECT 1 FROM athlete AS a WHERE a.fiscode = lc.fiscode OR a.sporttiid = lc.sporttiid);
+
+-- name: DeleteStaleAthleteLinkCandidates :execrows
+DELETE FROM public.fis_athlete_link_candidates AS lc
+WHERE lc.status = 'pending'
+  AND NOT EXISTS (
+    SELECT 1
+    FROM unnest(sqlc.arg(fiscodes)::int[], sqlc.arg(sporttiids)::int[]) AS c(fiscode, sporttiid)
+    WHERE c.fiscode = lc.fiscode AND c.sporttiid = lc.sporttiid
+  );
+
+-- name: UpsertAthleteLinkCandidate :execrows
+INSERT INTO public.fis_athlete_link_candidates (
+  fiscode,
+  sporttiid,
+  confidence,
+  sources,
+  evidence
+) VALUES (
+  sqlc.arg(fiscode)::int, sqlc.arg(sporttiid)::int, sqlc.arg(confidence)::numeric, sqlc.arg(sources)::text[], sqlc.arg(evidence)::jsonb
+)
+ON CONFLICT (fiscode, sporttiid) DO UPDATE SET
+  confidence = EXCLUDED.confidence,
+  sources    = EXCLUDED.sources,
+  evidence   = EXCLUDED.evidence,
+  updated_at = (now() AT TIME ZONE 'UTC')
+WHERE fis_athlete_link_candidates.status = 'pending';
+
+-- name: ListAthleteLinkCandidates :many
+SELECT id, fiscode, sporttiid, confidence, sources, evidence, status, created_at, updated_at, reviewed_by, reviewed_at
+FROM public.fis_athlete_link_candidates
+WHERE (sqlc.arg(status)::text = '' OR status = sqlc.arg(status)::text)
+  AND (sqlc.narg(fiscode)::int IS NULL OR fiscode = sqlc.narg(fiscode)::int)
+  AND (sqlc.narg(sporttiid)::int IS NULL OR sporttiid = sqlc.narg(sporttiid)::int)
+  AND confidence >= sqlc.arg(min_confidence)::numeric
+  AND (sqlc.narg(after_confidence)::numeric IS NULL
+       OR confidence < sqlc.narg(after_confidence)::numeric
+       OR (confidence = sqlc.narg(after_confidence)::numeric AND id > sqlc.narg(after_id)::int))
+ORDER BY confidence DESC, id
+LIMIT sqlc.arg(page_limit)::int;
+
+-- name: GetAthleteLinkCandidate :one
+SELECT id, fiscode, sporttiid, confidence, sources, evidence, status, created_at, updated_at, reviewed_by, reviewed_at
+FROM public.fis_athlete_link_candidates
+WHERE id = $1;
+
+-- name: ReviewAthleteLinkCandidate :one
+UPDATE public.fis_athlete_link_candidates SET
+  status      = sqlc.arg(status)::text,
+  reviewed_by = sqlc.arg(reviewed_by)::text,
+  reviewed_at = (now() AT TIME ZONE 'UTC'),
+  updated_at  = (now() AT TIME ZONE 'UTC')
+WHERE id = sqlc.arg(id)::int
+  AND status = 'pending'
+RETURNING id, fiscode, sporttiid, confidence, sources, evidence, status, created_at, updated_at, reviewed_by, reviewed_at;
+
+-- name: RejectCompetingAthleteLinkCandidates :execrows
+UPDATE public.fis_athlete_link_candidates SET
+  status      = 'rejected',
+  reviewed_by = sqlc.arg(reviewed_by)::text,
+  reviewed_at = (now() AT TIME ZONE 'UTC'),
+  updated_at  = (now() AT TIME ZONE 'UTC')
+WHERE (fiscode = sqlc.arg(fiscode)::int OR sporttiid = sqlc.arg(sporttiid)::int)
+  AND id <> sqlc.arg(id)::int
+  AND status = 'pending';
+
+-- name: InsertAthleteFromCompetitor :execrows
+INSERT INTO public.athlete (fiscode, sporttiid, firstname, lastname)
+SELECT c.fiscode, sqlc.arg(sporttiid)::int, LEFT(c.firstname, 50), LEFT(c.lastname, 50)
+FROM a_competitor AS c
+WHERE c.fiscode = sqlc.arg(fiscode)::int
+ORDER BY c.lastupdate DESC NULLS LAST
+LIMIT 1;
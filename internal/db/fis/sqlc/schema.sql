@@ -17,6 +17,31 @@ SET xmloption = content;
 SET client_min_messages = warning;
 SET row_security = off;
 
+--
+-- Name: pg_trgm; Type: EXTENSION; Schema: -; Owner: -
+--
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm WITH SCHEMA public;
+
+
+--
+-- Name: unaccent; Type: EXTENSION; Schema: -; Owner: -
+--
+
+CREATE EXTENSION IF NOT EXISTS unaccent WITH SCHEMA public;
+
+
+--
+-- Name: fis_unaccent(text); Type: FUNCTION; Schema: public; Owner: -
+--
+
+CREATE FUNCTION public.fis_unaccent(text) RETURNS text
+    LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT
+    AS $_$
+    SELECT public.unaccent('public.unaccent'::regdictionary, $1)
+$_$;
+
+
 SET default_tablespace = '';
 
 SET default_table_access_method = heap;
@@ -573,6 +598,51 @@ CREATE TABLE public.athlete (
 );
 
 
+--
+-- Name: fis_tombstones; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.fis_tombstones (
+    entity character varying(16) NOT NULL,
+    id integer NOT NULL,
+    deleted_at timestamp without time zone NOT NULL DEFAULT (now() AT TIME ZONE 'UTC'),
+    PRIMARY KEY (entity, id)
+);
+
+
+--
+-- Name: fis_writes; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.fis_writes (
+    entity character varying(16) NOT NULL,
+    id integer NOT NULL,
+    written_at timestamp without time zone NOT NULL DEFAULT (now() AT TIME ZONE 'UTC'),
+    PRIMARY KEY (entity, id)
+);
+
+
+--
+-- Name: fis_athlete_link_candidates; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.fis_athlete_link_candidates (
+    id serial PRIMARY KEY,
+    fiscode integer NOT NULL,
+    sporttiid integer NOT NULL,
+    confidence numeric(4,3) NOT NULL,
+    sources text[] NOT NULL,
+    evidence jsonb NOT NULL,
+    status character varying(16) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'approved', 'rejected')),
+    created_at timestamp without time zone NOT NULL DEFAULT (now() AT TIME ZONE 'UTC'),
+    updated_at timestamp without time zone NOT NULL DEFAULT (now() AT TIME ZONE 'UTC'),
+    reviewed_by character varying(255),
+    reviewed_at timestamp without time zone,
+    UNIQUE (fiscode, sporttiid)
+);
+
+
 --
 -- Name: a_competitor a_competitor_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
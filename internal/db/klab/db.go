@@ -69,6 +69,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.insertMeasurementStmt, err = db.PrepareContext(ctx, insertMeasurement); err != nil {
 		return nil, fmt.Errorf("error preparing query InsertMeasurement: %w", err)
 	}
+	if q.listCustomerIdentitiesStmt, err = db.PrepareContext(ctx, listCustomerIdentities); err != nil {
+		return nil, fmt.Errorf("error preparing query ListCustomerIdentities: %w", err)
+	}
 	if q.upsertCustomerStmt, err = db.PrepareContext(ctx, upsertCustomer); err != nil {
 		return nil, fmt.Errorf("error preparing query UpsertCustomer: %w", err)
 	}
@@ -152,6 +155,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing insertMeasurementStmt: %w", cerr)
 		}
 	}
+	if q.listCustomerIdentitiesStmt != nil {
+		if cerr := q.listCustomerIdentitiesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listCustomerIdentitiesStmt: %w", cerr)
+		}
+	}
 	if q.upsertCustomerStmt != nil {
 		if cerr := q.upsertCustomerStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing upsertCustomerStmt: %w", cerr)
@@ -211,6 +219,7 @@ type Queries struct {
 	insertDirTestStmt                   *sql.Stmt
 	insertDirTestStepStmt               *sql.Stmt
 	insertMeasurementStmt               *sql.Stmt
+	listCustomerIdentitiesStmt          *sql.Stmt
 	upsertCustomerStmt                  *sql.Stmt
 }
 
@@ -233,6 +242,7 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		insertDirTestStmt:                   q.insertDirTestStmt,
 		insertDirTestStepStmt:               q.insertDirTestStepStmt,
 		insertMeasurementStmt:               q.insertMeasurementStmt,
+		listCustomerIdentitiesStmt:          q.listCustomerIdentitiesStmt,
 		upsertCustomerStmt:                  q.upsertCustomerStmt,
 	}
 }
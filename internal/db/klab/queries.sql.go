@@ -485,11 +485,19 @@ const getMeasurementsByCustomer = `-- name: GetMeasurementsByCustomer :many
 SELECT idmeasurement, measname, idcustomer, tablename, idpatterndef, do_year, do_month, do_day, do_hour, do_min, sessionno, info, measurements, groupnotes, cbcharts, cbcomments, created_by, mod_by, mod_date, deleted, created_date, modded, test_location, keywords, tester_name, modder_name, meastype, sent_to_sprintai
 FROM measurement_list
 WHERE idcustomer = $1
+  AND ($2::int8 IS NULL OR idmeasurement > $2::int8)
 ORDER BY idmeasurement
+LIMIT $3::int4
 `
 
-func (q *Queries) GetMeasurementsByCustomer(ctx context.Context, idcustomer int32) ([]MeasurementList, error) {
-	rows, err := q.query(ctx, q.getMeasurementsByCustomerStmt, getMeasurementsByCustomer, idcustomer)
+type GetMeasurementsByCustomerParams struct {
+	Idcustomer         int32
+	AfterIdmeasurement sql.NullInt64
+	PageLimit          int32
+}
+
+func (q *Queries) GetMeasurementsByCustomer(ctx context.Context, arg GetMeasurementsByCustomerParams) ([]MeasurementList, error) {
+	rows, err := q.query(ctx, q.getMeasurementsByCustomerStmt, getMeasurementsByCustomer, arg.Idcustomer, arg.AfterIdmeasurement, arg.PageLimit)
 	if err != nil {
 		return nil, err
 	}
@@ -540,7 +548,7 @@ func (q *Queries) GetMeasurementsByCustomer(ctx context.Context, idcustomer int3
 	return items, nil
 }
 
-const insertDirRawData = `-- name: InsertDirRawData :exec
+const insertDirRawData = `-- name: InsertDirRawData :one
 INSERT INTO dirrawdata (
     iddirrawdata, idmeasurement, rawdata, columndata, info, unitsdata,
     created_by, mod_by, mod_date, deleted, created_date, modded
@@ -560,6 +568,7 @@ ON CONFLICT (iddirrawdata) DO UPDATE SET
     deleted = EXCLUDED.deleted,
     created_date = EXCLUDED.created_date,
     modded = EXCLUDED.modded
+RETURNING (xmax = 0) AS inserted
 `
 
 type InsertDirRawDataParams struct {
@@ -577,8 +586,8 @@ type InsertDirRawDataParams struct {
 	Modded        sql.NullInt16
 }
 
-func (q *Queries) InsertDirRawData(ctx context.Context, arg InsertDirRawDataParams) error {
-	_, err := q.exec(ctx, q.insertDirRawDataStmt, insertDirRawData,
+func (q *Queries) InsertDirRawData(ctx context.Context, arg InsertDirRawDataParams) (bool, error) {
+	row := q.queryRow(ctx, q.insertDirRawDataStmt, insertDirRawData,
 		arg.Iddirrawdata,
 		arg.Idmeasurement,
 		arg.Rawdata,
@@ -592,10 +601,12 @@ func (q *Queries) InsertDirRawData(ctx context.Context, arg InsertDirRawDataPara
 		arg.CreatedDate,
 		arg.Modded,
 	)
-	return err
+	var inserted bool
+	err := row.Scan(&inserted)
+	return inserted, err
 }
 
-const insertDirReport = `-- name: InsertDirReport :exec
+const insertDirReport = `-- name: InsertDirReport :one
 INSERT INTO dirreport (
     iddirreport, page_instructions, idmeasurement, template_rec, librec_name,
     created_by, mod_by, mod_date, deleted, created_date, modded
@@ -614,6 +625,7 @@ ON CONFLICT (iddirreport) DO UPDATE SET
     deleted = EXCLUDED.deleted,
     created_date = EXCLUDED.created_date,
     modded = EXCLUDED.modded
+RETURNING (xmax = 0) AS inserted
 `
 
 type InsertDirReportParams struct {
@@ -630,8 +642,8 @@ type InsertDirReportParams struct {
 	Modded           sql.NullInt16
 }
 
-func (q *Queries) InsertDirReport(ctx context.Context, arg InsertDirReportParams) error {
-	_, err := q.exec(ctx, q.insertDirReportStmt, insertDirReport,
+func (q *Queries) InsertDirReport(ctx context.Context, arg InsertDirReportParams) (bool, error) {
+	row := q.queryRow(ctx, q.insertDirReportStmt, insertDirReport,
 		arg.Iddirreport,
 		arg.PageInstructions,
 		arg.Idmeasurement,
@@ -644,10 +656,12 @@ func (q *Queries) InsertDirReport(ctx context.Context, arg InsertDirReportParams
 		arg.CreatedDate,
 		arg.Modded,
 	)
-	return err
+	var inserted bool
+	err := row.Scan(&inserted)
+	return inserted, err
 }
 
-const insertDirResults = `-- name: InsertDirResults :exec
+const insertDirResults = `-- name: InsertDirResults :one
 INSERT INTO dirresults (
     iddirresults, idmeasurement, max_vo2mlkgmin, max_vo2mlmin, max_vo2,
     max_hr, max_speed, max_pace, max_p, max_pkg, max_angle, max_lac,
@@ -761,6 +775,7 @@ ON CONFLICT (iddirresults) DO UPDATE SET
     deleted = EXCLUDED.deleted,
     created_date = EXCLUDED.created_date,
     modded = EXCLUDED.modded
+RETURNING (xmax = 0) AS inserted
 `
 
 type InsertDirResultsParams struct {
@@ -843,8 +858,8 @@ type InsertDirResultsParams struct {
 	Modded             sql.NullInt16
 }
 
-func (q *Queries) InsertDirResults(ctx context.Context, arg InsertDirResultsParams) error {
-	_, err := q.exec(ctx, q.insertDirResultsStmt, insertDirResults,
+func (q *Queries) InsertDirResults(ctx context.Context, arg InsertDirResultsParams) (bool, error) {
+	row := q.queryRow(ctx, q.insertDirResultsStmt, insertDirResults,
 		arg.Iddirresults,
 		arg.Idmeasurement,
 		arg.MaxVo2mlkgmin,
@@ -923,10 +938,12 @@ func (q *Queries) InsertDirResults(ctx context.Context, arg InsertDirResultsPara
 		arg.CreatedDate,
 		arg.Modded,
 	)
-	return err
+	var inserted bool
+	err := row.Scan(&inserted)
+	return inserted, err
 }
 
-const insertDirTest = `-- name: InsertDirTest :exec
+const insertDirTest = `-- name: InsertDirTest :one
 INSERT INTO dirtest (
     iddirtest, idmeasurement, meascols, weightkg, heightcm, bmi,
     fat_pr, fat_p1, fat_p2, fat_p3, fat_p4, fat_style, fat_equip,
@@ -989,6 +1006,7 @@ ON CONFLICT (iddirtest) DO UPDATE SET
     created_date = EXCLUDED.created_date,
     modded = EXCLUDED.modded,
     norawdata = EXCLUDED.norawdata
+RETURNING (xmax = 0) AS inserted
 `
 
 type InsertDirTestParams struct {
@@ -1039,8 +1057,8 @@ type InsertDirTestParams struct {
 	Norawdata     sql.NullInt16
 }
 
-func (q *Queries) InsertDirTest(ctx context.Context, arg InsertDirTestParams) error {
-	_, err := q.exec(ctx, q.insertDirTestStmt, insertDirTest,
+func (q *Queries) InsertDirTest(ctx context.Context, arg InsertDirTestParams) (bool, error) {
+	row := q.queryRow(ctx, q.insertDirTestStmt, insertDirTest,
 		arg.Iddirtest,
 		arg.Idmeasurement,
 		arg.Meascols,
@@ -1087,10 +1105,12 @@ func (q *Queries) InsertDirTest(ctx context.Context, arg InsertDirTestParams) er
 		arg.Modded,
 		arg.Norawdata,
 	)
-	return err
+	var inserted bool
+	err := row.Scan(&inserted)
+	return inserted, err
 }
 
-const insertDirTestStep = `-- name: InsertDirTestStep :exec
+const insertDirTestStep = `-- name: InsertDirTestStep :one
 INSERT INTO dirteststeps (
     iddirteststeps, idmeasurement, stepno, ana_time, timestop, speed, pace,
     angle, elev, vo2calc, t_tot, t_ex, fico2, fio2, feco2, feo2, vde, vco2,
@@ -1188,6 +1208,7 @@ ON CONFLICT (iddirteststeps) DO UPDATE SET
     own10 = EXCLUDED.own10,
     to2 = EXCLUDED.to2,
     tco2 = EXCLUDED.tco2
+RETURNING (xmax = 0) AS inserted
 `
 
 type InsertDirTestStepParams struct {
@@ -1267,8 +1288,8 @@ type InsertDirTestStepParams struct {
 	Tco2           sql.NullFloat64
 }
 
-func (q *Queries) InsertDirTestStep(ctx context.Context, arg InsertDirTestStepParams) error {
-	_, err := q.exec(ctx, q.insertDirTestStepStmt, insertDirTestStep,
+func (q *Queries) InsertDirTestStep(ctx context.Context, arg InsertDirTestStepParams) (bool, error) {
+	row := q.queryRow(ctx, q.insertDirTestStepStmt, insertDirTestStep,
 		arg.Iddirteststeps,
 		arg.Idmeasurement,
 		arg.Stepno,
@@ -1344,10 +1365,12 @@ func (q *Queries) InsertDirTestStep(ctx context.Context, arg InsertDirTestStepPa
 		arg.To2,
 		arg.Tco2,
 	)
-	return err
+	var inserted bool
+	err := row.Scan(&inserted)
+	return inserted, err
 }
 
-const insertMeasurement = `-- name: InsertMeasurement :exec
+const insertMeasurement = `-- name: InsertMeasurement :one
 INSERT INTO measurement_list (
     idmeasurement, measname, idcustomer, tablename, idpatterndef,
     do_year, do_month, do_day, do_hour, do_min, sessionno, info,
@@ -1391,6 +1414,7 @@ ON CONFLICT (idmeasurement) DO UPDATE SET
     modder_name = EXCLUDED.modder_name,
     meastype = EXCLUDED.meastype,
     sent_to_sprintai = EXCLUDED.sent_to_sprintai
+RETURNING (xmax = 0) AS inserted
 `
 
 type InsertMeasurementParams struct {
@@ -1424,8 +1448,8 @@ type InsertMeasurementParams struct {
 	SentToSprintai sql.NullTime
 }
 
-func (q *Queries) InsertMeasurement(ctx context.Context, arg InsertMeasurementParams) error {
-	_, err := q.exec(ctx, q.insertMeasurementStmt, insertMeasurement,
+func (q *Queries) InsertMeasurement(ctx context.Context, arg InsertMeasurementParams) (bool, error) {
+	row := q.queryRow(ctx, q.insertMeasurementStmt, insertMeasurement,
 		arg.Idmeasurement,
 		arg.Measname,
 		arg.Idcustomer,
@@ -1455,10 +1479,12 @@ func (q *Queries) InsertMeasurement(ctx context.Context, arg InsertMeasurementPa
 		arg.Meastype,
 		arg.SentToSprintai,
 	)
-	return err
+	var inserted bool
+	err := row.Scan(&inserted)
+	return inserted, err
 }
 
-const upsertCustomer = `-- name: UpsertCustomer :exec
+const upsertCustomer = `-- name: UpsertCustomer :one
 INSERT INTO customer (
     idcustomer, firstname, lastname, idgroups, dob, sex, dob_year, dob_month, dob_day,
     pid_number, company, occupation, education, address, phone_home, phone_work, phone_mobile,
@@ -1526,6 +1552,7 @@ ON CONFLICT (idcustomer) DO UPDATE SET
     tosprintai_from = EXCLUDED.tosprintai_from,
     stat_sent = EXCLUDED.stat_sent,
     sportti_id = EXCLUDED.sportti_id
+RETURNING (xmax = 0) AS inserted
 `
 
 type UpsertCustomerParams struct {
@@ -1583,8 +1610,8 @@ type UpsertCustomerParams struct {
 }
 
 // Prefer updating customer metadata if it already exists.
-func (q *Queries) UpsertCustomer(ctx context.Context, arg UpsertCustomerParams) error {
-	_, err := q.exec(ctx, q.upsertCustomerStmt, upsertCustomer,
+func (q *Queries) UpsertCustomer(ctx context.Context, arg UpsertCustomerParams) (bool, error) {
+	row := q.queryRow(ctx, q.upsertCustomerStmt, upsertCustomer,
 		arg.Idcustomer,
 		arg.Firstname,
 		arg.Lastname,
@@ -1637,5 +1664,50 @@ func (q *Queries) UpsertCustomer(ctx context.Context, arg UpsertCustomerParams)
 		arg.StatSent,
 		arg.SporttiID,
 	)
-	return err
+	var inserted bool
+	err := row.Scan(&inserted)
+	return inserted, err
+}
+
+const listCustomerIdentities = `-- name: ListCustomerIdentities :many
+SELECT sportti_id, firstname, lastname, dob
+FROM customer
+WHERE sportti_id IS NOT NULL
+  AND sportti_id <> ''
+  AND COALESCE(deleted, 0) = 0
+`
+
+type ListCustomerIdentitiesRow struct {
+	SporttiID sql.NullString
+	Firstname string
+	Lastname  string
+	Dob       sql.NullTime
+}
+
+func (q *Queries) ListCustomerIdentities(ctx context.Context) ([]ListCustomerIdentitiesRow, error) {
+	rows, err := q.query(ctx, q.listCustomerIdentitiesStmt, listCustomerIdentities)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCustomerIdentitiesRow
+	for rows.Next() {
+		var i ListCustomerIdentitiesRow
+		if err := rows.Scan(
+			&i.SporttiID,
+			&i.Firstname,
+			&i.Lastname,
+			&i.Dob,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
@@ -1,5 +1,5 @@
 -- Prefer updating customer metadata if it already exists.
--- name: UpsertCustomer :exec
+-- name: UpsertCustomer :one
 INSERT INTO customer (
     idcustomer, firstname, lastname, idgroups, dob, sex, dob_year, dob_month, dob_day,
     pid_number, company, occupation, education, address, phone_home, phone_work, phone_mobile,
@@ -66,10 +66,11 @@ ON CONFLICT (idcustomer) DO UPDATE SET
     allow_to_sprintai = EXCLUDED.allow_to_sprintai,
     tosprintai_from = EXCLUDED.tosprintai_from,
     stat_sent = EXCLUDED.stat_sent,
-    sportti_id = EXCLUDED.sportti_id;
+    sportti_id = EXCLUDED.sportti_id
+RETURNING (xmax = 0) AS inserted;
 
 
--- name: InsertMeasurement :exec
+-- name: InsertMeasurement :one
 INSERT INTO measurement_list (
     idmeasurement, measname, idcustomer, tablename, idpatterndef,
     do_year, do_month, do_day, do_hour, do_min, sessionno, info,
@@ -112,10 +113,11 @@ ON CONFLICT (idmeasurement) DO UPDATE SET
     tester_name = EXCLUDED.tester_name,
     modder_name = EXCLUDED.modder_name,
     meastype = EXCLUDED.meastype,
-    sent_to_sprintai = EXCLUDED.sent_to_sprintai;
+    sent_to_sprintai = EXCLUDED.sent_to_sprintai
+RETURNING (xmax = 0) AS inserted;
 
 
--- name: InsertDirTest :exec
+-- name: InsertDirTest :one
 INSERT INTO dirtest (
     iddirtest, idmeasurement, meascols, weightkg, heightcm, bmi,
     fat_pr, fat_p1, fat_p2, fat_p3, fat_p4, fat_style, fat_equip,
@@ -177,10 +179,11 @@ ON CONFLICT (iddirtest) DO UPDATE SET
     deleted = EXCLUDED.deleted,
     created_date = EXCLUDED.created_date,
     modded = EXCLUDED.modded,
-    norawdata = EXCLUDED.norawdata;
+    norawdata = EXCLUDED.norawdata
+RETURNING (xmax = 0) AS inserted;
 
 
--- name: InsertDirTestStep :exec
+-- name: InsertDirTestStep :one
 INSERT INTO dirteststeps (
     iddirteststeps, idmeasurement, stepno, ana_time, timestop, speed, pace,
     angle, elev, vo2calc, t_tot, t_ex, fico2, fio2, feco2, feo2, vde, vco2,
@@ -277,11 +280,12 @@ ON CONFLICT (iddirteststeps) DO UPDATE SET
     own9 = EXCLUDED.own9,
     own10 = EXCLUDED.own10,
     to2 = EXCLUDED.to2,
-    tco2 = EXCLUDED.tco2;
+    tco2 = EXCLUDED.tco2
+RETURNING (xmax = 0) AS inserted;
 
 
 
--- name: InsertDirRawData :exec
+-- name: InsertDirRawData :one
 INSERT INTO dirrawdata (
     iddirrawdata, idmeasurement, rawdata, columndata, info, unitsdata,
     created_by, mod_by, mod_date, deleted, created_date, modded
@@ -300,10 +304,11 @@ ON CONFLICT (iddirrawdata) DO UPDATE SET
     mod_date = EXCLUDED.mod_date,
     deleted = EXCLUDED.deleted,
     created_date = EXCLUDED.created_date,
-    modded = EXCLUDED.modded;
+    modded = EXCLUDED.modded
+RETURNING (xmax = 0) AS inserted;
 
 
--- name: InsertDirReport :exec
+-- name: InsertDirReport :one
 INSERT INTO dirreport (
     iddirreport, page_instructions, idmeasurement, template_rec, librec_name,
     created_by, mod_by, mod_date, deleted, created_date, modded
@@ -321,10 +326,11 @@ ON CONFLICT (iddirreport) DO UPDATE SET
     mod_date = EXCLUDED.mod_date,
     deleted = EXCLUDED.deleted,
     created_date = EXCLUDED.created_date,
-    modded = EXCLUDED.modded;
+    modded = EXCLUDED.modded
+RETURNING (xmax = 0) AS inserted;
 
 
--- name: InsertDirResults :exec
+-- name: InsertDirResults :one
 INSERT INTO dirresults (
     iddirresults, idmeasurement, max_vo2mlkgmin, max_vo2mlmin, max_vo2,
     max_hr, max_speed, max_pace, max_p, max_pkg, max_angle, max_lac,
@@ -437,7 +443,8 @@ ON CONFLICT (iddirresults) DO UPDATE SET
     mod_date = EXCLUDED.mod_date,
     deleted = EXCLUDED.deleted,
     created_date = EXCLUDED.created_date,
-    modded = EXCLUDED.modded;
+    modded = EXCLUDED.modded
+RETURNING (xmax = 0) AS inserted;
 
 -- name: GetCustomerByID :one
 SELECT * FROM customer
@@ -446,8 +453,10 @@ WHERE idcustomer = $1;
 -- name: GetMeasurementsByCustomer :many
 SELECT *
 FROM measurement_list
-WHERE idcustomer = $1
-ORDER BY idmeasurement;
+WHERE idcustomer = @idcustomer
+  AND (sqlc.narg(after_idmeasurement)::int8 IS NULL OR idmeasurement > sqlc.narg(after_idmeasurement)::int8)
+ORDER BY idmeasurement
+LIMIT @page_limit::int4;
 
 -- name: GetDirTestsByMeasurementIDs :many
 SELECT *
@@ -491,3 +500,11 @@ WHERE sportti_id = $1;
 DELETE FROM customer
 WHERE sportti_id = $1
 RETURNING idcustomer, sportti_id;
+
+
+-- name: ListCustomerIdentities :many
+SELECT sportti_id, firstname, lastname, dob
+FROM customer
+WHERE sportti_id IS NOT NULL
+  AND sportti_id <> ''
+  AND COALESCE(deleted, 0) = 0;
@@ -32,11 +32,34 @@ func (q *Queries) DeleteUser(ctx context.Context, id uuid.UUID) (int64, error) {
 const getActivityZonesByUser = `-- name: GetActivityZonesByUser :many
 SELECT user_id, date, created_at, updated_at, seconds_in_zone_0, seconds_in_zone_1, seconds_in_zone_2, seconds_in_zone_3, seconds_in_zone_4, seconds_in_zone_5, source, raw_data FROM activity_zones
 WHERE user_id = $1
-ORDER BY date DESC, created_at DESC
+  AND ($2::date IS NULL OR (date, source) < ($2::date, $3::text))
+  AND ($4::date IS NULL OR date >= $4::date)
+  AND ($5::date IS NULL OR date <= $5::date)
+  AND ($6::text IS NULL OR source = $6::text)
+ORDER BY date DESC, source DESC
+LIMIT $7::int4
 `
 
-func (q *Queries) GetActivityZonesByUser(ctx context.Context, userID uuid.UUID) ([]ActivityZone, error) {
-	rows, err := q.query(ctx, q.getActivityZonesByUserStmt, getActivityZonesByUser, userID)
+type GetActivityZonesByUserParams struct {
+	UserID      uuid.UUID
+	AfterDate   sql.NullTime
+	AfterSource sql.NullString
+	FromDate    sql.NullTime
+	ToDate      sql.NullTime
+	Source      sql.NullString
+	PageLimit   int32
+}
+
+func (q *Queries) GetActivityZonesByUser(ctx context.Context, arg GetActivityZonesByUserParams) ([]ActivityZone, error) {
+	rows, err := q.query(ctx, q.getActivityZonesByUserStmt, getActivityZonesByUser,
+		arg.UserID,
+		arg.AfterDate,
+		arg.AfterSource,
+		arg.FromDate,
+		arg.ToDate,
+		arg.Source,
+		arg.PageLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -105,14 +128,14 @@ func (q *Queries) GetDeletedUsers(ctx context.Context) ([]DeletedUsersLog, error
 	return items, nil
 }
 
-const getExerciseHRZones = `-- name: GetExerciseHRZones :many
+const getExerciseHRZonesByExerciseIDs = `-- name: GetExerciseHRZonesByExerciseIDs :many
 SELECT exercise_id, zone_index, seconds_in_zone, lower_limit, upper_limit, created_at, updated_at FROM exercise_hr_zones
-WHERE exercise_id = $1
-ORDER BY zone_index
+WHERE exercise_id = ANY($1::uuid[])
+ORDER BY exercise_id, zone_index
 `
 
-func (q *Queries) GetExerciseHRZones(ctx context.Context, exerciseID uuid.UUID) ([]ExerciseHrZone, error) {
-	rows, err := q.query(ctx, q.getExerciseHRZonesStmt, getExerciseHRZones, exerciseID)
+func (q *Queries) GetExerciseHRZonesByExerciseIDs(ctx context.Context, dollar_1 []uuid.UUID) ([]ExerciseHrZone, error) {
+	rows, err := q.query(ctx, q.getExerciseHRZonesByExerciseIDsStmt, getExerciseHRZonesByExerciseIDs, pq.Array(dollar_1))
 	if err != nil {
 		return nil, err
 	}
@@ -142,13 +165,14 @@ func (q *Queries) GetExerciseHRZones(ctx context.Context, exerciseID uuid.UUID)
 	return items, nil
 }
 
-const getExerciseSamples = `-- name: GetExerciseSamples :many
+const getExerciseSamplesByExerciseIDs = `-- name: GetExerciseSamplesByExerciseIDs :many
 SELECT id, user_id, exercise_id, sample_type, recording_rate, samples, source FROM exercise_samples
-WHERE exercise_id = $1
+WHERE exercise_id = ANY($1::uuid[])
+ORDER BY exercise_id, id
 `
 
-func (q *Queries) GetExerciseSamples(ctx context.Context, exerciseID uuid.UUID) ([]ExerciseSample, error) {
-	rows, err := q.query(ctx, q.getExerciseSamplesStmt, getExerciseSamples, exerciseID)
+func (q *Queries) GetExerciseSamplesByExerciseIDs(ctx context.Context, dollar_1 []uuid.UUID) ([]ExerciseSample, error) {
+	rows, err := q.query(ctx, q.getExerciseSamplesByExerciseIDsStmt, getExerciseSamplesByExerciseIDs, pq.Array(dollar_1))
 	if err != nil {
 		return nil, err
 	}
@@ -178,14 +202,14 @@ func (q *Queries) GetExerciseSamples(ctx context.Context, exerciseID uuid.UUID)
 	return items, nil
 }
 
-const getExerciseSections = `-- name: GetExerciseSections :many
+const getExerciseSectionsByExerciseIDs = `-- name: GetExerciseSectionsByExerciseIDs :many
 SELECT id, user_id, exercise_id, created_at, updated_at, start_time, end_time, section_type, name, comment, source, raw_id, raw_data FROM exercise_sections
-WHERE exercise_id = $1
-ORDER BY start_time
+WHERE exercise_id = ANY($1::uuid[])
+ORDER BY exercise_id, start_time
 `
 
-func (q *Queries) GetExerciseSections(ctx context.Context, exerciseID uuid.UUID) ([]ExerciseSection, error) {
-	rows, err := q.query(ctx, q.getExerciseSectionsStmt, getExerciseSections, exerciseID)
+func (q *Queries) GetExerciseSectionsByExerciseIDs(ctx context.Context, dollar_1 []uuid.UUID) ([]ExerciseSection, error) {
+	rows, err := q.query(ctx, q.getExerciseSectionsByExerciseIDsStmt, getExerciseSectionsByExerciseIDs, pq.Array(dollar_1))
 	if err != nil {
 		return nil, err
 	}
@@ -224,11 +248,37 @@ func (q *Queries) GetExerciseSections(ctx context.Context, exerciseID uuid.UUID)
 const getExercisesByUser = `-- name: GetExercisesByUser :many
 SELECT id, created_at, updated_at, user_id, start_time, duration, comment, sport_type, detailed_sport_type, distance, avg_heart_rate, max_heart_rate, trimp, sprint_count, avg_speed, max_speed, source, status, calories, training_load, raw_id, raw_data, feeling, recovery, rpe FROM exercises
 WHERE user_id = $1
-ORDER BY start_time DESC
+  AND ($2::timestamptz IS NULL OR (start_time, id) < ($2::timestamptz, $3::uuid))
+  AND ($4::timestamptz IS NULL OR start_time >= $4::timestamptz)
+  AND ($5::timestamptz IS NULL OR start_time < $5::timestamptz)
+  AND ($6::text IS NULL OR source = $6::text)
+  AND ($7::text IS NULL OR sport_type = $7::text)
+ORDER BY start_time DESC, id DESC
+LIMIT $8::int4
 `
 
-func (q *Queries) GetExercisesByUser(ctx context.Context, userID uuid.UUID) ([]Exercise, error) {
-	rows, err := q.query(ctx, q.getExercisesByUserStmt, getExercisesByUser, userID)
+type GetExercisesByUserParams struct {
+	UserID    uuid.UUID
+	AfterTime sql.NullTime
+	AfterID   uuid.NullUUID
+	FromTime  sql.NullTime
+	ToTime    sql.NullTime
+	Source    sql.NullString
+	SportType sql.NullString
+	PageLimit int32
+}
+
+func (q *Queries) GetExercisesByUser(ctx context.Context, arg GetExercisesByUserParams) ([]Exercise, error) {
+	rows, err := q.query(ctx, q.getExercisesByUserStmt, getExercisesByUser,
+		arg.UserID,
+		arg.AfterTime,
+		arg.AfterID,
+		arg.FromTime,
+		arg.ToTime,
+		arg.Source,
+		arg.SportType,
+		arg.PageLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -279,11 +329,37 @@ func (q *Queries) GetExercisesByUser(ctx context.Context, userID uuid.UUID) ([]E
 const getMeasurementsByUser = `-- name: GetMeasurementsByUser :many
 SELECT id, created_at, updated_at, user_id, date, name, name_type, source, value, value_numeric, comment, raw_id, raw_data, additional_info FROM measurements
 WHERE user_id = $1
-ORDER BY date DESC, created_at DESC
+  AND ($2::date IS NULL OR (date, id) < ($2::date, $3::uuid))
+  AND ($4::date IS NULL OR date >= $4::date)
+  AND ($5::date IS NULL OR date <= $5::date)
+  AND ($6::citext IS NULL OR source = $6::citext)
+  AND ($7::citext IS NULL OR name = $7::citext)
+ORDER BY date DESC, id DESC
+LIMIT $8::int4
 `
 
-func (q *Queries) GetMeasurementsByUser(ctx context.Context, userID uuid.UUID) ([]Measurement, error) {
-	rows, err := q.query(ctx, q.getMeasurementsByUserStmt, getMeasurementsByUser, userID)
+type GetMeasurementsByUserParams struct {
+	UserID    uuid.UUID
+	AfterDate sql.NullTime
+	AfterID   uuid.NullUUID
+	FromDate  sql.NullTime
+	ToDate    sql.NullTime
+	Source    sql.NullString
+	Name      sql.NullString
+	PageLimit int32
+}
+
+func (q *Queries) GetMeasurementsByUser(ctx context.Context, arg GetMeasurementsByUserParams) ([]Measurement, error) {
+	rows, err := q.query(ctx, q.getMeasurementsByUserStmt, getMeasurementsByUser,
+		arg.UserID,
+		arg.AfterDate,
+		arg.AfterID,
+		arg.FromDate,
+		arg.ToDate,
+		arg.Source,
+		arg.Name,
+		arg.PageLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -323,11 +399,36 @@ func (q *Queries) GetMeasurementsByUser(ctx context.Context, userID uuid.UUID) (
 const getQuestionnairesByUser = `-- name: GetQuestionnairesByUser :many
 SELECT user_id, questionnaire_instance_id, questionnaire_name_fi, questionnaire_name_en, questionnaire_key, question_id, question_label_fi, question_label_en, question_type, option_id, option_value, option_label_fi, option_label_en, free_text, created_at, updated_at, value FROM question_answers
 WHERE user_id = $1
-ORDER BY created_at DESC
+  AND ($2::timestamptz IS NULL OR (created_at, questionnaire_instance_id, question_id) < ($2::timestamptz, $3::uuid, $4::uuid))
+  AND ($5::timestamptz IS NULL OR created_at >= $5::timestamptz)
+  AND ($6::timestamptz IS NULL OR created_at < $6::timestamptz)
+  AND ($7::text IS NULL OR questionnaire_key = $7::text)
+ORDER BY created_at DESC, questionnaire_instance_id DESC, question_id DESC
+LIMIT $8::int4
 `
 
-func (q *Queries) GetQuestionnairesByUser(ctx context.Context, userID uuid.UUID) ([]QuestionAnswer, error) {
-	rows, err := q.query(ctx, q.getQuestionnairesByUserStmt, getQuestionnairesByUser, userID)
+type GetQuestionnairesByUserParams struct {
+	UserID                       uuid.UUID
+	AfterCreatedAt               sql.NullTime
+	AfterQuestionnaireInstanceID uuid.NullUUID
+	AfterQuestionID              uuid.NullUUID
+	FromTime                     sql.NullTime
+	ToTime                       sql.NullTime
+	QuestionnaireKey             sql.NullString
+	PageLimit                    int32
+}
+
+func (q *Queries) GetQuestionnairesByUser(ctx context.Context, arg GetQuestionnairesByUserParams) ([]QuestionAnswer, error) {
+	rows, err := q.query(ctx, q.getQuestionnairesByUserStmt, getQuestionnairesByUser,
+		arg.UserID,
+		arg.AfterCreatedAt,
+		arg.AfterQuestionnaireInstanceID,
+		arg.AfterQuestionID,
+		arg.FromTime,
+		arg.ToTime,
+		arg.QuestionnaireKey,
+		arg.PageLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -370,11 +471,37 @@ func (q *Queries) GetQuestionnairesByUser(ctx context.Context, userID uuid.UUID)
 const getSymptomsByUser = `-- name: GetSymptomsByUser :many
 SELECT id, user_id, date, symptom, severity, comment, source, created_at, updated_at, raw_id, original_id, recovered, pain_index, side, category, additional_data FROM symptoms
 WHERE user_id = $1
-ORDER BY date DESC, created_at DESC
+  AND ($2::date IS NULL OR (date, id) < ($2::date, $3::uuid))
+  AND ($4::date IS NULL OR date >= $4::date)
+  AND ($5::date IS NULL OR date <= $5::date)
+  AND ($6::citext IS NULL OR source = $6::citext)
+  AND ($7::text IS NULL OR symptom = $7::text)
+ORDER BY date DESC, id DESC
+LIMIT $8::int4
 `
 
-func (q *Queries) GetSymptomsByUser(ctx context.Context, userID uuid.UUID) ([]Symptom, error) {
-	rows, err := q.query(ctx, q.getSymptomsByUserStmt, getSymptomsByUser, userID)
+type GetSymptomsByUserParams struct {
+	UserID    uuid.UUID
+	AfterDate sql.NullTime
+	AfterID   uuid.NullUUID
+	FromDate  sql.NullTime
+	ToDate    sql.NullTime
+	Source    sql.NullString
+	Symptom   sql.NullString
+	PageLimit int32
+}
+
+func (q *Queries) GetSymptomsByUser(ctx context.Context, arg GetSymptomsByUserParams) ([]Symptom, error) {
+	rows, err := q.query(ctx, q.getSymptomsByUserStmt, getSymptomsByUser,
+		arg.UserID,
+		arg.AfterDate,
+		arg.AfterID,
+		arg.FromDate,
+		arg.ToDate,
+		arg.Source,
+		arg.Symptom,
+		arg.PageLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -416,11 +543,31 @@ func (q *Queries) GetSymptomsByUser(ctx context.Context, userID uuid.UUID) ([]Sy
 const getTestResultsByUser = `-- name: GetTestResultsByUser :many
 SELECT id, user_id, type_id, type_type, type_result_type, type_name, timestamp, name, comment, data, created_at, updated_at, test_event_id, test_event_name, test_event_date, test_event_template_test_id, test_event_template_test_name, test_event_template_test_limits FROM test_results
 WHERE user_id = $1
-ORDER BY timestamp DESC, created_at DESC
+  AND ($2::timestamptz IS NULL OR (timestamp, id) < ($2::timestamptz, $3::uuid))
+  AND ($4::timestamptz IS NULL OR timestamp >= $4::timestamptz)
+  AND ($5::timestamptz IS NULL OR timestamp < $5::timestamptz)
+ORDER BY timestamp DESC, id DESC
+LIMIT $6::int4
 `
 
-func (q *Queries) GetTestResultsByUser(ctx context.Context, userID uuid.UUID) ([]TestResult, error) {
-	rows, err := q.query(ctx, q.getTestResultsByUserStmt, getTestResultsByUser, userID)
+type GetTestResultsByUserParams struct {
+	UserID         uuid.UUID
+	AfterTimestamp sql.NullTime
+	AfterID        uuid.NullUUID
+	FromTime       sql.NullTime
+	ToTime         sql.NullTime
+	PageLimit      int32
+}
+
+func (q *Queries) GetTestResultsByUser(ctx context.Context, arg GetTestResultsByUserParams) ([]TestResult, error) {
+	rows, err := q.query(ctx, q.getTestResultsByUserStmt, getTestResultsByUser,
+		arg.UserID,
+		arg.AfterTimestamp,
+		arg.AfterID,
+		arg.FromTime,
+		arg.ToTime,
+		arg.PageLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -484,7 +631,7 @@ func (q *Queries) GetUser(ctx context.Context, id uuid.UUID) (User, error) {
 	return i, err
 }
 
-const insertActivityZone = `-- name: InsertActivityZone :exec
+const insertActivityZone = `-- name: InsertActivityZone :one
 INSERT INTO activity_zones (
     user_id, date, created_at, updated_at,
     seconds_in_zone_0, seconds_in_zone_1, seconds_in_zone_2,
@@ -505,6 +652,7 @@ ON CONFLICT (user_id, date, source) DO UPDATE SET
   seconds_in_zone_4= EXCLUDED.seconds_in_zone_4,
   seconds_in_zone_5= EXCLUDED.seconds_in_zone_5,
   raw_data         = EXCLUDED.raw_data
+RETURNING (xmax = 0) AS inserted
 `
 
 type InsertActivityZoneParams struct {
@@ -522,8 +670,8 @@ type InsertActivityZoneParams struct {
 	RawData        pqtype.NullRawMessage
 }
 
-func (q *Queries) InsertActivityZone(ctx context.Context, arg InsertActivityZoneParams) error {
-	_, err := q.exec(ctx, q.insertActivityZoneStmt, insertActivityZone,
+func (q *Queries) InsertActivityZone(ctx context.Context, arg InsertActivityZoneParams) (bool, error) {
+	row := q.queryRow(ctx, q.insertActivityZoneStmt, insertActivityZone,
 		arg.UserID,
 		arg.Date,
 		arg.CreatedAt,
@@ -537,10 +685,12 @@ func (q *Queries) InsertActivityZone(ctx context.Context, arg InsertActivityZone
 		arg.Source,
 		arg.RawData,
 	)
-	return err
+	var inserted bool
+	err := row.Scan(&inserted)
+	return inserted, err
 }
 
-const insertExercise = `-- name: InsertExercise :exec
+const insertExercise = `-- name: InsertExercise :one
 INSERT INTO exercises (
     id, created_at, updated_at, user_id, start_time, duration,
     comment, sport_type, detailed_sport_type, distance, avg_heart_rate,
@@ -575,6 +725,7 @@ ON CONFLICT (id) DO UPDATE SET
   recovery            = EXCLUDED.recovery,
   rpe                 = EXCLUDED.rpe,
   raw_data            = EXCLUDED.raw_data
+RETURNING (xmax = 0) AS inserted
 `
 
 type InsertExerciseParams struct {
@@ -605,8 +756,8 @@ type InsertExerciseParams struct {
 	RawData           pqtype.NullRawMessage
 }
 
-func (q *Queries) InsertExercise(ctx context.Context, arg InsertExerciseParams) error {
-	_, err := q.exec(ctx, q.insertExerciseStmt, insertExercise,
+func (q *Queries) InsertExercise(ctx context.Context, arg InsertExerciseParams) (bool, error) {
+	row := q.queryRow(ctx, q.insertExerciseStmt, insertExercise,
 		arg.ID,
 		arg.CreatedAt,
 		arg.UpdatedAt,
@@ -633,7 +784,9 @@ func (q *Queries) InsertExercise(ctx context.Context, arg InsertExerciseParams)
 		arg.Rpe,
 		arg.RawData,
 	)
-	return err
+	var inserted bool
+	err := row.Scan(&inserted)
+	return inserted, err
 }
 
 const insertExerciseHRZone = `-- name: InsertExerciseHRZone :exec
@@ -766,7 +919,7 @@ func (q *Queries) InsertExerciseSection(ctx context.Context, arg InsertExerciseS
 	return err
 }
 
-const insertMeasurement = `-- name: InsertMeasurement :exec
+const insertMeasurement = `-- name: InsertMeasurement :one
 INSERT INTO measurements (
     id, created_at, updated_at, user_id, date, name, name_type,
     source, value, value_numeric, comment, raw_id, raw_data, additional_info
@@ -782,6 +935,7 @@ ON CONFLICT (id) DO UPDATE SET
   comment        = EXCLUDED.comment,
   raw_data       = EXCLUDED.raw_data,
   additional_info= EXCLUDED.additional_info
+RETURNING (xmax = 0) AS inserted
 `
 
 type InsertMeasurementParams struct {
@@ -801,8 +955,8 @@ type InsertMeasurementParams struct {
 	AdditionalInfo pqtype.NullRawMessage
 }
 
-func (q *Queries) InsertMeasurement(ctx context.Context, arg InsertMeasurementParams) error {
-	_, err := q.exec(ctx, q.insertMeasurementStmt, insertMeasurement,
+func (q *Queries) InsertMeasurement(ctx context.Context, arg InsertMeasurementParams) (bool, error) {
+	row := q.queryRow(ctx, q.insertMeasurementStmt, insertMeasurement,
 		arg.ID,
 		arg.CreatedAt,
 		arg.UpdatedAt,
@@ -818,10 +972,12 @@ func (q *Queries) InsertMeasurement(ctx context.Context, arg InsertMeasurementPa
 		arg.RawData,
 		arg.AdditionalInfo,
 	)
-	return err
+	var inserted bool
+	err := row.Scan(&inserted)
+	return inserted, err
 }
 
-const insertQuestionnaireAnswer = `-- name: InsertQuestionnaireAnswer :exec
+const insertQuestionnaireAnswer = `-- name: InsertQuestionnaireAnswer :one
 INSERT INTO question_answers (
     user_id, questionnaire_instance_id, questionnaire_name_fi,
     questionnaire_name_en, questionnaire_key, question_id, question_label_fi,
@@ -847,6 +1003,7 @@ ON CONFLICT (questionnaire_instance_id, question_id, user_id) DO UPDATE SET
   free_text             = EXCLUDED.free_text,
   updated_at            = GREATEST(question_answers.updated_at, EXCLUDED.updated_at),
   value                 = EXCLUDED.value
+RETURNING (xmax = 0) AS inserted
 `
 
 type InsertQuestionnaireAnswerParams struct {
@@ -869,8 +1026,8 @@ type InsertQuestionnaireAnswerParams struct {
 	Value                   pqtype.NullRawMessage
 }
 
-func (q *Queries) InsertQuestionnaireAnswer(ctx context.Context, arg InsertQuestionnaireAnswerParams) error {
-	_, err := q.exec(ctx, q.insertQuestionnaireAnswerStmt, insertQuestionnaireAnswer,
+func (q *Queries) InsertQuestionnaireAnswer(ctx context.Context, arg InsertQuestionnaireAnswerParams) (bool, error) {
+	row := q.queryRow(ctx, q.insertQuestionnaireAnswerStmt, insertQuestionnaireAnswer,
 		arg.UserID,
 		arg.QuestionnaireInstanceID,
 		arg.QuestionnaireNameFi,
@@ -889,10 +1046,12 @@ func (q *Queries) InsertQuestionnaireAnswer(ctx context.Context, arg InsertQuest
 		arg.UpdatedAt,
 		arg.Value,
 	)
-	return err
+	var inserted bool
+	err := row.Scan(&inserted)
+	return inserted, err
 }
 
-const insertSymptom = `-- name: InsertSymptom :exec
+const insertSymptom = `-- name: InsertSymptom :one
 INSERT INTO symptoms (
     id, user_id, date, symptom, severity, comment, source,
     created_at, updated_at, raw_id, original_id, recovered,
@@ -913,6 +1072,7 @@ ON CONFLICT (id) DO UPDATE SET
   side            = EXCLUDED.side,
   category        = EXCLUDED.category,
   additional_data = EXCLUDED.additional_data
+RETURNING (xmax = 0) AS inserted
 `
 
 type InsertSymptomParams struct {
@@ -934,8 +1094,8 @@ type InsertSymptomParams struct {
 	AdditionalData pqtype.NullRawMessage
 }
 
-func (q *Queries) InsertSymptom(ctx context.Context, arg InsertSymptomParams) error {
-	_, err := q.exec(ctx, q.insertSymptomStmt, insertSymptom,
+func (q *Queries) InsertSymptom(ctx context.Context, arg InsertSymptomParams) (bool, error) {
+	row := q.queryRow(ctx, q.insertSymptomStmt, insertSymptom,
 		arg.ID,
 		arg.UserID,
 		arg.Date,
@@ -953,10 +1113,12 @@ func (q *Queries) InsertSymptom(ctx context.Context, arg InsertSymptomParams) er
 		arg.Category,
 		arg.AdditionalData,
 	)
-	return err
+	var inserted bool
+	err := row.Scan(&inserted)
+	return inserted, err
 }
 
-const insertTestResult = `-- name: InsertTestResult :exec
+const insertTestResult = `-- name: InsertTestResult :one
 INSERT INTO test_results (
     id, user_id, type_id, type_type, type_result_type, type_name,
     timestamp, name, comment, data, created_at, updated_at,
@@ -984,6 +1146,7 @@ ON CONFLICT (id) DO UPDATE SET
   test_event_template_test_id    = EXCLUDED.test_event_template_test_id,
   test_event_template_test_name  = EXCLUDED.test_event_template_test_name,
   test_event_template_test_limits= EXCLUDED.test_event_template_test_limits
+RETURNING (xmax = 0) AS inserted
 `
 
 type InsertTestResultParams struct {
@@ -1007,8 +1170,8 @@ type InsertTestResultParams struct {
 	TestEventTemplateTestLimits pqtype.NullRawMessage
 }
 
-func (q *Queries) InsertTestResult(ctx context.Context, arg InsertTestResultParams) error {
-	_, err := q.exec(ctx, q.insertTestResultStmt, insertTestResult,
+func (q *Queries) InsertTestResult(ctx context.Context, arg InsertTestResultParams) (bool, error) {
+	row := q.queryRow(ctx, q.insertTestResultStmt, insertTestResult,
 		arg.ID,
 		arg.UserID,
 		arg.TypeID,
@@ -1028,7 +1191,9 @@ func (q *Queries) InsertTestResult(ctx context.Context, arg InsertTestResultPara
 		arg.TestEventTemplateTestName,
 		arg.TestEventTemplateTestLimits,
 	)
-	return err
+	var inserted bool
+	err := row.Scan(&inserted)
+	return inserted, err
 }
 
 const logDeletedUser = `-- name: LogDeletedUser :exec
@@ -1096,3 +1261,41 @@ func (q *Queries) UpsertUser(ctx context.Context, arg UpsertUserParams) error {
 	)
 	return err
 }
+
+const listUserIdentities = `-- name: ListUserIdentities :many
+SELECT sportti_id, profile_gender, profile_birthdate
+FROM users
+`
+
+type ListUserIdentitiesRow struct {
+	SporttiID        int32
+	ProfileGender    sql.NullString
+	ProfileBirthdate sql.NullTime
+}
+
+func (q *Queries) ListUserIdentities(ctx context.Context) ([]ListUserIdentitiesRow, error) {
+	rows, err := q.query(ctx, q.listUserIdentitiesStmt, listUserIdentities)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUserIdentitiesRow
+	for rows.Next() {
+		var i ListUserIdentitiesRow
+		if err := rows.Scan(
+			&i.SporttiID,
+			&i.ProfileGender,
+			&i.ProfileBirthdate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
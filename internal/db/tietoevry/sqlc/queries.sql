@@ -24,7 +24,7 @@ ON CONFLICT (id) DO UPDATE SET
 DELETE FROM users
 WHERE id = $1;
 
--- name: InsertExercise :exec
+-- name: InsertExercise :one
 INSERT INTO exercises (
     id, created_at, updated_at, user_id, start_time, duration,
     comment, sport_type, detailed_sport_type, distance, avg_heart_rate,
@@ -58,7 +58,8 @@ ON CONFLICT (id) DO UPDATE SET
   feeling             = EXCLUDED.feeling,
   recovery            = EXCLUDED.recovery,
   rpe                 = EXCLUDED.rpe,
-  raw_data            = EXCLUDED.raw_data;
+  raw_data            = EXCLUDED.raw_data
+RETURNING (xmax = 0) AS inserted;
 
 
 -- name: InsertExerciseHRZone :exec
@@ -108,7 +109,7 @@ ON CONFLICT (id) DO UPDATE SET
   raw_data    = EXCLUDED.raw_data;
 
 
--- name: InsertSymptom :exec
+-- name: InsertSymptom :one
 INSERT INTO symptoms (
     id, user_id, date, symptom, severity, comment, source,
     created_at, updated_at, raw_id, original_id, recovered,
@@ -128,10 +129,11 @@ ON CONFLICT (id) DO UPDATE SET
   pain_index      = EXCLUDED.pain_index,
   side            = EXCLUDED.side,
   category        = EXCLUDED.category,
-  additional_data = EXCLUDED.additional_data;
+  additional_data = EXCLUDED.additional_data
+RETURNING (xmax = 0) AS inserted;
 
 
--- name: InsertMeasurement :exec
+-- name: InsertMeasurement :one
 INSERT INTO measurements (
     id, created_at, updated_at, user_id, date, name, name_type,
     source, value, value_numeric, comment, raw_id, raw_data, additional_info
@@ -146,10 +148,11 @@ ON CONFLICT (id) DO UPDATE SET
   value_numeric  = EXCLUDED.value_numeric,
   comment        = EXCLUDED.comment,
   raw_data       = EXCLUDED.raw_data,
-  additional_info= EXCLUDED.additional_info;
+  additional_info= EXCLUDED.additional_info
+RETURNING (xmax = 0) AS inserted;
 
 
--- name: InsertTestResult :exec
+-- name: InsertTestResult :one
 INSERT INTO test_results (
     id, user_id, type_id, type_type, type_result_type, type_name,
     timestamp, name, comment, data, created_at, updated_at,
@@ -176,10 +179,11 @@ ON CONFLICT (id) DO UPDATE SET
   test_event_date                = EXCLUDED.test_event_date,
   test_event_template_test_id    = EXCLUDED.test_event_template_test_id,
   test_event_template_test_name  = EXCLUDED.test_event_template_test_name,
-  test_event_template_test_limits= EXCLUDED.test_event_template_test_limits;
+  test_event_template_test_limits= EXCLUDED.test_event_template_test_limits
+RETURNING (xmax = 0) AS inserted;
 
 
--- name: InsertQuestionnaireAnswer :exec
+-- name: InsertQuestionnaireAnswer :one
 INSERT INTO question_answers (
     user_id, questionnaire_instance_id, questionnaire_name_fi,
     questionnaire_name_en, questionnaire_key, question_id, question_label_fi,
@@ -204,11 +208,12 @@ ON CONFLICT (questionnaire_instance_id, question_id, user_id) DO UPDATE SET
   option_label_en       = EXCLUDED.option_label_en,
   free_text             = EXCLUDED.free_text,
   updated_at            = GREATEST(question_answers.updated_at, EXCLUDED.updated_at),
-  value                 = EXCLUDED.value;
+  value                 = EXCLUDED.value
+RETURNING (xmax = 0) AS inserted;
 
 
 
--- name: InsertActivityZone :exec
+-- name: InsertActivityZone :one
 INSERT INTO activity_zones (
     user_id, date, created_at, updated_at,
     seconds_in_zone_0, seconds_in_zone_1, seconds_in_zone_2,
@@ -228,7 +233,8 @@ ON CONFLICT (user_id, date, source) DO UPDATE SET
   seconds_in_zone_3= EXCLUDED.seconds_in_zone_3,
   seconds_in_zone_4= EXCLUDED.seconds_in_zone_4,
   seconds_in_zone_5= EXCLUDED.seconds_in_zone_5,
-  raw_data         = EXCLUDED.raw_data;
+  raw_data         = EXCLUDED.raw_data
+RETURNING (xmax = 0) AS inserted;
 
 -- name: GetUser :one
 SELECT * FROM users WHERE id = $1;
@@ -246,44 +252,82 @@ ORDER BY deleted_at DESC;
 
 -- name: GetExercisesByUser :many
 SELECT * FROM exercises
-WHERE user_id = $1
-ORDER BY start_time DESC;
+WHERE user_id = @user_id
+  AND (sqlc.narg(after_time)::timestamptz IS NULL OR (start_time, id) < (sqlc.narg(after_time)::timestamptz, sqlc.narg(after_id)::uuid))
+  AND (sqlc.narg(from_time)::timestamptz IS NULL OR start_time >= sqlc.narg(from_time)::timestamptz)
+  AND (sqlc.narg(to_time)::timestamptz IS NULL OR start_time < sqlc.narg(to_time)::timestamptz)
+  AND (sqlc.narg(source)::text IS NULL OR source = sqlc.narg(source)::text)
+  AND (sqlc.narg(sport_type)::text IS NULL OR sport_type = sqlc.narg(sport_type)::text)
+ORDER BY start_time DESC, id DESC
+LIMIT @page_limit::int4;
 
--- name: GetExerciseHRZones :many
+-- name: GetExerciseHRZonesByExerciseIDs :many
 SELECT * FROM exercise_hr_zones
-WHERE exercise_id = $1
-ORDER BY zone_index;
+WHERE exercise_id = ANY($1::uuid[])
+ORDER BY exercise_id, zone_index;
 
--- name: GetExerciseSamples :many
+-- name: GetExerciseSamplesByExerciseIDs :many
 SELECT * FROM exercise_samples
-WHERE exercise_id = $1;
+WHERE exercise_id = ANY($1::uuid[])
+ORDER BY exercise_id, id;
 
--- name: GetExerciseSections :many
+-- name: GetExerciseSectionsByExerciseIDs :many
 SELECT * FROM exercise_sections
-WHERE exercise_id = $1
-ORDER BY start_time;
+WHERE exercise_id = ANY($1::uuid[])
+ORDER BY exercise_id, start_time;
 
 -- name: GetSymptomsByUser :many
 SELECT * FROM symptoms
-WHERE user_id = $1
-ORDER BY date DESC, created_at DESC;
+WHERE user_id = @user_id
+  AND (sqlc.narg(after_date)::date IS NULL OR (date, id) < (sqlc.narg(after_date)::date, sqlc.narg(after_id)::uuid))
+  AND (sqlc.narg(from_date)::date IS NULL OR date >= sqlc.narg(from_date)::date)
+  AND (sqlc.narg(to_date)::date IS NULL OR date <= sqlc.narg(to_date)::date)
+  AND (sqlc.narg(source)::citext IS NULL OR source = sqlc.narg(source)::citext)
+  AND (sqlc.narg(symptom)::text IS NULL OR symptom = sqlc.narg(symptom)::text)
+ORDER BY date DESC, id DESC
+LIMIT @page_limit::int4;
 
 -- name: GetMeasurementsByUser :many
 SELECT * FROM measurements
-WHERE user_id = $1
-ORDER BY date DESC, created_at DESC;
+WHERE user_id = @user_id
+  AND (sqlc.narg(after_date)::date IS NULL OR (date, id) < (sqlc.narg(after_date)::date, sqlc.narg(after_id)::uuid))
+  AND (sqlc.narg(from_date)::date IS NULL OR date >= sqlc.narg(from_date)::date)
+  AND (sqlc.narg(to_date)::date IS NULL OR date <= sqlc.narg(to_date)::date)
+  AND (sqlc.narg(source)::citext IS NULL OR source = sqlc.narg(source)::citext)
+  AND (sqlc.narg(name)::citext IS NULL OR name = sqlc.narg(name)::citext)
+ORDER BY date DESC, id DESC
+LIMIT @page_limit::int4;
 
 -- name: GetTestResultsByUser :many
 SELECT * FROM test_results
-WHERE user_id = $1
-ORDER BY timestamp DESC, created_at DESC;
+WHERE user_id = @user_id
+  AND (sqlc.narg(after_timestamp)::timestamptz IS NULL OR (timestamp, id) < (sqlc.narg(after_timestamp)::timestamptz, sqlc.narg(after_id)::uuid))
+  AND (sqlc.narg(from_time)::timestamptz IS NULL OR timestamp >= sqlc.narg(from_time)::timestamptz)
+  AND (sqlc.narg(to_time)::timestamptz IS NULL OR timestamp < sqlc.narg(to_time)::timestamptz)
+ORDER BY timestamp DESC, id DESC
+LIMIT @page_limit::int4;
 
 -- name: GetQuestionnairesByUser :many
 SELECT * FROM question_answers
-WHERE user_id = $1
-ORDER BY created_at DESC;
+WHERE user_id = @user_id
+  AND (sqlc.narg(after_created_at)::timestamptz IS NULL OR (created_at, questionnaire_instance_id, question_id) < (sqlc.narg(after_created_at)::timestamptz, sqlc.narg(after_questionnaire_instance_id)::uuid, sqlc.narg(after_question_id)::uuid))
+  AND (sqlc.narg(from_time)::timestamptz IS NULL OR created_at >= sqlc.narg(from_time)::timestamptz)
+  AND (sqlc.narg(to_time)::timestamptz IS NULL OR created_at < sqlc.narg(to_time)::timestamptz)
+  AND (sqlc.narg(questionnaire_key)::text IS NULL OR questionnaire_key = sqlc.narg(questionnaire_key)::text)
+ORDER BY created_at DESC, questionnaire_instance_id DESC, question_id DESC
+LIMIT @page_limit::int4;
 
 -- name: GetActivityZonesByUser :many
 SELECT * FROM activity_zones
-WHERE user_id = $1
-ORDER BY date DESC, created_at DESC;
\ No newline at end of file
+WHERE user_id = @user_id
+  AND (sqlc.narg(after_date)::date IS NULL OR (date, source) < (sqlc.narg(after_date)::date, sqlc.narg(after_source)::text))
+  AND (sqlc.narg(from_date)::date IS NULL OR date >= sqlc.narg(from_date)::date)
+  AND (sqlc.narg(to_date)::date IS NULL OR date <= sqlc.narg(to_date)::date)
+  AND (sqlc.narg(source)::text IS NULL OR source = sqlc.narg(source)::text)
+ORDER BY date DESC, source DESC
+LIMIT @page_limit::int4;
+
+
+-- name: ListUserIdentities :many
+SELECT sportti_id, profile_gender, profile_birthdate
+FROM users;
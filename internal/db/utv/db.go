@@ -78,6 +78,18 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.deleteUserDataStmt, err = db.PrepareContext(ctx, deleteUserData); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteUserData: %w", err)
 	}
+	if q.exportGarminDataStmt, err = db.PrepareContext(ctx, exportGarminData); err != nil {
+		return nil, fmt.Errorf("error preparing query ExportGarminData: %w", err)
+	}
+	if q.exportOuraDataStmt, err = db.PrepareContext(ctx, exportOuraData); err != nil {
+		return nil, fmt.Errorf("error preparing query ExportOuraData: %w", err)
+	}
+	if q.exportPolarDataStmt, err = db.PrepareContext(ctx, exportPolarData); err != nil {
+		return nil, fmt.Errorf("error preparing query ExportPolarData: %w", err)
+	}
+	if q.exportSuuntoDataStmt, err = db.PrepareContext(ctx, exportSuuntoData); err != nil {
+		return nil, fmt.Errorf("error preparing query ExportSuuntoData: %w", err)
+	}
 	if q.garminTokenExistsStmt, err = db.PrepareContext(ctx, garminTokenExists); err != nil {
 		return nil, fmt.Errorf("error preparing query GarminTokenExists: %w", err)
 	}
@@ -437,6 +449,26 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deleteUserDataStmt: %w", cerr)
 		}
 	}
+	if q.exportGarminDataStmt != nil {
+		if cerr := q.exportGarminDataStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing exportGarminDataStmt: %w", cerr)
+		}
+	}
+	if q.exportOuraDataStmt != nil {
+		if cerr := q.exportOuraDataStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing exportOuraDataStmt: %w", cerr)
+		}
+	}
+	if q.exportPolarDataStmt != nil {
+		if cerr := q.exportPolarDataStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing exportPolarDataStmt: %w", cerr)
+		}
+	}
+	if q.exportSuuntoDataStmt != nil {
+		if cerr := q.exportSuuntoDataStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing exportSuuntoDataStmt: %w", cerr)
+		}
+	}
 	if q.garminTokenExistsStmt != nil {
 		if cerr := q.garminTokenExistsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing garminTokenExistsStmt: %w", cerr)
@@ -934,6 +966,10 @@ type Queries struct {
 	deleteSuuntoTokenStmt             *sql.Stmt
 	deleteUserStmt                    *sql.Stmt
 	deleteUserDataStmt                *sql.Stmt
+	exportGarminDataStmt              *sql.Stmt
+	exportOuraDataStmt                *sql.Stmt
+	exportPolarDataStmt               *sql.Stmt
+	exportSuuntoDataStmt              *sql.Stmt
 	garminTokenExistsStmt             *sql.Stmt
 	getAllDataForDateGarminStmt       *sql.Stmt
 	getAllDataForDateOuraStmt         *sql.Stmt
@@ -1046,6 +1082,10 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		deleteSuuntoTokenStmt:             q.deleteSuuntoTokenStmt,
 		deleteUserStmt:                    q.deleteUserStmt,
 		deleteUserDataStmt:                q.deleteUserDataStmt,
+		exportGarminDataStmt:              q.exportGarminDataStmt,
+		exportOuraDataStmt:                q.exportOuraDataStmt,
+		exportPolarDataStmt:               q.exportPolarDataStmt,
+		exportSuuntoDataStmt:              q.exportSuuntoDataStmt,
 		garminTokenExistsStmt:             q.garminTokenExistsStmt,
 		getAllDataForDateGarminStmt:       q.getAllDataForDateGarminStmt,
 		getAllDataForDateOuraStmt:         q.getAllDataForDateOuraStmt,
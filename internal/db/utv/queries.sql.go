@@ -13,6 +13,7 @@ import (
 	"database/sql"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 const addNotification = `-- name: AddNotification :one
@@ -1977,10 +1978,19 @@ func (q *Queries) DeleteSuuntoToken(ctx context.Context, userID uuid.UUID) error
 const getGarminTokensForUpdate = `-- name: GetGarminTokensForUpdate :many
 SELECT user_id, data FROM garmin_tokens
 WHERE (data ->> 'token_last_refreshed')::timestamp < $1::timestamp
+  AND ($2::uuid IS NULL OR user_id > $2::uuid)
+ORDER BY user_id
+LIMIT $3::int4
 `
 
-func (q *Queries) GetGarminTokensForUpdate(ctx context.Context, cutoff time.Time) ([]GarminToken, error) {
-	rows, err := q.query(ctx, q.getGarminTokensForUpdateStmt, getGarminTokensForUpdate, cutoff)
+type GetGarminTokensForUpdateParams struct {
+	Cutoff      time.Time
+	AfterUserID uuid.NullUUID
+	PageLimit   int32
+}
+
+func (q *Queries) GetGarminTokensForUpdate(ctx context.Context, arg GetGarminTokensForUpdateParams) ([]GarminToken, error) {
+	rows, err := q.query(ctx, q.getGarminTokensForUpdateStmt, getGarminTokensForUpdate, arg.Cutoff, arg.AfterUserID, arg.PageLimit)
 	if err != nil {
 		return nil, err
 	}
@@ -2005,10 +2015,19 @@ func (q *Queries) GetGarminTokensForUpdate(ctx context.Context, cutoff time.Time
 const getOuraTokensForUpdate = `-- name: GetOuraTokensForUpdate :many
 SELECT user_id, data FROM oura_tokens
 WHERE (data ->> 'token_last_refreshed')::timestamp < $1::timestamp
+  AND ($2::uuid IS NULL OR user_id > $2::uuid)
+ORDER BY user_id
+LIMIT $3::int4
 `
 
-func (q *Queries) GetOuraTokensForUpdate(ctx context.Context, cutoff time.Time) ([]OuraToken, error) {
-	rows, err := q.query(ctx, q.getOuraTokensForUpdateStmt, getOuraTokensForUpdate, cutoff)
+type GetOuraTokensForUpdateParams struct {
+	Cutoff      time.Time
+	AfterUserID uuid.NullUUID
+	PageLimit   int32
+}
+
+func (q *Queries) GetOuraTokensForUpdate(ctx context.Context, arg GetOuraTokensForUpdateParams) ([]OuraToken, error) {
+	rows, err := q.query(ctx, q.getOuraTokensForUpdateStmt, getOuraTokensForUpdate, arg.Cutoff, arg.AfterUserID, arg.PageLimit)
 	if err != nil {
 		return nil, err
 	}
@@ -2033,10 +2052,19 @@ func (q *Queries) GetOuraTokensForUpdate(ctx context.Context, cutoff time.Time)
 const getPolarTokensForUpdate = `-- name: GetPolarTokensForUpdate :many
 SELECT user_id, data FROM polar_tokens
 WHERE (data ->> 'token_last_refreshed')::timestamp < $1::timestamp
+  AND ($2::uuid IS NULL OR user_id > $2::uuid)
+ORDER BY user_id
+LIMIT $3::int4
 `
 
-func (q *Queries) GetPolarTokensForUpdate(ctx context.Context, cutoff time.Time) ([]PolarToken, error) {
-	rows, err := q.query(ctx, q.getPolarTokensForUpdateStmt, getPolarTokensForUpdate, cutoff)
+type GetPolarTokensForUpdateParams struct {
+	Cutoff      time.Time
+	AfterUserID uuid.NullUUID
+	PageLimit   int32
+}
+
+func (q *Queries) GetPolarTokensForUpdate(ctx context.Context, arg GetPolarTokensForUpdateParams) ([]PolarToken, error) {
+	rows, err := q.query(ctx, q.getPolarTokensForUpdateStmt, getPolarTokensForUpdate, arg.Cutoff, arg.AfterUserID, arg.PageLimit)
 	if err != nil {
 		return nil, err
 	}
@@ -2061,10 +2089,19 @@ func (q *Queries) GetPolarTokensForUpdate(ctx context.Context, cutoff time.Time)
 const getSuuntoTokensForUpdate = `-- name: GetSuuntoTokensForUpdate :many
 SELECT user_id, data FROM suunto_tokens
 WHERE (data ->> 'token_last_refreshed')::timestamp < $1::timestamp
+  AND ($2::uuid IS NULL OR user_id > $2::uuid)
+ORDER BY user_id
+LIMIT $3::int4
 `
 
-func (q *Queries) GetSuuntoTokensForUpdate(ctx context.Context, cutoff time.Time) ([]SuuntoToken, error) {
-	rows, err := q.query(ctx, q.getSuuntoTokensForUpdateStmt, getSuuntoTokensForUpdate, cutoff)
+type GetSuuntoTokensForUpdateParams struct {
+	Cutoff      time.Time
+	AfterUserID uuid.NullUUID
+	PageLimit   int32
+}
+
+func (q *Queries) GetSuuntoTokensForUpdate(ctx context.Context, arg GetSuuntoTokensForUpdateParams) ([]SuuntoToken, error) {
+	rows, err := q.query(ctx, q.getSuuntoTokensForUpdateStmt, getSuuntoTokensForUpdate, arg.Cutoff, arg.AfterUserID, arg.PageLimit)
 	if err != nil {
 		return nil, err
 	}
@@ -2575,3 +2612,219 @@ func (q *Queries) GetGarminTokenJSON(ctx context.Context, userID uuid.UUID) (jso
 	err := row.Scan(&token)
 	return token, err
 }
+
+const exportGarminData = `-- name: ExportGarminData :many
+SELECT user_id, summary_date, data
+FROM garmin_data
+WHERE ($1::uuid IS NULL OR user_id IN (
+    SELECT user_id FROM utv_group_members WHERE group_id = $1::uuid))
+  AND (coalesce(cardinality($2::uuid[]), 0) = 0 OR user_id = ANY($2::uuid[]))
+  AND ($3::date IS NULL OR summary_date >= $3::date)
+  AND ($4::date IS NULL OR summary_date <= $4::date)
+  AND ($5::uuid IS NULL OR (user_id, summary_date) > ($5::uuid, $6::date))
+ORDER BY user_id, summary_date
+LIMIT $7::int4
+`
+
+type ExportGarminDataParams struct {
+	GroupID     uuid.NullUUID
+	UserIds     []uuid.UUID
+	FromDate    sql.NullTime
+	ToDate      sql.NullTime
+	AfterUserID uuid.NullUUID
+	AfterDate   sql.NullTime
+	PageLimit   int32
+}
+
+func (q *Queries) ExportGarminData(ctx context.Context, arg ExportGarminDataParams) ([]GarminDatum, error) {
+	rows, err := q.query(ctx, q.exportGarminDataStmt, exportGarminData,
+		arg.GroupID,
+		pq.Array(arg.UserIds),
+		arg.FromDate,
+		arg.ToDate,
+		arg.AfterUserID,
+		arg.AfterDate,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GarminDatum
+	for rows.Next() {
+		var i GarminDatum
+		if err := rows.Scan(&i.UserID, &i.SummaryDate, &i.Data); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const exportOuraData = `-- name: ExportOuraData :many
+SELECT user_id, summary_date, data
+FROM oura_data
+WHERE ($1::uuid IS NULL OR user_id IN (
+    SELECT user_id FROM utv_group_members WHERE group_id = $1::uuid))
+  AND (coalesce(cardinality($2::uuid[]), 0) = 0 OR user_id = ANY($2::uuid[]))
+  AND ($3::date IS NULL OR summary_date >= $3::date)
+  AND ($4::date IS NULL OR summary_date <= $4::date)
+  AND ($5::uuid IS NULL OR (user_id, summary_date) > ($5::uuid, $6::date))
+ORDER BY user_id, summary_date
+LIMIT $7::int4
+`
+
+type ExportOuraDataParams struct {
+	GroupID     uuid.NullUUID
+	UserIds     []uuid.UUID
+	FromDate    sql.NullTime
+	ToDate      sql.NullTime
+	AfterUserID uuid.NullUUID
+	AfterDate   sql.NullTime
+	PageLimit   int32
+}
+
+func (q *Queries) ExportOuraData(ctx context.Context, arg ExportOuraDataParams) ([]OuraDatum, error) {
+	rows, err := q.query(ctx, q.exportOuraDataStmt, exportOuraData,
+		arg.GroupID,
+		pq.Array(arg.UserIds),
+		arg.FromDate,
+		arg.ToDate,
+		arg.AfterUserID,
+		arg.AfterDate,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []OuraDatum
+	for rows.Next() {
+		var i OuraDatum
+		if err := rows.Scan(&i.UserID, &i.SummaryDate, &i.Data); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const exportPolarData = `-- name: ExportPolarData :many
+SELECT user_id, summary_date, data
+FROM polar_data
+WHERE ($1::uuid IS NULL OR user_id IN (
+    SELECT user_id FROM utv_group_members WHERE group_id = $1::uuid))
+  AND (coalesce(cardinality($2::uuid[]), 0) = 0 OR user_id = ANY($2::uuid[]))
+  AND ($3::date IS NULL OR summary_date >= $3::date)
+  AND ($4::date IS NULL OR summary_date <= $4::date)
+  AND ($5::uuid IS NULL OR (user_id, summary_date) > ($5::uuid, $6::date))
+ORDER BY user_id, summary_date
+LIMIT $7::int4
+`
+
+type ExportPolarDataParams struct {
+	GroupID     uuid.NullUUID
+	UserIds     []uuid.UUID
+	FromDate    sql.NullTime
+	ToDate      sql.NullTime
+	AfterUserID uuid.NullUUID
+	AfterDate   sql.NullTime
+	PageLimit   int32
+}
+
+func (q *Queries) ExportPolarData(ctx context.Context, arg ExportPolarDataParams) ([]PolarDatum, error) {
+	rows, err := q.query(ctx, q.exportPolarDataStmt, exportPolarData,
+		arg.GroupID,
+		pq.Array(arg.UserIds),
+		arg.FromDate,
+		arg.ToDate,
+		arg.AfterUserID,
+		arg.AfterDate,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PolarDatum
+	for rows.Next() {
+		var i PolarDatum
+		if err := rows.Scan(&i.UserID, &i.SummaryDate, &i.Data); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const exportSuuntoData = `-- name: ExportSuuntoData :many
+SELECT user_id, summary_date, data
+FROM suunto_data
+WHERE ($1::uuid IS NULL OR user_id IN (
+    SELECT user_id FROM utv_group_members WHERE group_id = $1::uuid))
+  AND (coalesce(cardinality($2::uuid[]), 0) = 0 OR user_id = ANY($2::uuid[]))
+  AND ($3::date IS NULL OR summary_date >= $3::date)
+  AND ($4::date IS NULL OR summary_date <= $4::date)
+  AND ($5::uuid IS NULL OR (user_id, summary_date) > ($5::uuid, $6::date))
+ORDER BY user_id, summary_date
+LIMIT $7::int4
+`
+
+type ExportSuuntoDataParams struct {
+	GroupID     uuid.NullUUID
+	UserIds     []uuid.UUID
+	FromDate    sql.NullTime
+	ToDate      sql.NullTime
+	AfterUserID uuid.NullUUID
+	AfterDate   sql.NullTime
+	PageLimit   int32
+}
+
+func (q *Queries) ExportSuuntoData(ctx context.Context, arg ExportSuuntoDataParams) ([]SuuntoDatum, error) {
+	rows, err := q.query(ctx, q.exportSuuntoDataStmt, exportSuuntoData,
+		arg.GroupID,
+		pq.Array(arg.UserIds),
+		arg.FromDate,
+		arg.ToDate,
+		arg.AfterUserID,
+		arg.AfterDate,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SuuntoDatum
+	for rows.Next() {
+		var i SuuntoDatum
+		if err := rows.Scan(&i.UserID, &i.SummaryDate, &i.Data); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -351,6 +351,54 @@ WHERE user_id = $1 AND data ? $2
 ORDER BY summary_date DESC
 LIMIT $3;
 
+-- name: ExportGarminData :many
+SELECT user_id, summary_date, data
+FROM garmin_data
+WHERE (sqlc.narg(group_id)::uuid IS NULL OR user_id IN (
+    SELECT user_id FROM utv_group_members WHERE group_id = sqlc.narg(group_id)::uuid))
+  AND (coalesce(cardinality(@user_ids::uuid[]), 0) = 0 OR user_id = ANY(@user_ids::uuid[]))
+  AND (sqlc.narg(from_date)::date IS NULL OR summary_date >= sqlc.narg(from_date)::date)
+  AND (sqlc.narg(to_date)::date IS NULL OR summary_date <= sqlc.narg(to_date)::date)
+  AND (sqlc.narg(after_user_id)::uuid IS NULL OR (user_id, summary_date) > (sqlc.narg(after_user_id)::uuid, sqlc.narg(after_date)::date))
+ORDER BY user_id, summary_date
+LIMIT @page_limit::int4;
+
+-- name: ExportOuraData :many
+SELECT user_id, summary_date, data
+FROM oura_data
+WHERE (sqlc.narg(group_id)::uuid IS NULL OR user_id IN (
+    SELECT user_id FROM utv_group_members WHERE group_id = sqlc.narg(group_id)::uuid))
+  AND (coalesce(cardinality(@user_ids::uuid[]), 0) = 0 OR user_id = ANY(@user_ids::uuid[]))
+  AND (sqlc.narg(from_date)::date IS NULL OR summary_date >= sqlc.narg(from_date)::date)
+  AND (sqlc.narg(to_date)::date IS NULL OR summary_date <= sqlc.narg(to_date)::date)
+  AND (sqlc.narg(after_user_id)::uuid IS NULL OR (user_id, summary_date) > (sqlc.narg(after_user_id)::uuid, sqlc.narg(after_date)::date))
+ORDER BY user_id, summary_date
+LIMIT @page_limit::int4;
+
+-- name: ExportPolarData :many
+SELECT user_id, summary_date, data
+FROM polar_data
+WHERE (sqlc.narg(group_id)::uuid IS NULL OR user_id IN (
+    SELECT user_id FROM utv_group_members WHERE group_id = sqlc.narg(group_id)::uuid))
+  AND (coalesce(cardinality(@user_ids::uuid[]), 0) = 0 OR user_id = ANY(@user_ids::uuid[]))
+  AND (sqlc.narg(from_date)::date IS NULL OR summary_date >= sqlc.narg(from_date)::date)
+  AND (sqlc.narg(to_date)::date IS NULL OR summary_date <= sqlc.narg(to_date)::date)
+  AND (sqlc.narg(after_user_id)::uuid IS NULL OR (user_id, summary_date) > (sqlc.narg(after_user_id)::uuid, sqlc.narg(after_date)::date))
+ORDER BY user_id, summary_date
+LIMIT @page_limit::int4;
+
+-- name: ExportSuuntoData :many
+SELECT user_id, summary_date, data
+FROM suunto_data
+WHERE (sqlc.narg(group_id)::uuid IS NULL OR user_id IN (
+    SELECT user_id FROM utv_group_members WHERE group_id = sqlc.narg(group_id)::uuid))
+  AND (coalesce(cardinality(@user_ids::uuid[]), 0) = 0 OR user_id = ANY(@user_ids::uuid[]))
+  AND (sqlc.narg(from_date)::date IS NULL OR summary_date >= sqlc.narg(from_date)::date)
+  AND (sqlc.narg(to_date)::date IS NULL OR summary_date <= sqlc.narg(to_date)::date)
+  AND (sqlc.narg(after_user_id)::uuid IS NULL OR (user_id, summary_date) > (sqlc.narg(after_user_id)::uuid, sqlc.narg(after_date)::date))
+ORDER BY user_id, summary_date
+LIMIT @page_limit::int4;
+
 -- name: GetDataByTypeOura :many
 SELECT summary_date, (data -> $2::text)::jsonb AS data
 FROM oura_data
@@ -507,19 +555,31 @@ DELETE FROM klab_tokens WHERE user_id = $1;
 
 -- name: GetPolarTokensForUpdate :many
 SELECT user_id, data FROM polar_tokens
-WHERE (data ->> 'token_last_refreshed')::timestamp < sqlc.arg(cutoff)::timestamp;
+WHERE (data ->> 'token_last_refreshed')::timestamp < sqlc.arg(cutoff)::timestamp
+  AND (sqlc.narg(after_user_id)::uuid IS NULL OR user_id > sqlc.narg(after_user_id)::uuid)
+ORDER BY user_id
+LIMIT sqlc.arg(page_limit)::int4;
 
 -- name: GetOuraTokensForUpdate :many
 SELECT user_id, data FROM oura_tokens
-WHERE (data ->> 'token_last_refreshed')::timestamp < sqlc.arg(cutoff)::timestamp;
+WHERE (data ->> 'token_last_refreshed')::timestamp < sqlc.arg(cutoff)::timestamp
+  AND (sqlc.narg(after_user_id)::uuid IS NULL OR user_id > sqlc.narg(after_user_id)::uuid)
+ORDER BY user_id
+LIMIT sqlc.arg(page_limit)::int4;
 
 -- name: GetGarminTokensForUpdate :many
 SELECT user_id, data FROM garmin_tokens
-WHERE (data ->> 'token_last_refreshed')::timestamp < sqlc.arg(cutoff)::timestamp;
+WHERE (data ->> 'token_last_refreshed')::timestamp < sqlc.arg(cutoff)::timestamp
+  AND (sqlc.narg(after_user_id)::uuid IS NULL OR user_id > sqlc.narg(after_user_id)::uuid)
+ORDER BY user_id
+LIMIT sqlc.arg(page_limit)::int4;
 
 -- name: GetSuuntoTokensForUpdate :many
 SELECT user_id, data FROM suunto_tokens
-WHERE (data ->> 'token_last_refreshed')::timestamp < sqlc.arg(cutoff)::timestamp;
+WHERE (data ->> 'token_last_refreshed')::timestamp < sqlc.arg(cutoff)::timestamp
+  AND (sqlc.narg(after_user_id)::uuid IS NULL OR user_id > sqlc.narg(after_user_id)::uuid)
+ORDER BY user_id
+LIMIT sqlc.arg(page_limit)::int4;
 
 -- name: GetPolarDataForUpdate :many
 SELECT user_id, data
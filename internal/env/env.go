@@ -1,8 +1,10 @@
 package env
 
 import (
+	"fmt"
 	"os"
 	"strconv"
+	"strings"
 )
 
 func GetString(key, defOption string) string {
@@ -40,3 +42,23 @@ func GetBool(key string, fallback bool) bool {
 
 	return boolVal
 }
+
+// Lookup returns the value of key, or the contents of the file named by
+// key_FILE (Docker/Kubernetes secrets). Setting both is an error.
+func Lookup(key string) (string, bool, error) {
+	val, ok := os.LookupEnv(key)
+	path, fileOk := os.LookupEnv(key + "_FILE")
+
+	if ok && fileOk {
+		return "", false, fmt.Errorf("both %s and %s_FILE are set", key, key)
+	}
+	if !fileOk {
+		return val, ok, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", false, fmt.Errorf("reading %s_FILE: %w", key, err)
+	}
+	return strings.TrimRight(string(data), "\r\n"), true, nil
+}
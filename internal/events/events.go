@@ -0,0 +1,136 @@
+// Package events describes the changes made by successful writes in the
+// store layer. Stores call Emit after a write has been committed; the
+// Outbox appends the event to the outbox in the auth database, from where
+// it is delivered to webhook subscribers and streamed to clients by the
+// Hub. The write and its event are not atomic: an event is published at
+// most once, and is lost if the process stops before it is recorded.
+package events
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/logger"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+// Domains that emit events. They match the route prefixes under /v1, so a
+// client may follow a domain when it can read its routes.
+var Domains = []string{"fis", "utv", "tietoevry", "kamk", "klab", "archinisis"}
+
+// Operations
+const (
+	Insert = "insert"
+	Update = "update"
+	Upsert = "upsert"
+	Delete = "delete"
+)
+
+var Operations = []string{Insert, Update, Upsert, Delete}
+
+// Event is a committed write: which records of which athletes changed.
+// Bulk writes emit a single event covering all their records.
+type Event struct {
+	Domain     string   `json:"domain"`
+	Entity     string   `json:"entity"`
+	Operation  string   `json:"operation"`
+	AthleteIDs []string `json:"athlete_ids"`
+	Records    int      `json:"records"`
+}
+
+// New returns the event of a write of records records of entity
+// belonging to the athletes with the given IDs
+func New[T any](domain, entity, operation string, records int, ids ...T) Event {
+	return Event{
+		Domain:     domain,
+		Entity:     entity,
+		Operation:  operation,
+		AthleteIDs: IDs(ids...),
+		Records:    records,
+	}
+}
+
+// Type is the name of the event sent to subscribers, e.g.
+// "tietoevry.measurements.upsert"
+func (e Event) Type() string {
+	return e.Domain + "." + e.Entity + "." + e.Operation
+}
+
+// Published is an event as stored in the outbox and sent to clients
+type Published struct {
+	ID   int64  `json:"id"`
+	Type string `json:"type"`
+	Event
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// Published returns e as stored in the outbox with the given ID
+func (e Event) Published(id int64, at time.Time) Published {
+	return Published{ID: id, Type: e.Type(), Event: e, OccurredAt: at}
+}
+
+// Publisher records emitted events
+type Publisher interface {
+	Publish(ctx context.Context, e Event) error
+}
+
+var publisher Publisher
+
+// SetPublisher sets where emitted events go. Without a publisher events
+// are dropped.
+func SetPublisher(p Publisher) {
+	publisher = p
+}
+
+// Emit publishes e. It is called after the write is committed, so a
+// failure the publisher cannot retry is only logged: the write stands and
+// the event is lost.
+func Emit(ctx context.Context, e Event) {
+	if publisher == nil || e.Records == 0 {
+		return
+	}
+	// the write is done; a client hanging up now must not lose the event
+	ctx = context.WithoutCancel(ctx)
+
+	if err := publisher.Publish(ctx, e); err != nil {
+		logger.Logger.Warnw("publishing event failed", "event", e.Type(), "records", e.Records, "error", err)
+	}
+}
+
+// IDs returns the distinct athlete IDs of a write in sorted order
+func IDs[T any](ids ...T) []string {
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, fmt.Sprint(id))
+	}
+	slices.Sort(out)
+	return slices.Compact(out)
+}
+
+// Collect returns the distinct athlete IDs of the records of a bulk write
+func Collect[T, ID any](records []T, id func(T) ID) []string {
+	ids := make([]ID, len(records))
+	for i, r := range records {
+		ids[i] = id(r)
+	}
+	return IDs(ids...)
+}
+
+// Written returns the items of a partial bulk write that were inserted or
+// updated, see utils.WriteEach
+func Written[T any](items []utils.BulkItem[T], results []utils.BulkItemResult) []T {
+	status := make(map[int]utils.BulkStatus, len(results))
+	for _, res := range results {
+		status[res.Index] = res.Status
+	}
+
+	written := make([]T, 0, len(items))
+	for _, item := range items {
+		if s := status[item.Index]; s == utils.BulkInserted || s == utils.BulkUpdated {
+			written = append(written, item.Value)
+		}
+	}
+	return written
+}
@@ -0,0 +1,335 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	authsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/auth"
+	"github.com/DeRuina/KUHA-REST-API/internal/logger"
+)
+
+// hubBatch is the number of events read from the log at a time
+const hubBatch = 500
+
+// subscriptionBuffer is how many events may wait for a slow client before
+// its subscription is dropped
+const subscriptionBuffer = 256
+
+// ErrGap means some events after the requested one are no longer in the
+// log, or are too many to replay
+var ErrGap = errors.New("events missed")
+
+// LogStore is where the published events are read back from
+type LogStore interface {
+	ListEventsAfter(ctx context.Context, afterID, throughID int64, limit int32) ([]authsqlc.OutboxEvent, error)
+	EventBounds(ctx context.Context) (authsqlc.GetOutboxEventBoundsRow, error)
+	EventHorizon(ctx context.Context) (authsqlc.GetOutboxHorizonRow, error)
+}
+
+// HubOptions configures a Hub
+type HubOptions struct {
+	PollInterval time.Duration
+	MaxReplay    int
+}
+
+// Filter selects the events of a subscription
+type Filter struct {
+	Domains []string
+	// AthleteIDs, if not nil, keeps only the events of these athletes
+	AthleteIDs map[string]bool
+}
+
+// Match reports whether e passes the filter
+func (f Filter) Match(e Published) bool {
+	if !slices.Contains(f.Domains, e.Domain) {
+		return false
+	}
+	if f.AthleteIDs == nil {
+		return true
+	}
+	for _, id := range e.AthleteIDs {
+		if f.AthleteIDs[id] {
+			return true
+		}
+	}
+	return false
+}
+
+// Subscription receives the events published after it was made
+type Subscription struct {
+	filter Filter
+	events chan Published
+	done   chan struct{}
+	once   sync.Once
+}
+
+// Events returns the events of the subscription in ID order
+func (s *Subscription) Events() <-chan Published {
+	return s.events
+}
+
+// Done is closed when the hub drops the subscription, because the client
+// fell behind or the hub stopped
+func (s *Subscription) Done() <-chan struct{} {
+	return s.done
+}
+
+func (s *Subscription) close() {
+	s.once.Do(func() { close(s.done) })
+}
+
+// horizon is the latest event ID seen by a poll and the transactions that
+// were in progress at the time
+type horizon struct {
+	latestID int64
+	openXids []int64
+}
+
+// Hub follows the event log and fans the new events out to the
+// subscriptions. Reading the shared log rather than the events published
+// in this process means every API instance streams every event.
+//
+// Event IDs are drawn when the insert runs, so a later event may commit
+// before an earlier one. Only settled events are streamed, which keeps the
+// stream in ID order for resuming after the last event ID: an event is
+// settled once every transaction that was in progress when it was first
+// seen has ended, since only those can still commit an event with a lower
+// ID.
+type Hub struct {
+	store LogStore
+	opts  HubOptions
+
+	mu     sync.Mutex
+	subs   map[*Subscription]struct{}
+	closed bool
+
+	// settled is the ID up to which the log is final, -1 until the
+	// first poll. horizons are the polls not settled yet, oldest first;
+	// only the poller uses them.
+	settled  atomic.Int64
+	horizons []horizon
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewHub(store LogStore, opts HubOptions) *Hub {
+	h := &Hub{
+		store: store,
+		opts:  opts,
+		subs:  map[*Subscription]struct{}{},
+	}
+	h.settled.Store(-1)
+	return h
+}
+
+// Start launches the poller
+func (h *Hub) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	h.cancel = cancel
+
+	h.wg.Add(1)
+	go func() {
+		defer h.wg.Done()
+		h.poll(ctx)
+	}()
+}
+
+// Stop drops every subscription and waits for the poller to exit. It is
+// called when the server starts shutting down, so open streams end and do
+// not hold up the shutdown.
+func (h *Hub) Stop() {
+	h.mu.Lock()
+	h.closed = true
+	for s := range h.subs {
+		s.close()
+		delete(h.subs, s)
+	}
+	h.mu.Unlock()
+
+	if h.cancel == nil {
+		return
+	}
+	h.cancel()
+	h.wg.Wait()
+}
+
+// Subscribe starts receiving the events matching f. It returns false if
+// the hub has stopped.
+func (h *Hub) Subscribe(f Filter) (*Subscription, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return nil, false
+	}
+	s := &Subscription{
+		filter: f,
+		events: make(chan Published, subscriptionBuffer),
+		done:   make(chan struct{}),
+	}
+	h.subs[s] = struct{}{}
+	return s, true
+}
+
+func (h *Hub) Unsubscribe(s *Subscription) {
+	h.mu.Lock()
+	delete(h.subs, s)
+	h.mu.Unlock()
+	s.close()
+}
+
+// Replay returns the settled events after lastID matching f. It returns
+// ErrGap, with the events it could read, if some of the events after
+// lastID have been deleted or there are more than MaxReplay of them, or if
+// the hub has not read the log yet.
+func (h *Hub) Replay(ctx context.Context, lastID int64, f Filter) ([]Published, error) {
+	through := h.settled.Load()
+	if through < 0 {
+		return nil, ErrGap
+	}
+	if through <= lastID {
+		return nil, nil
+	}
+
+	bounds, err := h.store.EventBounds(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var gap error
+	if bounds.OldestID > lastID+1 {
+		gap = ErrGap
+		lastID = bounds.OldestID - 1
+	}
+
+	var out []Published
+	for scanned := 0; ; {
+		if scanned >= h.opts.MaxReplay {
+			return out, ErrGap
+		}
+		limit := min(hubBatch, h.opts.MaxReplay-scanned)
+		rows, err := h.store.ListEventsAfter(ctx, lastID, through, int32(limit))
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			if e := published(row); f.Match(e) {
+				out = append(out, e)
+			}
+			lastID = row.ID
+		}
+		scanned += len(rows)
+		if len(rows) < limit {
+			return out, gap
+		}
+	}
+}
+
+func (h *Hub) poll(ctx context.Context) {
+	ticker := time.NewTicker(h.opts.PollInterval)
+	defer ticker.Stop()
+
+	cursor := int64(-1)
+	for {
+		through, err := h.settle(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				logger.Logger.Warnw("reading event log failed", "error", err)
+			}
+		} else if cursor < 0 {
+			// stream only what is published from now on
+			cursor = through
+		}
+
+		for cursor >= 0 && cursor < through && ctx.Err() == nil {
+			rows, err := h.store.ListEventsAfter(ctx, cursor, through, hubBatch)
+			if err != nil {
+				if ctx.Err() == nil {
+					logger.Logger.Warnw("reading event log failed", "error", err)
+				}
+				break
+			}
+			for _, row := range rows {
+				h.broadcast(published(row))
+				cursor = row.ID
+			}
+			if len(rows) < hubBatch {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// settle reads the horizon of the log and returns the ID up to which it is
+// final. The horizons of earlier polls settle once none of their
+// transactions is still in progress. A transaction that stays open holds
+// the stream back until it ends.
+func (h *Hub) settle(ctx context.Context) (int64, error) {
+	row, err := h.store.EventHorizon(ctx)
+	if err != nil {
+		return h.settled.Load(), err
+	}
+
+	// a newer horizon with the same latest ID replaces the older one: the
+	// transactions the older one waits on and that are not open any more
+	// have ended
+	if n := len(h.horizons); n > 0 && h.horizons[n-1].latestID == row.LatestID {
+		h.horizons = h.horizons[:n-1]
+	}
+	h.horizons = append(h.horizons, horizon{latestID: row.LatestID, openXids: row.OpenXids})
+
+	settled := 0
+	for _, hz := range h.horizons {
+		if slices.ContainsFunc(hz.openXids, func(xid int64) bool {
+			return slices.Contains(row.OpenXids, xid)
+		}) {
+			break
+		}
+		h.settled.Store(max(h.settled.Load(), hz.latestID))
+		settled++
+	}
+	h.horizons = slices.Delete(h.horizons, 0, settled)
+
+	return h.settled.Load(), nil
+}
+
+// broadcast hands e to the matching subscriptions, dropping those whose
+// client cannot keep up; they resume from their last event on reconnect
+func (h *Hub) broadcast(e Published) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for s := range h.subs {
+		if !s.filter.Match(e) {
+			continue
+		}
+		select {
+		case s.events <- e:
+		default:
+			s.close()
+			delete(h.subs, s)
+		}
+	}
+}
+
+func published(row authsqlc.OutboxEvent) Published {
+	e := Event{
+		Domain:     row.Domain,
+		Entity:     row.Entity,
+		Operation:  row.Operation,
+		AthleteIDs: row.AthleteIds,
+		Records:    int(row.Records),
+	}
+	return e.Published(row.ID, row.CreatedAt)
+}
@@ -0,0 +1,191 @@
+package events
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	authsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/auth"
+	"github.com/DeRuina/KUHA-REST-API/internal/logger"
+)
+
+// OutboxStore is where the outbox is kept
+type OutboxStore interface {
+	PublishEvent(ctx context.Context, arg authsqlc.PublishOutboxEventParams) (authsqlc.PublishOutboxEventRow, error)
+	DeleteExpiredEvents(ctx context.Context, before time.Time) (int64, error)
+}
+
+const (
+	// maxRetriedEvents caps the events kept in memory for a retry while the
+	// auth database is unavailable; further events are dropped
+	maxRetriedEvents = 10000
+
+	minRetryBackoff = time.Second
+	maxRetryBackoff = time.Minute
+)
+
+// Outbox publishes events to the outbox table and deletes them after the
+// retention, together with their webhook deliveries. The outbox is in the
+// auth database, not in the database of the write, so an event is recorded
+// after its write has committed: an insert that fails is retried in the
+// background, but the events still waiting when the process stops are lost.
+type Outbox struct {
+	store     OutboxStore
+	retention time.Duration
+	notify    func()
+
+	mu      sync.Mutex
+	retries []Event
+	wake    chan struct{}
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewOutbox returns a publisher writing to store. notify, if not nil, is
+// called when an event queues webhook deliveries.
+func NewOutbox(store OutboxStore, retention time.Duration, notify func()) *Outbox {
+	return &Outbox{store: store, retention: retention, notify: notify, wake: make(chan struct{}, 1)}
+}
+
+// Publish records e in the outbox. If that fails, e is queued for a retry
+// and an error is only returned when the retry queue is full.
+func (o *Outbox) Publish(ctx context.Context, e Event) error {
+	err := o.publish(ctx, e)
+	if err == nil {
+		return nil
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if len(o.retries) >= maxRetriedEvents {
+		return err
+	}
+	o.retries = append(o.retries, e)
+	if len(o.retries) == 1 {
+		logger.Logger.Warnw("publishing event failed, retrying", "event", e.Type(), "error", err)
+	}
+	select {
+	case o.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (o *Outbox) publish(ctx context.Context, e Event) error {
+	ids := e.AthleteIDs
+	if ids == nil {
+		ids = []string{}
+	}
+
+	row, err := o.store.PublishEvent(ctx, authsqlc.PublishOutboxEventParams{
+		Domain:     e.Domain,
+		Entity:     e.Entity,
+		Operation:  e.Operation,
+		AthleteIds: ids,
+		Records:    int32(e.Records),
+	})
+	if err != nil {
+		return err
+	}
+
+	if row.Deliveries > 0 && o.notify != nil {
+		o.notify()
+	}
+	return nil
+}
+
+// Start launches the janitor and the retries
+func (o *Outbox) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	o.cancel = cancel
+
+	o.wg.Add(2)
+	go func() {
+		defer o.wg.Done()
+		o.janitor(ctx)
+	}()
+	go func() {
+		defer o.wg.Done()
+		o.retry(ctx)
+	}()
+}
+
+// Stop waits for the janitor and the retries to exit and logs the events
+// that could not be published
+func (o *Outbox) Stop() {
+	if o.cancel == nil {
+		return
+	}
+	o.cancel()
+	o.wg.Wait()
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if len(o.retries) > 0 {
+		logger.Logger.Errorw("events lost: the outbox was unavailable until shutdown", "count", len(o.retries))
+	}
+}
+
+// retry publishes the queued events in order, backing off while the
+// outbox is unavailable
+func (o *Outbox) retry(ctx context.Context) {
+	backoff := minRetryBackoff
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-o.wake:
+		}
+
+		for {
+			o.mu.Lock()
+			if len(o.retries) == 0 {
+				o.mu.Unlock()
+				break
+			}
+			e := o.retries[0]
+			o.mu.Unlock()
+
+			if err := o.publish(ctx, e); err != nil {
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(backoff):
+				}
+				backoff = min(2*backoff, maxRetryBackoff)
+				continue
+			}
+
+			backoff = minRetryBackoff
+			o.mu.Lock()
+			o.retries = o.retries[1:]
+			if len(o.retries) == 0 {
+				logger.Logger.Infow("queued events published")
+			}
+			o.mu.Unlock()
+		}
+	}
+}
+
+// janitor deletes the events older than the retention
+func (o *Outbox) janitor(ctx context.Context) {
+	ticker := time.NewTicker(min(o.retention, time.Hour))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if n, err := o.store.DeleteExpiredEvents(ctx, time.Now().Add(-o.retention)); err != nil {
+			if ctx.Err() == nil {
+				logger.Logger.Warnw("deleting expired events failed", "error", err)
+			}
+		} else if n > 0 {
+			logger.Logger.Infow("deleted expired events", "count", n)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package exports
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync/atomic"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/store/auth"
+	"github.com/google/uuid"
+)
+
+// chunkWriter stores the file of a job as numbered chunks of chunkSize
+// bytes; only the last chunk may be shorter
+type chunkWriter struct {
+	ctx   context.Context
+	jobs  auth.ExportJobs
+	id    uuid.UUID
+	buf   []byte
+	seq   int32
+	size  atomic.Int64
+	limit int
+}
+
+func newChunkWriter(ctx context.Context, jobs auth.ExportJobs, id uuid.UUID, chunkSize int) *chunkWriter {
+	return &chunkWriter{
+		ctx:   ctx,
+		jobs:  jobs,
+		id:    id,
+		buf:   make([]byte, 0, chunkSize),
+		limit: chunkSize,
+	}
+}
+
+func (c *chunkWriter) Write(p []byte) (int, error) {
+	n := 0
+	for len(p) > 0 {
+		k := min(len(p), c.limit-len(c.buf))
+		c.buf = append(c.buf, p[:k]...)
+		p = p[k:]
+		n += k
+		if len(c.buf) == c.limit {
+			if err := c.flush(); err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// Close stores the last, partial chunk
+func (c *chunkWriter) Close() error {
+	if len(c.buf) == 0 {
+		return nil
+	}
+	return c.flush()
+}
+
+// Size returns the number of bytes written so far
+func (c *chunkWriter) Size() int64 {
+	return c.size.Load()
+}
+
+func (c *chunkWriter) flush() error {
+	if err := c.jobs.InsertChunk(c.ctx, c.id, c.seq, c.buf); err != nil {
+		return err
+	}
+	c.seq++
+	c.size.Add(int64(len(c.buf)))
+	c.buf = c.buf[:0]
+	return nil
+}
+
+// ChunkReader reads the file of a finished job. It implements io.ReadSeeker
+// so it can be served with http.ServeContent, which handles Range requests.
+type ChunkReader struct {
+	ctx       context.Context
+	jobs      auth.ExportJobs
+	id        uuid.UUID
+	size      int64
+	chunkSize int64
+	off       int64
+
+	// the chunk read last
+	seq   int32
+	chunk []byte
+}
+
+// NewChunkReader reads size bytes stored in chunks of chunkSize
+func NewChunkReader(ctx context.Context, jobs auth.ExportJobs, id uuid.UUID, size int64, chunkSize int32) *ChunkReader {
+	return &ChunkReader{
+		ctx:       ctx,
+		jobs:      jobs,
+		id:        id,
+		size:      size,
+		chunkSize: int64(chunkSize),
+		seq:       -1,
+	}
+}
+
+func (r *ChunkReader) Read(p []byte) (int, error) {
+	if r.off >= r.size {
+		return 0, io.EOF
+	}
+
+	seq := int32(r.off / r.chunkSize)
+	if seq != r.seq {
+		data, err := r.jobs.GetChunk(r.ctx, r.id, seq)
+		if err != nil {
+			return 0, err
+		}
+		r.seq = seq
+		r.chunk = data
+	}
+
+	start := r.off - int64(seq)*r.chunkSize
+	if start >= int64(len(r.chunk)) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	n := copy(p, r.chunk[start:])
+	r.off += int64(n)
+	return n, nil
+}
+
+func (r *ChunkReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.off
+	case io.SeekEnd:
+		offset += r.size
+	default:
+		return 0, errors.New("exports: invalid whence")
+	}
+	if offset < 0 {
+		return 0, errors.New("exports: negative position")
+	}
+	r.off = offset
+	return offset, nil
+}
@@ -0,0 +1,73 @@
+// Package exports runs long exports in the background: jobs are queued in
+// the auth database, picked up by a pool of workers and written as CSV,
+// Parquet or NDJSON files stored in chunks next to the job. Rows are
+// streamed into the chunks as they are read; a Parquet worker holds at most
+// one row group in memory.
+package exports
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/store"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+// exportPageSize is the number of rows read per query
+const exportPageSize = 1000
+
+var ErrInvalidParams = errors.New("invalid export parameters")
+
+// Exporter produces the rows of one kind of export
+type Exporter interface {
+	// Path is the API path whose GET permission a client needs to run the export
+	Path() string
+	// Prepare checks the job parameters and returns the struct type of
+	// the rows passed to emit
+	Prepare(params json.RawMessage) (reflect.Type, error)
+	// Export passes every row to emit in a stable order
+	Export(ctx context.Context, params json.RawMessage, emit func(row any) error) error
+}
+
+// Registry maps job kinds to their exporter
+type Registry map[string]Exporter
+
+// NewRegistry registers the exports whose database is connected
+func NewRegistry(s store.Storage) Registry {
+	r := Registry{}
+	if s.FIS != nil {
+		r["fis_results"] = &fisResults{fis: s.FIS}
+	}
+	if s.UTV != nil {
+		r["utv_data"] = &utvData{utv: s.UTV}
+	}
+	return r
+}
+
+// Kinds returns the registered job kinds in sorted order
+func (r Registry) Kinds() []string {
+	kinds := make([]string, 0, len(r))
+	for kind := range r {
+		kinds = append(kinds, kind)
+	}
+	slices.Sort(kinds)
+	return kinds
+}
+
+// decodeParams strictly decodes job parameters into dst and validates them
+func decodeParams(params json.RawMessage, dst any) error {
+	dec := json.NewDecoder(bytes.NewReader(params))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(dst); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidParams, err)
+	}
+	if err := utils.GetValidator().Struct(dst); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidParams, err)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package exports
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/store"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+// FISResultsParams selects the results of the races of a sector in a
+// range of seasons
+type FISResultsParams struct {
+	Sector     string `json:"sector" validate:"required,oneof=cc jp nk"`
+	SeasonFrom int32  `json:"season_from" validate:"required,min=1900,max=2100"`
+	SeasonTo   int32  `json:"season_to" validate:"required,min=1900,max=2100,gtefield=SeasonFrom"`
+}
+
+// fisResults exports the raw result rows of a sector, ordered by recid.
+// The columns are those of a_resultcc, a_resultjp or a_resultnk.
+type fisResults struct {
+	fis store.FIS
+}
+
+func (e *fisResults) Path() string {
+	return "/v1/fis/results"
+}
+
+func (e *fisResults) Prepare(params json.RawMessage) (reflect.Type, error) {
+	var p FISResultsParams
+	if err := decodeParams(params, &p); err != nil {
+		return nil, err
+	}
+	switch p.Sector {
+	case "cc":
+		return reflect.TypeOf(fissqlc.AResultcc{}), nil
+	case "jp":
+		return reflect.TypeOf(fissqlc.AResultjp{}), nil
+	default:
+		return reflect.TypeOf(fissqlc.AResultnk{}), nil
+	}
+}
+
+func (e *fisResults) Export(ctx context.Context, params json.RawMessage, emit func(row any) error) error {
+	var p FISResultsParams
+	if err := decodeParams(params, &p); err != nil {
+		return err
+	}
+
+	switch p.Sector {
+	case "cc":
+		return exportFISPages(ctx, emit, func(page utils.Page) ([]fissqlc.AResultcc, error) {
+			return e.fis.ResultCC().ExportResultsCC(ctx, p.SeasonFrom, p.SeasonTo, page)
+		}, func(r fissqlc.AResultcc) int32 { return r.Recid })
+	case "jp":
+		return exportFISPages(ctx, emit, func(page utils.Page) ([]fissqlc.AResultjp, error) {
+			return e.fis.ResultJP().ExportResultsJP(ctx, p.SeasonFrom, p.SeasonTo, page)
+		}, func(r fissqlc.AResultjp) int32 { return r.Recid })
+	case "nk":
+		return exportFISPages(ctx, emit, func(page utils.Page) ([]fissqlc.AResultnk, error) {
+			return e.fis.ResultNK().ExportResultsNK(ctx, p.SeasonFrom, p.SeasonTo, page)
+		}, func(r fissqlc.AResultnk) int32 { return r.Recid })
+	}
+	return fmt.Errorf("%w: unknown sector %q", ErrInvalidParams, p.Sector)
+}
+
+// exportFISPages emits the rows of fetch page by page, keyed on recid
+func exportFISPages[T any](ctx context.Context, emit func(row any) error, fetch func(utils.Page) ([]T, error), recid func(T) int32) error {
+	page := utils.Page{Limit: exportPageSize}
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		rows, err := fetch(page)
+		if err != nil {
+			return err
+		}
+		for i := range rows {
+			if err := emit(&rows[i]); err != nil {
+				return err
+			}
+		}
+		if len(rows) < exportPageSize {
+			return nil
+		}
+		c := utils.IntCursor(int64(recid(rows[len(rows)-1])))
+		page.After = &c
+	}
+}
@@ -0,0 +1,303 @@
+package exports
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	authsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/auth"
+	"github.com/DeRuina/KUHA-REST-API/internal/logger"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/auth"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+// errJobCanceled stops a running export canceled by its client
+var errJobCanceled = errors.New("export canceled")
+
+// Options configures a Pool
+type Options struct {
+	Workers      int
+	PollInterval time.Duration
+	TTL          time.Duration
+	ChunkSize    int
+	StaleAfter   time.Duration
+}
+
+// Pool runs queued export jobs. Jobs are claimed with SKIP LOCKED, so
+// several API instances can share the queue. A running job reports its
+// progress every PollInterval and stops when its client cancels it; jobs
+// of a worker that stopped reporting for StaleAfter are queued again.
+type Pool struct {
+	jobs     auth.ExportJobs
+	registry Registry
+	opts     Options
+	name     string
+
+	wake   chan struct{}
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewPool(jobs auth.ExportJobs, registry Registry, opts Options) *Pool {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	return &Pool{
+		jobs:     jobs,
+		registry: registry,
+		opts:     opts,
+		name:     fmt.Sprintf("%s:%d", host, os.Getpid()),
+		wake:     make(chan struct{}, 1),
+	}
+}
+
+// Registry returns the exporters the pool can run
+func (p *Pool) Registry() Registry {
+	return p.registry
+}
+
+// ChunkSize is the size of the chunks new jobs are stored in
+func (p *Pool) ChunkSize() int32 {
+	return int32(p.opts.ChunkSize)
+}
+
+// ExpiresAt returns when a job finished now expires
+func (p *Pool) ExpiresAt() time.Time {
+	return time.Now().Add(p.opts.TTL)
+}
+
+// Start launches the workers and the janitor
+func (p *Pool) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+
+	for i := 0; i < p.opts.Workers; i++ {
+		p.wg.Add(1)
+		go func(n int) {
+			defer p.wg.Done()
+			p.work(ctx, fmt.Sprintf("%s/%d", p.name, n))
+		}(i)
+	}
+
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		p.janitor(ctx)
+	}()
+
+	logger.Logger.Infow("export workers started", "workers", p.opts.Workers, "kinds", p.registry.Kinds())
+}
+
+// Stop interrupts the running jobs, puts them back in the queue and waits
+// for the workers to exit
+func (p *Pool) Stop() {
+	if p.cancel == nil {
+		return
+	}
+	p.cancel()
+	p.wg.Wait()
+	logger.Logger.Info("export workers stopped")
+}
+
+// Notify wakes an idle worker so a new job starts without waiting for the
+// next poll
+func (p *Pool) Notify() {
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (p *Pool) work(ctx context.Context, worker string) {
+	ticker := time.NewTicker(p.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		// drain the queue before waiting again
+		for ctx.Err() == nil {
+			job, err := p.jobs.ClaimJob(ctx, worker)
+			if errors.Is(err, sql.ErrNoRows) {
+				break
+			}
+			if err != nil {
+				if ctx.Err() == nil {
+					logger.Logger.Warnw("claiming export job failed", "worker", worker, "error", err)
+				}
+				break
+			}
+			p.run(ctx, job)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-p.wake:
+		}
+	}
+}
+
+func (p *Pool) run(ctx context.Context, job authsqlc.ExportJob) {
+	log := logger.Logger.With("export_id", job.ID, "kind", job.Kind, "client", job.ClientName)
+	start := time.Now()
+
+	// the jobs table outlives requests; use a fresh context for bookkeeping
+	bg := context.Background()
+
+	jobCtx, cancel := context.WithCancelCause(ctx)
+
+	var rows atomic.Int64
+	cw := newChunkWriter(jobCtx, p.jobs, job.ID, int(job.ChunkSize))
+
+	heartbeat := make(chan struct{})
+	go func() {
+		defer close(heartbeat)
+		p.heartbeat(jobCtx, cancel, job, &rows, cw)
+	}()
+
+	err := p.export(jobCtx, job, cw, &rows)
+
+	cancel(nil)
+	<-heartbeat
+
+	switch {
+	case errors.Is(context.Cause(jobCtx), errJobCanceled):
+		log.Infow("export canceled", "rows", rows.Load())
+		p.dropFile(job)
+	case ctx.Err() != nil:
+		log.Infow("export interrupted, requeueing", "rows", rows.Load())
+		p.dropFile(job)
+		if err := p.jobs.RequeueJob(bg, job.ID); err != nil {
+			log.Warnw("requeueing export failed", "error", err)
+		}
+	case err != nil:
+		log.Warnw("export failed", "error", err, "rows", rows.Load())
+		p.dropFile(job)
+		msg := "export failed"
+		if errors.Is(err, ErrInvalidParams) {
+			msg = err.Error()
+		}
+		if err := p.jobs.FailJob(bg, job.ID, msg, p.ExpiresAt()); err != nil {
+			log.Warnw("marking export as failed failed", "error", err)
+		}
+	default:
+		ok, err := p.jobs.FinishJob(bg, job.ID, rows.Load(), cw.Size(), p.ExpiresAt())
+		if err != nil {
+			log.Warnw("marking export as finished failed", "error", err)
+			return
+		}
+		if !ok {
+			// canceled after the last heartbeat
+			p.dropFile(job)
+			return
+		}
+		log.Infow("export finished", "rows", rows.Load(), "bytes", cw.Size(), "duration", time.Since(start).String())
+	}
+}
+
+// export writes the rows of job through cw
+func (p *Pool) export(ctx context.Context, job authsqlc.ExportJob, cw *chunkWriter, rows *atomic.Int64) error {
+	exp, ok := p.registry[job.Kind]
+	if !ok {
+		return fmt.Errorf("%w: unknown kind %q", ErrInvalidParams, job.Kind)
+	}
+	rowType, err := exp.Prepare(job.Params)
+	if err != nil {
+		return err
+	}
+
+	// a requeued job starts over
+	if err := p.jobs.DeleteChunks(ctx, job.ID); err != nil {
+		return err
+	}
+
+	tw, err := utils.NewTableWriter(cw, utils.Format(job.Format), rowType)
+	if err != nil {
+		return err
+	}
+
+	err = exp.Export(ctx, job.Params, func(row any) error {
+		if err := tw.Write(row); err != nil {
+			return err
+		}
+		rows.Add(1)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return cw.Close()
+}
+
+// heartbeat records the progress of job until ctx is done and cancels the
+// export once the client has canceled the job
+func (p *Pool) heartbeat(ctx context.Context, cancel context.CancelCauseFunc, job authsqlc.ExportJob, rows *atomic.Int64, cw *chunkWriter) {
+	ticker := time.NewTicker(p.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		status, err := p.jobs.TouchJob(ctx, job.ID, rows.Load(), cw.Size())
+		if err != nil {
+			if ctx.Err() == nil {
+				logger.Logger.Warnw("export heartbeat failed", "export_id", job.ID, "error", err)
+			}
+			continue
+		}
+		if status != auth.ExportRunning {
+			cancel(errJobCanceled)
+			return
+		}
+	}
+}
+
+// dropFile deletes the chunks written so far
+func (p *Pool) dropFile(job authsqlc.ExportJob) {
+	if err := p.jobs.DeleteChunks(context.Background(), job.ID); err != nil {
+		logger.Logger.Warnw("deleting export chunks failed", "export_id", job.ID, "error", err)
+	}
+}
+
+// janitor requeues the jobs of dead workers and deletes expired jobs
+func (p *Pool) janitor(ctx context.Context) {
+	ticker := time.NewTicker(p.opts.StaleAfter)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if n, err := p.jobs.RequeueStaleJobs(ctx, time.Now().Add(-p.opts.StaleAfter)); err != nil {
+			if ctx.Err() == nil {
+				logger.Logger.Warnw("requeueing stale exports failed", "error", err)
+			}
+		} else if n > 0 {
+			logger.Logger.Infow("requeued stale exports", "count", n)
+		}
+
+		if n, err := p.jobs.DeleteExpiredJobs(ctx); err != nil {
+			if ctx.Err() == nil {
+				logger.Logger.Warnw("deleting expired exports failed", "error", err)
+			}
+		} else if n > 0 {
+			logger.Logger.Infow("deleted expired exports", "count", n)
+		}
+	}
+}
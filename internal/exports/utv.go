@@ -0,0 +1,129 @@
+package exports
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/store"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/utv"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/google/uuid"
+)
+
+// UTVDataParams selects the daily data of one provider for the members of
+// a group and/or a list of users, optionally limited to a range of days
+type UTVDataParams struct {
+	Source  string      `json:"source" validate:"required,oneof=oura polar garmin suunto"`
+	GroupID *uuid.UUID  `json:"group_id" validate:"required_without=UserIDs"`
+	UserIDs []uuid.UUID `json:"user_ids" validate:"required_without=GroupID,max=1000"`
+	From    string      `json:"from" validate:"omitempty,datetime=2006-01-02"`
+	To      string      `json:"to" validate:"omitempty,datetime=2006-01-02"`
+}
+
+// UTVDataRow is one day of provider data of a user
+type UTVDataRow struct {
+	UserID      uuid.UUID       `json:"user_id"`
+	SummaryDate string          `json:"summary_date"`
+	Data        json.RawMessage `json:"data"`
+}
+
+// utvData exports provider data ordered by user and day
+type utvData struct {
+	utv store.UTV
+}
+
+type utvExporter interface {
+	ExportData(ctx context.Context, filter utv.ExportFilter, page utils.Page) ([]utv.ExportRow, error)
+}
+
+func (e *utvData) Path() string {
+	return "/v1/utv/data"
+}
+
+func (e *utvData) Prepare(params json.RawMessage) (reflect.Type, error) {
+	var p UTVDataParams
+	if err := decodeParams(params, &p); err != nil {
+		return nil, err
+	}
+	if _, err := p.filter(); err != nil {
+		return nil, err
+	}
+	return reflect.TypeOf(UTVDataRow{}), nil
+}
+
+func (e *utvData) Export(ctx context.Context, params json.RawMessage, emit func(row any) error) error {
+	var p UTVDataParams
+	if err := decodeParams(params, &p); err != nil {
+		return err
+	}
+	filter, err := p.filter()
+	if err != nil {
+		return err
+	}
+
+	var src utvExporter
+	switch p.Source {
+	case "oura":
+		src = e.utv.Oura()
+	case "polar":
+		src = e.utv.Polar()
+	case "garmin":
+		src = e.utv.Garmin()
+	case "suunto":
+		src = e.utv.Suunto()
+	default:
+		return fmt.Errorf("%w: unknown source %q", ErrInvalidParams, p.Source)
+	}
+
+	page := utils.Page{Limit: exportPageSize}
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		rows, err := src.ExportData(ctx, filter, page)
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			err := emit(UTVDataRow{
+				UserID:      row.UserID,
+				SummaryDate: row.Date.Format("2006-01-02"),
+				Data:        row.Data,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if len(rows) < exportPageSize {
+			return nil
+		}
+		last := rows[len(rows)-1]
+		c := utils.TimeCursor(last.Date, last.UserID)
+		page.After = &c
+	}
+}
+
+func (p UTVDataParams) filter() (utv.ExportFilter, error) {
+	f := utv.ExportFilter{GroupID: p.GroupID, UserIDs: p.UserIDs}
+	if p.From != "" {
+		t, err := time.Parse("2006-01-02", p.From)
+		if err != nil {
+			return f, fmt.Errorf("%w: from: %v", ErrInvalidParams, err)
+		}
+		f.From = &t
+	}
+	if p.To != "" {
+		t, err := time.Parse("2006-01-02", p.To)
+		if err != nil {
+			return f, fmt.Errorf("%w: to: %v", ErrInvalidParams, err)
+		}
+		f.To = &t
+	}
+	if f.From != nil && f.To != nil && f.To.Before(*f.From) {
+		return f, fmt.Errorf("%w: to must not be before from", ErrInvalidParams)
+	}
+	return f, nil
+}
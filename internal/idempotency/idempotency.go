@@ -0,0 +1,94 @@
+// Package idempotency stores the responses of write requests sent with an
+// Idempotency-Key header so that a retried request gets the original
+// response instead of being executed twice.
+package idempotency
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// ErrNotHeld is returned by Complete and Release when the key is no longer
+// held by the request: its reservation expired and another request took
+// the key over.
+var ErrNotHeld = errors.New("idempotency key is no longer held by this request")
+
+// Record is the state of an idempotency key. Status is 0 while the
+// original request is still running, and Token identifies that request.
+type Record struct {
+	Fingerprint string      `json:"fingerprint"`
+	Token       string      `json:"token,omitempty"`
+	Status      int         `json:"status,omitempty"`
+	Header      http.Header `json:"header,omitempty"`
+	Body        []byte      `json:"body,omitempty"`
+}
+
+// Completed reports whether the original request has finished
+func (r Record) Completed() bool {
+	return r.Status != 0
+}
+
+// Store keeps idempotency records
+type Store interface {
+	// Reserve claims key for a request with the given fingerprint for at
+	// most lockTTL and returns the record with the Token of the request. If
+	// the key is already taken it returns the existing record and false.
+	Reserve(ctx context.Context, key, fingerprint string, lockTTL time.Duration) (Record, bool, error)
+	// Complete stores the response of the request holding key with token
+	// for ttl, or returns ErrNotHeld
+	Complete(ctx context.Context, key, token string, rec Record, ttl time.Duration) error
+	// Release frees key held with token so that the request can be
+	// retried, or returns ErrNotHeld
+	Release(ctx context.Context, key, token string) error
+}
+
+// newToken returns a random token for a reservation
+func newToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// Key scopes a client supplied key to the client and the endpoint, so
+// that the same key sent by two clients or to two endpoints never collides
+func Key(clientName, method, path, key string) string {
+	h := sha256.New()
+	for _, s := range []string{clientName, method, path, key} {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Fingerprint identifies the content of a request, so that reusing a key
+// for a different request can be detected
+func Fingerprint(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method))
+	h.Write([]byte{0})
+	h.Write([]byte(r.URL.Path))
+	h.Write([]byte{0})
+	h.Write([]byte(r.URL.RawQuery))
+	h.Write([]byte{0})
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// header keys that are not replayed
+var skippedHeaders = []string{"Date", "Content-Length", "Set-Cookie", "X-Request-Id"}
+
+// ResponseHeader returns the headers of a response worth replaying
+func ResponseHeader(h http.Header) http.Header {
+	out := h.Clone()
+	for _, k := range skippedHeaders {
+		out.Del(k)
+	}
+	return out
+}
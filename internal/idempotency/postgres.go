@@ -0,0 +1,122 @@
+package idempotency
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	authsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/auth"
+	"github.com/DeRuina/KUHA-REST-API/internal/logger"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/auth"
+	"github.com/sqlc-dev/pqtype"
+)
+
+// cleanupInterval is how often expired keys are deleted from Postgres
+const cleanupInterval = time.Hour
+
+// PostgresStore keeps records in the idempotency_keys table of the auth
+// database. Expired rows are taken over by new requests and deleted in
+// the background from time to time.
+type PostgresStore struct {
+	keys        auth.IdempotencyKeys
+	lastCleanup atomic.Int64
+}
+
+func NewPostgresStore(keys auth.IdempotencyKeys) *PostgresStore {
+	return &PostgresStore{keys: keys}
+}
+
+func (s *PostgresStore) Reserve(ctx context.Context, key, fingerprint string, lockTTL time.Duration) (Record, bool, error) {
+	s.cleanup()
+
+	token, err := newToken()
+	if err != nil {
+		return Record{}, false, err
+	}
+
+	// a key that expires between the insert and the select is simply tried again
+	for range 2 {
+		ok, err := s.keys.ReserveKey(ctx, key, fingerprint, token, time.Now().Add(lockTTL))
+		if err != nil {
+			return Record{}, false, err
+		}
+		if ok {
+			return Record{Fingerprint: fingerprint, Token: token}, true, nil
+		}
+
+		row, err := s.keys.GetKey(ctx, key)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return Record{}, false, err
+		}
+		rec := Record{
+			Fingerprint: row.Fingerprint,
+			Status:      int(row.Status.Int32),
+			Body:        row.Body,
+		}
+		if row.Headers.Valid {
+			if err := json.Unmarshal(row.Headers.RawMessage, &rec.Header); err != nil {
+				return Record{}, false, err
+			}
+		}
+		return rec, false, nil
+	}
+	return Record{}, false, errors.New("idempotency key expired while being reserved")
+}
+
+func (s *PostgresStore) Complete(ctx context.Context, key, token string, rec Record, ttl time.Duration) error {
+	header, err := json.Marshal(rec.Header)
+	if err != nil {
+		return err
+	}
+	ok, err := s.keys.CompleteKey(ctx, authsqlc.CompleteIdempotencyKeyParams{
+		Key:       key,
+		Token:     token,
+		Status:    sql.NullInt32{Int32: int32(rec.Status), Valid: true},
+		Headers:   pqtype.NullRawMessage{RawMessage: header, Valid: rec.Header != nil},
+		Body:      rec.Body,
+		ExpiresAt: time.Now().Add(ttl),
+	})
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrNotHeld
+	}
+	return nil
+}
+
+func (s *PostgresStore) Release(ctx context.Context, key, token string) error {
+	ok, err := s.keys.DeleteKey(ctx, key, token)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrNotHeld
+	}
+	return nil
+}
+
+func (s *PostgresStore) cleanup() {
+	now := time.Now()
+	last := s.lastCleanup.Load()
+	if now.Sub(time.Unix(0, last)) < cleanupInterval || !s.lastCleanup.CompareAndSwap(last, now.UnixNano()) {
+		return
+	}
+
+	go func() {
+		n, err := s.keys.DeleteExpiredKeys(context.Background())
+		if err != nil {
+			logger.Logger.Warnw("failed to delete expired idempotency keys", "error", err)
+			return
+		}
+		if n > 0 {
+			logger.Logger.Infow("deleted expired idempotency keys", "count", n)
+		}
+	}()
+}
@@ -0,0 +1,113 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/store/cache"
+	"github.com/redis/go-redis/v9"
+)
+
+const redisKeyPrefix = "idempotency:"
+
+// RedisStore keeps records as JSON values that expire with the key
+type RedisStore struct {
+	cache *cache.Storage
+}
+
+func NewRedisStore(c *cache.Storage) *RedisStore {
+	return &RedisStore{cache: c}
+}
+
+func (s *RedisStore) Reserve(ctx context.Context, key, fingerprint string, lockTTL time.Duration) (Record, bool, error) {
+	token, err := newToken()
+	if err != nil {
+		return Record{}, false, err
+	}
+	rec := Record{Fingerprint: fingerprint, Token: token}
+	b, err := json.Marshal(rec)
+	if err != nil {
+		return Record{}, false, err
+	}
+
+	// a key that expires between SetNX and Get is simply tried again
+	for range 2 {
+		ok, err := s.cache.SetNX(ctx, redisKeyPrefix+key, string(b), lockTTL)
+		if err != nil {
+			return Record{}, false, err
+		}
+		if ok {
+			return rec, true, nil
+		}
+
+		raw, err := s.cache.Get(ctx, redisKeyPrefix+key)
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			return Record{}, false, err
+		}
+		var existing Record
+		if err := json.Unmarshal([]byte(raw), &existing); err != nil {
+			return Record{}, false, err
+		}
+		return existing, false, nil
+	}
+	return Record{}, false, errors.New("idempotency key expired while being reserved")
+}
+
+// Complete replaces the reservation only if the key still holds it, so a
+// request whose reservation expired cannot overwrite the key of the one
+// that took it over
+func (s *RedisStore) Complete(ctx context.Context, key, token string, rec Record, ttl time.Duration) error {
+	held, err := reservation(rec.Fingerprint, token)
+	if err != nil {
+		return err
+	}
+	rec.Token = ""
+	b, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	ok, err := s.cache.CompareAndSet(ctx, redisKeyPrefix+key, held, string(b), ttl)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrNotHeld
+	}
+	return nil
+}
+
+func (s *RedisStore) Release(ctx context.Context, key, token string) error {
+	raw, err := s.cache.Get(ctx, redisKeyPrefix+key)
+	if errors.Is(err, redis.Nil) {
+		return ErrNotHeld
+	}
+	if err != nil {
+		return err
+	}
+	var existing Record
+	if err := json.Unmarshal([]byte(raw), &existing); err != nil {
+		return err
+	}
+	if existing.Token != token {
+		return ErrNotHeld
+	}
+	ok, err := s.cache.CompareAndDelete(ctx, redisKeyPrefix+key, raw)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrNotHeld
+	}
+	return nil
+}
+
+// reservation is the value Reserve stores for a request
+func reservation(fingerprint, token string) (string, error) {
+	b, err := json.Marshal(Record{Fingerprint: fingerprint, Token: token})
+	return string(b), err
+}
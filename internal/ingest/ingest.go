@@ -0,0 +1,119 @@
+// Package ingest processes bulk ingestion requests in the background: the
+// body of a write request sent with ?async=true is queued in the auth
+// database and replayed later by a pool of workers through the handler of
+// its route, on behalf of the client that sent it.
+package ingest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+)
+
+var ErrInvalidPayload = errors.New("request body must be a JSON object")
+
+// Handlers maps job kinds to the handler of their route
+type Handlers map[string]http.Handler
+
+// Kinds returns the registered job kinds in sorted order
+func (h Handlers) Kinds() []string {
+	kinds := make([]string, 0, len(h))
+	for kind := range h {
+		kinds = append(kinds, kind)
+	}
+	slices.Sort(kinds)
+	return kinds
+}
+
+// Requested reports whether a write request asks to be processed in the
+// background with ?async=true
+func Requested(r *http.Request) bool {
+	async, _ := strconv.ParseBool(r.URL.Query().Get("async"))
+	return async
+}
+
+// Count returns the number of records of a request body: one per element
+// of its top-level arrays, or one for a body without arrays
+func Count(payload []byte) (int, error) {
+	fields, err := decodeObject(payload)
+	if err != nil {
+		return 0, err
+	}
+	return count(fields), nil
+}
+
+func count(fields map[string]json.RawMessage) int {
+	n := 0
+	for _, raw := range fields {
+		var items []json.RawMessage
+		if json.Unmarshal(raw, &items) == nil {
+			n += len(items)
+		}
+	}
+	return max(n, 1)
+}
+
+// batch is a run of records of a job replayed in one request
+type batch struct {
+	Start   int
+	Records int
+	Body    []byte
+}
+
+// split cuts a request body into batches of at most size records. Only
+// partial mode bodies holding a single array are split: every batch is
+// written in its own transaction, which an all-or-nothing request must
+// not be. Other bodies make a single batch.
+func split(payload []byte, size int, partial bool) ([]batch, error) {
+	fields, err := decodeObject(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	whole := []batch{{Start: 0, Records: count(fields), Body: payload}}
+	if !partial || len(fields) != 1 {
+		return whole, nil
+	}
+
+	var key string
+	var items []json.RawMessage
+	for k, raw := range fields {
+		key = k
+		if json.Unmarshal(raw, &items) != nil {
+			return whole, nil
+		}
+	}
+	if len(items) <= size {
+		return whole, nil
+	}
+
+	batches := make([]batch, 0, (len(items)+size-1)/size)
+	for start := 0; start < len(items); start += size {
+		end := min(start+size, len(items))
+		body, err := json.Marshal(map[string][]json.RawMessage{key: items[start:end]})
+		if err != nil {
+			return nil, err
+		}
+		batches = append(batches, batch{Start: start, Records: end - start, Body: body})
+	}
+	return batches, nil
+}
+
+func decodeObject(payload []byte) (map[string]json.RawMessage, error) {
+	var fields map[string]json.RawMessage
+	dec := json.NewDecoder(bytes.NewReader(payload))
+	if err := dec.Decode(&fields); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPayload, err)
+	}
+	if fields == nil {
+		return nil, ErrInvalidPayload
+	}
+	if dec.More() {
+		return nil, fmt.Errorf("%w: unexpected data after the object", ErrInvalidPayload)
+	}
+	return fields, nil
+}
@@ -0,0 +1,453 @@
+package ingest
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authn"
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	authsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/auth"
+	"github.com/DeRuina/KUHA-REST-API/internal/logger"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/auth"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// maxRetryDelay caps the backoff between attempts of a job
+const maxRetryDelay = time.Hour
+
+// Options configures a Pool
+type Options struct {
+	Workers      int
+	PollInterval time.Duration
+	TTL          time.Duration
+	BatchSize    int
+	MaxAttempts  int
+	RetryBackoff time.Duration
+	StaleAfter   time.Duration
+}
+
+// Pool runs queued ingest jobs. Jobs are claimed with SKIP LOCKED, so
+// several API instances can share the queue. A job is replayed in batches
+// and its progress saved after each, so a job that is requeued resumes
+// after the last saved batch. Server errors are retried with exponential
+// backoff up to MaxAttempts; client errors fail the job at once. Each batch
+// runs with the roles the client has when it runs, not when it was queued,
+// and a client no longer allowed to write to the path fails the job.
+type Pool struct {
+	jobs     auth.IngestJobs
+	handlers Handlers
+	opts     Options
+	name     string
+
+	wake   chan struct{}
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewPool(jobs auth.IngestJobs, opts Options) *Pool {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	return &Pool{
+		jobs:     jobs,
+		handlers: Handlers{},
+		opts:     opts,
+		name:     fmt.Sprintf("%s:%d", host, os.Getpid()),
+		wake:     make(chan struct{}, 1),
+	}
+}
+
+// Register sets the handler that processes jobs of kind. Handlers are
+// registered while the routes are mounted, before Start.
+func (p *Pool) Register(kind string, h http.Handler) {
+	p.handlers[kind] = h
+}
+
+// ExpiresAt returns when a job finished now expires
+func (p *Pool) ExpiresAt() time.Time {
+	return time.Now().Add(p.opts.TTL)
+}
+
+// Start launches the workers and the janitor
+func (p *Pool) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+
+	for i := 0; i < p.opts.Workers; i++ {
+		p.wg.Add(1)
+		go func(n int) {
+			defer p.wg.Done()
+			p.work(ctx, fmt.Sprintf("%s/%d", p.name, n))
+		}(i)
+	}
+
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		p.janitor(ctx)
+	}()
+
+	logger.Logger.Infow("ingest workers started", "workers", p.opts.Workers, "kinds", p.handlers.Kinds())
+}
+
+// Stop interrupts the running jobs, puts them back in the queue and waits
+// for the workers to exit
+func (p *Pool) Stop() {
+	if p.cancel == nil {
+		return
+	}
+	p.cancel()
+	p.wg.Wait()
+	logger.Logger.Info("ingest workers stopped")
+}
+
+// Notify wakes an idle worker so a new job starts without waiting for the
+// next poll
+func (p *Pool) Notify() {
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (p *Pool) work(ctx context.Context, worker string) {
+	ticker := time.NewTicker(p.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		// drain the queue before waiting again
+		for ctx.Err() == nil {
+			job, err := p.jobs.ClaimJob(ctx, worker)
+			if errors.Is(err, sql.ErrNoRows) {
+				break
+			}
+			if err != nil {
+				if ctx.Err() == nil {
+					logger.Logger.Warnw("claiming ingest job failed", "worker", worker, "error", err)
+				}
+				break
+			}
+			p.run(ctx, job)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-p.wake:
+		}
+	}
+}
+
+// jobError is a failure of a job; temporary failures are retried
+type jobError struct {
+	msg       string
+	temporary bool
+}
+
+func (e *jobError) Error() string {
+	return e.msg
+}
+
+func (p *Pool) run(ctx context.Context, job authsqlc.IngestJob) {
+	log := logger.Logger.With("ingest_id", job.ID, "kind", job.Kind, "client", job.ClientName, "attempt", job.Attempts)
+	start := time.Now()
+
+	// the jobs table outlives requests; use a fresh context for bookkeeping
+	bg := context.Background()
+
+	jobCtx, cancel := context.WithCancel(ctx)
+
+	heartbeat := make(chan struct{})
+	go func() {
+		defer close(heartbeat)
+		p.heartbeat(jobCtx, job)
+	}()
+
+	progress, err := p.ingest(jobCtx, job)
+
+	cancel()
+	<-heartbeat
+
+	var jobErr *jobError
+	switch {
+	case ctx.Err() != nil:
+		log.Infow("ingest interrupted, requeueing", "records_done", progress.RecordsDone)
+		if err := p.jobs.RequeueJob(bg, job.ID); err != nil {
+			log.Warnw("requeueing ingest job failed", "error", err)
+		}
+	case err == nil:
+		if err := p.jobs.FinishJob(bg, job.ID, progress, p.ExpiresAt()); err != nil {
+			log.Warnw("marking ingest job as finished failed", "error", err)
+			return
+		}
+		log.Infow("ingest finished", "records", progress.RecordsDone, "failed", progress.RecordsFailed, "duration", time.Since(start).String())
+	case errors.As(err, &jobErr) && !jobErr.temporary:
+		log.Infow("ingest failed", "error", err, "records_done", progress.RecordsDone)
+		if err := p.jobs.FailJob(bg, job.ID, err.Error(), p.ExpiresAt()); err != nil {
+			log.Warnw("marking ingest job as failed failed", "error", err)
+		}
+	case int(job.Attempts) >= p.opts.MaxAttempts:
+		log.Warnw("ingest failed, no attempts left", "error", err, "records_done", progress.RecordsDone)
+		msg := fmt.Sprintf("%s (gave up after %d attempts)", err, job.Attempts)
+		if err := p.jobs.FailJob(bg, job.ID, msg, p.ExpiresAt()); err != nil {
+			log.Warnw("marking ingest job as failed failed", "error", err)
+		}
+	default:
+		delay := p.retryDelay(int(job.Attempts))
+		log.Warnw("ingest failed, retrying", "error", err, "records_done", progress.RecordsDone, "retry_in", delay.String())
+		if err := p.jobs.RetryJob(bg, job.ID, err.Error(), time.Now().Add(delay)); err != nil {
+			log.Warnw("requeueing ingest job failed", "error", err)
+		}
+	}
+}
+
+// ingest replays the batches of job not done yet, saving the progress
+// after each
+func (p *Pool) ingest(ctx context.Context, job authsqlc.IngestJob) (auth.IngestProgress, error) {
+	progress := auth.IngestProgress{
+		RecordsDone:   job.RecordsDone,
+		RecordsFailed: job.RecordsFailed,
+		RecordErrors:  job.RecordErrors,
+	}
+
+	h, ok := p.handlers[job.Kind]
+	if !ok {
+		return progress, &jobError{msg: fmt.Sprintf("unknown kind %q", job.Kind)}
+	}
+
+	payload, err := p.jobs.GetPayload(ctx, job.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return progress, &jobError{msg: "payload is no longer available"}
+	}
+	if err != nil {
+		return progress, err
+	}
+
+	query, err := url.ParseQuery(job.Query)
+	if err != nil {
+		return progress, &jobError{msg: fmt.Sprintf("invalid query: %v", err)}
+	}
+	partial, _ := strconv.ParseBool(query.Get("partial"))
+
+	batches, err := split(payload, p.opts.BatchSize, partial)
+	if err != nil {
+		return progress, &jobError{msg: err.Error()}
+	}
+
+	var recordErrors []utils.BulkItemResult
+	if err := json.Unmarshal(job.RecordErrors, &recordErrors); err != nil {
+		return progress, err
+	}
+
+	for _, b := range batches {
+		// done in an earlier attempt
+		if b.Start+b.Records <= int(progress.RecordsDone) {
+			continue
+		}
+
+		status, body, err := p.replay(ctx, job, h, b.Body)
+		if err != nil {
+			return progress, err
+		}
+		if status >= 300 {
+			return progress, &jobError{
+				msg:       responseError(status, body),
+				temporary: status >= 500,
+			}
+		}
+
+		if partial {
+			var report utils.BulkReport
+			if err := json.Unmarshal(body, &report); err != nil {
+				return progress, fmt.Errorf("reading bulk report: %w", err)
+			}
+			for _, res := range report.Results {
+				if res.Status == utils.BulkFailed {
+					res.Index += b.Start
+					recordErrors = append(recordErrors, res)
+				}
+			}
+			progress.RecordsFailed += int32(report.Summary.Failed)
+		}
+		progress.RecordsDone = int32(b.Start + b.Records)
+
+		if progress.RecordErrors, err = json.Marshal(recordErrors); err != nil {
+			return progress, err
+		}
+		if err := p.jobs.CheckpointJob(ctx, job.ID, progress); err != nil {
+			return progress, err
+		}
+	}
+
+	return progress, nil
+}
+
+// replay sends body to h as a request of the client of job, with its
+// current roles, and returns the response
+func (p *Pool) replay(ctx context.Context, job authsqlc.IngestJob, h http.Handler, body []byte) (int, []byte, error) {
+	roles, err := p.jobs.ClientRoles(ctx, job.ClientName)
+	if err != nil {
+		return 0, nil, err
+	}
+	ctx = authn.WithClientMetadata(ctx, job.ClientName, roles)
+	if !authz.Allowed(ctx, http.MethodPost, job.Path) {
+		return 0, nil, &jobError{msg: fmt.Sprintf("client %s is no longer allowed to write to %s", job.ClientName, job.Path)}
+	}
+	ctx = context.WithValue(ctx, middleware.RequestIDKey, "ingest-"+job.ID.String())
+	// the body already passed the limits of the route when it was queued
+	ctx = utils.WithBodyLimits(ctx, utils.BodyLimits{
+		MaxBytes:             int64(len(body)),
+		MaxGzipBytes:         int64(len(body)),
+		MaxDecompressedBytes: int64(len(body)),
+	})
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, job.Path, bytes.NewReader(body))
+	if err != nil {
+		return 0, nil, &jobError{msg: err.Error()}
+	}
+	req.URL.RawQuery = job.Query
+	req.Header.Set("Content-Type", "application/json")
+
+	rec := &recorder{header: http.Header{}}
+	if err := serve(h, rec, req); err != nil {
+		return 0, nil, err
+	}
+	if ctx.Err() != nil {
+		return 0, nil, ctx.Err()
+	}
+	if rec.status == 0 {
+		rec.status = http.StatusOK
+	}
+	return rec.status, rec.body.Bytes(), nil
+}
+
+// serve runs h, turning a panic into an error
+func serve(h http.Handler, w http.ResponseWriter, r *http.Request) (err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			err = fmt.Errorf("handler panicked: %v", v)
+		}
+	}()
+	h.ServeHTTP(w, r)
+	return nil
+}
+
+// recorder is the response writer of replayed requests
+type recorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (r *recorder) Header() http.Header {
+	return r.header
+}
+
+func (r *recorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+}
+
+func (r *recorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	return r.body.Write(b)
+}
+
+// responseError describes an error response, e.g.
+// "400 Bad Request: user_id: invalid UUID"
+func responseError(status int, body []byte) string {
+	msg := fmt.Sprintf("%d %s", status, http.StatusText(status))
+
+	var resp struct {
+		Errors []map[string]string `json:"errors"`
+	}
+	if json.Unmarshal(body, &resp) != nil || len(resp.Errors) == 0 {
+		return msg
+	}
+
+	details := make([]string, 0, len(resp.Errors))
+	for _, e := range resp.Errors {
+		for field, text := range e {
+			if field == "error" {
+				details = append(details, text)
+			} else {
+				details = append(details, field+": "+text)
+			}
+		}
+	}
+	return msg + ": " + strings.Join(details, "; ")
+}
+
+// retryDelay is the backoff after the given number of attempts
+func (p *Pool) retryDelay(attempts int) time.Duration {
+	delay := p.opts.RetryBackoff
+	for i := 1; i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
+
+// heartbeat records that job is alive until ctx is done
+func (p *Pool) heartbeat(ctx context.Context, job authsqlc.IngestJob) {
+	ticker := time.NewTicker(p.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := p.jobs.TouchJob(ctx, job.ID); err != nil && ctx.Err() == nil {
+			logger.Logger.Warnw("ingest heartbeat failed", "ingest_id", job.ID, "error", err)
+		}
+	}
+}
+
+// janitor requeues the jobs of dead workers and deletes expired jobs
+func (p *Pool) janitor(ctx context.Context) {
+	ticker := time.NewTicker(p.opts.StaleAfter)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if n, err := p.jobs.RequeueStaleJobs(ctx, time.Now().Add(-p.opts.StaleAfter)); err != nil {
+			if ctx.Err() == nil {
+				logger.Logger.Warnw("requeueing stale ingest jobs failed", "error", err)
+			}
+		} else if n > 0 {
+			logger.Logger.Infow("requeued stale ingest jobs", "count", n)
+		}
+
+		if n, err := p.jobs.DeleteExpiredJobs(ctx); err != nil {
+			if ctx.Err() == nil {
+				logger.Logger.Warnw("deleting expired ingest jobs failed", "error", err)
+			}
+		} else if n > 0 {
+			logger.Logger.Infow("deleted expired ingest jobs", "count", n)
+		}
+	}
+}
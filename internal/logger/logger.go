@@ -2,6 +2,7 @@ package logger
 
 import (
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"time"
@@ -71,6 +72,11 @@ func (rw *responseRecorder) WriteHeader(code int) {
 	rw.ResponseWriter.WriteHeader(code)
 }
 
+// Unwrap lets http.ResponseController reach the underlying writer, e.g. to flush streamed responses
+func (rw *responseRecorder) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
 func LoggerMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
@@ -88,7 +94,7 @@ func LoggerMiddleware(next http.Handler) http.Handler {
 		logFields := []zap.Field{
 			zap.String("method", r.Method),
 			zap.String("path", r.URL.Path),
-			zap.String("query_params", r.URL.RawQuery),
+			zap.String("query_params", redactQuery(r.URL)),
 			zap.String("client_id", clientID),
 			zap.String("request_id", requestID),
 			zap.String("ip", r.RemoteAddr),
@@ -107,3 +113,14 @@ func LoggerMiddleware(next http.Handler) http.Handler {
 		}
 	})
 }
+
+// redactQuery returns the query of u with the value of its token parameter,
+// the secret of a calendar feed, hidden
+func redactQuery(u *url.URL) string {
+	q := u.Query()
+	if !q.Has("token") {
+		return u.RawQuery
+	}
+	q.Set("token", "REDACTED")
+	return q.Encode()
+}
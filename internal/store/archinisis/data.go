@@ -5,6 +5,7 @@ import (
 	"database/sql"
 
 	archsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/archinisis"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
 )
 
@@ -100,7 +101,12 @@ func (s *DataStore) UpsertRaceReport(ctx context.Context, sporttiID string, sess
 		return err
 	}
 
-	return tx.Commit()
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, events.New("archinisis", "race_reports", events.Upsert, 1, sporttiID))
+	return nil
 }
 
 func (s *DataStore) GetSporttiIDsBySessionID(ctx context.Context, sessionID int32) ([]string, error) {
@@ -138,7 +144,12 @@ func (s *DataStore) UpsertData(ctx context.Context, payload ArchDataPayload) err
 		}
 	}
 
-	return tx.Commit()
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, events.New("archinisis", "data", events.Upsert, 1+len(payload.Measurements), payload.Athlete.NationalID))
+	return nil
 }
 
 func (s *DataStore) GetDataBySporttiID(ctx context.Context, sporttiID string) (*ArchDataResponse, error) {
@@ -3,11 +3,14 @@ package archinisis
 import (
 	"context"
 	"database/sql"
+
+	archsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/archinisis"
 )
 
 // Interfaces
 type Users interface {
 	DeleteUserBySporttiID(ctx context.Context, sporttiID string) (string, error)
+	ListAthleteIdentities(ctx context.Context) ([]archsqlc.ListAthleteIdentitiesRow, error)
 }
 
 type Data interface {
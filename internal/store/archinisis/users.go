@@ -5,6 +5,7 @@ import (
 	"database/sql"
 
 	archsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/archinisis"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
 )
 
@@ -26,5 +27,17 @@ func (s *UsersStore) DeleteUserBySporttiID(ctx context.Context, sporttiID string
 	if err != nil {
 		return "", err
 	}
+
+	events.Emit(ctx, events.New("archinisis", "users", events.Delete, 1, id))
 	return deletedID, nil
 }
+
+// ListAthleteIdentities returns the name and birthdate of the athletes, for
+// matching them to FIS competitors
+func (s *UsersStore) ListAthleteIdentities(ctx context.Context) ([]archsqlc.ListAthleteIdentitiesRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.BulkQueryTimeout)
+	defer cancel()
+
+	q := archsqlc.New(s.db)
+	return q.ListAthleteIdentities(ctx)
+}
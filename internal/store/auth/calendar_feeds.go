@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authn"
+	authsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/auth"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/google/uuid"
+)
+
+// CalendarFeedsStore keeps the feed tokens that let calendar applications
+// read the FIS calendar of a client without its bearer token. Only the
+// hashes of the tokens are stored.
+type CalendarFeedsStore struct {
+	db *sql.DB
+}
+
+func hashFeedToken(token string) string {
+	hashed := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hashed[:])
+}
+
+// CreateFeed creates a feed for the client and returns it with its token,
+// which cannot be read back later
+func (s *CalendarFeedsStore) CreateFeed(ctx context.Context, clientName string) (authsqlc.CalendarFeed, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	token, err := authn.GenerateRandomToken()
+	if err != nil {
+		return authsqlc.CalendarFeed{}, "", err
+	}
+
+	feed, err := authsqlc.New(s.db).CreateCalendarFeed(ctx, authsqlc.CreateCalendarFeedParams{
+		ClientName: clientName,
+		TokenHash:  hashFeedToken(token),
+	})
+	if err != nil {
+		return authsqlc.CalendarFeed{}, "", err
+	}
+	return feed, token, nil
+}
+
+// ListFeeds lists the feeds of the client, newest first
+func (s *CalendarFeedsStore) ListFeeds(ctx context.Context, clientName string) ([]authsqlc.CalendarFeed, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).ListCalendarFeedsByClient(ctx, clientName)
+}
+
+// DeleteFeed revokes a feed of the client and returns the number of feeds
+// deleted
+func (s *CalendarFeedsStore) DeleteFeed(ctx context.Context, id uuid.UUID, clientName string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).DeleteCalendarFeed(ctx, authsqlc.DeleteCalendarFeedParams{
+		ID:         id,
+		ClientName: clientName,
+	})
+}
+
+// UseFeed returns the client of a feed token with its current roles and
+// records the use. It returns sql.ErrNoRows if the token is unknown or
+// revoked, or if the client was deleted or its client token revoked.
+func (s *CalendarFeedsStore) UseFeed(ctx context.Context, token string) (authsqlc.UseCalendarFeedRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).UseCalendarFeed(ctx, hashFeedToken(token))
+}
@@ -0,0 +1,167 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	authsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/auth"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/google/uuid"
+)
+
+// Export job statuses
+const (
+	ExportQueued    = "queued"
+	ExportRunning   = "running"
+	ExportSucceeded = "succeeded"
+	ExportFailed    = "failed"
+	ExportCanceled  = "canceled"
+)
+
+type ExportJobsStore struct {
+	db *sql.DB
+}
+
+func (s *ExportJobsStore) CreateJob(ctx context.Context, arg authsqlc.CreateExportJobParams) (authsqlc.ExportJob, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).CreateExportJob(ctx, arg)
+}
+
+func (s *ExportJobsStore) GetJob(ctx context.Context, id uuid.UUID, clientName string) (authsqlc.ExportJob, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).GetExportJob(ctx, authsqlc.GetExportJobParams{
+		ID:         id,
+		ClientName: clientName,
+	})
+}
+
+func (s *ExportJobsStore) ListJobs(ctx context.Context, clientName string, page utils.Page) ([]authsqlc.ExportJob, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).ListExportJobsByClient(ctx, authsqlc.ListExportJobsByClientParams{
+		ClientName: clientName,
+		AfterTime:  page.AfterTime(),
+		AfterID:    page.AfterID(),
+		PageLimit:  page.FetchLimit(),
+	})
+}
+
+// CancelJob cancels a queued or running job of the client. It returns
+// sql.ErrNoRows if there is no such job.
+func (s *ExportJobsStore) CancelJob(ctx context.Context, id uuid.UUID, clientName string, expiresAt time.Time) (authsqlc.ExportJob, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).CancelExportJob(ctx, authsqlc.CancelExportJobParams{
+		ID:         id,
+		ClientName: clientName,
+		ExpiresAt:  expiresAt,
+	})
+}
+
+// ClaimJob marks the oldest queued job as running by worker. It returns
+// sql.ErrNoRows if the queue is empty.
+func (s *ExportJobsStore) ClaimJob(ctx context.Context, worker string) (authsqlc.ExportJob, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).ClaimExportJob(ctx, utils.NullString(worker))
+}
+
+// TouchJob records the progress of a running job and returns its status,
+// which is "canceled" once the client has canceled it
+func (s *ExportJobsStore) TouchJob(ctx context.Context, id uuid.UUID, rows, size int64) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).TouchExportJob(ctx, authsqlc.TouchExportJobParams{
+		ID:          id,
+		RowsWritten: rows,
+		SizeBytes:   size,
+	})
+}
+
+// FinishJob marks a running job as succeeded. It reports false if the job
+// was canceled in the meantime.
+func (s *ExportJobsStore) FinishJob(ctx context.Context, id uuid.UUID, rows, size int64, expiresAt time.Time) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	n, err := authsqlc.New(s.db).FinishExportJob(ctx, authsqlc.FinishExportJobParams{
+		ID:          id,
+		RowsWritten: rows,
+		SizeBytes:   size,
+		ExpiresAt:   expiresAt,
+	})
+	return n > 0, err
+}
+
+func (s *ExportJobsStore) FailJob(ctx context.Context, id uuid.UUID, msg string, expiresAt time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).FailExportJob(ctx, authsqlc.FailExportJobParams{
+		ID:        id,
+		Error:     utils.NullString(msg),
+		ExpiresAt: expiresAt,
+	})
+}
+
+// RequeueJob puts a running job back in the queue, e.g. on shutdown
+func (s *ExportJobsStore) RequeueJob(ctx context.Context, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).RequeueExportJob(ctx, id)
+}
+
+// RequeueStaleJobs puts running jobs without a heartbeat since before back
+// in the queue; their worker is assumed dead
+func (s *ExportJobsStore) RequeueStaleJobs(ctx context.Context, before time.Time) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).RequeueStaleExportJobs(ctx, sql.NullTime{Time: before, Valid: true})
+}
+
+// DeleteExpiredJobs deletes expired jobs together with their files
+func (s *ExportJobsStore) DeleteExpiredJobs(ctx context.Context) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.BulkQueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).DeleteExpiredExportJobs(ctx)
+}
+
+func (s *ExportJobsStore) InsertChunk(ctx context.Context, id uuid.UUID, seq int32, data []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).InsertExportJobChunk(ctx, authsqlc.InsertExportJobChunkParams{
+		JobID: id,
+		Seq:   seq,
+		Data:  data,
+	})
+}
+
+func (s *ExportJobsStore) GetChunk(ctx context.Context, id uuid.UUID, seq int32) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).GetExportJobChunk(ctx, authsqlc.GetExportJobChunkParams{
+		JobID: id,
+		Seq:   seq,
+	})
+}
+
+func (s *ExportJobsStore) DeleteChunks(ctx context.Context, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, utils.BulkQueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).DeleteExportJobChunks(ctx, id)
+}
@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	authsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/auth"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+type IdempotencyKeysStore struct {
+	db *sql.DB
+}
+
+// ReserveKey claims key for a new request identified by token. It reports
+// false if the key is already held by an unexpired request, completed or
+// not.
+func (s *IdempotencyKeysStore) ReserveKey(ctx context.Context, key, fingerprint, token string, expiresAt time.Time) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	n, err := authsqlc.New(s.db).ReserveIdempotencyKey(ctx, authsqlc.ReserveIdempotencyKeyParams{
+		Key:         key,
+		Fingerprint: fingerprint,
+		Token:       token,
+		ExpiresAt:   expiresAt,
+	})
+	return n > 0, err
+}
+
+func (s *IdempotencyKeysStore) GetKey(ctx context.Context, key string) (authsqlc.IdempotencyKey, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).GetIdempotencyKey(ctx, key)
+}
+
+// CompleteKey stores the response of the request holding the key with
+// arg.Token. It reports false if the key is no longer held by it.
+func (s *IdempotencyKeysStore) CompleteKey(ctx context.Context, arg authsqlc.CompleteIdempotencyKeyParams) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	n, err := authsqlc.New(s.db).CompleteIdempotencyKey(ctx, arg)
+	return n > 0, err
+}
+
+// DeleteKey frees a key still held, and not completed, by the request with
+// token. It reports false if the key is no longer held by it.
+func (s *IdempotencyKeysStore) DeleteKey(ctx context.Context, key, token string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	n, err := authsqlc.New(s.db).DeleteIdempotencyKey(ctx, authsqlc.DeleteIdempotencyKeyParams{
+		Key:   key,
+		Token: token,
+	})
+	return n > 0, err
+}
+
+func (s *IdempotencyKeysStore) DeleteExpiredKeys(ctx context.Context) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).DeleteExpiredIdempotencyKeys(ctx)
+}
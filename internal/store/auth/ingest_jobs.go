@@ -0,0 +1,221 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	authsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/auth"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/google/uuid"
+)
+
+// Ingest job statuses
+const (
+	IngestQueued    = "queued"
+	IngestRunning   = "running"
+	IngestSucceeded = "succeeded"
+	IngestFailed    = "failed"
+)
+
+// IngestProgress is the state of a running ingest job saved after every
+// batch, so that a requeued job resumes after the last saved batch
+type IngestProgress struct {
+	RecordsDone   int32
+	RecordsFailed int32
+	RecordErrors  json.RawMessage
+}
+
+type IngestJobsStore struct {
+	db *sql.DB
+}
+
+// CreateJob queues a job together with its payload
+func (s *IngestJobsStore) CreateJob(ctx context.Context, arg authsqlc.CreateIngestJobParams, payload []byte) (authsqlc.IngestJob, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.BulkQueryTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return authsqlc.IngestJob{}, err
+	}
+	defer tx.Rollback()
+
+	q := authsqlc.New(tx)
+
+	job, err := q.CreateIngestJob(ctx, arg)
+	if err != nil {
+		return job, err
+	}
+	if err := q.InsertIngestJobPayload(ctx, authsqlc.InsertIngestJobPayloadParams{
+		JobID: job.ID,
+		Data:  payload,
+	}); err != nil {
+		return job, err
+	}
+
+	return job, tx.Commit()
+}
+
+func (s *IngestJobsStore) GetJob(ctx context.Context, id uuid.UUID, clientName string) (authsqlc.IngestJob, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).GetIngestJob(ctx, authsqlc.GetIngestJobParams{
+		ID:         id,
+		ClientName: clientName,
+	})
+}
+
+func (s *IngestJobsStore) ListJobs(ctx context.Context, clientName string, page utils.Page) ([]authsqlc.IngestJob, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).ListIngestJobsByClient(ctx, authsqlc.ListIngestJobsByClientParams{
+		ClientName: clientName,
+		AfterTime:  page.AfterTime(),
+		AfterID:    page.AfterID(),
+		PageLimit:  page.FetchLimit(),
+	})
+}
+
+// ResubmitJob queues a failed job of the client again. It returns
+// sql.ErrNoRows if there is no such job.
+func (s *IngestJobsStore) ResubmitJob(ctx context.Context, id uuid.UUID, clientName string, expiresAt time.Time) (authsqlc.IngestJob, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).ResubmitIngestJob(ctx, authsqlc.ResubmitIngestJobParams{
+		ID:         id,
+		ClientName: clientName,
+		ExpiresAt:  expiresAt,
+	})
+}
+
+// ClaimJob marks the oldest queued job that is due as running by worker.
+// It returns sql.ErrNoRows if no job is due.
+func (s *IngestJobsStore) ClaimJob(ctx context.Context, worker string) (authsqlc.IngestJob, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).ClaimIngestJob(ctx, utils.NullString(worker))
+}
+
+// ClientRoles returns the current roles of a client, none if it was deleted
+// or its token revoked
+func (s *IngestJobsStore) ClientRoles(ctx context.Context, clientName string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).GetIngestJobClientRoles(ctx, clientName)
+}
+
+func (s *IngestJobsStore) GetPayload(ctx context.Context, id uuid.UUID) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.BulkQueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).GetIngestJobPayload(ctx, id)
+}
+
+// TouchJob records that the worker of a running job is alive
+func (s *IngestJobsStore) TouchJob(ctx context.Context, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).TouchIngestJob(ctx, id)
+}
+
+func (s *IngestJobsStore) CheckpointJob(ctx context.Context, id uuid.UUID, progress IngestProgress) error {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).CheckpointIngestJob(ctx, authsqlc.CheckpointIngestJobParams{
+		ID:            id,
+		RecordsDone:   progress.RecordsDone,
+		RecordsFailed: progress.RecordsFailed,
+		RecordErrors:  progress.RecordErrors,
+	})
+}
+
+// FinishJob marks a running job as succeeded and drops its payload
+func (s *IngestJobsStore) FinishJob(ctx context.Context, id uuid.UUID, progress IngestProgress, expiresAt time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	q := authsqlc.New(tx)
+
+	if err := q.FinishIngestJob(ctx, authsqlc.FinishIngestJobParams{
+		ID:            id,
+		RecordsDone:   progress.RecordsDone,
+		RecordsFailed: progress.RecordsFailed,
+		RecordErrors:  progress.RecordErrors,
+		ExpiresAt:     expiresAt,
+	}); err != nil {
+		return err
+	}
+	if err := q.DeleteIngestJobPayload(ctx, id); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// FailJob marks a running job as failed. The payload is kept so that the
+// client can retry the job.
+func (s *IngestJobsStore) FailJob(ctx context.Context, id uuid.UUID, msg string, expiresAt time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).FailIngestJob(ctx, authsqlc.FailIngestJobParams{
+		ID:        id,
+		Error:     utils.NullString(msg),
+		ExpiresAt: expiresAt,
+	})
+}
+
+// RetryJob puts a running job back in the queue after a transient failure,
+// to be attempted again at next
+func (s *IngestJobsStore) RetryJob(ctx context.Context, id uuid.UUID, msg string, next time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).RetryIngestJob(ctx, authsqlc.RetryIngestJobParams{
+		ID:            id,
+		Error:         utils.NullString(msg),
+		NextAttemptAt: next,
+	})
+}
+
+// RequeueJob puts a running job back in the queue without counting the
+// attempt, e.g. on shutdown
+func (s *IngestJobsStore) RequeueJob(ctx context.Context, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).RequeueIngestJob(ctx, id)
+}
+
+// RequeueStaleJobs puts running jobs without a heartbeat since before back
+// in the queue; their worker is assumed dead
+func (s *IngestJobsStore) RequeueStaleJobs(ctx context.Context, before time.Time) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).RequeueStaleIngestJobs(ctx, sql.NullTime{Time: before, Valid: true})
+}
+
+// DeleteExpiredJobs deletes finished jobs past their expiry together with
+// their payloads
+func (s *IngestJobsStore) DeleteExpiredJobs(ctx context.Context) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.BulkQueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).DeleteExpiredIngestJobs(ctx)
+}
@@ -3,26 +3,131 @@ package auth
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	authsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/auth"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/google/uuid"
 )
 
+type ExportJobs interface {
+	CreateJob(ctx context.Context, arg authsqlc.CreateExportJobParams) (authsqlc.ExportJob, error)
+	GetJob(ctx context.Context, id uuid.UUID, clientName string) (authsqlc.ExportJob, error)
+	ListJobs(ctx context.Context, clientName string, page utils.Page) ([]authsqlc.ExportJob, error)
+	CancelJob(ctx context.Context, id uuid.UUID, clientName string, expiresAt time.Time) (authsqlc.ExportJob, error)
+	ClaimJob(ctx context.Context, worker string) (authsqlc.ExportJob, error)
+	TouchJob(ctx context.Context, id uuid.UUID, rows, size int64) (string, error)
+	FinishJob(ctx context.Context, id uuid.UUID, rows, size int64, expiresAt time.Time) (bool, error)
+	FailJob(ctx context.Context, id uuid.UUID, msg string, expiresAt time.Time) error
+	RequeueJob(ctx context.Context, id uuid.UUID) error
+	RequeueStaleJobs(ctx context.Context, before time.Time) (int64, error)
+	DeleteExpiredJobs(ctx context.Context) (int64, error)
+	InsertChunk(ctx context.Context, id uuid.UUID, seq int32, data []byte) error
+	GetChunk(ctx context.Context, id uuid.UUID, seq int32) ([]byte, error)
+	DeleteChunks(ctx context.Context, id uuid.UUID) error
+}
+
+type IngestJobs interface {
+	CreateJob(ctx context.Context, arg authsqlc.CreateIngestJobParams, payload []byte) (authsqlc.IngestJob, error)
+	GetJob(ctx context.Context, id uuid.UUID, clientName string) (authsqlc.IngestJob, error)
+	ListJobs(ctx context.Context, clientName string, page utils.Page) ([]authsqlc.IngestJob, error)
+	ResubmitJob(ctx context.Context, id uuid.UUID, clientName string, expiresAt time.Time) (authsqlc.IngestJob, error)
+	ClaimJob(ctx context.Context, worker string) (authsqlc.IngestJob, error)
+	ClientRoles(ctx context.Context, clientName string) ([]string, error)
+	GetPayload(ctx context.Context, id uuid.UUID) ([]byte, error)
+	TouchJob(ctx context.Context, id uuid.UUID) error
+	CheckpointJob(ctx context.Context, id uuid.UUID, progress IngestProgress) error
+	FinishJob(ctx context.Context, id uuid.UUID, progress IngestProgress, expiresAt time.Time) error
+	FailJob(ctx context.Context, id uuid.UUID, msg string, expiresAt time.Time) error
+	RetryJob(ctx context.Context, id uuid.UUID, msg string, next time.Time) error
+	RequeueJob(ctx context.Context, id uuid.UUID) error
+	RequeueStaleJobs(ctx context.Context, before time.Time) (int64, error)
+	DeleteExpiredJobs(ctx context.Context) (int64, error)
+}
+
+type IdempotencyKeys interface {
+	ReserveKey(ctx context.Context, key, fingerprint, token string, expiresAt time.Time) (bool, error)
+	GetKey(ctx context.Context, key string) (authsqlc.IdempotencyKey, error)
+	CompleteKey(ctx context.Context, arg authsqlc.CompleteIdempotencyKeyParams) (bool, error)
+	DeleteKey(ctx context.Context, key, token string) (bool, error)
+	DeleteExpiredKeys(ctx context.Context) (int64, error)
+}
+
+type Webhooks interface {
+	PublishEvent(ctx context.Context, arg authsqlc.PublishOutboxEventParams) (authsqlc.PublishOutboxEventRow, error)
+	DeleteExpiredEvents(ctx context.Context, before time.Time) (int64, error)
+	ListEventsAfter(ctx context.Context, afterID, throughID int64, limit int32) ([]authsqlc.OutboxEvent, error)
+	EventBounds(ctx context.Context) (authsqlc.GetOutboxEventBoundsRow, error)
+	EventHorizon(ctx context.Context) (authsqlc.GetOutboxHorizonRow, error)
+	CreateSubscription(ctx context.Context, arg authsqlc.CreateWebhookSubscriptionParams) (authsqlc.WebhookSubscription, error)
+	GetSubscription(ctx context.Context, id uuid.UUID, clientName string) (authsqlc.WebhookSubscription, error)
+	ListSubscriptions(ctx context.Context, clientName string, page utils.Page) ([]authsqlc.WebhookSubscription, error)
+	UpdateSubscription(ctx context.Context, arg authsqlc.UpdateWebhookSubscriptionParams) (authsqlc.WebhookSubscription, error)
+	RotateSecret(ctx context.Context, id uuid.UUID, clientName, secret string) (authsqlc.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, id uuid.UUID, clientName string) (int64, error)
+	ClaimDeliveries(ctx context.Context, leaseUntil time.Time, limit int32) ([]authsqlc.ClaimWebhookDeliveriesRow, error)
+	SucceedDelivery(ctx context.Context, id int64, status int) error
+	RetryDelivery(ctx context.Context, id int64, status int, msg string, next time.Time) error
+	KillDelivery(ctx context.Context, id int64, status int, msg string) error
+	DisableSubscription(ctx context.Context, id uuid.UUID) error
+	ListDeliveries(ctx context.Context, subscriptionID uuid.UUID, status string, page utils.Page) ([]authsqlc.ListWebhookDeliveriesRow, error)
+	Redeliver(ctx context.Context, id int64, subscriptionID uuid.UUID) (int64, error)
+	RedeliverDead(ctx context.Context, subscriptionID uuid.UUID) (int64, error)
+}
+
+type CalendarFeeds interface {
+	CreateFeed(ctx context.Context, clientName string) (authsqlc.CalendarFeed, string, error)
+	ListFeeds(ctx context.Context, clientName string) ([]authsqlc.CalendarFeed, error)
+	DeleteFeed(ctx context.Context, id uuid.UUID, clientName string) (int64, error)
+	UseFeed(ctx context.Context, token string) (authsqlc.UseCalendarFeedRow, error)
+}
+
 type AuthStorage struct {
-	db      *sql.DB
-	queries *authsqlc.Queries
+	db              *sql.DB
+	queries         *authsqlc.Queries
+	exportJobs      ExportJobs
+	ingestJobs      IngestJobs
+	idempotencyKeys IdempotencyKeys
+	webhooks        Webhooks
+	calendarFeeds   CalendarFeeds
 }
 
 func (a *AuthStorage) Queries() *authsqlc.Queries {
 	return a.queries
 }
 
+func (s *AuthStorage) ExportJobs() ExportJobs {
+	return s.exportJobs
+}
+
+func (s *AuthStorage) IngestJobs() IngestJobs {
+	return s.ingestJobs
+}
+
+func (s *AuthStorage) IdempotencyKeys() IdempotencyKeys {
+	return s.idempotencyKeys
+}
+
+func (s *AuthStorage) Webhooks() Webhooks {
+	return s.webhooks
+}
+
+func (s *AuthStorage) CalendarFeeds() CalendarFeeds {
+	return s.calendarFeeds
+}
+
 func (s *AuthStorage) Ping(ctx context.Context) error {
 	return s.db.PingContext(ctx)
 }
 
 func NewAuthStorage(db *sql.DB) *AuthStorage {
 	return &AuthStorage{
-		db:      db,
-		queries: authsqlc.New(db),
+		db:              db,
+		queries:         authsqlc.New(db),
+		exportJobs:      &ExportJobsStore{db: db},
+		ingestJobs:      &IngestJobsStore{db: db},
+		idempotencyKeys: &IdempotencyKeysStore{db: db},
+		webhooks:        &WebhooksStore{db: db},
+		calendarFeeds:   &CalendarFeedsStore{db: db},
 	}
 }
@@ -0,0 +1,239 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	authsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/auth"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/google/uuid"
+)
+
+// Webhook delivery statuses
+const (
+	DeliveryPending   = "pending"
+	DeliverySucceeded = "succeeded"
+	DeliveryDead      = "dead"
+)
+
+type WebhooksStore struct {
+	db *sql.DB
+}
+
+// PublishEvent appends an event to the outbox and queues a delivery for
+// every active subscription whose filters match it. The transaction ID is
+// assigned before the event ID is drawn, so a transaction that may still
+// commit an event is always among the open ones of EventHorizon.
+func (s *WebhooksStore) PublishEvent(ctx context.Context, arg authsqlc.PublishOutboxEventParams) (authsqlc.PublishOutboxEventRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).PublishOutboxEvent(ctx, arg)
+}
+
+// DeleteExpiredEvents deletes the events published before the given time
+// together with their deliveries
+func (s *WebhooksStore) DeleteExpiredEvents(ctx context.Context, before time.Time) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.BulkQueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).DeleteExpiredOutboxEvents(ctx, before)
+}
+
+// ListEventsAfter lists up to limit events with an ID above afterID and
+// at most throughID, oldest first
+func (s *WebhooksStore) ListEventsAfter(ctx context.Context, afterID, throughID int64, limit int32) ([]authsqlc.OutboxEvent, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).ListOutboxEventsAfter(ctx, authsqlc.ListOutboxEventsAfterParams{
+		AfterID:    afterID,
+		ThroughID:  throughID,
+		BatchLimit: limit,
+	})
+}
+
+// EventBounds returns the IDs of the oldest and latest events still in the
+// outbox, both 0 if it is empty
+func (s *WebhooksStore) EventBounds(ctx context.Context) (authsqlc.GetOutboxEventBoundsRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).GetOutboxEventBounds(ctx)
+}
+
+// EventHorizon returns the latest event ID together with the transactions
+// that were still in progress when it was read
+func (s *WebhooksStore) EventHorizon(ctx context.Context) (authsqlc.GetOutboxHorizonRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).GetOutboxHorizon(ctx)
+}
+
+func (s *WebhooksStore) CreateSubscription(ctx context.Context, arg authsqlc.CreateWebhookSubscriptionParams) (authsqlc.WebhookSubscription, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).CreateWebhookSubscription(ctx, arg)
+}
+
+func (s *WebhooksStore) GetSubscription(ctx context.Context, id uuid.UUID, clientName string) (authsqlc.WebhookSubscription, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).GetWebhookSubscription(ctx, authsqlc.GetWebhookSubscriptionParams{
+		ID:         id,
+		ClientName: clientName,
+	})
+}
+
+func (s *WebhooksStore) ListSubscriptions(ctx context.Context, clientName string, page utils.Page) ([]authsqlc.WebhookSubscription, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).ListWebhookSubscriptionsByClient(ctx, authsqlc.ListWebhookSubscriptionsByClientParams{
+		ClientName: clientName,
+		AfterTime:  page.AfterTime(),
+		AfterID:    page.AfterID(),
+		PageLimit:  page.FetchLimit(),
+	})
+}
+
+// UpdateSubscription replaces the URL, filters and state of a subscription
+// of the client. It returns sql.ErrNoRows if there is no such subscription.
+func (s *WebhooksStore) UpdateSubscription(ctx context.Context, arg authsqlc.UpdateWebhookSubscriptionParams) (authsqlc.WebhookSubscription, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).UpdateWebhookSubscription(ctx, arg)
+}
+
+func (s *WebhooksStore) RotateSecret(ctx context.Context, id uuid.UUID, clientName, secret string) (authsqlc.WebhookSubscription, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).RotateWebhookSecret(ctx, authsqlc.RotateWebhookSecretParams{
+		ID:         id,
+		ClientName: clientName,
+		Secret:     secret,
+	})
+}
+
+// DeleteSubscription deletes a subscription of the client with its
+// deliveries
+func (s *WebhooksStore) DeleteSubscription(ctx context.Context, id uuid.UUID, clientName string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).DeleteWebhookSubscription(ctx, authsqlc.DeleteWebhookSubscriptionParams{
+		ID:         id,
+		ClientName: clientName,
+	})
+}
+
+// ClaimDeliveries takes up to limit pending deliveries that are due and
+// hides them from other workers until leaseUntil, so that a delivery whose
+// worker died is attempted again once the lease runs out
+func (s *WebhooksStore) ClaimDeliveries(ctx context.Context, leaseUntil time.Time, limit int32) ([]authsqlc.ClaimWebhookDeliveriesRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).ClaimWebhookDeliveries(ctx, authsqlc.ClaimWebhookDeliveriesParams{
+		LeaseUntil: leaseUntil,
+		BatchLimit: limit,
+	})
+}
+
+// SucceedDelivery marks a delivery acknowledged by the subscriber with the
+// given response status
+func (s *WebhooksStore) SucceedDelivery(ctx context.Context, id int64, status int) error {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).SucceedWebhookDelivery(ctx, authsqlc.SucceedWebhookDeliveryParams{
+		ID:             id,
+		ResponseStatus: responseStatus(status),
+	})
+}
+
+// RetryDelivery schedules the next attempt of a failed delivery. status is
+// 0 if no response was received.
+func (s *WebhooksStore) RetryDelivery(ctx context.Context, id int64, status int, msg string, next time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).RetryWebhookDelivery(ctx, authsqlc.RetryWebhookDeliveryParams{
+		ID:             id,
+		ResponseStatus: responseStatus(status),
+		Error:          utils.NullString(msg),
+		NextAttemptAt:  next,
+	})
+}
+
+// DisableSubscription deactivates a subscription whose client can no
+// longer read one of its domains
+func (s *WebhooksStore) DisableSubscription(ctx context.Context, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).DisableWebhookSubscription(ctx, id)
+}
+
+// KillDelivery moves a delivery that ran out of attempts to the dead
+// letters
+func (s *WebhooksStore) KillDelivery(ctx context.Context, id int64, status int, msg string) error {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).KillWebhookDelivery(ctx, authsqlc.KillWebhookDeliveryParams{
+		ID:             id,
+		ResponseStatus: responseStatus(status),
+		Error:          utils.NullString(msg),
+	})
+}
+
+// ListDeliveries lists the deliveries of a subscription, newest first,
+// optionally only those with the given status
+func (s *WebhooksStore) ListDeliveries(ctx context.Context, subscriptionID uuid.UUID, status string, page utils.Page) ([]authsqlc.ListWebhookDeliveriesRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	arg := authsqlc.ListWebhookDeliveriesParams{
+		SubscriptionID: subscriptionID,
+		AfterN:         page.AfterN(),
+		PageLimit:      page.FetchLimit(),
+	}
+	if status != "" {
+		arg.Status = utils.NullString(status)
+	}
+	return authsqlc.New(s.db).ListWebhookDeliveries(ctx, arg)
+}
+
+// Redeliver queues a dead delivery of a subscription again with a fresh
+// set of attempts
+func (s *WebhooksStore) Redeliver(ctx context.Context, id int64, subscriptionID uuid.UUID) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).RedeliverWebhookDelivery(ctx, authsqlc.RedeliverWebhookDeliveryParams{
+		ID:             id,
+		SubscriptionID: subscriptionID,
+	})
+}
+
+// RedeliverDead queues all dead deliveries of a subscription again
+func (s *WebhooksStore) RedeliverDead(ctx context.Context, subscriptionID uuid.UUID) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return authsqlc.New(s.db).RedeliverDeadWebhookDeliveries(ctx, subscriptionID)
+}
+
+func responseStatus(status int) sql.NullInt32 {
+	if status == 0 {
+		return sql.NullInt32{}
+	}
+	return utils.NullInt32(int32(status))
+}
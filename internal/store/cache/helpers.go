@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
 )
 
 func SetCacheJSON(ctx context.Context, cache *Storage, key string, value any, ttl time.Duration) {
@@ -16,3 +18,16 @@ func SetCacheJSON(ctx context.Context, cache *Storage, key string, value any, tt
 	}
 	_ = cache.Set(ctx, key, string(data), ttl)
 }
+
+// SetCacheStream caches the body of a streamed JSON response if it was
+// small enough to be kept; NDJSON responses are never cached
+func SetCacheStream(ctx context.Context, cache *Storage, key string, sw *utils.StreamWriter, ttl time.Duration) {
+	if cache == nil {
+		return
+	}
+	body, ok := sw.CachedBody()
+	if !ok {
+		return
+	}
+	_ = cache.Set(ctx, key, body, ttl)
+}
@@ -7,6 +7,24 @@ import (
 	"github.com/redis/go-redis/v9"
 )
 
+// compareAndSetScript sets KEYS[1] to ARGV[2] for ARGV[3] milliseconds if
+// it still holds ARGV[1]
+var compareAndSetScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	redis.call("SET", KEYS[1], ARGV[2], "PX", ARGV[3])
+	return 1
+end
+return 0
+`)
+
+// compareAndDeleteScript deletes KEYS[1] if it still holds ARGV[1]
+var compareAndDeleteScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
 type Storage struct {
 	client *redis.Client
 }
@@ -23,6 +41,25 @@ func (s *Storage) Set(ctx context.Context, key string, value string, ttl time.Du
 	return s.client.Set(ctx, key, value, ttl).Err()
 }
 
+// SetNX sets key only if it does not exist yet and reports whether it did
+func (s *Storage) SetNX(ctx context.Context, key string, value string, ttl time.Duration) (bool, error) {
+	return s.client.SetNX(ctx, key, value, ttl).Result()
+}
+
+// CompareAndSet replaces the value of key only if it still is old and
+// reports whether it did
+func (s *Storage) CompareAndSet(ctx context.Context, key, old, value string, ttl time.Duration) (bool, error) {
+	n, err := compareAndSetScript.Run(ctx, s.client, []string{key}, old, value, ttl.Milliseconds()).Int()
+	return n == 1, err
+}
+
+// CompareAndDelete deletes key only if its value still is old and reports
+// whether it did
+func (s *Storage) CompareAndDelete(ctx context.Context, key, old string) (bool, error) {
+	n, err := compareAndDeleteScript.Run(ctx, s.client, []string{key}, old).Int()
+	return n == 1, err
+}
+
 func (s *Storage) Delete(ctx context.Context, key string) error {
 	return s.client.Del(ctx, key).Err()
 }
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 
 	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
 )
 
@@ -38,7 +39,12 @@ func (s *AthleteStore) InsertAthlete(ctx context.Context, in InsertAthleteClean)
 	defer cancel()
 
 	q := fissqlc.New(s.db)
-	return q.InsertAthlete(ctx, mapInsertAthleteToParams(in))
+	if err := q.InsertAthlete(ctx, mapInsertAthleteToParams(in)); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, events.New("fis", "athletes", events.Insert, 1, in.Fiscode))
+	return nil
 }
 
 func (s *AthleteStore) UpdateAthleteByFiscode(ctx context.Context, in UpdateAthleteClean) error {
@@ -46,8 +52,12 @@ func (s *AthleteStore) UpdateAthleteByFiscode(ctx context.Context, in UpdateAthl
 	defer cancel()
 
 	q := fissqlc.New(s.db)
-	_, err := q.UpdateAthleteByFiscode(ctx, mapUpdateAthleteToParams(in))
-	return err
+	if _, err := q.UpdateAthleteByFiscode(ctx, mapUpdateAthleteToParams(in)); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, events.New("fis", "athletes", events.Update, 1, in.Fiscode))
+	return nil
 }
 
 func (s *AthleteStore) DeleteAthleteByFiscode(ctx context.Context, fiscode int32) error {
@@ -55,6 +65,10 @@ func (s *AthleteStore) DeleteAthleteByFiscode(ctx context.Context, fiscode int32
 	defer cancel()
 
 	q := fissqlc.New(s.db)
-	_, err := q.DeleteAthleteByFiscode(ctx, fiscode)
-	return err
+	if _, err := q.DeleteAthleteByFiscode(ctx, fiscode); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, events.New("fis", "athletes", events.Delete, 1, fiscode))
+	return nil
 }
@@ -0,0 +1,202 @@
+package fis
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strconv"
+
+	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+// Athlete link candidate statuses
+const (
+	LinkPending  = "pending"
+	LinkApproved = "approved"
+	LinkRejected = "rejected"
+)
+
+// LinkCandidate is a proposed fiscode <-> sportti_id link. Evidence is
+// stored as is and returned by the review API.
+type LinkCandidate struct {
+	Fiscode    int32
+	Sporttiid  int32
+	Confidence float64
+	Sources    []string
+	Evidence   json.RawMessage
+}
+
+// LinkCandidateQuery filters the candidates of the review API; an empty
+// Status matches every status
+type LinkCandidateQuery struct {
+	Status        string
+	Fiscode       *int32
+	Sporttiid     *int32
+	MinConfidence float64
+}
+
+type AthleteLinksStore struct {
+	db *sql.DB
+}
+
+// ListUnlinkedFinnishCompetitors returns the latest row of every Finnish
+// competitor whose fiscode is not in athlete yet
+func (s *AthleteLinksStore) ListUnlinkedFinnishCompetitors(ctx context.Context) ([]fissqlc.ListUnlinkedFinnishCompetitorsRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.BulkQueryTimeout)
+	defer cancel()
+
+	q := fissqlc.New(s.db)
+	return q.ListUnlinkedFinnishCompetitors(ctx)
+}
+
+// ListLinkedSporttiIDs returns the sportti_ids that are in athlete already
+func (s *AthleteLinksStore) ListLinkedSporttiIDs(ctx context.Context) ([]int32, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	q := fissqlc.New(s.db)
+	return q.ListLinkedSporttiIDs(ctx)
+}
+
+// ProposeCandidates saves the candidates of a matching run. Pending
+// candidates the run did not find again, or whose fiscode or sportti_id has
+// been linked since, are dropped; reviewed candidates are left as they are.
+// It returns the number of candidates created or refreshed.
+func (s *AthleteLinksStore) ProposeCandidates(ctx context.Context, candidates []LinkCandidate) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.BulkQueryTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	q := fissqlc.New(tx)
+
+	if _, err := q.DeleteLinkedAthleteLinkCandidates(ctx); err != nil {
+		return 0, err
+	}
+
+	stale := fissqlc.DeleteStaleAthleteLinkCandidatesParams{
+		Fiscodes:   make([]int32, 0, len(candidates)),
+		Sporttiids: make([]int32, 0, len(candidates)),
+	}
+	for _, c := range candidates {
+		stale.Fiscodes = append(stale.Fiscodes, c.Fiscode)
+		stale.Sporttiids = append(stale.Sporttiids, c.Sporttiid)
+	}
+	if _, err := q.DeleteStaleAthleteLinkCandidates(ctx, stale); err != nil {
+		return 0, err
+	}
+
+	var written int64
+	for _, c := range candidates {
+		n, err := q.UpsertAthleteLinkCandidate(ctx, fissqlc.UpsertAthleteLinkCandidateParams{
+			Fiscode:    c.Fiscode,
+			Sporttiid:  c.Sporttiid,
+			Confidence: strconv.FormatFloat(c.Confidence, 'f', 3, 64),
+			Sources:    c.Sources,
+			Evidence:   c.Evidence,
+		})
+		if err != nil {
+			return 0, err
+		}
+		written += n
+	}
+
+	return written, tx.Commit()
+}
+
+func (s *AthleteLinksStore) ListCandidates(ctx context.Context, in LinkCandidateQuery, page utils.Page) ([]fissqlc.FisAthleteLinkCandidate, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	q := fissqlc.New(s.db)
+
+	after := page.AfterN()
+	return q.ListAthleteLinkCandidates(ctx, fissqlc.ListAthleteLinkCandidatesParams{
+		Status:          in.Status,
+		Fiscode:         utils.NullInt32Ptr(in.Fiscode),
+		Sporttiid:       utils.NullInt32Ptr(in.Sporttiid),
+		MinConfidence:   strconv.FormatFloat(in.MinConfidence, 'f', 3, 64),
+		AfterConfidence: page.AfterKey(),
+		AfterID:         sql.NullInt32{Int32: int32(after.Int64), Valid: after.Valid},
+		PageLimit:       page.FetchLimit(),
+	})
+}
+
+func (s *AthleteLinksStore) GetCandidate(ctx context.Context, id int32) (fissqlc.FisAthleteLinkCandidate, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	q := fissqlc.New(s.db)
+	return q.GetAthleteLinkCandidate(ctx, id)
+}
+
+// ReviewCandidate approves or rejects a pending candidate, or returns
+// sql.ErrNoRows if there is no pending candidate id. Approving it inserts
+// the link into athlete, named after the FIS competitor, and rejects the
+// other pending candidates of the fiscode and of the sportti_id.
+func (s *AthleteLinksStore) ReviewCandidate(ctx context.Context, id int32, approve bool, reviewer string) (fissqlc.FisAthleteLinkCandidate, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	status := LinkRejected
+	if approve {
+		status = LinkApproved
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fissqlc.FisAthleteLinkCandidate{}, err
+	}
+	defer tx.Rollback()
+
+	q := fissqlc.New(tx)
+
+	c, err := q.ReviewAthleteLinkCandidate(ctx, fissqlc.ReviewAthleteLinkCandidateParams{
+		Status:     status,
+		ReviewedBy: reviewer,
+		ID:         id,
+	})
+	if err != nil {
+		return c, err
+	}
+	if !approve {
+		return c, tx.Commit()
+	}
+
+	n, err := q.InsertAthleteFromCompetitor(ctx, fissqlc.InsertAthleteFromCompetitorParams{
+		Sporttiid: c.Sporttiid,
+		Fiscode:   c.Fiscode,
+	})
+	if err != nil {
+		return c, err
+	}
+	if n == 0 {
+		// the competitor has been deleted since the match; link without names
+		if err := q.InsertAthlete(ctx, fissqlc.InsertAthleteParams{
+			Fiscode:   c.Fiscode,
+			Sporttiid: sql.NullInt32{Int32: c.Sporttiid, Valid: true},
+		}); err != nil {
+			return c, err
+		}
+	}
+	if _, err := q.RejectCompetingAthleteLinkCandidates(ctx, fissqlc.RejectCompetingAthleteLinkCandidatesParams{
+		ReviewedBy: reviewer,
+		Fiscode:    c.Fiscode,
+		Sporttiid:  c.Sporttiid,
+		ID:         c.ID,
+	}); err != nil {
+		return c, err
+	}
+	if err := tx.Commit(); err != nil {
+		return c, err
+	}
+
+	events.Emit(ctx, events.New("fis", "athletes", events.Insert, 1, c.Fiscode))
+	return c, nil
+}
@@ -0,0 +1,42 @@
+package fis
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+// CalendarQuery selects the races of the calendar; empty filters match
+// every race
+type CalendarQuery struct {
+	From     time.Time
+	To       time.Time
+	Sectors  []string
+	Nations  []string
+	Catcodes []string
+	Genders  []string
+}
+
+type CalendarStore struct {
+	db *sql.DB
+}
+
+// GetCalendarRaces returns the races of every sector dated From..To,
+// ordered by race date and event
+func (s *CalendarStore) GetCalendarRaces(ctx context.Context, in CalendarQuery) ([]fissqlc.GetCalendarRacesRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	q := fissqlc.New(s.db)
+	return q.GetCalendarRaces(ctx, fissqlc.GetCalendarRacesParams{
+		DateFrom: in.From,
+		DateTo:   in.To,
+		Sectors:  in.Sectors,
+		Nations:  in.Nations,
+		Cats:     in.Catcodes,
+		Genders:  in.Genders,
+	})
+}
@@ -0,0 +1,161 @@
+package fis
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+// Operations of a change
+const (
+	ChangeUpsert = "upsert"
+	ChangeDelete = "delete"
+)
+
+// ChangeEntities are the tables whose changes can be listed, named like
+// their routes and the entities of fis_writes and fis_tombstones
+var ChangeEntities = []string{"competitor", "racecc", "racejp", "racenk", "resultcc", "resultjp", "resultnk"}
+
+// Change is a row written or deleted since a watermark. ChangedAt is the
+// time the database recorded the write or the deletion, both on its own
+// clock, so that one watermark covers both. Row holds the sqlc row of a
+// written row and is nil for deletions.
+type Change struct {
+	Op        string
+	ID        int32
+	ChangedAt time.Time
+	Row       any
+}
+
+type ChangesStore struct {
+	db *sql.DB
+}
+
+// ListChanges returns the changes of entity from since on, ordered by
+// (ChangedAt, ID), a write before a deletion of the same key
+func (s *ChangesStore) ListChanges(ctx context.Context, entity string, since time.Time, page utils.Page) ([]Change, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.BulkQueryTimeout)
+	defer cancel()
+
+	q := fissqlc.New(s.db)
+	after := page.AfterN()
+	afterID := sql.NullInt32{Int32: int32(after.Int64), Valid: after.Valid}
+
+	written, err := listWritten(ctx, q, entity, since, page.AfterTime(), afterID, page.FetchLimit())
+	if err != nil {
+		return nil, err
+	}
+
+	tombstones, err := q.ListTombstones(ctx, fissqlc.ListTombstonesParams{
+		Entity:      entity,
+		Since:       since,
+		AfterTime:   page.AfterTime(),
+		AfterID:     afterID,
+		AfterDelete: page.AfterKey().String == ChangeDelete,
+		PageLimit:   page.FetchLimit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	deleted := make([]Change, len(tombstones))
+	for i, t := range tombstones {
+		deleted[i] = Change{Op: ChangeDelete, ID: t.ID, ChangedAt: t.DeletedAt}
+	}
+
+	return mergeChanges(written, deleted, int(page.FetchLimit())), nil
+}
+
+// Horizon returns the start of the oldest transaction in progress, or now
+// if there is none. Writes and deletions are stamped with the start of their
+// transaction, so a transaction still in progress can commit changes dated
+// before the changes already listed, but not before the horizon.
+func (s *ChangesStore) Horizon(ctx context.Context) (time.Time, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	return fissqlc.New(s.db).GetChangesHorizon(ctx)
+}
+
+// listWritten lists the rows of entity written from since on, after the
+// cursor position
+func listWritten(ctx context.Context, q *fissqlc.Queries, entity string, since time.Time, afterTime sql.NullTime, afterID sql.NullInt32, limit int32) ([]Change, error) {
+	switch entity {
+	case "competitor":
+		rows, err := q.ListCompetitorsChanges(ctx, fissqlc.ListCompetitorsChangesParams{Since: since, AfterTime: afterTime, AfterID: afterID, PageLimit: limit})
+		return writtenChanges(rows, err, func(r fissqlc.ListCompetitorsChangesRow) (int32, time.Time, any) {
+			return r.ACompetitor.Competitorid, r.WrittenAt, r.ACompetitor
+		})
+	case "racecc":
+		rows, err := q.ListRacesCCChanges(ctx, fissqlc.ListRacesCCChangesParams{Since: since, AfterTime: afterTime, AfterID: afterID, PageLimit: limit})
+		return writtenChanges(rows, err, func(r fissqlc.ListRacesCCChangesRow) (int32, time.Time, any) {
+			return r.ARacecc.Raceid, r.WrittenAt, r.ARacecc
+		})
+	case "racejp":
+		rows, err := q.ListRacesJPChanges(ctx, fissqlc.ListRacesJPChangesParams{Since: since, AfterTime: afterTime, AfterID: afterID, PageLimit: limit})
+		return writtenChanges(rows, err, func(r fissqlc.ListRacesJPChangesRow) (int32, time.Time, any) {
+			return r.ARacejp.Raceid, r.WrittenAt, r.ARacejp
+		})
+	case "racenk":
+		rows, err := q.ListRacesNKChanges(ctx, fissqlc.ListRacesNKChangesParams{Since: since, AfterTime: afterTime, AfterID: afterID, PageLimit: limit})
+		return writtenChanges(rows, err, func(r fissqlc.ListRacesNKChangesRow) (int32, time.Time, any) {
+			return r.ARacenk.Raceid, r.WrittenAt, r.ARacenk
+		})
+	case "resultcc":
+		rows, err := q.ListResultsCCChanges(ctx, fissqlc.ListResultsCCChangesParams{Since: since, AfterTime: afterTime, AfterID: afterID, PageLimit: limit})
+		return writtenChanges(rows, err, func(r fissqlc.ListResultsCCChangesRow) (int32, time.Time, any) {
+			return r.AResultcc.Recid, r.WrittenAt, r.AResultcc
+		})
+	case "resultjp":
+		rows, err := q.ListResultsJPChanges(ctx, fissqlc.ListResultsJPChangesParams{Since: since, AfterTime: afterTime, AfterID: afterID, PageLimit: limit})
+		return writtenChanges(rows, err, func(r fissqlc.ListResultsJPChangesRow) (int32, time.Time, any) {
+			return r.AResultjp.Recid, r.WrittenAt, r.AResultjp
+		})
+	case "resultnk":
+		rows, err := q.ListResultsNKChanges(ctx, fissqlc.ListResultsNKChangesParams{Since: since, AfterTime: afterTime, AfterID: afterID, PageLimit: limit})
+		return writtenChanges(rows, err, func(r fissqlc.ListResultsNKChangesRow) (int32, time.Time, any) {
+			return r.AResultnk.Recid, r.WrittenAt, r.AResultnk
+		})
+	}
+	return nil, fmt.Errorf("unknown FIS entity %q", entity)
+}
+
+func writtenChanges[T any](rows []T, err error, changeOf func(T) (int32, time.Time, any)) ([]Change, error) {
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Change, len(rows))
+	for i, row := range rows {
+		id, writtenAt, data := changeOf(row)
+		out[i] = Change{Op: ChangeUpsert, ID: id, ChangedAt: writtenAt, Row: data}
+	}
+	return out, nil
+}
+
+// mergeChanges merges the written and deleted rows, each in change order,
+// and keeps the first limit
+func mergeChanges(written, deleted []Change, limit int) []Change {
+	out := make([]Change, 0, min(limit, len(written)+len(deleted)))
+	i, j := 0, 0
+	for len(out) < limit && (i < len(written) || j < len(deleted)) {
+		if j == len(deleted) || (i < len(written) && !changeAfter(written[i], deleted[j])) {
+			out = append(out, written[i])
+			i++
+		} else {
+			out = append(out, deleted[j])
+			j++
+		}
+	}
+	return out
+}
+
+// changeAfter reports whether a write a comes after a deletion d
+func changeAfter(a, d Change) bool {
+	if !a.ChangedAt.Equal(d.ChangedAt) {
+		return a.ChangedAt.After(d.ChangedAt)
+	}
+	return a.ID > d.ID
+}
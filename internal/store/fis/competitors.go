@@ -8,6 +8,7 @@ import (
 	"time"
 
 	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
 )
 
@@ -66,7 +67,25 @@ func (s *CompetitorsStore) InsertCompetitor(ctx context.Context, in InsertCompet
 	defer cancel()
 
 	q := fissqlc.New(s.db)
-	return q.InsertCompetitor(ctx, mapInsertToParams(in))
+	if err := q.InsertCompetitor(ctx, mapInsertToParams(in)); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, events.New("fis", "competitors", events.Insert, 1, in.Competitorid))
+	return nil
+}
+
+// SyncCompetitors upserts competitors in one transaction, skipping those
+// older than the stored rows, see syncRows
+func (s *CompetitorsStore) SyncCompetitors(ctx context.Context, rows []InsertCompetitorClean) (utils.UpsertCounts, error) {
+	params := make([]fissqlc.InsertCompetitorParams, len(rows))
+	athletes := make([]int32, len(rows))
+	for i, in := range rows {
+		params[i] = mapInsertToParams(in)
+		athletes[i] = in.Competitorid
+	}
+
+	return syncRows(ctx, s.db, "competitors", competitorsCopy, params, competitorCopyKey, competitorCopyValues, athletes)
 }
 
 func (s *CompetitorsStore) UpdateCompetitorByID(ctx context.Context, in UpdateCompetitorClean) error {
@@ -74,8 +93,12 @@ func (s *CompetitorsStore) UpdateCompetitorByID(ctx context.Context, in UpdateCo
 	defer cancel()
 
 	q := fissqlc.New(s.db)
-	_, err := q.UpdateCompetitorByID(ctx, mapUpdateToParams(in))
-	return err
+	if _, err := q.UpdateCompetitorByID(ctx, mapUpdateToParams(in)); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, events.New("fis", "competitors", events.Update, 1, in.Competitorid))
+	return nil
 }
 
 func (s *CompetitorsStore) DeleteCompetitorByID(ctx context.Context, competitorID int32) error {
@@ -83,8 +106,12 @@ func (s *CompetitorsStore) DeleteCompetitorByID(ctx context.Context, competitorI
 	defer cancel()
 
 	q := fissqlc.New(s.db)
-	_, err := q.DeleteCompetitorByID(ctx, competitorID)
-	return err
+	if _, err := q.DeleteCompetitorByID(ctx, competitorID); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, events.New("fis", "competitors", events.Delete, 1, competitorID))
+	return nil
 }
 
 func (s *CompetitorsStore) GetCompetitorIDByFiscodeCC(ctx context.Context, fiscode int32) (int32, error) {
@@ -111,43 +138,64 @@ func (s *CompetitorsStore) GetCompetitorIDByFiscodeNK(ctx context.Context, fisco
 	return q.GetCompetitorIDByFiscodeNK(ctx, sql.NullInt32{Int32: fiscode, Valid: true})
 }
 
+// SearchCompetitors filters the competitors and matches query against
+// their names and ski clubs, ignoring accents and tolerating typos. The
+// matches are ordered by relevance, then by competitorid; the cursor of a
+// page holds both. fiscodePrefix is searched as a range of text fiscodes,
+// which the prefix index serves even with a generic plan.
 func (s *CompetitorsStore) SearchCompetitors(
 	ctx context.Context,
 	nationcode, sectorcode, gender *string,
 	birthdateMin, birthdateMax *time.Time,
-) ([]fissqlc.ACompetitor, error) {
+	query, fiscodePrefix string,
+	page utils.Page,
+) ([]fissqlc.SearchCompetitorsRow, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
 	defer cancel()
 
 	q := fissqlc.New(s.db)
 
+	after := page.AfterN()
+	fiscodeFrom, fiscodeTo := fiscodeRange(fiscodePrefix)
 	params := fissqlc.SearchCompetitorsParams{
-		Column1: "",
-		Column2: "",
-		Column3: "",
-		Column4: time.Time{},
-		Column5: time.Time{},
+		Query:          query,
+		FiscodeFrom:    fiscodeFrom,
+		FiscodeTo:      fiscodeTo,
+		AfterRelevance: page.AfterKey(),
+		AfterID:        sql.NullInt32{Int32: int32(after.Int64), Valid: after.Valid},
+		PageLimit:      page.FetchLimit(),
 	}
 
 	if nationcode != nil {
-		params.Column1 = *nationcode
+		params.Nationcode = *nationcode
 	}
 	if sectorcode != nil {
-		params.Column2 = *sectorcode
+		params.Sectorcode = *sectorcode
 	}
 	if gender != nil {
-		params.Column3 = *gender
+		params.Gender = *gender
 	}
 	if birthdateMin != nil {
-		params.Column4 = *birthdateMin
+		params.BirthdateMin = *birthdateMin
 	}
 	if birthdateMax != nil {
-		params.Column5 = *birthdateMax
+		params.BirthdateMax = *birthdateMax
 	}
 
 	return q.SearchCompetitors(ctx, params)
 }
 
+// fiscodeRange returns the bounds [from, to) of the text fiscodes starting
+// with prefix, a string of digits. ':' follows '9', so the empty prefix
+// covers every fiscode.
+func fiscodeRange(prefix string) (string, string) {
+	if prefix == "" {
+		return "", ":"
+	}
+	last := len(prefix) - 1
+	return prefix, prefix[:last] + string(prefix[last]+1)
+}
+
 func (s *CompetitorsStore) GetCompetitorCountsByNation(
 	ctx context.Context,
 	sectorcode, gender *string,
@@ -0,0 +1,783 @@
+package fis
+
+import (
+	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+// Upsert loading of the FIS tables for the sync endpoints, see
+// utils.Upsert. Columns mirror the Insert queries in queries.sql and must
+// be kept in sync with them. A row only replaces the stored one if its
+// lastupdate is newer, or equally new with a newer version (a different
+// one for results, whose version is text); rows without a lastupdate never
+// replace a row that has one.
+
+var competitorsCopy = utils.CopyTable{
+	Table: "a_competitor",
+	Columns: []string{
+		"competitorid", "personid", "ipcid", "fiscode", "birthdate", "status_date",
+		"fee", "dateofcreation", "injury", "version", "compidmssql", "carving",
+		"photo", "notallowed", "published", "team", "photo_big", "lastupdate",
+		"statusnextlist", "alternatenamecheck", "deletedat", "doped", "createdby",
+		"categorycode", "classname", "data", "lastupdateby", "disciplines", "type",
+		"sectorcode", "classcode", "lastname", "firstname", "gender", "natteam",
+		"nationcode", "nationalcode", "skiclub", "association", "status", "status_old",
+		"status_by", "tragroup",
+	},
+	OnConflict: `ON CONFLICT (competitorid) DO UPDATE SET
+  personid           = EXCLUDED.personid,
+  ipcid              = EXCLUDED.ipcid,
+  fiscode            = EXCLUDED.fiscode,
+  birthdate          = EXCLUDED.birthdate,
+  status_date        = EXCLUDED.status_date,
+  fee                = EXCLUDED.fee,
+  dateofcreation     = EXCLUDED.dateofcreation,
+  injury             = EXCLUDED.injury,
+  version            = EXCLUDED.version,
+  compidmssql        = EXCLUDED.compidmssql,
+  carving            = EXCLUDED.carving,
+  photo              = EXCLUDED.photo,
+  notallowed         = EXCLUDED.notallowed,
+  published          = EXCLUDED.published,
+  team               = EXCLUDED.team,
+  photo_big          = EXCLUDED.photo_big,
+  lastupdate         = EXCLUDED.lastupdate,
+  statusnextlist     = EXCLUDED.statusnextlist,
+  alternatenamecheck = EXCLUDED.alternatenamecheck,
+  deletedat          = EXCLUDED.deletedat,
+  doped              = EXCLUDED.doped,
+  createdby          = EXCLUDED.createdby,
+  categorycode       = EXCLUDED.categorycode,
+  classname          = EXCLUDED.classname,
+  data               = EXCLUDED.data,
+  lastupdateby       = EXCLUDED.lastupdateby,
+  disciplines        = EXCLUDED.disciplines,
+  type               = EXCLUDED.type,
+  sectorcode         = EXCLUDED.sectorcode,
+  classcode          = EXCLUDED.classcode,
+  lastname           = EXCLUDED.lastname,
+  firstname          = EXCLUDED.firstname,
+  gender             = EXCLUDED.gender,
+  natteam            = EXCLUDED.natteam,
+  nationcode         = EXCLUDED.nationcode,
+  nationalcode       = EXCLUDED.nationalcode,
+  skiclub            = EXCLUDED.skiclub,
+  association        = EXCLUDED.association,
+  status             = EXCLUDED.status,
+  status_old         = EXCLUDED.status_old,
+  status_by          = EXCLUDED.status_by,
+  tragroup           = EXCLUDED.tragroup
+WHERE a_competitor.lastupdate IS NULL
+   OR EXCLUDED.lastupdate > a_competitor.lastupdate
+   OR (EXCLUDED.lastupdate = a_competitor.lastupdate AND EXCLUDED.version > a_competitor.version)`,
+}
+
+func competitorCopyKey(p fissqlc.InsertCompetitorParams) any {
+	return p.Competitorid
+}
+
+func competitorCopyValues(p fissqlc.InsertCompetitorParams) []any {
+	return []any{
+		p.Competitorid, p.Personid, p.Ipcid, p.Fiscode, p.Birthdate, p.StatusDate,
+		p.Fee, p.Dateofcreation, p.Injury, p.Version, p.Compidmssql, p.Carving,
+		p.Photo, p.Notallowed, p.Published, p.Team, p.PhotoBig, p.Lastupdate,
+		p.Statusnextlist, p.Alternatenamecheck, p.Deletedat, p.Doped, p.Createdby,
+		p.Categorycode, p.Classname, p.Data, p.Lastupdateby, p.Disciplines, p.Type,
+		p.Sectorcode, p.Classcode, p.Lastname, p.Firstname, p.Gender, p.Natteam,
+		p.Nationcode, p.Nationalcode, p.Skiclub, p.Association, p.Status, p.StatusOld,
+		p.StatusBy, p.Tragroup,
+	}
+}
+
+var racesCCCopy = utils.CopyTable{
+	Table: "a_racecc",
+	Columns: []string{
+		"raceid", "eventid", "seasoncode", "racecodex", "disciplineid",
+		"disciplinecode", "catcode", "catcode2", "catcode3", "catcode4", "gender",
+		"racedate", "starteventdate", "description", "place", "nationcode",
+		"receiveddate", "validdate", "td1id", "td1name", "td1nation", "td1code",
+		"td2id", "td2name", "td2nation", "td2code", "calstatuscode", "procstatuscode",
+		"displaystatus", "fisinterncomment", "webcomment", "pursuit", "masse", "relay",
+		"distance", "hill", "style", "qualif", "finale", "homol", "published",
+		"validforfispoints", "usedfislist", "tolist", "discforlistcode",
+		"calculatedpenalty", "appliedpenalty", "appliedscala", "penscafixed",
+		"version", "nationraceid", "provraceid", "msql7evid", "mssql7id", "topbanner",
+		"bottombanner", "toplogo", "bottomlogo", "gallery", "indi", "team", "tabcount",
+		"columncount", "level", "hloc1", "hloc2", "hloc3", "hcet1", "hcet2", "hcet3",
+		"live", "livestatus1", "livestatus2", "livestatus3", "liveinfo1", "liveinfo2",
+		"liveinfo3", "passwd", "timinglogo", "results", "pdf", "noepr", "tddoc",
+		"timingreport", "special_cup_points", "skip_wcsl", "validforowg", "lastupdate",
+	},
+	OnConflict: `ON CONFLICT (raceid) DO UPDATE SET
+  eventid            = EXCLUDED.eventid,
+  seasoncode         = EXCLUDED.seasoncode,
+  racecodex          = EXCLUDED.racecodex,
+  disciplineid       = EXCLUDED.disciplineid,
+  disciplinecode     = EXCLUDED.disciplinecode,
+  catcode            = EXCLUDED.catcode,
+  catcode2           = EXCLUDED.catcode2,
+  catcode3           = EXCLUDED.catcode3,
+  catcode4           = EXCLUDED.catcode4,
+  gender             = EXCLUDED.gender,
+  racedate           = EXCLUDED.racedate,
+  starteventdate     = EXCLUDED.starteventdate,
+  description        = EXCLUDED.description,
+  place              = EXCLUDED.place,
+  nationcode         = EXCLUDED.nationcode,
+  receiveddate       = EXCLUDED.receiveddate,
+  validdate          = EXCLUDED.validdate,
+  td1id              = EXCLUDED.td1id,
+  td1name            = EXCLUDED.td1name,
+  td1nation          = EXCLUDED.td1nation,
+  td1code            = EXCLUDED.td1code,
+  td2id              = EXCLUDED.td2id,
+  td2name            = EXCLUDED.td2name,
+  td2nation          = EXCLUDED.td2nation,
+  td2code            = EXCLUDED.td2code,
+  calstatuscode      = EXCLUDED.calstatuscode,
+  procstatuscode     = EXCLUDED.procstatuscode,
+  displaystatus      = EXCLUDED.displaystatus,
+  fisinterncomment   = EXCLUDED.fisinterncomment,
+  webcomment         = EXCLUDED.webcomment,
+  pursuit            = EXCLUDED.pursuit,
+  masse              = EXCLUDED.masse,
+  relay              = EXCLUDED.relay,
+  distance           = EXCLUDED.distance,
+  hill               = EXCLUDED.hill,
+  style              = EXCLUDED.style,
+  qualif             = EXCLUDED.qualif,
+  finale             = EXCLUDED.finale,
+  homol              = EXCLUDED.homol,
+  published          = EXCLUDED.published,
+  validforfispoints  = EXCLUDED.validforfispoints,
+  usedfislist        = EXCLUDED.usedfislist,
+  tolist             = EXCLUDED.tolist,
+  discforlistcode    = EXCLUDED.discforlistcode,
+  calculatedpenalty  = EXCLUDED.calculatedpenalty,
+  appliedpenalty     = EXCLUDED.appliedpenalty,
+  appliedscala       = EXCLUDED.appliedscala,
+  penscafixed        = EXCLUDED.penscafixed,
+  version            = EXCLUDED.version,
+  nationraceid       = EXCLUDED.nationraceid,
+  provraceid         = EXCLUDED.provraceid,
+  msql7evid          = EXCLUDED.msql7evid,
+  mssql7id           = EXCLUDED.mssql7id,
+  topbanner          = EXCLUDED.topbanner,
+  bottombanner       = EXCLUDED.bottombanner,
+  toplogo            = EXCLUDED.toplogo,
+  bottomlogo         = EXCLUDED.bottomlogo,
+  gallery            = EXCLUDED.gallery,
+  indi               = EXCLUDED.indi,
+  team               = EXCLUDED.team,
+  tabcount           = EXCLUDED.tabcount,
+  columncount        = EXCLUDED.columncount,
+  level              = EXCLUDED.level,
+  hloc1              = EXCLUDED.hloc1,
+  hloc2              = EXCLUDED.hloc2,
+  hloc3              = EXCLUDED.hloc3,
+  hcet1              = EXCLUDED.hcet1,
+  hcet2              = EXCLUDED.hcet2,
+  hcet3              = EXCLUDED.hcet3,
+  live               = EXCLUDED.live,
+  livestatus1        = EXCLUDED.livestatus1,
+  livestatus2        = EXCLUDED.livestatus2,
+  livestatus3        = EXCLUDED.livestatus3,
+  liveinfo1          = EXCLUDED.liveinfo1,
+  liveinfo2          = EXCLUDED.liveinfo2,
+  liveinfo3          = EXCLUDED.liveinfo3,
+  passwd             = EXCLUDED.passwd,
+  timinglogo         = EXCLUDED.timinglogo,
+  results            = EXCLUDED.results,
+  pdf                = EXCLUDED.pdf,
+  noepr              = EXCLUDED.noepr,
+  tddoc              = EXCLUDED.tddoc,
+  timingreport       = EXCLUDED.timingreport,
+  special_cup_points = EXCLUDED.special_cup_points,
+  skip_wcsl          = EXCLUDED.skip_wcsl,
+  validforowg        = EXCLUDED.validforowg,
+  lastupdate         = EXCLUDED.lastupdate
+WHERE a_racecc.lastupdate IS NULL
+   OR EXCLUDED.lastupdate > a_racecc.lastupdate
+   OR (EXCLUDED.lastupdate = a_racecc.lastupdate AND EXCLUDED.version > a_racecc.version)`,
+}
+
+func raceCCCopyKey(p fissqlc.InsertRaceCCParams) any {
+	return p.Raceid
+}
+
+func raceCCCopyValues(p fissqlc.InsertRaceCCParams) []any {
+	return []any{
+		p.Raceid, p.Eventid, p.Seasoncode, p.Racecodex, p.Disciplineid,
+		p.Disciplinecode, p.Catcode, p.Catcode2, p.Catcode3, p.Catcode4, p.Gender,
+		p.Racedate, p.Starteventdate, p.Description, p.Place, p.Nationcode,
+		p.Receiveddate, p.Validdate, p.Td1id, p.Td1name, p.Td1nation, p.Td1code,
+		p.Td2id, p.Td2name, p.Td2nation, p.Td2code, p.Calstatuscode, p.Procstatuscode,
+		p.Displaystatus, p.Fisinterncomment, p.Webcomment, p.Pursuit, p.Masse, p.Relay,
+		p.Distance, p.Hill, p.Style, p.Qualif, p.Finale, p.Homol, p.Published,
+		p.Validforfispoints, p.Usedfislist, p.Tolist, p.Discforlistcode,
+		p.Calculatedpenalty, p.Appliedpenalty, p.Appliedscala, p.Penscafixed,
+		p.Version, p.Nationraceid, p.Provraceid, p.Msql7evid, p.Mssql7id, p.Topbanner,
+		p.Bottombanner, p.Toplogo, p.Bottomlogo, p.Gallery, p.Indi, p.Team, p.Tabcount,
+		p.Columncount, p.Level, p.Hloc1, p.Hloc2, p.Hloc3, p.Hcet1, p.Hcet2, p.Hcet3,
+		p.Live, p.Livestatus1, p.Livestatus2, p.Livestatus3, p.Liveinfo1, p.Liveinfo2,
+		p.Liveinfo3, p.Passwd, p.Timinglogo, p.Results, p.Pdf, p.Noepr, p.Tddoc,
+		p.Timingreport, p.SpecialCupPoints, p.SkipWcsl, p.Validforowg, p.Lastupdate,
+	}
+}
+
+var racesJPCopy = utils.CopyTable{
+	Table: "a_racejp",
+	Columns: []string{
+		"raceid", "eventid", "seasoncode", "racecodex", "disciplineid",
+		"disciplinecode", "catcode", "catcode2", "catcode3", "catcode4", "gender",
+		"racedate", "starteventdate", "description", "place", "nationcode", "td1id",
+		"td1name", "td1nation", "td1code", "td2id", "td2name", "td2nation", "td2code",
+		"calstatuscode", "procstatuscode", "receiveddate", "pursuit", "masse", "relay",
+		"distance", "hill", "style", "qualif", "finale", "homol", "webcomment",
+		"displaystatus", "fisinterncomment", "published", "validforfispoints",
+		"usedfislist", "tolist", "discforlistcode", "calculatedpenalty",
+		"appliedpenalty", "appliedscala", "penscafixed", "version", "nationraceid",
+		"provraceid", "msql7evid", "mssql7id", "results", "pdf", "topbanner",
+		"bottombanner", "toplogo", "bottomlogo", "gallery", "indi", "team", "tabcount",
+		"columncount", "level", "hloc1", "hloc2", "hloc3", "hcet1", "hcet2", "hcet3",
+		"live", "livestatus1", "livestatus2", "livestatus3", "liveinfo1", "liveinfo2",
+		"liveinfo3", "passwd", "timinglogo", "validdate", "noepr", "tddoc",
+		"timingreport", "special_cup_points", "skip_wcsl", "lastupdate", "validforowg",
+	},
+	OnConflict: `ON CONFLICT (raceid) DO UPDATE SET
+  eventid            = EXCLUDED.eventid,
+  seasoncode         = EXCLUDED.seasoncode,
+  racecodex          = EXCLUDED.racecodex,
+  disciplineid       = EXCLUDED.disciplineid,
+  disciplinecode     = EXCLUDED.disciplinecode,
+  catcode            = EXCLUDED.catcode,
+  catcode2           = EXCLUDED.catcode2,
+  catcode3           = EXCLUDED.catcode3,
+  catcode4           = EXCLUDED.catcode4,
+  gender             = EXCLUDED.gender,
+  racedate           = EXCLUDED.racedate,
+  starteventdate     = EXCLUDED.starteventdate,
+  description        = EXCLUDED.description,
+  place              = EXCLUDED.place,
+  nationcode         = EXCLUDED.nationcode,
+  td1id              = EXCLUDED.td1id,
+  td1name            = EXCLUDED.td1name,
+  td1nation          = EXCLUDED.td1nation,
+  td1code            = EXCLUDED.td1code,
+  td2id              = EXCLUDED.td2id,
+  td2name            = EXCLUDED.td2name,
+  td2nation          = EXCLUDED.td2nation,
+  td2code            = EXCLUDED.td2code,
+  calstatuscode      = EXCLUDED.calstatuscode,
+  procstatuscode     = EXCLUDED.procstatuscode,
+  receiveddate       = EXCLUDED.receiveddate,
+  pursuit            = EXCLUDED.pursuit,
+  masse              = EXCLUDED.masse,
+  relay              = EXCLUDED.relay,
+  distance           = EXCLUDED.distance,
+  hill               = EXCLUDED.hill,
+  style              = EXCLUDED.style,
+  qualif             = EXCLUDED.qualif,
+  finale             = EXCLUDED.finale,
+  homol              = EXCLUDED.homol,
+  webcomment         = EXCLUDED.webcomment,
+  displaystatus      = EXCLUDED.displaystatus,
+  fisinterncomment   = EXCLUDED.fisinterncomment,
+  published          = EXCLUDED.published,
+  validforfispoints  = EXCLUDED.validforfispoints,
+  usedfislist        = EXCLUDED.usedfislist,
+  tolist             = EXCLUDED.tolist,
+  discforlistcode    = EXCLUDED.discforlistcode,
+  calculatedpenalty  = EXCLUDED.calculatedpenalty,
+  appliedpenalty     = EXCLUDED.appliedpenalty,
+  appliedscala       = EXCLUDED.appliedscala,
+  penscafixed        = EXCLUDED.penscafixed,
+  version            = EXCLUDED.version,
+  nationraceid       = EXCLUDED.nationraceid,
+  provraceid         = EXCLUDED.provraceid,
+  msql7evid          = EXCLUDED.msql7evid,
+  mssql7id           = EXCLUDED.mssql7id,
+  results            = EXCLUDED.results,
+  pdf                = EXCLUDED.pdf,
+  topbanner          = EXCLUDED.topbanner,
+  bottombanner       = EXCLUDED.bottombanner,
+  toplogo            = EXCLUDED.toplogo,
+  bottomlogo         = EXCLUDED.bottomlogo,
+  gallery            = EXCLUDED.gallery,
+  indi               = EXCLUDED.indi,
+  team               = EXCLUDED.team,
+  tabcount           = EXCLUDED.tabcount,
+  columncount        = EXCLUDED.columncount,
+  level              = EXCLUDED.level,
+  hloc1              = EXCLUDED.hloc1,
+  hloc2              = EXCLUDED.hloc2,
+  hloc3              = EXCLUDED.hloc3,
+  hcet1              = EXCLUDED.hcet1,
+  hcet2              = EXCLUDED.hcet2,
+  hcet3              = EXCLUDED.hcet3,
+  live               = EXCLUDED.live,
+  livestatus1        = EXCLUDED.livestatus1,
+  livestatus2        = EXCLUDED.livestatus2,
+  livestatus3        = EXCLUDED.livestatus3,
+  liveinfo1          = EXCLUDED.liveinfo1,
+  liveinfo2          = EXCLUDED.liveinfo2,
+  liveinfo3          = EXCLUDED.liveinfo3,
+  passwd             = EXCLUDED.passwd,
+  timinglogo         = EXCLUDED.timinglogo,
+  validdate          = EXCLUDED.validdate,
+  noepr              = EXCLUDED.noepr,
+  tddoc              = EXCLUDED.tddoc,
+  timingreport       = EXCLUDED.timingreport,
+  special_cup_points = EXCLUDED.special_cup_points,
+  skip_wcsl          = EXCLUDED.skip_wcsl,
+  lastupdate         = EXCLUDED.lastupdate,
+  validforowg        = EXCLUDED.validforowg
+WHERE a_racejp.lastupdate IS NULL
+   OR EXCLUDED.lastupdate > a_racejp.lastupdate
+   OR (EXCLUDED.lastupdate = a_racejp.lastupdate AND EXCLUDED.version > a_racejp.version)`,
+}
+
+func raceJPCopyKey(p fissqlc.InsertRaceJPParams) any {
+	return p.Raceid
+}
+
+func raceJPCopyValues(p fissqlc.InsertRaceJPParams) []any {
+	return []any{
+		p.Raceid, p.Eventid, p.Seasoncode, p.Racecodex, p.Disciplineid,
+		p.Disciplinecode, p.Catcode, p.Catcode2, p.Catcode3, p.Catcode4, p.Gender,
+		p.Racedate, p.Starteventdate, p.Description, p.Place, p.Nationcode, p.Td1id,
+		p.Td1name, p.Td1nation, p.Td1code, p.Td2id, p.Td2name, p.Td2nation, p.Td2code,
+		p.Calstatuscode, p.Procstatuscode, p.Receiveddate, p.Pursuit, p.Masse, p.Relay,
+		p.Distance, p.Hill, p.Style, p.Qualif, p.Finale, p.Homol, p.Webcomment,
+		p.Displaystatus, p.Fisinterncomment, p.Published, p.Validforfispoints,
+		p.Usedfislist, p.Tolist, p.Discforlistcode, p.Calculatedpenalty,
+		p.Appliedpenalty, p.Appliedscala, p.Penscafixed, p.Version, p.Nationraceid,
+		p.Provraceid, p.Msql7evid, p.Mssql7id, p.Results, p.Pdf, p.Topbanner,
+		p.Bottombanner, p.Toplogo, p.Bottomlogo, p.Gallery, p.Indi, p.Team, p.Tabcount,
+		p.Columncount, p.Level, p.Hloc1, p.Hloc2, p.Hloc3, p.Hcet1, p.Hcet2, p.Hcet3,
+		p.Live, p.Livestatus1, p.Livestatus2, p.Livestatus3, p.Liveinfo1, p.Liveinfo2,
+		p.Liveinfo3, p.Passwd, p.Timinglogo, p.Validdate, p.Noepr, p.Tddoc,
+		p.Timingreport, p.SpecialCupPoints, p.SkipWcsl, p.Lastupdate, p.Validforowg,
+	}
+}
+
+var racesNKCopy = utils.CopyTable{
+	Table: "a_racenk",
+	Columns: []string{
+		"raceid", "eventid", "seasoncode", "racecodex", "disciplineid",
+		"disciplinecode", "catcode", "catcode2", "catcode3", "catcode4", "gender",
+		"racedate", "starteventdate", "description", "place", "nationcode", "td1id",
+		"td1name", "td1nation", "td1code", "td2id", "td2name", "td2nation", "td2code",
+		"calstatuscode", "procstatuscode", "receiveddate", "pursuit", "masse", "relay",
+		"distance", "hill", "style", "qualif", "finale", "homol", "webcomment",
+		"displaystatus", "fisinterncomment", "published", "validforfispoints",
+		"usedfislist", "tolist", "discforlistcode", "calculatedpenalty",
+		"appliedpenalty", "appliedscala", "penscafixed", "version", "nationraceid",
+		"provraceid", "msql7evid", "mssql7id", "results", "pdf", "topbanner",
+		"bottombanner", "toplogo", "bottomlogo", "gallery", "indi", "team", "tabcount",
+		"columncount", "level", "hloc1", "hloc2", "hloc3", "hcet1", "hcet2", "hcet3",
+		"live", "livestatus1", "livestatus2", "livestatus3", "liveinfo1", "liveinfo2",
+		"liveinfo3", "passwd", "timinglogo", "validdate", "noepr", "tddoc",
+		"timingreport", "special_cup_points", "skip_wcsl", "validforowg", "lastupdate",
+	},
+	OnConflict: `ON CONFLICT (raceid) DO UPDATE SET
+  eventid            = EXCLUDED.eventid,
+  seasoncode         = EXCLUDED.seasoncode,
+  racecodex          = EXCLUDED.racecodex,
+  disciplineid       = EXCLUDED.disciplineid,
+  disciplinecode     = EXCLUDED.disciplinecode,
+  catcode            = EXCLUDED.catcode,
+  catcode2           = EXCLUDED.catcode2,
+  catcode3           = EXCLUDED.catcode3,
+  catcode4           = EXCLUDED.catcode4,
+  gender             = EXCLUDED.gender,
+  racedate           = EXCLUDED.racedate,
+  starteventdate     = EXCLUDED.starteventdate,
+  description        = EXCLUDED.description,
+  place              = EXCLUDED.place,
+  nationcode         = EXCLUDED.nationcode,
+  td1id              = EXCLUDED.td1id,
+  td1name            = EXCLUDED.td1name,
+  td1nation          = EXCLUDED.td1nation,
+  td1code            = EXCLUDED.td1code,
+  td2id              = EXCLUDED.td2id,
+  td2name            = EXCLUDED.td2name,
+  td2nation          = EXCLUDED.td2nation,
+  td2code            = EXCLUDED.td2code,
+  calstatuscode      = EXCLUDED.calstatuscode,
+  procstatuscode     = EXCLUDED.procstatuscode,
+  receiveddate       = EXCLUDED.receiveddate,
+  pursuit            = EXCLUDED.pursuit,
+  masse              = EXCLUDED.masse,
+  relay              = EXCLUDED.relay,
+  distance           = EXCLUDED.distance,
+  hill               = EXCLUDED.hill,
+  style              = EXCLUDED.style,
+  qualif             = EXCLUDED.qualif,
+  finale             = EXCLUDED.finale,
+  homol              = EXCLUDED.homol,
+  webcomment         = EXCLUDED.webcomment,
+  displaystatus      = EXCLUDED.displaystatus,
+  fisinterncomment   = EXCLUDED.fisinterncomment,
+  published          = EXCLUDED.published,
+  validforfispoints  = EXCLUDED.validforfispoints,
+  usedfislist        = EXCLUDED.usedfislist,
+  tolist             = EXCLUDED.tolist,
+  discforlistcode    = EXCLUDED.discforlistcode,
+  calculatedpenalty  = EXCLUDED.calculatedpenalty,
+  appliedpenalty     = EXCLUDED.appliedpenalty,
+  appliedscala       = EXCLUDED.appliedscala,
+  penscafixed        = EXCLUDED.penscafixed,
+  version            = EXCLUDED.version,
+  nationraceid       = EXCLUDED.nationraceid,
+  provraceid         = EXCLUDED.provraceid,
+  msql7evid          = EXCLUDED.msql7evid,
+  mssql7id           = EXCLUDED.mssql7id,
+  results            = EXCLUDED.results,
+  pdf                = EXCLUDED.pdf,
+  topbanner          = EXCLUDED.topbanner,
+  bottombanner       = EXCLUDED.bottombanner,
+  toplogo            = EXCLUDED.toplogo,
+  bottomlogo         = EXCLUDED.bottomlogo,
+  gallery            = EXCLUDED.gallery,
+  indi               = EXCLUDED.indi,
+  team               = EXCLUDED.team,
+  tabcount           = EXCLUDED.tabcount,
+  columncount        = EXCLUDED.columncount,
+  level              = EXCLUDED.level,
+  hloc1              = EXCLUDED.hloc1,
+  hloc2              = EXCLUDED.hloc2,
+  hloc3              = EXCLUDED.hloc3,
+  hcet1              = EXCLUDED.hcet1,
+  hcet2              = EXCLUDED.hcet2,
+  hcet3              = EXCLUDED.hcet3,
+  live               = EXCLUDED.live,
+  livestatus1        = EXCLUDED.livestatus1,
+  livestatus2        = EXCLUDED.livestatus2,
+  livestatus3        = EXCLUDED.livestatus3,
+  liveinfo1          = EXCLUDED.liveinfo1,
+  liveinfo2          = EXCLUDED.liveinfo2,
+  liveinfo3          = EXCLUDED.liveinfo3,
+  passwd             = EXCLUDED.passwd,
+  timinglogo         = EXCLUDED.timinglogo,
+  validdate          = EXCLUDED.validdate,
+  noepr              = EXCLUDED.noepr,
+  tddoc              = EXCLUDED.tddoc,
+  timingreport       = EXCLUDED.timingreport,
+  special_cup_points = EXCLUDED.special_cup_points,
+  skip_wcsl          = EXCLUDED.skip_wcsl,
+  validforowg        = EXCLUDED.validforowg,
+  lastupdate         = EXCLUDED.lastupdate
+WHERE a_racenk.lastupdate IS NULL
+   OR EXCLUDED.lastupdate > a_racenk.lastupdate
+   OR (EXCLUDED.lastupdate = a_racenk.lastupdate AND EXCLUDED.version > a_racenk.version)`,
+}
+
+func raceNKCopyKey(p fissqlc.InsertRaceNKParams) any {
+	return p.Raceid
+}
+
+func raceNKCopyValues(p fissqlc.InsertRaceNKParams) []any {
+	return []any{
+		p.Raceid, p.Eventid, p.Seasoncode, p.Racecodex, p.Disciplineid,
+		p.Disciplinecode, p.Catcode, p.Catcode2, p.Catcode3, p.Catcode4, p.Gender,
+		p.Racedate, p.Starteventdate, p.Description, p.Place, p.Nationcode, p.Td1id,
+		p.Td1name, p.Td1nation, p.Td1code, p.Td2id, p.Td2name, p.Td2nation, p.Td2code,
+		p.Calstatuscode, p.Procstatuscode, p.Receiveddate, p.Pursuit, p.Masse, p.Relay,
+		p.Distance, p.Hill, p.Style, p.Qualif, p.Finale, p.Homol, p.Webcomment,
+		p.Displaystatus, p.Fisinterncomment, p.Published, p.Validforfispoints,
+		p.Usedfislist, p.Tolist, p.Discforlistcode, p.Calculatedpenalty,
+		p.Appliedpenalty, p.Appliedscala, p.Penscafixed, p.Version, p.Nationraceid,
+		p.Provraceid, p.Msql7evid, p.Mssql7id, p.Results, p.Pdf, p.Topbanner,
+		p.Bottombanner, p.Toplogo, p.Bottomlogo, p.Gallery, p.Indi, p.Team, p.Tabcount,
+		p.Columncount, p.Level, p.Hloc1, p.Hloc2, p.Hloc3, p.Hcet1, p.Hcet2, p.Hcet3,
+		p.Live, p.Livestatus1, p.Livestatus2, p.Livestatus3, p.Liveinfo1, p.Liveinfo2,
+		p.Liveinfo3, p.Passwd, p.Timinglogo, p.Validdate, p.Noepr, p.Tddoc,
+		p.Timingreport, p.SpecialCupPoints, p.SkipWcsl, p.Validforowg, p.Lastupdate,
+	}
+}
+
+var resultsCCCopy = utils.CopyTable{
+	Table: "a_resultcc",
+	Columns: []string{
+		"recid", "raceid", "competitorid", "status", "reason", "position", "pf",
+		"status2", "bib", "bibcolor", "fiscode", "competitorname", "nationcode",
+		"stage", "level", "heat", "timer1", "timer2", "timer3", "timetot", "valid",
+		"racepoints", "cuppoints", "bonustime", "bonuscuppoints", "version", "rg1",
+		"rg2", "lastupdate",
+	},
+	OnConflict: `ON CONFLICT (recid) DO UPDATE SET
+  raceid         = EXCLUDED.raceid,
+  competitorid   = EXCLUDED.competitorid,
+  status         = EXCLUDED.status,
+  reason         = EXCLUDED.reason,
+  "position"     = EXCLUDED."position",
+  pf             = EXCLUDED.pf,
+  status2        = EXCLUDED.status2,
+  bib            = EXCLUDED.bib,
+  bibcolor       = EXCLUDED.bibcolor,
+  fiscode        = EXCLUDED.fiscode,
+  competitorname = EXCLUDED.competitorname,
+  nationcode     = EXCLUDED.nationcode,
+  stage          = EXCLUDED.stage,
+  level          = EXCLUDED.level,
+  heat           = EXCLUDED.heat,
+  timer1         = EXCLUDED.timer1,
+  timer2         = EXCLUDED.timer2,
+  timer3         = EXCLUDED.timer3,
+  timetot        = EXCLUDED.timetot,
+  valid          = EXCLUDED.valid,
+  racepoints     = EXCLUDED.racepoints,
+  cuppoints      = EXCLUDED.cuppoints,
+  bonustime      = EXCLUDED.bonustime,
+  bonuscuppoints = EXCLUDED.bonuscuppoints,
+  version        = EXCLUDED.version,
+  rg1            = EXCLUDED.rg1,
+  rg2            = EXCLUDED.rg2,
+  lastupdate     = EXCLUDED.lastupdate
+WHERE a_resultcc.lastupdate IS NULL
+   OR EXCLUDED.lastupdate > a_resultcc.lastupdate
+   OR (EXCLUDED.lastupdate = a_resultcc.lastupdate AND EXCLUDED.version IS DISTINCT FROM a_resultcc.version)`,
+}
+
+func resultCCCopyKey(p fissqlc.InsertResultCCParams) any {
+	return p.Recid
+}
+
+func resultCCCopyValues(p fissqlc.InsertResultCCParams) []any {
+	return []any{
+		p.Recid, p.Raceid, p.Competitorid, p.Status, p.Reason, p.Position, p.Pf,
+		p.Status2, p.Bib, p.Bibcolor, p.Fiscode, p.Competitorname, p.Nationcode,
+		p.Stage, p.Level, p.Heat, p.Timer1, p.Timer2, p.Timer3, p.Timetot, p.Valid,
+		p.Racepoints, p.Cuppoints, p.Bonustime, p.Bonuscuppoints, p.Version, p.Rg1,
+		p.Rg2, p.Lastupdate,
+	}
+}
+
+var resultsJPCopy = utils.CopyTable{
+	Table: "a_resultjp",
+	Columns: []string{
+		"recid", "raceid", "competitorid", "status", "status2", "position", "bib",
+		"fiscode", "competitorname", "nationcode", "level", "heat", "stage", "j1r1",
+		"j2r1", "j3r1", "j4r1", "j5r1", "speedr1", "distr1", "disptsr1", "judptsr1",
+		"totrun1", "posr1", "statusr1", "j1r2", "j2r2", "j3r2", "j4r2", "j5r2",
+		"speedr2", "distr2", "disptsr2", "judptsr2", "totrun2", "posr2", "statusr2",
+		"j1r3", "j2r3", "j3r3", "j4r3", "j5r3", "speedr3", "distr3", "disptsr3",
+		"judptsr3", "totrun3", "posr3", "statusr3", "j1r4", "j2r4", "j3r4", "j4r4",
+		"j5r4", "speedr4", "distr4", "disptsr4", "judptsr4", "gater1", "gater2",
+		"gater3", "gater4", "gateptsr1", "gateptsr2", "gateptsr3", "gateptsr4",
+		"windr1", "windr2", "windr3", "windr4", "windptsr1", "windptsr2", "windptsr3",
+		"windptsr4", "reason", "totrun4", "tot", "valid", "racepoints", "cuppoints",
+		"version", "lastupdate", "posr4", "statusr4",
+	},
+	OnConflict: `ON CONFLICT (recid) DO UPDATE SET
+  raceid         = EXCLUDED.raceid,
+  competitorid   = EXCLUDED.competitorid,
+  status         = EXCLUDED.status,
+  status2        = EXCLUDED.status2,
+  "position"     = EXCLUDED."position",
+  bib            = EXCLUDED.bib,
+  fiscode        = EXCLUDED.fiscode,
+  competitorname = EXCLUDED.competitorname,
+  nationcode     = EXCLUDED.nationcode,
+  level          = EXCLUDED.level,
+  heat           = EXCLUDED.heat,
+  stage          = EXCLUDED.stage,
+  j1r1           = EXCLUDED.j1r1,
+  j2r1           = EXCLUDED.j2r1,
+  j3r1           = EXCLUDED.j3r1,
+  j4r1           = EXCLUDED.j4r1,
+  j5r1           = EXCLUDED.j5r1,
+  speedr1        = EXCLUDED.speedr1,
+  distr1         = EXCLUDED.distr1,
+  disptsr1       = EXCLUDED.disptsr1,
+  judptsr1       = EXCLUDED.judptsr1,
+  totrun1        = EXCLUDED.totrun1,
+  posr1          = EXCLUDED.posr1,
+  statusr1       = EXCLUDED.statusr1,
+  j1r2           = EXCLUDED.j1r2,
+  j2r2           = EXCLUDED.j2r2,
+  j3r2           = EXCLUDED.j3r2,
+  j4r2           = EXCLUDED.j4r2,
+  j5r2           = EXCLUDED.j5r2,
+  speedr2        = EXCLUDED.speedr2,
+  distr2         = EXCLUDED.distr2,
+  disptsr2       = EXCLUDED.disptsr2,
+  judptsr2       = EXCLUDED.judptsr2,
+  totrun2        = EXCLUDED.totrun2,
+  posr2          = EXCLUDED.posr2,
+  statusr2       = EXCLUDED.statusr2,
+  j1r3           = EXCLUDED.j1r3,
+  j2r3           = EXCLUDED.j2r3,
+  j3r3           = EXCLUDED.j3r3,
+  j4r3           = EXCLUDED.j4r3,
+  j5r3           = EXCLUDED.j5r3,
+  speedr3        = EXCLUDED.speedr3,
+  distr3         = EXCLUDED.distr3,
+  disptsr3       = EXCLUDED.disptsr3,
+  judptsr3       = EXCLUDED.judptsr3,
+  totrun3        = EXCLUDED.totrun3,
+  posr3          = EXCLUDED.posr3,
+  statusr3       = EXCLUDED.statusr3,
+  j1r4           = EXCLUDED.j1r4,
+  j2r4           = EXCLUDED.j2r4,
+  j3r4           = EXCLUDED.j3r4,
+  j4r4           = EXCLUDED.j4r4,
+  j5r4           = EXCLUDED.j5r4,
+  speedr4        = EXCLUDED.speedr4,
+  distr4         = EXCLUDED.distr4,
+  disptsr4       = EXCLUDED.disptsr4,
+  judptsr4       = EXCLUDED.judptsr4,
+  gater1         = EXCLUDED.gater1,
+  gater2         = EXCLUDED.gater2,
+  gater3         = EXCLUDED.gater3,
+  gater4         = EXCLUDED.gater4,
+  gateptsr1      = EXCLUDED.gateptsr1,
+  gateptsr2      = EXCLUDED.gateptsr2,
+  gateptsr3      = EXCLUDED.gateptsr3,
+  gateptsr4      = EXCLUDED.gateptsr4,
+  windr1         = EXCLUDED.windr1,
+  windr2         = EXCLUDED.windr2,
+  windr3         = EXCLUDED.windr3,
+  windr4         = EXCLUDED.windr4,
+  windptsr1      = EXCLUDED.windptsr1,
+  windptsr2      = EXCLUDED.windptsr2,
+  windptsr3      = EXCLUDED.windptsr3,
+  windptsr4      = EXCLUDED.windptsr4,
+  reason         = EXCLUDED.reason,
+  totrun4        = EXCLUDED.totrun4,
+  tot            = EXCLUDED.tot,
+  valid          = EXCLUDED.valid,
+  racepoints     = EXCLUDED.racepoints,
+  cuppoints      = EXCLUDED.cuppoints,
+  version        = EXCLUDED.version,
+  lastupdate     = EXCLUDED.lastupdate,
+  posr4          = EXCLUDED.posr4,
+  statusr4       = EXCLUDED.statusr4
+WHERE a_resultjp.lastupdate IS NULL
+   OR EXCLUDED.lastupdate > a_resultjp.lastupdate
+   OR (EXCLUDED.lastupdate = a_resultjp.lastupdate AND EXCLUDED.version IS DISTINCT FROM a_resultjp.version)`,
+}
+
+func resultJPCopyKey(p fissqlc.InsertResultJPParams) any {
+	return p.Recid
+}
+
+func resultJPCopyValues(p fissqlc.InsertResultJPParams) []any {
+	return []any{
+		p.Recid, p.Raceid, p.Competitorid, p.Status, p.Status2, p.Position, p.Bib,
+		p.Fiscode, p.Competitorname, p.Nationcode, p.Level, p.Heat, p.Stage, p.J1r1,
+		p.J2r1, p.J3r1, p.J4r1, p.J5r1, p.Speedr1, p.Distr1, p.Disptsr1, p.Judptsr1,
+		p.Totrun1, p.Posr1, p.Statusr1, p.J1r2, p.J2r2, p.J3r2, p.J4r2, p.J5r2,
+		p.Speedr2, p.Distr2, p.Disptsr2, p.Judptsr2, p.Totrun2, p.Posr2, p.Statusr2,
+		p.J1r3, p.J2r3, p.J3r3, p.J4r3, p.J5r3, p.Speedr3, p.Distr3, p.Disptsr3,
+		p.Judptsr3, p.Totrun3, p.Posr3, p.Statusr3, p.J1r4, p.J2r4, p.J3r4, p.J4r4,
+		p.J5r4, p.Speedr4, p.Distr4, p.Disptsr4, p.Judptsr4, p.Gater1, p.Gater2,
+		p.Gater3, p.Gater4, p.Gateptsr1, p.Gateptsr2, p.Gateptsr3, p.Gateptsr4,
+		p.Windr1, p.Windr2, p.Windr3, p.Windr4, p.Windptsr1, p.Windptsr2, p.Windptsr3,
+		p.Windptsr4, p.Reason, p.Totrun4, p.Tot, p.Valid, p.Racepoints, p.Cuppoints,
+		p.Version, p.Lastupdate, p.Posr4, p.Statusr4,
+	}
+}
+
+var resultsNKCopy = utils.CopyTable{
+	Table: "a_resultnk",
+	Columns: []string{
+		"recid", "raceid", "competitorid", "status", "status2", "reason", "position",
+		"pf", "bib", "bibcolor", "fiscode", "competitorname", "nationcode", "level",
+		"heat", "stage", "j1r1", "j2r1", "j3r1", "j4r1", "j5r1", "speedr1", "distr1",
+		"disptsr1", "judptsr1", "gater1", "gateptsr1", "windr1", "windptsr1",
+		"totrun1", "posr1", "statusr1", "j1r2", "j2r2", "j3r2", "j4r2", "j5r2",
+		"speedr2", "distr2", "disptsr2", "judptsr2", "gater2", "gateptsr2", "windr2",
+		"windptsr2", "totrun2", "posr2", "statusr2", "pointsjump", "behindjump",
+		"posjump", "timecc", "timeccint", "poscc", "starttime", "statuscc",
+		"totbehind", "timetot", "timetotint", "valid", "racepoints", "cuppoints",
+		"version", "lastupdate",
+	},
+	OnConflict: `ON CONFLICT (recid) DO UPDATE SET
+  raceid         = EXCLUDED.raceid,
+  competitorid   = EXCLUDED.competitorid,
+  status         = EXCLUDED.status,
+  status2        = EXCLUDED.status2,
+  reason         = EXCLUDED.reason,
+  "position"     = EXCLUDED."position",
+  pf             = EXCLUDED.pf,
+  bib            = EXCLUDED.bib,
+  bibcolor       = EXCLUDED.bibcolor,
+  fiscode        = EXCLUDED.fiscode,
+  competitorname = EXCLUDED.competitorname,
+  nationcode     = EXCLUDED.nationcode,
+  level          = EXCLUDED.level,
+  heat           = EXCLUDED.heat,
+  stage          = EXCLUDED.stage,
+  j1r1           = EXCLUDED.j1r1,
+  j2r1           = EXCLUDED.j2r1,
+  j3r1           = EXCLUDED.j3r1,
+  j4r1           = EXCLUDED.j4r1,
+  j5r1           = EXCLUDED.j5r1,
+  speedr1        = EXCLUDED.speedr1,
+  distr1         = EXCLUDED.distr1,
+  disptsr1       = EXCLUDED.disptsr1,
+  judptsr1       = EXCLUDED.judptsr1,
+  gater1         = EXCLUDED.gater1,
+  gateptsr1      = EXCLUDED.gateptsr1,
+  windr1         = EXCLUDED.windr1,
+  windptsr1      = EXCLUDED.windptsr1,
+  totrun1        = EXCLUDED.totrun1,
+  posr1          = EXCLUDED.posr1,
+  statusr1       = EXCLUDED.statusr1,
+  j1r2           = EXCLUDED.j1r2,
+  j2r2           = EXCLUDED.j2r2,
+  j3r2           = EXCLUDED.j3r2,
+  j4r2           = EXCLUDED.j4r2,
+  j5r2           = EXCLUDED.j5r2,
+  speedr2        = EXCLUDED.speedr2,
+  distr2         = EXCLUDED.distr2,
+  disptsr2       = EXCLUDED.disptsr2,
+  judptsr2       = EXCLUDED.judptsr2,
+  gater2         = EXCLUDED.gater2,
+  gateptsr2      = EXCLUDED.gateptsr2,
+  windr2         = EXCLUDED.windr2,
+  windptsr2      = EXCLUDED.windptsr2,
+  totrun2        = EXCLUDED.totrun2,
+  posr2          = EXCLUDED.posr2,
+  statusr2       = EXCLUDED.statusr2,
+  pointsjump     = EXCLUDED.pointsjump,
+  behindjump     = EXCLUDED.behindjump,
+  posjump        = EXCLUDED.posjump,
+  timecc         = EXCLUDED.timecc,
+  timeccint      = EXCLUDED.timeccint,
+  poscc          = EXCLUDED.poscc,
+  starttime      = EXCLUDED.starttime,
+  statuscc       = EXCLUDED.statuscc,
+  totbehind      = EXCLUDED.totbehind,
+  timetot        = EXCLUDED.timetot,
+  timetotint     = EXCLUDED.timetotint,
+  valid          = EXCLUDED.valid,
+  racepoints     = EXCLUDED.racepoints,
+  cuppoints      = EXCLUDED.cuppoints,
+  version        = EXCLUDED.version,
+  lastupdate     = EXCLUDED.lastupdate
+WHERE a_resultnk.lastupdate IS NULL
+   OR EXCLUDED.lastupdate > a_resultnk.lastupdate
+   OR (EXCLUDED.lastupdate = a_resultnk.lastupdate AND EXCLUDED.version IS DISTINCT FROM a_resultnk.version)`,
+}
+
+func resultNKCopyKey(p fissqlc.InsertResultNKParams) any {
+	return p.Recid
+}
+
+func resultNKCopyValues(p fissqlc.InsertResultNKParams) []any {
+	return []any{
+		p.Recid, p.Raceid, p.Competitorid, p.Status, p.Status2, p.Reason, p.Position,
+		p.Pf, p.Bib, p.Bibcolor, p.Fiscode, p.Competitorname, p.Nationcode, p.Level,
+		p.Heat, p.Stage, p.J1r1, p.J2r1, p.J3r1, p.J4r1, p.J5r1, p.Speedr1, p.Distr1,
+		p.Disptsr1, p.Judptsr1, p.Gater1, p.Gateptsr1, p.Windr1, p.Windptsr1,
+		p.Totrun1, p.Posr1, p.Statusr1, p.J1r2, p.J2r2, p.J3r2, p.J4r2, p.J5r2,
+		p.Speedr2, p.Distr2, p.Disptsr2, p.Judptsr2, p.Gater2, p.Gateptsr2, p.Windr2,
+		p.Windptsr2, p.Totrun2, p.Posr2, p.Statusr2, p.Pointsjump, p.Behindjump,
+		p.Posjump, p.Timecc, p.Timeccint, p.Poscc, p.Starttime, p.Statuscc,
+		p.Totbehind, p.Timetot, p.Timetotint, p.Valid, p.Racepoints, p.Cuppoints,
+		p.Version, p.Lastupdate,
+	}
+}
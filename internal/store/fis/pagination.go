@@ -0,0 +1,21 @@
+package fis
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+// Races without a date sort last in the athlete results queries
+var undatedRace = time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+
+// ResultCursor is the keyset position of an athlete result (race date, RecID)
+func ResultCursor(raceDate sql.NullTime, recID int32) utils.Cursor {
+	d := undatedRace
+	if raceDate.Valid {
+		d = raceDate.Time
+	}
+	n := int64(recID)
+	return utils.Cursor{Time: &d, N: &n}
+}
@@ -0,0 +1,71 @@
+package fis
+
+import (
+	"context"
+	"time"
+
+	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+// PointsListQuery selects the FIS points lists to compute. A list is dated
+// every StepMonths from From to To and averages the Best lowest race points
+// plus penalty of the PeriodMonths before its date, in the races valid for
+// FIS points with a FIS list and a known penalty.
+type PointsListQuery struct {
+	From         time.Time
+	To           time.Time
+	StepMonths   int32
+	PeriodMonths int32
+	Disciplines  []string
+	Gender       string
+	Best         int32
+	MinResults   int32
+	// Fiscode keeps one competitor, 0 all of them
+	Fiscode int32
+	// Nationcode keeps the competitors of a nation; Top then caps the
+	// national rank instead of the overall one
+	Nationcode string
+	// Top caps the rank, 0 for no cap
+	Top int32
+}
+
+func (s *ResultCCStore) GetFISPointsListCC(ctx context.Context, in PointsListQuery) ([]fissqlc.GetFISPointsListCCRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	q := fissqlc.New(s.db)
+	return q.GetFISPointsListCC(ctx, fissqlc.GetFISPointsListCCParams{
+		Column1:  in.From,
+		Column2:  in.To,
+		Column3:  in.StepMonths,
+		Column4:  in.PeriodMonths,
+		Column5:  in.Disciplines,
+		Column6:  in.Gender,
+		Column7:  in.Best,
+		Column8:  in.MinResults,
+		Column9:  in.Fiscode,
+		Column10: in.Nationcode,
+		Column11: in.Top,
+	})
+}
+
+func (s *ResultNKStore) GetFISPointsListNK(ctx context.Context, in PointsListQuery) ([]fissqlc.GetFISPointsListNKRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	q := fissqlc.New(s.db)
+	return q.GetFISPointsListNK(ctx, fissqlc.GetFISPointsListNKParams{
+		Column1:  in.From,
+		Column2:  in.To,
+		Column3:  in.StepMonths,
+		Column4:  in.PeriodMonths,
+		Column5:  in.Disciplines,
+		Column6:  in.Gender,
+		Column7:  in.Best,
+		Column8:  in.MinResults,
+		Column9:  in.Fiscode,
+		Column10: in.Nationcode,
+		Column11: in.Top,
+	})
+}
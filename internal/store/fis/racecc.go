@@ -5,6 +5,7 @@ import (
 	"database/sql"
 
 	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
 )
 
@@ -69,7 +70,7 @@ func (s *RaceCCStore) GetCrossCountryCategories(ctx context.Context) ([]string,
 	return out, nil
 }
 
-func (s *RaceCCStore) GetRacesCC(ctx context.Context, seasons []int32, disciplines, cats []string) ([]fissqlc.ARacecc, error) {
+func (s *RaceCCStore) GetRacesCC(ctx context.Context, seasons []int32, disciplines, cats []string, page utils.Page) ([]fissqlc.ARacecc, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
 	defer cancel()
 
@@ -78,6 +79,8 @@ func (s *RaceCCStore) GetRacesCC(ctx context.Context, seasons []int32, disciplin
 		Column1: seasons,
 		Column2: disciplines,
 		Column3: cats,
+		Column4: int32(page.AfterN().Int64),
+		Column5: page.FetchLimit(),
 	}
 	return q.GetRacesCC(ctx, params)
 }
@@ -95,7 +98,23 @@ func (s *RaceCCStore) InsertRaceCC(ctx context.Context, in InsertRaceCCClean) er
 	defer cancel()
 
 	q := fissqlc.New(s.db)
-	return q.InsertRaceCC(ctx, mapInsertRaceCCToParams(in))
+	if err := q.InsertRaceCC(ctx, mapInsertRaceCCToParams(in)); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, events.New[int32]("fis", "races_cc", events.Insert, 1))
+	return nil
+}
+
+// SyncRacesCC upserts races in one transaction, skipping those
+// older than the stored rows, see syncRows
+func (s *RaceCCStore) SyncRacesCC(ctx context.Context, rows []InsertRaceCCClean) (utils.UpsertCounts, error) {
+	params := make([]fissqlc.InsertRaceCCParams, len(rows))
+	for i, in := range rows {
+		params[i] = mapInsertRaceCCToParams(in)
+	}
+
+	return syncRows(ctx, s.db, "races_cc", racesCCCopy, params, raceCCCopyKey, raceCCCopyValues, nil)
 }
 
 func (s *RaceCCStore) UpdateRaceCCByID(ctx context.Context, in UpdateRaceCCClean) error {
@@ -103,8 +122,12 @@ func (s *RaceCCStore) UpdateRaceCCByID(ctx context.Context, in UpdateRaceCCClean
 	defer cancel()
 
 	q := fissqlc.New(s.db)
-	_, err := q.UpdateRaceCCByID(ctx, mapUpdateRaceCCToParams(in))
-	return err
+	if _, err := q.UpdateRaceCCByID(ctx, mapUpdateRaceCCToParams(in)); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, events.New[int32]("fis", "races_cc", events.Update, 1))
+	return nil
 }
 
 func (s *RaceCCStore) DeleteRaceCCByID(ctx context.Context, raceID int32) error {
@@ -112,8 +135,12 @@ func (s *RaceCCStore) DeleteRaceCCByID(ctx context.Context, raceID int32) error
 	defer cancel()
 
 	q := fissqlc.New(s.db)
-	_, err := q.DeleteRaceCCByID(ctx, raceID)
-	return err
+	if _, err := q.DeleteRaceCCByID(ctx, raceID); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, events.New[int32]("fis", "races_cc", events.Delete, 1))
+	return nil
 }
 
 func (s *RaceCCStore) SearchRacesCC(
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 
 	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
 )
 
@@ -69,7 +70,7 @@ func (s *RaceJPStore) GetSkiJumpingCategories(ctx context.Context) ([]string, er
 	return out, nil
 }
 
-func (s *RaceJPStore) GetRacesJP(ctx context.Context, seasons []int32, disciplines, cats []string) ([]fissqlc.ARacejp, error) {
+func (s *RaceJPStore) GetRacesJP(ctx context.Context, seasons []int32, disciplines, cats []string, page utils.Page) ([]fissqlc.ARacejp, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
 	defer cancel()
 
@@ -78,6 +79,8 @@ func (s *RaceJPStore) GetRacesJP(ctx context.Context, seasons []int32, disciplin
 		Column1: seasons,
 		Column2: disciplines,
 		Column3: cats,
+		Column4: int32(page.AfterN().Int64),
+		Column5: page.FetchLimit(),
 	}
 	return q.GetRacesJP(ctx, params)
 }
@@ -95,7 +98,23 @@ func (s *RaceJPStore) InsertRaceJP(ctx context.Context, in InsertRaceJPClean) er
 	defer cancel()
 
 	q := fissqlc.New(s.db)
-	return q.InsertRaceJP(ctx, mapInsertRaceJPToParams(in))
+	if err := q.InsertRaceJP(ctx, mapInsertRaceJPToParams(in)); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, events.New[int32]("fis", "races_jp", events.Insert, 1))
+	return nil
+}
+
+// SyncRacesJP upserts races in one transaction, skipping those
+// older than the stored rows, see syncRows
+func (s *RaceJPStore) SyncRacesJP(ctx context.Context, rows []InsertRaceJPClean) (utils.UpsertCounts, error) {
+	params := make([]fissqlc.InsertRaceJPParams, len(rows))
+	for i, in := range rows {
+		params[i] = mapInsertRaceJPToParams(in)
+	}
+
+	return syncRows(ctx, s.db, "races_jp", racesJPCopy, params, raceJPCopyKey, raceJPCopyValues, nil)
 }
 
 func (s *RaceJPStore) UpdateRaceJPByID(ctx context.Context, in UpdateRaceJPClean) error {
@@ -103,8 +122,12 @@ func (s *RaceJPStore) UpdateRaceJPByID(ctx context.Context, in UpdateRaceJPClean
 	defer cancel()
 
 	q := fissqlc.New(s.db)
-	_, err := q.UpdateRaceJPByID(ctx, mapUpdateRaceJPToParams(in))
-	return err
+	if _, err := q.UpdateRaceJPByID(ctx, mapUpdateRaceJPToParams(in)); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, events.New[int32]("fis", "races_jp", events.Update, 1))
+	return nil
 }
 
 func (s *RaceJPStore) DeleteRaceJPByID(ctx context.Context, raceID int32) error {
@@ -112,8 +135,12 @@ func (s *RaceJPStore) DeleteRaceJPByID(ctx context.Context, raceID int32) error
 	defer cancel()
 
 	q := fissqlc.New(s.db)
-	_, err := q.DeleteRaceJPByID(ctx, raceID)
-	return err
+	if _, err := q.DeleteRaceJPByID(ctx, raceID); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, events.New[int32]("fis", "races_jp", events.Delete, 1))
+	return nil
 }
 
 func (s *RaceJPStore) SearchRacesJP(
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 
 	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
 )
 
@@ -69,7 +70,7 @@ func (s *RaceNKStore) GetNordicCombinedCategories(ctx context.Context) ([]string
 	return out, nil
 }
 
-func (s *RaceNKStore) GetRacesNK(ctx context.Context, seasons []int32, disciplines, cats []string) ([]fissqlc.ARacenk, error) {
+func (s *RaceNKStore) GetRacesNK(ctx context.Context, seasons []int32, disciplines, cats []string, page utils.Page) ([]fissqlc.ARacenk, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
 	defer cancel()
 
@@ -78,6 +79,8 @@ func (s *RaceNKStore) GetRacesNK(ctx context.Context, seasons []int32, disciplin
 		Column1: seasons,
 		Column2: disciplines,
 		Column3: cats,
+		Column4: int32(page.AfterN().Int64),
+		Column5: page.FetchLimit(),
 	}
 	return q.GetRacesNK(ctx, params)
 }
@@ -95,7 +98,23 @@ func (s *RaceNKStore) InsertRaceNK(ctx context.Context, in InsertRaceNKClean) er
 	defer cancel()
 
 	q := fissqlc.New(s.db)
-	return q.InsertRaceNK(ctx, mapInsertRaceNKToParams(in))
+	if err := q.InsertRaceNK(ctx, mapInsertRaceNKToParams(in)); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, events.New[int32]("fis", "races_nk", events.Insert, 1))
+	return nil
+}
+
+// SyncRacesNK upserts races in one transaction, skipping those
+// older than the stored rows, see syncRows
+func (s *RaceNKStore) SyncRacesNK(ctx context.Context, rows []InsertRaceNKClean) (utils.UpsertCounts, error) {
+	params := make([]fissqlc.InsertRaceNKParams, len(rows))
+	for i, in := range rows {
+		params[i] = mapInsertRaceNKToParams(in)
+	}
+
+	return syncRows(ctx, s.db, "races_nk", racesNKCopy, params, raceNKCopyKey, raceNKCopyValues, nil)
 }
 
 func (s *RaceNKStore) UpdateRaceNKByID(ctx context.Context, in UpdateRaceNKClean) error {
@@ -103,8 +122,12 @@ func (s *RaceNKStore) UpdateRaceNKByID(ctx context.Context, in UpdateRaceNKClean
 	defer cancel()
 
 	q := fissqlc.New(s.db)
-	_, err := q.UpdateRaceNKByID(ctx, mapUpdateRaceNKToParams(in))
-	return err
+	if _, err := q.UpdateRaceNKByID(ctx, mapUpdateRaceNKToParams(in)); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, events.New[int32]("fis", "races_nk", events.Update, 1))
+	return nil
 }
 
 func (s *RaceNKStore) DeleteRaceNKByID(ctx context.Context, raceID int32) error {
@@ -112,8 +135,12 @@ func (s *RaceNKStore) DeleteRaceNKByID(ctx context.Context, raceID int32) error
 	defer cancel()
 
 	q := fissqlc.New(s.db)
-	_, err := q.DeleteRaceNKByID(ctx, raceID)
-	return err
+	if _, err := q.DeleteRaceNKByID(ctx, raceID); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, events.New[int32]("fis", "races_nk", events.Delete, 1))
+	return nil
 }
 
 func (s *RaceNKStore) SearchRacesNK(
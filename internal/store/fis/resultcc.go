@@ -5,6 +5,7 @@ import (
 	"database/sql"
 
 	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
 )
 
@@ -25,7 +26,25 @@ func (s *ResultCCStore) InsertResultCC(ctx context.Context, in InsertResultCCCle
 	defer cancel()
 
 	q := fissqlc.New(s.db)
-	return q.InsertResultCC(ctx, mapInsertResultCCToParams(in))
+	if err := q.InsertResultCC(ctx, mapInsertResultCCToParams(in)); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, events.New("fis", "results_cc", events.Insert, 1, competitorOf(in.Competitorid)...))
+	return nil
+}
+
+// SyncResultsCC upserts results in one transaction, skipping those
+// older than the stored rows, see syncRows
+func (s *ResultCCStore) SyncResultsCC(ctx context.Context, rows []InsertResultCCClean) (utils.UpsertCounts, error) {
+	params := make([]fissqlc.InsertResultCCParams, len(rows))
+	var athletes []int32
+	for i, in := range rows {
+		params[i] = mapInsertResultCCToParams(in)
+		athletes = append(athletes, competitorOf(in.Competitorid)...)
+	}
+
+	return syncRows(ctx, s.db, "results_cc", resultsCCCopy, params, resultCCCopyKey, resultCCCopyValues, athletes)
 }
 
 func (s *ResultCCStore) UpdateResultCCByRecID(ctx context.Context, in UpdateResultCCClean) error {
@@ -33,8 +52,12 @@ func (s *ResultCCStore) UpdateResultCCByRecID(ctx context.Context, in UpdateResu
 	defer cancel()
 
 	q := fissqlc.New(s.db)
-	_, err := q.UpdateResultCCByRecID(ctx, mapUpdateResultCCToParams(in))
-	return err
+	if _, err := q.UpdateResultCCByRecID(ctx, mapUpdateResultCCToParams(in)); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, events.New("fis", "results_cc", events.Update, 1, competitorOf(in.Competitorid)...))
+	return nil
 }
 
 func (s *ResultCCStore) DeleteResultCCByRecID(ctx context.Context, recid int32) error {
@@ -42,8 +65,12 @@ func (s *ResultCCStore) DeleteResultCCByRecID(ctx context.Context, recid int32)
 	defer cancel()
 
 	q := fissqlc.New(s.db)
-	_, err := q.DeleteResultCCByRecID(ctx, recid)
-	return err
+	if _, err := q.DeleteResultCCByRecID(ctx, recid); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, events.New[int32]("fis", "results_cc", events.Delete, 1))
+	return nil
 }
 
 func (s *ResultCCStore) GetRaceResultsCCByRaceID(ctx context.Context, raceID int32) ([]fissqlc.AResultcc, error) {
@@ -54,11 +81,37 @@ func (s *ResultCCStore) GetRaceResultsCCByRaceID(ctx context.Context, raceID int
 	return q.GetRaceResultsCCByRaceID(ctx, sql.NullInt32{Int32: raceID, Valid: true})
 }
 
+// GetEnrichedRaceResultsCC returns the results of a race with the birthdate,
+// ski club and nation of the competitors
+func (s *ResultCCStore) GetEnrichedRaceResultsCC(ctx context.Context, raceID int32) ([]fissqlc.GetEnrichedRaceResultsCCRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	q := fissqlc.New(s.db)
+	return q.GetEnrichedRaceResultsCC(ctx, raceID)
+}
+
+// ExportResultsCC returns a page of the results of races in seasons
+// seasonFrom..seasonTo, ordered by recid
+func (s *ResultCCStore) ExportResultsCC(ctx context.Context, seasonFrom, seasonTo int32, page utils.Page) ([]fissqlc.AResultcc, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.BulkQueryTimeout)
+	defer cancel()
+
+	q := fissqlc.New(s.db)
+	return q.ExportResultsCC(ctx, fissqlc.ExportResultsCCParams{
+		Column1: seasonFrom,
+		Column2: seasonTo,
+		Column3: int32(page.AfterN().Int64),
+		Column4: page.Limit,
+	})
+}
+
 func (s *ResultCCStore) GetAthleteResultsCC(
 	ctx context.Context,
 	competitorID int32,
 	seasons []int32,
 	disciplines, cats []string,
+	page utils.Page,
 ) ([]fissqlc.GetAthleteResultsCCRow, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
 	defer cancel()
@@ -69,6 +122,9 @@ func (s *ResultCCStore) GetAthleteResultsCC(
 		Column2:      seasons,
 		Column3:      disciplines,
 		Column4:      cats,
+		Column5:      page.AfterTime().Time,
+		Column6:      int32(page.AfterN().Int64),
+		Column7:      page.FetchLimit(),
 	}
 	return q.GetAthleteResultsCC(ctx, params)
 }
@@ -84,6 +140,41 @@ func (s *ResultCCStore) GetSeasonsCatcodesCCByCompetitor(
 	return q.GetSeasonsCatcodesCCByCompetitor(ctx, fiscode)
 }
 
+// GetHeadToHeadCC returns the results of the competitors in the races at
+// least two of them competed in
+func (s *ResultCCStore) GetHeadToHeadCC(ctx context.Context, fiscodes, seasons []int32, disciplines, cats []string) ([]fissqlc.GetHeadToHeadCCRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	q := fissqlc.New(s.db)
+	return q.GetHeadToHeadCC(ctx, fissqlc.GetHeadToHeadCCParams{
+		Column1: fiscodes,
+		Column2: seasons,
+		Column3: disciplines,
+		Column4: cats,
+	})
+}
+
+// GetCompetitorSeasonStatsCC aggregates the results of a competitor per
+// season, with a career row at the end
+func (s *ResultCCStore) GetCompetitorSeasonStatsCC(ctx context.Context, competitorID int32) ([]fissqlc.GetCompetitorSeasonStatsCCRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	q := fissqlc.New(s.db)
+	return q.GetCompetitorSeasonStatsCC(ctx, competitorID)
+}
+
+// GetCompetitorBestResultsCC returns the best result of a competitor per
+// discipline and category
+func (s *ResultCCStore) GetCompetitorBestResultsCC(ctx context.Context, competitorID int32) ([]fissqlc.GetCompetitorBestResultsCCRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	q := fissqlc.New(s.db)
+	return q.GetCompetitorBestResultsCC(ctx, competitorID)
+}
+
 func (s *ResultCCStore) GetLatestResultsCC(
 	ctx context.Context,
 	fiscode int32,
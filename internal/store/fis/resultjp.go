@@ -5,6 +5,7 @@ import (
 	"database/sql"
 
 	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
 )
 
@@ -25,7 +26,25 @@ func (s *ResultJPStore) InsertResultJP(ctx context.Context, in InsertResultJPCle
 	defer cancel()
 
 	q := fissqlc.New(s.db)
-	return q.InsertResultJP(ctx, mapInsertResultJPToParams(in))
+	if err := q.InsertResultJP(ctx, mapInsertResultJPToParams(in)); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, events.New("fis", "results_jp", events.Insert, 1, competitorOf(in.Competitorid)...))
+	return nil
+}
+
+// SyncResultsJP upserts results in one transaction, skipping those
+// older than the stored rows, see syncRows
+func (s *ResultJPStore) SyncResultsJP(ctx context.Context, rows []InsertResultJPClean) (utils.UpsertCounts, error) {
+	params := make([]fissqlc.InsertResultJPParams, len(rows))
+	var athletes []int32
+	for i, in := range rows {
+		params[i] = mapInsertResultJPToParams(in)
+		athletes = append(athletes, competitorOf(in.Competitorid)...)
+	}
+
+	return syncRows(ctx, s.db, "results_jp", resultsJPCopy, params, resultJPCopyKey, resultJPCopyValues, athletes)
 }
 
 func (s *ResultJPStore) UpdateResultJPByRecID(ctx context.Context, in UpdateResultJPClean) error {
@@ -33,8 +52,12 @@ func (s *ResultJPStore) UpdateResultJPByRecID(ctx context.Context, in UpdateResu
 	defer cancel()
 
 	q := fissqlc.New(s.db)
-	_, err := q.UpdateResultJPByRecID(ctx, mapUpdateResultJPToParams(in))
-	return err
+	if _, err := q.UpdateResultJPByRecID(ctx, mapUpdateResultJPToParams(in)); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, events.New("fis", "results_jp", events.Update, 1, competitorOf(in.Competitorid)...))
+	return nil
 }
 
 func (s *ResultJPStore) DeleteResultJPByRecID(ctx context.Context, recid int32) error {
@@ -42,8 +65,12 @@ func (s *ResultJPStore) DeleteResultJPByRecID(ctx context.Context, recid int32)
 	defer cancel()
 
 	q := fissqlc.New(s.db)
-	_, err := q.DeleteResultJPByRecID(ctx, recid)
-	return err
+	if _, err := q.DeleteResultJPByRecID(ctx, recid); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, events.New[int32]("fis", "results_jp", events.Delete, 1))
+	return nil
 }
 
 func (s *ResultJPStore) GetRaceResultsJPByRaceID(ctx context.Context, raceID int32) ([]fissqlc.AResultjp, error) {
@@ -54,11 +81,37 @@ func (s *ResultJPStore) GetRaceResultsJPByRaceID(ctx context.Context, raceID int
 	return q.GetRaceResultsJPByRaceID(ctx, sql.NullInt32{Int32: raceID, Valid: true})
 }
 
+// GetEnrichedRaceResultsJP returns the results of a race with the birthdate,
+// ski club and nation of the competitors
+func (s *ResultJPStore) GetEnrichedRaceResultsJP(ctx context.Context, raceID int32) ([]fissqlc.GetEnrichedRaceResultsJPRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	q := fissqlc.New(s.db)
+	return q.GetEnrichedRaceResultsJP(ctx, raceID)
+}
+
+// ExportResultsJP returns a page of the results of races in seasons
+// seasonFrom..seasonTo, ordered by recid
+func (s *ResultJPStore) ExportResultsJP(ctx context.Context, seasonFrom, seasonTo int32, page utils.Page) ([]fissqlc.AResultjp, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.BulkQueryTimeout)
+	defer cancel()
+
+	q := fissqlc.New(s.db)
+	return q.ExportResultsJP(ctx, fissqlc.ExportResultsJPParams{
+		Column1: seasonFrom,
+		Column2: seasonTo,
+		Column3: int32(page.AfterN().Int64),
+		Column4: page.Limit,
+	})
+}
+
 func (s *ResultJPStore) GetAthleteResultsJP(
 	ctx context.Context,
 	competitorID int32,
 	seasons []int32,
 	disciplines, cats []string,
+	page utils.Page,
 ) ([]fissqlc.GetAthleteResultsJPRow, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
 	defer cancel()
@@ -69,6 +122,9 @@ func (s *ResultJPStore) GetAthleteResultsJP(
 		Column2:      seasons,
 		Column3:      disciplines,
 		Column4:      cats,
+		Column5:      page.AfterTime().Time,
+		Column6:      int32(page.AfterN().Int64),
+		Column7:      page.FetchLimit(),
 	}
 	return q.GetAthleteResultsJP(ctx, params)
 }
@@ -84,6 +140,41 @@ func (s *ResultJPStore) GetSeasonsCatcodesJPByCompetitor(
 	return q.GetSeasonsCatcodesJPByCompetitor(ctx, fiscode)
 }
 
+// GetHeadToHeadJP returns the results of the competitors in the races at
+// least two of them competed in
+func (s *ResultJPStore) GetHeadToHeadJP(ctx context.Context, fiscodes, seasons []int32, disciplines, cats []string) ([]fissqlc.GetHeadToHeadJPRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	q := fissqlc.New(s.db)
+	return q.GetHeadToHeadJP(ctx, fissqlc.GetHeadToHeadJPParams{
+		Column1: fiscodes,
+		Column2: seasons,
+		Column3: disciplines,
+		Column4: cats,
+	})
+}
+
+// GetCompetitorSeasonStatsJP aggregates the results of a competitor per
+// season, with a career row at the end
+func (s *ResultJPStore) GetCompetitorSeasonStatsJP(ctx context.Context, competitorID int32) ([]fissqlc.GetCompetitorSeasonStatsJPRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	q := fissqlc.New(s.db)
+	return q.GetCompetitorSeasonStatsJP(ctx, competitorID)
+}
+
+// GetCompetitorBestResultsJP returns the best result of a competitor per
+// discipline and category
+func (s *ResultJPStore) GetCompetitorBestResultsJP(ctx context.Context, competitorID int32) ([]fissqlc.GetCompetitorBestResultsJPRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	q := fissqlc.New(s.db)
+	return q.GetCompetitorBestResultsJP(ctx, competitorID)
+}
+
 func (s *ResultJPStore) GetLatestResultsJP(
 	ctx context.Context,
 	fiscode int32,
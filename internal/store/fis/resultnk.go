@@ -5,6 +5,7 @@ import (
 	"database/sql"
 
 	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
 )
 
@@ -25,7 +26,25 @@ func (s *ResultNKStore) InsertResultNK(ctx context.Context, in InsertResultNKCle
 	defer cancel()
 
 	q := fissqlc.New(s.db)
-	return q.InsertResultNK(ctx, mapInsertResultNKToParams(in))
+	if err := q.InsertResultNK(ctx, mapInsertResultNKToParams(in)); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, events.New("fis", "results_nk", events.Insert, 1, competitorOf(in.Competitorid)...))
+	return nil
+}
+
+// SyncResultsNK upserts results in one transaction, skipping those
+// older than the stored rows, see syncRows
+func (s *ResultNKStore) SyncResultsNK(ctx context.Context, rows []InsertResultNKClean) (utils.UpsertCounts, error) {
+	params := make([]fissqlc.InsertResultNKParams, len(rows))
+	var athletes []int32
+	for i, in := range rows {
+		params[i] = mapInsertResultNKToParams(in)
+		athletes = append(athletes, competitorOf(in.Competitorid)...)
+	}
+
+	return syncRows(ctx, s.db, "results_nk", resultsNKCopy, params, resultNKCopyKey, resultNKCopyValues, athletes)
 }
 
 func (s *ResultNKStore) UpdateResultNKByRecID(ctx context.Context, in UpdateResultNKClean) error {
@@ -33,8 +52,12 @@ func (s *ResultNKStore) UpdateResultNKByRecID(ctx context.Context, in UpdateResu
 	defer cancel()
 
 	q := fissqlc.New(s.db)
-	_, err := q.UpdateResultNKByRecID(ctx, mapUpdateResultNKToParams(in))
-	return err
+	if _, err := q.UpdateResultNKByRecID(ctx, mapUpdateResultNKToParams(in)); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, events.New("fis", "results_nk", events.Update, 1, competitorOf(in.Competitorid)...))
+	return nil
 }
 
 func (s *ResultNKStore) DeleteResultNKByRecID(ctx context.Context, recid int32) error {
@@ -42,8 +65,12 @@ func (s *ResultNKStore) DeleteResultNKByRecID(ctx context.Context, recid int32)
 	defer cancel()
 
 	q := fissqlc.New(s.db)
-	_, err := q.DeleteResultNKByRecID(ctx, recid)
-	return err
+	if _, err := q.DeleteResultNKByRecID(ctx, recid); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, events.New[int32]("fis", "results_nk", events.Delete, 1))
+	return nil
 }
 
 func (s *ResultNKStore) GetRaceResultsNKByRaceID(ctx context.Context, raceID int32) ([]fissqlc.AResultnk, error) {
@@ -54,11 +81,37 @@ func (s *ResultNKStore) GetRaceResultsNKByRaceID(ctx context.Context, raceID int
 	return q.GetRaceResultsNKByRaceID(ctx, sql.NullInt32{Int32: raceID, Valid: true})
 }
 
+// GetEnrichedRaceResultsNK returns the results of a race with the birthdate,
+// ski club and nation of the competitors
+func (s *ResultNKStore) GetEnrichedRaceResultsNK(ctx context.Context, raceID int32) ([]fissqlc.GetEnrichedRaceResultsNKRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	q := fissqlc.New(s.db)
+	return q.GetEnrichedRaceResultsNK(ctx, raceID)
+}
+
+// ExportResultsNK returns a page of the results of races in seasons
+// seasonFrom..seasonTo, ordered by recid
+func (s *ResultNKStore) ExportResultsNK(ctx context.Context, seasonFrom, seasonTo int32, page utils.Page) ([]fissqlc.AResultnk, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.BulkQueryTimeout)
+	defer cancel()
+
+	q := fissqlc.New(s.db)
+	return q.ExportResultsNK(ctx, fissqlc.ExportResultsNKParams{
+		Column1: seasonFrom,
+		Column2: seasonTo,
+		Column3: int32(page.AfterN().Int64),
+		Column4: page.Limit,
+	})
+}
+
 func (s *ResultNKStore) GetAthleteResultsNK(
 	ctx context.Context,
 	competitorID int32,
 	seasons []int32,
 	disciplines, cats []string,
+	page utils.Page,
 ) ([]fissqlc.GetAthleteResultsNKRow, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
 	defer cancel()
@@ -69,6 +122,9 @@ func (s *ResultNKStore) GetAthleteResultsNK(
 		Column2:      seasons,
 		Column3:      disciplines,
 		Column4:      cats,
+		Column5:      page.AfterTime().Time,
+		Column6:      int32(page.AfterN().Int64),
+		Column7:      page.FetchLimit(),
 	}
 	return q.GetAthleteResultsNK(ctx, params)
 }
@@ -84,6 +140,41 @@ func (s *ResultNKStore) GetSeasonsCatcodesNKByCompetitor(
 	return q.GetSeasonsCatcodesNKByCompetitor(ctx, fiscode)
 }
 
+// GetHeadToHeadNK returns the results of the competitors in the races at
+// least two of them competed in
+func (s *ResultNKStore) GetHeadToHeadNK(ctx context.Context, fiscodes, seasons []int32, disciplines, cats []string) ([]fissqlc.GetHeadToHeadNKRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	q := fissqlc.New(s.db)
+	return q.GetHeadToHeadNK(ctx, fissqlc.GetHeadToHeadNKParams{
+		Column1: fiscodes,
+		Column2: seasons,
+		Column3: disciplines,
+		Column4: cats,
+	})
+}
+
+// GetCompetitorSeasonStatsNK aggregates the results of a competitor per
+// season, with a career row at the end
+func (s *ResultNKStore) GetCompetitorSeasonStatsNK(ctx context.Context, competitorID int32) ([]fissqlc.GetCompetitorSeasonStatsNKRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	q := fissqlc.New(s.db)
+	return q.GetCompetitorSeasonStatsNK(ctx, competitorID)
+}
+
+// GetCompetitorBestResultsNK returns the best result of a competitor per
+// discipline and category
+func (s *ResultNKStore) GetCompetitorBestResultsNK(ctx context.Context, competitorID int32) ([]fissqlc.GetCompetitorBestResultsNKRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	q := fissqlc.New(s.db)
+	return q.GetCompetitorBestResultsNK(ctx, competitorID)
+}
+
 func (s *ResultNKStore) GetLatestResultsNK(
 	ctx context.Context,
 	fiscode int32,
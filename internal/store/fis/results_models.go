@@ -427,3 +427,12 @@ func mapUpdateResultNKToParams(in UpdateResultNKClean) fissqlc.UpdateResultNKByR
 	p := mapInsertResultNKToParams(InsertResultNKClean(in))
 	return fissqlc.UpdateResultNKByRecIDParams(p)
 }
+
+// competitorOf lists the competitor of a result, if set, as the athlete of
+// its events
+func competitorOf(competitorID *int32) []int32 {
+	if competitorID == nil {
+		return nil
+	}
+	return []int32{*competitorID}
+}
@@ -6,42 +6,63 @@ import (
 	"time"
 
 	fissqlc "github.com/DeRuina/KUHA-REST-API/internal/db/fis"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
 )
 
 // Resultcc interface
 type Resultcc interface {
 	GetLastRowResultCC(ctx context.Context) (fissqlc.AResultcc, error)
 	InsertResultCC(ctx context.Context, in InsertResultCCClean) error
+	SyncResultsCC(ctx context.Context, rows []InsertResultCCClean) (utils.UpsertCounts, error)
 	UpdateResultCCByRecID(ctx context.Context, in UpdateResultCCClean) error
 	DeleteResultCCByRecID(ctx context.Context, recid int32) error
 	GetRaceResultsCCByRaceID(ctx context.Context, raceID int32) ([]fissqlc.AResultcc, error)
-	GetAthleteResultsCC(ctx context.Context, competitorID int32, seasons []int32, disciplines, cats []string) ([]fissqlc.GetAthleteResultsCCRow, error)
+	GetEnrichedRaceResultsCC(ctx context.Context, raceID int32) ([]fissqlc.GetEnrichedRaceResultsCCRow, error)
+	ExportResultsCC(ctx context.Context, seasonFrom, seasonTo int32, page utils.Page) ([]fissqlc.AResultcc, error)
+	GetAthleteResultsCC(ctx context.Context, competitorID int32, seasons []int32, disciplines, cats []string, page utils.Page) ([]fissqlc.GetAthleteResultsCCRow, error)
 	GetSeasonsCatcodesCCByCompetitor(ctx context.Context, fiscode int32) ([]fissqlc.GetSeasonsCatcodesCCByCompetitorRow, error)
 	GetLatestResultsCC(ctx context.Context, fiscode int32, seasoncode *int32, catcodes []string, limit *int32) ([]fissqlc.GetLatestResultsCCRow, error)
+	GetCompetitorSeasonStatsCC(ctx context.Context, competitorID int32) ([]fissqlc.GetCompetitorSeasonStatsCCRow, error)
+	GetCompetitorBestResultsCC(ctx context.Context, competitorID int32) ([]fissqlc.GetCompetitorBestResultsCCRow, error)
+	GetHeadToHeadCC(ctx context.Context, fiscodes, seasons []int32, disciplines, cats []string) ([]fissqlc.GetHeadToHeadCCRow, error)
+	GetFISPointsListCC(ctx context.Context, in PointsListQuery) ([]fissqlc.GetFISPointsListCCRow, error)
 }
 
 // Resultjp interface
 type Resultjp interface {
 	GetLastRowResultJP(ctx context.Context) (fissqlc.AResultjp, error)
 	InsertResultJP(ctx context.Context, in InsertResultJPClean) error
+	SyncResultsJP(ctx context.Context, rows []InsertResultJPClean) (utils.UpsertCounts, error)
 	UpdateResultJPByRecID(ctx context.Context, in UpdateResultJPClean) error
 	DeleteResultJPByRecID(ctx context.Context, recid int32) error
 	GetRaceResultsJPByRaceID(ctx context.Context, raceID int32) ([]fissqlc.AResultjp, error)
-	GetAthleteResultsJP(ctx context.Context, competitorID int32, seasons []int32, disciplines, cats []string) ([]fissqlc.GetAthleteResultsJPRow, error)
+	GetEnrichedRaceResultsJP(ctx context.Context, raceID int32) ([]fissqlc.GetEnrichedRaceResultsJPRow, error)
+	ExportResultsJP(ctx context.Context, seasonFrom, seasonTo int32, page utils.Page) ([]fissqlc.AResultjp, error)
+	GetAthleteResultsJP(ctx context.Context, competitorID int32, seasons []int32, disciplines, cats []string, page utils.Page) ([]fissqlc.GetAthleteResultsJPRow, error)
 	GetSeasonsCatcodesJPByCompetitor(ctx context.Context, fiscode int32) ([]fissqlc.GetSeasonsCatcodesJPByCompetitorRow, error)
 	GetLatestResultsJP(ctx context.Context, fiscode int32, seasoncode *int32, catcodes []string, limit *int32) ([]fissqlc.GetLatestResultsJPRow, error)
+	GetCompetitorSeasonStatsJP(ctx context.Context, competitorID int32) ([]fissqlc.GetCompetitorSeasonStatsJPRow, error)
+	GetCompetitorBestResultsJP(ctx context.Context, competitorID int32) ([]fissqlc.GetCompetitorBestResultsJPRow, error)
+	GetHeadToHeadJP(ctx context.Context, fiscodes, seasons []int32, disciplines, cats []string) ([]fissqlc.GetHeadToHeadJPRow, error)
 }
 
 // Resultnk interface
 type Resultnk interface {
 	GetLastRowResultNK(ctx context.Context) (fissqlc.AResultnk, error)
 	InsertResultNK(ctx context.Context, in InsertResultNKClean) error
+	SyncResultsNK(ctx context.Context, rows []InsertResultNKClean) (utils.UpsertCounts, error)
 	UpdateResultNKByRecID(ctx context.Context, in UpdateResultNKClean) error
 	DeleteResultNKByRecID(ctx context.Context, recid int32) error
 	GetRaceResultsNKByRaceID(ctx context.Context, raceID int32) ([]fissqlc.AResultnk, error)
-	GetAthleteResultsNK(ctx context.Context, competitorID int32, seasons []int32, disciplines, cats []string) ([]fissqlc.GetAthleteResultsNKRow, error)
+	GetEnrichedRaceResultsNK(ctx context.Context, raceID int32) ([]fissqlc.GetEnrichedRaceResultsNKRow, error)
+	ExportResultsNK(ctx context.Context, seasonFrom, seasonTo int32, page utils.Page) ([]fissqlc.AResultnk, error)
+	GetAthleteResultsNK(ctx context.Context, competitorID int32, seasons []int32, disciplines, cats []string, page utils.Page) ([]fissqlc.GetAthleteResultsNKRow, error)
 	GetSeasonsCatcodesNKByCompetitor(ctx context.Context, fiscode int32) ([]fissqlc.GetSeasonsCatcodesNKByCompetitorRow, error)
 	GetLatestResultsNK(ctx context.Context, fiscode int32, seasoncode *int32, catcodes []string, limit *int32) ([]fissqlc.GetLatestResultsNKRow, error)
+	GetCompetitorSeasonStatsNK(ctx context.Context, competitorID int32) ([]fissqlc.GetCompetitorSeasonStatsNKRow, error)
+	GetCompetitorBestResultsNK(ctx context.Context, competitorID int32) ([]fissqlc.GetCompetitorBestResultsNKRow, error)
+	GetHeadToHeadNK(ctx context.Context, fiscodes, seasons []int32, disciplines, cats []string) ([]fissqlc.GetHeadToHeadNKRow, error)
+	GetFISPointsListNK(ctx context.Context, in PointsListQuery) ([]fissqlc.GetFISPointsListNKRow, error)
 }
 
 // Racecc interface
@@ -49,9 +70,10 @@ type Racecc interface {
 	GetCrossCountrySeasons(ctx context.Context) ([]int32, error)
 	GetCrossCountryDisciplines(ctx context.Context) ([]string, error)
 	GetCrossCountryCategories(ctx context.Context) ([]string, error)
-	GetRacesCC(ctx context.Context, seasons []int32, disciplines, cats []string) ([]fissqlc.ARacecc, error)
+	GetRacesCC(ctx context.Context, seasons []int32, disciplines, cats []string, page utils.Page) ([]fissqlc.ARacecc, error)
 	GetLastRowRaceCC(ctx context.Context) (fissqlc.ARacecc, error)
 	InsertRaceCC(ctx context.Context, in InsertRaceCCClean) error
+	SyncRacesCC(ctx context.Context, rows []InsertRaceCCClean) (utils.UpsertCounts, error)
 	UpdateRaceCCByID(ctx context.Context, in UpdateRaceCCClean) error
 	DeleteRaceCCByID(ctx context.Context, raceID int32) error
 	SearchRacesCC(ctx context.Context, seasoncode *int32, nationcode, gender, catcode *string) ([]fissqlc.SearchRacesCCRow, error)
@@ -66,9 +88,10 @@ type Racejp interface {
 	GetSkiJumpingSeasons(ctx context.Context) ([]int32, error)
 	GetSkiJumpingDisciplines(ctx context.Context) ([]string, error)
 	GetSkiJumpingCategories(ctx context.Context) ([]string, error)
-	GetRacesJP(ctx context.Context, seasons []int32, disciplines, cats []string) ([]fissqlc.ARacejp, error)
+	GetRacesJP(ctx context.Context, seasons []int32, disciplines, cats []string, page utils.Page) ([]fissqlc.ARacejp, error)
 	GetLastRowRaceJP(ctx context.Context) (fissqlc.ARacejp, error)
 	InsertRaceJP(ctx context.Context, in InsertRaceJPClean) error
+	SyncRacesJP(ctx context.Context, rows []InsertRaceJPClean) (utils.UpsertCounts, error)
 	UpdateRaceJPByID(ctx context.Context, in UpdateRaceJPClean) error
 	DeleteRaceJPByID(ctx context.Context, raceID int32) error
 	SearchRacesJP(ctx context.Context, seasoncode *int32, nationcode, gender, catcode *string) ([]fissqlc.SearchRacesJPRow, error)
@@ -83,9 +106,10 @@ type Racenk interface {
 	GetNordicCombinedSeasons(ctx context.Context) ([]int32, error)
 	GetNordicCombinedDisciplines(ctx context.Context) ([]string, error)
 	GetNordicCombinedCategories(ctx context.Context) ([]string, error)
-	GetRacesNK(ctx context.Context, seasons []int32, disciplines, cats []string) ([]fissqlc.ARacenk, error)
+	GetRacesNK(ctx context.Context, seasons []int32, disciplines, cats []string, page utils.Page) ([]fissqlc.ARacenk, error)
 	GetLastRowRaceNK(ctx context.Context) (fissqlc.ARacenk, error)
 	InsertRaceNK(ctx context.Context, in InsertRaceNKClean) error
+	SyncRacesNK(ctx context.Context, rows []InsertRaceNKClean) (utils.UpsertCounts, error)
 	UpdateRaceNKByID(ctx context.Context, in UpdateRaceNKClean) error
 	DeleteRaceNKByID(ctx context.Context, raceID int32) error
 	SearchRacesNK(ctx context.Context, seasoncode *int32, nationcode, gender, catcode *string) ([]fissqlc.SearchRacesNKRow, error)
@@ -101,12 +125,13 @@ type Competitors interface {
 	GetNationsBySector(ctx context.Context, sector string) ([]string, error)
 	GetLastRowCompetitor(ctx context.Context) (fissqlc.ACompetitor, error)
 	InsertCompetitor(ctx context.Context, in InsertCompetitorClean) error
+	SyncCompetitors(ctx context.Context, rows []InsertCompetitorClean) (utils.UpsertCounts, error)
 	UpdateCompetitorByID(ctx context.Context, in UpdateCompetitorClean) error
 	DeleteCompetitorByID(ctx context.Context, competitorID int32) error
 	GetCompetitorIDByFiscodeCC(ctx context.Context, fiscode int32) (int32, error)
 	GetCompetitorIDByFiscodeJP(ctx context.Context, fiscode int32) (int32, error)
 	GetCompetitorIDByFiscodeNK(ctx context.Context, fiscode int32) (int32, error)
-	SearchCompetitors(ctx context.Context, nationcode, sectorcode, gender *string, birthdateMin, birthdateMax *time.Time) ([]fissqlc.ACompetitor, error)
+	SearchCompetitors(ctx context.Context, nationcode, sectorcode, gender *string, birthdateMin, birthdateMax *time.Time, query, fiscodePrefix string, page utils.Page) ([]fissqlc.SearchCompetitorsRow, error)
 	GetCompetitorCountsByNation(ctx context.Context, sectorcode, gender *string, birthdateMin, birthdateMax *time.Time) ([]fissqlc.GetCompetitorCountsByNationRow, error)
 	GetSectorcodeByFiscode(ctx context.Context, fiscode int32) (string, error)
 }
@@ -119,6 +144,27 @@ type Athlete interface {
 	DeleteAthleteByFiscode(ctx context.Context, fiscode int32) error
 }
 
+// Changes interface
+type Changes interface {
+	ListChanges(ctx context.Context, entity string, since time.Time, page utils.Page) ([]Change, error)
+	Horizon(ctx context.Context) (time.Time, error)
+}
+
+// Calendar interface
+type Calendar interface {
+	GetCalendarRaces(ctx context.Context, in CalendarQuery) ([]fissqlc.GetCalendarRacesRow, error)
+}
+
+// AthleteLinks interface
+type AthleteLinks interface {
+	ListUnlinkedFinnishCompetitors(ctx context.Context) ([]fissqlc.ListUnlinkedFinnishCompetitorsRow, error)
+	ListLinkedSporttiIDs(ctx context.Context) ([]int32, error)
+	ProposeCandidates(ctx context.Context, candidates []LinkCandidate) (int64, error)
+	ListCandidates(ctx context.Context, in LinkCandidateQuery, page utils.Page) ([]fissqlc.FisAthleteLinkCandidate, error)
+	GetCandidate(ctx context.Context, id int32) (fissqlc.FisAthleteLinkCandidate, error)
+	ReviewCandidate(ctx context.Context, id int32, approve bool, reviewer string) (fissqlc.FisAthleteLinkCandidate, error)
+}
+
 // FISStorage struct to hold table-specific storage
 type FISStorage struct {
 	db          *sql.DB
@@ -130,6 +176,9 @@ type FISStorage struct {
 	resultjp    Resultjp
 	resultnk    Resultnk
 	athlete     Athlete
+	changes     Changes
+	calendar    Calendar
+	links       AthleteLinks
 }
 
 // Ping method
@@ -170,6 +219,18 @@ func (s *FISStorage) Athlete() Athlete {
 	return s.athlete
 }
 
+func (s *FISStorage) Changes() Changes {
+	return s.changes
+}
+
+func (s *FISStorage) Calendar() Calendar {
+	return s.calendar
+}
+
+func (s *FISStorage) AthleteLinks() AthleteLinks {
+	return s.links
+}
+
 // Storage for FIS database tables
 func NewFISStorage(db *sql.DB) *FISStorage {
 	return &FISStorage{
@@ -182,5 +243,8 @@ func NewFISStorage(db *sql.DB) *FISStorage {
 		resultjp:    &ResultJPStore{db: db},
 		resultnk:    &ResultNKStore{db: db},
 		athlete:     &AthleteStore{db: db},
+		changes:     &ChangesStore{db: db},
+		calendar:    &CalendarStore{db: db},
+		links:       &AthleteLinksStore{db: db},
 	}
 }
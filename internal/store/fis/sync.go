@@ -0,0 +1,35 @@
+package fis
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+// syncRows upserts rows into the table of t in one transaction, see
+// utils.Upsert, and emits a single event for the rows written
+func syncRows[T any](ctx context.Context, db *sql.DB, entity string, t utils.CopyTable, rows []T, key func(T) any, values func(T) []any, athletes []int32) (utils.UpsertCounts, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.BulkQueryTimeout)
+	defer cancel()
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return utils.UpsertCounts{}, err
+	}
+	defer tx.Rollback()
+
+	counts, err := utils.Upsert(ctx, tx, t, rows, key, values)
+	if err != nil {
+		return utils.UpsertCounts{}, err
+	}
+	if err := tx.Commit(); err != nil {
+		return utils.UpsertCounts{}, err
+	}
+
+	if written := counts.Inserted + counts.Updated; written > 0 {
+		events.Emit(ctx, events.New("fis", entity, events.Upsert, int(written), athletes...))
+	}
+	return counts, nil
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	kamksqlc "github.com/DeRuina/KUHA-REST-API/internal/db/kamk"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
 )
 
@@ -51,7 +52,12 @@ func (s *InjuriesStore) AddInjury(ctx context.Context, userID int32, in InjuryIn
 		Meta:        in.Meta,
 	}
 
-	return q.InsertInjury(ctx, arg)
+	if err := q.InsertInjury(ctx, arg); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, events.New("kamk", "injuries", events.Insert, 1, userID))
+	return nil
 }
 
 func (s *InjuriesStore) MarkInjuryRecovered(ctx context.Context, userID int32, injuryID int32) (int64, error) {
@@ -66,6 +72,7 @@ func (s *InjuriesStore) MarkInjuryRecovered(ctx context.Context, userID int32, i
 		return 0, err
 	}
 
+	events.Emit(ctx, events.New("kamk", "injuries", events.Update, 1, userID))
 	return 1, nil
 }
 
@@ -117,5 +124,7 @@ func (s *InjuriesStore) DeleteInjury(ctx context.Context, userID int32, injuryID
 	if err != nil {
 		return 0, err
 	}
+
+	events.Emit(ctx, events.New("kamk", "injuries", events.Delete, int(n), userID))
 	return n, nil
 }
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	kamksqlc "github.com/DeRuina/KUHA-REST-API/internal/db/kamk"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
 )
 
@@ -42,7 +43,13 @@ func (s *QueriesStore) AddQuestionnaire(ctx context.Context, userID int32, in Qu
 		Comment:   in.Comment,
 		Meta:      in.Meta,
 	}
-	return q.InsertQuestionnaire(ctx, arg)
+	id, err := q.InsertQuestionnaire(ctx, arg)
+	if err != nil {
+		return 0, err
+	}
+
+	events.Emit(ctx, events.New("kamk", "questionnaires", events.Insert, 1, userID))
+	return id, nil
 }
 
 func (s *QueriesStore) GetQuestionnaires(ctx context.Context, userID int32) ([]Questionnaire, error) {
@@ -118,6 +125,8 @@ func (s *QueriesStore) UpdateQuestionnaireByID(ctx context.Context, userID int32
 	if err != nil {
 		return 0, err
 	}
+
+	events.Emit(ctx, events.New("kamk", "questionnaires", events.Update, int(n), userID))
 	return n, nil
 }
 
@@ -133,5 +142,7 @@ func (s *QueriesStore) DeleteQuestionnaireByID(ctx context.Context, userID int32
 	if err != nil {
 		return 0, err
 	}
+
+	events.Emit(ctx, events.New("kamk", "questionnaires", events.Delete, int(n), userID))
 	return n, nil
 }
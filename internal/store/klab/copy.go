@@ -0,0 +1,154 @@
+package klab
+
+import (
+	klabsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/klab"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+// COPY loading of the tables that receive the largest bulk inserts, see
+// utils.CopyMerge. Columns and ON CONFLICT clauses mirror InsertDirTestStep
+// and InsertDirRawData in queries.sql and must be kept in sync with them.
+
+var dirTestStepsCopy = utils.CopyTable{
+	Table: "dirteststeps",
+	Columns: []string{
+		"iddirteststeps", "idmeasurement", "stepno", "ana_time", "timestop", "speed", "pace",
+		"angle", "elev", "vo2calc", "t_tot", "t_ex", "fico2", "fio2",
+		"feco2", "feo2", "vde", "vco2", "vo2", "bf", "ve",
+		"petco2", "peto2", "vo2kg", "re", "hr", "la", "rer",
+		"ve_stpd", "veo2", "veco2", "tv", "ee_ae", "la_vo2", "o2pulse",
+		"vde_tv", "va", "o2sa", "rpe", "bp_sys", "bp_dia", "own1",
+		"own2", "own3", "own4", "own5", "step_is_rest", "step_is_30max", "step_is_60max",
+		"step_is_rec", "calc_start", "calc_end", "comments", "timestart", "duration", "eco",
+		"p", "wkg", "vo2_30s", "vo2_pr", "step_is_last", "deleted", "created_by",
+		"mod_by", "mod_date", "created_date", "modded", "own6", "own7", "own8",
+		"own9", "own10", "to2", "tco2",
+	},
+	OnConflict: `ON CONFLICT (iddirteststeps) DO UPDATE SET
+    idmeasurement = EXCLUDED.idmeasurement,
+    stepno = EXCLUDED.stepno,
+    ana_time = EXCLUDED.ana_time,
+    timestop = EXCLUDED.timestop,
+    speed = EXCLUDED.speed,
+    pace = EXCLUDED.pace,
+    angle = EXCLUDED.angle,
+    elev = EXCLUDED.elev,
+    vo2calc = EXCLUDED.vo2calc,
+    t_tot = EXCLUDED.t_tot,
+    t_ex = EXCLUDED.t_ex,
+    fico2 = EXCLUDED.fico2,
+    fio2 = EXCLUDED.fio2,
+    feco2 = EXCLUDED.feco2,
+    feo2 = EXCLUDED.feo2,
+    vde = EXCLUDED.vde,
+    vco2 = EXCLUDED.vco2,
+    vo2 = EXCLUDED.vo2,
+    bf = EXCLUDED.bf,
+    ve = EXCLUDED.ve,
+    petco2 = EXCLUDED.petco2,
+    peto2 = EXCLUDED.peto2,
+    vo2kg = EXCLUDED.vo2kg,
+    re = EXCLUDED.re,
+    hr = EXCLUDED.hr,
+    la = EXCLUDED.la,
+    rer = EXCLUDED.rer,
+    ve_stpd = EXCLUDED.ve_stpd,
+    veo2 = EXCLUDED.veo2,
+    veco2 = EXCLUDED.veco2,
+    tv = EXCLUDED.tv,
+    ee_ae = EXCLUDED.ee_ae,
+    la_vo2 = EXCLUDED.la_vo2,
+    o2pulse = EXCLUDED.o2pulse,
+    vde_tv = EXCLUDED.vde_tv,
+    va = EXCLUDED.va,
+    o2sa = EXCLUDED.o2sa,
+    rpe = EXCLUDED.rpe,
+    bp_sys = EXCLUDED.bp_sys,
+    bp_dia = EXCLUDED.bp_dia,
+    own1 = EXCLUDED.own1,
+    own2 = EXCLUDED.own2,
+    own3 = EXCLUDED.own3,
+    own4 = EXCLUDED.own4,
+    own5 = EXCLUDED.own5,
+    step_is_rest = EXCLUDED.step_is_rest,
+    step_is_30max = EXCLUDED.step_is_30max,
+    step_is_60max = EXCLUDED.step_is_60max,
+    step_is_rec = EXCLUDED.step_is_rec,
+    calc_start = EXCLUDED.calc_start,
+    calc_end = EXCLUDED.calc_end,
+    comments = EXCLUDED.comments,
+    timestart = EXCLUDED.timestart,
+    duration = EXCLUDED.duration,
+    eco = EXCLUDED.eco,
+    p = EXCLUDED.p,
+    wkg = EXCLUDED.wkg,
+    vo2_30s = EXCLUDED.vo2_30s,
+    vo2_pr = EXCLUDED.vo2_pr,
+    step_is_last = EXCLUDED.step_is_last,
+    deleted = EXCLUDED.deleted,
+    created_by = EXCLUDED.created_by,
+    mod_by = EXCLUDED.mod_by,
+    mod_date = EXCLUDED.mod_date,
+    created_date = EXCLUDED.created_date,
+    modded = EXCLUDED.modded,
+    own6 = EXCLUDED.own6,
+    own7 = EXCLUDED.own7,
+    own8 = EXCLUDED.own8,
+    own9 = EXCLUDED.own9,
+    own10 = EXCLUDED.own10,
+    to2 = EXCLUDED.to2,
+    tco2 = EXCLUDED.tco2`,
+}
+
+func dirTestStepCopyKey(st klabsqlc.InsertDirTestStepParams) any {
+	return st.Iddirteststeps
+}
+
+func dirTestStepCopyValues(st klabsqlc.InsertDirTestStepParams) []any {
+	return []any{
+		st.Iddirteststeps, st.Idmeasurement, st.Stepno, st.AnaTime, st.Timestop, st.Speed,
+		st.Pace, st.Angle, st.Elev, st.Vo2calc, st.TTot, st.TEx,
+		st.Fico2, st.Fio2, st.Feco2, st.Feo2, st.Vde, st.Vco2,
+		st.Vo2, st.Bf, st.Ve, st.Petco2, st.Peto2, st.Vo2kg,
+		st.Re, st.Hr, st.La, st.Rer, st.VeStpd, st.Veo2,
+		st.Veco2, st.Tv, st.EeAe, st.LaVo2, st.O2pulse, st.VdeTv,
+		st.Va, st.O2sa, st.Rpe, st.BpSys, st.BpDia, st.Own1,
+		st.Own2, st.Own3, st.Own4, st.Own5, st.StepIsRest, st.StepIs30max,
+		st.StepIs60max, st.StepIsRec, st.CalcStart, st.CalcEnd, st.Comments, st.Timestart,
+		st.Duration, st.Eco, st.P, st.Wkg, st.Vo230s, st.Vo2Pr,
+		st.StepIsLast, st.Deleted, st.CreatedBy, st.ModBy, st.ModDate, st.CreatedDate,
+		st.Modded, st.Own6, st.Own7, st.Own8, st.Own9, st.Own10,
+		st.To2, st.Tco2,
+	}
+}
+
+var dirRawDataCopy = utils.CopyTable{
+	Table: "dirrawdata",
+	Columns: []string{
+		"iddirrawdata", "idmeasurement", "rawdata", "columndata", "info", "unitsdata",
+		"created_by", "mod_by", "mod_date", "deleted", "created_date", "modded",
+	},
+	OnConflict: `ON CONFLICT (iddirrawdata) DO UPDATE SET
+    idmeasurement = EXCLUDED.idmeasurement,
+    rawdata = EXCLUDED.rawdata,
+    columndata = EXCLUDED.columndata,
+    info = EXCLUDED.info,
+    unitsdata = EXCLUDED.unitsdata,
+    created_by = EXCLUDED.created_by,
+    mod_by = EXCLUDED.mod_by,
+    mod_date = EXCLUDED.mod_date,
+    deleted = EXCLUDED.deleted,
+    created_date = EXCLUDED.created_date,
+    modded = EXCLUDED.modded`,
+}
+
+func dirRawDataCopyKey(rd klabsqlc.InsertDirRawDataParams) any {
+	return rd.Iddirrawdata
+}
+
+func dirRawDataCopyValues(rd klabsqlc.InsertDirRawDataParams) []any {
+	return []any{
+		rd.Iddirrawdata, rd.Idmeasurement, rd.Rawdata, rd.Columndata, rd.Info, rd.Unitsdata,
+		rd.CreatedBy, rd.ModBy, rd.ModDate, rd.Deleted, rd.CreatedDate, rd.Modded,
+	}
+}
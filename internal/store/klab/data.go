@@ -5,6 +5,7 @@ import (
 	"database/sql"
 
 	klabsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/klab"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
 )
 
@@ -22,6 +23,29 @@ type KlabDataPayload struct {
 	DirResults   []klabsqlc.InsertDirResultsParams
 }
 
+// KlabDataItems is a k-Lab bundle in partial mode, each row tagged with
+// its index in the request
+type KlabDataItems struct {
+	Customers    []utils.BulkItem[klabsqlc.UpsertCustomerParams]
+	Measurements []utils.BulkItem[klabsqlc.InsertMeasurementParams]
+	DirTests     []utils.BulkItem[klabsqlc.InsertDirTestParams]
+	DirTestSteps []utils.BulkItem[klabsqlc.InsertDirTestStepParams]
+	DirRawData   []utils.BulkItem[klabsqlc.InsertDirRawDataParams]
+	DirReports   []utils.BulkItem[klabsqlc.InsertDirReportParams]
+	DirResults   []utils.BulkItem[klabsqlc.InsertDirResultsParams]
+}
+
+// KlabDataResults holds the outcome of every row of KlabDataItems
+type KlabDataResults struct {
+	Customers    []utils.BulkItemResult
+	Measurements []utils.BulkItemResult
+	DirTests     []utils.BulkItemResult
+	DirTestSteps []utils.BulkItemResult
+	DirRawData   []utils.BulkItemResult
+	DirReports   []utils.BulkItemResult
+	DirResults   []utils.BulkItemResult
+}
+
 type KlabDataNoCustomer struct {
 	CustomerID   int32
 	Measurements []klabsqlc.MeasurementList
@@ -47,47 +71,160 @@ func (s *DataStore) InsertKlabDataBulk(ctx context.Context, payloads []KlabDataP
 	for _, b := range payloads {
 		// 1) Customers
 		for _, c := range b.Customers {
-			if err := q.UpsertCustomer(ctx, c); err != nil {
+			if _, err := q.UpsertCustomer(ctx, c); err != nil {
 				return err
 			}
 		}
 
 		// 2) Measurements
 		for _, m := range b.Measurements {
-			if err := q.InsertMeasurement(ctx, m); err != nil {
+			if _, err := q.InsertMeasurement(ctx, m); err != nil {
 				return err
 			}
 		}
 
 		// 3) Child tables
 		for _, t := range b.DirTests {
-			if err := q.InsertDirTest(ctx, t); err != nil {
+			if _, err := q.InsertDirTest(ctx, t); err != nil {
 				return err
 			}
 		}
-		for _, st := range b.DirTestSteps {
-			if err := q.InsertDirTestStep(ctx, st); err != nil {
+		// Test steps and raw data are loaded with COPY past the threshold
+		if utils.UseCopy(len(b.DirTestSteps)) {
+			if err := utils.CopyMerge(ctx, tx, dirTestStepsCopy, b.DirTestSteps, dirTestStepCopyKey, dirTestStepCopyValues); err != nil {
 				return err
 			}
+		} else {
+			for _, st := range b.DirTestSteps {
+				if _, err := q.InsertDirTestStep(ctx, st); err != nil {
+					return err
+				}
+			}
 		}
-		for _, rd := range b.DirRawData {
-			if err := q.InsertDirRawData(ctx, rd); err != nil {
+		if utils.UseCopy(len(b.DirRawData)) {
+			if err := utils.CopyMerge(ctx, tx, dirRawDataCopy, b.DirRawData, dirRawDataCopyKey, dirRawDataCopyValues); err != nil {
 				return err
 			}
+		} else {
+			for _, rd := range b.DirRawData {
+				if _, err := q.InsertDirRawData(ctx, rd); err != nil {
+					return err
+				}
+			}
 		}
 		for _, rp := range b.DirReports {
-			if err := q.InsertDirReport(ctx, rp); err != nil {
+			if _, err := q.InsertDirReport(ctx, rp); err != nil {
 				return err
 			}
 		}
 		for _, rs := range b.DirResults {
-			if err := q.InsertDirResults(ctx, rs); err != nil {
+			if _, err := q.InsertDirResults(ctx, rs); err != nil {
 				return err
 			}
 		}
 	}
 
-	return tx.Commit()
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	var customers []klabsqlc.UpsertCustomerParams
+	records := 0
+	for _, b := range payloads {
+		customers = append(customers, b.Customers...)
+		records += len(b.Customers) + len(b.Measurements) + len(b.DirTests) + len(b.DirTestSteps) +
+			len(b.DirRawData) + len(b.DirReports) + len(b.DirResults)
+	}
+	emitData(ctx, customers, records)
+	return nil
+}
+
+// InsertKlabDataPartial writes every row on its own, parents before
+// children, and reports the outcome of each, see utils.WriteEach. Rows
+// referring to a measurement that failed fail as well.
+func (s *DataStore) InsertKlabDataPartial(ctx context.Context, items KlabDataItems) (KlabDataResults, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	var res KlabDataResults
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return res, err
+	}
+	defer tx.Rollback()
+
+	q := klabsqlc.New(tx)
+
+	if res.Customers, err = utils.WriteEach(ctx, tx, items.Customers, func(c klabsqlc.UpsertCustomerParams) (bool, error) {
+		return q.UpsertCustomer(ctx, c)
+	}); err != nil {
+		return res, err
+	}
+	if res.Measurements, err = utils.WriteEach(ctx, tx, items.Measurements, func(m klabsqlc.InsertMeasurementParams) (bool, error) {
+		return q.InsertMeasurement(ctx, m)
+	}); err != nil {
+		return res, err
+	}
+	if res.DirTests, err = utils.WriteEach(ctx, tx, items.DirTests, func(t klabsqlc.InsertDirTestParams) (bool, error) {
+		return q.InsertDirTest(ctx, t)
+	}); err != nil {
+		return res, err
+	}
+	if res.DirTestSteps, err = utils.WriteEach(ctx, tx, items.DirTestSteps, func(st klabsqlc.InsertDirTestStepParams) (bool, error) {
+		return q.InsertDirTestStep(ctx, st)
+	}); err != nil {
+		return res, err
+	}
+	if res.DirRawData, err = utils.WriteEach(ctx, tx, items.DirRawData, func(rd klabsqlc.InsertDirRawDataParams) (bool, error) {
+		return q.InsertDirRawData(ctx, rd)
+	}); err != nil {
+		return res, err
+	}
+	if res.DirReports, err = utils.WriteEach(ctx, tx, items.DirReports, func(rp klabsqlc.InsertDirReportParams) (bool, error) {
+		return q.InsertDirReport(ctx, rp)
+	}); err != nil {
+		return res, err
+	}
+	if res.DirResults, err = utils.WriteEach(ctx, tx, items.DirResults, func(rs klabsqlc.InsertDirResultsParams) (bool, error) {
+		return q.InsertDirResults(ctx, rs)
+	}); err != nil {
+		return res, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return res, err
+	}
+
+	records := 0
+	for _, results := range [][]utils.BulkItemResult{res.Customers, res.Measurements, res.DirTests,
+		res.DirTestSteps, res.DirRawData, res.DirReports, res.DirResults} {
+		for _, r := range results {
+			if r.Status == utils.BulkInserted || r.Status == utils.BulkUpdated {
+				records++
+			}
+		}
+	}
+	customers := make([]klabsqlc.UpsertCustomerParams, len(items.Customers))
+	for i, c := range items.Customers {
+		customers[i] = c.Value
+	}
+	emitData(ctx, customers, records)
+	return res, nil
+}
+
+// emitData reports a committed write of records rows of the bundles of
+// customers. k-Lab events identify athletes by Sportti ID.
+func emitData(ctx context.Context, customers []klabsqlc.UpsertCustomerParams, records int) {
+	events.Emit(ctx, events.Event{
+		Domain:    "klab",
+		Entity:    "data",
+		Operation: events.Upsert,
+		AthleteIDs: events.Collect(customers, func(c klabsqlc.UpsertCustomerParams) string {
+			return c.SporttiID.String
+		}),
+		Records: records,
+	})
 }
 
 func (s *DataStore) GetCustomerByID(ctx context.Context, idcustomer int32) (klabsqlc.Customer, error) {
@@ -98,7 +235,7 @@ func (s *DataStore) GetCustomerByID(ctx context.Context, idcustomer int32) (klab
 	return queries.GetCustomerByID(ctx, idcustomer)
 }
 
-func (s *DataStore) GetDataByCustomerIDNoCustomer(ctx context.Context, idcustomer int32) (*KlabDataNoCustomerResponse, error) {
+func (s *DataStore) GetDataByCustomerIDNoCustomer(ctx context.Context, idcustomer int32, page utils.Page) (*KlabDataNoCustomerResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
 	defer cancel()
 
@@ -110,11 +247,18 @@ func (s *DataStore) GetDataByCustomerIDNoCustomer(ctx context.Context, idcustome
 
 	q := klabsqlc.New(s.db)
 
-	// Get all measurements for the customer
-	meas, err := q.GetMeasurementsByCustomer(ctx, idcustomer)
+	// Get one page of measurements for the customer
+	meas, err := q.GetMeasurementsByCustomer(ctx, klabsqlc.GetMeasurementsByCustomerParams{
+		Idcustomer:         idcustomer,
+		AfterIdmeasurement: page.AfterN(),
+		PageLimit:          page.FetchLimit(),
+	})
 	if err != nil {
 		return nil, err
 	}
+	meas, pageInfo := utils.NextPage(meas, page, func(m klabsqlc.MeasurementList) utils.Cursor {
+		return utils.IntCursor(int64(m.Idmeasurement))
+	})
 
 	// Collect measurement IDs for bulk fetches
 	mids := make([]int32, 0, len(meas))
@@ -187,6 +331,7 @@ func (s *DataStore) GetDataByCustomerIDNoCustomer(ctx context.Context, idcustome
 		DirReports:   cleanReports,
 		DirRawData:   cleanRawData,
 		DirResults:   cleanResults,
+		Pagination:   pageInfo,
 	}, nil
 }
 
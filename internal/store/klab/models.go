@@ -4,6 +4,7 @@ import (
 	"time"
 
 	klabsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/klab"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
 )
 
 // Clean response types (what you want in JSON)
@@ -281,6 +282,7 @@ type KlabDataNoCustomerResponse struct {
 	DirReports   []KlabDirReportResponse   `json:"dirreport"`
 	DirRawData   []KlabDirRawDataResponse  `json:"dirrawdata"`
 	DirResults   []KlabDirResultsResponse  `json:"dirresults"`
+	Pagination   utils.PageInfo            `json:"pagination"`
 }
 
 func convertMeasurement(m klabsqlc.MeasurementList) KlabMeasurementResponse {
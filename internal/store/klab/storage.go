@@ -5,6 +5,7 @@ import (
 	"database/sql"
 
 	klabsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/klab"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
 )
 
 // Interfaces
@@ -12,11 +13,13 @@ type Users interface {
 	GetCustomerByID(ctx context.Context, idcustomer int32) (klabsqlc.Customer, error)
 	GetCustomerIDBySporttiID(ctx context.Context, sporttiID string) (int32, error)
 	DeleteUserBySporttiID(ctx context.Context, sporttiID string) (string, error)
+	ListCustomerIdentities(ctx context.Context) ([]klabsqlc.ListCustomerIdentitiesRow, error)
 }
 
 type Data interface {
 	InsertKlabDataBulk(ctx context.Context, payloads []KlabDataPayload) error
-	GetDataByCustomerIDNoCustomer(ctx context.Context, idcustomer int32) (*KlabDataNoCustomerResponse, error)
+	InsertKlabDataPartial(ctx context.Context, items KlabDataItems) (KlabDataResults, error)
+	GetDataByCustomerIDNoCustomer(ctx context.Context, idcustomer int32, page utils.Page) (*KlabDataNoCustomerResponse, error)
 	GetCustomerIDBySporttiID(ctx context.Context, sporttiID string) (int32, error)
 }
 
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 
 	klabsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/klab"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
 )
 
@@ -47,8 +48,19 @@ func (s *UsersStore) DeleteUserBySporttiID(ctx context.Context, sporttiID string
 		return "", err
 	}
 
+	events.Emit(ctx, events.New("klab", "users", events.Delete, 1, sid))
 	if row.SporttiID.Valid {
 		return row.SporttiID.String, nil
 	}
 	return sid, nil
 }
+
+// ListCustomerIdentities returns the name and birthdate of the
+// customers with a sportti_id, for matching them to FIS competitors
+func (s *UsersStore) ListCustomerIdentities(ctx context.Context) ([]klabsqlc.ListCustomerIdentitiesRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.BulkQueryTimeout)
+	defer cancel()
+
+	q := klabsqlc.New(s.db)
+	return q.ListCustomerIdentities(ctx)
+}
@@ -25,6 +25,9 @@ type FIS interface {
 	ResultJP() fis.Resultjp
 	ResultNK() fis.Resultnk
 	Athlete() fis.Athlete
+	Changes() fis.Changes
+	Calendar() fis.Calendar
+	AthleteLinks() fis.AthleteLinks
 }
 
 type UTV interface {
@@ -48,6 +51,11 @@ type Auth interface {
 	Ping(ctx context.Context) error
 	IssueToken(ctx context.Context, clientToken, ip, userAgent string) (*auth.Tokens, error)
 	RefreshToken(ctx context.Context, refreshToken, ip, userAgent string) (string, error)
+	ExportJobs() auth.ExportJobs
+	IngestJobs() auth.IngestJobs
+	IdempotencyKeys() auth.IdempotencyKeys
+	Webhooks() auth.Webhooks
+	CalendarFeeds() auth.CalendarFeeds
 }
 
 type Tietoevry interface {
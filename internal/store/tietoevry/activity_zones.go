@@ -80,18 +80,42 @@ func (s *ActivityZonesStore) InsertActivityZonesBulk(ctx context.Context, zones
 	q := tietoevrysqlc.New(tx)
 
 	for _, z := range zones {
-		if err := q.InsertActivityZone(ctx, z); err != nil {
+		if _, err := q.InsertActivityZone(ctx, z); err != nil {
 			return err
 		}
 	}
 
-	return tx.Commit()
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	emitBulk(ctx, "activity_zones", zones, func(p tietoevrysqlc.InsertActivityZoneParams) uuid.UUID { return p.UserID })
+	return nil
+}
+
+// InsertActivityZonesPartial writes every item on its own and reports the outcome of each,
+// see utils.WriteEach
+func (s *ActivityZonesStore) InsertActivityZonesPartial(ctx context.Context, zones []utils.BulkItem[tietoevrysqlc.InsertActivityZoneParams]) ([]utils.BulkItemResult, error) {
+	return writeEach(ctx, s.db, "activity_zones", zones,
+		func(p tietoevrysqlc.InsertActivityZoneParams) uuid.UUID { return p.UserID },
+		func(ctx context.Context, q *tietoevrysqlc.Queries, p tietoevrysqlc.InsertActivityZoneParams) (bool, error) {
+			return q.InsertActivityZone(ctx, p)
+		},
+	)
 }
 
-func (s *ActivityZonesStore) GetActivityZonesByUser(ctx context.Context, userID uuid.UUID) ([]tietoevrysqlc.ActivityZone, error) {
+func (s *ActivityZonesStore) GetActivityZonesByUser(ctx context.Context, userID uuid.UUID, filter ListFilter, page utils.Page) ([]tietoevrysqlc.ActivityZone, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.BulkQueryTimeout)
 	defer cancel()
 
 	q := tietoevrysqlc.New(s.db)
-	return q.GetActivityZonesByUser(ctx, userID)
+	return q.GetActivityZonesByUser(ctx, tietoevrysqlc.GetActivityZonesByUserParams{
+		UserID:      userID,
+		AfterDate:   page.AfterTime(),
+		AfterSource: page.AfterKey(),
+		FromDate:    filter.fromDate(),
+		ToDate:      filter.toDate(),
+		Source:      utils.NullStringPtr(filter.Source),
+		PageLimit:   page.FetchLimit(),
+	})
 }
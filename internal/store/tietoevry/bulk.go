@@ -0,0 +1,97 @@
+package tietoevry
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	tietoevrysqlc "github.com/DeRuina/KUHA-REST-API/internal/db/tietoevry"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// existingUsers returns the subset of userIDs found in the users table
+func existingUsers(ctx context.Context, db *sql.DB, userIDs []uuid.UUID) (map[uuid.UUID]bool, error) {
+	rows, err := db.QueryContext(ctx, `SELECT id FROM users WHERE id = ANY($1)`, pq.Array(userIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate users: %w", err)
+	}
+	defer rows.Close()
+
+	existing := make(map[uuid.UUID]bool)
+	for rows.Next() {
+		var userID uuid.UUID
+		if err := rows.Scan(&userID); err != nil {
+			return nil, fmt.Errorf("failed to scan user ID: %w", err)
+		}
+		existing[userID] = true
+	}
+	return existing, rows.Err()
+}
+
+// writeEach is the partial mode of the bulk inserts: items of unknown
+// users fail up front and the others are written one by one in a single
+// transaction, see utils.WriteEach
+func writeEach[T any](
+	ctx context.Context,
+	db *sql.DB,
+	entity string,
+	items []utils.BulkItem[T],
+	userOf func(T) uuid.UUID,
+	write func(ctx context.Context, q *tietoevrysqlc.Queries, item T) (bool, error),
+) ([]utils.BulkItemResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.BulkQueryTimeout)
+	defer cancel()
+
+	userIDs := make([]uuid.UUID, len(items))
+	for i, item := range items {
+		userIDs[i] = userOf(item.Value)
+	}
+	existing, err := existingUsers(ctx, db, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []utils.BulkItemResult
+	known := make([]utils.BulkItem[T], 0, len(items))
+	for _, item := range items {
+		if !existing[userOf(item.Value)] {
+			results = append(results, utils.BulkFailure(item.Index, utils.ErrUserNotFound))
+			continue
+		}
+		known = append(known, item)
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	q := tietoevrysqlc.New(tx)
+	written, err := utils.WriteEach(ctx, tx, known, func(item T) (bool, error) {
+		return write(ctx, q, item)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	emitBulk(ctx, entity, events.Written(known, written), userOf)
+	return append(results, written...), nil
+}
+
+// emitBulk reports the records of a committed bulk write of entity
+func emitBulk[T any](ctx context.Context, entity string, records []T, userOf func(T) uuid.UUID) {
+	events.Emit(ctx, events.Event{
+		Domain:     "tietoevry",
+		Entity:     entity,
+		Operation:  events.Upsert,
+		AthleteIDs: events.Collect(records, userOf),
+		Records:    len(records),
+	})
+}
@@ -0,0 +1,128 @@
+package tietoevry
+
+import (
+	tietoevrysqlc "github.com/DeRuina/KUHA-REST-API/internal/db/tietoevry"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// COPY loading of the tables that receive the largest bulk inserts, see
+// utils.CopyMerge. Columns and ON CONFLICT clauses mirror InsertMeasurement,
+// InsertExerciseHRZone, InsertExerciseSample and InsertExerciseSection in
+// queries.sql and must be kept in sync with them.
+
+var measurementsCopy = utils.CopyTable{
+	Table: "measurements",
+	Columns: []string{
+		"id", "created_at", "updated_at", "user_id", "date", "name", "name_type",
+		"source", "value", "value_numeric", "comment", "raw_id", "raw_data", "additional_info",
+	},
+	OnConflict: `ON CONFLICT (id) DO UPDATE SET
+  updated_at     = GREATEST(measurements.updated_at, EXCLUDED.updated_at),
+  name_type      = EXCLUDED.name_type,
+  value          = EXCLUDED.value,
+  value_numeric  = EXCLUDED.value_numeric,
+  comment        = EXCLUDED.comment,
+  raw_data       = EXCLUDED.raw_data,
+  additional_info= EXCLUDED.additional_info`,
+}
+
+func measurementCopyKey(m tietoevrysqlc.InsertMeasurementParams) any {
+	return m.ID
+}
+
+func measurementCopyValues(m tietoevrysqlc.InsertMeasurementParams) []any {
+	return []any{
+		m.ID, m.CreatedAt, m.UpdatedAt, m.UserID, m.Date, m.Name, m.NameType,
+		m.Source, m.Value, m.ValueNumeric, m.Comment, m.RawID,
+		utils.CopyJSON(m.RawData), utils.CopyJSON(m.AdditionalInfo),
+	}
+}
+
+var exerciseHRZonesCopy = utils.CopyTable{
+	Table: "exercise_hr_zones",
+	Columns: []string{
+		"exercise_id", "zone_index", "seconds_in_zone",
+		"lower_limit", "upper_limit", "created_at", "updated_at",
+	},
+	OnConflict: `ON CONFLICT (exercise_id, zone_index) DO UPDATE SET
+  seconds_in_zone = EXCLUDED.seconds_in_zone,
+  lower_limit     = EXCLUDED.lower_limit,
+  upper_limit     = EXCLUDED.upper_limit,
+  updated_at      = GREATEST(exercise_hr_zones.updated_at, EXCLUDED.updated_at)`,
+}
+
+type exerciseHRZoneKey struct {
+	ExerciseID uuid.UUID
+	ZoneIndex  int32
+}
+
+func exerciseHRZoneCopyKey(z tietoevrysqlc.InsertExerciseHRZoneParams) any {
+	return exerciseHRZoneKey{z.ExerciseID, z.ZoneIndex}
+}
+
+func exerciseHRZoneCopyValues(z tietoevrysqlc.InsertExerciseHRZoneParams) []any {
+	return []any{
+		z.ExerciseID, z.ZoneIndex, z.SecondsInZone,
+		z.LowerLimit, z.UpperLimit, z.CreatedAt, z.UpdatedAt,
+	}
+}
+
+var exerciseSamplesCopy = utils.CopyTable{
+	Table: "exercise_samples",
+	Columns: []string{
+		"id", "user_id", "exercise_id",
+		"sample_type", "recording_rate", "samples", "source",
+	},
+	OnConflict: `ON CONFLICT (exercise_id, sample_type) DO UPDATE SET
+    recording_rate = EXCLUDED.recording_rate,
+    samples = EXCLUDED.samples`,
+}
+
+type exerciseSampleKey struct {
+	ExerciseID uuid.UUID
+	SampleType string
+}
+
+func exerciseSampleCopyKey(s tietoevrysqlc.InsertExerciseSampleParams) any {
+	return exerciseSampleKey{s.ExerciseID, s.SampleType}
+}
+
+func exerciseSampleCopyValues(s tietoevrysqlc.InsertExerciseSampleParams) []any {
+	return []any{
+		s.ID, s.UserID, s.ExerciseID,
+		s.SampleType, s.RecordingRate, pq.Array(s.Samples), s.Source,
+	}
+}
+
+var exerciseSectionsCopy = utils.CopyTable{
+	Table: "exercise_sections",
+	Columns: []string{
+		"id", "user_id", "exercise_id",
+		"created_at", "updated_at", "start_time", "end_time",
+		"section_type", "name", "comment", "source", "raw_id", "raw_data",
+	},
+	OnConflict: `ON CONFLICT (id) DO UPDATE SET
+  exercise_id = EXCLUDED.exercise_id,
+  updated_at  = GREATEST(exercise_sections.updated_at, EXCLUDED.updated_at),
+  start_time  = EXCLUDED.start_time,
+  end_time    = EXCLUDED.end_time,
+  section_type= EXCLUDED.section_type,
+  name        = EXCLUDED.name,
+  comment     = EXCLUDED.comment,
+  raw_data    = EXCLUDED.raw_data`,
+}
+
+func exerciseSectionCopyKey(s tietoevrysqlc.InsertExerciseSectionParams) any {
+	return s.ID
+}
+
+func exerciseSectionCopyValues(s tietoevrysqlc.InsertExerciseSectionParams) []any {
+	return []any{
+		s.ID, s.UserID, s.ExerciseID,
+		s.CreatedAt, s.UpdatedAt, s.StartTime, s.EndTime,
+		s.SectionType, s.Name, s.Comment, s.Source, s.RawID,
+		utils.CopyJSON(s.RawData),
+	}
+}
@@ -18,6 +18,20 @@ type ExercisePayload struct {
 	Sections []tietoevrysqlc.InsertExerciseSectionParams
 }
 
+// ExerciseIncludes selects the child rows GetExerciseDetails loads
+type ExerciseIncludes struct {
+	HRZones  bool
+	Samples  bool
+	Sections bool
+}
+
+// ExerciseDetails holds the child rows of a page of exercises keyed by exercise ID
+type ExerciseDetails struct {
+	HRZones  map[uuid.UUID][]tietoevrysqlc.ExerciseHrZone
+	Samples  map[uuid.UUID][]tietoevrysqlc.ExerciseSample
+	Sections map[uuid.UUID][]tietoevrysqlc.ExerciseSection
+}
+
 type ExercisesStore struct {
 	db *sql.DB
 }
@@ -87,57 +101,162 @@ func (s *ExercisesStore) InsertExercisesBulk(ctx context.Context, exercises []Ex
 
 	q := tietoevrysqlc.New(tx)
 
+	// Each child table is measured on its own: past the COPY threshold its
+	// rows of the request are loaded after all exercises in one COPY
+	var hrZones []tietoevrysqlc.InsertExerciseHRZoneParams
+	var samples []tietoevrysqlc.InsertExerciseSampleParams
+	var sections []tietoevrysqlc.InsertExerciseSectionParams
+	for _, exercise := range exercises {
+		hrZones = append(hrZones, exercise.HRZones...)
+		samples = append(samples, exercise.Samples...)
+		sections = append(sections, exercise.Sections...)
+	}
+	copyHRZones := utils.UseCopy(len(hrZones))
+	copySamples := utils.UseCopy(len(samples))
+	copySections := utils.UseCopy(len(sections))
+
 	for _, exercise := range exercises {
-		// Insert base exercise
-		if err := q.InsertExercise(ctx, exercise.Exercise); err != nil {
+		if copyHRZones {
+			exercise.HRZones = nil
+		}
+		if copySamples {
+			exercise.Samples = nil
+		}
+		if copySections {
+			exercise.Sections = nil
+		}
+		if _, err := insertExercise(ctx, q, exercise); err != nil {
 			return err
 		}
+	}
 
-		//Insert HR zones
-		for _, zone := range exercise.HRZones {
-			if err := q.InsertExerciseHRZone(ctx, zone); err != nil {
-				return err
-			}
+	if copyHRZones {
+		if err := utils.CopyMerge(ctx, tx, exerciseHRZonesCopy, hrZones, exerciseHRZoneCopyKey, exerciseHRZoneCopyValues); err != nil {
+			return err
 		}
-
-		// Insert samples
-		for _, sample := range exercise.Samples {
-			if err := q.InsertExerciseSample(ctx, sample); err != nil {
-				return err
-			}
+	}
+	if copySamples {
+		if err := utils.CopyMerge(ctx, tx, exerciseSamplesCopy, samples, exerciseSampleCopyKey, exerciseSampleCopyValues); err != nil {
+			return err
 		}
-
-		// Insert sections
-		for _, section := range exercise.Sections {
-			if err := q.InsertExerciseSection(ctx, section); err != nil {
-				return err
-			}
+	}
+	if copySections {
+		if err := utils.CopyMerge(ctx, tx, exerciseSectionsCopy, sections, exerciseSectionCopyKey, exerciseSectionCopyValues); err != nil {
+			return err
 		}
 	}
 
-	return tx.Commit()
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	emitBulk(ctx, "exercises", exercises, func(p ExercisePayload) uuid.UUID { return p.Exercise.UserID })
+	return nil
 }
 
-func (s *ExercisesStore) GetExercisesByUser(ctx context.Context, userID uuid.UUID) ([]tietoevrysqlc.Exercise, error) {
-	ctx, cancel := context.WithTimeout(ctx, utils.BulkQueryTimeout)
-	defer cancel()
-	return tietoevrysqlc.New(s.db).GetExercisesByUser(ctx, userID)
+// InsertExercisesPartial writes every exercise with its child rows on its
+// own and reports the outcome of each, see utils.WriteEach
+func (s *ExercisesStore) InsertExercisesPartial(ctx context.Context, exercises []utils.BulkItem[ExercisePayload]) ([]utils.BulkItemResult, error) {
+	return writeEach(ctx, s.db, "exercises", exercises,
+		func(p ExercisePayload) uuid.UUID { return p.Exercise.UserID },
+		insertExercise,
+	)
 }
 
-func (s *ExercisesStore) GetExerciseHRZones(ctx context.Context, id uuid.UUID) ([]tietoevrysqlc.ExerciseHrZone, error) {
-	ctx, cancel := context.WithTimeout(ctx, utils.BulkQueryTimeout)
-	defer cancel()
-	return tietoevrysqlc.New(s.db).GetExerciseHRZones(ctx, id)
+// insertExercise writes an exercise and its child rows and reports whether
+// the exercise is new
+func insertExercise(ctx context.Context, q *tietoevrysqlc.Queries, exercise ExercisePayload) (bool, error) {
+	// Insert base exercise
+	inserted, err := q.InsertExercise(ctx, exercise.Exercise)
+	if err != nil {
+		return false, err
+	}
+
+	//Insert HR zones
+	for _, zone := range exercise.HRZones {
+		if err := q.InsertExerciseHRZone(ctx, zone); err != nil {
+			return false, err
+		}
+	}
+
+	// Insert samples
+	for _, sample := range exercise.Samples {
+		if err := q.InsertExerciseSample(ctx, sample); err != nil {
+			return false, err
+		}
+	}
+
+	// Insert sections
+	for _, section := range exercise.Sections {
+		if err := q.InsertExerciseSection(ctx, section); err != nil {
+			return false, err
+		}
+	}
+
+	return inserted, nil
 }
 
-func (s *ExercisesStore) GetExerciseSamples(ctx context.Context, id uuid.UUID) ([]tietoevrysqlc.ExerciseSample, error) {
+func (s *ExercisesStore) GetExercisesByUser(ctx context.Context, userID uuid.UUID, filter ListFilter, page utils.Page) ([]tietoevrysqlc.Exercise, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.BulkQueryTimeout)
 	defer cancel()
-	return tietoevrysqlc.New(s.db).GetExerciseSamples(ctx, id)
+	return tietoevrysqlc.New(s.db).GetExercisesByUser(ctx, tietoevrysqlc.GetExercisesByUserParams{
+		UserID:    userID,
+		AfterTime: page.AfterTime(),
+		AfterID:   page.AfterID(),
+		FromTime:  filter.fromTime(),
+		ToTime:    filter.toTime(),
+		Source:    utils.NullStringPtr(filter.Source),
+		SportType: utils.NullStringPtr(filter.SportType),
+		PageLimit: page.FetchLimit(),
+	})
 }
 
-func (s *ExercisesStore) GetExerciseSections(ctx context.Context, id uuid.UUID) ([]tietoevrysqlc.ExerciseSection, error) {
+// GetExerciseDetails loads the requested child rows of a page of exercises
+// with one query per child table, grouped by exercise ID
+func (s *ExercisesStore) GetExerciseDetails(ctx context.Context, ids []uuid.UUID, include ExerciseIncludes) (ExerciseDetails, error) {
+	details := ExerciseDetails{
+		HRZones:  make(map[uuid.UUID][]tietoevrysqlc.ExerciseHrZone),
+		Samples:  make(map[uuid.UUID][]tietoevrysqlc.ExerciseSample),
+		Sections: make(map[uuid.UUID][]tietoevrysqlc.ExerciseSection),
+	}
+	if len(ids) == 0 {
+		return details, nil
+	}
+
 	ctx, cancel := context.WithTimeout(ctx, utils.BulkQueryTimeout)
 	defer cancel()
-	return tietoevrysqlc.New(s.db).GetExerciseSections(ctx, id)
+
+	q := tietoevrysqlc.New(s.db)
+
+	if include.HRZones {
+		zones, err := q.GetExerciseHRZonesByExerciseIDs(ctx, ids)
+		if err != nil {
+			return details, fmt.Errorf("hr zones: %w", err)
+		}
+		for _, z := range zones {
+			details.HRZones[z.ExerciseID] = append(details.HRZones[z.ExerciseID], z)
+		}
+	}
+
+	if include.Samples {
+		samples, err := q.GetExerciseSamplesByExerciseIDs(ctx, ids)
+		if err != nil {
+			return details, fmt.Errorf("samples: %w", err)
+		}
+		for _, smp := range samples {
+			details.Samples[smp.ExerciseID] = append(details.Samples[smp.ExerciseID], smp)
+		}
+	}
+
+	if include.Sections {
+		sections, err := q.GetExerciseSectionsByExerciseIDs(ctx, ids)
+		if err != nil {
+			return details, fmt.Errorf("sections: %w", err)
+		}
+		for _, sec := range sections {
+			details.Sections[sec.ExerciseID] = append(details.Sections[sec.ExerciseID], sec)
+		}
+	}
+
+	return details, nil
 }
@@ -0,0 +1,43 @@
+package tietoevry
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
+)
+
+// ListFilter narrows the Get*ByUser reads. From and To are inclusive days;
+// fields a resource does not have are ignored. Timestamp columns are
+// compared with the UTC bounds of the days, so the result does not depend
+// on the time zone of the database session.
+type ListFilter struct {
+	From             *time.Time
+	To               *time.Time
+	Source           *string
+	SportType        *string // exercises
+	Name             *string // measurements
+	Symptom          *string // symptoms
+	QuestionnaireKey *string // questionnaires
+}
+
+func (f ListFilter) fromDate() sql.NullTime { return utils.NullTimeIfEmpty(f.From) }
+func (f ListFilter) toDate() sql.NullTime   { return utils.NullTimeIfEmpty(f.To) }
+
+// fromTime is the start of the From day in UTC
+func (f ListFilter) fromTime() sql.NullTime {
+	if f.From == nil {
+		return sql.NullTime{}
+	}
+	y, m, d := f.From.Date()
+	return sql.NullTime{Time: time.Date(y, m, d, 0, 0, 0, 0, time.UTC), Valid: true}
+}
+
+// toTime is the end of the To day in UTC, exclusive
+func (f ListFilter) toTime() sql.NullTime {
+	if f.To == nil {
+		return sql.NullTime{}
+	}
+	y, m, d := f.To.Date()
+	return sql.NullTime{Time: time.Date(y, m, d+1, 0, 0, 0, 0, time.UTC), Valid: true}
+}
@@ -63,6 +63,8 @@ func (s *MeasurementsStore) ValidateUsersExist(ctx context.Context, userIDs []uu
 	return nil
 }
 
+// InsertMeasurementsBulk writes all measurements in one transaction, with
+// COPY from utils.CopyThreshold rows
 func (s *MeasurementsStore) InsertMeasurementsBulk(ctx context.Context, measurements []tietoevrysqlc.InsertMeasurementParams) error {
 	ctx, cancel := context.WithTimeout(ctx, utils.BulkQueryTimeout)
 	defer cancel()
@@ -73,20 +75,51 @@ func (s *MeasurementsStore) InsertMeasurementsBulk(ctx context.Context, measurem
 	}
 	defer tx.Rollback()
 
-	q := tietoevrysqlc.New(tx)
-	for _, m := range measurements {
-		if err := q.InsertMeasurement(ctx, m); err != nil {
+	if utils.UseCopy(len(measurements)) {
+		if err := utils.CopyMerge(ctx, tx, measurementsCopy, measurements, measurementCopyKey, measurementCopyValues); err != nil {
 			return err
 		}
+	} else {
+		q := tietoevrysqlc.New(tx)
+		for _, m := range measurements {
+			if _, err := q.InsertMeasurement(ctx, m); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
 	}
 
-	return tx.Commit()
+	emitBulk(ctx, "measurements", measurements, func(p tietoevrysqlc.InsertMeasurementParams) uuid.UUID { return p.UserID })
+	return nil
+}
+
+// InsertMeasurementsPartial writes every item on its own and reports the outcome of each,
+// see utils.WriteEach
+func (s *MeasurementsStore) InsertMeasurementsPartial(ctx context.Context, measurements []utils.BulkItem[tietoevrysqlc.InsertMeasurementParams]) ([]utils.BulkItemResult, error) {
+	return writeEach(ctx, s.db, "measurements", measurements,
+		func(p tietoevrysqlc.InsertMeasurementParams) uuid.UUID { return p.UserID },
+		func(ctx context.Context, q *tietoevrysqlc.Queries, p tietoevrysqlc.InsertMeasurementParams) (bool, error) {
+			return q.InsertMeasurement(ctx, p)
+		},
+	)
 }
 
-func (s *MeasurementsStore) GetMeasurementsByUser(ctx context.Context, userID uuid.UUID) ([]tietoevrysqlc.Measurement, error) {
+func (s *MeasurementsStore) GetMeasurementsByUser(ctx context.Context, userID uuid.UUID, filter ListFilter, page utils.Page) ([]tietoevrysqlc.Measurement, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.BulkQueryTimeout)
 	defer cancel()
 
 	q := tietoevrysqlc.New(s.db)
-	return q.GetMeasurementsByUser(ctx, userID)
+	return q.GetMeasurementsByUser(ctx, tietoevrysqlc.GetMeasurementsByUserParams{
+		UserID:    userID,
+		AfterDate: page.AfterTime(),
+		AfterID:   page.AfterID(),
+		FromDate:  filter.fromDate(),
+		ToDate:    filter.toDate(),
+		Source:    utils.NullStringPtr(filter.Source),
+		Name:      utils.NullStringPtr(filter.Name),
+		PageLimit: page.FetchLimit(),
+	})
 }
@@ -80,18 +80,43 @@ func (s *QuestionnairesStore) InsertQuestionnaireAnswersBulk(ctx context.Context
 	q := tietoevrysqlc.New(tx)
 
 	for _, a := range answers {
-		if err := q.InsertQuestionnaireAnswer(ctx, a); err != nil {
+		if _, err := q.InsertQuestionnaireAnswer(ctx, a); err != nil {
 			return err
 		}
 	}
 
-	return tx.Commit()
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	emitBulk(ctx, "questionnaires", answers, func(p tietoevrysqlc.InsertQuestionnaireAnswerParams) uuid.UUID { return p.UserID })
+	return nil
+}
+
+// InsertQuestionnaireAnswersPartial writes every item on its own and reports the outcome of each,
+// see utils.WriteEach
+func (s *QuestionnairesStore) InsertQuestionnaireAnswersPartial(ctx context.Context, answers []utils.BulkItem[tietoevrysqlc.InsertQuestionnaireAnswerParams]) ([]utils.BulkItemResult, error) {
+	return writeEach(ctx, s.db, "questionnaires", answers,
+		func(p tietoevrysqlc.InsertQuestionnaireAnswerParams) uuid.UUID { return p.UserID },
+		func(ctx context.Context, q *tietoevrysqlc.Queries, p tietoevrysqlc.InsertQuestionnaireAnswerParams) (bool, error) {
+			return q.InsertQuestionnaireAnswer(ctx, p)
+		},
+	)
 }
 
-func (s *QuestionnairesStore) GetQuestionnairesByUser(ctx context.Context, userID uuid.UUID) ([]tietoevrysqlc.QuestionAnswer, error) {
+func (s *QuestionnairesStore) GetQuestionnairesByUser(ctx context.Context, userID uuid.UUID, filter ListFilter, page utils.Page) ([]tietoevrysqlc.QuestionAnswer, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.BulkQueryTimeout)
 	defer cancel()
 
 	q := tietoevrysqlc.New(s.db)
-	return q.GetQuestionnairesByUser(ctx, userID)
+	return q.GetQuestionnairesByUser(ctx, tietoevrysqlc.GetQuestionnairesByUserParams{
+		UserID:                       userID,
+		AfterCreatedAt:               page.AfterTime(),
+		AfterQuestionnaireInstanceID: page.AfterID(),
+		AfterQuestionID:              page.AfterSubID(),
+		FromTime:                     filter.fromTime(),
+		ToTime:                       filter.toTime(),
+		QuestionnaireKey:             utils.NullStringPtr(filter.QuestionnaireKey),
+		PageLimit:                    page.FetchLimit(),
+	})
 }
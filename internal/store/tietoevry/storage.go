@@ -5,6 +5,7 @@ import (
 	"database/sql"
 
 	tietoevrysqlc "github.com/DeRuina/KUHA-REST-API/internal/db/tietoevry"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
 	"github.com/google/uuid"
 )
 
@@ -16,45 +17,50 @@ type Users interface {
 	LogDeletedUser(ctx context.Context, userID uuid.UUID) error
 	DeleteUserWithLogging(ctx context.Context, userID uuid.UUID) (int64, error)
 	GetDeletedUsers(ctx context.Context) ([]tietoevrysqlc.DeletedUsersLog, error)
+	ListUserIdentities(ctx context.Context) ([]tietoevrysqlc.ListUserIdentitiesRow, error)
 }
 
 type Exercises interface {
 	ValidateUsersExist(ctx context.Context, userIDs []uuid.UUID) error
 	InsertExercisesBulk(ctx context.Context, exercises []ExercisePayload) error
-	GetExercisesByUser(ctx context.Context, userID uuid.UUID) ([]tietoevrysqlc.Exercise, error)
-	GetExerciseHRZones(ctx context.Context, id uuid.UUID) ([]tietoevrysqlc.ExerciseHrZone, error)
-	GetExerciseSamples(ctx context.Context, id uuid.UUID) ([]tietoevrysqlc.ExerciseSample, error)
-	GetExerciseSections(ctx context.Context, id uuid.UUID) ([]tietoevrysqlc.ExerciseSection, error)
+	InsertExercisesPartial(ctx context.Context, exercises []utils.BulkItem[ExercisePayload]) ([]utils.BulkItemResult, error)
+	GetExercisesByUser(ctx context.Context, userID uuid.UUID, filter ListFilter, page utils.Page) ([]tietoevrysqlc.Exercise, error)
+	GetExerciseDetails(ctx context.Context, ids []uuid.UUID, include ExerciseIncludes) (ExerciseDetails, error)
 }
 
 type Symptoms interface {
 	ValidateUsersExist(ctx context.Context, userIDs []uuid.UUID) error
 	InsertSymptomsBulk(ctx context.Context, symptoms []tietoevrysqlc.InsertSymptomParams) error
-	GetSymptomsByUser(ctx context.Context, userID uuid.UUID) ([]tietoevrysqlc.Symptom, error)
+	InsertSymptomsPartial(ctx context.Context, symptoms []utils.BulkItem[tietoevrysqlc.InsertSymptomParams]) ([]utils.BulkItemResult, error)
+	GetSymptomsByUser(ctx context.Context, userID uuid.UUID, filter ListFilter, page utils.Page) ([]tietoevrysqlc.Symptom, error)
 }
 
 type Measurements interface {
 	ValidateUsersExist(ctx context.Context, userIDs []uuid.UUID) error
 	InsertMeasurementsBulk(ctx context.Context, measurements []tietoevrysqlc.InsertMeasurementParams) error
-	GetMeasurementsByUser(ctx context.Context, userID uuid.UUID) ([]tietoevrysqlc.Measurement, error)
+	InsertMeasurementsPartial(ctx context.Context, measurements []utils.BulkItem[tietoevrysqlc.InsertMeasurementParams]) ([]utils.BulkItemResult, error)
+	GetMeasurementsByUser(ctx context.Context, userID uuid.UUID, filter ListFilter, page utils.Page) ([]tietoevrysqlc.Measurement, error)
 }
 
 type TestResults interface {
 	ValidateUsersExist(ctx context.Context, userIDs []uuid.UUID) error
 	InsertTestResultsBulk(ctx context.Context, results []tietoevrysqlc.InsertTestResultParams) error
-	GetTestResultsByUser(ctx context.Context, userID uuid.UUID) ([]tietoevrysqlc.TestResult, error)
+	InsertTestResultsPartial(ctx context.Context, results []utils.BulkItem[tietoevrysqlc.InsertTestResultParams]) ([]utils.BulkItemResult, error)
+	GetTestResultsByUser(ctx context.Context, userID uuid.UUID, filter ListFilter, page utils.Page) ([]tietoevrysqlc.TestResult, error)
 }
 
 type Questionnaires interface {
 	ValidateUsersExist(ctx context.Context, userIDs []uuid.UUID) error
 	InsertQuestionnaireAnswersBulk(ctx context.Context, answers []tietoevrysqlc.InsertQuestionnaireAnswerParams) error
-	GetQuestionnairesByUser(ctx context.Context, userID uuid.UUID) ([]tietoevrysqlc.QuestionAnswer, error)
+	InsertQuestionnaireAnswersPartial(ctx context.Context, answers []utils.BulkItem[tietoevrysqlc.InsertQuestionnaireAnswerParams]) ([]utils.BulkItemResult, error)
+	GetQuestionnairesByUser(ctx context.Context, userID uuid.UUID, filter ListFilter, page utils.Page) ([]tietoevrysqlc.QuestionAnswer, error)
 }
 
 type ActivityZones interface {
 	ValidateUsersExist(ctx context.Context, userIDs []uuid.UUID) error
 	InsertActivityZonesBulk(ctx context.Context, zones []tietoevrysqlc.InsertActivityZoneParams) error
-	GetActivityZonesByUser(ctx context.Context, userID uuid.UUID) ([]tietoevrysqlc.ActivityZone, error)
+	InsertActivityZonesPartial(ctx context.Context, zones []utils.BulkItem[tietoevrysqlc.InsertActivityZoneParams]) ([]utils.BulkItemResult, error)
+	GetActivityZonesByUser(ctx context.Context, userID uuid.UUID, filter ListFilter, page utils.Page) ([]tietoevrysqlc.ActivityZone, error)
 }
 
 // TietoevryStorage
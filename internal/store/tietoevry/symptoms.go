@@ -80,17 +80,42 @@ func (s *SymptomsStore) InsertSymptomsBulk(ctx context.Context, symptoms []tieto
 	q := tietoevrysqlc.New(tx)
 
 	for _, symptom := range symptoms {
-		if err := q.InsertSymptom(ctx, symptom); err != nil {
+		if _, err := q.InsertSymptom(ctx, symptom); err != nil {
 			return err
 		}
 	}
 
-	return tx.Commit()
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	emitBulk(ctx, "symptoms", symptoms, func(p tietoevrysqlc.InsertSymptomParams) uuid.UUID { return p.UserID })
+	return nil
+}
+
+// InsertSymptomsPartial writes every item on its own and reports the outcome of each,
+// see utils.WriteEach
+func (s *SymptomsStore) InsertSymptomsPartial(ctx context.Context, symptoms []utils.BulkItem[tietoevrysqlc.InsertSymptomParams]) ([]utils.BulkItemResult, error) {
+	return writeEach(ctx, s.db, "symptoms", symptoms,
+		func(p tietoevrysqlc.InsertSymptomParams) uuid.UUID { return p.UserID },
+		func(ctx context.Context, q *tietoevrysqlc.Queries, p tietoevrysqlc.InsertSymptomParams) (bool, error) {
+			return q.InsertSymptom(ctx, p)
+		},
+	)
 }
 
-func (s *SymptomsStore) GetSymptomsByUser(ctx context.Context, userID uuid.UUID) ([]tietoevrysqlc.Symptom, error) {
+func (s *SymptomsStore) GetSymptomsByUser(ctx context.Context, userID uuid.UUID, filter ListFilter, page utils.Page) ([]tietoevrysqlc.Symptom, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.BulkQueryTimeout)
 	defer cancel()
 
-	return tietoevrysqlc.New(s.db).GetSymptomsByUser(ctx, userID)
+	return tietoevrysqlc.New(s.db).GetSymptomsByUser(ctx, tietoevrysqlc.GetSymptomsByUserParams{
+		UserID:    userID,
+		AfterDate: page.AfterTime(),
+		AfterID:   page.AfterID(),
+		FromDate:  filter.fromDate(),
+		ToDate:    filter.toDate(),
+		Source:    utils.NullStringPtr(filter.Source),
+		Symptom:   utils.NullStringPtr(filter.Symptom),
+		PageLimit: page.FetchLimit(),
+	})
 }
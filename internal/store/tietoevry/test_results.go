@@ -80,18 +80,41 @@ func (s *TestResultsStore) InsertTestResultsBulk(ctx context.Context, results []
 	q := tietoevrysqlc.New(tx)
 
 	for _, r := range results {
-		if err := q.InsertTestResult(ctx, r); err != nil {
+		if _, err := q.InsertTestResult(ctx, r); err != nil {
 			return err
 		}
 	}
 
-	return tx.Commit()
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	emitBulk(ctx, "test_results", results, func(p tietoevrysqlc.InsertTestResultParams) uuid.UUID { return p.UserID })
+	return nil
+}
+
+// InsertTestResultsPartial writes every item on its own and reports the outcome of each,
+// see utils.WriteEach
+func (s *TestResultsStore) InsertTestResultsPartial(ctx context.Context, results []utils.BulkItem[tietoevrysqlc.InsertTestResultParams]) ([]utils.BulkItemResult, error) {
+	return writeEach(ctx, s.db, "test_results", results,
+		func(p tietoevrysqlc.InsertTestResultParams) uuid.UUID { return p.UserID },
+		func(ctx context.Context, q *tietoevrysqlc.Queries, p tietoevrysqlc.InsertTestResultParams) (bool, error) {
+			return q.InsertTestResult(ctx, p)
+		},
+	)
 }
 
-func (s *TestResultsStore) GetTestResultsByUser(ctx context.Context, userID uuid.UUID) ([]tietoevrysqlc.TestResult, error) {
+func (s *TestResultsStore) GetTestResultsByUser(ctx context.Context, userID uuid.UUID, filter ListFilter, page utils.Page) ([]tietoevrysqlc.TestResult, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.BulkQueryTimeout)
 	defer cancel()
 
 	q := tietoevrysqlc.New(s.db)
-	return q.GetTestResultsByUser(ctx, userID)
+	return q.GetTestResultsByUser(ctx, tietoevrysqlc.GetTestResultsByUserParams{
+		UserID:         userID,
+		AfterTimestamp: page.AfterTime(),
+		AfterID:        page.AfterID(),
+		FromTime:       filter.fromTime(),
+		ToTime:         filter.toTime(),
+		PageLimit:      page.FetchLimit(),
+	})
 }
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 
 	tietoevrysqlc "github.com/DeRuina/KUHA-REST-API/internal/db/tietoevry"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
 	"github.com/google/uuid"
 )
@@ -18,7 +19,12 @@ func (s *UserStore) UpsertUser(ctx context.Context, arg tietoevrysqlc.UpsertUser
 	defer cancel()
 
 	q := tietoevrysqlc.New(s.db)
-	return q.UpsertUser(ctx, arg)
+	if err := q.UpsertUser(ctx, arg); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, events.New("tietoevry", "users", events.Upsert, 1, arg.ID))
+	return nil
 }
 
 func (s *UserStore) DeleteUser(ctx context.Context, id uuid.UUID) (int64, error) {
@@ -26,7 +32,13 @@ func (s *UserStore) DeleteUser(ctx context.Context, id uuid.UUID) (int64, error)
 	defer cancel()
 
 	q := tietoevrysqlc.New(s.db)
-	return q.DeleteUser(ctx, id)
+	rows, err := q.DeleteUser(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+
+	events.Emit(ctx, events.New("tietoevry", "users", events.Delete, int(rows), id))
+	return rows, nil
 }
 
 func (s *UserStore) GetUser(ctx context.Context, id uuid.UUID) (tietoevrysqlc.User, error) {
@@ -70,6 +82,7 @@ func (s *UserStore) DeleteUserWithLogging(ctx context.Context, userID uuid.UUID)
 		return 0, err
 	}
 
+	events.Emit(ctx, events.New("tietoevry", "users", events.Delete, int(rows), userID))
 	return rows, nil
 }
 
@@ -80,3 +93,13 @@ func (s *UserStore) GetDeletedUsers(ctx context.Context) ([]tietoevrysqlc.Delete
 	q := tietoevrysqlc.New(s.db)
 	return q.GetDeletedUsers(ctx)
 }
+
+// ListUserIdentities returns the gender and birthdate of the users, for
+// matching them to FIS competitors
+func (s *UserStore) ListUserIdentities(ctx context.Context) ([]tietoevrysqlc.ListUserIdentitiesRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.BulkQueryTimeout)
+	defer cancel()
+
+	q := tietoevrysqlc.New(s.db)
+	return q.ListUserIdentities(ctx)
+}
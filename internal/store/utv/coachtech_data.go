@@ -7,6 +7,7 @@ import (
 	"time"
 
 	utvsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/utv"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
 	"github.com/google/uuid"
 )
@@ -54,15 +55,22 @@ func (s *CoachtechDataStore) InsertCoachtechID(ctx context.Context, userID uuid.
 	})
 }
 
-func (s *CoachtechDataStore) InsertCoachtechData(ctx context.Context, coachtechID int32, summaryDate time.Time, testID string, data json.RawMessage) error {
+// InsertCoachtechData stores a Coachtech test of the user linked to
+// coachtechID with InsertCoachtechID
+func (s *CoachtechDataStore) InsertCoachtechData(ctx context.Context, userID uuid.UUID, coachtechID int32, summaryDate time.Time, testID string, data json.RawMessage) error {
 	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
 	defer cancel()
 
 	queries := utvsqlc.New(s.db)
-	return queries.InsertCoachtechData(ctx, utvsqlc.InsertCoachtechDataParams{
+	if err := queries.InsertCoachtechData(ctx, utvsqlc.InsertCoachtechDataParams{
 		CoachtechID: coachtechID,
 		SummaryDate: summaryDate,
 		TestID:      testID,
 		Data:        data,
-	})
+	}); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, events.New("utv", "coachtech_data", events.Upsert, 1, userID))
+	return nil
 }
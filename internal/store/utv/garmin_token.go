@@ -63,12 +63,16 @@ func (s *GarminTokenStore) DeleteToken(ctx context.Context, userID uuid.UUID) er
 	return queries.DeleteGarminToken(ctx, userID)
 }
 
-func (s *GarminTokenStore) GetTokensForUpdate(ctx context.Context, cutoff time.Time) ([]utvsqlc.GarminToken, error) {
+func (s *GarminTokenStore) GetTokensForUpdate(ctx context.Context, cutoff time.Time, page utils.Page) ([]utvsqlc.GarminToken, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
 	defer cancel()
 
 	queries := utvsqlc.New(s.db)
-	return queries.GetGarminTokensForUpdate(ctx, cutoff)
+	return queries.GetGarminTokensForUpdate(ctx, utvsqlc.GetGarminTokensForUpdateParams{
+		Cutoff:      cutoff,
+		AfterUserID: page.AfterID(),
+		PageLimit:   page.FetchLimit(),
+	})
 }
 
 func (s *GarminTokenStore) GetDataForUpdate(ctx context.Context, cutoff time.Time) ([]utvsqlc.GarminToken, error) {
@@ -58,12 +58,16 @@ func (s *OuraTokenStore) DeleteToken(ctx context.Context, userID uuid.UUID) erro
 	return queries.DeleteOuraToken(ctx, userID)
 }
 
-func (s *OuraTokenStore) GetTokensForUpdate(ctx context.Context, cutoff time.Time) ([]utvsqlc.OuraToken, error) {
+func (s *OuraTokenStore) GetTokensForUpdate(ctx context.Context, cutoff time.Time, page utils.Page) ([]utvsqlc.OuraToken, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
 	defer cancel()
 
 	queries := utvsqlc.New(s.db)
-	return queries.GetOuraTokensForUpdate(ctx, cutoff)
+	return queries.GetOuraTokensForUpdate(ctx, utvsqlc.GetOuraTokensForUpdateParams{
+		Cutoff:      cutoff,
+		AfterUserID: page.AfterID(),
+		PageLimit:   page.FetchLimit(),
+	})
 }
 
 func (s *OuraTokenStore) GetDataForUpdate(ctx context.Context, cutoff time.Time) ([]utvsqlc.OuraToken, error) {
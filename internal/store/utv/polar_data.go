@@ -8,6 +8,7 @@ import (
 	"time"
 
 	utvsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/utv"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
 	"github.com/google/uuid"
 )
@@ -160,7 +161,12 @@ func (s *PolarDataStore) InsertData(ctx context.Context, userID uuid.UUID, date
 		Data:   data,
 	}
 
-	return queries.InsertPolarData(ctx, arg)
+	if err := queries.InsertPolarData(ctx, arg); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, events.New("utv", "polar_data", events.Upsert, 1, userID))
+	return nil
 }
 
 // DeleteAllData deletes Polar data for a specific user
@@ -170,7 +176,13 @@ func (s *PolarDataStore) DeleteAllData(ctx context.Context, userID uuid.UUID) (i
 
 	queries := utvsqlc.New(s.db)
 
-	return queries.DeleteAllPolarData(ctx, userID)
+	rows, err := queries.DeleteAllPolarData(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	events.Emit(ctx, events.New("utv", "polar_data", events.Delete, int(rows), userID))
+	return rows, nil
 }
 
 // GetLatestByType
@@ -235,3 +247,39 @@ func (s *PolarDataStore) GetAllByType(ctx context.Context, userID uuid.UUID, typ
 
 	return result, nil
 }
+
+// ExportData returns a page of the Polar data matching filter, ordered by
+// user and day
+func (s *PolarDataStore) ExportData(ctx context.Context, filter ExportFilter, page utils.Page) ([]ExportRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.BulkQueryTimeout)
+	defer cancel()
+
+	queries := utvsqlc.New(s.db)
+
+	arg := utvsqlc.ExportPolarDataParams{
+		UserIds:     filter.UserIDs,
+		FromDate:    utils.NullTimeIfEmpty(filter.From),
+		ToDate:      utils.NullTimeIfEmpty(filter.To),
+		AfterUserID: page.AfterID(),
+		AfterDate:   page.AfterTime(),
+		PageLimit:   page.Limit,
+	}
+	if filter.GroupID != nil {
+		arg.GroupID = uuid.NullUUID{UUID: *filter.GroupID, Valid: true}
+	}
+
+	rows, err := queries.ExportPolarData(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]ExportRow, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, ExportRow{
+			UserID: row.UserID,
+			Date:   row.SummaryDate,
+			Data:   row.Data,
+		})
+	}
+	return result, nil
+}
@@ -58,12 +58,16 @@ func (s *PolarTokenStore) DeleteToken(ctx context.Context, userID uuid.UUID) err
 	return queries.DeletePolarToken(ctx, userID)
 }
 
-func (s *PolarTokenStore) GetTokensForUpdate(ctx context.Context, cutoff time.Time) ([]utvsqlc.PolarToken, error) {
+func (s *PolarTokenStore) GetTokensForUpdate(ctx context.Context, cutoff time.Time, page utils.Page) ([]utvsqlc.PolarToken, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
 	defer cancel()
 
 	queries := utvsqlc.New(s.db)
-	return queries.GetPolarTokensForUpdate(ctx, cutoff)
+	return queries.GetPolarTokensForUpdate(ctx, utvsqlc.GetPolarTokensForUpdateParams{
+		Cutoff:      cutoff,
+		AfterUserID: page.AfterID(),
+		PageLimit:   page.FetchLimit(),
+	})
 }
 
 func (s *PolarTokenStore) GetDataForUpdate(ctx context.Context, cutoff time.Time) ([]utvsqlc.PolarToken, error) {
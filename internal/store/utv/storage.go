@@ -7,6 +7,7 @@ import (
 	"time"
 
 	utvsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/utv"
+	"github.com/DeRuina/KUHA-REST-API/internal/utils"
 	"github.com/google/uuid"
 )
 
@@ -17,6 +18,21 @@ type LatestDataEntry struct {
 	Data   json.RawMessage
 }
 
+// ExportFilter selects the users and days of a data export. Users are
+// the members of GroupID and/or UserIDs.
+type ExportFilter struct {
+	GroupID  *uuid.UUID
+	UserIDs  []uuid.UUID
+	From, To *time.Time
+}
+
+// ExportRow is the data of one user and day
+type ExportRow struct {
+	UserID uuid.UUID
+	Date   time.Time
+	Data   json.RawMessage
+}
+
 // OuraData interface
 type OuraData interface {
 	GetDates(ctx context.Context, userID string, startDate *string, endDate *string) ([]string, error)
@@ -26,6 +42,7 @@ type OuraData interface {
 	DeleteAllData(ctx context.Context, userID uuid.UUID) (int64, error)
 	GetLatestByType(ctx context.Context, userID uuid.UUID, typ string, limit int32) ([]LatestDataEntry, error)
 	GetAllByType(ctx context.Context, userID uuid.UUID, typ string, after, before *time.Time, limit, offset int32) ([]LatestDataEntry, error)
+	ExportData(ctx context.Context, filter ExportFilter, page utils.Page) ([]ExportRow, error)
 }
 
 // OuraToken interface
@@ -34,7 +51,7 @@ type OuraToken interface {
 	UpsertToken(ctx context.Context, userID uuid.UUID, data json.RawMessage) error
 	GetTokenByOuraID(ctx context.Context, ouraID string) (uuid.UUID, json.RawMessage, error)
 	DeleteToken(ctx context.Context, userID uuid.UUID) error
-	GetTokensForUpdate(ctx context.Context, cutoff time.Time) ([]utvsqlc.OuraToken, error)
+	GetTokensForUpdate(ctx context.Context, cutoff time.Time, page utils.Page) ([]utvsqlc.OuraToken, error)
 	GetDataForUpdate(ctx context.Context, cutoff time.Time) ([]utvsqlc.OuraToken, error)
 	GetAccessTokenJSON(ctx context.Context, userID uuid.UUID) (json.RawMessage, error)
 }
@@ -48,6 +65,7 @@ type PolarData interface {
 	DeleteAllData(ctx context.Context, userID uuid.UUID) (int64, error)
 	GetLatestByType(ctx context.Context, userID uuid.UUID, typ string, limit int32) ([]LatestDataEntry, error)
 	GetAllByType(ctx context.Context, userID uuid.UUID, typ string, after, before *time.Time, limit, offset int32) ([]LatestDataEntry, error)
+	ExportData(ctx context.Context, filter ExportFilter, page utils.Page) ([]ExportRow, error)
 }
 
 // PolarToken interface
@@ -56,7 +74,7 @@ type PolarToken interface {
 	UpsertToken(ctx context.Context, userID uuid.UUID, data json.RawMessage) error
 	GetTokenByPolarID(ctx context.Context, polarID string) (uuid.UUID, json.RawMessage, error)
 	DeleteToken(ctx context.Context, userID uuid.UUID) error
-	GetTokensForUpdate(ctx context.Context, cutoff time.Time) ([]utvsqlc.PolarToken, error)
+	GetTokensForUpdate(ctx context.Context, cutoff time.Time, page utils.Page) ([]utvsqlc.PolarToken, error)
 	GetDataForUpdate(ctx context.Context, cutoff time.Time) ([]utvsqlc.PolarToken, error)
 	GetTokenJSON(ctx context.Context, userID uuid.UUID) (json.RawMessage, error)
 }
@@ -70,6 +88,7 @@ type SuuntoData interface {
 	DeleteAllData(ctx context.Context, userID uuid.UUID) (int64, error)
 	GetLatestByType(ctx context.Context, userID uuid.UUID, typ string, limit int32) ([]LatestDataEntry, error)
 	GetAllByType(ctx context.Context, userID uuid.UUID, typ string, after, before *time.Time, limit, offset int32) ([]LatestDataEntry, error)
+	ExportData(ctx context.Context, filter ExportFilter, page utils.Page) ([]ExportRow, error)
 }
 
 // SuuntoToken interface
@@ -78,7 +97,7 @@ type SuuntoToken interface {
 	UpsertToken(ctx context.Context, userID uuid.UUID, data json.RawMessage) error
 	GetTokenByUsername(ctx context.Context, username string) (uuid.UUID, json.RawMessage, error)
 	DeleteToken(ctx context.Context, userID uuid.UUID) error
-	GetTokensForUpdate(ctx context.Context, cutoff time.Time) ([]utvsqlc.SuuntoToken, error)
+	GetTokensForUpdate(ctx context.Context, cutoff time.Time, page utils.Page) ([]utvsqlc.SuuntoToken, error)
 	GetDataForUpdate(ctx context.Context, cutoff time.Time) ([]utvsqlc.SuuntoToken, error)
 	GetAccessTokenJSON(ctx context.Context, userID uuid.UUID) (json.RawMessage, error)
 }
@@ -92,6 +111,7 @@ type GarminData interface {
 	DeleteAllData(ctx context.Context, userID uuid.UUID) (int64, error)
 	GetLatestByType(ctx context.Context, userID uuid.UUID, typ string, limit int32) ([]LatestDataEntry, error)
 	GetAllByType(ctx context.Context, userID uuid.UUID, typ string, after, before *time.Time, limit, offset int32) ([]LatestDataEntry, error)
+	ExportData(ctx context.Context, filter ExportFilter, page utils.Page) ([]ExportRow, error)
 }
 
 // GarminToken interface
@@ -101,7 +121,7 @@ type GarminToken interface {
 	TokenExists(ctx context.Context, token string) (bool, error)
 	GetUserIDByToken(ctx context.Context, token string) (uuid.UUID, error)
 	DeleteToken(ctx context.Context, userID uuid.UUID) error
-	GetTokensForUpdate(ctx context.Context, cutoff time.Time) ([]utvsqlc.GarminToken, error)
+	GetTokensForUpdate(ctx context.Context, cutoff time.Time, page utils.Page) ([]utvsqlc.GarminToken, error)
 	GetDataForUpdate(ctx context.Context, cutoff time.Time) ([]utvsqlc.GarminToken, error)
 	GetTokenJSON(ctx context.Context, userID uuid.UUID) (json.RawMessage, error)
 }
@@ -127,7 +147,7 @@ type CoachtechData interface {
 	GetStatus(ctx context.Context, userID uuid.UUID) (bool, error)
 	GetData(ctx context.Context, userID uuid.UUID, after, before *time.Time) ([]json.RawMessage, error)
 	InsertCoachtechID(ctx context.Context, userID uuid.UUID, coachtechID int32) error
-	InsertCoachtechData(ctx context.Context, coachtechID int32, summaryDate time.Time, testID string, data json.RawMessage) error
+	InsertCoachtechData(ctx context.Context, userID uuid.UUID, coachtechID int32, summaryDate time.Time, testID string, data json.RawMessage) error
 }
 
 // UserData interface
@@ -137,6 +157,7 @@ type UserData interface {
 	DeleteUserData(ctx context.Context, userID uuid.UUID) error
 	GetUserIDBySportID(ctx context.Context, sportID string) (uuid.UUID, error)
 	GetUserDeviceStatus(ctx context.Context, userID uuid.UUID) (DeviceStatus, error)
+	GetGroupMembers(ctx context.Context, groupID uuid.UUID) ([]uuid.UUID, error)
 }
 
 type SourceCache interface {
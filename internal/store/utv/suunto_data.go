@@ -8,6 +8,7 @@ import (
 	"time"
 
 	utvsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/utv"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
 	"github.com/google/uuid"
 )
@@ -161,7 +162,12 @@ func (s *SuuntoDataStore) InsertData(ctx context.Context, userID uuid.UUID, date
 		Data:   data,
 	}
 
-	return queries.InsertSuuntoData(ctx, arg)
+	if err := queries.InsertSuuntoData(ctx, arg); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, events.New("utv", "suunto_data", events.Upsert, 1, userID))
+	return nil
 }
 
 // DeleteAllData deletes Suunto data for a specific user
@@ -171,7 +177,13 @@ func (s *SuuntoDataStore) DeleteAllData(ctx context.Context, userID uuid.UUID) (
 
 	queries := utvsqlc.New(s.db)
 
-	return queries.DeleteAllSuuntoData(ctx, userID)
+	rows, err := queries.DeleteAllSuuntoData(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	events.Emit(ctx, events.New("utv", "suunto_data", events.Delete, int(rows), userID))
+	return rows, nil
 }
 
 // GetLatestByType
@@ -236,3 +248,39 @@ func (s *SuuntoDataStore) GetAllByType(ctx context.Context, userID uuid.UUID, ty
 
 	return result, nil
 }
+
+// ExportData returns a page of the Suunto data matching filter, ordered by
+// user and day
+func (s *SuuntoDataStore) ExportData(ctx context.Context, filter ExportFilter, page utils.Page) ([]ExportRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.BulkQueryTimeout)
+	defer cancel()
+
+	queries := utvsqlc.New(s.db)
+
+	arg := utvsqlc.ExportSuuntoDataParams{
+		UserIds:     filter.UserIDs,
+		FromDate:    utils.NullTimeIfEmpty(filter.From),
+		ToDate:      utils.NullTimeIfEmpty(filter.To),
+		AfterUserID: page.AfterID(),
+		AfterDate:   page.AfterTime(),
+		PageLimit:   page.Limit,
+	}
+	if filter.GroupID != nil {
+		arg.GroupID = uuid.NullUUID{UUID: *filter.GroupID, Valid: true}
+	}
+
+	rows, err := queries.ExportSuuntoData(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]ExportRow, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, ExportRow{
+			UserID: row.UserID,
+			Date:   row.SummaryDate,
+			Data:   row.Data,
+		})
+	}
+	return result, nil
+}
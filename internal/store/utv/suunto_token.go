@@ -58,12 +58,16 @@ func (s *SuuntoTokenStore) DeleteToken(ctx context.Context, userID uuid.UUID) er
 	return queries.DeleteSuuntoToken(ctx, userID)
 }
 
-func (s *SuuntoTokenStore) GetTokensForUpdate(ctx context.Context, cutoff time.Time) ([]utvsqlc.SuuntoToken, error) {
+func (s *SuuntoTokenStore) GetTokensForUpdate(ctx context.Context, cutoff time.Time, page utils.Page) ([]utvsqlc.SuuntoToken, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
 	defer cancel()
 
 	queries := utvsqlc.New(s.db)
-	return queries.GetSuuntoTokensForUpdate(ctx, cutoff)
+	return queries.GetSuuntoTokensForUpdate(ctx, utvsqlc.GetSuuntoTokensForUpdateParams{
+		Cutoff:      cutoff,
+		AfterUserID: page.AfterID(),
+		PageLimit:   page.FetchLimit(),
+	})
 }
 
 func (s *SuuntoTokenStore) GetDataForUpdate(ctx context.Context, cutoff time.Time) ([]utvsqlc.SuuntoToken, error) {
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 
 	utvsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/utv"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
 	"github.com/DeRuina/KUHA-REST-API/internal/utils"
 	"github.com/google/uuid"
 )
@@ -39,10 +40,15 @@ func (s *UserDataStore) UpsertUserData(ctx context.Context, userID uuid.UUID, da
 	defer cancel()
 
 	queries := utvsqlc.New(s.db)
-	return queries.UpsertUserData(ctx, utvsqlc.UpsertUserDataParams{
+	if err := queries.UpsertUserData(ctx, utvsqlc.UpsertUserDataParams{
 		UserID: userID,
 		Data:   data,
-	})
+	}); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, events.New("utv", "user_data", events.Upsert, 1, userID))
+	return nil
 }
 
 func (s *UserDataStore) DeleteUserData(ctx context.Context, userID uuid.UUID) error {
@@ -50,7 +56,12 @@ func (s *UserDataStore) DeleteUserData(ctx context.Context, userID uuid.UUID) er
 	defer cancel()
 
 	queries := utvsqlc.New(s.db)
-	return queries.DeleteUserData(ctx, userID)
+	if err := queries.DeleteUserData(ctx, userID); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, events.New("utv", "user_data", events.Delete, 1, userID))
+	return nil
 }
 
 func (s *UserDataStore) GetUserIDBySportID(ctx context.Context, sportID string) (uuid.UUID, error) {
@@ -61,6 +72,24 @@ func (s *UserDataStore) GetUserIDBySportID(ctx context.Context, sportID string)
 	return queries.GetUserIDBySportID(ctx, sportID)
 }
 
+// GetGroupMembers returns the IDs of the users in a group
+func (s *UserDataStore) GetGroupMembers(ctx context.Context, groupID uuid.UUID) ([]uuid.UUID, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
+	defer cancel()
+
+	queries := utvsqlc.New(s.db)
+	rows, err := queries.ListGroupMembers(ctx, uuid.NullUUID{UUID: groupID, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(rows))
+	for i, row := range rows {
+		ids[i] = row.UserID
+	}
+	return ids, nil
+}
+
 func (s *UserDataStore) GetUserDeviceStatus(ctx context.Context, userID uuid.UUID) (DeviceStatus, error) {
 	ctx, cancel := context.WithTimeout(ctx, utils.QueryTimeout)
 	defer cancel()
@@ -0,0 +1,212 @@
+package utils
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/lib/pq"
+)
+
+// BulkStatus is the outcome of one item of a bulk request in partial mode
+type BulkStatus string
+
+const (
+	BulkInserted BulkStatus = "inserted"
+	BulkUpdated  BulkStatus = "updated"
+	BulkSkipped  BulkStatus = "skipped"
+	BulkFailed   BulkStatus = "failed"
+)
+
+// BulkItem is a converted item of a bulk request with its position in the
+// request body
+type BulkItem[T any] struct {
+	Index int
+	Value T
+}
+
+// BulkItemResult reports what happened to one item. Table names the array
+// of the item in requests that carry several. Fields holds validation
+// errors by field; other failures are described in Error.
+type BulkItemResult struct {
+	Table  string            `json:"table,omitempty"`
+	Index  int               `json:"index"`
+	Status BulkStatus        `json:"status"`
+	Error  string            `json:"error,omitempty"`
+	Fields map[string]string `json:"fields,omitempty"`
+}
+
+type BulkSummary struct {
+	Total    int `json:"total"`
+	Inserted int `json:"inserted"`
+	Updated  int `json:"updated"`
+	Skipped  int `json:"skipped"`
+	Failed   int `json:"failed"`
+}
+
+// BulkReport is the response body of a bulk request in partial mode, with
+// one result per item in request order
+type BulkReport struct {
+	Summary BulkSummary      `json:"summary"`
+	Results []BulkItemResult `json:"results"`
+}
+
+// PartialBulk reports whether a bulk request asks for partial mode with
+// ?partial=true, in which every item is written on its own
+func PartialBulk(r *http.Request) bool {
+	partial, _ := strconv.ParseBool(r.URL.Query().Get("partial"))
+	return partial
+}
+
+// PrepareBulk validates and converts every item on its own. Items that fail
+// are recorded in the returned report; the others are returned for the
+// store to write.
+func PrepareBulk[In, Out any](items []In, convert func(In) (Out, error)) ([]BulkItem[Out], *BulkReport) {
+	report := &BulkReport{Results: make([]BulkItemResult, len(items))}
+	report.Summary.Total = len(items)
+
+	valid := make([]BulkItem[Out], 0, len(items))
+	for i, item := range items {
+		if err := GetValidator().Struct(item); err != nil {
+			report.Record(BulkFailure(i, err))
+			continue
+		}
+		out, err := convert(item)
+		if err != nil {
+			report.Record(BulkFailure(i, err))
+			continue
+		}
+		valid = append(valid, BulkItem[Out]{Index: i, Value: out})
+	}
+	return valid, report
+}
+
+// Record stores the result of an item and counts it in the summary
+func (b *BulkReport) Record(results ...BulkItemResult) {
+	for _, res := range results {
+		b.Results[res.Index] = res
+		switch res.Status {
+		case BulkInserted:
+			b.Summary.Inserted++
+		case BulkUpdated:
+			b.Summary.Updated++
+		case BulkSkipped:
+			b.Summary.Skipped++
+		case BulkFailed:
+			b.Summary.Failed++
+		}
+	}
+}
+
+// Append adds the results of the report of another array of the request,
+// tagged with its name
+func (b *BulkReport) Append(table string, other *BulkReport) {
+	for _, res := range other.Results {
+		res.Table = table
+		b.Results = append(b.Results, res)
+	}
+	b.Summary.Total += other.Summary.Total
+	b.Summary.Inserted += other.Summary.Inserted
+	b.Summary.Updated += other.Summary.Updated
+	b.Summary.Skipped += other.Summary.Skipped
+	b.Summary.Failed += other.Summary.Failed
+}
+
+// WriteBulkReport answers 201 if every item was written or skipped and
+// 207 Multi-Status if some failed
+func WriteBulkReport(w http.ResponseWriter, report *BulkReport) error {
+	status := http.StatusCreated
+	if report.Summary.Failed > 0 {
+		status = http.StatusMultiStatus
+	}
+	return WriteJSON(w, status, report)
+}
+
+// BulkFailure describes why an item failed, in the same terms as the
+// error response the item would get on its own
+func BulkFailure(index int, err error) BulkItemResult {
+	res := BulkItemResult{Index: index, Status: BulkFailed}
+
+	var validationErrs validator.ValidationErrors
+	var fieldErr *InvalidFieldTypeError
+	switch {
+	case errors.As(err, &validationErrs):
+		res.Fields = FormatValidationErrors(validationErrs)
+	case errors.As(err, &fieldErr):
+		res.Fields = map[string]string{toSnakeCase(fieldErr.Field): fieldErr.Error()}
+	default:
+		if _, clientErr, ok := databaseClientError(err); ok {
+			err = clientErr
+		}
+		res.Error = err.Error()
+	}
+	return res
+}
+
+// IsDataError reports whether err was caused by the data of a single
+// statement (SQLSTATE class 22 or 23) rather than by the connection or
+// the transaction
+func IsDataError(err error) bool {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return false
+	}
+	class := pqErr.Code.Class()
+	return class == "22" || class == "23"
+}
+
+// WriteEach writes every item in tx under its own savepoint. write returns
+// whether the item created new rows. An item failing with a data error is
+// rolled back to its savepoint and reported as failed without affecting
+// the others; any other error aborts the batch. An item identical to an
+// earlier one of the same batch that was written is skipped.
+func WriteEach[T any](ctx context.Context, tx *sql.Tx, items []BulkItem[T], write func(T) (bool, error)) ([]BulkItemResult, error) {
+	results := make([]BulkItemResult, 0, len(items))
+	seen := make(map[[sha256.Size]byte]struct{}, len(items))
+
+	for _, item := range items {
+		b, hashErr := json.Marshal(item.Value)
+		sum := sha256.Sum256(b)
+		if hashErr == nil {
+			if _, dup := seen[sum]; dup {
+				results = append(results, BulkItemResult{Index: item.Index, Status: BulkSkipped})
+				continue
+			}
+		}
+
+		if _, err := tx.ExecContext(ctx, "SAVEPOINT bulk_item"); err != nil {
+			return nil, err
+		}
+
+		inserted, err := write(item.Value)
+		if err != nil {
+			if !IsDataError(err) {
+				return nil, err
+			}
+			if _, err := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT bulk_item; RELEASE SAVEPOINT bulk_item"); err != nil {
+				return nil, err
+			}
+			results = append(results, BulkFailure(item.Index, err))
+			continue
+		}
+
+		if _, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT bulk_item"); err != nil {
+			return nil, err
+		}
+		if hashErr == nil {
+			seen[sum] = struct{}{}
+		}
+		status := BulkUpdated
+		if inserted {
+			status = BulkInserted
+		}
+		results = append(results, BulkItemResult{Index: item.Index, Status: status})
+	}
+
+	return results, nil
+}
@@ -0,0 +1,204 @@
+package utils
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/sqlc-dev/pqtype"
+)
+
+// CopyThreshold is the number of rows from which bulk inserts load a table
+// with COPY instead of one INSERT per row, overridden at startup by
+// SetCopyThreshold. 0 disables COPY.
+var CopyThreshold = 1000
+
+// SetCopyThreshold replaces the default COPY threshold
+func SetCopyThreshold(rows int) {
+	CopyThreshold = rows
+}
+
+// UseCopy reports whether a bulk insert of rows rows goes through COPY
+func UseCopy(rows int) bool {
+	return CopyThreshold > 0 && rows >= CopyThreshold
+}
+
+// CopyTable describes how CopyMerge loads a table. Columns are the columns
+// of the row-by-row INSERT in the order of the row values and OnConflict
+// is its ON CONFLICT clause, so both paths resolve conflicts the same way.
+type CopyTable struct {
+	Table      string
+	Columns    []string
+	OnConflict string
+}
+
+// CopyMerge loads rows into a temporary copy of the table with COPY and
+// merges them into the table with INSERT ... SELECT and the ON CONFLICT
+// clause of the table. Rows sharing a conflict key are merged in rounds,
+// the n-th occurrence of each key in round n, so that a later row updates
+// an earlier one exactly as with one INSERT per row. key returns the
+// conflict key of a row and values its column values.
+func CopyMerge[T any](ctx context.Context, tx *sql.Tx, t CopyTable, rows []T, key func(T) any, values func(T) []any) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	rounds, err := copyStage(ctx, tx, t, rows, key, values)
+	if err != nil {
+		return err
+	}
+
+	merge := copyMergeQuery(t, "")
+	for round := 1; round <= rounds; round++ {
+		if _, err := tx.ExecContext(ctx, merge, round); err != nil {
+			return err
+		}
+	}
+	return copyDrop(ctx, tx, t)
+}
+
+// UpsertCounts tells how the rows of an upsert were written. Skipped rows
+// were left out by the WHERE of the DO UPDATE, e.g. for being older than
+// the stored ones.
+type UpsertCounts struct {
+	Inserted int64 `json:"inserted"`
+	Updated  int64 `json:"updated"`
+	Skipped  int64 `json:"skipped"`
+}
+
+// Upsert writes rows with the ON CONFLICT clause of the table and counts
+// the rows inserted, updated and skipped. From CopyThreshold rows it loads
+// them with COPY and merges them in rounds like CopyMerge, otherwise it
+// inserts one row at a time.
+func Upsert[T any](ctx context.Context, tx *sql.Tx, t CopyTable, rows []T, key func(T) any, values func(T) []any) (UpsertCounts, error) {
+	var c UpsertCounts
+	if len(rows) == 0 {
+		return c, nil
+	}
+
+	if !UseCopy(len(rows)) {
+		params := make([]string, len(t.Columns))
+		for i := range params {
+			params[i] = fmt.Sprintf("$%d", i+1)
+		}
+		insert := fmt.Sprintf(
+			"INSERT INTO %s (%s) VALUES (%s) %s RETURNING (xmax = 0)",
+			pq.QuoteIdentifier(t.Table), copyColumns(t), strings.Join(params, ", "), t.OnConflict,
+		)
+		for _, row := range rows {
+			var inserted bool
+			err := tx.QueryRowContext(ctx, insert, values(row)...).Scan(&inserted)
+			switch {
+			case errors.Is(err, sql.ErrNoRows):
+				c.Skipped++
+			case err != nil:
+				return c, err
+			case inserted:
+				c.Inserted++
+			default:
+				c.Updated++
+			}
+		}
+		return c, nil
+	}
+
+	rounds, err := copyStage(ctx, tx, t, rows, key, values)
+	if err != nil {
+		return c, err
+	}
+
+	// xmax is 0 for the rows inserted rather than updated
+	merge := fmt.Sprintf(
+		"WITH merged AS (%s) SELECT count(*) FILTER (WHERE inserted), count(*) FILTER (WHERE NOT inserted) FROM merged",
+		copyMergeQuery(t, "RETURNING (xmax = 0) AS inserted"),
+	)
+	for round := 1; round <= rounds; round++ {
+		var inserted, updated int64
+		if err := tx.QueryRowContext(ctx, merge, round).Scan(&inserted, &updated); err != nil {
+			return c, err
+		}
+		c.Inserted += inserted
+		c.Updated += updated
+	}
+	c.Skipped = int64(len(rows)) - c.Inserted - c.Updated
+	return c, copyDrop(ctx, tx, t)
+}
+
+// copyStage loads rows into the staging table of t and returns the number
+// of merge rounds they need
+func copyStage[T any](ctx context.Context, tx *sql.Tx, t CopyTable, rows []T, key func(T) any, values func(T) []any) (int, error) {
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(
+		"CREATE TEMP TABLE %s (LIKE %s INCLUDING DEFAULTS, copy_round integer NOT NULL) ON COMMIT DROP",
+		copyStaging(t), pq.QuoteIdentifier(t.Table),
+	)); err != nil {
+		return 0, fmt.Errorf("create staging table for %s: %w", t.Table, err)
+	}
+
+	stmt, err := tx.PrepareContext(ctx, pq.CopyIn(t.Table+"_copy", slices.Concat(t.Columns, []string{"copy_round"})...))
+	if err != nil {
+		return 0, fmt.Errorf("copy into %s: %w", t.Table, err)
+	}
+	defer stmt.Close()
+
+	seen := make(map[any]int, len(rows))
+	rounds := 0
+	for _, row := range rows {
+		k := key(row)
+		seen[k]++
+		rounds = max(rounds, seen[k])
+
+		if _, err := stmt.ExecContext(ctx, append(values(row), seen[k])...); err != nil {
+			return 0, fmt.Errorf("copy into %s: %w", t.Table, err)
+		}
+	}
+	if _, err := stmt.ExecContext(ctx); err != nil {
+		return 0, fmt.Errorf("copy into %s: %w", t.Table, err)
+	}
+	if err := stmt.Close(); err != nil {
+		return 0, fmt.Errorf("copy into %s: %w", t.Table, err)
+	}
+	return rounds, nil
+}
+
+// copyMergeQuery merges one round of the staging table into the table
+func copyMergeQuery(t CopyTable, returning string) string {
+	cols := copyColumns(t)
+	return fmt.Sprintf(
+		"INSERT INTO %s (%s) SELECT %s FROM %s WHERE copy_round = $1 %s %s",
+		pq.QuoteIdentifier(t.Table), cols, cols, copyStaging(t), t.OnConflict, returning,
+	)
+}
+
+// copyDrop drops the staging table now rather than at commit so the table
+// can be loaded again in the same transaction
+func copyDrop(ctx context.Context, tx *sql.Tx, t CopyTable) error {
+	if _, err := tx.ExecContext(ctx, "DROP TABLE "+copyStaging(t)); err != nil {
+		return fmt.Errorf("drop staging table for %s: %w", t.Table, err)
+	}
+	return nil
+}
+
+func copyColumns(t CopyTable) string {
+	cols := make([]string, len(t.Columns))
+	for i, c := range t.Columns {
+		cols[i] = pq.QuoteIdentifier(c)
+	}
+	return strings.Join(cols, ", ")
+}
+
+func copyStaging(t CopyTable) string {
+	return pq.QuoteIdentifier(t.Table + "_copy")
+}
+
+// CopyJSON returns a JSON value for COPY, which would otherwise encode it
+// as bytea
+func CopyJSON(m pqtype.NullRawMessage) any {
+	if !m.Valid {
+		return nil
+	}
+	return string(m.RawMessage)
+}
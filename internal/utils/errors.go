@@ -73,6 +73,7 @@ var (
 	ErrInvalidIDNumeric    = errors.New("id must be numeric")
 	ErrInvalidLimit        = errors.New("invalid limit: must be in the documented range")
 	ErrInvalidOffset       = errors.New("invalid offset: must be a non-negative integer")
+	ErrInvalidCursor       = errors.New("invalid cursor: use the next_cursor value of the previous page")
 	ErrMaxLimitExceeded    = errors.New("maximum value exceeded: please use a smaller value")
 	ErrMinLimitExceeded    = errors.New("minimum value not met: please use a larger value")
 
@@ -296,6 +297,12 @@ func BadRequestResponse(w http.ResponseWriter, r *http.Request, err error) {
 	WriteJSONError(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
 }
 
+// 413 Request Entity Too Large
+func RequestEntityTooLargeResponse(w http.ResponseWriter, r *http.Request, err error) {
+	logError(r, "Request entity too large", err, http.StatusRequestEntityTooLarge)
+	WriteJSONError(w, http.StatusRequestEntityTooLarge, map[string]string{"error": err.Error()})
+}
+
 // 404 Not Found
 func NotFoundResponse(w http.ResponseWriter, r *http.Request, err error) {
 	logError(r, "Not found error", err, http.StatusNotFound)
@@ -345,68 +352,13 @@ func ServiceUnavailableDBResponse(w http.ResponseWriter, r *http.Request, dbName
 // HandleDatabaseError analyzes database errors and returns appropriate HTTP responses - default 500 Internal Server Error
 func HandleDatabaseError(w http.ResponseWriter, r *http.Request, err error) {
 	// Check if it's a PostgreSQL error
-	if pqErr, ok := err.(*pq.Error); ok {
-		switch pqErr.Code {
-		case "22P02": // invalid_text_representation (includes JSON syntax errors)
-			if strings.Contains(pqErr.Message, "json") || strings.Contains(pqErr.Message, "JSON") {
-				// Try to identify which field has the JSON error
-				if strings.Contains(pqErr.Message, "data") {
-					BadRequestResponse(w, r, errors.New("invalid JSON format in 'data' field - check for missing braces, quotes, or commas"))
-				} else if strings.Contains(pqErr.Message, "test_event_template_test_limits") {
-					BadRequestResponse(w, r, errors.New("invalid JSON format in 'test_event_template_test_limits' field - check for missing braces, quotes, or commas"))
-				} else if strings.Contains(pqErr.Message, "raw_data") {
-					BadRequestResponse(w, r, errors.New("invalid JSON format in 'raw_data' field - check for missing braces, quotes, or commas"))
-				} else if strings.Contains(pqErr.Message, "additional_info") {
-					BadRequestResponse(w, r, errors.New("invalid JSON format in 'additional_info' field - check for missing braces, quotes, or commas"))
-				} else if strings.Contains(pqErr.Message, "additional_data") {
-					BadRequestResponse(w, r, errors.New("invalid JSON format in 'additional_data' field - check for missing braces, quotes, or commas"))
-				} else {
-					BadRequestResponse(w, r, errors.New("invalid JSON format in one of the JSON fields - check for missing braces, quotes, or commas"))
-				}
-				return
-			}
-			BadRequestResponse(w, r, fmt.Errorf("invalid data format: %s", pqErr.Message))
-			return
-		case "23503": // foreign_key_violation
-			if strings.Contains(pqErr.Message, "user_id") || strings.Contains(pqErr.Detail, "user_id") {
-				// Try to extract the specific user_id from the error detail
-				if pqErr.Detail != "" {
-					BadRequestResponse(w, r, fmt.Errorf("user does not exist. Details: %s", pqErr.Detail))
-				} else {
-					BadRequestResponse(w, r, ErrUserNotFound)
-				}
-				return
-			} else if strings.Contains(pqErr.Message, "exercise_id") || strings.Contains(pqErr.Detail, "exercise_id") {
-				// This usually means the main exercise insert was skipped due to conflict
-				if pqErr.Detail != "" {
-					BadRequestResponse(w, r, fmt.Errorf("exercise insert was skipped due to a conflict (duplicated raw_id, exercise_id, user_id for example), Details: %s", pqErr.Detail))
-				} else {
-					BadRequestResponse(w, r, ErrInvalidExerciseData)
-				}
-				return
-			}
-			// Generic foreign key violation with details if available
-			if pqErr.Detail != "" {
-				BadRequestResponse(w, r, fmt.Errorf("referenced record does not exist. Details: %s", pqErr.Detail))
-			} else {
-				BadRequestResponse(w, r, ErrForeignKeyViolation)
-			}
-			return
-		case "23505": // unique_violation
-			if pqErr.Detail != "" {
-				ConflictResponse(w, r, fmt.Errorf("record already exists. Details: %s", pqErr.Detail))
-			} else {
-				ConflictResponse(w, r, errors.New("record already exists"))
-			}
-			return
-		case "23514": // check_violation
-			if pqErr.Detail != "" {
-				BadRequestResponse(w, r, fmt.Errorf("data violates database constraints. Details: %s", pqErr.Detail))
-			} else {
-				BadRequestResponse(w, r, errors.New("data violates database constraints"))
-			}
-			return
+	if status, clientErr, ok := databaseClientError(err); ok {
+		if status == http.StatusConflict {
+			ConflictResponse(w, r, clientErr)
+		} else {
+			BadRequestResponse(w, r, clientErr)
 		}
+		return
 	}
 
 	// Context errors (timeouts/cancellations)
@@ -440,3 +392,64 @@ func RateLimitExceededResponse(w http.ResponseWriter, r *http.Request, retryAfte
 		"retry_after": retryAfter,
 	})
 }
+
+// databaseClientError maps a PostgreSQL error caused by the submitted data
+// to the status and message returned to the client. It reports false for
+// any other error.
+func databaseClientError(err error) (int, error, bool) {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return 0, nil, false
+	}
+
+	switch pqErr.Code {
+	case "22P02": // invalid_text_representation (includes JSON syntax errors)
+		if strings.Contains(pqErr.Message, "json") || strings.Contains(pqErr.Message, "JSON") {
+			// Try to identify which field has the JSON error
+			field := "one of the JSON fields"
+			for _, f := range []string{"data", "test_event_template_test_limits", "raw_data", "additional_info", "additional_data"} {
+				if strings.Contains(pqErr.Message, f) {
+					field = fmt.Sprintf("'%s' field", f)
+					break
+				}
+			}
+			return http.StatusBadRequest, fmt.Errorf("invalid JSON format in %s - check for missing braces, quotes, or commas", field), true
+		}
+		return http.StatusBadRequest, fmt.Errorf("invalid data format: %s", pqErr.Message), true
+
+	case "23503": // foreign_key_violation
+		if strings.Contains(pqErr.Message, "user_id") || strings.Contains(pqErr.Detail, "user_id") {
+			// Try to extract the specific user_id from the error detail
+			if pqErr.Detail != "" {
+				return http.StatusBadRequest, fmt.Errorf("user does not exist. Details: %s", pqErr.Detail), true
+			}
+			return http.StatusBadRequest, ErrUserNotFound, true
+		}
+		if strings.Contains(pqErr.Message, "exercise_id") || strings.Contains(pqErr.Detail, "exercise_id") {
+			// This usually means the main exercise insert was skipped due to conflict
+			if pqErr.Detail != "" {
+				return http.StatusBadRequest, fmt.Errorf("exercise insert was skipped due to a conflict (duplicated raw_id, exercise_id, user_id for example), Details: %s", pqErr.Detail), true
+			}
+			return http.StatusBadRequest, ErrInvalidExerciseData, true
+		}
+		// Generic foreign key violation with details if available
+		if pqErr.Detail != "" {
+			return http.StatusBadRequest, fmt.Errorf("referenced record does not exist. Details: %s", pqErr.Detail), true
+		}
+		return http.StatusBadRequest, ErrForeignKeyViolation, true
+
+	case "23505": // unique_violation
+		if pqErr.Detail != "" {
+			return http.StatusConflict, fmt.Errorf("record already exists. Details: %s", pqErr.Detail), true
+		}
+		return http.StatusConflict, errors.New("record already exists"), true
+
+	case "23514": // check_violation
+		if pqErr.Detail != "" {
+			return http.StatusBadRequest, fmt.Errorf("data violates database constraints. Details: %s", pqErr.Detail), true
+		}
+		return http.StatusBadRequest, errors.New("data violates database constraints"), true
+	}
+
+	return 0, nil, false
+}
@@ -0,0 +1,475 @@
+package utils
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/parquet-go/parquet-go"
+)
+
+// Format is the representation of a tabular response
+type Format string
+
+const (
+	FormatJSON    Format = "json"
+	FormatCSV     Format = "csv"
+	FormatParquet Format = "parquet"
+	FormatNDJSON  Format = "ndjson"
+)
+
+const (
+	ContentTypeCSV     = "text/csv"
+	ContentTypeParquet = "application/vnd.apache.parquet"
+)
+
+// parquetRowGroupRows caps the rows of a Parquet row group. A row group is
+// buffered until it is full, so the file is written out one row group at a
+// time instead of being held in memory until Close.
+const parquetRowGroupRows = 10000
+
+var ErrInvalidFormat = errors.New("invalid format: allowed values are json, csv, parquet")
+
+// NegotiateFormat reads the format parameter, falling back to the Accept
+// header. JSON is the default.
+func NegotiateFormat(r *http.Request) (Format, error) {
+	switch val := strings.ToLower(r.URL.Query().Get("format")); val {
+	case "":
+	case string(FormatJSON), string(FormatCSV), string(FormatParquet):
+		return Format(val), nil
+	default:
+		return "", ErrInvalidFormat
+	}
+
+	for _, part := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, _, _ := strings.Cut(part, ";")
+		switch strings.ToLower(strings.TrimSpace(mediaType)) {
+		case ContentTypeCSV:
+			return FormatCSV, nil
+		case ContentTypeParquet:
+			return FormatParquet, nil
+		}
+	}
+	return FormatJSON, nil
+}
+
+// ContentType returns the media type of f
+func (f Format) ContentType() string {
+	switch f {
+	case FormatCSV:
+		return ContentTypeCSV + "; charset=utf-8"
+	case FormatParquet:
+		return ContentTypeParquet
+	case FormatNDJSON:
+		return ContentTypeNDJSON
+	default:
+		return "application/json"
+	}
+}
+
+// WriteTable writes rows as a CSV or Parquet attachment named after name.
+// Columns follow the field order and json names of T; nested values are
+// written as JSON text.
+func WriteTable[T any](w http.ResponseWriter, format Format, name string, rows []T) error {
+	if format != FormatCSV && format != FormatParquet {
+		return ErrInvalidFormat
+	}
+
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name+"."+string(format)))
+	w.WriteHeader(http.StatusOK)
+
+	tw, err := NewTableWriter(w, format, reflect.TypeOf((*T)(nil)).Elem())
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if err := tw.Write(row); err != nil {
+			return err
+		}
+	}
+	return tw.Close()
+}
+
+// TableWriter writes struct rows one at a time as CSV, Parquet or NDJSON.
+// Columns are the exported fields of the row type in declaration order,
+// named after their json tag or the snake_cased field name. sql.Null*
+// and uuid values are unwrapped; nested values are written as JSON text.
+type TableWriter struct {
+	format Format
+	cols   []tableColumn
+	rows   int64
+
+	csv    *csv.Writer
+	record []string
+
+	parquet *parquet.Writer
+	pqRow   reflect.Value
+
+	w io.Writer
+}
+
+// NewTableWriter starts a table of rowType (a struct or pointer to struct)
+// on w. CSV output begins with a header line; Parquet output is flushed to w
+// every parquetRowGroupRows rows.
+func NewTableWriter(w io.Writer, format Format, rowType reflect.Type) (*TableWriter, error) {
+	t := &TableWriter{format: format, cols: tableColumns(rowType), w: w}
+
+	switch format {
+	case FormatCSV:
+		t.csv = csv.NewWriter(w)
+		t.record = make([]string, len(t.cols))
+		for i, col := range t.cols {
+			t.record[i] = col.name
+		}
+		if err := t.csv.Write(t.record); err != nil {
+			return nil, err
+		}
+	case FormatParquet:
+		pqType := parquetRowType(t.cols)
+		t.parquet = parquet.NewWriter(w,
+			parquet.SchemaOf(reflect.New(pqType).Interface()),
+			parquet.MaxRowsPerRowGroup(parquetRowGroupRows),
+		)
+		t.pqRow = reflect.New(pqType).Elem()
+	case FormatNDJSON:
+	default:
+		return nil, ErrInvalidFormat
+	}
+	return t, nil
+}
+
+// Rows returns the number of rows written so far
+func (t *TableWriter) Rows() int64 {
+	return t.rows
+}
+
+// Write appends one row
+func (t *TableWriter) Write(row any) error {
+	rv := reflect.Indirect(reflect.ValueOf(row))
+
+	var err error
+	switch t.format {
+	case FormatCSV:
+		err = t.writeCSV(rv)
+	case FormatParquet:
+		err = t.writeParquet(rv)
+	case FormatNDJSON:
+		err = t.writeNDJSON(rv)
+	}
+	if err == nil {
+		t.rows++
+	}
+	return err
+}
+
+// Close flushes buffered output; Parquet files are only valid after Close
+func (t *TableWriter) Close() error {
+	switch t.format {
+	case FormatCSV:
+		t.csv.Flush()
+		return t.csv.Error()
+	case FormatParquet:
+		return t.parquet.Close()
+	}
+	return nil
+}
+
+func (t *TableWriter) writeCSV(rv reflect.Value) error {
+	for i, col := range t.cols {
+		switch v := col.cell(rv).(type) {
+		case nil:
+			t.record[i] = ""
+		case string:
+			t.record[i] = v
+		case int64:
+			t.record[i] = strconv.FormatInt(v, 10)
+		case float64:
+			t.record[i] = strconv.FormatFloat(v, 'f', -1, 64)
+		case bool:
+			t.record[i] = strconv.FormatBool(v)
+		}
+	}
+	return t.csv.Write(t.record)
+}
+
+func (t *TableWriter) writeParquet(rv reflect.Value) error {
+	for i, col := range t.cols {
+		f := t.pqRow.Field(i)
+		v := col.cell(rv)
+		if v == nil {
+			f.Set(reflect.Zero(f.Type()))
+			continue
+		}
+		val := reflect.ValueOf(v)
+		if col.nullable {
+			p := reflect.New(f.Type().Elem())
+			p.Elem().Set(val)
+			f.Set(p)
+		} else {
+			f.Set(val)
+		}
+	}
+	return t.parquet.Write(t.pqRow.Interface())
+}
+
+// writeNDJSON writes the columns as one flat JSON object, in column order
+func (t *TableWriter) writeNDJSON(rv reflect.Value) error {
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, col := range t.cols {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		k, _ := json.Marshal(col.name)
+		b.Write(k)
+		b.WriteByte(':')
+
+		v := col.cell(rv)
+		if col.kind == kindJSON && v != nil {
+			// already JSON text
+			b.WriteString(v.(string))
+			continue
+		}
+		val, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		b.Write(val)
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(t.w, b.String())
+	return err
+}
+
+type columnKind int
+
+const (
+	kindString columnKind = iota
+	kindInt
+	kindFloat
+	kindBool
+	kindJSON
+)
+
+type tableColumn struct {
+	name     string
+	index    int
+	kind     columnKind
+	nullable bool
+	valuer   bool
+}
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage(nil))
+)
+
+// valuerKinds are the database types unwrapped through driver.Valuer;
+// the nullable ones are written as nulls when not valid
+var valuerKinds = map[reflect.Type]struct {
+	kind     columnKind
+	nullable bool
+}{
+	reflect.TypeOf(sql.NullString{}):  {kindString, true},
+	reflect.TypeOf(sql.NullInt16{}):   {kindInt, true},
+	reflect.TypeOf(sql.NullInt32{}):   {kindInt, true},
+	reflect.TypeOf(sql.NullInt64{}):   {kindInt, true},
+	reflect.TypeOf(sql.NullFloat64{}): {kindFloat, true},
+	reflect.TypeOf(sql.NullBool{}):    {kindBool, true},
+	reflect.TypeOf(sql.NullTime{}):    {kindString, true},
+	reflect.TypeOf(uuid.UUID{}):       {kindString, false},
+	reflect.TypeOf(uuid.NullUUID{}):   {kindString, true},
+}
+
+var valuerType = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+
+func tableColumns(t reflect.Type) []tableColumn {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	var cols []tableColumn
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name := snakeCase(f.Name)
+		if tag, _, _ := strings.Cut(f.Tag.Get("json"), ","); tag == "-" {
+			continue
+		} else if tag != "" {
+			name = tag
+		}
+
+		ft := f.Type
+		col := tableColumn{name: name, index: i}
+		if ft.Kind() == reflect.Pointer {
+			col.nullable = true
+			ft = ft.Elem()
+		}
+
+		if vk, ok := valuerKinds[ft]; ok {
+			col.kind = vk.kind
+			col.nullable = col.nullable || vk.nullable
+			col.valuer = true
+			cols = append(cols, col)
+			continue
+		}
+
+		switch {
+		case ft == rawMessageType:
+			col.kind = kindJSON
+			col.nullable = true
+		case ft == timeType:
+			col.kind = kindString
+		case ft.Implements(valuerType):
+			// other database types such as pqtype.NullRawMessage
+			col.kind = kindJSON
+			col.nullable = true
+			col.valuer = true
+		default:
+			switch ft.Kind() {
+			case reflect.String:
+				col.kind = kindString
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+				reflect.Uint8, reflect.Uint16, reflect.Uint32:
+				col.kind = kindInt
+			case reflect.Float32, reflect.Float64:
+				col.kind = kindFloat
+			case reflect.Bool:
+				col.kind = kindBool
+			default:
+				col.kind = kindJSON
+				col.nullable = true
+			}
+		}
+		cols = append(cols, col)
+	}
+	return cols
+}
+
+// cell returns the value of col in row as string, int64, float64 or bool,
+// or nil for null values
+func (col tableColumn) cell(row reflect.Value) any {
+	v := row.Field(col.index)
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if col.valuer {
+		return col.driverCell(v)
+	}
+
+	switch col.kind {
+	case kindInt:
+		return v.Convert(reflect.TypeOf(int64(0))).Int()
+	case kindFloat:
+		return v.Float()
+	case kindBool:
+		return v.Bool()
+	case kindJSON:
+		if v.Type() == rawMessageType {
+			if v.Len() == 0 {
+				return nil
+			}
+			return string(v.Bytes())
+		}
+		if (v.Kind() == reflect.Slice || v.Kind() == reflect.Map) && v.IsNil() {
+			return nil
+		}
+		b, err := json.Marshal(v.Interface())
+		if err != nil {
+			return nil
+		}
+		return string(b)
+	default:
+		if v.Type() == timeType {
+			return v.Interface().(time.Time).Format(time.RFC3339Nano)
+		}
+		return v.String()
+	}
+}
+
+// driverCell unwraps a driver.Valuer field
+func (col tableColumn) driverCell(v reflect.Value) any {
+	val, err := v.Interface().(driver.Valuer).Value()
+	if err != nil || val == nil {
+		return nil
+	}
+	switch val := val.(type) {
+	case time.Time:
+		return val.Format(time.RFC3339Nano)
+	case []byte:
+		if len(val) == 0 {
+			return nil
+		}
+		return string(val)
+	case int64:
+		if col.kind == kindFloat {
+			return float64(val)
+		}
+	}
+	return val
+}
+
+// parquetRowType builds a struct type with one parquet field per column,
+// in column order
+func parquetRowType(cols []tableColumn) reflect.Type {
+	fields := make([]reflect.StructField, len(cols))
+	for i, col := range cols {
+		var ft reflect.Type
+		switch col.kind {
+		case kindInt:
+			ft = reflect.TypeOf(int64(0))
+		case kindFloat:
+			ft = reflect.TypeOf(float64(0))
+		case kindBool:
+			ft = reflect.TypeOf(false)
+		default:
+			ft = reflect.TypeOf("")
+		}
+		tag := col.name
+		if col.nullable {
+			ft = reflect.PointerTo(ft)
+			tag += ",optional"
+		}
+		fields[i] = reflect.StructField{
+			Name: fmt.Sprintf("F%d", i),
+			Type: ft,
+			Tag:  reflect.StructTag(fmt.Sprintf(`parquet:%q`, tag)),
+		}
+	}
+	return reflect.StructOf(fields)
+}
+
+// snakeCase turns a Go field name such as UserID or StatusOld into user_id
+// or status_old
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
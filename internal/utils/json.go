@@ -30,8 +30,8 @@ func goTypeToFriendlyType(goType string) string {
 
 func ReadJSON(w http.ResponseWriter, r *http.Request, data any) error {
 	if r.Header.Get("X-Was-Gzipped") != "true" {
-		maxBytes := int64(50 * 1024 * 1024) // 50MB for regular content only
-		r.Body = http.MaxBytesReader(w, r.Body, maxBytes)
+		// gzip bodies are already capped by GzipDecompressionMiddleware
+		r.Body = http.MaxBytesReader(w, r.Body, GetBodyLimits(r.Context()).MaxBytes)
 	}
 
 	decoder := json.NewDecoder(r.Body)
@@ -0,0 +1,36 @@
+package utils
+
+import "context"
+
+// BodyLimits caps the size of request bodies
+type BodyLimits struct {
+	MaxBytes             int64 // plain JSON bodies
+	MaxGzipBytes         int64 // gzip-compressed bodies
+	MaxDecompressedBytes int64 // gzip bodies after decompression
+}
+
+var defaultBodyLimits = BodyLimits{
+	MaxBytes:             50 * 1024 * 1024,   // 50 MB
+	MaxGzipBytes:         200 * 1024 * 1024,  // 200 MB
+	MaxDecompressedBytes: 1024 * 1024 * 1024, // 1 GB
+}
+
+type bodyLimitsKey struct{}
+
+// SetDefaultBodyLimits replaces the limits used when a route sets none
+func SetDefaultBodyLimits(l BodyLimits) {
+	defaultBodyLimits = l
+}
+
+// WithBodyLimits attaches route specific body limits to the request context
+func WithBodyLimits(ctx context.Context, l BodyLimits) context.Context {
+	return context.WithValue(ctx, bodyLimitsKey{}, l)
+}
+
+// GetBodyLimits returns the body limits of the current route
+func GetBodyLimits(ctx context.Context) BodyLimits {
+	if l, ok := ctx.Value(bodyLimitsKey{}).(BodyLimits); ok {
+		return l
+	}
+	return defaultBodyLimits
+}
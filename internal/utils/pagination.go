@@ -0,0 +1,219 @@
+package utils
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Cursor is the keyset position of the last row of a page. It is sent to
+// clients as an opaque base64url token; which fields are set depends on
+// the sort order of the endpoint.
+type Cursor struct {
+	Time  *time.Time `json:"t,omitempty"`
+	N     *int64     `json:"n,omitempty"`
+	ID    *uuid.UUID `json:"id,omitempty"`
+	SubID *uuid.UUID `json:"sid,omitempty"`
+	Key   *string    `json:"k,omitempty"`
+}
+
+// CursorKeys lists the Cursor fields an endpoint sorts on
+type CursorKeys uint8
+
+const (
+	CursorTime CursorKeys = 1 << iota
+	CursorN
+	CursorID
+	CursorSubID
+	CursorKey
+)
+
+func (c Cursor) keys() CursorKeys {
+	var k CursorKeys
+	if c.Time != nil {
+		k |= CursorTime
+	}
+	if c.N != nil {
+		k |= CursorN
+	}
+	if c.ID != nil {
+		k |= CursorID
+	}
+	if c.SubID != nil {
+		k |= CursorSubID
+	}
+	if c.Key != nil {
+		k |= CursorKey
+	}
+	return k
+}
+
+// EncodeCursor returns the opaque token for c
+func EncodeCursor(c Cursor) string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeCursor parses a token produced by EncodeCursor
+func DecodeCursor(s string) (Cursor, error) {
+	var c Cursor
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, ErrInvalidCursor
+	}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return c, ErrInvalidCursor
+	}
+	return c, nil
+}
+
+// PageLimits are the default and maximum page sizes of an endpoint
+type PageLimits struct {
+	Default int32
+	Max     int32
+}
+
+var DefaultPageLimits = PageLimits{Default: 100, Max: 1000}
+
+// Page is a keyset pagination request: at most Limit rows after the
+// position in After (nil on the first page)
+type Page struct {
+	Limit int32
+	After *Cursor
+
+	raw string
+}
+
+// ParsePage reads the limit and cursor query parameters. A cursor must
+// carry exactly the keys the endpoint sorts on.
+func ParsePage(r *http.Request, limits PageLimits, keys CursorKeys) (Page, error) {
+	p := Page{Limit: limits.Default}
+
+	if val := r.URL.Query().Get("limit"); val != "" {
+		n, err := strconv.ParseInt(val, 10, 32)
+		if err != nil || n < 1 {
+			return p, ErrInvalidLimit
+		}
+		if int32(n) > limits.Max {
+			return p, fmt.Errorf("%w: limit must be at most %d", ErrMaxLimitExceeded, limits.Max)
+		}
+		p.Limit = int32(n)
+	}
+
+	if val := r.URL.Query().Get("cursor"); val != "" {
+		c, err := DecodeCursor(val)
+		if err != nil {
+			return p, err
+		}
+		if c.keys() != keys {
+			return p, ErrInvalidCursor
+		}
+		p.After = &c
+		p.raw = val
+	}
+
+	return p, nil
+}
+
+// CacheKey identifies the page in cache keys
+func (p Page) CacheKey() string {
+	return fmt.Sprintf("l=%d:c=%s", p.Limit, p.raw)
+}
+
+// FetchLimit is the number of rows to query; the extra row tells whether
+// there is a next page
+func (p Page) FetchLimit() int32 {
+	return p.Limit + 1
+}
+
+func (p Page) AfterTime() sql.NullTime {
+	if p.After == nil || p.After.Time == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *p.After.Time, Valid: true}
+}
+
+func (p Page) AfterN() sql.NullInt64 {
+	if p.After == nil || p.After.N == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: *p.After.N, Valid: true}
+}
+
+func (p Page) AfterID() uuid.NullUUID {
+	if p.After == nil || p.After.ID == nil {
+		return uuid.NullUUID{}
+	}
+	return uuid.NullUUID{UUID: *p.After.ID, Valid: true}
+}
+
+func (p Page) AfterSubID() uuid.NullUUID {
+	if p.After == nil || p.After.SubID == nil {
+		return uuid.NullUUID{}
+	}
+	return uuid.NullUUID{UUID: *p.After.SubID, Valid: true}
+}
+
+func (p Page) AfterKey() sql.NullString {
+	if p.After == nil || p.After.Key == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: *p.After.Key, Valid: true}
+}
+
+// PageInfo is the "pagination" object of list responses
+type PageInfo struct {
+	Limit      int32  `json:"limit"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// NextPage drops the extra row fetched with FetchLimit and returns the
+// page info, with the cursor of the last row if more rows follow
+func NextPage[T any](rows []T, p Page, cursorOf func(T) Cursor) ([]T, PageInfo) {
+	info := PageInfo{Limit: p.Limit}
+	if int32(len(rows)) <= p.Limit {
+		return rows, info
+	}
+	rows = rows[:p.Limit]
+	info.NextCursor = EncodeCursor(cursorOf(rows[len(rows)-1]))
+	return rows, info
+}
+
+// SetNextLink advertises the next page in an RFC 8288 Link header
+func SetNextLink(w http.ResponseWriter, r *http.Request, next string) {
+	if next == "" {
+		return
+	}
+	u := *r.URL
+	q := u.Query()
+	q.Set("cursor", next)
+	u.RawQuery = q.Encode()
+	w.Header().Set("Link", fmt.Sprintf("<%s>; rel=\"next\"", u.RequestURI()))
+}
+
+// CachedNextCursor reads pagination.next_cursor from a cached response body
+func CachedNextCursor(raw string) string {
+	var body struct {
+		Pagination PageInfo `json:"pagination"`
+	}
+	if err := json.Unmarshal([]byte(raw), &body); err != nil {
+		return ""
+	}
+	return body.Pagination.NextCursor
+}
+
+// TimeCursor is the cursor of lists ordered by a timestamp and a UUID
+func TimeCursor(t time.Time, id uuid.UUID) Cursor {
+	return Cursor{Time: &t, ID: &id}
+}
+
+// IntCursor is the cursor of lists ordered by an integer key
+func IntCursor(n int64) Cursor {
+	return Cursor{N: &n}
+}
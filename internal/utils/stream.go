@@ -0,0 +1,283 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// ContentTypeNDJSON is the media type of newline-delimited JSON responses
+const ContentTypeNDJSON = "application/x-ndjson"
+
+const (
+	// streamFlushRows is the number of rows written between flushes
+	streamFlushRows = 100
+
+	// StreamCacheLimit is the largest streamed JSON body kept for caching.
+	// Bigger responses are streamed without being cached.
+	StreamCacheLimit = 1 << 20
+)
+
+var (
+	errRowOutsideArray = errors.New("stream: Row called before Array")
+	errBareArray       = errors.New("stream: members are not allowed in a bare array")
+)
+
+// WantsNDJSON reports whether the Accept header asks for application/x-ndjson
+func WantsNDJSON(r *http.Request) bool {
+	for _, part := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, _, _ := strings.Cut(part, ";")
+		if strings.EqualFold(strings.TrimSpace(mediaType), ContentTypeNDJSON) {
+			return true
+		}
+	}
+	return false
+}
+
+// StreamWriter writes a list response row by row instead of marshalling
+// it as a whole. In JSON mode the output is a single object whose members
+// are written in call order; in NDJSON mode every row is one line and
+// every Field is a {"key": value} line. Output is flushed as it goes.
+type StreamWriter struct {
+	w       http.ResponseWriter
+	rc      *http.ResponseController
+	ndjson  bool
+	tagRows bool
+	bare    bool
+
+	started   bool
+	members   int
+	inArray   bool
+	arrayKey  string
+	rows      int
+	unflushed int
+
+	cache *bytes.Buffer
+	err   error
+}
+
+// NewStreamWriter picks JSON or NDJSON output from the Accept header of r.
+// Nothing is written until the first Field, Array or Close call, so an
+// error response can still be sent before that.
+func NewStreamWriter(w http.ResponseWriter, r *http.Request) *StreamWriter {
+	s := &StreamWriter{
+		w:      w,
+		rc:     http.NewResponseController(w),
+		ndjson: WantsNDJSON(r),
+	}
+	if !s.ndjson {
+		s.cache = new(bytes.Buffer)
+	}
+	return s
+}
+
+// NewArrayStreamWriter is NewStreamWriter for responses whose JSON body
+// is a bare array; only Row and Close may be called
+func NewArrayStreamWriter(w http.ResponseWriter, r *http.Request) *StreamWriter {
+	s := NewStreamWriter(w, r)
+	s.bare = true
+	s.inArray = true
+	return s
+}
+
+// NDJSON reports whether the response is newline-delimited JSON
+func (s *StreamWriter) NDJSON() bool {
+	return s.ndjson
+}
+
+// TagRows writes NDJSON rows as {"type": <array key>, "data": <row>} so
+// rows of responses with several arrays can be told apart
+func (s *StreamWriter) TagRows() {
+	s.tagRows = true
+}
+
+// Started reports whether anything has been written yet
+func (s *StreamWriter) Started() bool {
+	return s.started
+}
+
+// Field writes a single member
+func (s *StreamWriter) Field(key string, v any) error {
+	if s.bare {
+		return s.fail(errBareArray)
+	}
+	s.start()
+	s.endArray()
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return s.fail(err)
+	}
+	if s.ndjson {
+		line, _ := json.Marshal(map[string]json.RawMessage{key: b})
+		s.write(line)
+		s.write([]byte{'\n'})
+	} else {
+		s.member(key)
+		s.write(b)
+	}
+	return s.err
+}
+
+// Array starts the array member key; the following Row calls add its elements
+func (s *StreamWriter) Array(key string) error {
+	if s.bare {
+		return s.fail(errBareArray)
+	}
+	s.start()
+	s.endArray()
+
+	s.inArray = true
+	s.arrayKey = key
+	s.rows = 0
+	if !s.ndjson {
+		s.member(key)
+		s.write([]byte{'['})
+	}
+	return s.err
+}
+
+// Row writes one element of the current array
+func (s *StreamWriter) Row(v any) error {
+	if !s.inArray {
+		return s.fail(errRowOutsideArray)
+	}
+	s.start()
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return s.fail(err)
+	}
+	if s.ndjson {
+		if s.tagRows {
+			b, _ = json.Marshal(struct {
+				Type string          `json:"type"`
+				Data json.RawMessage `json:"data"`
+			}{s.arrayKey, b})
+		}
+		s.write(b)
+		s.write([]byte{'\n'})
+	} else {
+		if s.rows > 0 {
+			s.write([]byte{','})
+		}
+		s.write(b)
+	}
+
+	s.rows++
+	s.unflushed++
+	if s.unflushed >= streamFlushRows {
+		s.flush()
+	}
+	return s.err
+}
+
+// StreamArray writes rows as the array member key
+func StreamArray[T any](s *StreamWriter, key string, rows []T) error {
+	if err := s.Array(key); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if err := s.Row(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close ends the response and flushes what is left
+func (s *StreamWriter) Close() error {
+	s.start()
+	s.endArray()
+	switch {
+	case s.ndjson:
+	case s.bare:
+		s.write([]byte("]\n"))
+	default:
+		s.write([]byte("}\n"))
+	}
+	s.flush()
+	return s.err
+}
+
+// CachedBody returns the complete JSON body for caching. It reports false
+// for NDJSON responses, failed writes and bodies over StreamCacheLimit.
+func (s *StreamWriter) CachedBody() (string, bool) {
+	if s.err != nil || s.cache == nil {
+		return "", false
+	}
+	return s.cache.String(), true
+}
+
+func (s *StreamWriter) start() {
+	if s.started {
+		return
+	}
+	s.started = true
+
+	contentType := "application/json"
+	if s.ndjson {
+		contentType = ContentTypeNDJSON
+	}
+	s.w.Header().Set("Content-Type", contentType)
+	s.w.WriteHeader(http.StatusOK)
+
+	switch {
+	case s.ndjson:
+	case s.bare:
+		s.write([]byte{'['})
+	default:
+		s.write([]byte{'{'})
+	}
+}
+
+func (s *StreamWriter) member(key string) {
+	if s.members > 0 {
+		s.write([]byte{','})
+	}
+	s.members++
+	k, _ := json.Marshal(key)
+	s.write(k)
+	s.write([]byte{':'})
+}
+
+func (s *StreamWriter) endArray() {
+	if s.inArray && !s.ndjson && !s.bare {
+		s.write([]byte{']'})
+	}
+	s.inArray = false
+}
+
+func (s *StreamWriter) write(p []byte) {
+	if s.err != nil {
+		return
+	}
+	if _, err := s.w.Write(p); err != nil {
+		s.err = err
+		return
+	}
+	if s.cache != nil {
+		if s.cache.Len()+len(p) > StreamCacheLimit {
+			s.cache = nil
+		} else {
+			s.cache.Write(p)
+		}
+	}
+}
+
+func (s *StreamWriter) flush() {
+	s.unflushed = 0
+	if s.err == nil {
+		// writers that cannot flush simply buffer
+		_ = s.rc.Flush()
+	}
+}
+
+func (s *StreamWriter) fail(err error) error {
+	if s.err == nil {
+		s.err = err
+	}
+	return s.err
+}
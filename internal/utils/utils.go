@@ -17,9 +17,15 @@ import (
 	"github.com/sqlc-dev/pqtype"
 )
 
-// query timeout duration
-const QueryTimeout = 30 * time.Second
-const BulkQueryTimeout = 3 * time.Minute
+// query timeout duration, overridden at startup by SetQueryTimeouts
+var QueryTimeout = 30 * time.Second
+var BulkQueryTimeout = 3 * time.Minute
+
+// SetQueryTimeouts replaces the default database query timeouts
+func SetQueryTimeouts(query, bulk time.Duration) {
+	QueryTimeout = query
+	BulkQueryTimeout = bulk
+}
 
 // Validator to be initialized once
 var validate *validator.Validate
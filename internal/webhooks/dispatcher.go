@@ -0,0 +1,250 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authn"
+	"github.com/DeRuina/KUHA-REST-API/internal/auth/authz"
+	authsqlc "github.com/DeRuina/KUHA-REST-API/internal/db/auth"
+	"github.com/DeRuina/KUHA-REST-API/internal/events"
+	"github.com/DeRuina/KUHA-REST-API/internal/logger"
+	"github.com/DeRuina/KUHA-REST-API/internal/store/auth"
+)
+
+// maxRetryDelay caps the backoff between attempts of a delivery
+const maxRetryDelay = time.Hour
+
+// maxDrainBody is how much of a response is read before closing it, so
+// that the connection can be reused
+const maxDrainBody = 512
+
+// Options configures a Dispatcher
+type Options struct {
+	Workers      int
+	PollInterval time.Duration
+	BatchSize    int
+	Timeout      time.Duration
+	MaxAttempts  int
+	RetryBackoff time.Duration
+	// AllowPrivate lets deliveries go to loopback, private and other
+	// non-public addresses, for local testing only
+	AllowPrivate bool
+}
+
+// Dispatcher sends queued deliveries. Deliveries are claimed with SKIP
+// LOCKED and leased for a while, so several API instances can share the
+// queue and a delivery whose instance died is retried once its lease runs
+// out. A 2xx response acknowledges a delivery; anything else is retried
+// with exponential backoff up to MaxAttempts, after which the delivery is
+// dead and waits for a manual redelivery. The client's current roles are
+// checked before every attempt: a subscription whose client can no longer
+// read the domain of a delivery is disabled.
+type Dispatcher struct {
+	store  auth.Webhooks
+	opts   Options
+	client *http.Client
+	agent  string
+
+	wake   chan struct{}
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewDispatcher(store auth.Webhooks, opts Options, version string) *Dispatcher {
+	dialer := &net.Dialer{Timeout: opts.Timeout}
+	if !opts.AllowPrivate {
+		dialer.Control = publicOnly
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// a proxy would be dialed instead of the subscriber, escaping the check
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &Dispatcher{
+		store: store,
+		opts:  opts,
+		client: &http.Client{
+			Timeout:   opts.Timeout,
+			Transport: transport,
+			// a redirect could point the signed payload anywhere
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		agent: "KUHA-REST-API-Webhooks/" + version,
+		wake:  make(chan struct{}, 1),
+	}
+}
+
+// Start launches the workers
+func (d *Dispatcher) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	d.cancel = cancel
+
+	for i := 0; i < d.opts.Workers; i++ {
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+			d.work(ctx)
+		}()
+	}
+
+	logger.Logger.Infow("webhook workers started", "workers", d.opts.Workers)
+}
+
+// Stop waits for the deliveries in flight and the workers to exit
+func (d *Dispatcher) Stop() {
+	if d.cancel == nil {
+		return
+	}
+	d.cancel()
+	d.wg.Wait()
+	logger.Logger.Info("webhook workers stopped")
+}
+
+// Notify wakes an idle worker so new deliveries go out without waiting for
+// the next poll
+func (d *Dispatcher) Notify() {
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (d *Dispatcher) work(ctx context.Context) {
+	ticker := time.NewTicker(d.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		// drain the queue before waiting again
+		for ctx.Err() == nil {
+			// long enough for every delivery of the batch to time out
+			lease := time.Now().Add(time.Duration(d.opts.BatchSize+1) * d.opts.Timeout)
+			batch, err := d.store.ClaimDeliveries(ctx, lease, int32(d.opts.BatchSize))
+			if err != nil {
+				if ctx.Err() == nil {
+					logger.Logger.Warnw("claiming webhook deliveries failed", "error", err)
+				}
+				break
+			}
+			for _, delivery := range batch {
+				// the rest of the batch is retried when its lease runs out
+				if ctx.Err() != nil {
+					return
+				}
+				d.deliver(delivery)
+			}
+			if len(batch) < d.opts.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-d.wake:
+		}
+	}
+}
+
+// deliver sends one delivery and records the outcome. It is not cut short
+// by Stop: the request is bounded by the client timeout.
+func (d *Dispatcher) deliver(delivery authsqlc.ClaimWebhookDeliveriesRow) {
+	log := logger.Logger.With("delivery_id", delivery.ID, "subscription_id", delivery.SubscriptionID, "event_id", delivery.EventID, "attempt", delivery.Attempts)
+	ctx := context.Background()
+
+	// the roles of the client are read with the delivery, so a client that
+	// lost access to the domain since it subscribed gets nothing more
+	client := authn.WithClientMetadata(ctx, delivery.ClientName, delivery.ClientRoles)
+	if !authz.Allowed(client, http.MethodGet, "/v1/"+delivery.Domain) {
+		log.Warnw("webhook client can no longer read the domain, disabling the subscription", "client", delivery.ClientName, "domain", delivery.Domain)
+		if err := d.store.DisableSubscription(ctx, delivery.SubscriptionID); err != nil {
+			log.Warnw("disabling webhook subscription failed", "error", err)
+		}
+		msg := fmt.Sprintf("access denied to domain %s: subscription disabled", delivery.Domain)
+		if err := d.store.KillDelivery(ctx, delivery.ID, 0, msg); err != nil {
+			log.Warnw("moving webhook delivery to dead letters failed", "error", err)
+		}
+		return
+	}
+
+	status, err := d.send(ctx, delivery)
+	switch {
+	case err == nil:
+		if err := d.store.SucceedDelivery(ctx, delivery.ID, status); err != nil {
+			log.Warnw("marking webhook delivery as succeeded failed", "error", err)
+		}
+	case int(delivery.Attempts) >= d.opts.MaxAttempts:
+		log.Warnw("webhook delivery failed, no attempts left", "error", err)
+		msg := fmt.Sprintf("%s (gave up after %d attempts)", err, delivery.Attempts)
+		if err := d.store.KillDelivery(ctx, delivery.ID, status, msg); err != nil {
+			log.Warnw("moving webhook delivery to dead letters failed", "error", err)
+		}
+	default:
+		delay := d.retryDelay(int(delivery.Attempts))
+		log.Infow("webhook delivery failed, retrying", "error", err, "retry_in", delay.String())
+		if err := d.store.RetryDelivery(ctx, delivery.ID, status, err.Error(), time.Now().Add(delay)); err != nil {
+			log.Warnw("requeueing webhook delivery failed", "error", err)
+		}
+	}
+}
+
+// send posts the signed payload of delivery and returns the response
+// status, 0 if there was no response
+func (d *Dispatcher) send(ctx context.Context, delivery authsqlc.ClaimWebhookDeliveriesRow) (int, error) {
+	e := events.Event{
+		Domain:     delivery.Domain,
+		Entity:     delivery.Entity,
+		Operation:  delivery.Operation,
+		AthleteIDs: delivery.AthleteIds,
+		Records:    int(delivery.Records),
+	}
+	body, err := json.Marshal(e.Published(delivery.EventID, delivery.CreatedAt))
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", d.agent)
+	req.Header.Set(HeaderEvent, e.Type())
+	req.Header.Set(HeaderEventID, strconv.FormatInt(delivery.EventID, 10))
+	req.Header.Set(HeaderDelivery, strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set(HeaderSignature, Sign(delivery.Secret, time.Now(), body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	io.Copy(io.Discard, io.LimitReader(resp.Body, maxDrainBody))
+
+	// the body is not kept: it is returned to the client with the delivery
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("%d %s", resp.StatusCode, http.StatusText(resp.StatusCode))
+	}
+	return resp.StatusCode, nil
+}
+
+// retryDelay is the backoff after the given number of attempts
+func (d *Dispatcher) retryDelay(attempts int) time.Duration {
+	delay := d.opts.RetryBackoff
+	for i := 1; i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
@@ -0,0 +1,50 @@
+// Command webhook_stub is a local webhook receiver for trying out the
+// change events. It verifies the signature of every delivery, prints the
+// event and answers 204, or the status given with -status to exercise the
+// retries and the dead letters.
+//
+//	go run ./scripts/webhook_stub -addr :9000 -secret whsec_...
+//
+// Run the API with WEBHOOKS_ALLOW_HTTP=true and subscribe
+// http://localhost:9000/ to receive the events.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/DeRuina/KUHA-REST-API/internal/webhooks"
+)
+
+func main() {
+	addr := flag.String("addr", ":9000", "listen address")
+	secret := flag.String("secret", "", "signing secret of the subscription; empty skips verification")
+	status := flag.Int("status", http.StatusNoContent, "response status")
+	flag.Parse()
+
+	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if *secret != "" {
+			if err := webhooks.Verify(*secret, r.Header.Get(webhooks.HeaderSignature), body, 5*time.Minute); err != nil {
+				log.Printf("rejected delivery %s: %v", r.Header.Get(webhooks.HeaderDelivery), err)
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+		}
+
+		fmt.Printf("delivery %s: %s\n%s\n", r.Header.Get(webhooks.HeaderDelivery), r.Header.Get(webhooks.HeaderEvent), body)
+		w.WriteHeader(*status)
+	})
+
+	log.Printf("webhook stub listening on %s", *addr)
+	log.Fatal(http.ListenAndServe(*addr, nil))
+}